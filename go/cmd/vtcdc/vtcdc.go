@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtcdc"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+
+	// Import and register the gRPC vtgateconn client
+	_ "vitess.io/vitess/go/vt/vtgate/grpcvtgateconn"
+)
+
+var (
+	usage = `
+vtcdc streams the row changes of a Vitess cluster from the VStream API of
+vtgate, and writes them to a sink. The position of the stream is saved in a
+checkpoint store, so that vtcdc resumes where it stopped after a restart.
+
+Examples:
+
+  $ vtcdc -server vtgate:15991 -keyspace commerce -tables customer,corder
+
+  $ vtcdc -server vtgate:15991 -keyspace commerce -cdc_sink webhook -cdc_webhook_url http://localhost:8080/events \
+      -cdc_checkpoint_store table -cdc_checkpoint_target cdc -name commerce_webhook
+
+`
+	server             = flag.String("server", "", "vtgate server to connect to")
+	name               = flag.String("name", "vtcdc", "name of the stream, used as the key of its checkpoint")
+	keyspace           = flag.String("keyspace", "", "keyspace to stream from, all keyspaces if empty")
+	shard              = flag.String("shard", "", "shard to stream from, all shards of the keyspace if empty")
+	tables             = flag.String("tables", "", "comma separated list of tables or /regexps to stream, all tables if empty")
+	tabletType         = flag.String("tablet_type", "replica", "type of the tablets to stream from")
+	minimizeSkew       = flag.Bool("minimize_skew", false, "ask vtgate to align the timestamps of the shards")
+	heartbeatInterval  = flag.Duration("heartbeat_interval", 0, "interval of the vtgate heartbeats, none if 0")
+	retryDelay         = flag.Duration("retry_delay", 5*time.Second, "time to wait before restarting the stream after an error")
+	checkpointInterval = flag.Duration("checkpoint_interval", time.Second, "minimum time between two checkpoints, 0 to save one after every transaction")
+)
+
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+		flag.PrintDefaults()
+		fmt.Fprint(os.Stderr, usage)
+	}
+}
+
+func main() {
+	defer exit.Recover()
+	defer logutil.Flush()
+
+	flag.Parse()
+	if *server == "" {
+		flag.Usage()
+		log.Exitf("-server is required")
+	}
+	if *keyspace == "" && *shard != "" {
+		log.Exitf("-shard requires -keyspace")
+	}
+	tt, err := topoproto.ParseTabletType(*tabletType)
+	if err != nil {
+		log.Exitf("invalid -tablet_type: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-sigChan
+		log.Infof("Received %v, stopping the stream", sig)
+		cancel()
+	}()
+
+	conn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		log.Exitf("cannot connect to vtgate: %v", err)
+	}
+	defer conn.Close()
+	store, err := vtcdc.NewCheckpointStore(ctx, conn, *name)
+	if err != nil {
+		log.Exitf("cannot create the checkpoint store: %v", err)
+	}
+	defer store.Close()
+	sink, err := vtcdc.NewSink()
+	if err != nil {
+		log.Exitf("cannot create the sink: %v", err)
+	}
+	defer sink.Close()
+
+	streamer := vtcdc.NewStreamer(conn, store, sink, vtcdc.Params{
+		TabletType: tt,
+		Filter:     filter(),
+		StartVGtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: *keyspace,
+				Shard:    *shard,
+				Gtid:     "current",
+			}},
+		},
+		MinimizeSkew:       *minimizeSkew,
+		HeartbeatInterval:  uint32(heartbeatInterval.Seconds()),
+		RetryDelay:         *retryDelay,
+		CheckpointInterval: *checkpointInterval,
+	})
+	if err := streamer.Run(ctx); err != nil {
+		log.Errorf("vtcdc failed: %v", err)
+		exit.Return(1)
+	}
+}
+
+func filter() *binlogdatapb.Filter {
+	if *tables == "" {
+		return &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "/.*"}}}
+	}
+	filter := &binlogdatapb.Filter{}
+	for _, table := range strings.Split(*tables, ",") {
+		filter.Rules = append(filter.Rules, &binlogdatapb.Rule{Match: strings.TrimSpace(table)})
+	}
+	return filter
+}
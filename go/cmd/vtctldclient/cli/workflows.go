@@ -0,0 +1,47 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// ParseKeyspaceWorkflow splits a <keyspace.workflow> positional argument into
+// its keyspace and workflow names.
+func ParseKeyspaceWorkflow(arg string) (keyspace string, workflow string, err error) {
+	parts := strings.Split(arg, ".")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid format for <keyspace.workflow>: %s", arg)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// ParseTabletTypes parses a comma-separated list of tablet types. It returns
+// nil for an empty list.
+func ParseTabletTypes(arg string) ([]topodatapb.TabletType, error) {
+	if arg == "" {
+		return nil, nil
+	}
+
+	return topoproto.ParseTabletTypes(arg)
+}
@@ -17,12 +17,17 @@ limitations under the License.
 package command
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
@@ -38,6 +43,7 @@ var GetBackups = &cobra.Command{
 var getBackupsOptions = struct {
 	Limit      uint32
 	OutputJSON bool
+	Detailed   bool
 }{}
 
 func commandGetBackups(cmd *cobra.Command, args []string) error {
@@ -52,6 +58,7 @@ func commandGetBackups(cmd *cobra.Command, args []string) error {
 		Keyspace: keyspace,
 		Shard:    shard,
 		Limit:    getBackupsOptions.Limit,
+		Detailed: getBackupsOptions.Detailed,
 	})
 	if err != nil {
 		return err
@@ -70,6 +77,9 @@ func commandGetBackups(cmd *cobra.Command, args []string) error {
 	names := make([]string, len(resp.Backups))
 	for i, b := range resp.Backups {
 		names[i] = b.Name
+		if b.ParentBackup != "" {
+			names[i] = fmt.Sprintf("%s (incremental on top of %s)", b.Name, b.ParentBackup)
+		}
 	}
 
 	fmt.Printf("%s\n", strings.Join(names, "\n"))
@@ -77,8 +87,87 @@ func commandGetBackups(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// RestoreFromBackup makes a RestoreFromBackup gRPC call to a vtctld.
+var RestoreFromBackup = &cobra.Command{
+	Use:   "RestoreFromBackup [--backup-timestamp <time>] [--restore-to-pos <position> | --restore-to-timestamp <time>] <tablet_alias>",
+	Short: "Stops mysqld on the specified tablet and restores the data from either the latest backup or closest before `backup-timestamp`. If a restore position or timestamp is given, the archived binary logs are then replayed up to it, and the tablet is left DRAINED.",
+	Args:  cobra.ExactArgs(1),
+	RunE:  commandRestoreFromBackup,
+}
+
+var restoreFromBackupOptions = struct {
+	BackupTimestamp    string
+	RestoreToPos       string
+	RestoreToTimestamp string
+}{}
+
+func commandRestoreFromBackup(cmd *cobra.Command, args []string) error {
+	alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	req := &vtctldatapb.RestoreFromBackupRequest{
+		TabletAlias:  alias,
+		RestoreToPos: restoreFromBackupOptions.RestoreToPos,
+	}
+
+	if restoreFromBackupOptions.BackupTimestamp != "" {
+		t, err := time.Parse(time.RFC3339, restoreFromBackupOptions.BackupTimestamp)
+		if err != nil {
+			return fmt.Errorf("cannot parse --backup-timestamp as RFC3339: %w", err)
+		}
+
+		req.BackupTime = logutil.TimeToProto(t)
+	}
+
+	if restoreFromBackupOptions.RestoreToPos != "" && restoreFromBackupOptions.RestoreToTimestamp != "" {
+		return errors.New("only one of --restore-to-pos and --restore-to-timestamp may be specified")
+	}
+
+	if restoreFromBackupOptions.RestoreToPos != "" {
+		if _, err := mysql.DecodePosition(restoreFromBackupOptions.RestoreToPos); err != nil {
+			return fmt.Errorf("cannot parse --restore-to-pos: %w", err)
+		}
+	}
+
+	if restoreFromBackupOptions.RestoreToTimestamp != "" {
+		t, err := time.Parse(time.RFC3339, restoreFromBackupOptions.RestoreToTimestamp)
+		if err != nil {
+			return fmt.Errorf("cannot parse --restore-to-timestamp as RFC3339: %w", err)
+		}
+
+		req.RestoreToTimestamp = logutil.TimeToProto(t)
+	}
+
+	cli.FinishedParsing(cmd)
+
+	stream, err := client.RestoreFromBackup(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		switch err {
+		case nil:
+			fmt.Println(logutil.EventString(resp.Event))
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
 func init() {
 	GetBackups.Flags().Uint32VarP(&getBackupsOptions.Limit, "limit", "l", 0, "Retrieve only the most recent N backups")
 	GetBackups.Flags().BoolVarP(&getBackupsOptions.OutputJSON, "json", "j", false, "Output backup info in JSON format rather than a list of backups")
+	GetBackups.Flags().BoolVar(&getBackupsOptions.Detailed, "detailed", false, "Read the MANIFEST of each backup to report its engine, status and parent backup, if it is incremental")
 	Root.AddCommand(GetBackups)
+
+	RestoreFromBackup.Flags().StringVarP(&restoreFromBackupOptions.BackupTimestamp, "backup-timestamp", "t", "", "Use the backup taken at or before this timestamp (RFC3339) rather than using the latest backup.")
+	RestoreFromBackup.Flags().StringVar(&restoreFromBackupOptions.RestoreToPos, "restore-to-pos", "", "Replay the archived binary logs on top of the backup, up to this GTID position.")
+	RestoreFromBackup.Flags().StringVar(&restoreFromBackupOptions.RestoreToTimestamp, "restore-to-timestamp", "", "Replay the archived binary logs on top of the backup, up to this timestamp (RFC3339).")
+	Root.AddCommand(RestoreFromBackup)
 }
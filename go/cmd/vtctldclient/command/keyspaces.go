@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo"
@@ -90,6 +91,17 @@ var (
 		Args:                  cobra.RangeArgs(1, 3),
 		RunE:                  commandSetKeyspaceShardingInfo,
 	}
+	// SetKeyspaceThrottlerConfig makes a SetKeyspaceThrottlerConfig gRPC call to a vtctld.
+	SetKeyspaceThrottlerConfig = &cobra.Command{
+		Use:   "SetKeyspaceThrottlerConfig [--clear] <keyspace> [<throttler config json>]",
+		Short: "Updates the throttler config of a keyspace, which selects the metrics that its tablet throttlers check each app against, and their thresholds.",
+		Long: `Updates the throttler config of a keyspace, which selects the metrics that its tablet throttlers check each app against, and their thresholds.
+The metrics are lag, threads_running, history_list_length, loadavg, datadir_used_ratio, semi_sync_ack_latency and custom. For example:
+{"metric_thresholds": {"lag": 5, "threads_running": 100}, "app_configs": {"tablegc": {"metrics": ["lag", "loadavg"], "metric_thresholds": {"loadavg": 2}}}}`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.RangeArgs(1, 2),
+		RunE:                  commandSetKeyspaceThrottlerConfig,
+	}
 )
 
 var createKeyspaceOptions = struct {
@@ -375,6 +387,43 @@ func commandSetKeyspaceShardingInfo(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var setKeyspaceThrottlerConfigOptions = struct {
+	Clear bool
+}{}
+
+func commandSetKeyspaceThrottlerConfig(cmd *cobra.Command, args []string) error {
+	keyspace := cmd.Flags().Arg(0)
+	throttlerConfig := &topodatapb.ThrottlerConfig{}
+	switch {
+	case setKeyspaceThrottlerConfigOptions.Clear && len(cmd.Flags().Args()) == 1:
+		// Nothing else to do; an empty config clears the throttler config.
+	case !setKeyspaceThrottlerConfigOptions.Clear && len(cmd.Flags().Args()) == 2:
+		if err := json2.Unmarshal([]byte(cmd.Flags().Arg(1)), throttlerConfig); err != nil {
+			return err
+		}
+	default:
+		return errors.New("exactly one of --clear and <throttler config json> must be provided")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.SetKeyspaceThrottlerConfig(commandCtx, &vtctldatapb.SetKeyspaceThrottlerConfigRequest{
+		Keyspace:        keyspace,
+		ThrottlerConfig: throttlerConfig,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+	return nil
+}
+
 func init() {
 	CreateKeyspace.Flags().BoolVarP(&createKeyspaceOptions.Force, "force", "f", false, "Proceeds even if the keyspace already exists. Does not overwrite the existing keyspace record")
 	CreateKeyspace.Flags().BoolVarP(&createKeyspaceOptions.AllowEmptyVSchema, "allow-empty-vschema", "e", false, "Allows a new keyspace to have no vschema")
@@ -404,4 +453,7 @@ func init() {
 
 	SetKeyspaceShardingInfo.Flags().BoolVarP(&setKeyspaceShardingInfoOptions.Force, "force", "f", false, "Updates fields even if they are already set. Use caution before passing force to this command.")
 	Root.AddCommand(SetKeyspaceShardingInfo)
+
+	SetKeyspaceThrottlerConfig.Flags().BoolVar(&setKeyspaceThrottlerConfigOptions.Clear, "clear", false, "Clears the throttler config of the keyspace, so that its tablets use their defaults.")
+	Root.AddCommand(SetKeyspaceThrottlerConfig)
 }
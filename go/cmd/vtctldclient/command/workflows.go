@@ -18,23 +18,211 @@ package command
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/protoutil"
 
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
 var (
+	// CancelWorkflow makes a CancelWorkflow gRPC call to a vtctld.
+	CancelWorkflow = &cobra.Command{
+		Use:                   "CancelWorkflow [--keep-data] <keyspace.workflow>",
+		Short:                 "Deletes a MoveTables or Reshard workflow that has not switched any traffic, along with its target tables or shards.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandCancelWorkflow,
+	}
+	// CompleteWorkflow makes a CompleteWorkflow gRPC call to a vtctld.
+	CompleteWorkflow = &cobra.Command{
+		Use:                   "CompleteWorkflow [--keep-data] [--rename-tables] [--dry-run] <keyspace.workflow>",
+		Short:                 "Cleans up a MoveTables or Reshard workflow that has switched all its traffic, dropping its source tables or shards.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandCompleteWorkflow,
+	}
+	// CreateLookupVindex makes a CreateLookupVindex gRPC call to a vtctld.
+	CreateLookupVindex = &cobra.Command{
+		Use:   "CreateLookupVindex [--cells <cells>] [--tablet-types <types>] [--continue-after-copy-with-owner] <keyspace> <json_spec>",
+		Short: "Creates a lookup vindex, its lookup table, and the workflow that backfills it.",
+		Long: `Creates a lookup vindex, its lookup table, and the workflow that backfills it.
+
+The json_spec is a keyspace vschema with the vindex to create, and the table
+it is added to.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandCreateLookupVindex,
+	}
 	// GetWorkflows makes a GetWorkflows gRPC call to a vtctld.
 	GetWorkflows = &cobra.Command{
 		Use:  "GetWorkflows <keyspace>",
 		Args: cobra.ExactArgs(1),
 		RunE: commandGetWorkflows,
 	}
+	// Materialize makes a Materialize gRPC call to a vtctld.
+	Materialize = &cobra.Command{
+		Use:   "Materialize [--cells <cells>] [--tablet-types <types>] <json_spec>",
+		Short: "Creates a workflow that materializes the results of queries on a source keyspace into a target keyspace.",
+		Long: `Creates a workflow that materializes the results of queries on a source keyspace
+into a target keyspace.
+
+The json_spec is a vtctldata.MaterializeSettings object.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandMaterialize,
+	}
+	// MoveTables makes a MoveTables gRPC call to a vtctld.
+	MoveTables = &cobra.Command{
+		Use:                   "MoveTables --source <keyspace> {--tables <tables> | --all [--exclude-tables <tables>]} [--cells <cells>] [--tablet-types <types>] [--auto-start] [--stop-after-copy] [--external-cluster <cluster>] <target_keyspace.workflow>",
+		Short:                 "Creates a workflow that moves tables from a source keyspace to a target keyspace.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandMoveTables,
+	}
+	// Reshard makes a Reshard gRPC call to a vtctld.
+	Reshard = &cobra.Command{
+		Use:                   "Reshard --source-shards <shards> --target-shards <shards> [--skip-schema-copy] [--cells <cells>] [--tablet-types <types>] [--auto-start] [--stop-after-copy] [--seed-from-backup] <keyspace.workflow>",
+		Short:                 "Creates a workflow that copies the data of the source shards of a keyspace to its target shards.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandReshard,
+	}
+	// ReverseTraffic makes a ReverseTraffic gRPC call to a vtctld.
+	ReverseTraffic = &cobra.Command{
+		Use:                   "ReverseTraffic [--cells <cells>] [--tablet-types <types>] [--timeout <duration>] [--reverse-replication] [--dry-run] <keyspace.workflow>",
+		Short:                 "Switches the traffic of a MoveTables or Reshard workflow back to its source.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandReverseTraffic,
+	}
+	// SwitchTraffic makes a SwitchTraffic gRPC call to a vtctld.
+	SwitchTraffic = &cobra.Command{
+		Use:   "SwitchTraffic [--cells <cells>] [--tablet-types <types>] [--timeout <duration>] [--reverse-replication] [--dry-run] [--not-before <timestamp>] [--not-after <timestamp>] [--retry-interval <duration>] [--max-attempts <n>] [--max-replication-lag <duration>] [--require-vdiff] [--check-throttler] <keyspace.workflow>",
+		Short: "Switches the traffic of a MoveTables or Reshard workflow from its source to its target.",
+		Long: `Switches the traffic of a MoveTables or Reshard workflow from its source to its target.
+
+If any of the schedule or precondition flags are set, the cutover is stored in
+the topo instead, and vtctld switches the traffic within the schedule window
+once the preconditions hold. Every attempt is recorded in the cutover of the
+workflow, which GetWorkflows returns.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandSwitchTraffic,
+	}
+	// VDiff makes a VDiff gRPC call to a vtctld.
+	VDiff = &cobra.Command{
+		Use:                   "VDiff [--source-cell <cell>] [--tablet-types <types>] [--filtered-replication-wait-time <duration>] [--limit <rows>] [--tables <tables>] [--debug-query] [--only-pks] <keyspace.workflow>",
+		Short:                 "Compares the source and target tables of a workflow.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandVDiff,
+	}
 )
 
+// printWorkflowResponse prints the response of a workflow action as JSON.
+func printWorkflowResponse(resp interface{}) error {
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var cancelWorkflowOptions = struct {
+	KeepData bool
+}{}
+
+func commandCancelWorkflow(cmd *cobra.Command, args []string) error {
+	keyspace, workflow, err := cli.ParseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.CancelWorkflow(commandCtx, &vtctldatapb.CancelWorkflowRequest{
+		Keyspace: keyspace,
+		Workflow: workflow,
+		KeepData: cancelWorkflowOptions.KeepData,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp)
+}
+
+var completeWorkflowOptions = struct {
+	KeepData     bool
+	RenameTables bool
+	DryRun       bool
+}{}
+
+func commandCompleteWorkflow(cmd *cobra.Command, args []string) error {
+	keyspace, workflow, err := cli.ParseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.CompleteWorkflow(commandCtx, &vtctldatapb.CompleteWorkflowRequest{
+		Keyspace:     keyspace,
+		Workflow:     workflow,
+		KeepData:     completeWorkflowOptions.KeepData,
+		RenameTables: completeWorkflowOptions.RenameTables,
+		DryRun:       completeWorkflowOptions.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp)
+}
+
+var createLookupVindexOptions = struct {
+	Cells                      []string
+	TabletTypes                string
+	ContinueAfterCopyWithOwner bool
+}{}
+
+func commandCreateLookupVindex(cmd *cobra.Command, args []string) error {
+	vindex := &vschemapb.Keyspace{}
+	if err := json2.Unmarshal([]byte(cmd.Flags().Arg(1)), vindex); err != nil {
+		return err
+	}
+
+	tabletTypes, err := cli.ParseTabletTypes(createLookupVindexOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.CreateLookupVindex(commandCtx, &vtctldatapb.CreateLookupVindexRequest{
+		Keyspace:                   cmd.Flags().Arg(0),
+		Vindex:                     vindex,
+		Cells:                      createLookupVindexOptions.Cells,
+		TabletTypes:                tabletTypes,
+		ContinueAfterCopyWithOwner: createLookupVindexOptions.ContinueAfterCopyWithOwner,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp)
+}
+
 var getWorkflowsOptions = struct {
 	ShowAll bool
 }{}
@@ -63,7 +251,373 @@ func commandGetWorkflows(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var materializeOptions = struct {
+	Cells       []string
+	TabletTypes string
+}{}
+
+func commandMaterialize(cmd *cobra.Command, args []string) error {
+	ms := &vtctldatapb.MaterializeSettings{}
+	if err := json2.Unmarshal([]byte(cmd.Flags().Arg(0)), ms); err != nil {
+		return err
+	}
+
+	// The flags override the settings of the spec, like in vtctl.
+	if cmd.Flags().Changed("cells") {
+		ms.Cell = strings.Join(materializeOptions.Cells, ",")
+	}
+	if cmd.Flags().Changed("tablet-types") {
+		if _, err := cli.ParseTabletTypes(materializeOptions.TabletTypes); err != nil {
+			return err
+		}
+		ms.TabletTypes = materializeOptions.TabletTypes
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.Materialize(commandCtx, &vtctldatapb.MaterializeRequest{
+		Settings: ms,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp)
+}
+
+var moveTablesOptions = struct {
+	SourceKeyspace  string
+	Tables          []string
+	AllTables       bool
+	ExcludeTables   []string
+	Cells           []string
+	TabletTypes     string
+	AutoStart       bool
+	StopAfterCopy   bool
+	ExternalCluster string
+}{}
+
+func commandMoveTables(cmd *cobra.Command, args []string) error {
+	keyspace, workflow, err := cli.ParseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletTypes, err := cli.ParseTabletTypes(moveTablesOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.MoveTables(commandCtx, &vtctldatapb.MoveTablesRequest{
+		Workflow:        workflow,
+		SourceKeyspace:  moveTablesOptions.SourceKeyspace,
+		TargetKeyspace:  keyspace,
+		Tables:          moveTablesOptions.Tables,
+		AllTables:       moveTablesOptions.AllTables,
+		ExcludeTables:   moveTablesOptions.ExcludeTables,
+		Cells:           moveTablesOptions.Cells,
+		TabletTypes:     tabletTypes,
+		AutoStart:       moveTablesOptions.AutoStart,
+		StopAfterCopy:   moveTablesOptions.StopAfterCopy,
+		ExternalCluster: moveTablesOptions.ExternalCluster,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp)
+}
+
+var reshardOptions = struct {
+	SourceShards   []string
+	TargetShards   []string
+	SkipSchemaCopy bool
+	Cells          []string
+	TabletTypes    string
+	AutoStart      bool
+	StopAfterCopy  bool
+	SeedFromBackup bool
+}{}
+
+func commandReshard(cmd *cobra.Command, args []string) error {
+	keyspace, workflow, err := cli.ParseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletTypes, err := cli.ParseTabletTypes(reshardOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.Reshard(commandCtx, &vtctldatapb.ReshardRequest{
+		Keyspace:       keyspace,
+		Workflow:       workflow,
+		SourceShards:   reshardOptions.SourceShards,
+		TargetShards:   reshardOptions.TargetShards,
+		SkipSchemaCopy: reshardOptions.SkipSchemaCopy,
+		Cells:          reshardOptions.Cells,
+		TabletTypes:    tabletTypes,
+		AutoStart:      reshardOptions.AutoStart,
+		StopAfterCopy:  reshardOptions.StopAfterCopy,
+		SeedFromBackup: reshardOptions.SeedFromBackup,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp)
+}
+
+// trafficOptions are the options of SwitchTraffic and ReverseTraffic.
+type trafficOptions struct {
+	Cells                    []string
+	TabletTypes              string
+	Timeout                  time.Duration
+	EnableReverseReplication bool
+	DryRun                   bool
+}
+
+var (
+	switchTrafficOptions  = trafficOptions{}
+	reverseTrafficOptions = trafficOptions{}
+)
+
+// switchTrafficScheduleOptions are the options of SwitchTraffic that schedule
+// the cutover.
+var switchTrafficScheduleOptions = struct {
+	NotBefore         string
+	NotAfter          string
+	RetryInterval     time.Duration
+	MaxAttempts       int32
+	MaxReplicationLag time.Duration
+	RequireVDiff      bool
+	CheckThrottler    bool
+}{}
+
+func commandReverseTraffic(cmd *cobra.Command, args []string) error {
+	keyspace, workflow, err := cli.ParseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletTypes, err := cli.ParseTabletTypes(reverseTrafficOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ReverseTraffic(commandCtx, &vtctldatapb.ReverseTrafficRequest{
+		Keyspace:                 keyspace,
+		Workflow:                 workflow,
+		Cells:                    reverseTrafficOptions.Cells,
+		TabletTypes:              tabletTypes,
+		Timeout:                  protoutil.DurationToProto(reverseTrafficOptions.Timeout),
+		EnableReverseReplication: reverseTrafficOptions.EnableReverseReplication,
+		DryRun:                   reverseTrafficOptions.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp)
+}
+
+func commandSwitchTraffic(cmd *cobra.Command, args []string) error {
+	keyspace, workflow, err := cli.ParseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletTypes, err := cli.ParseTabletTypes(switchTrafficOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	req := &vtctldatapb.SwitchTrafficRequest{
+		Keyspace:                 keyspace,
+		Workflow:                 workflow,
+		Cells:                    switchTrafficOptions.Cells,
+		TabletTypes:              tabletTypes,
+		Timeout:                  protoutil.DurationToProto(switchTrafficOptions.Timeout),
+		EnableReverseReplication: switchTrafficOptions.EnableReverseReplication,
+		DryRun:                   switchTrafficOptions.DryRun,
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("not-before") || flags.Changed("not-after") || flags.Changed("retry-interval") || flags.Changed("max-attempts") {
+		req.Schedule = &vtctldatapb.CutoverSchedule{
+			MaxAttempts: switchTrafficScheduleOptions.MaxAttempts,
+		}
+
+		if switchTrafficScheduleOptions.NotBefore != "" {
+			t, err := time.Parse(time.RFC3339, switchTrafficScheduleOptions.NotBefore)
+			if err != nil {
+				return fmt.Errorf("cannot parse --not-before as RFC3339: %w", err)
+			}
+
+			req.Schedule.NotBefore = protoutil.TimeToProto(t)
+		}
+
+		if switchTrafficScheduleOptions.NotAfter != "" {
+			t, err := time.Parse(time.RFC3339, switchTrafficScheduleOptions.NotAfter)
+			if err != nil {
+				return fmt.Errorf("cannot parse --not-after as RFC3339: %w", err)
+			}
+
+			req.Schedule.NotAfter = protoutil.TimeToProto(t)
+		}
+
+		if switchTrafficScheduleOptions.RetryInterval > 0 {
+			req.Schedule.RetryInterval = protoutil.DurationToProto(switchTrafficScheduleOptions.RetryInterval)
+		}
+	}
+
+	if flags.Changed("max-replication-lag") || flags.Changed("require-vdiff") || flags.Changed("check-throttler") {
+		req.Preconditions = &vtctldatapb.CutoverPreconditions{
+			RequireVdiff:   switchTrafficScheduleOptions.RequireVDiff,
+			CheckThrottler: switchTrafficScheduleOptions.CheckThrottler,
+		}
+
+		if flags.Changed("max-replication-lag") {
+			req.Preconditions.MaxReplicationLag = protoutil.DurationToProto(switchTrafficScheduleOptions.MaxReplicationLag)
+		}
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.SwitchTraffic(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp)
+}
+
+var vdiffOptions = struct {
+	SourceCell                  string
+	TabletTypes                 string
+	FilteredReplicationWaitTime time.Duration
+	Limit                       int64
+	Tables                      []string
+	DebugQuery                  bool
+	OnlyPks                     bool
+}{}
+
+func commandVDiff(cmd *cobra.Command, args []string) error {
+	keyspace, workflow, err := cli.ParseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletTypes, err := cli.ParseTabletTypes(vdiffOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	if vdiffOptions.Limit < 0 {
+		return fmt.Errorf("--limit cannot be negative")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.VDiff(commandCtx, &vtctldatapb.VDiffRequest{
+		Keyspace:                    keyspace,
+		Workflow:                    workflow,
+		SourceCell:                  vdiffOptions.SourceCell,
+		TabletTypes:                 tabletTypes,
+		FilteredReplicationWaitTime: protoutil.DurationToProto(vdiffOptions.FilteredReplicationWaitTime),
+		MaxRows:                     vdiffOptions.Limit,
+		Tables:                      vdiffOptions.Tables,
+		DebugQuery:                  vdiffOptions.DebugQuery,
+		OnlyPks:                     vdiffOptions.OnlyPks,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp)
+}
+
 func init() {
+	CancelWorkflow.Flags().BoolVar(&cancelWorkflowOptions.KeepData, "keep-data", false, "Keep the target tables or shards, and only delete the streams of the workflow.")
+	Root.AddCommand(CancelWorkflow)
+
+	CompleteWorkflow.Flags().BoolVar(&completeWorkflowOptions.KeepData, "keep-data", false, "Keep the source tables or shards, and only delete the streams of the workflow.")
+	CompleteWorkflow.Flags().BoolVar(&completeWorkflowOptions.RenameTables, "rename-tables", false, "Rename the source tables of a MoveTables workflow instead of dropping them.")
+	CompleteWorkflow.Flags().BoolVar(&completeWorkflowOptions.DryRun, "dry-run", false, "Only report the actions that would be taken.")
+	Root.AddCommand(CompleteWorkflow)
+
+	CreateLookupVindex.Flags().StringSliceVarP(&createLookupVindexOptions.Cells, "cells", "c", nil, "Source cells to replicate from.")
+	CreateLookupVindex.Flags().StringVar(&createLookupVindexOptions.TabletTypes, "tablet-types", "", "Source tablet types to replicate from (comma-separated).")
+	CreateLookupVindex.Flags().BoolVar(&createLookupVindexOptions.ContinueAfterCopyWithOwner, "continue-after-copy-with-owner", false, "Keep the workflow of an owned vindex running after its copy phase.")
+	Root.AddCommand(CreateLookupVindex)
+
 	GetWorkflows.Flags().BoolVarP(&getWorkflowsOptions.ShowAll, "show-all", "a", false, "Show all workflows instead of just active workflows")
 	Root.AddCommand(GetWorkflows)
+
+	Materialize.Flags().StringSliceVarP(&materializeOptions.Cells, "cells", "c", nil, "Source cells to replicate from. Overrides the cell of the spec.")
+	Materialize.Flags().StringVar(&materializeOptions.TabletTypes, "tablet-types", "", "Source tablet types to replicate from (comma-separated). Overrides the tablet types of the spec.")
+	Root.AddCommand(Materialize)
+
+	MoveTables.Flags().StringVar(&moveTablesOptions.SourceKeyspace, "source", "", "Source keyspace.")
+	MoveTables.Flags().StringSliceVar(&moveTablesOptions.Tables, "tables", nil, "Tables to move.")
+	MoveTables.Flags().BoolVar(&moveTablesOptions.AllTables, "all", false, "Move all the tables of the source keyspace.")
+	MoveTables.Flags().StringSliceVar(&moveTablesOptions.ExcludeTables, "exclude-tables", nil, "Tables not to move when --all is specified.")
+	MoveTables.Flags().StringSliceVarP(&moveTablesOptions.Cells, "cells", "c", nil, "Source cells to replicate from.")
+	MoveTables.Flags().StringVar(&moveTablesOptions.TabletTypes, "tablet-types", "", "Source tablet types to replicate from (comma-separated).")
+	MoveTables.Flags().BoolVar(&moveTablesOptions.AutoStart, "auto-start", true, "Start the streams once they are created. If false, they are created in the Stopped state.")
+	MoveTables.Flags().BoolVar(&moveTablesOptions.StopAfterCopy, "stop-after-copy", false, "Stop the streams once the copy phase is completed.")
+	MoveTables.Flags().StringVar(&moveTablesOptions.ExternalCluster, "external-cluster", "", "Mounted cluster which has the source keyspace.")
+	MoveTables.MarkFlagRequired("source")
+	Root.AddCommand(MoveTables)
+
+	Reshard.Flags().StringSliceVar(&reshardOptions.SourceShards, "source-shards", nil, "Source shards.")
+	Reshard.Flags().StringSliceVar(&reshardOptions.TargetShards, "target-shards", nil, "Target shards.")
+	Reshard.Flags().BoolVar(&reshardOptions.SkipSchemaCopy, "skip-schema-copy", false, "Skip copying the schema to the target shards.")
+	Reshard.Flags().StringSliceVarP(&reshardOptions.Cells, "cells", "c", nil, "Source cells to replicate from.")
+	Reshard.Flags().StringVar(&reshardOptions.TabletTypes, "tablet-types", "", "Source tablet types to replicate from (comma-separated).")
+	Reshard.Flags().BoolVar(&reshardOptions.AutoStart, "auto-start", true, "Start the streams once they are created. If false, they are created in the Stopped state.")
+	Reshard.Flags().BoolVar(&reshardOptions.StopAfterCopy, "stop-after-copy", false, "Stop the streams once the copy phase is completed.")
+	Reshard.Flags().BoolVar(&reshardOptions.SeedFromBackup, "seed-from-backup", false, "Restore the target shards from the latest backup of their source shard instead of copying the rows. Only splits are supported, and the target shards must not have a primary.")
+	Reshard.MarkFlagRequired("source-shards")
+	Reshard.MarkFlagRequired("target-shards")
+	Root.AddCommand(Reshard)
+
+	for _, c := range []struct {
+		cmd     *cobra.Command
+		options *trafficOptions
+	}{
+		{cmd: ReverseTraffic, options: &reverseTrafficOptions},
+		{cmd: SwitchTraffic, options: &switchTrafficOptions},
+	} {
+		c.cmd.Flags().StringSliceVarP(&c.options.Cells, "cells", "c", nil, "Cells to switch the reads in.")
+		c.cmd.Flags().StringVar(&c.options.TabletTypes, "tablet-types", "", "Tablet types to switch the traffic of (comma-separated). Defaults to all of them.")
+		c.cmd.Flags().DurationVar(&c.options.Timeout, "timeout", 30*time.Second, "Time to wait for the streams to catch up when switching the writes.")
+		c.cmd.Flags().BoolVar(&c.options.EnableReverseReplication, "reverse-replication", true, "Create the reverse workflow once the writes are switched.")
+		c.cmd.Flags().BoolVar(&c.options.DryRun, "dry-run", false, "Only report the actions that would be taken.")
+		Root.AddCommand(c.cmd)
+	}
+
+	SwitchTraffic.Flags().StringVar(&switchTrafficScheduleOptions.NotBefore, "not-before", "", "Start of the cutover window, as a timestamp in RFC3339 format.")
+	SwitchTraffic.Flags().StringVar(&switchTrafficScheduleOptions.NotAfter, "not-after", "", "End of the cutover window, as a timestamp in RFC3339 format. The cutover is given up once it is reached.")
+	SwitchTraffic.Flags().DurationVar(&switchTrafficScheduleOptions.RetryInterval, "retry-interval", 0, "Minimum time between two attempts of the cutover.")
+	SwitchTraffic.Flags().Int32Var(&switchTrafficScheduleOptions.MaxAttempts, "max-attempts", 0, "Number of attempts after which the cutover is given up, at most 100. Unlimited if zero. Only the last 100 attempts are recorded.")
+	SwitchTraffic.Flags().DurationVar(&switchTrafficScheduleOptions.MaxReplicationLag, "max-replication-lag", 0, "Only switch the traffic if the vreplication lag of the workflow is at most this long.")
+	SwitchTraffic.Flags().BoolVar(&switchTrafficScheduleOptions.RequireVDiff, "require-vdiff", false, "Only switch the traffic if the last VDiff of the workflow completed less than a day ago without finding differences.")
+	SwitchTraffic.Flags().BoolVar(&switchTrafficScheduleOptions.CheckThrottler, "check-throttler", false, "Only switch the traffic if the tablet throttlers of the target primaries are healthy.")
+
+	VDiff.Flags().StringVar(&vdiffOptions.SourceCell, "source-cell", "", "The source cell to compare from. Defaults to any available cell.")
+	VDiff.Flags().StringVar(&vdiffOptions.TabletTypes, "tablet-types", "primary,replica,rdonly", "Tablet types for the source and the target (comma-separated).")
+	VDiff.Flags().DurationVar(&vdiffOptions.FilteredReplicationWaitTime, "filtered-replication-wait-time", 30*time.Second, "Time to wait for the streams to catch up.")
+	VDiff.Flags().Int64Var(&vdiffOptions.Limit, "limit", 0, "Number of rows to stop comparing after. All the rows are compared if zero.")
+	VDiff.Flags().StringSliceVar(&vdiffOptions.Tables, "tables", nil, "Only compare these tables of the workflow.")
+	VDiff.Flags().BoolVar(&vdiffOptions.DebugQuery, "debug-query", false, "Add a query to the reports that can be used for further debugging.")
+	VDiff.Flags().BoolVar(&vdiffOptions.OnlyPks, "only-pks", false, "Only report the primary keys of the missing rows.")
+	Root.AddCommand(VDiff)
 }
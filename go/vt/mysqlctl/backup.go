@@ -46,6 +46,11 @@ const (
 	backupInnodbLogGroupHomeDir = "InnoDBLog"
 	backupData                  = "Data"
 
+	// the bases for archived binary logs, and for binary logs
+	// downloaded to be replayed during a point-in-time restore
+	backupBinlogDir        = "BinLog"
+	backupRestoreBinlogDir = "RestoreBinLog"
+
 	// backupManifestFileName is the MANIFEST file name within a backup.
 	backupManifestFileName = "MANIFEST"
 	// RestoreState is the name of the sentinel file used to detect whether a previous restore
@@ -112,6 +117,14 @@ func Backup(ctx context.Context, params BackupParams) error {
 	if err != nil {
 		return vterrors.Wrap(err, "StartBackup failed")
 	}
+	ebh, err := encryptBackupHandle(ctx, bh)
+	if err != nil {
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			params.Logger.Errorf2(abortErr, "failed to abort backup %v", name)
+		}
+		return err
+	}
+	bh = ebh
 
 	be, err := GetBackupEngine()
 	if err != nil {
@@ -143,6 +156,23 @@ func Backup(ctx context.Context, params BackupParams) error {
 	return finishErr
 }
 
+// FindChildBackups returns the names of the backups, among the given ones,
+// that are incremental on top of the named backup. A backup with children
+// must not be removed, as they refer to its files.
+func FindChildBackups(ctx context.Context, bhs []backupstorage.BackupHandle, name string) []string {
+	var children []string
+	for _, bh := range bhs {
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil {
+			continue
+		}
+		if bm.ParentBackup == name {
+			children = append(children, bh.Name())
+		}
+	}
+	return children
+}
+
 // ParseBackupName parses the backup name for a given dir/name, according to
 // the format generated by mysqlctl.Backup. An error is returned only if the
 // backup name does not have the expected number of parts; errors parsing the
@@ -317,6 +347,14 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, vterrors.Wrap(err, "Failed to find restore engine")
 	}
 
+	bm, err := GetBackupManifest(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if bh, err = decryptBackupHandle(ctx, bh, bm); err != nil {
+		return nil, err
+	}
+
 	manifest, err := re.ExecuteRestore(ctx, params, bh)
 	if err != nil {
 		return nil, err
@@ -380,6 +418,12 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, err
 	}
 
+	if params.IsPointInTimeRecovery() {
+		if err := restoreToPointInTime(ctx, params, bs, manifest, metadataManager); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = removeStateFile(params.Cnf); err != nil {
 		return nil, err
 	}
@@ -65,6 +65,13 @@ type BackupParams struct {
 	TabletAlias string
 	// BackupTime is the time at which the backup is being started
 	BackupTime time.Time
+	// Incremental asks for a backup that only stores what changed since the
+	// most recent complete backup of the shard, which becomes its parent.
+	// Engines that don't support incremental backups return an error.
+	Incremental bool
+	// CompressionEngine is the name of the compression engine to use, if the
+	// backup is compressed. The -compression_engine flag is used if empty.
+	CompressionEngine string
 }
 
 // RestoreParams is the struct that holds all params passed to ExecuteRestore
@@ -91,6 +98,18 @@ type RestoreParams struct {
 	// StartTime: if non-zero, look for a backup that was taken at or before this time
 	// Otherwise, find the most recent backup
 	StartTime time.Time
+	// RestoreToPos: if non-zero, restore the most recent backup at or before this
+	// position, then replay archived binary logs until it is reached
+	RestoreToPos mysql.Position
+	// RestoreToTimestamp: if non-zero, restore the most recent backup taken at or
+	// before this time, then replay archived binary logs until it is reached
+	RestoreToTimestamp time.Time
+}
+
+// IsPointInTimeRecovery returns true if archived binary logs need to be
+// replayed on top of the restored backup.
+func (p *RestoreParams) IsPointInTimeRecovery() bool {
+	return !p.RestoreToPos.IsZero() || !p.RestoreToTimestamp.IsZero()
 }
 
 // RestoreEngine is the interface to restore a backup with a given engine.
@@ -187,6 +206,26 @@ type BackupManifest struct {
 	// FinishedTime is the time (in RFC 3339 format, UTC) at which the backup finished, if known.
 	// Some backups may not set this field if they were created before the field was added.
 	FinishedTime string
+
+	// ParentBackup is the name of the backup, in the same directory, that this
+	// backup is incremental on top of. It is empty for full backups, which can
+	// be restored on their own.
+	ParentBackup string
+
+	// CompressionEngine is the name of the compression engine the backup
+	// files were compressed with, if they were. It is empty for backups
+	// created before the field existed, which were compressed with pgzip.
+	CompressionEngine string
+
+	// ExternalDecompressor is the command that decompresses the files of a
+	// backup compressed with the external compression engine, if it was known
+	// when the backup was taken. It is only reported in errors: restores run
+	// the -external_decompressor command.
+	ExternalDecompressor string
+
+	// EncryptionKeyID is the ID of the key the backup files were encrypted
+	// with, if they were. The MANIFEST itself is never encrypted.
+	EncryptionKeyID string
 }
 
 // FindBackupToRestore returns a selected candidate backup to be restored.
@@ -197,6 +236,10 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 	var index int
 	// if a StartTime is provided in params, then find a backup that was taken at or before that time
 	checkBackupTime := !params.StartTime.IsZero()
+	// for a point-in-time recovery, the backup must also have been finished
+	// before the restore target
+	checkRestoreTime := !params.RestoreToTimestamp.IsZero()
+	checkRestorePos := !params.RestoreToPos.IsZero()
 	backupDir := GetBackupDir(params.Keyspace, params.Shard)
 
 	for index = len(bhs) - 1; index >= 0; index-- {
@@ -216,8 +259,27 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 				continue
 			}
 		}
+		if checkRestorePos && !params.RestoreToPos.AtLeast(bm.Position) {
+			continue
+		}
+		if checkRestoreTime {
+			finishedTime := bm.FinishedTime
+			if finishedTime == "" {
+				finishedTime = bm.BackupTime
+			}
+			restoreTime, err := time.Parse(time.RFC3339, finishedTime)
+			if err != nil {
+				params.Logger.Warningf("Restore: skipping backup %v/%v with invalid time %v: %v", backupDir, bh.Name(), finishedTime, err)
+				continue
+			}
+			if restoreTime.After(params.RestoreToTimestamp) {
+				continue
+			}
+		}
 		if !checkBackupTime || backupTime.Equal(params.StartTime) || backupTime.Before(params.StartTime) {
-			if !checkBackupTime {
+			if params.IsPointInTimeRecovery() {
+				params.Logger.Infof("Restore: found backup %v %v at position %v to restore before replaying binary logs", bh.Directory(), bh.Name(), bm.Position)
+			} else if !checkBackupTime {
 				params.Logger.Infof("Restore: found latest backup %v %v to restore", bh.Directory(), bh.Name())
 			} else {
 				params.Logger.Infof("Restore: found backup %v %v to restore using the specified timestamp of '%v'", bh.Directory(), bh.Name(), params.StartTime.Format(BackupTimestampFormat))
@@ -229,6 +291,12 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 		if checkBackupTime {
 			params.Logger.Errorf("No valid backup found before time %v", params.StartTime.Format(BackupTimestampFormat))
 		}
+		if checkRestorePos {
+			params.Logger.Errorf("No valid backup found before position %v", params.RestoreToPos)
+		}
+		if checkRestoreTime {
+			params.Logger.Errorf("No valid backup found before time %v", params.RestoreToTimestamp.Format(BackupTimestampFormat))
+		}
 		// There is at least one attempted backup, but none could be read.
 		// This implies there is data we ought to have, so it's not safe to start
 		// up empty.
@@ -0,0 +1,519 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles archiving the binary logs of a primary into the
+// BackupStorage, and replaying them on top of a restored backup for
+// point-in-time recovery.
+
+const (
+	// binlogBackupMethod is the BackupMethod recorded in the MANIFEST of a
+	// binary log archive.
+	binlogBackupMethod = "binlog"
+
+	// restoreBinlogDir is where archived binary logs are downloaded to,
+	// relative to the tablet directory, before being replayed.
+	restoreBinlogDir = "restore_binlogs"
+
+	// binlogEventHeaderLength is the length of a v4 binlog event header.
+	binlogEventHeaderLength = 19
+)
+
+var (
+	// binlogFileMagic is the header of every binary log file.
+	binlogFileMagic = []byte{0xfe, 'b', 'i', 'n'}
+
+	binlogBackupCount     = stats.NewCounter("BinlogBackupFiles", "Number of binary log files archived to the BackupStorage")
+	binlogBackupDuration  = stats.NewGauge("binlog_backup_duration_seconds", "How long it took to complete the last binlog backup operation (in seconds)")
+	binlogReplayFileCount = stats.NewCounter("BinlogReplayFiles", "Number of archived binary log files replayed during point-in-time restores")
+)
+
+// BinlogBackupParams is the struct that holds all params passed to BackupBinlogs
+type BinlogBackupParams struct {
+	Cnf    *Mycnf
+	Mysqld MysqlDaemon
+	Logger logutil.Logger
+	// Extra env variables for the backup transform hook
+	HookExtraEnv map[string]string
+	// Keyspace and Shard are used to infer the directory where archives should be stored
+	Keyspace string
+	Shard    string
+	// TabletAlias is used along with the binlog file name to construct the archive name
+	TabletAlias string
+}
+
+// binlogBackupManifest is the MANIFEST of a binary log archive. Each archive
+// holds a single binary log file of the primary.
+type binlogBackupManifest struct {
+	// BackupManifest is an anonymous embedding of the base manifest struct.
+	// Position is the GTID set executed at the end of the file, BackupTime and
+	// FinishedTime are the timestamps of the first and last event in the file.
+	BackupManifest
+
+	// FromPosition is the GTID set executed before the file was started, as
+	// recorded in its PREVIOUS_GTIDS event.
+	FromPosition mysql.Position
+
+	// FileEntry is the archived binary log file.
+	FileEntry FileEntry
+
+	// TransformHook that was used on the file, if any.
+	TransformHook string
+
+	// SkipCompress is true if the file was NOT compressed.
+	SkipCompress bool
+}
+
+// binlogArchive is a binary log archive found on the BackupStorage.
+type binlogArchive struct {
+	bh       backupstorage.BackupHandle
+	manifest *binlogBackupManifest
+	first    time.Time
+	last     time.Time
+}
+
+// binlogFileInfo is what we learn from scanning a binary log file.
+type binlogFileInfo struct {
+	fromPosition mysql.Position
+	position     mysql.Position
+	first        time.Time
+	last         time.Time
+}
+
+// GetBinlogBackupDir returns the directory where binary log archives for
+// the given keyspace/shard are (or will be) stored. It is kept apart from
+// GetBackupDir so archives are never mistaken for full backups.
+func GetBinlogBackupDir(keyspace, shard string) string {
+	return fmt.Sprintf("binlogs/%v/%v", keyspace, shard)
+}
+
+// BackupBinlogs archives every binary log file of the local mysqld that
+// was closed since the last run, then returns how many files it archived.
+// It flushes the binary logs first, so everything written before the call
+// ends up in the BackupStorage.
+func BackupBinlogs(ctx context.Context, params BinlogBackupParams) (int, error) {
+	startTs := time.Now()
+	if err := validateBackupCompression(BackupParams{}); err != nil {
+		return 0, err
+	}
+	if err := params.Mysqld.ExecuteSuperQueryList(ctx, []string{"FLUSH BINARY LOGS"}); err != nil {
+		return 0, vterrors.Wrap(err, "can't flush binary logs")
+	}
+	qr, err := params.Mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return 0, vterrors.Wrap(err, "can't list binary logs")
+	}
+	if len(qr.Rows) == 0 {
+		return 0, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binary logging is not enabled")
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return 0, vterrors.Wrap(err, "unable to get backup storage")
+	}
+	defer bs.Close()
+
+	dir := GetBinlogBackupDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return 0, vterrors.Wrap(err, "ListBackups failed")
+	}
+	archived := make(map[string]bool, len(bhs))
+	for _, bh := range bhs {
+		archived[bh.Name()] = true
+	}
+
+	// The last file is the one mysqld is currently writing to.
+	count := 0
+	for _, row := range qr.Rows[:len(qr.Rows)-1] {
+		file := row[0].ToString()
+		name := fmt.Sprintf("%v.%v", params.TabletAlias, file)
+		if archived[name] {
+			continue
+		}
+		if err := backupBinlogFile(ctx, params, bs, dir, name, file); err != nil {
+			return count, err
+		}
+		count++
+		binlogBackupCount.Add(1)
+	}
+	binlogBackupDuration.Set(int64(time.Since(startTs).Seconds()))
+	return count, nil
+}
+
+// backupBinlogFile archives a single binary log file under the given name.
+func backupBinlogFile(ctx context.Context, params BinlogBackupParams, bs backupstorage.BackupStorage, dir, name, file string) error {
+	fe := FileEntry{
+		Base: backupBinlogDir,
+		Name: file,
+	}
+	fname, err := fe.fullPath(params.Cnf)
+	if err != nil {
+		return err
+	}
+	info, err := readBinlogFileInfo(fname)
+	if err != nil {
+		return vterrors.Wrapf(err, "can't read binary log %v", fname)
+	}
+
+	params.Logger.Infof("Archiving binary log %v as %v/%v", file, dir, name)
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		return vterrors.Wrap(err, "StartBackup failed")
+	}
+	if err := writeBinlogBackup(ctx, params, bh, &fe, info); err != nil {
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			params.Logger.Errorf2(abortErr, "failed to abort binlog backup %v", name)
+		}
+		return err
+	}
+	return bh.EndBackup(ctx)
+}
+
+// writeBinlogBackup copies the binary log file and writes the MANIFEST.
+func writeBinlogBackup(ctx context.Context, params BinlogBackupParams, bh backupstorage.BackupHandle, fe *FileEntry, info binlogFileInfo) (finalErr error) {
+	bh, err := encryptBackupHandle(ctx, bh)
+	if err != nil {
+		return err
+	}
+	be := &BuiltinBackupEngine{}
+	backupParams := BackupParams{
+		Cnf:          params.Cnf,
+		Mysqld:       params.Mysqld,
+		Logger:       params.Logger,
+		HookExtraEnv: params.HookExtraEnv,
+	}
+	if err := be.backupFile(ctx, backupParams, bh, fe, "0"); err != nil {
+		return err
+	}
+
+	wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to backup", backupManifestFileName)
+	}
+	defer func() {
+		if closeErr := wc.Close(); finalErr == nil {
+			finalErr = closeErr
+		}
+	}()
+
+	bm := &binlogBackupManifest{
+		BackupManifest: BackupManifest{
+			BackupMethod: binlogBackupMethod,
+			Position:     info.position,
+			BackupTime:   info.first.UTC().Format(time.RFC3339),
+			FinishedTime: info.last.UTC().Format(time.RFC3339),
+		},
+		FromPosition:  info.fromPosition,
+		FileEntry:     *fe,
+		TransformHook: *backupStorageHook,
+		SkipCompress:  !*backupStorageCompress,
+	}
+	setBackupCompression(backupParams, &bm.BackupManifest)
+	setBackupEncryption(bh, &bm.BackupManifest)
+	data, err := json.MarshalIndent(bm, "", "  ")
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
+	}
+	if _, err := wc.Write(data); err != nil {
+		return vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+	return nil
+}
+
+// readBinlogFileInfo scans a MySQL 5.6+ binary log file, and returns the
+// GTID sets executed before and after it, and the timestamps of its first
+// and last event.
+func readBinlogFileInfo(name string) (binlogFileInfo, error) {
+	var info binlogFileInfo
+	f, err := os.Open(name)
+	if err != nil {
+		return info, err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+
+	magic := make([]byte, len(binlogFileMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, binlogFileMagic) {
+		return info, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "%v is not a binary log file", name)
+	}
+
+	var format mysql.BinlogFormat
+	header := make([]byte, binlogEventHeaderLength)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return info, err
+		}
+		length := binary.LittleEndian.Uint32(header[9:13])
+		if length < binlogEventHeaderLength {
+			return info, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid event length %v in %v", length, name)
+		}
+		buf := make([]byte, length)
+		copy(buf, header)
+		if _, err := io.ReadFull(r, buf[binlogEventHeaderLength:]); err != nil {
+			return info, vterrors.Wrapf(err, "truncated event in %v", name)
+		}
+
+		ev := mysql.NewMysql56BinlogEvent(buf)
+		if ts := ev.Timestamp(); ts != 0 {
+			t := time.Unix(int64(ts), 0).UTC()
+			if info.first.IsZero() {
+				info.first = t
+			}
+			info.last = t
+		}
+		switch {
+		case ev.IsFormatDescription():
+			if format, err = ev.Format(); err != nil {
+				return info, err
+			}
+		case format.IsZero():
+			return info, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "%v does not start with a FORMAT_DESCRIPTION_EVENT", name)
+		case ev.IsPreviousGTIDs():
+			if ev, _, err = ev.StripChecksum(format); err != nil {
+				return info, err
+			}
+			if info.fromPosition, err = ev.PreviousGTIDs(format); err != nil {
+				return info, err
+			}
+			info.position = info.fromPosition
+		case ev.IsGTID():
+			if info.fromPosition.IsZero() {
+				return info, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "%v has a GTID event before its PREVIOUS_GTIDS_EVENT", name)
+			}
+			if ev, _, err = ev.StripChecksum(format); err != nil {
+				return info, err
+			}
+			gtid, _, err := ev.GTID(format)
+			if err != nil {
+				return info, err
+			}
+			info.position = mysql.AppendGTID(info.position, gtid)
+		}
+	}
+	if info.fromPosition.IsZero() {
+		return info, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "%v has no PREVIOUS_GTIDS_EVENT, binlog backups require MySQL GTID mode", name)
+	}
+	return info, nil
+}
+
+// listBinlogArchives returns all the complete binary log archives for a
+// shard, sorted by the time of their first event.
+func listBinlogArchives(ctx context.Context, params RestoreParams, bs backupstorage.BackupStorage) ([]*binlogArchive, error) {
+	dir := GetBinlogBackupDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	var archives []*binlogArchive
+	for _, bh := range bhs {
+		var bm binlogBackupManifest
+		if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+			params.Logger.Warningf("Possibly incomplete binlog backup %v in directory %v on BackupStorage: can't read MANIFEST: %v)", bh.Name(), dir, err)
+			continue
+		}
+		archive := &binlogArchive{bh: bh, manifest: &bm}
+		if archive.first, err = time.Parse(time.RFC3339, bm.BackupTime); err != nil {
+			params.Logger.Warningf("Restore: skipping binlog backup %v/%v with invalid time %v: %v", dir, bh.Name(), bm.BackupTime, err)
+			continue
+		}
+		if archive.last, err = time.Parse(time.RFC3339, bm.FinishedTime); err != nil {
+			params.Logger.Warningf("Restore: skipping binlog backup %v/%v with invalid time %v: %v", dir, bh.Name(), bm.FinishedTime, err)
+			continue
+		}
+		archives = append(archives, archive)
+	}
+	sort.SliceStable(archives, func(i, j int) bool {
+		return archives[i].first.Before(archives[j].first)
+	})
+	return archives, nil
+}
+
+// findBinlogsToReplay returns the chain of archives that brings a server at
+// position pos to the restore target of params. Archives may overlap, for
+// instance after a reparent, but there must be no gap in the GTID sets.
+func findBinlogsToReplay(params RestoreParams, pos mysql.Position, archives []*binlogArchive) ([]*binlogArchive, error) {
+	if pos.IsZero() {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the restored backup has no replication position")
+	}
+	var chain []*binlogArchive
+	used := make(map[*binlogArchive]bool)
+	for {
+		if !params.RestoreToPos.IsZero() && pos.AtLeast(params.RestoreToPos) {
+			return chain, nil
+		}
+		if !params.RestoreToTimestamp.IsZero() && len(chain) > 0 && !chain[len(chain)-1].last.Before(params.RestoreToTimestamp) {
+			return chain, nil
+		}
+
+		var next *binlogArchive
+		for _, archive := range archives {
+			if used[archive] {
+				continue
+			}
+			if pos.AtLeast(archive.manifest.FromPosition) && !pos.AtLeast(archive.manifest.Position) {
+				next = archive
+				break
+			}
+		}
+		if next == nil {
+			if !params.RestoreToPos.IsZero() {
+				return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "archived binary logs only reach position %v, cannot restore to %v", pos, params.RestoreToPos)
+			}
+			if len(chain) > 0 {
+				return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "archived binary logs only reach %v, cannot restore to %v", chain[len(chain)-1].manifest.FinishedTime, params.RestoreToTimestamp.UTC().Format(time.RFC3339))
+			}
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no archived binary logs follow position %v, cannot restore to %v", pos, params.RestoreToTimestamp.UTC().Format(time.RFC3339))
+		}
+		used[next] = true
+		chain = append(chain, next)
+		pos = mysql.Position{GTIDSet: pos.GTIDSet.Union(next.manifest.Position.GTIDSet)}
+	}
+}
+
+// replayBinlogs applies archived binary logs on top of a restored backup at
+// position pos, up to the restore target of params. mysqld must be running.
+// It returns the position that was reached.
+func replayBinlogs(ctx context.Context, params RestoreParams, bs backupstorage.BackupStorage, pos mysql.Position) (mysql.Position, error) {
+	archives, err := listBinlogArchives(ctx, params, bs)
+	if err != nil {
+		return pos, err
+	}
+	chain, err := findBinlogsToReplay(params, pos, archives)
+	if err != nil {
+		return pos, err
+	}
+	params.Logger.Infof("Restore: replaying %v archived binary logs on top of position %v", len(chain), pos)
+
+	tmpDir := path.Join(params.Cnf.TabletDir(), restoreBinlogDir)
+	if err := os.MkdirAll(tmpDir, os.ModePerm); err != nil {
+		return pos, vterrors.Wrapf(err, "cannot create directory %v", tmpDir)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Already executed transactions are skipped by mysqld, so we only need to
+	// tell it where to stop. The timestamp is inclusive, while mysqlbinlog
+	// stops at the first event at or after --stop-datetime.
+	var stopTime time.Time
+	if !params.RestoreToTimestamp.IsZero() {
+		stopTime = params.RestoreToTimestamp.Truncate(time.Second).Add(time.Second)
+	}
+	be := &BuiltinBackupEngine{}
+	for _, archive := range chain {
+		bm := archive.manifest
+		fe := FileEntry{
+			Base: backupRestoreBinlogDir,
+			Name: filepath.Base(bm.FileEntry.Name),
+			Hash: bm.FileEntry.Hash,
+		}
+		params.Logger.Infof("Restore: downloading binary log %v/%v", archive.bh.Directory(), archive.bh.Name())
+		compression, err := restoreCompressionEngine(bm.SkipCompress, &bm.BackupManifest)
+		if err != nil {
+			return pos, err
+		}
+		bh, err := decryptBackupHandle(ctx, archive.bh, &bm.BackupManifest)
+		if err != nil {
+			return pos, err
+		}
+		if err := be.restoreFile(ctx, params, bh, &fe, bm.TransformHook, compression, "0"); err != nil {
+			return pos, vterrors.Wrapf(err, "can't restore binary log %v", archive.bh.Name())
+		}
+		file := path.Join(tmpDir, fe.Name)
+		params.Logger.Infof("Restore: applying binary log %v", file)
+		if err := params.Mysqld.ApplyBinlogFile(ctx, file, params.RestoreToPos, stopTime); err != nil {
+			return pos, vterrors.Wrapf(err, "can't apply binary log %v", archive.bh.Name())
+		}
+		if err := os.Remove(file); err != nil {
+			return pos, err
+		}
+		binlogReplayFileCount.Add(1)
+	}
+
+	reached, err := params.Mysqld.PrimaryPosition()
+	if err != nil {
+		return pos, vterrors.Wrap(err, "can't get position after replaying binary logs")
+	}
+	if !params.RestoreToPos.IsZero() && !reached.AtLeast(params.RestoreToPos) {
+		return reached, vterrors.Errorf(vtrpc.Code_INTERNAL, "replaying binary logs reached position %v, expected %v", reached, params.RestoreToPos)
+	}
+	params.Logger.Infof("Restore: binary logs replayed up to position %v", reached)
+	return reached, nil
+}
+
+// restoreToPointInTime replays archived binary logs on top of the backup
+// that was just restored, and records the position that was reached in
+// the manifest and in the local metadata.
+func restoreToPointInTime(ctx context.Context, params RestoreParams, bs backupstorage.BackupStorage, manifest *BackupManifest, metadataManager *MetadataManager) error {
+	params.Logger.Infof("Restore: disabling super_read_only to replay binary logs")
+	if err := params.Mysqld.SetSuperReadOnly(false); err != nil {
+		if strings.Contains(err.Error(), Error1193) {
+			params.Logger.Warningf("Restore: server does not know about super_read_only; maybe MariaDB? Continuing anyway.")
+		} else {
+			return err
+		}
+	}
+
+	// mysqld skips transactions that are already in gtid_executed, so the
+	// archives can safely overlap with the restored backup.
+	if err := params.Mysqld.ResetReplication(ctx); err != nil {
+		return vterrors.Wrap(err, "can't reset replication before replaying binary logs")
+	}
+	if err := params.Mysqld.SetReplicationPosition(ctx, manifest.Position); err != nil {
+		return vterrors.Wrap(err, "can't set replication position before replaying binary logs")
+	}
+
+	pos, err := replayBinlogs(ctx, params, bs, manifest.Position)
+	if err != nil {
+		return err
+	}
+	if err := params.Mysqld.SetReadOnly(true); err != nil {
+		return err
+	}
+
+	params.LocalMetadata["RestorePosition"] = mysql.EncodePosition(pos)
+	if err := metadataManager.UpsertLocalMetadata(params.Mysqld, map[string]string{"RestorePosition": params.LocalMetadata["RestorePosition"]}, params.DbName); err != nil {
+		return err
+	}
+	manifest.Position = pos
+	return nil
+}
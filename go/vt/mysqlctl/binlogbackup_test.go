@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"encoding/binary"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+)
+
+const testSID = "00010203-0405-0607-0809-0a0b0c0d0e0f"
+
+func mustDecodePosition(t *testing.T, s string) mysql.Position {
+	t.Helper()
+	pos, err := mysql.DecodePosition(s)
+	require.NoError(t, err)
+	return pos
+}
+
+func eventBytes(ev mysql.BinlogEvent) []byte {
+	return ev.(interface{ Bytes() []byte }).Bytes()
+}
+
+// writeTestBinlog writes a binary log file holding the given GTIDs of
+// testSID, one second apart, after the given previous GTIDs.
+func writeTestBinlog(t *testing.T, name string, previous string, start time.Time, gnos ...int64) {
+	t.Helper()
+	f := mysql.NewMySQL56BinlogFormat()
+	s := mysql.NewFakeBinlogStream()
+	s.Timestamp = uint32(start.Unix())
+
+	prev, err := mysql.ParsePosition(mysql.Mysql56FlavorID, previous)
+	require.NoError(t, err)
+	sid, err := mysql.ParseSID(testSID)
+	require.NoError(t, err)
+
+	data := []byte{0xfe, 'b', 'i', 'n'}
+	data = append(data, eventBytes(mysql.NewFormatDescriptionEvent(f, s))...)
+	data = append(data, s.Packetize(f, 35 /* PREVIOUS_GTIDS_EVENT */, 0, prev.GTIDSet.(mysql.Mysql56GTIDSet).SIDBlock())...)
+	for _, gno := range gnos {
+		gtid := make([]byte, 1+16+8)
+		copy(gtid[1:], sid[:])
+		binary.LittleEndian.PutUint64(gtid[17:], uint64(gno))
+		data = append(data, s.Packetize(f, 33 /* GTID_EVENT */, 0, gtid)...)
+		data = append(data, eventBytes(mysql.NewXIDEvent(f, s))...)
+		s.Timestamp++
+	}
+	require.NoError(t, os.WriteFile(name, data, 0644))
+}
+
+func TestReadBinlogFileInfo(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Date(2021, 11, 1, 10, 0, 0, 0, time.UTC)
+
+	name := path.Join(dir, "vt-0000000100-bin.000002")
+	writeTestBinlog(t, name, testSID+":1-5", start, 6, 7, 8)
+	info, err := readBinlogFileInfo(name)
+	require.NoError(t, err)
+	assert.True(t, info.fromPosition.Equal(mustDecodePosition(t, "MySQL56/"+testSID+":1-5")), "got %v", info.fromPosition)
+	assert.True(t, info.position.Equal(mustDecodePosition(t, "MySQL56/"+testSID+":1-8")), "got %v", info.position)
+	assert.Equal(t, start, info.first)
+	assert.Equal(t, start.Add(2*time.Second), info.last)
+
+	// The first binary log of a server has no previous GTIDs.
+	name = path.Join(dir, "vt-0000000100-bin.000001")
+	writeTestBinlog(t, name, "", start, 1, 2)
+	info, err = readBinlogFileInfo(name)
+	require.NoError(t, err)
+	assert.True(t, info.position.Equal(mustDecodePosition(t, "MySQL56/"+testSID+":1-2")), "got %v", info.position)
+
+	// A file that's not a binary log.
+	name = path.Join(dir, "garbage")
+	require.NoError(t, os.WriteFile(name, []byte("not a binlog"), 0644))
+	_, err = readBinlogFileInfo(name)
+	assert.Error(t, err)
+
+	// A truncated binary log.
+	name = path.Join(dir, "vt-0000000100-bin.000003")
+	writeTestBinlog(t, name, testSID+":1-8", start, 9)
+	data, err := os.ReadFile(name)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(name, data[:len(data)-5], 0644))
+	_, err = readBinlogFileInfo(name)
+	assert.Error(t, err)
+}
+
+func TestFindBinlogsToReplay(t *testing.T) {
+	start := time.Date(2021, 11, 1, 10, 0, 0, 0, time.UTC)
+	archive := func(from, to string, first, last int) *binlogArchive {
+		return &binlogArchive{
+			manifest: &binlogBackupManifest{
+				BackupManifest: BackupManifest{
+					Position: mustDecodePosition(t, "MySQL56/"+testSID+":"+to),
+				},
+				FromPosition: mustDecodePosition(t, "MySQL56/"+testSID+":"+from),
+			},
+			first: start.Add(time.Duration(first) * time.Minute),
+			last:  start.Add(time.Duration(last) * time.Minute),
+		}
+	}
+	a := archive("1-10", "1-20", 0, 10)
+	b := archive("1-20", "1-30", 10, 20)
+	c := archive("1-30", "1-40", 20, 30)
+	// d overlaps with b and c, as an archive of another primary would.
+	d := archive("1-25", "1-35", 15, 25)
+
+	tests := []struct {
+		name     string
+		pos      string
+		params   RestoreParams
+		archives []*binlogArchive
+		want     []*binlogArchive
+		wantErr  bool
+	}{
+		{
+			name:     "to position",
+			pos:      "1-15",
+			params:   RestoreParams{RestoreToPos: mustDecodePosition(t, "MySQL56/"+testSID+":1-25")},
+			archives: []*binlogArchive{a, b, c},
+			want:     []*binlogArchive{a, b},
+		},
+		{
+			name:     "position already reached",
+			pos:      "1-15",
+			params:   RestoreParams{RestoreToPos: mustDecodePosition(t, "MySQL56/"+testSID+":1-12")},
+			archives: []*binlogArchive{a, b, c},
+			want:     nil,
+		},
+		{
+			name:     "to timestamp",
+			pos:      "1-15",
+			params:   RestoreParams{RestoreToTimestamp: start.Add(15 * time.Minute)},
+			archives: []*binlogArchive{a, b, c},
+			want:     []*binlogArchive{a, b},
+		},
+		{
+			name:     "overlapping archives",
+			pos:      "1-20",
+			params:   RestoreParams{RestoreToPos: mustDecodePosition(t, "MySQL56/"+testSID+":1-40")},
+			archives: []*binlogArchive{a, b, d, c},
+			want:     []*binlogArchive{b, d, c},
+		},
+		{
+			name:     "gap",
+			pos:      "1-15",
+			params:   RestoreParams{RestoreToPos: mustDecodePosition(t, "MySQL56/"+testSID+":1-35")},
+			archives: []*binlogArchive{a, c},
+			wantErr:  true,
+		},
+		{
+			name:     "position not archived yet",
+			pos:      "1-15",
+			params:   RestoreParams{RestoreToPos: mustDecodePosition(t, "MySQL56/"+testSID+":1-50")},
+			archives: []*binlogArchive{a, b, c},
+			wantErr:  true,
+		},
+		{
+			name:     "timestamp not archived yet",
+			pos:      "1-15",
+			params:   RestoreParams{RestoreToTimestamp: start.Add(time.Hour)},
+			archives: []*binlogArchive{a, b, c},
+			wantErr:  true,
+		},
+		{
+			name:     "backup older than archives",
+			pos:      "1-5",
+			params:   RestoreParams{RestoreToPos: mustDecodePosition(t, "MySQL56/"+testSID+":1-25")},
+			archives: []*binlogArchive{a, b, c},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findBinlogsToReplay(tt.params, mustDecodePosition(t, "MySQL56/"+testSID+":"+tt.pos), tt.archives)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
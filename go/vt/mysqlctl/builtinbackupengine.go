@@ -19,6 +19,7 @@ package mysqlctl
 import (
 	"bufio"
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"flag"
@@ -32,9 +33,6 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/klauspost/pgzip"
-	"github.com/planetscale/pargzip"
-
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -84,10 +82,11 @@ type builtinBackupManifest struct {
 	// TransformHook that was used on the files, if any.
 	TransformHook string
 
-	// SkipCompress is true if the backup files were NOT run through gzip.
+	// SkipCompress is true if the backup files were NOT compressed.
 	// The field is expressed as a negative because it will come through as
 	// false for backups that were created before the field existed, and those
-	// backups all had compression enabled.
+	// backups all had compression enabled. BackupManifest.CompressionEngine
+	// tells how the files were compressed otherwise.
 	SkipCompress bool
 }
 
@@ -97,6 +96,8 @@ type FileEntry struct {
 	// - backupInnodbDataHomeDir for files that go into Mycnf.InnodbDataHomeDir
 	// - backupInnodbLogGroupHomeDir for files that go into Mycnf.InnodbLogGroupHomeDir
 	// - backupData for files that go into Mycnf.DataDir
+	// - backupBinlogDir for archived binary logs, in the directory of Mycnf.BinLogPath
+	// - backupRestoreBinlogDir for binary logs downloaded to be replayed
 	Base string
 
 	// Name is the file name, relative to Base
@@ -105,26 +106,111 @@ type FileEntry struct {
 	// Hash is the hash of the final data (transformed and
 	// compressed if specified) stored in the BackupStorage.
 	Hash string
+
+	// Size and SourceHash describe the original contents of the file.
+	// Incremental backups use them to find the files that didn't change
+	// since the parent backup.
+	Size       int64
+	SourceHash string
+
+	// ParentBackup is set when the file didn't change since an earlier
+	// backup in the same directory. Its data is then stored in that backup,
+	// as ParentName, rather than in this one.
+	ParentBackup string
+	ParentName   string
+}
+
+// parentBackup is a backup that an incremental backup refers to.
+type parentBackup struct {
+	bh       backupstorage.BackupHandle
+	manifest builtinBackupManifest
+
+	// files maps the Base and Name of each file entry to its index in
+	// manifest.FileEntries.
+	files map[string]int
+}
+
+func newParentBackup(bh backupstorage.BackupHandle, manifest builtinBackupManifest) *parentBackup {
+	pb := &parentBackup{
+		bh:       bh,
+		manifest: manifest,
+		files:    make(map[string]int, len(manifest.FileEntries)),
+	}
+	for i, fe := range manifest.FileEntries {
+		pb.files[path.Join(fe.Base, fe.Name)] = i
+	}
+	return pb
+}
+
+// reuseFile checks whether the given file is unchanged since the parent
+// backup. If so, it points the file entry to the data already stored in
+// the parent, or in the backup the parent itself refers to.
+func (pb *parentBackup) reuseFile(cnf *Mycnf, fe *FileEntry) (bool, error) {
+	i, ok := pb.files[path.Join(fe.Base, fe.Name)]
+	if !ok {
+		return false, nil
+	}
+	pfe := &pb.manifest.FileEntries[i]
+	if pfe.SourceHash == "" {
+		return false, nil
+	}
+
+	name, err := fe.fullPath(cnf)
+	if err != nil {
+		return false, err
+	}
+	fi, err := os.Stat(name)
+	if err != nil {
+		return false, err
+	}
+	if fi.Size() != pfe.Size {
+		return false, nil
+	}
+	hash, err := hashSourceFile(name)
+	if err != nil {
+		return false, err
+	}
+	if hash != pfe.SourceHash {
+		return false, nil
+	}
+
+	fe.Size = pfe.Size
+	fe.SourceHash = pfe.SourceHash
+	fe.Hash = pfe.Hash
+	if pfe.ParentBackup != "" {
+		fe.ParentBackup = pfe.ParentBackup
+		fe.ParentName = pfe.ParentName
+	} else {
+		fe.ParentBackup = pb.bh.Name()
+		fe.ParentName = fmt.Sprintf("%v", i)
+	}
+	return true, nil
+}
+
+// hashSourceFile returns the hash of the contents of a local file, as
+// recorded in FileEntry.SourceHash.
+func hashSourceFile(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", vterrors.Wrapf(err, "cannot open source file %v", name)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", vterrors.Wrapf(err, "cannot read source file %v", name)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
 func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
-	// find the root to use
-	var root string
-	switch fe.Base {
-	case backupInnodbDataHomeDir:
-		root = cnf.InnodbDataHomeDir
-	case backupInnodbLogGroupHomeDir:
-		root = cnf.InnodbLogGroupHomeDir
-	case backupData:
-		root = cnf.DataDir
-	default:
-		return nil, vterrors.Errorf(vtrpc.Code_UNKNOWN, "unknown base: %v", fe.Base)
+	name, err := fe.fullPath(cnf)
+	if err != nil {
+		return nil, err
 	}
 
 	// and open the file
-	name := path.Join(root, fe.Name)
 	var fd *os.File
-	var err error
 	if readOnly {
 		if fd, err = os.Open(name); err != nil {
 			return nil, vterrors.Wrapf(err, "cannot open source file %v", name)
@@ -141,11 +227,34 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 	return fd, nil
 }
 
+// fullPath returns the local path of the file, based on its root.
+func (fe *FileEntry) fullPath(cnf *Mycnf) (string, error) {
+	var root string
+	switch fe.Base {
+	case backupInnodbDataHomeDir:
+		root = cnf.InnodbDataHomeDir
+	case backupInnodbLogGroupHomeDir:
+		root = cnf.InnodbLogGroupHomeDir
+	case backupData:
+		root = cnf.DataDir
+	case backupBinlogDir:
+		root = path.Dir(cnf.BinLogPath)
+	case backupRestoreBinlogDir:
+		root = path.Join(cnf.TabletDir(), restoreBinlogDir)
+	default:
+		return "", vterrors.Errorf(vtrpc.Code_UNKNOWN, "unknown base: %v", fe.Base)
+	}
+	return path.Join(root, fe.Name), nil
+}
+
 // ExecuteBackup returns a boolean that indicates if the backup is usable,
 // and an overall error.
 func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
 
-	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
+	params.Logger.Infof("Hook: %v, Compress: %v, Compression engine: %v", *backupStorageHook, *backupStorageCompress, backupCompressionEngine(params))
+	if err := validateBackupCompression(params); err != nil {
+		return false, err
+	}
 
 	// Save initial state so we can restore.
 	replicaStartRequired := false
@@ -293,6 +402,21 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}
 	params.Logger.Infof("found %v files to backup", len(fes))
 
+	// For an incremental backup, find the parent backup that unchanged files
+	// will refer to.
+	var parent *parentBackup
+	if params.Incremental {
+		if parent, err = findParentBackup(ctx, params, bh); err != nil {
+			return err
+		}
+	}
+	var parentName string
+	var reused sync2.AtomicInt64
+	if parent != nil {
+		parentName = parent.bh.Name()
+		params.Logger.Infof("taking an incremental backup on top of %v", parentName)
+	}
+
 	// Backup with the provided concurrency.
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	wg := sync.WaitGroup{}
@@ -309,6 +433,19 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 				return
 			}
 
+			// Skip the file if the parent backup already has it.
+			if parent != nil {
+				unchanged, err := parent.reuseFile(params.Cnf, &fes[i])
+				if err != nil {
+					bh.RecordError(err)
+					return
+				}
+				if unchanged {
+					reused.Add(1)
+					return
+				}
+			}
+
 			// Backup the individual file.
 			name := fmt.Sprintf("%v", i)
 			bh.RecordError(be.backupFile(ctx, params, bh, &fes[i], name))
@@ -316,6 +453,9 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}
 
 	wg.Wait()
+	if parent != nil {
+		params.Logger.Infof("%v of %v files are unchanged since %v", reused.Get(), len(fes), parentName)
+	}
 
 	// BackupHandle supports the ErrorRecorder interface for tracking errors
 	// across any goroutines that fan out to take the backup. This means that we
@@ -348,6 +488,7 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 			Position:     replicationPosition,
 			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
 			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+			ParentBackup: parentName,
 		},
 
 		// Builtin-specific fields
@@ -355,6 +496,8 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 		TransformHook: *backupStorageHook,
 		SkipCompress:  !*backupStorageCompress,
 	}
+	setBackupCompression(params, &bm.BackupManifest)
+	setBackupEncryption(bh, &bm.BackupManifest)
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
 		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
@@ -497,27 +640,32 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		writer = pipe
 	}
 
-	// Create the gzip compression pipe, if necessary.
-	var gzip *pargzip.Writer
-	if *backupStorageCompress {
-		gzip = pargzip.NewWriter(writer)
-		gzip.ChunkSize = *backupCompressBlockSize
-		gzip.Parallel = *backupCompressBlocks
-		gzip.CompressionLevel = pargzip.BestSpeed
-		writer = gzip
+	// Create the compression pipe, if necessary.
+	var compressor io.WriteCloser
+	if engine := backupCompressionEngine(params); engine != "" {
+		ce, err := getCompressionEngine(engine)
+		if err != nil {
+			return err
+		}
+		if compressor, err = ce.NewCompressor(ctx, writer, params.Logger); err != nil {
+			return err
+		}
+		writer = compressor
 	}
 
-	// Copy from the source file to writer (optional gzip,
-	// optional pipe, tee, output file and hasher).
-	_, err = io.Copy(writer, source)
+	// Copy from the source file to writer (optional compression,
+	// optional pipe, tee, output file and hasher), and hash the
+	// original contents on the way.
+	sourceHash := sha256.New()
+	size, err := io.Copy(writer, io.TeeReader(source, sourceHash))
 	if err != nil {
 		return vterrors.Wrap(err, "cannot copy data")
 	}
 
-	// Close gzip to flush it, after that all data is sent to writer.
-	if gzip != nil {
-		if err = gzip.Close(); err != nil {
-			return vterrors.Wrap(err, "cannot close gzip")
+	// Close the compressor to flush it, after that all data is sent to writer.
+	if compressor != nil {
+		if err = compressor.Close(); err != nil {
+			return vterrors.Wrap(err, "cannot close compressor")
 		}
 	}
 
@@ -540,11 +688,94 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		return vterrors.Wrapf(err, "cannot flush destination: %v", name)
 	}
 
-	// Save the hash.
+	// Save the hashes.
 	fe.Hash = bw.HashString()
+	fe.Size = size
+	fe.SourceHash = hex.EncodeToString(sourceHash.Sum(nil))
 	return nil
 }
 
+// findParentBackup returns the most recent complete builtin backup of the
+// shard, for an incremental backup to be taken on top of. It returns nil if
+// there is no such backup, in which case a full backup is taken.
+func findParentBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (*parentBackup, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "unable to get backup storage")
+	}
+	defer bs.Close()
+
+	backupDir := GetBackupDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	for i := len(bhs) - 1; i >= 0; i-- {
+		if bhs[i].Name() == bh.Name() {
+			continue
+		}
+		var bm builtinBackupManifest
+		if err := getBackupManifestInto(ctx, bhs[i], &bm); err != nil {
+			// The backup is incomplete, or still in progress.
+			continue
+		}
+		if bm.BackupMethod != "" && bm.BackupMethod != builtinBackupEngineName {
+			continue
+		}
+		pb := newParentBackup(bhs[i], bm)
+		for _, fe := range bm.FileEntries {
+			if fe.SourceHash != "" {
+				return pb, nil
+			}
+		}
+		// Backups taken before file contents were hashed can't be compared
+		// with, so a new full backup is needed.
+		params.Logger.Warningf("latest backup %v/%v has no file hashes, taking a full backup", backupDir, bhs[i].Name())
+		return nil, nil
+	}
+	params.Logger.Warningf("no complete builtin backup found in %v, taking a full backup", backupDir)
+	return nil, nil
+}
+
+// loadParentBackups returns the backups that hold the data of the files an
+// incremental backup didn't store itself, indexed by name. It fails if any
+// of them can't be read, which is checked before any local data is removed.
+func loadParentBackups(ctx context.Context, bh backupstorage.BackupHandle, bm *builtinBackupManifest, bs backupstorage.BackupStorage) (map[string]*parentBackup, error) {
+	parents := make(map[string]*parentBackup)
+	for _, fe := range bm.FileEntries {
+		if fe.ParentBackup != "" {
+			parents[fe.ParentBackup] = nil
+		}
+	}
+	if len(parents) == 0 {
+		return parents, nil
+	}
+
+	bhs, err := bs.ListBackups(ctx, bh.Directory())
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	for _, pbh := range bhs {
+		if _, ok := parents[pbh.Name()]; !ok {
+			continue
+		}
+		var pbm builtinBackupManifest
+		if err := getBackupManifestInto(ctx, pbh, &pbm); err != nil {
+			return nil, vterrors.Wrapf(err, "can't read parent backup %v of %v", pbh.Name(), bh.Name())
+		}
+		if pbh, err = decryptBackupHandle(ctx, pbh, &pbm.BackupManifest); err != nil {
+			return nil, err
+		}
+		parents[pbh.Name()] = &parentBackup{bh: pbh, manifest: pbm}
+	}
+	for name, pb := range parents {
+		if pb == nil {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "parent backup %v of %v not found in %v", name, bh.Name(), bh.Directory())
+		}
+	}
+	return parents, nil
+}
+
 // ExecuteRestore restores from a backup. If the restore is successful
 // we return the position from which replication should start
 // otherwise an error is returned
@@ -556,6 +787,20 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 		return nil, err
 	}
 
+	// An incremental backup also needs the backups it refers to.
+	var parents map[string]*parentBackup
+	if bm.ParentBackup != "" {
+		bs, err := backupstorage.GetBackupStorage()
+		if err != nil {
+			return nil, vterrors.Wrap(err, "unable to get backup storage")
+		}
+		defer bs.Close()
+		if parents, err = loadParentBackups(ctx, bh, &bm, bs); err != nil {
+			return nil, err
+		}
+		params.Logger.Infof("Restore: backup %v is incremental on top of %v, reading unchanged files from %v backup(s)", bh.Name(), bm.ParentBackup, len(parents))
+	}
+
 	// mark restore as in progress
 	if err := createStateFile(params.Cnf); err != nil {
 		return nil, err
@@ -567,7 +812,7 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 
 	params.Logger.Infof("Restore: copying %v files", len(bm.FileEntries))
 
-	if err := be.restoreFiles(context.Background(), params, bh, bm); err != nil {
+	if err := be.restoreFiles(context.Background(), params, bh, bm, parents); err != nil {
 		// don't delete the file here because that is how we detect an interrupted restore
 		return nil, vterrors.Wrap(err, "failed to restore files")
 	}
@@ -577,8 +822,9 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 }
 
 // restoreFiles will copy all the files from the BackupStorage to the
-// right place.
-func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, bm builtinBackupManifest) error {
+// right place. Files that an incremental backup didn't store are copied
+// from the parent backups they refer to.
+func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, bm builtinBackupManifest, parents map[string]*parentBackup) error {
 	fes := bm.FileEntries
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
@@ -597,9 +843,17 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 			}
 
 			// And restore the file.
-			name := fmt.Sprintf("%v", i)
-			params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
-			err := be.restoreFile(ctx, params, bh, &fes[i], bm.TransformHook, !bm.SkipCompress, name)
+			source, name, manifest := bh, fmt.Sprintf("%v", i), &bm
+			if pb := parents[fes[i].ParentBackup]; pb != nil {
+				source, name, manifest = pb.bh, fes[i].ParentName, &pb.manifest
+				params.Logger.Infof("Copying file %v from %v: %v", name, pb.bh.Name(), fes[i].Name)
+			} else {
+				params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
+			}
+			compression, err := restoreCompressionEngine(manifest.SkipCompress, &manifest.BackupManifest)
+			if err == nil {
+				err = be.restoreFile(ctx, params, source, &fes[i], manifest.TransformHook, compression, name)
+			}
 			if err != nil {
 				rec.RecordError(vterrors.Wrapf(err, "can't restore file %v to %v", name, fes[i].Name))
 			}
@@ -610,7 +864,7 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 }
 
 // restoreFile restores an individual file.
-func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string) (finalErr error) {
+func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compression CompressionEngine, name string) (finalErr error) {
 	// Open the source file for reading.
 	source, err := bh.ReadFile(ctx, name)
 	if err != nil {
@@ -652,22 +906,22 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	}
 
 	// Create the uncompresser if needed.
-	if compress {
-		gz, err := pgzip.NewReader(reader)
+	if compression != nil {
+		decompressor, err := compression.NewDecompressor(ctx, reader, params.Logger)
 		if err != nil {
-			return vterrors.Wrap(err, "can't open gzip decompressor")
+			return err
 		}
 		defer func() {
-			if cerr := gz.Close(); cerr != nil {
+			if cerr := decompressor.Close(); cerr != nil {
 				if finalErr != nil {
 					// We already have an error, just log this one.
-					log.Errorf("failed to close gzip decompressor %v: %v", name, cerr)
+					log.Errorf("failed to close decompressor %v: %v", name, cerr)
 				} else {
-					finalErr = vterrors.Wrap(err, "failed to close gzip decompressor")
+					finalErr = vterrors.Wrap(cerr, "failed to close decompressor")
 				}
 			}
 		}()
-		reader = gz
+		reader = decompressor
 	}
 
 	// Copy the data. Will also write to the hasher.
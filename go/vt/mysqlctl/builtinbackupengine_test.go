@@ -6,6 +6,7 @@ package mysqlctl_test
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 	"path"
 	"testing"
@@ -17,6 +18,7 @@ import (
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
 	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
 	"vitess.io/vitess/go/vt/proto/topodata"
@@ -134,3 +136,145 @@ func TestExecuteBackup(t *testing.T) {
 	assert.Error(t, err)
 	assert.False(t, ok)
 }
+
+func TestExecuteIncrementalBackup(t *testing.T) {
+	root := t.TempDir()
+	oldRoot, oldImplementation := *filebackupstorage.FileBackupStorageRoot, *backupstorage.BackupStorageImplementation
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	*backupstorage.BackupStorageImplementation = "file"
+	defer func() {
+		*filebackupstorage.FileBackupStorageRoot = oldRoot
+		*backupstorage.BackupStorageImplementation = oldImplementation
+	}()
+
+	ctx := context.Background()
+	keyspace, shard := "mykeyspace", "-"
+	backupDir := mysqlctl.GetBackupDir(keyspace, shard)
+	cnf := &mysqlctl.Mycnf{
+		InnodbDataHomeDir:     path.Join(root, "innodb"),
+		InnodbLogGroupHomeDir: path.Join(root, "log"),
+		DataDir:               path.Join(root, "datadir"),
+	}
+	require.NoError(t, createBackupDir(root, "innodb", "log", "datadir/vt_db"))
+	writeFile := func(name, contents string) {
+		require.NoError(t, os.WriteFile(path.Join(root, name), []byte(contents), 0644))
+	}
+	writeFile("innodb/ibdata1", "ibdata1 v1")
+	writeFile("log/ib_logfile0", "ib_logfile0 v1")
+	writeFile("datadir/vt_db/t1.ibd", "t1 v1")
+	writeFile("datadir/vt_db/t2.ibd", "t2 v1")
+
+	bs, err := backupstorage.GetBackupStorage()
+	require.NoError(t, err)
+	defer bs.Close()
+
+	be := &mysqlctl.BuiltinBackupEngine{}
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(fakesqldb.New(t))
+	mysqld.ExpectedExecuteSuperQueryList = []string{"STOP SLAVE", "STOP SLAVE", "STOP SLAVE"}
+	backup := func(name string, incremental bool) {
+		bh, err := bs.StartBackup(ctx, backupDir, name)
+		require.NoError(t, err)
+		ok, err := be.ExecuteBackup(ctx, mysqlctl.BackupParams{
+			Logger:       logutil.NewConsoleLogger(),
+			Mysqld:       mysqld,
+			Cnf:          cnf,
+			Concurrency:  2,
+			HookExtraEnv: map[string]string{},
+			Keyspace:     keyspace,
+			Shard:        shard,
+			Incremental:  incremental,
+		}, bh)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+	type manifest struct {
+		ParentBackup string
+		FileEntries  []mysqlctl.FileEntry
+	}
+	readManifest := func(name string) (m manifest) {
+		data, err := os.ReadFile(path.Join(root, "backups", backupDir, name, "MANIFEST"))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &m))
+		return m
+	}
+	parents := func(m manifest) map[string]string {
+		result := make(map[string]string)
+		for _, fe := range m.FileEntries {
+			result[fe.Name] = fe.ParentBackup
+		}
+		return result
+	}
+
+	// Without a parent, an incremental backup is a full one.
+	backup("backup1", true)
+	m := readManifest("backup1")
+	assert.Equal(t, "", m.ParentBackup)
+	assert.Equal(t, map[string]string{"ibdata1": "", "ib_logfile0": "", "vt_db/t1.ibd": "", "vt_db/t2.ibd": ""}, parents(m))
+
+	writeFile("datadir/vt_db/t2.ibd", "t2 v2")
+	writeFile("datadir/vt_db/t3.ibd", "t3 v1")
+	backup("backup2", true)
+	m = readManifest("backup2")
+	assert.Equal(t, "backup1", m.ParentBackup)
+	assert.Equal(t, map[string]string{"ibdata1": "backup1", "ib_logfile0": "backup1", "vt_db/t1.ibd": "backup1", "vt_db/t2.ibd": "", "vt_db/t3.ibd": ""}, parents(m))
+
+	// Unchanged files refer to the backup that holds their data, not just
+	// to the parent.
+	writeFile("innodb/ibdata1", "ibdata1 v2")
+	backup("backup3", true)
+	m = readManifest("backup3")
+	assert.Equal(t, "backup2", m.ParentBackup)
+	assert.Equal(t, map[string]string{"ibdata1": "", "ib_logfile0": "backup1", "vt_db/t1.ibd": "backup1", "vt_db/t2.ibd": "backup2", "vt_db/t3.ibd": "backup2"}, parents(m))
+	files, err := os.ReadDir(path.Join(root, "backups", backupDir, "backup3"))
+	require.NoError(t, err)
+	assert.Len(t, files, 2, "backup3 should only hold ibdata1 and its MANIFEST")
+
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	require.NoError(t, err)
+	require.Len(t, bhs, 3)
+	assert.Equal(t, []string{"backup2"}, mysqlctl.FindChildBackups(ctx, bhs, "backup1"))
+	assert.Equal(t, []string{"backup3"}, mysqlctl.FindChildBackups(ctx, bhs, "backup2"))
+	assert.Empty(t, mysqlctl.FindChildBackups(ctx, bhs, "backup3"))
+
+	// Restoring the last backup reassembles the files from the whole chain.
+	restoreRoot := path.Join(root, "restore")
+	require.NoError(t, createBackupDir(restoreRoot, "datadir"))
+	restoreCnf := &mysqlctl.Mycnf{
+		InnodbDataHomeDir:     path.Join(restoreRoot, "innodb"),
+		InnodbLogGroupHomeDir: path.Join(restoreRoot, "log"),
+		DataDir:               path.Join(restoreRoot, "datadir"),
+		BinLogPath:            path.Join(restoreRoot, "bin-logs/bin"),
+		RelayLogPath:          path.Join(restoreRoot, "relay-logs/relay"),
+		RelayLogIndexPath:     path.Join(restoreRoot, "relay-logs/relay.index"),
+		RelayLogInfoPath:      path.Join(restoreRoot, "relay-logs/relay.info"),
+	}
+	restoreParams := mysqlctl.RestoreParams{
+		Cnf:          restoreCnf,
+		Mysqld:       mysqld,
+		Logger:       logutil.NewConsoleLogger(),
+		Concurrency:  2,
+		HookExtraEnv: map[string]string{},
+		Keyspace:     keyspace,
+		Shard:        shard,
+	}
+	_, err = be.ExecuteRestore(ctx, restoreParams, bhs[2])
+	require.NoError(t, err)
+	for name, want := range map[string]string{
+		"innodb/ibdata1":       "ibdata1 v2",
+		"log/ib_logfile0":      "ib_logfile0 v1",
+		"datadir/vt_db/t1.ibd": "t1 v1",
+		"datadir/vt_db/t2.ibd": "t2 v2",
+		"datadir/vt_db/t3.ibd": "t3 v1",
+	} {
+		got, err := os.ReadFile(path.Join(restoreRoot, name))
+		require.NoError(t, err)
+		assert.Equal(t, want, string(got), name)
+	}
+
+	// Without its parents, an incremental backup can't be restored.
+	require.NoError(t, bs.RemoveBackup(ctx, backupDir, "backup1"))
+	_, err = be.ExecuteRestore(ctx, restoreParams, bhs[2])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "parent backup backup1 of backup3 not found")
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+	"github.com/planetscale/pargzip"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles the compression engines that backup files are
+// compressed with.
+
+const (
+	// PgzipCompressor compresses with parallel gzip. It is the default, and
+	// the one used by backups that predate the other engines.
+	PgzipCompressor = "pgzip"
+	// ZstdCompressor compresses with zstd.
+	ZstdCompressor = "zstd"
+	// Lz4Compressor compresses with the lz4 command line tool, which must be
+	// installed on the hosts taking and restoring the backups.
+	Lz4Compressor = "lz4"
+	// ExternalCompressor compresses with the commands given by the
+	// -external_compressor and -external_decompressor flags.
+	ExternalCompressor = "external"
+)
+
+var (
+	// compressionEngineName is the compression engine new backups use, if
+	// they are compressed at all. Restores use the engine recorded in the
+	// MANIFEST of the backup.
+	compressionEngineName = flag.String("compression_engine", PgzipCompressor, "if backup_storage_compress is true, the compression engine for new backups: pgzip, zstd, lz4 or external. Restores use the engine recorded in the backup MANIFEST.")
+
+	// compressionLevel is the level new backups are compressed at. Level 1
+	// favors speed over size with all engines.
+	compressionLevel = flag.Int("compression_level", 1, "the compression level for new backups. Its range depends on the compression engine, 1 favors speed with all of them.")
+
+	externalCompressorCmd   = flag.String("external_compressor", "", "with the external compression engine, the command, with its space-separated arguments, that compresses its standard input to its standard output")
+	externalDecompressorCmd = flag.String("external_decompressor", "", "with the external compression engine, the command, with its space-separated arguments, that decompresses its standard input to its standard output. Restores of backups taken with the external compression engine require it.")
+)
+
+// CompressionEngine compresses and decompresses the files of a backup.
+type CompressionEngine interface {
+	// NewCompressor returns a writer that compresses its data into w.
+	// Closing it flushes the compressed data, but doesn't close w.
+	NewCompressor(ctx context.Context, w io.Writer, logger logutil.Logger) (io.WriteCloser, error)
+
+	// NewDecompressor returns a reader of the decompressed data of r.
+	NewDecompressor(ctx context.Context, r io.Reader, logger logutil.Logger) (io.ReadCloser, error)
+}
+
+// CompressionEngineMap contains the registered compression engines, by the
+// name recorded in backup manifests.
+var CompressionEngineMap = make(map[string]CompressionEngine)
+
+// getCompressionEngine returns the named compression engine.
+func getCompressionEngine(name string) (CompressionEngine, error) {
+	ce, ok := CompressionEngineMap[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "unknown compression engine %q", name)
+	}
+	return ce, nil
+}
+
+// backupCompressionEngine returns the name of the compression engine that
+// new backup files are compressed with, or "" if they aren't compressed.
+func backupCompressionEngine(params BackupParams) string {
+	if !*backupStorageCompress {
+		return ""
+	}
+	if params.CompressionEngine != "" {
+		return params.CompressionEngine
+	}
+	return *compressionEngineName
+}
+
+// validateBackupCompression checks that new backup files can be compressed
+// as requested, before anything is done to take the backup.
+func validateBackupCompression(params BackupParams) error {
+	name := backupCompressionEngine(params)
+	if name == "" {
+		return nil
+	}
+	if _, err := getCompressionEngine(name); err != nil {
+		return err
+	}
+	if name == ExternalCompressor && *externalCompressorCmd == "" {
+		return vterrors.New(vtrpc.Code_FAILED_PRECONDITION, "the external compression engine requires -external_compressor")
+	}
+	return nil
+}
+
+// setBackupCompression records in a backup manifest how its files are
+// compressed.
+func setBackupCompression(params BackupParams, bm *BackupManifest) {
+	bm.CompressionEngine = backupCompressionEngine(params)
+	if bm.CompressionEngine == ExternalCompressor {
+		bm.ExternalDecompressor = *externalDecompressorCmd
+	}
+}
+
+// restoreCompressionEngine returns the compression engine that the files
+// of a backup were compressed with, or nil if they aren't compressed.
+func restoreCompressionEngine(skipCompress bool, bm *BackupManifest) (CompressionEngine, error) {
+	if skipCompress {
+		return nil, nil
+	}
+	switch bm.CompressionEngine {
+	case "":
+		// The backup predates the other compression engines.
+		return getCompressionEngine(PgzipCompressor)
+	case ExternalCompressor:
+		// The command recorded in the MANIFEST is only a hint for the
+		// operator: running commands read from the backup storage would let
+		// whoever can write there run anything on the restoring host.
+		if *externalDecompressorCmd == "" {
+			if bm.ExternalDecompressor != "" {
+				return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the backup was compressed with an external compressor, restoring it requires -external_decompressor (the backup MANIFEST suggests %q)", bm.ExternalDecompressor)
+			}
+			return nil, vterrors.New(vtrpc.Code_FAILED_PRECONDITION, "the backup was compressed with an external compressor, restoring it requires -external_decompressor")
+		}
+		return getCompressionEngine(ExternalCompressor)
+	default:
+		return getCompressionEngine(bm.CompressionEngine)
+	}
+}
+
+// pgzipCompressionEngine compresses with parallel gzip.
+type pgzipCompressionEngine struct{}
+
+// NewCompressor is part of the CompressionEngine interface.
+func (pgzipCompressionEngine) NewCompressor(ctx context.Context, w io.Writer, logger logutil.Logger) (io.WriteCloser, error) {
+	gzip := pargzip.NewWriter(w)
+	gzip.ChunkSize = *backupCompressBlockSize
+	gzip.Parallel = *backupCompressBlocks
+	gzip.CompressionLevel = *compressionLevel
+	return gzip, nil
+}
+
+// NewDecompressor is part of the CompressionEngine interface.
+func (pgzipCompressionEngine) NewDecompressor(ctx context.Context, r io.Reader, logger logutil.Logger) (io.ReadCloser, error) {
+	gz, err := pgzip.NewReader(r)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't open gzip decompressor")
+	}
+	return gz, nil
+}
+
+// zstdCompressionEngine compresses with zstd.
+type zstdCompressionEngine struct{}
+
+// NewCompressor is part of the CompressionEngine interface.
+func (zstdCompressionEngine) NewCompressor(ctx context.Context, w io.Writer, logger logutil.Logger) (io.WriteCloser, error) {
+	zw, err := zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(*compressionLevel)))
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't open zstd compressor")
+	}
+	return zw, nil
+}
+
+// NewDecompressor is part of the CompressionEngine interface.
+func (zstdCompressionEngine) NewDecompressor(ctx context.Context, r io.Reader, logger logutil.Logger) (io.ReadCloser, error) {
+	zr, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't open zstd decompressor")
+	}
+	return zr.IOReadCloser(), nil
+}
+
+// commandCompressionEngine compresses and decompresses by piping the data
+// through external commands. The commands are looked up when used, so they
+// can depend on flags.
+type commandCompressionEngine struct {
+	compressCmd   func() string
+	decompressCmd func() string
+}
+
+// NewCompressor is part of the CompressionEngine interface.
+func (ce *commandCompressionEngine) NewCompressor(ctx context.Context, w io.Writer, logger logutil.Logger) (io.WriteCloser, error) {
+	if ce.compressCmd == nil {
+		return nil, vterrors.New(vtrpc.Code_FAILED_PRECONDITION, "no compression command")
+	}
+	cmd, wg, err := newCompressionCommand(ctx, ce.compressCmd(), logger)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdout = w
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create stdin pipe")
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "can't start %v", cmd.Path)
+	}
+	return &commandWriter{WriteCloser: stdin, cmd: cmd, wg: wg}, nil
+}
+
+// NewDecompressor is part of the CompressionEngine interface.
+func (ce *commandCompressionEngine) NewDecompressor(ctx context.Context, r io.Reader, logger logutil.Logger) (io.ReadCloser, error) {
+	if ce.decompressCmd == nil {
+		return nil, vterrors.New(vtrpc.Code_FAILED_PRECONDITION, "no decompression command")
+	}
+	cmd, wg, err := newCompressionCommand(ctx, ce.decompressCmd(), logger)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdin = r
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create stdout pipe")
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "can't start %v", cmd.Path)
+	}
+	return &commandReader{ReadCloser: stdout, cmd: cmd, wg: wg}, nil
+}
+
+// newCompressionCommand prepares a command line to run, logging its
+// standard error.
+func newCompressionCommand(ctx context.Context, cmdLine string, logger logutil.Logger) (*exec.Cmd, *sync.WaitGroup, error) {
+	args := strings.Fields(cmdLine)
+	if len(args) == 0 {
+		return nil, nil, vterrors.New(vtrpc.Code_FAILED_PRECONDITION, "empty compression command")
+	}
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "cannot create stderr pipe")
+	}
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go scanLinesToLogger(args[0]+" stderr", stderr, logger, wg.Done)
+	return cmd, wg, nil
+}
+
+// commandWriter writes to the standard input of a compression command.
+type commandWriter struct {
+	io.WriteCloser
+	cmd *exec.Cmd
+	wg  *sync.WaitGroup
+}
+
+// Close closes the standard input of the command, and waits for it to
+// write out the rest of its output.
+func (cw *commandWriter) Close() error {
+	if err := cw.WriteCloser.Close(); err != nil {
+		return vterrors.Wrap(err, "cannot close stdin pipe")
+	}
+	cw.wg.Wait()
+	if err := cw.cmd.Wait(); err != nil {
+		return vterrors.Wrapf(err, "%v failed", cw.cmd.Path)
+	}
+	return nil
+}
+
+// commandReader reads the standard output of a decompression command.
+type commandReader struct {
+	io.ReadCloser
+	cmd *exec.Cmd
+	wg  *sync.WaitGroup
+}
+
+// Close stops reading the output of the command, and waits for it to exit.
+func (cr *commandReader) Close() error {
+	if err := cr.ReadCloser.Close(); err != nil {
+		return vterrors.Wrap(err, "cannot close stdout pipe")
+	}
+	cr.wg.Wait()
+	if err := cr.cmd.Wait(); err != nil {
+		return vterrors.Wrapf(err, "%v failed", cr.cmd.Path)
+	}
+	return nil
+}
+
+func init() {
+	CompressionEngineMap[PgzipCompressor] = pgzipCompressionEngine{}
+	CompressionEngineMap[ZstdCompressor] = zstdCompressionEngine{}
+	CompressionEngineMap[Lz4Compressor] = &commandCompressionEngine{
+		compressCmd:   func() string { return fmt.Sprintf("lz4 -c -q -%d", *compressionLevel) },
+		decompressCmd: func() string { return "lz4 -d -c -q" },
+	}
+	CompressionEngineMap[ExternalCompressor] = &commandCompressionEngine{
+		compressCmd:   func() string { return *externalCompressorCmd },
+		decompressCmd: func() string { return *externalDecompressorCmd },
+	}
+}
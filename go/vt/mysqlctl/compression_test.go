@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+func TestCompressionEngines(t *testing.T) {
+	defer func(compressor, decompressor string) {
+		*externalCompressorCmd = compressor
+		*externalDecompressorCmd = decompressor
+	}(*externalCompressorCmd, *externalDecompressorCmd)
+	*externalCompressorCmd = "gzip -c"
+	*externalDecompressorCmd = "gzip -dc"
+
+	var data bytes.Buffer
+	for i := 0; i < 10000; i++ {
+		fmt.Fprintf(&data, "row %d of the test data\n", i)
+	}
+
+	ctx := context.Background()
+	logger := logutil.NewMemoryLogger()
+	for _, name := range []string{PgzipCompressor, ZstdCompressor, Lz4Compressor, ExternalCompressor} {
+		t.Run(name, func(t *testing.T) {
+			switch name {
+			case Lz4Compressor:
+				if _, err := exec.LookPath("lz4"); err != nil {
+					t.Skip("lz4 is not installed")
+				}
+			case ExternalCompressor:
+				if _, err := exec.LookPath("gzip"); err != nil {
+					t.Skip("gzip is not installed")
+				}
+			}
+			ce, err := getCompressionEngine(name)
+			require.NoError(t, err)
+
+			var compressed bytes.Buffer
+			compressor, err := ce.NewCompressor(ctx, &compressed, logger)
+			require.NoError(t, err)
+			_, err = io.Copy(compressor, bytes.NewReader(data.Bytes()))
+			require.NoError(t, err)
+			require.NoError(t, compressor.Close())
+			assert.Less(t, compressed.Len(), data.Len())
+
+			decompressor, err := ce.NewDecompressor(ctx, &compressed, logger)
+			require.NoError(t, err)
+			got, err := io.ReadAll(decompressor)
+			require.NoError(t, err)
+			require.NoError(t, decompressor.Close())
+			assert.Equal(t, data.Bytes(), got)
+		})
+	}
+}
+
+func TestRestoreCompressionEngine(t *testing.T) {
+	defer func(decompressor string) {
+		*externalDecompressorCmd = decompressor
+	}(*externalDecompressorCmd)
+	*externalDecompressorCmd = ""
+
+	// Uncompressed backups.
+	ce, err := restoreCompressionEngine(true, &BackupManifest{CompressionEngine: ZstdCompressor})
+	require.NoError(t, err)
+	assert.Nil(t, ce)
+
+	// Backups that predate the compression engines were compressed with pgzip.
+	ce, err = restoreCompressionEngine(false, &BackupManifest{})
+	require.NoError(t, err)
+	assert.Equal(t, CompressionEngineMap[PgzipCompressor], ce)
+
+	ce, err = restoreCompressionEngine(false, &BackupManifest{CompressionEngine: ZstdCompressor})
+	require.NoError(t, err)
+	assert.Equal(t, CompressionEngineMap[ZstdCompressor], ce)
+
+	_, err = restoreCompressionEngine(false, &BackupManifest{CompressionEngine: "unknown"})
+	assert.Error(t, err)
+
+	// The command recorded in the MANIFEST is never run, the restore
+	// requires -external_decompressor.
+	_, err = restoreCompressionEngine(false, &BackupManifest{CompressionEngine: ExternalCompressor, ExternalDecompressor: "gzip -dc"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires -external_decompressor")
+	assert.Equal(t, vtrpc.Code_FAILED_PRECONDITION, vterrors.Code(err))
+	_, err = restoreCompressionEngine(false, &BackupManifest{CompressionEngine: ExternalCompressor})
+	assert.Error(t, err)
+
+	*externalDecompressorCmd = "zcat"
+	ce, err = restoreCompressionEngine(false, &BackupManifest{CompressionEngine: ExternalCompressor, ExternalDecompressor: "gzip -dc"})
+	require.NoError(t, err)
+	assert.Equal(t, "zcat", ce.(*commandCompressionEngine).decompressCmd())
+}
+
+func TestValidateBackupCompression(t *testing.T) {
+	defer func(compress bool, compressor string) {
+		*backupStorageCompress = compress
+		*externalCompressorCmd = compressor
+	}(*backupStorageCompress, *externalCompressorCmd)
+	*backupStorageCompress = true
+	*externalCompressorCmd = ""
+
+	assert.NoError(t, validateBackupCompression(BackupParams{}))
+	assert.NoError(t, validateBackupCompression(BackupParams{CompressionEngine: ZstdCompressor}))
+	assert.Error(t, validateBackupCompression(BackupParams{CompressionEngine: "unknown"}))
+	assert.Error(t, validateBackupCompression(BackupParams{CompressionEngine: ExternalCompressor}))
+
+	*externalCompressorCmd = "gzip -c"
+	assert.NoError(t, validateBackupCompression(BackupParams{CompressionEngine: ExternalCompressor}))
+
+	// The engine doesn't matter when backups aren't compressed.
+	*backupStorageCompress = false
+	assert.NoError(t, validateBackupCompression(BackupParams{CompressionEngine: "unknown"}))
+	assert.Equal(t, "", backupCompressionEngine(BackupParams{CompressionEngine: ZstdCompressor}))
+}
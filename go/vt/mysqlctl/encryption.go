@@ -0,0 +1,330 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"flag"
+	"io"
+
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles the client-side encryption of backup files.
+//
+// Each file but the MANIFEST is encrypted with AES-GCM, in chunks, so it
+// can be streamed. An encrypted file starts with a magic string and a
+// random salt. The salt derives the key of the file from the backup
+// encryption key, so nonces never repeat across files. The file is then a
+// sequence of sealed chunks of encryptionChunkSize bytes of plaintext, the
+// last one possibly shorter. The nonce of each chunk is its index, and
+// whether it is the last chunk, so chunks cannot be reordered, and a
+// truncated file cannot be decrypted.
+
+const (
+	encryptionMagic     = "VTBKENC1"
+	encryptionSaltSize  = 32
+	encryptionChunkSize = 64 * 1024
+)
+
+var (
+	// backupEncryptionKeyProvider is the provider of the encryption keys.
+	// Backups are not encrypted if it is empty.
+	backupEncryptionKeyProvider = flag.String("backup_encryption_key_provider", "", "if set, new backups are encrypted with a key of this provider: file or vault. Restores of encrypted backups need it as well.")
+
+	// backupEncryptionKeyID is the ID of the key new backups are encrypted
+	// with. Restores use the key ID recorded in the MANIFEST of the backup,
+	// so keys can be rotated.
+	backupEncryptionKeyID = flag.String("backup_encryption_key_id", "", "the ID of the key new backups are encrypted with. Restores use the key ID recorded in the backup MANIFEST.")
+)
+
+// encryptBackupHandle wraps a new backup so its files are encrypted, if
+// backup encryption is enabled.
+func encryptBackupHandle(ctx context.Context, bh backupstorage.BackupHandle) (backupstorage.BackupHandle, error) {
+	if *backupEncryptionKeyProvider == "" {
+		return bh, nil
+	}
+	if *backupEncryptionKeyID == "" {
+		return nil, vterrors.New(vtrpc.Code_FAILED_PRECONDITION, "-backup_encryption_key_provider requires -backup_encryption_key_id")
+	}
+	key, err := getEncryptionKey(ctx, *backupEncryptionKeyID)
+	if err != nil {
+		return nil, err
+	}
+	return newEncryptedBackupHandle(bh, *backupEncryptionKeyID, key)
+}
+
+// decryptBackupHandle wraps an existing backup so its files are decrypted,
+// if its MANIFEST says it is encrypted.
+func decryptBackupHandle(ctx context.Context, bh backupstorage.BackupHandle, bm *BackupManifest) (backupstorage.BackupHandle, error) {
+	if bm.EncryptionKeyID == "" {
+		return bh, nil
+	}
+	if *backupEncryptionKeyProvider == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup %v is encrypted with key %v, but -backup_encryption_key_provider is not set", bh.Name(), bm.EncryptionKeyID)
+	}
+	key, err := getEncryptionKey(ctx, bm.EncryptionKeyID)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "can't get the key of backup %v", bh.Name())
+	}
+	return newEncryptedBackupHandle(bh, bm.EncryptionKeyID, key)
+}
+
+// setBackupEncryption records in a backup manifest the key its files are
+// encrypted with.
+func setBackupEncryption(bh backupstorage.BackupHandle, bm *BackupManifest) {
+	if ebh, ok := bh.(*encryptedBackupHandle); ok {
+		bm.EncryptionKeyID = ebh.keyID
+	}
+}
+
+// encryptedBackupHandle encrypts the files written to a backup, and
+// decrypts the files read from it. The MANIFEST is left in the clear, so
+// the key ID can be read from it.
+type encryptedBackupHandle struct {
+	backupstorage.BackupHandle
+	keyID string
+	key   []byte
+}
+
+func newEncryptedBackupHandle(bh backupstorage.BackupHandle, keyID string, key []byte) (*encryptedBackupHandle, error) {
+	if _, err := aes.NewCipher(key); err != nil {
+		return nil, vterrors.Wrapf(err, "invalid encryption key %v", keyID)
+	}
+	return &encryptedBackupHandle{BackupHandle: bh, keyID: keyID, key: key}, nil
+}
+
+// AddFile is part of the BackupHandle interface.
+func (ebh *encryptedBackupHandle) AddFile(ctx context.Context, filename string, filesize int64) (io.WriteCloser, error) {
+	if filename == backupManifestFileName {
+		return ebh.BackupHandle.AddFile(ctx, filename, filesize)
+	}
+	if filesize != backupstorage.FileSizeUnknown {
+		chunks := filesize/encryptionChunkSize + 1
+		filesize += int64(len(encryptionMagic)) + encryptionSaltSize + chunks*16
+	}
+	wc, err := ebh.BackupHandle.AddFile(ctx, filename, filesize)
+	if err != nil {
+		return nil, err
+	}
+	ew, err := newEncryptingWriter(wc, ebh.key)
+	if err != nil {
+		wc.Close()
+		return nil, err
+	}
+	return ew, nil
+}
+
+// ReadFile is part of the BackupHandle interface.
+func (ebh *encryptedBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	rc, err := ebh.BackupHandle.ReadFile(ctx, filename)
+	if err != nil || filename == backupManifestFileName {
+		return rc, err
+	}
+	dr, err := newDecryptingReader(rc, ebh.key)
+	if err != nil {
+		rc.Close()
+		return nil, vterrors.Wrapf(err, "can't decrypt %v", filename)
+	}
+	return dr, nil
+}
+
+// newFileAEAD returns the cipher of a file, from the backup key and the
+// salt of the file.
+func newFileAEAD(key, salt []byte) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(salt)
+	block, err := aes.NewCipher(mac.Sum(nil)[:len(key)])
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't create cipher")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't create cipher")
+	}
+	return aead, nil
+}
+
+// chunkNonce returns the nonce of the chunk at the given index.
+func chunkNonce(nonce []byte, index uint64, last bool) []byte {
+	for i := range nonce {
+		nonce[i] = 0
+	}
+	binary.BigEndian.PutUint64(nonce[len(nonce)-9:], index)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// encryptingWriter encrypts a file as it is written.
+type encryptingWriter struct {
+	wc     io.WriteCloser
+	aead   cipher.AEAD
+	nonce  []byte
+	index  uint64
+	chunk  []byte
+	sealed []byte
+}
+
+func newEncryptingWriter(wc io.WriteCloser, key []byte) (*encryptingWriter, error) {
+	header := make([]byte, len(encryptionMagic)+encryptionSaltSize)
+	copy(header, encryptionMagic)
+	if _, err := rand.Read(header[len(encryptionMagic):]); err != nil {
+		return nil, vterrors.Wrap(err, "can't generate salt")
+	}
+	aead, err := newFileAEAD(key, header[len(encryptionMagic):])
+	if err != nil {
+		return nil, err
+	}
+	if _, err := wc.Write(header); err != nil {
+		return nil, err
+	}
+	return &encryptingWriter{
+		wc:    wc,
+		aead:  aead,
+		nonce: make([]byte, aead.NonceSize()),
+		chunk: make([]byte, 0, encryptionChunkSize),
+	}, nil
+}
+
+// Write is part of the io.Writer interface.
+func (ew *encryptingWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		// Only seal a full chunk once we know it's not the last one.
+		if len(ew.chunk) == encryptionChunkSize {
+			if err := ew.seal(false); err != nil {
+				return written, err
+			}
+		}
+		n := encryptionChunkSize - len(ew.chunk)
+		if n > len(p) {
+			n = len(p)
+		}
+		ew.chunk = append(ew.chunk, p[:n]...)
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+func (ew *encryptingWriter) seal(last bool) error {
+	ew.sealed = ew.aead.Seal(ew.sealed[:0], chunkNonce(ew.nonce, ew.index, last), ew.chunk, nil)
+	ew.index++
+	ew.chunk = ew.chunk[:0]
+	_, err := ew.wc.Write(ew.sealed)
+	return err
+}
+
+// Close seals the last chunk, and closes the underlying file.
+func (ew *encryptingWriter) Close() error {
+	err := ew.seal(true)
+	if cerr := ew.wc.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// decryptingReader decrypts a file as it is read.
+type decryptingReader struct {
+	rc     io.ReadCloser
+	r      *bufio.Reader
+	aead   cipher.AEAD
+	nonce  []byte
+	index  uint64
+	sealed []byte
+	chunk  []byte
+	done   bool
+}
+
+func newDecryptingReader(rc io.ReadCloser, key []byte) (*decryptingReader, error) {
+	r := bufio.NewReader(rc)
+	header := make([]byte, len(encryptionMagic)+encryptionSaltSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, vterrors.Wrap(err, "can't read encryption header")
+	}
+	if string(header[:len(encryptionMagic)]) != encryptionMagic {
+		return nil, vterrors.New(vtrpc.Code_DATA_LOSS, "file is not encrypted")
+	}
+	aead, err := newFileAEAD(key, header[len(encryptionMagic):])
+	if err != nil {
+		return nil, err
+	}
+	return &decryptingReader{
+		rc:     rc,
+		r:      r,
+		aead:   aead,
+		nonce:  make([]byte, aead.NonceSize()),
+		sealed: make([]byte, encryptionChunkSize+aead.Overhead()),
+	}, nil
+}
+
+// Read is part of the io.Reader interface.
+func (dr *decryptingReader) Read(p []byte) (int, error) {
+	for len(dr.chunk) == 0 {
+		if dr.done {
+			return 0, io.EOF
+		}
+		if err := dr.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, dr.chunk)
+	dr.chunk = dr.chunk[n:]
+	return n, nil
+}
+
+func (dr *decryptingReader) open() error {
+	n, err := io.ReadFull(dr.r, dr.sealed)
+	switch err {
+	case nil:
+		// The chunk is the last one if nothing follows it.
+		if _, err := dr.r.Peek(1); err == io.EOF {
+			dr.done = true
+		} else if err != nil {
+			return err
+		}
+	case io.ErrUnexpectedEOF:
+		dr.done = true
+	case io.EOF:
+		return vterrors.New(vtrpc.Code_DATA_LOSS, "encrypted file is truncated")
+	default:
+		return err
+	}
+	chunk, err := dr.aead.Open(dr.sealed[:0], chunkNonce(dr.nonce, dr.index, dr.done), dr.sealed[:n], nil)
+	if err != nil {
+		return vterrors.New(vtrpc.Code_DATA_LOSS, "can't decrypt file: wrong key, or corrupted file")
+	}
+	dr.index++
+	dr.chunk = chunk
+	return nil
+}
+
+// Close is part of the io.Closer interface.
+func (dr *decryptingReader) Close() error {
+	return dr.rc.Close()
+}
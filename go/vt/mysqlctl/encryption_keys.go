@@ -0,0 +1,206 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	vaultapi "github.com/aquarapid/vaultlib"
+
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles the providers of the keys that backups are encrypted
+// with. Both providers store the keys as a JSON object, that maps key IDs
+// to base64-encoded AES keys of 16, 24 or 32 bytes.
+
+var (
+	// 'file' implementation flags
+	backupEncryptionKeyFile = flag.String("backup_encryption_key_file", "", "with the file key provider, the JSON file that maps key IDs to base64-encoded AES keys of 16, 24 or 32 bytes")
+
+	// 'vault' implementation flags
+	backupEncryptionVaultAddr             = flag.String("backup_encryption_vault_addr", "", "URL to Vault server")
+	backupEncryptionVaultTimeout          = flag.Duration("backup_encryption_vault_timeout", 10*time.Second, "Timeout for vault API operations")
+	backupEncryptionVaultCACert           = flag.String("backup_encryption_vault_tls_ca", "", "Path to CA PEM for validating Vault server certificate")
+	backupEncryptionVaultPath             = flag.String("backup_encryption_vault_path", "", "Vault path to the JSON blob that maps key IDs to base64-encoded AES keys, e.g.: secret/data/prod/backupkeys")
+	backupEncryptionVaultTokenFile        = flag.String("backup_encryption_vault_tokenfile", "", "Path to file containing Vault auth token; token can also be passed using VAULT_TOKEN environment variable")
+	backupEncryptionVaultRoleID           = flag.String("backup_encryption_vault_roleid", "", "Vault AppRole id; can also be passed using VAULT_ROLEID environment variable")
+	backupEncryptionVaultRoleSecretIDFile = flag.String("backup_encryption_vault_role_secretidfile", "", "Path to file containing Vault AppRole secret_id; can also be passed using VAULT_SECRETID environment variable")
+	backupEncryptionVaultRoleMountPoint   = flag.String("backup_encryption_vault_role_mountpoint", "approle", "Vault AppRole mountpoint; can also be passed using VAULT_MOUNTPOINT environment variable")
+)
+
+// KeyProvider is the interface for a provider of backup encryption keys.
+type KeyProvider interface {
+	// GetKey returns the key with the given ID.
+	// Note this call needs to be thread safe.
+	GetKey(ctx context.Context, keyID string) ([]byte, error)
+}
+
+// KeyProviderMap contains the registered KeyProvider implementations, by
+// the name given to -backup_encryption_key_provider.
+var KeyProviderMap = make(map[string]KeyProvider)
+
+// getEncryptionKey returns the key with the given ID, from the configured
+// KeyProvider.
+func getEncryptionKey(ctx context.Context, keyID string) ([]byte, error) {
+	kp, ok := KeyProviderMap[*backupEncryptionKeyProvider]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown backup encryption key provider %q", *backupEncryptionKeyProvider)
+	}
+	return kp.GetKey(ctx, keyID)
+}
+
+// parseEncryptionKey returns the key with the given ID, out of a JSON
+// object of base64-encoded keys.
+func parseEncryptionKey(data []byte, keyID string) ([]byte, error) {
+	keys := make(map[string]string)
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, vterrors.Wrap(err, "can't parse encryption keys")
+	}
+	encoded, ok := keys[keyID]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "unknown encryption key %v", keyID)
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "can't decode encryption key %v", keyID)
+	}
+	switch len(key) {
+	case 16, 24, 32:
+	default:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "encryption key %v is %v bytes long, not 16, 24 or 32", keyID, len(key))
+	}
+	return key, nil
+}
+
+// FileKeyProvider is a KeyProvider that reads the keys from the file
+// given by -backup_encryption_key_file. The file is read every time, so
+// keys can be added without a restart.
+type FileKeyProvider struct{}
+
+// GetKey is part of the KeyProvider interface.
+func (FileKeyProvider) GetKey(ctx context.Context, keyID string) ([]byte, error) {
+	if *backupEncryptionKeyFile == "" {
+		return nil, vterrors.New(vtrpc.Code_FAILED_PRECONDITION, "no -backup_encryption_key_file specified")
+	}
+	data, err := os.ReadFile(*backupEncryptionKeyFile)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "can't read %v", *backupEncryptionKeyFile)
+	}
+	return parseEncryptionKey(data, keyID)
+}
+
+// VaultKeyProvider is a KeyProvider that reads the keys from a Vault
+// backend from HashiCorp.
+type VaultKeyProvider struct {
+	mu          sync.Mutex
+	vaultClient *vaultapi.Client
+}
+
+// GetKey is part of the KeyProvider interface.
+func (vkp *VaultKeyProvider) GetKey(ctx context.Context, keyID string) ([]byte, error) {
+	vkp.mu.Lock()
+	defer vkp.mu.Unlock()
+
+	if *backupEncryptionVaultAddr == "" {
+		return nil, vterrors.New(vtrpc.Code_FAILED_PRECONDITION, "no Vault server specified")
+	}
+	if *backupEncryptionVaultPath == "" {
+		return nil, vterrors.New(vtrpc.Code_FAILED_PRECONDITION, "no Vault path specified")
+	}
+
+	if vkp.vaultClient == nil {
+		token, err := readVaultFile(*backupEncryptionVaultTokenFile)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "no Vault token in provided filename")
+		}
+		secretID, err := readVaultFile(*backupEncryptionVaultRoleSecretIDFile)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "no Vault secret_id in provided filename")
+		}
+
+		config := vaultapi.NewConfig()
+
+		// All these can be overriden by environment
+		//   so we need to check if they have been set by NewConfig
+		if config.Address == "" {
+			config.Address = *backupEncryptionVaultAddr
+		}
+		if config.Timeout == (0 * time.Second) {
+			config.Timeout = *backupEncryptionVaultTimeout
+		}
+		if config.CACert == "" {
+			config.CACert = *backupEncryptionVaultCACert
+		}
+		if config.Token == "" {
+			config.Token = token
+		}
+		if config.AppRoleCredentials.RoleID == "" {
+			config.AppRoleCredentials.RoleID = *backupEncryptionVaultRoleID
+		}
+		if config.AppRoleCredentials.SecretID == "" {
+			config.AppRoleCredentials.SecretID = secretID
+		}
+		if config.AppRoleCredentials.MountPoint == "" {
+			config.AppRoleCredentials.MountPoint = *backupEncryptionVaultRoleMountPoint
+		}
+
+		if config.CACert != "" {
+			// If we provide a CA, ensure we actually use it
+			config.InsecureSSL = false
+		}
+
+		client, err := vaultapi.NewClient(config)
+		if err != nil || client == nil {
+			return nil, vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "error in vault client initialization: %v", err)
+		}
+		vkp.vaultClient = client
+	}
+
+	secret, err := vkp.vaultClient.GetSecret(*backupEncryptionVaultPath)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't read encryption keys from Vault")
+	}
+	if secret.JSONSecret == nil {
+		return nil, vterrors.New(vtrpc.Code_NOT_FOUND, "empty encryption keys retrieved from Vault server")
+	}
+	return parseEncryptionKey(secret.JSONSecret, keyID)
+}
+
+func readVaultFile(filePath string) (string, error) {
+	if filePath == "" {
+		return "", nil
+	}
+	fileBytes, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(fileBytes)), nil
+}
+
+func init() {
+	KeyProviderMap["file"] = FileKeyProvider{}
+	KeyProviderMap["vault"] = &VaultKeyProvider{}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+// nopWriteCloser is a bytes.Buffer that can be closed.
+type nopWriteCloser struct {
+	bytes.Buffer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func encryptForTest(t *testing.T, key, data []byte) []byte {
+	t.Helper()
+	var out nopWriteCloser
+	ew, err := newEncryptingWriter(&out, key)
+	require.NoError(t, err)
+	_, err = io.Copy(ew, bytes.NewReader(data))
+	require.NoError(t, err)
+	require.NoError(t, ew.Close())
+	return out.Bytes()
+}
+
+func decryptForTest(key, data []byte) ([]byte, error) {
+	dr, err := newDecryptingReader(io.NopCloser(bytes.NewReader(data)), key)
+	if err != nil {
+		return nil, err
+	}
+	defer dr.Close()
+	return io.ReadAll(dr)
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	for _, size := range []int{0, 1, encryptionChunkSize - 1, encryptionChunkSize, encryptionChunkSize + 1, 3*encryptionChunkSize + 17} {
+		t.Run(fmt.Sprintf("%d bytes", size), func(t *testing.T) {
+			data := make([]byte, size)
+			for i := range data {
+				data[i] = byte(i % 251)
+			}
+			encrypted := encryptForTest(t, key, data)
+			if size > 16 {
+				assert.NotContains(t, string(encrypted), string(data[:size/2]))
+			}
+
+			got, err := decryptForTest(key, encrypted)
+			require.NoError(t, err)
+			assert.Equal(t, data, got)
+		})
+	}
+}
+
+func TestEncryptionErrors(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	data := bytes.Repeat([]byte("some data "), encryptionChunkSize/5)
+	encrypted := encryptForTest(t, key, data)
+
+	// The same data never encrypts the same way.
+	assert.NotEqual(t, encrypted, encryptForTest(t, key, data))
+
+	_, err := decryptForTest(bytes.Repeat([]byte{8}, 32), encrypted)
+	assert.Error(t, err, "wrong key")
+
+	tampered := append([]byte(nil), encrypted...)
+	tampered[len(tampered)/2] ^= 1
+	_, err = decryptForTest(key, tampered)
+	assert.Error(t, err, "tampered file")
+
+	// Truncating the file at a chunk boundary is detected as well.
+	sealedSize := encryptionChunkSize + 16
+	headerSize := len(encryptionMagic) + encryptionSaltSize
+	_, err = decryptForTest(key, encrypted[:headerSize+sealedSize])
+	assert.Error(t, err, "truncated file")
+	_, err = decryptForTest(key, encrypted[:headerSize])
+	assert.Error(t, err, "truncated file")
+
+	_, err = decryptForTest(key, data)
+	assert.Error(t, err, "unencrypted file")
+}
+
+func TestFileKeyProvider(t *testing.T) {
+	defer func(keyFile string) {
+		*backupEncryptionKeyFile = keyFile
+	}(*backupEncryptionKeyFile)
+	*backupEncryptionKeyFile = path.Join(t.TempDir(), "keys.json")
+
+	key := bytes.Repeat([]byte{1}, 32)
+	keys := fmt.Sprintf(`{"key1": %q, "short": %q, "invalid": "not base64"}`, base64.StdEncoding.EncodeToString(key), base64.StdEncoding.EncodeToString(key[:10]))
+	require.NoError(t, os.WriteFile(*backupEncryptionKeyFile, []byte(keys), 0600))
+
+	ctx := context.Background()
+	got, err := FileKeyProvider{}.GetKey(ctx, "key1")
+	require.NoError(t, err)
+	assert.Equal(t, key, got)
+
+	for _, keyID := range []string{"unknown", "short", "invalid"} {
+		_, err = FileKeyProvider{}.GetKey(ctx, keyID)
+		assert.Error(t, err, keyID)
+	}
+}
+
+func TestEncryptedBackupHandle(t *testing.T) {
+	defer func(root, provider, keyID, keyFile string) {
+		*filebackupstorage.FileBackupStorageRoot = root
+		*backupEncryptionKeyProvider = provider
+		*backupEncryptionKeyID = keyID
+		*backupEncryptionKeyFile = keyFile
+	}(*filebackupstorage.FileBackupStorageRoot, *backupEncryptionKeyProvider, *backupEncryptionKeyID, *backupEncryptionKeyFile)
+	root := t.TempDir()
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	*backupEncryptionKeyFile = path.Join(root, "keys.json")
+	keys := fmt.Sprintf(`{"key1": %q, "key2": %q}`, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 16)), base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32)))
+	require.NoError(t, os.WriteFile(*backupEncryptionKeyFile, []byte(keys), 0600))
+
+	ctx := context.Background()
+	fbs := &filebackupstorage.FileBackupStorage{}
+	writeBackup := func(name string) {
+		bh, err := fbs.StartBackup(ctx, "ks/0", name)
+		require.NoError(t, err)
+		bh, err = encryptBackupHandle(ctx, bh)
+		require.NoError(t, err)
+		wc, err := bh.AddFile(ctx, "0", 10)
+		require.NoError(t, err)
+		_, err = wc.Write([]byte("some data"))
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+
+		var bm BackupManifest
+		setBackupEncryption(bh, &bm)
+		wc, err = bh.AddFile(ctx, backupManifestFileName, -1)
+		require.NoError(t, err)
+		_, err = fmt.Fprintf(wc, `{"EncryptionKeyID": %q}`, bm.EncryptionKeyID)
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+	readBackup := func(bh backupstorage.BackupHandle) ([]byte, error) {
+		bm, err := GetBackupManifest(ctx, bh)
+		require.NoError(t, err)
+		dbh, err := decryptBackupHandle(ctx, bh, bm)
+		if err != nil {
+			return nil, err
+		}
+		rc, err := dbh.ReadFile(ctx, "0")
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+
+	// Backups taken without encryption remain readable.
+	writeBackup("backup1")
+	*backupEncryptionKeyProvider = "file"
+	*backupEncryptionKeyID = "key1"
+	writeBackup("backup2")
+	*backupEncryptionKeyID = "key2"
+	writeBackup("backup3")
+
+	// The files are encrypted, but not the MANIFEST.
+	data, err := os.ReadFile(path.Join(root, "backups/ks/0/backup2/0"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "some data")
+	data, err = os.ReadFile(path.Join(root, "backups/ks/0/backup2/MANIFEST"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"EncryptionKeyID": "key1"}`, string(data))
+
+	// Restores use the key recorded in the MANIFEST, whatever the current key.
+	bhs, err := fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	require.Len(t, bhs, 3)
+	for _, bh := range bhs {
+		got, err := readBackup(bh)
+		require.NoError(t, err, bh.Name())
+		assert.Equal(t, "some data", string(got), bh.Name())
+	}
+
+	// Encrypted backups can't be restored without a key provider.
+	*backupEncryptionKeyProvider = ""
+	_, err = readBackup(bhs[1])
+	assert.Error(t, err)
+}
@@ -160,6 +160,16 @@ type FakeMysqlDaemon struct {
 	// BinlogPlayerEnabled is used by {Enable,Disable}BinlogPlayer
 	BinlogPlayerEnabled sync2.AtomicBool
 
+	// AppliedBinlogFiles is updated by ApplyBinlogFile
+	AppliedBinlogFiles []string
+
+	// ApplyBinlogFilePosition, if set, is what CurrentPrimaryPosition is
+	// set to by ApplyBinlogFile
+	ApplyBinlogFilePosition mysql.Position
+
+	// ApplyBinlogFileError is used by ApplyBinlogFile
+	ApplyBinlogFileError error
+
 	// SemiSyncMasterEnabled represents the state of rpl_semi_sync_master_enabled.
 	SemiSyncMasterEnabled bool
 	// SemiSyncReplicaEnabled represents the state of rpl_semi_sync_slave_enabled.
@@ -489,6 +499,18 @@ func (fmd *FakeMysqlDaemon) DisableBinlogPlayback() error {
 	return nil
 }
 
+// ApplyBinlogFile is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) ApplyBinlogFile(ctx context.Context, binlogFile string, stopPos mysql.Position, stopTime time.Time) error {
+	if fmd.ApplyBinlogFileError != nil {
+		return fmd.ApplyBinlogFileError
+	}
+	fmd.AppliedBinlogFiles = append(fmd.AppliedBinlogFiles, binlogFile)
+	if !fmd.ApplyBinlogFilePosition.IsZero() {
+		fmd.CurrentPrimaryPosition = fmd.ApplyBinlogFilePosition
+	}
+	return nil
+}
+
 // Close is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) Close() {
 	if fmd.appPool != nil {
@@ -1050,6 +1050,78 @@ func (mysqld *Mysqld) executeMysqlScript(connParams *mysql.ConnParams, sql io.Re
 	return nil
 }
 
+// ApplyBinlogFile is part of the MysqlDaemon interface. It pipes the output
+// of mysqlbinlog into the mysql client, connected as the dba user.
+func (mysqld *Mysqld) ApplyBinlogFile(ctx context.Context, binlogFile string, stopPos mysql.Position, stopTime time.Time) error {
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	mysqlbinlogName, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	mysqlName, err := binaryPath(dir, "mysql")
+	if err != nil {
+		return err
+	}
+	env, err := buildLdPaths()
+	if err != nil {
+		return err
+	}
+
+	var binlogArgs []string
+	if !stopPos.IsZero() {
+		binlogArgs = append(binlogArgs, "--include-gtids="+stopPos.GTIDSet.String())
+	}
+	if !stopTime.IsZero() {
+		// mysqlbinlog interprets --stop-datetime in its local time zone.
+		binlogArgs = append(binlogArgs, "--stop-datetime="+stopTime.UTC().Format("2006-01-02 15:04:05"))
+	}
+	binlogArgs = append(binlogArgs, binlogFile)
+	binlogCmd := exec.CommandContext(ctx, mysqlbinlogName, binlogArgs...)
+	binlogCmd.Env = append(env, "TZ=UTC")
+	binlogCmd.Dir = dir
+	var binlogStderr bytes.Buffer
+	binlogCmd.Stderr = &binlogStderr
+
+	params, err := mysqld.dbcfgs.DbaConnector().MysqlParams()
+	if err != nil {
+		return err
+	}
+	cnf, err := mysqld.defaultsExtraFile(params)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(cnf)
+	mysqlCmd := exec.CommandContext(ctx, mysqlName, "--defaults-extra-file="+cnf, "--batch")
+	mysqlCmd.Env = env
+	mysqlCmd.Dir = dir
+	var mysqlOutput bytes.Buffer
+	mysqlCmd.Stdout = &mysqlOutput
+	mysqlCmd.Stderr = &mysqlOutput
+
+	pipe, err := binlogCmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	mysqlCmd.Stdin = pipe
+
+	log.Infof("ApplyBinlogFile: %v %v | %v", mysqlbinlogName, binlogArgs, mysqlName)
+	if err := binlogCmd.Start(); err != nil {
+		return err
+	}
+	mysqlErr := mysqlCmd.Run()
+	binlogErr := binlogCmd.Wait()
+	if mysqlErr != nil {
+		return fmt.Errorf("%v: %v, output: %v", mysqlName, mysqlErr, mysqlOutput.String())
+	}
+	if binlogErr != nil {
+		return fmt.Errorf("%v: %v, output: %v", mysqlbinlogName, binlogErr, binlogStderr.String())
+	}
+	return nil
+}
+
 // defaultsExtraFile returns the filename for a temporary config file
 // that contains the user, password and socket file to connect to
 // mysqld.  We write a temporary config file so the password is never
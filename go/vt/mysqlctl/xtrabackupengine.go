@@ -31,9 +31,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
-	"github.com/planetscale/pargzip"
-
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
@@ -94,10 +91,11 @@ type xtraBackupManifest struct {
 	// StripeBlockSize is the size in bytes of each stripe block.
 	StripeBlockSize int32
 
-	// SkipCompress is true if the backup files were NOT run through gzip.
+	// SkipCompress is true if the backup files were NOT compressed.
 	// The field is expressed as a negative because it will come through as
 	// false for backups that were created before the field existed, and those
-	// backups all had compression enabled.
+	// backups all had compression enabled. BackupManifest.CompressionEngine
+	// tells how the files were compressed otherwise.
 	SkipCompress bool
 }
 
@@ -130,6 +128,12 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	if *xtrabackupUser == "" {
 		return false, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "xtrabackupUser must be specified.")
 	}
+	if params.Incremental {
+		return false, vterrors.New(vtrpc.Code_UNIMPLEMENTED, "incremental backups are not supported by the xtrabackup engine")
+	}
+	if err := validateBackupCompression(params); err != nil {
+		return false, err
+	}
 	// use a mysql connection to detect flavor at runtime
 	conn, err := params.Mysqld.GetDbaConnection(ctx)
 	if conn != nil && err == nil {
@@ -186,6 +190,8 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 		NumStripes:      int32(numStripes),
 		StripeBlockSize: int32(*xtrabackupStripeBlockSize),
 	}
+	setBackupCompression(params, &bm.BackupManifest)
+	setBackupEncryption(bh, &bm.BackupManifest)
 
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
@@ -272,17 +278,23 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 	destWriters := []io.Writer{}
 	destBuffers := []*bufio.Writer{}
 	destCompressors := []io.WriteCloser{}
+	var compression CompressionEngine
+	if engine := backupCompressionEngine(params); engine != "" {
+		if compression, err = getCompressionEngine(engine); err != nil {
+			return replicationPosition, err
+		}
+	}
 	for _, file := range destFiles {
 		buffer := bufio.NewWriterSize(file, writerBufferSize)
 		destBuffers = append(destBuffers, buffer)
 		writer := io.Writer(buffer)
 
-		// Create the gzip compression pipe, if necessary.
-		if *backupStorageCompress {
-			compressor := pargzip.NewWriter(writer)
-			compressor.ChunkSize = *backupCompressBlockSize
-			compressor.Parallel = *backupCompressBlocks
-			compressor.CompressionLevel = pargzip.BestSpeed
+		// Create the compression pipe, if necessary.
+		if compression != nil {
+			compressor, err := compression.NewCompressor(ctx, writer, params.Logger)
+			if err != nil {
+				return replicationPosition, err
+			}
 			writer = compressor
 			destCompressors = append(destCompressors, compressor)
 		}
@@ -343,7 +355,7 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 	// Close compressor to flush it. After that all data is sent to the buffer.
 	for _, compressor := range destCompressors {
 		if err := compressor.Close(); err != nil {
-			return replicationPosition, vterrors.Wrap(err, "cannot close gzip compressor")
+			return replicationPosition, vterrors.Wrap(err, "cannot close compressor")
 		}
 	}
 
@@ -500,7 +512,10 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	// Pull details from the MANIFEST where available, so we can still restore
 	// backups taken with different flags. Some fields were not always present,
 	// so if necessary we default to the flag values.
-	compressed := !bm.SkipCompress
+	compression, err := restoreCompressionEngine(bm.SkipCompress, &bm.BackupManifest)
+	if err != nil {
+		return err
+	}
 	streamMode := bm.StreamMode
 	if streamMode == "" {
 		streamMode = *xtrabackupStreamMode
@@ -527,10 +542,10 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 		reader := io.Reader(file)
 
 		// Create the decompressor if needed.
-		if compressed {
-			decompressor, err := pgzip.NewReader(reader)
+		if compression != nil {
+			decompressor, err := compression.NewDecompressor(ctx, reader, logger)
 			if err != nil {
-				return vterrors.Wrap(err, "can't create gzip decompressor")
+				return err
 			}
 			srcDecompressors = append(srcDecompressors, decompressor)
 			reader = decompressor
@@ -541,7 +556,7 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	defer func() {
 		for _, decompressor := range srcDecompressors {
 			if cerr := decompressor.Close(); cerr != nil {
-				logger.Errorf("failed to close gzip decompressor: %v", cerr)
+				logger.Errorf("failed to close decompressor: %v", cerr)
 			}
 		}
 	}()
@@ -399,6 +399,13 @@ func (m *BackupInfo) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.ParentBackup) > 0 {
+		i -= len(m.ParentBackup)
+		copy(dAtA[i:], m.ParentBackup)
+		i = encodeVarint(dAtA, i, uint64(len(m.ParentBackup)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Status != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.Status))
 		i--
@@ -639,6 +646,10 @@ func (m *BackupInfo) SizeVT() (n int) {
 	if m.Status != 0 {
 		n += 1 + sov(uint64(m.Status))
 	}
+	l = len(m.ParentBackup)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
 	if m.unknownFields != nil {
 		n += len(m.unknownFields)
 	}
@@ -1493,6 +1504,38 @@ func (m *BackupInfo) UnmarshalVT(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentBackup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentBackup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -146,16 +146,25 @@ type VtctldClient interface {
 	ApplyRoutingRules(ctx context.Context, in *vtctldata.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.ApplyRoutingRulesResponse, error)
 	// ApplyVSchema applies a vschema to a keyspace.
 	ApplyVSchema(ctx context.Context, in *vtctldata.ApplyVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ApplyVSchemaResponse, error)
+	// CancelWorkflow deletes the streams and the target tables or shards of a
+	// MoveTables or Reshard workflow that has not switched any traffic yet.
+	CancelWorkflow(ctx context.Context, in *vtctldata.CancelWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.CancelWorkflowResponse, error)
 	// ChangeTabletType changes the db type for the specified tablet, if possible.
 	// This is used primarily to arrange replicas, and it will not convert a
 	// primary. For that, use InitShardPrimary.
 	//
 	// NOTE: This command automatically updates the serving graph.
 	ChangeTabletType(ctx context.Context, in *vtctldata.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldata.ChangeTabletTypeResponse, error)
+	// CompleteWorkflow cleans up a MoveTables or Reshard workflow that switched
+	// all its traffic, dropping or renaming the source tables or shards.
+	CompleteWorkflow(ctx context.Context, in *vtctldata.CompleteWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.CompleteWorkflowResponse, error)
 	// CreateKeyspace creates the specified keyspace in the topology. For a
 	// SNAPSHOT keyspace, the request must specify the name of a base keyspace,
 	// as well as a snapshot time.
 	CreateKeyspace(ctx context.Context, in *vtctldata.CreateKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.CreateKeyspaceResponse, error)
+	// CreateLookupVindex creates a lookup vindex, its lookup table and the
+	// workflow that backfills it.
+	CreateLookupVindex(ctx context.Context, in *vtctldata.CreateLookupVindexRequest, opts ...grpc.CallOption) (*vtctldata.CreateLookupVindexResponse, error)
 	// CreateShard creates the specified shard in the topology.
 	CreateShard(ctx context.Context, in *vtctldata.CreateShardRequest, opts ...grpc.CallOption) (*vtctldata.CreateShardResponse, error)
 	// DeleteCellInfo deletes the CellInfo for the provided cell. The cell cannot
@@ -232,6 +241,12 @@ type VtctldClient interface {
 	// PlannedReparentShard or EmergencyReparentShard should be used in those
 	// cases instead.
 	InitShardPrimary(ctx context.Context, in *vtctldata.InitShardPrimaryRequest, opts ...grpc.CallOption) (*vtctldata.InitShardPrimaryResponse, error)
+	// Materialize creates a workflow that materializes the results of queries on
+	// the tables of a source keyspace into tables of a target keyspace.
+	Materialize(ctx context.Context, in *vtctldata.MaterializeRequest, opts ...grpc.CallOption) (*vtctldata.MaterializeResponse, error)
+	// MoveTables creates a workflow that moves tables from a source keyspace to
+	// a target keyspace.
+	MoveTables(ctx context.Context, in *vtctldata.MoveTablesRequest, opts ...grpc.CallOption) (*vtctldata.MoveTablesResponse, error)
 	// PingTablet checks that the specified tablet is awake and responding to RPCs.
 	// This command can be blocked by other in-flight operations.
 	PingTablet(ctx context.Context, in *vtctldata.PingTabletRequest, opts ...grpc.CallOption) (*vtctldata.PingTabletResponse, error)
@@ -277,6 +292,15 @@ type VtctldClient interface {
 	// only works if the current replica position matches the last known reparent
 	// action.
 	ReparentTablet(ctx context.Context, in *vtctldata.ReparentTabletRequest, opts ...grpc.CallOption) (*vtctldata.ReparentTabletResponse, error)
+	// Reshard creates a workflow that copies the data of the source shards of a
+	// keyspace to its target shards.
+	Reshard(ctx context.Context, in *vtctldata.ReshardRequest, opts ...grpc.CallOption) (*vtctldata.ReshardResponse, error)
+	// RestoreFromBackup stops mysqld for the given tablet and restores a backup,
+	// optionally replaying archived binary logs to recover to a point in time.
+	RestoreFromBackup(ctx context.Context, in *vtctldata.RestoreFromBackupRequest, opts ...grpc.CallOption) (Vtctld_RestoreFromBackupClient, error)
+	// ReverseTraffic switches the traffic of a MoveTables or Reshard workflow
+	// back to its source.
+	ReverseTraffic(ctx context.Context, in *vtctldata.ReverseTrafficRequest, opts ...grpc.CallOption) (*vtctldata.ReverseTrafficResponse, error)
 	// RunHealthCheck runs a healthcheck on the remote tablet.
 	RunHealthCheck(ctx context.Context, in *vtctldata.RunHealthCheckRequest, opts ...grpc.CallOption) (*vtctldata.RunHealthCheckResponse, error)
 	// SetKeyspaceServedFrom changes the ServedFromMap manually, and is intended
@@ -286,6 +310,9 @@ type VtctldClient interface {
 	SetKeyspaceServedFrom(ctx context.Context, in *vtctldata.SetKeyspaceServedFromRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceServedFromResponse, error)
 	// SetKeyspaceShardingInfo updates the sharding information for a keyspace.
 	SetKeyspaceShardingInfo(ctx context.Context, in *vtctldata.SetKeyspaceShardingInfoRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceShardingInfoResponse, error)
+	// SetKeyspaceThrottlerConfig updates the throttler config of a keyspace,
+	// which its tablets pick up within a few seconds.
+	SetKeyspaceThrottlerConfig(ctx context.Context, in *vtctldata.SetKeyspaceThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceThrottlerConfigResponse, error)
 	// SetShardIsPrimaryServing adds or removes a shard from serving.
 	//
 	// This is meant as an emergency function. It does not rebuild any serving
@@ -315,6 +342,9 @@ type VtctldClient interface {
 	StartReplication(ctx context.Context, in *vtctldata.StartReplicationRequest, opts ...grpc.CallOption) (*vtctldata.StartReplicationResponse, error)
 	// StopReplication stops replication on the specified tablet.
 	StopReplication(ctx context.Context, in *vtctldata.StopReplicationRequest, opts ...grpc.CallOption) (*vtctldata.StopReplicationResponse, error)
+	// SwitchTraffic switches the traffic of a MoveTables or Reshard workflow
+	// from its source to its target.
+	SwitchTraffic(ctx context.Context, in *vtctldata.SwitchTrafficRequest, opts ...grpc.CallOption) (*vtctldata.SwitchTrafficResponse, error)
 	// TabletExternallyReparented changes metadata in the topology server to
 	// acknowledge a shard primary change performed by an external tool (e.g.
 	// orchestrator).
@@ -330,6 +360,8 @@ type VtctldClient interface {
 	// parameters. Empty values are ignored. If the alias does not exist, the
 	// CellsAlias will be created.
 	UpdateCellsAlias(ctx context.Context, in *vtctldata.UpdateCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.UpdateCellsAliasResponse, error)
+	// VDiff compares the source and target tables of a workflow.
+	VDiff(ctx context.Context, in *vtctldata.VDiffRequest, opts ...grpc.CallOption) (*vtctldata.VDiffResponse, error)
 	// Validate validates that all nodes from the global replication graph are
 	// reachable, and that all tablets in discoverable cells are consistent.
 	Validate(ctx context.Context, in *vtctldata.ValidateRequest, opts ...grpc.CallOption) (*vtctldata.ValidateResponse, error)
@@ -385,6 +417,15 @@ func (c *vtctldClient) ApplyVSchema(ctx context.Context, in *vtctldata.ApplyVSch
 	return out, nil
 }
 
+func (c *vtctldClient) CancelWorkflow(ctx context.Context, in *vtctldata.CancelWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.CancelWorkflowResponse, error) {
+	out := new(vtctldata.CancelWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CancelWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) ChangeTabletType(ctx context.Context, in *vtctldata.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldata.ChangeTabletTypeResponse, error) {
 	out := new(vtctldata.ChangeTabletTypeResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ChangeTabletType", in, out, opts...)
@@ -394,6 +435,15 @@ func (c *vtctldClient) ChangeTabletType(ctx context.Context, in *vtctldata.Chang
 	return out, nil
 }
 
+func (c *vtctldClient) CompleteWorkflow(ctx context.Context, in *vtctldata.CompleteWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.CompleteWorkflowResponse, error) {
+	out := new(vtctldata.CompleteWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CompleteWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) CreateKeyspace(ctx context.Context, in *vtctldata.CreateKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.CreateKeyspaceResponse, error) {
 	out := new(vtctldata.CreateKeyspaceResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CreateKeyspace", in, out, opts...)
@@ -403,6 +453,15 @@ func (c *vtctldClient) CreateKeyspace(ctx context.Context, in *vtctldata.CreateK
 	return out, nil
 }
 
+func (c *vtctldClient) CreateLookupVindex(ctx context.Context, in *vtctldata.CreateLookupVindexRequest, opts ...grpc.CallOption) (*vtctldata.CreateLookupVindexResponse, error) {
+	out := new(vtctldata.CreateLookupVindexResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CreateLookupVindex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) CreateShard(ctx context.Context, in *vtctldata.CreateShardRequest, opts ...grpc.CallOption) (*vtctldata.CreateShardResponse, error) {
 	out := new(vtctldata.CreateShardResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CreateShard", in, out, opts...)
@@ -655,6 +714,24 @@ func (c *vtctldClient) InitShardPrimary(ctx context.Context, in *vtctldata.InitS
 	return out, nil
 }
 
+func (c *vtctldClient) Materialize(ctx context.Context, in *vtctldata.MaterializeRequest, opts ...grpc.CallOption) (*vtctldata.MaterializeResponse, error) {
+	out := new(vtctldata.MaterializeResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/Materialize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) MoveTables(ctx context.Context, in *vtctldata.MoveTablesRequest, opts ...grpc.CallOption) (*vtctldata.MoveTablesResponse, error) {
+	out := new(vtctldata.MoveTablesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/MoveTables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) PingTablet(ctx context.Context, in *vtctldata.PingTabletRequest, opts ...grpc.CallOption) (*vtctldata.PingTabletResponse, error) {
 	out := new(vtctldata.PingTabletResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/PingTablet", in, out, opts...)
@@ -763,6 +840,56 @@ func (c *vtctldClient) ReparentTablet(ctx context.Context, in *vtctldata.Reparen
 	return out, nil
 }
 
+func (c *vtctldClient) Reshard(ctx context.Context, in *vtctldata.ReshardRequest, opts ...grpc.CallOption) (*vtctldata.ReshardResponse, error) {
+	out := new(vtctldata.ReshardResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/Reshard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) RestoreFromBackup(ctx context.Context, in *vtctldata.RestoreFromBackupRequest, opts ...grpc.CallOption) (Vtctld_RestoreFromBackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Vtctld_ServiceDesc.Streams[0], "/vtctlservice.Vtctld/RestoreFromBackup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldRestoreFromBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_RestoreFromBackupClient interface {
+	Recv() (*vtctldata.RestoreFromBackupResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldRestoreFromBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldRestoreFromBackupClient) Recv() (*vtctldata.RestoreFromBackupResponse, error) {
+	m := new(vtctldata.RestoreFromBackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vtctldClient) ReverseTraffic(ctx context.Context, in *vtctldata.ReverseTrafficRequest, opts ...grpc.CallOption) (*vtctldata.ReverseTrafficResponse, error) {
+	out := new(vtctldata.ReverseTrafficResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ReverseTraffic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) RunHealthCheck(ctx context.Context, in *vtctldata.RunHealthCheckRequest, opts ...grpc.CallOption) (*vtctldata.RunHealthCheckResponse, error) {
 	out := new(vtctldata.RunHealthCheckResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RunHealthCheck", in, out, opts...)
@@ -790,6 +917,15 @@ func (c *vtctldClient) SetKeyspaceShardingInfo(ctx context.Context, in *vtctldat
 	return out, nil
 }
 
+func (c *vtctldClient) SetKeyspaceThrottlerConfig(ctx context.Context, in *vtctldata.SetKeyspaceThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceThrottlerConfigResponse, error) {
+	out := new(vtctldata.SetKeyspaceThrottlerConfigResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetKeyspaceThrottlerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) SetShardIsPrimaryServing(ctx context.Context, in *vtctldata.SetShardIsPrimaryServingRequest, opts ...grpc.CallOption) (*vtctldata.SetShardIsPrimaryServingResponse, error) {
 	out := new(vtctldata.SetShardIsPrimaryServingResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetShardIsPrimaryServing", in, out, opts...)
@@ -853,6 +989,15 @@ func (c *vtctldClient) StopReplication(ctx context.Context, in *vtctldata.StopRe
 	return out, nil
 }
 
+func (c *vtctldClient) SwitchTraffic(ctx context.Context, in *vtctldata.SwitchTrafficRequest, opts ...grpc.CallOption) (*vtctldata.SwitchTrafficResponse, error) {
+	out := new(vtctldata.SwitchTrafficResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SwitchTraffic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) TabletExternallyReparented(ctx context.Context, in *vtctldata.TabletExternallyReparentedRequest, opts ...grpc.CallOption) (*vtctldata.TabletExternallyReparentedResponse, error) {
 	out := new(vtctldata.TabletExternallyReparentedResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/TabletExternallyReparented", in, out, opts...)
@@ -880,6 +1025,15 @@ func (c *vtctldClient) UpdateCellsAlias(ctx context.Context, in *vtctldata.Updat
 	return out, nil
 }
 
+func (c *vtctldClient) VDiff(ctx context.Context, in *vtctldata.VDiffRequest, opts ...grpc.CallOption) (*vtctldata.VDiffResponse, error) {
+	out := new(vtctldata.VDiffResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/VDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) Validate(ctx context.Context, in *vtctldata.ValidateRequest, opts ...grpc.CallOption) (*vtctldata.ValidateResponse, error) {
 	out := new(vtctldata.ValidateResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/Validate", in, out, opts...)
@@ -925,16 +1079,25 @@ type VtctldServer interface {
 	ApplyRoutingRules(context.Context, *vtctldata.ApplyRoutingRulesRequest) (*vtctldata.ApplyRoutingRulesResponse, error)
 	// ApplyVSchema applies a vschema to a keyspace.
 	ApplyVSchema(context.Context, *vtctldata.ApplyVSchemaRequest) (*vtctldata.ApplyVSchemaResponse, error)
+	// CancelWorkflow deletes the streams and the target tables or shards of a
+	// MoveTables or Reshard workflow that has not switched any traffic yet.
+	CancelWorkflow(context.Context, *vtctldata.CancelWorkflowRequest) (*vtctldata.CancelWorkflowResponse, error)
 	// ChangeTabletType changes the db type for the specified tablet, if possible.
 	// This is used primarily to arrange replicas, and it will not convert a
 	// primary. For that, use InitShardPrimary.
 	//
 	// NOTE: This command automatically updates the serving graph.
 	ChangeTabletType(context.Context, *vtctldata.ChangeTabletTypeRequest) (*vtctldata.ChangeTabletTypeResponse, error)
+	// CompleteWorkflow cleans up a MoveTables or Reshard workflow that switched
+	// all its traffic, dropping or renaming the source tables or shards.
+	CompleteWorkflow(context.Context, *vtctldata.CompleteWorkflowRequest) (*vtctldata.CompleteWorkflowResponse, error)
 	// CreateKeyspace creates the specified keyspace in the topology. For a
 	// SNAPSHOT keyspace, the request must specify the name of a base keyspace,
 	// as well as a snapshot time.
 	CreateKeyspace(context.Context, *vtctldata.CreateKeyspaceRequest) (*vtctldata.CreateKeyspaceResponse, error)
+	// CreateLookupVindex creates a lookup vindex, its lookup table and the
+	// workflow that backfills it.
+	CreateLookupVindex(context.Context, *vtctldata.CreateLookupVindexRequest) (*vtctldata.CreateLookupVindexResponse, error)
 	// CreateShard creates the specified shard in the topology.
 	CreateShard(context.Context, *vtctldata.CreateShardRequest) (*vtctldata.CreateShardResponse, error)
 	// DeleteCellInfo deletes the CellInfo for the provided cell. The cell cannot
@@ -1011,6 +1174,12 @@ type VtctldServer interface {
 	// PlannedReparentShard or EmergencyReparentShard should be used in those
 	// cases instead.
 	InitShardPrimary(context.Context, *vtctldata.InitShardPrimaryRequest) (*vtctldata.InitShardPrimaryResponse, error)
+	// Materialize creates a workflow that materializes the results of queries on
+	// the tables of a source keyspace into tables of a target keyspace.
+	Materialize(context.Context, *vtctldata.MaterializeRequest) (*vtctldata.MaterializeResponse, error)
+	// MoveTables creates a workflow that moves tables from a source keyspace to
+	// a target keyspace.
+	MoveTables(context.Context, *vtctldata.MoveTablesRequest) (*vtctldata.MoveTablesResponse, error)
 	// PingTablet checks that the specified tablet is awake and responding to RPCs.
 	// This command can be blocked by other in-flight operations.
 	PingTablet(context.Context, *vtctldata.PingTabletRequest) (*vtctldata.PingTabletResponse, error)
@@ -1056,6 +1225,15 @@ type VtctldServer interface {
 	// only works if the current replica position matches the last known reparent
 	// action.
 	ReparentTablet(context.Context, *vtctldata.ReparentTabletRequest) (*vtctldata.ReparentTabletResponse, error)
+	// Reshard creates a workflow that copies the data of the source shards of a
+	// keyspace to its target shards.
+	Reshard(context.Context, *vtctldata.ReshardRequest) (*vtctldata.ReshardResponse, error)
+	// RestoreFromBackup stops mysqld for the given tablet and restores a backup,
+	// optionally replaying archived binary logs to recover to a point in time.
+	RestoreFromBackup(*vtctldata.RestoreFromBackupRequest, Vtctld_RestoreFromBackupServer) error
+	// ReverseTraffic switches the traffic of a MoveTables or Reshard workflow
+	// back to its source.
+	ReverseTraffic(context.Context, *vtctldata.ReverseTrafficRequest) (*vtctldata.ReverseTrafficResponse, error)
 	// RunHealthCheck runs a healthcheck on the remote tablet.
 	RunHealthCheck(context.Context, *vtctldata.RunHealthCheckRequest) (*vtctldata.RunHealthCheckResponse, error)
 	// SetKeyspaceServedFrom changes the ServedFromMap manually, and is intended
@@ -1065,6 +1243,9 @@ type VtctldServer interface {
 	SetKeyspaceServedFrom(context.Context, *vtctldata.SetKeyspaceServedFromRequest) (*vtctldata.SetKeyspaceServedFromResponse, error)
 	// SetKeyspaceShardingInfo updates the sharding information for a keyspace.
 	SetKeyspaceShardingInfo(context.Context, *vtctldata.SetKeyspaceShardingInfoRequest) (*vtctldata.SetKeyspaceShardingInfoResponse, error)
+	// SetKeyspaceThrottlerConfig updates the throttler config of a keyspace,
+	// which its tablets pick up within a few seconds.
+	SetKeyspaceThrottlerConfig(context.Context, *vtctldata.SetKeyspaceThrottlerConfigRequest) (*vtctldata.SetKeyspaceThrottlerConfigResponse, error)
 	// SetShardIsPrimaryServing adds or removes a shard from serving.
 	//
 	// This is meant as an emergency function. It does not rebuild any serving
@@ -1094,6 +1275,9 @@ type VtctldServer interface {
 	StartReplication(context.Context, *vtctldata.StartReplicationRequest) (*vtctldata.StartReplicationResponse, error)
 	// StopReplication stops replication on the specified tablet.
 	StopReplication(context.Context, *vtctldata.StopReplicationRequest) (*vtctldata.StopReplicationResponse, error)
+	// SwitchTraffic switches the traffic of a MoveTables or Reshard workflow
+	// from its source to its target.
+	SwitchTraffic(context.Context, *vtctldata.SwitchTrafficRequest) (*vtctldata.SwitchTrafficResponse, error)
 	// TabletExternallyReparented changes metadata in the topology server to
 	// acknowledge a shard primary change performed by an external tool (e.g.
 	// orchestrator).
@@ -1109,6 +1293,8 @@ type VtctldServer interface {
 	// parameters. Empty values are ignored. If the alias does not exist, the
 	// CellsAlias will be created.
 	UpdateCellsAlias(context.Context, *vtctldata.UpdateCellsAliasRequest) (*vtctldata.UpdateCellsAliasResponse, error)
+	// VDiff compares the source and target tables of a workflow.
+	VDiff(context.Context, *vtctldata.VDiffRequest) (*vtctldata.VDiffResponse, error)
 	// Validate validates that all nodes from the global replication graph are
 	// reachable, and that all tablets in discoverable cells are consistent.
 	Validate(context.Context, *vtctldata.ValidateRequest) (*vtctldata.ValidateResponse, error)
@@ -1137,12 +1323,21 @@ func (UnimplementedVtctldServer) ApplyRoutingRules(context.Context, *vtctldata.A
 func (UnimplementedVtctldServer) ApplyVSchema(context.Context, *vtctldata.ApplyVSchemaRequest) (*vtctldata.ApplyVSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyVSchema not implemented")
 }
+func (UnimplementedVtctldServer) CancelWorkflow(context.Context, *vtctldata.CancelWorkflowRequest) (*vtctldata.CancelWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWorkflow not implemented")
+}
 func (UnimplementedVtctldServer) ChangeTabletType(context.Context, *vtctldata.ChangeTabletTypeRequest) (*vtctldata.ChangeTabletTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeTabletType not implemented")
 }
+func (UnimplementedVtctldServer) CompleteWorkflow(context.Context, *vtctldata.CompleteWorkflowRequest) (*vtctldata.CompleteWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteWorkflow not implemented")
+}
 func (UnimplementedVtctldServer) CreateKeyspace(context.Context, *vtctldata.CreateKeyspaceRequest) (*vtctldata.CreateKeyspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateKeyspace not implemented")
 }
+func (UnimplementedVtctldServer) CreateLookupVindex(context.Context, *vtctldata.CreateLookupVindexRequest) (*vtctldata.CreateLookupVindexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLookupVindex not implemented")
+}
 func (UnimplementedVtctldServer) CreateShard(context.Context, *vtctldata.CreateShardRequest) (*vtctldata.CreateShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateShard not implemented")
 }
@@ -1227,6 +1422,12 @@ func (UnimplementedVtctldServer) GetWorkflows(context.Context, *vtctldata.GetWor
 func (UnimplementedVtctldServer) InitShardPrimary(context.Context, *vtctldata.InitShardPrimaryRequest) (*vtctldata.InitShardPrimaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitShardPrimary not implemented")
 }
+func (UnimplementedVtctldServer) Materialize(context.Context, *vtctldata.MaterializeRequest) (*vtctldata.MaterializeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Materialize not implemented")
+}
+func (UnimplementedVtctldServer) MoveTables(context.Context, *vtctldata.MoveTablesRequest) (*vtctldata.MoveTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTables not implemented")
+}
 func (UnimplementedVtctldServer) PingTablet(context.Context, *vtctldata.PingTabletRequest) (*vtctldata.PingTabletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PingTablet not implemented")
 }
@@ -1263,6 +1464,15 @@ func (UnimplementedVtctldServer) RemoveShardCell(context.Context, *vtctldata.Rem
 func (UnimplementedVtctldServer) ReparentTablet(context.Context, *vtctldata.ReparentTabletRequest) (*vtctldata.ReparentTabletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReparentTablet not implemented")
 }
+func (UnimplementedVtctldServer) Reshard(context.Context, *vtctldata.ReshardRequest) (*vtctldata.ReshardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reshard not implemented")
+}
+func (UnimplementedVtctldServer) RestoreFromBackup(*vtctldata.RestoreFromBackupRequest, Vtctld_RestoreFromBackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreFromBackup not implemented")
+}
+func (UnimplementedVtctldServer) ReverseTraffic(context.Context, *vtctldata.ReverseTrafficRequest) (*vtctldata.ReverseTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTraffic not implemented")
+}
 func (UnimplementedVtctldServer) RunHealthCheck(context.Context, *vtctldata.RunHealthCheckRequest) (*vtctldata.RunHealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunHealthCheck not implemented")
 }
@@ -1272,6 +1482,9 @@ func (UnimplementedVtctldServer) SetKeyspaceServedFrom(context.Context, *vtctlda
 func (UnimplementedVtctldServer) SetKeyspaceShardingInfo(context.Context, *vtctldata.SetKeyspaceShardingInfoRequest) (*vtctldata.SetKeyspaceShardingInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetKeyspaceShardingInfo not implemented")
 }
+func (UnimplementedVtctldServer) SetKeyspaceThrottlerConfig(context.Context, *vtctldata.SetKeyspaceThrottlerConfigRequest) (*vtctldata.SetKeyspaceThrottlerConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeyspaceThrottlerConfig not implemented")
+}
 func (UnimplementedVtctldServer) SetShardIsPrimaryServing(context.Context, *vtctldata.SetShardIsPrimaryServingRequest) (*vtctldata.SetShardIsPrimaryServingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetShardIsPrimaryServing not implemented")
 }
@@ -1293,6 +1506,9 @@ func (UnimplementedVtctldServer) StartReplication(context.Context, *vtctldata.St
 func (UnimplementedVtctldServer) StopReplication(context.Context, *vtctldata.StopReplicationRequest) (*vtctldata.StopReplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopReplication not implemented")
 }
+func (UnimplementedVtctldServer) SwitchTraffic(context.Context, *vtctldata.SwitchTrafficRequest) (*vtctldata.SwitchTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchTraffic not implemented")
+}
 func (UnimplementedVtctldServer) TabletExternallyReparented(context.Context, *vtctldata.TabletExternallyReparentedRequest) (*vtctldata.TabletExternallyReparentedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TabletExternallyReparented not implemented")
 }
@@ -1302,6 +1518,9 @@ func (UnimplementedVtctldServer) UpdateCellInfo(context.Context, *vtctldata.Upda
 func (UnimplementedVtctldServer) UpdateCellsAlias(context.Context, *vtctldata.UpdateCellsAliasRequest) (*vtctldata.UpdateCellsAliasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCellsAlias not implemented")
 }
+func (UnimplementedVtctldServer) VDiff(context.Context, *vtctldata.VDiffRequest) (*vtctldata.VDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VDiff not implemented")
+}
 func (UnimplementedVtctldServer) Validate(context.Context, *vtctldata.ValidateRequest) (*vtctldata.ValidateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
 }
@@ -1396,6 +1615,24 @@ func _Vtctld_ApplyVSchema_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_CancelWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.CancelWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).CancelWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/CancelWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).CancelWorkflow(ctx, req.(*vtctldata.CancelWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_ChangeTabletType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.ChangeTabletTypeRequest)
 	if err := dec(in); err != nil {
@@ -1414,6 +1651,24 @@ func _Vtctld_ChangeTabletType_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_CompleteWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.CompleteWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).CompleteWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/CompleteWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).CompleteWorkflow(ctx, req.(*vtctldata.CompleteWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_CreateKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.CreateKeyspaceRequest)
 	if err := dec(in); err != nil {
@@ -1432,6 +1687,24 @@ func _Vtctld_CreateKeyspace_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_CreateLookupVindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.CreateLookupVindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).CreateLookupVindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/CreateLookupVindex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).CreateLookupVindex(ctx, req.(*vtctldata.CreateLookupVindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_CreateShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.CreateShardRequest)
 	if err := dec(in); err != nil {
@@ -1936,6 +2209,42 @@ func _Vtctld_InitShardPrimary_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_Materialize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.MaterializeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).Materialize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/Materialize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).Materialize(ctx, req.(*vtctldata.MaterializeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_MoveTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.MoveTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).MoveTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/MoveTables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).MoveTables(ctx, req.(*vtctldata.MoveTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_PingTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.PingTabletRequest)
 	if err := dec(in); err != nil {
@@ -2152,6 +2461,63 @@ func _Vtctld_ReparentTablet_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_Reshard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ReshardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).Reshard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/Reshard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).Reshard(ctx, req.(*vtctldata.ReshardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RestoreFromBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.RestoreFromBackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).RestoreFromBackup(m, &vtctldRestoreFromBackupServer{stream})
+}
+
+type Vtctld_RestoreFromBackupServer interface {
+	Send(*vtctldata.RestoreFromBackupResponse) error
+	grpc.ServerStream
+}
+
+type vtctldRestoreFromBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldRestoreFromBackupServer) Send(m *vtctldata.RestoreFromBackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vtctld_ReverseTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ReverseTrafficRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ReverseTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ReverseTraffic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ReverseTraffic(ctx, req.(*vtctldata.ReverseTrafficRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_RunHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.RunHealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -2206,6 +2572,24 @@ func _Vtctld_SetKeyspaceShardingInfo_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_SetKeyspaceThrottlerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SetKeyspaceThrottlerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SetKeyspaceThrottlerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SetKeyspaceThrottlerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SetKeyspaceThrottlerConfig(ctx, req.(*vtctldata.SetKeyspaceThrottlerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_SetShardIsPrimaryServing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.SetShardIsPrimaryServingRequest)
 	if err := dec(in); err != nil {
@@ -2332,6 +2716,24 @@ func _Vtctld_StopReplication_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_SwitchTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SwitchTrafficRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SwitchTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SwitchTraffic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SwitchTraffic(ctx, req.(*vtctldata.SwitchTrafficRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_TabletExternallyReparented_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.TabletExternallyReparentedRequest)
 	if err := dec(in); err != nil {
@@ -2386,6 +2788,24 @@ func _Vtctld_UpdateCellsAlias_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_VDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.VDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).VDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/VDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).VDiff(ctx, req.(*vtctldata.VDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.ValidateRequest)
 	if err := dec(in); err != nil {
@@ -2463,14 +2883,26 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyVSchema",
 			Handler:    _Vtctld_ApplyVSchema_Handler,
 		},
+		{
+			MethodName: "CancelWorkflow",
+			Handler:    _Vtctld_CancelWorkflow_Handler,
+		},
 		{
 			MethodName: "ChangeTabletType",
 			Handler:    _Vtctld_ChangeTabletType_Handler,
 		},
+		{
+			MethodName: "CompleteWorkflow",
+			Handler:    _Vtctld_CompleteWorkflow_Handler,
+		},
 		{
 			MethodName: "CreateKeyspace",
 			Handler:    _Vtctld_CreateKeyspace_Handler,
 		},
+		{
+			MethodName: "CreateLookupVindex",
+			Handler:    _Vtctld_CreateLookupVindex_Handler,
+		},
 		{
 			MethodName: "CreateShard",
 			Handler:    _Vtctld_CreateShard_Handler,
@@ -2583,6 +3015,14 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InitShardPrimary",
 			Handler:    _Vtctld_InitShardPrimary_Handler,
 		},
+		{
+			MethodName: "Materialize",
+			Handler:    _Vtctld_Materialize_Handler,
+		},
+		{
+			MethodName: "MoveTables",
+			Handler:    _Vtctld_MoveTables_Handler,
+		},
 		{
 			MethodName: "PingTablet",
 			Handler:    _Vtctld_PingTablet_Handler,
@@ -2631,6 +3071,14 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReparentTablet",
 			Handler:    _Vtctld_ReparentTablet_Handler,
 		},
+		{
+			MethodName: "Reshard",
+			Handler:    _Vtctld_Reshard_Handler,
+		},
+		{
+			MethodName: "ReverseTraffic",
+			Handler:    _Vtctld_ReverseTraffic_Handler,
+		},
 		{
 			MethodName: "RunHealthCheck",
 			Handler:    _Vtctld_RunHealthCheck_Handler,
@@ -2643,6 +3091,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetKeyspaceShardingInfo",
 			Handler:    _Vtctld_SetKeyspaceShardingInfo_Handler,
 		},
+		{
+			MethodName: "SetKeyspaceThrottlerConfig",
+			Handler:    _Vtctld_SetKeyspaceThrottlerConfig_Handler,
+		},
 		{
 			MethodName: "SetShardIsPrimaryServing",
 			Handler:    _Vtctld_SetShardIsPrimaryServing_Handler,
@@ -2671,6 +3123,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopReplication",
 			Handler:    _Vtctld_StopReplication_Handler,
 		},
+		{
+			MethodName: "SwitchTraffic",
+			Handler:    _Vtctld_SwitchTraffic_Handler,
+		},
 		{
 			MethodName: "TabletExternallyReparented",
 			Handler:    _Vtctld_TabletExternallyReparented_Handler,
@@ -2683,6 +3139,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCellsAlias",
 			Handler:    _Vtctld_UpdateCellsAlias_Handler,
 		},
+		{
+			MethodName: "VDiff",
+			Handler:    _Vtctld_VDiff_Handler,
+		},
 		{
 			MethodName: "Validate",
 			Handler:    _Vtctld_Validate_Handler,
@@ -2696,6 +3156,12 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Vtctld_ValidateShard_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RestoreFromBackup",
+			Handler:       _Vtctld_RestoreFromBackup_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "vtctlservice.proto",
 }
@@ -126,7 +126,7 @@ type (
 
 	// With contains the lists of common table expression and specifies if it is recursive or not
 	With struct {
-		ctes      []*CommonTableExpr
+		Ctes      []*CommonTableExpr
 		Recursive bool
 	}
 
@@ -227,6 +227,7 @@ type (
 		With        *With
 		GroupBy     GroupBy
 		Having      *Where
+		Windows     WindowDefinitions
 		OrderBy     OrderBy
 		Limit       *Limit
 		Lock        Lock
@@ -1952,6 +1953,7 @@ type (
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *OverClause
 	}
 
 	// GroupConcatExpr represents a call to GROUP_CONCAT
@@ -1963,6 +1965,17 @@ type (
 		Limit     *Limit
 	}
 
+	// WindowFuncExpr represents a call to one of the dedicated window functions,
+	// like ROW_NUMBER(), RANK() or LAG(). Aggregate functions used as window
+	// functions are represented by a FuncExpr with an OVER clause instead.
+	WindowFuncExpr struct {
+		Name        string
+		Exprs       Exprs
+		FromLast    bool
+		IgnoreNulls bool
+		Over        *OverClause
+	}
+
 	// ValuesFuncExpr represents a function call.
 	ValuesFuncExpr struct {
 		Name *ColName
@@ -2076,6 +2089,7 @@ func (*SubstrExpr) iExpr()        {}
 func (*ConvertUsingExpr) iExpr()  {}
 func (*MatchExpr) iExpr()         {}
 func (*GroupConcatExpr) iExpr()   {}
+func (*WindowFuncExpr) iExpr()    {}
 func (*Default) iExpr()           {}
 func (*ExtractedSubquery) iExpr() {}
 
@@ -2119,6 +2133,51 @@ type Limit struct {
 	Offset, Rowcount Expr
 }
 
+// OverClause represents the OVER clause of a window function call.
+// It either references a named window or specifies the window inline.
+type OverClause struct {
+	WindowName ColIdent
+	WindowSpec *WindowSpecification
+}
+
+// WindowSpecification represents the partitioning, ordering and framing
+// of a window. Name references a named window the specification builds upon.
+type WindowSpecification struct {
+	Name            ColIdent
+	PartitionClause Exprs
+	OrderClause     OrderBy
+	FrameClause     *FrameClause
+}
+
+// FrameClause represents the frame of a window specification.
+// End is nil when the frame is specified by its start point only.
+type FrameClause struct {
+	Unit  FrameUnitType
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FrameUnitType is an enum for FrameClause.Unit
+type FrameUnitType int8
+
+// FramePoint represents the start or end point of a window frame.
+type FramePoint struct {
+	Type FramePointType
+	Expr Expr
+}
+
+// FramePointType is an enum for FramePoint.Type
+type FramePointType int8
+
+// WindowDefinition represents a named window in the WINDOW clause.
+type WindowDefinition struct {
+	Name       ColIdent
+	WindowSpec *WindowSpecification
+}
+
+// WindowDefinitions represents the WINDOW clause.
+type WindowDefinitions []*WindowDefinition
+
 // Values represents a VALUES clause.
 type Values []ValTuple
 
@@ -64,6 +64,8 @@ func (node *Select) formatFast(buf *TrackedBuffer) {
 
 	node.Having.formatFast(buf)
 
+	node.Windows.formatFast(buf)
+
 	node.OrderBy.formatFast(buf)
 
 	node.Limit.formatFast(buf)
@@ -194,12 +196,12 @@ func (node *With) formatFast(buf *TrackedBuffer) {
 	if node.Recursive {
 		buf.WriteString("recursive ")
 	}
-	ctesLength := len(node.ctes)
+	ctesLength := len(node.Ctes)
 	for i := 0; i < ctesLength-1; i++ {
-		node.ctes[i].formatFast(buf)
+		node.Ctes[i].formatFast(buf)
 		buf.WriteString(", ")
 	}
-	node.ctes[ctesLength-1].formatFast(buf)
+	node.Ctes[ctesLength-1].formatFast(buf)
 }
 
 // formatFast formats the node.
@@ -1660,6 +1662,111 @@ func (node *FuncExpr) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(distinct)
 	node.Exprs.formatFast(buf)
 	buf.WriteByte(')')
+	node.Over.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *WindowFuncExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Name)
+	buf.WriteByte('(')
+	node.Exprs.formatFast(buf)
+	buf.WriteByte(')')
+	if node.FromLast {
+		buf.WriteString(" from last")
+	}
+	if node.IgnoreNulls {
+		buf.WriteString(" ignore nulls")
+	}
+	node.Over.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *OverClause) formatFast(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	if node.WindowSpec == nil {
+		buf.WriteString(" over ")
+		node.WindowName.formatFast(buf)
+		return
+	}
+	buf.WriteString(" over (")
+	node.WindowSpec.formatFast(buf)
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node.
+func (node *WindowSpecification) formatFast(buf *TrackedBuffer) {
+	prefix := ""
+	if !node.Name.IsEmpty() {
+		node.Name.formatFast(buf)
+		prefix = " "
+	}
+	if len(node.PartitionClause) > 0 {
+		buf.WriteString(prefix)
+		buf.WriteString("partition by ")
+		node.PartitionClause.formatFast(buf)
+		prefix = " "
+	}
+	if len(node.OrderClause) > 0 {
+		buf.WriteString(prefix)
+		buf.WriteString("order by ")
+		for i, order := range node.OrderClause {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			order.formatFast(buf)
+		}
+		prefix = " "
+	}
+	if node.FrameClause != nil {
+		buf.WriteString(prefix)
+		node.FrameClause.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *FrameClause) formatFast(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.WriteString(node.Unit.ToString())
+		buf.WriteByte(' ')
+		node.Start.formatFast(buf)
+		return
+	}
+	buf.WriteString(node.Unit.ToString())
+	buf.WriteString(" between ")
+	node.Start.formatFast(buf)
+	buf.WriteString(" and ")
+	node.End.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *FramePoint) formatFast(buf *TrackedBuffer) {
+	switch node.Type {
+	case ExprPrecedingType, ExprFollowingType:
+		node.Expr.formatFast(buf)
+		buf.WriteString(node.Type.ToString())
+	default:
+		buf.WriteString(node.Type.ToString())
+	}
+}
+
+// formatFast formats the node.
+func (node WindowDefinitions) formatFast(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = ", "
+	}
+}
+
+// formatFast formats the node.
+func (node *WindowDefinition) formatFast(buf *TrackedBuffer) {
+	node.Name.formatFast(buf)
+	buf.WriteString(" as (")
+	node.WindowSpec.formatFast(buf)
+	buf.WriteByte(')')
 }
 
 // formatFast formats the node
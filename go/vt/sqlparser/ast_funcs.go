@@ -519,27 +519,43 @@ func (node *ColName) Equal(c *ColName) bool {
 
 // Aggregates is a map of all aggregate functions.
 var Aggregates = map[string]bool{
-	"avg":          true,
-	"bit_and":      true,
-	"bit_or":       true,
-	"bit_xor":      true,
-	"count":        true,
-	"group_concat": true,
-	"max":          true,
-	"min":          true,
-	"std":          true,
-	"stddev_pop":   true,
-	"stddev_samp":  true,
-	"stddev":       true,
-	"sum":          true,
-	"var_pop":      true,
-	"var_samp":     true,
-	"variance":     true,
+	"avg":            true,
+	"bit_and":        true,
+	"bit_or":         true,
+	"bit_xor":        true,
+	"count":          true,
+	"group_concat":   true,
+	"json_arrayagg":  true,
+	"json_objectagg": true,
+	"max":            true,
+	"min":            true,
+	"std":            true,
+	"stddev_pop":     true,
+	"stddev_samp":    true,
+	"stddev":         true,
+	"sum":            true,
+	"var_pop":        true,
+	"var_samp":       true,
+	"variance":       true,
 }
 
 // IsAggregate returns true if the function is an aggregate.
+// An aggregate function used with an OVER clause is a window function, not an aggregate.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
+}
+
+// SeparatorString returns the string that separates the values concatenated
+// by GROUP_CONCAT. Like in MySQL, it is a comma when no separator is specified.
+func (node *GroupConcatExpr) SeparatorString() string {
+	if node.Separator == "" {
+		return ","
+	}
+	tkn := NewStringTokenizer(strings.TrimPrefix(node.Separator, " separator "))
+	if typ, val := tkn.Scan(); typ == STRING {
+		return val
+	}
+	return ","
 }
 
 // NewColIdent makes a new ColIdent.
@@ -1167,6 +1183,36 @@ func (option MatchExprOption) ToString() string {
 	}
 }
 
+// ToString returns the unit as a string
+func (unit FrameUnitType) ToString() string {
+	switch unit {
+	case RowsUnit:
+		return RowsUnitStr
+	case RangeUnit:
+		return RangeUnitStr
+	default:
+		return "Unknown FrameUnitType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty FramePointType) ToString() string {
+	switch ty {
+	case CurrentRowType:
+		return CurrentRowStr
+	case UnboundedPrecedingType:
+		return UnboundedPrecedingStr
+	case UnboundedFollowingType:
+		return UnboundedFollowingStr
+	case ExprPrecedingType:
+		return ExprPrecedingStr
+	case ExprFollowingType:
+		return ExprFollowingStr
+	default:
+		return "Unknown FramePointType"
+	}
+}
+
 // ToString returns the direction as a string
 func (dir OrderDirection) ToString() string {
 	switch dir {
@@ -1453,7 +1499,8 @@ func handleUnaryMinus(expr Expr) Expr {
 			num.Val = num.Val[1:]
 			return num
 		}
-		return NewIntLiteral("-" + num.Val)
+		num.Val = "-" + num.Val
+		return num
 	}
 	if unaryExpr, ok := expr.(*UnaryExpr); ok && unaryExpr.Operator == UMinusOp {
 		return unaryExpr.Expr
@@ -1478,10 +1525,15 @@ func ToString(exprs []TableExpr) string {
 	return buf.String()
 }
 
-// ContainsAggregation returns true if the expression contains aggregation
+// ContainsAggregation returns true if the expression contains aggregation.
+// Aggregations inside of subqueries are not considered.
 func ContainsAggregation(e SQLNode) bool {
 	hasAggregates := false
 	_ = Walk(func(node SQLNode) (kontinue bool, err error) {
+		switch node.(type) {
+		case *Subquery:
+			return false, nil
+		}
 		if IsAggregation(node) {
 			hasAggregates = true
 			return false, nil
@@ -1502,6 +1554,34 @@ func IsAggregation(node SQLNode) bool {
 	return false
 }
 
+// ContainsWindowFunc returns true if the expression contains a window function
+func ContainsWindowFunc(e SQLNode) bool {
+	hasWindowFunc := false
+	_ = Walk(func(node SQLNode) (kontinue bool, err error) {
+		switch node.(type) {
+		case *Subquery:
+			return false, nil
+		}
+		if IsWindowFunc(node) {
+			hasWindowFunc = true
+			return false, nil
+		}
+		return true, nil
+	}, e)
+	return hasWindowFunc
+}
+
+// IsWindowFunc returns true if the node is a window function expression
+func IsWindowFunc(node SQLNode) bool {
+	switch node := node.(type) {
+	case *FuncExpr:
+		return node.Over != nil
+	case *WindowFuncExpr:
+		return true
+	}
+	return false
+}
+
 // GetFirstSelect gets the first select statement
 func GetFirstSelect(selStmt SelectStatement) *Select {
 	switch node := selStmt.(type) {
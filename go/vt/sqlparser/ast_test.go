@@ -393,6 +393,26 @@ func TestIsAggregate(t *testing.T) {
 	}
 }
 
+func TestGroupConcatSeparator(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{"select group_concat(a) from t", ","},
+		{"select group_concat(a order by b separator ';') from t", ";"},
+		{"select group_concat(a separator '') from t", ""},
+		{`select group_concat(a separator 'it''s\n') from t`, "it's\n"},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			stmt, err := Parse(tc.in)
+			require.NoError(t, err)
+			expr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr.(*GroupConcatExpr)
+			assert.Equal(t, tc.out, expr.SeparatorString())
+		})
+	}
+}
+
 func TestIsImpossible(t *testing.T) {
 	f := ComparisonExpr{
 		Operator: NotEqualOp,
@@ -41,6 +41,10 @@ const (
 	DirectiveAllowScatter = "ALLOW_SCATTER"
 	// DirectiveAllowHashJoin lets the planner use hash join if possible
 	DirectiveAllowHashJoin = "ALLOW_HASH_JOIN"
+	// DirectiveWorkloadClass names the vttablet workload class of the query.
+	DirectiveWorkloadClass = "WORKLOAD_CLASS"
+	// DirectiveCacheResult lets vttablet replicas cache the result of the query.
+	DirectiveCacheResult = "CACHE_RESULT"
 )
 
 func isNonSpace(r rune) bool {
@@ -347,6 +351,33 @@ func SkipQueryPlanCacheDirective(stmt Statement) bool {
 	return false
 }
 
+// WorkloadClassDirective returns the workload class named in the query, if any.
+func WorkloadClassDirective(stmt Statement) string {
+	var comments Comments
+	switch stmt := stmt.(type) {
+	case *Select:
+		comments = stmt.Comments
+	case *Insert:
+		comments = stmt.Comments
+	case *Update:
+		comments = stmt.Comments
+	case *Delete:
+		comments = stmt.Comments
+	default:
+		return ""
+	}
+	return ExtractCommentDirectives(comments).GetString(DirectiveWorkloadClass, "")
+}
+
+// CacheResultDirective returns true if the cache result directive is set to true in query.
+func CacheResultDirective(stmt Statement) bool {
+	sel, ok := stmt.(*Select)
+	if !ok {
+		return false
+	}
+	return ExtractCommentDirectives(sel.Comments).IsSet(DirectiveCacheResult)
+}
+
 // IgnoreMaxPayloadSizeDirective returns true if the max payload size override
 // directive is set to true.
 func IgnoreMaxPayloadSizeDirective(stmt Statement) bool {
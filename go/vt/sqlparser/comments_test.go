@@ -418,6 +418,49 @@ func TestSkipQueryPlanCacheDirective(t *testing.T) {
 	}
 }
 
+func TestWorkloadClassDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{"select /*vt+ WORKLOAD_CLASS=reporting */ * from users", "reporting"},
+		{"select * from users", ""},
+		{"insert /*vt+ WORKLOAD_CLASS=batch */ into user(id) values (1), (2)", "batch"},
+		{"update /*vt+ WORKLOAD_CLASS=batch */ users set name=1", "batch"},
+		{"delete /*vt+ WORKLOAD_CLASS=batch */ from users", "batch"},
+		{"show /*vt+ WORKLOAD_CLASS=batch */ create table users", ""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, _ := Parse(test.query)
+			got := WorkloadClassDirective(stmt)
+			assert.Equalf(t, test.expected, got, fmt.Sprintf("WorkloadClassDirective(stmt) returned %v but expected %v", got, test.expected))
+		})
+	}
+}
+
+func TestCacheResultDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected bool
+	}{
+		{"select /*vt+ CACHE_RESULT */ * from users", true},
+		{"select /*vt+ CACHE_RESULT=1 */ * from users", true},
+		{"select /*vt+ CACHE_RESULT=0 */ * from users", false},
+		{"select * from users", false},
+		{"update /*vt+ CACHE_RESULT */ users set name=1", false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, _ := Parse(test.query)
+			got := CacheResultDirective(stmt)
+			assert.Equalf(t, test.expected, got, fmt.Sprintf("CacheResultDirective(stmt) returned %v but expected %v", got, test.expected))
+		})
+	}
+}
+
 func TestIgnoreMaxPayloadSizeDirective(t *testing.T) {
 	testCases := []struct {
 		query    string
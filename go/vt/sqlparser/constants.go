@@ -208,6 +208,17 @@ const (
 	NaturalLanguageModeWithQueryExpansionStr = " in natural language mode with query expansion"
 	QueryExpansionStr                        = " with query expansion"
 
+	// FrameClause.Unit
+	RowsUnitStr  = "rows"
+	RangeUnitStr = "range"
+
+	// FramePoint.Type
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	ExprPrecedingStr      = " preceding"
+	ExprFollowingStr      = " following"
+
 	// INTO OUTFILE
 	IntoOutfileStr   = " into outfile "
 	IntoOutfileS3Str = " into outfile s3 "
@@ -451,6 +462,21 @@ const (
 	DescOrder
 )
 
+// Constant for Enum Type - FrameUnitType
+const (
+	RowsUnit FrameUnitType = iota
+	RangeUnit
+)
+
+// Constant for Enum Type - FramePointType
+const (
+	CurrentRowType FramePointType = iota
+	UnboundedPrecedingType
+	UnboundedFollowingType
+	ExprPrecedingType
+	ExprFollowingType
+)
+
 // Constant for Enum Type - ConvertTypeOperator
 const (
 	NoOperator ConvertTypeOperator = iota
@@ -173,7 +173,8 @@ var keywords = []keyword{
 	{"continue", UNUSED},
 	{"convert", CONVERT},
 	{"copy", COPY},
-	{"cume_dist", UNUSED},
+	{"cume_dist", CUME_DIST},
+	{"current", CURRENT},
 	{"substr", SUBSTRING},
 	{"subpartition", SUBPARTITION},
 	{"subpartitions", SUBPARTITIONS},
@@ -204,7 +205,7 @@ var keywords = []keyword{
 	{"delay_key_write", DELAY_KEY_WRITE},
 	{"delayed", UNUSED},
 	{"delete", DELETE},
-	{"dense_rank", UNUSED},
+	{"dense_rank", DENSE_RANK},
 	{"desc", DESC},
 	{"describe", DESCRIBE},
 	{"deterministic", UNUSED},
@@ -250,12 +251,13 @@ var keywords = []keyword{
 	{"fetch", UNUSED},
 	{"fields", FIELDS},
 	{"first", FIRST},
-	{"first_value", UNUSED},
+	{"first_value", FIRST_VALUE},
 	{"fixed", FIXED},
 	{"float", FLOAT_TYPE},
 	{"float4", UNUSED},
 	{"float8", UNUSED},
 	{"flush", FLUSH},
+	{"following", FOLLOWING},
 	{"for", FOR},
 	{"force", FORCE},
 	{"foreign", FOREIGN},
@@ -320,13 +322,13 @@ var keywords = []keyword{
 	{"keyspaces", KEYSPACES},
 	{"key_block_size", KEY_BLOCK_SIZE},
 	{"kill", UNUSED},
-	{"lag", UNUSED},
+	{"lag", LAG},
 	{"language", LANGUAGE},
 	{"last", LAST},
-	{"last_value", UNUSED},
+	{"last_value", LAST_VALUE},
 	{"last_insert_id", LAST_INSERT_ID},
 	{"lateral", UNUSED},
-	{"lead", UNUSED},
+	{"lead", LEAD},
 	{"leading", UNUSED},
 	{"leave", UNUSED},
 	{"left", LEFT},
@@ -383,9 +385,10 @@ var keywords = []keyword{
 	{"none", NONE},
 	{"not", NOT},
 	{"no_write_to_binlog", NO_WRITE_TO_BINLOG},
-	{"nth_value", UNUSED},
-	{"ntile", UNUSED},
+	{"nth_value", NTH_VALUE},
+	{"ntile", NTILE},
 	{"null", NULL},
+	{"nulls", NULLS},
 	{"numeric", NUMERIC},
 	{"of", UNUSED},
 	{"off", OFF},
@@ -402,7 +405,7 @@ var keywords = []keyword{
 	{"out", UNUSED},
 	{"outer", OUTER},
 	{"outfile", OUTFILE},
-	{"over", UNUSED},
+	{"over", OVER},
 	{"overwrite", OVERWRITE},
 	{"pack_keys", PACK_KEYS},
 	{"parser", PARSER},
@@ -410,10 +413,11 @@ var keywords = []keyword{
 	{"partitions", PARTITIONS},
 	{"partitioning", PARTITIONING},
 	{"password", PASSWORD},
-	{"percent_rank", UNUSED},
+	{"percent_rank", PERCENT_RANK},
 	{"plugins", PLUGINS},
 	{"point", POINT},
 	{"polygon", POLYGON},
+	{"preceding", PRECEDING},
 	{"precision", UNUSED},
 	{"primary", PRIMARY},
 	{"privileges", PRIVILEGES},
@@ -422,7 +426,7 @@ var keywords = []keyword{
 	{"query", QUERY},
 	{"range", RANGE},
 	{"quarter", QUARTER},
-	{"rank", UNUSED},
+	{"rank", RANK},
 	{"read", READ},
 	{"reads", UNUSED},
 	{"read_write", UNUSED},
@@ -443,6 +447,7 @@ var keywords = []keyword{
 	{"replace", REPLACE},
 	{"require", UNUSED},
 	{"resignal", UNUSED},
+	{"respect", RESPECT},
 	{"restrict", RESTRICT},
 	{"return", UNUSED},
 	{"retry", RETRY},
@@ -451,10 +456,10 @@ var keywords = []keyword{
 	{"right", RIGHT},
 	{"rlike", REGEXP},
 	{"rollback", ROLLBACK},
-	{"row", UNUSED},
+	{"row", ROW},
 	{"row_format", ROW_FORMAT},
-	{"row_number", UNUSED},
-	{"rows", UNUSED},
+	{"row_number", ROW_NUMBER},
+	{"rows", ROWS},
 	{"s3", S3},
 	{"savepoint", SAVEPOINT},
 	{"schema", SCHEMA},
@@ -524,6 +529,7 @@ var keywords = []keyword{
 	{"triggers", TRIGGERS},
 	{"true", TRUE},
 	{"truncate", TRUNCATE},
+	{"unbounded", UNBOUNDED},
 	{"uncommitted", UNCOMMITTED},
 	{"undefined", UNDEFINED},
 	{"undo", UNUSED},
@@ -566,7 +572,7 @@ var keywords = []keyword{
 	{"when", WHEN},
 	{"where", WHERE},
 	{"while", UNUSED},
-	{"window", UNUSED},
+	{"window", WINDOW},
 	{"with", WITH},
 	{"without", WITHOUT},
 	{"work", WORK},
@@ -2037,6 +2037,29 @@ var (
 	}, {
 		input:  "select name, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by name",
 		output: "select `name`, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by `name`",
+	}, {
+		input:  "select a, row_number() over (partition by b order by c) from t",
+		output: "select a, row_number() over (partition by b order by c asc) from t",
+	}, {
+		input: "select rank() over (), dense_rank() over (), cume_dist() over (), percent_rank() over (), ntile(4) over () from t",
+	}, {
+		input:  "select lag(a) over w, lead(a, 2, 0) respect nulls over w, first_value(a) ignore nulls over w from t window w as (partition by b order by c desc)",
+		output: "select lag(a) over w, lead(a, 2, 0) over w, first_value(a) ignore nulls over w from t window w as (partition by b order by c desc)",
+	}, {
+		input:  "select nth_value(a, 2) from first over (order by b), last_value(a) over (order by b), nth_value(a, 2) from last over (order by b) from t",
+		output: "select nth_value(a, 2) over (order by b asc), last_value(a) over (order by b asc), nth_value(a, 2) from last over (order by b asc) from t",
+	}, {
+		input:  "select sum(b) over (w order by c rows between unbounded preceding and current row), count(*) over (rows 2 preceding) from t window w as (partition by a)",
+		output: "select sum(b) over (w order by c asc rows between unbounded preceding and current row), count(*) over (rows 2 preceding) from t window w as (partition by a)",
+	}, {
+		input:  "select avg(b) over (order by d range between interval 1 day preceding and interval :n day following) from t",
+		output: "select avg(b) over (order by d asc range between interval 1 day preceding and interval :n day following) from t",
+	}, {
+		input:  "select max(b) over (partition by a rows between 1 preceding and unbounded following) from t window w1 as (), w2 as (w1 partition by a) order by a",
+		output: "select max(b) over (partition by a rows between 1 preceding and unbounded following) from t window w1 as (), w2 as (w1 partition by a) order by a asc",
+	}, {
+		input:  "select rank, row_number, row(1, 2) from t",
+		output: "select `rank`, `row_number`, row(1, 2) from t",
 	}, {
 		input: "select * from t partition (p0)",
 	}, {
@@ -3490,6 +3513,12 @@ var (
 	}{{
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
+	}, {
+		input:  "select row_number() from t",
+		output: "syntax error at position 25 near 'from'",
+	}, {
+		input:  "select sum(a) over (rows between unbounded preceding) from t",
+		output: "syntax error at position 54",
 	}, {
 		input:  "select 0xH from t",
 		output: "syntax error at position 10 near '0x'",
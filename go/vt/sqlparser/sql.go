@@ -123,457 +123,460 @@ const NONE = 57420
 const SHARED = 57421
 const EXCLUSIVE = 57422
 const SUBQUERY_AS_EXPR = 57423
-const ID = 57424
-const AT_ID = 57425
-const AT_AT_ID = 57426
-const HEX = 57427
-const STRING = 57428
-const NCHAR_STRING = 57429
-const INTEGRAL = 57430
-const FLOAT = 57431
-const HEXNUM = 57432
-const VALUE_ARG = 57433
-const LIST_ARG = 57434
-const COMMENT = 57435
-const COMMENT_KEYWORD = 57436
-const BIT_LITERAL = 57437
-const COMPRESSION = 57438
-const EXTRACT = 57439
-const NULL = 57440
-const TRUE = 57441
-const FALSE = 57442
-const OFF = 57443
-const DISCARD = 57444
-const IMPORT = 57445
-const ENABLE = 57446
-const DISABLE = 57447
-const TABLESPACE = 57448
-const VIRTUAL = 57449
-const STORED = 57450
-const EMPTY_FROM_CLAUSE = 57451
-const LOWER_THAN_CHARSET = 57452
-const CHARSET = 57453
-const UNIQUE = 57454
-const KEY = 57455
-const EXPRESSION_PREC_SETTER = 57456
-const OR = 57457
-const XOR = 57458
-const AND = 57459
-const NOT = 57460
-const BETWEEN = 57461
-const CASE = 57462
-const WHEN = 57463
-const THEN = 57464
-const ELSE = 57465
-const END = 57466
-const LE = 57467
-const GE = 57468
-const NE = 57469
-const NULL_SAFE_EQUAL = 57470
-const IS = 57471
-const LIKE = 57472
-const REGEXP = 57473
-const IN = 57474
-const SHIFT_LEFT = 57475
-const SHIFT_RIGHT = 57476
-const DIV = 57477
-const MOD = 57478
-const UNARY = 57479
-const COLLATE = 57480
-const BINARY = 57481
-const UNDERSCORE_ARMSCII8 = 57482
-const UNDERSCORE_ASCII = 57483
-const UNDERSCORE_BIG5 = 57484
-const UNDERSCORE_BINARY = 57485
-const UNDERSCORE_CP1250 = 57486
-const UNDERSCORE_CP1251 = 57487
-const UNDERSCORE_CP1256 = 57488
-const UNDERSCORE_CP1257 = 57489
-const UNDERSCORE_CP850 = 57490
-const UNDERSCORE_CP852 = 57491
-const UNDERSCORE_CP866 = 57492
-const UNDERSCORE_CP932 = 57493
-const UNDERSCORE_DEC8 = 57494
-const UNDERSCORE_EUCJPMS = 57495
-const UNDERSCORE_EUCKR = 57496
-const UNDERSCORE_GB18030 = 57497
-const UNDERSCORE_GB2312 = 57498
-const UNDERSCORE_GBK = 57499
-const UNDERSCORE_GEOSTD8 = 57500
-const UNDERSCORE_GREEK = 57501
-const UNDERSCORE_HEBREW = 57502
-const UNDERSCORE_HP8 = 57503
-const UNDERSCORE_KEYBCS2 = 57504
-const UNDERSCORE_KOI8R = 57505
-const UNDERSCORE_KOI8U = 57506
-const UNDERSCORE_LATIN1 = 57507
-const UNDERSCORE_LATIN2 = 57508
-const UNDERSCORE_LATIN5 = 57509
-const UNDERSCORE_LATIN7 = 57510
-const UNDERSCORE_MACCE = 57511
-const UNDERSCORE_MACROMAN = 57512
-const UNDERSCORE_SJIS = 57513
-const UNDERSCORE_SWE7 = 57514
-const UNDERSCORE_TIS620 = 57515
-const UNDERSCORE_UCS2 = 57516
-const UNDERSCORE_UJIS = 57517
-const UNDERSCORE_UTF16 = 57518
-const UNDERSCORE_UTF16LE = 57519
-const UNDERSCORE_UTF32 = 57520
-const UNDERSCORE_UTF8 = 57521
-const UNDERSCORE_UTF8MB4 = 57522
-const INTERVAL = 57523
-const JSON_EXTRACT_OP = 57524
-const JSON_UNQUOTE_EXTRACT_OP = 57525
-const CREATE = 57526
-const ALTER = 57527
-const DROP = 57528
-const RENAME = 57529
-const ANALYZE = 57530
-const ADD = 57531
-const FLUSH = 57532
-const CHANGE = 57533
-const MODIFY = 57534
-const REVERT = 57535
-const SCHEMA = 57536
-const TABLE = 57537
-const INDEX = 57538
-const VIEW = 57539
-const TO = 57540
-const IGNORE = 57541
-const IF = 57542
-const PRIMARY = 57543
-const COLUMN = 57544
-const SPATIAL = 57545
-const FULLTEXT = 57546
-const KEY_BLOCK_SIZE = 57547
-const CHECK = 57548
-const INDEXES = 57549
-const ACTION = 57550
-const CASCADE = 57551
-const CONSTRAINT = 57552
-const FOREIGN = 57553
-const NO = 57554
-const REFERENCES = 57555
-const RESTRICT = 57556
-const SHOW = 57557
-const DESCRIBE = 57558
-const EXPLAIN = 57559
-const DATE = 57560
-const ESCAPE = 57561
-const REPAIR = 57562
-const OPTIMIZE = 57563
-const TRUNCATE = 57564
-const COALESCE = 57565
-const EXCHANGE = 57566
-const REBUILD = 57567
-const PARTITIONING = 57568
-const REMOVE = 57569
-const MAXVALUE = 57570
-const PARTITION = 57571
-const REORGANIZE = 57572
-const LESS = 57573
-const THAN = 57574
-const PROCEDURE = 57575
-const TRIGGER = 57576
-const VINDEX = 57577
-const VINDEXES = 57578
-const DIRECTORY = 57579
-const NAME = 57580
-const UPGRADE = 57581
-const STATUS = 57582
-const VARIABLES = 57583
-const WARNINGS = 57584
-const CASCADED = 57585
-const DEFINER = 57586
-const OPTION = 57587
-const SQL = 57588
-const UNDEFINED = 57589
-const SEQUENCE = 57590
-const MERGE = 57591
-const TEMPORARY = 57592
-const TEMPTABLE = 57593
-const INVOKER = 57594
-const SECURITY = 57595
-const FIRST = 57596
-const AFTER = 57597
-const LAST = 57598
-const VITESS_MIGRATION = 57599
-const CANCEL = 57600
-const RETRY = 57601
-const COMPLETE = 57602
-const CLEANUP = 57603
-const BEGIN = 57604
-const START = 57605
-const TRANSACTION = 57606
-const COMMIT = 57607
-const ROLLBACK = 57608
-const SAVEPOINT = 57609
-const RELEASE = 57610
-const WORK = 57611
-const BIT = 57612
-const TINYINT = 57613
-const SMALLINT = 57614
-const MEDIUMINT = 57615
-const INT = 57616
-const INTEGER = 57617
-const BIGINT = 57618
-const INTNUM = 57619
-const REAL = 57620
-const DOUBLE = 57621
-const FLOAT_TYPE = 57622
-const DECIMAL = 57623
-const NUMERIC = 57624
-const TIME = 57625
-const TIMESTAMP = 57626
-const DATETIME = 57627
-const YEAR = 57628
-const CHAR = 57629
-const VARCHAR = 57630
-const BOOL = 57631
-const CHARACTER = 57632
-const VARBINARY = 57633
-const NCHAR = 57634
-const TEXT = 57635
-const TINYTEXT = 57636
-const MEDIUMTEXT = 57637
-const LONGTEXT = 57638
-const BLOB = 57639
-const TINYBLOB = 57640
-const MEDIUMBLOB = 57641
-const LONGBLOB = 57642
-const JSON = 57643
-const ENUM = 57644
-const GEOMETRY = 57645
-const POINT = 57646
-const LINESTRING = 57647
-const POLYGON = 57648
-const GEOMETRYCOLLECTION = 57649
-const MULTIPOINT = 57650
-const MULTILINESTRING = 57651
-const MULTIPOLYGON = 57652
-const NULLX = 57653
-const AUTO_INCREMENT = 57654
-const APPROXNUM = 57655
-const SIGNED = 57656
-const UNSIGNED = 57657
-const ZEROFILL = 57658
-const CODE = 57659
-const COLLATION = 57660
-const COLUMNS = 57661
-const DATABASES = 57662
-const ENGINES = 57663
-const EVENT = 57664
-const EXTENDED = 57665
-const FIELDS = 57666
-const FULL = 57667
-const FUNCTION = 57668
-const GTID_EXECUTED = 57669
-const KEYSPACES = 57670
-const OPEN = 57671
-const PLUGINS = 57672
-const PRIVILEGES = 57673
-const PROCESSLIST = 57674
-const SCHEMAS = 57675
-const TABLES = 57676
-const TRIGGERS = 57677
-const USER = 57678
-const VGTID_EXECUTED = 57679
-const VITESS_KEYSPACES = 57680
-const VITESS_METADATA = 57681
-const VITESS_MIGRATIONS = 57682
-const VITESS_REPLICATION_STATUS = 57683
-const VITESS_SHARDS = 57684
-const VITESS_TABLETS = 57685
-const VSCHEMA = 57686
-const NAMES = 57687
-const GLOBAL = 57688
-const SESSION = 57689
-const ISOLATION = 57690
-const LEVEL = 57691
-const READ = 57692
-const WRITE = 57693
-const ONLY = 57694
-const REPEATABLE = 57695
-const COMMITTED = 57696
-const UNCOMMITTED = 57697
-const SERIALIZABLE = 57698
-const CURRENT_TIMESTAMP = 57699
-const DATABASE = 57700
-const CURRENT_DATE = 57701
-const CURRENT_TIME = 57702
-const LOCALTIME = 57703
-const LOCALTIMESTAMP = 57704
-const CURRENT_USER = 57705
-const UTC_DATE = 57706
-const UTC_TIME = 57707
-const UTC_TIMESTAMP = 57708
-const DAY = 57709
-const DAY_HOUR = 57710
-const DAY_MICROSECOND = 57711
-const DAY_MINUTE = 57712
-const DAY_SECOND = 57713
-const HOUR = 57714
-const HOUR_MICROSECOND = 57715
-const HOUR_MINUTE = 57716
-const HOUR_SECOND = 57717
-const MICROSECOND = 57718
-const MINUTE = 57719
-const MINUTE_MICROSECOND = 57720
-const MINUTE_SECOND = 57721
-const MONTH = 57722
-const QUARTER = 57723
-const SECOND = 57724
-const SECOND_MICROSECOND = 57725
-const YEAR_MONTH = 57726
-const WEEK = 57727
-const REPLACE = 57728
-const CONVERT = 57729
-const CAST = 57730
-const SUBSTR = 57731
-const SUBSTRING = 57732
-const GROUP_CONCAT = 57733
-const SEPARATOR = 57734
-const TIMESTAMPADD = 57735
-const TIMESTAMPDIFF = 57736
-const MATCH = 57737
-const AGAINST = 57738
-const BOOLEAN = 57739
-const LANGUAGE = 57740
-const WITH = 57741
-const QUERY = 57742
-const EXPANSION = 57743
-const WITHOUT = 57744
-const VALIDATION = 57745
-const UNUSED = 57746
-const ARRAY = 57747
-const CUME_DIST = 57748
-const DESCRIPTION = 57749
-const DENSE_RANK = 57750
-const EMPTY = 57751
-const EXCEPT = 57752
-const FIRST_VALUE = 57753
-const GROUPING = 57754
-const GROUPS = 57755
-const JSON_TABLE = 57756
-const LAG = 57757
-const LAST_VALUE = 57758
-const LATERAL = 57759
-const LEAD = 57760
-const MEMBER = 57761
-const NTH_VALUE = 57762
-const NTILE = 57763
-const OF = 57764
-const OVER = 57765
-const PERCENT_RANK = 57766
-const RANK = 57767
-const RECURSIVE = 57768
-const ROW_NUMBER = 57769
-const SYSTEM = 57770
-const WINDOW = 57771
-const ACTIVE = 57772
-const ADMIN = 57773
-const BUCKETS = 57774
-const CLONE = 57775
-const COMPONENT = 57776
-const DEFINITION = 57777
-const ENFORCED = 57778
-const EXCLUDE = 57779
-const FOLLOWING = 57780
-const GEOMCOLLECTION = 57781
-const GET_MASTER_PUBLIC_KEY = 57782
-const HISTOGRAM = 57783
-const HISTORY = 57784
-const INACTIVE = 57785
-const INVISIBLE = 57786
-const LOCKED = 57787
-const MASTER_COMPRESSION_ALGORITHMS = 57788
-const MASTER_PUBLIC_KEY_PATH = 57789
-const MASTER_TLS_CIPHERSUITES = 57790
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57791
-const NESTED = 57792
-const NETWORK_NAMESPACE = 57793
-const NOWAIT = 57794
-const NULLS = 57795
-const OJ = 57796
-const OLD = 57797
-const OPTIONAL = 57798
-const ORDINALITY = 57799
-const ORGANIZATION = 57800
-const OTHERS = 57801
-const PATH = 57802
-const PERSIST = 57803
-const PERSIST_ONLY = 57804
-const PRECEDING = 57805
-const PRIVILEGE_CHECKS_USER = 57806
-const PROCESS = 57807
-const RANDOM = 57808
-const REFERENCE = 57809
-const REQUIRE_ROW_FORMAT = 57810
-const RESOURCE = 57811
-const RESPECT = 57812
-const RESTART = 57813
-const RETAIN = 57814
-const REUSE = 57815
-const ROLE = 57816
-const SECONDARY = 57817
-const SECONDARY_ENGINE = 57818
-const SECONDARY_LOAD = 57819
-const SECONDARY_UNLOAD = 57820
-const SKIP = 57821
-const SRID = 57822
-const THREAD_PRIORITY = 57823
-const TIES = 57824
-const UNBOUNDED = 57825
-const VCPU = 57826
-const VISIBLE = 57827
-const FORMAT = 57828
-const TREE = 57829
-const VITESS = 57830
-const TRADITIONAL = 57831
-const LOCAL = 57832
-const LOW_PRIORITY = 57833
-const NO_WRITE_TO_BINLOG = 57834
-const LOGS = 57835
-const ERROR = 57836
-const GENERAL = 57837
-const HOSTS = 57838
-const OPTIMIZER_COSTS = 57839
-const USER_RESOURCES = 57840
-const SLOW = 57841
-const CHANNEL = 57842
-const RELAY = 57843
-const EXPORT = 57844
-const AVG_ROW_LENGTH = 57845
-const CONNECTION = 57846
-const CHECKSUM = 57847
-const DELAY_KEY_WRITE = 57848
-const ENCRYPTION = 57849
-const ENGINE = 57850
-const INSERT_METHOD = 57851
-const MAX_ROWS = 57852
-const MIN_ROWS = 57853
-const PACK_KEYS = 57854
-const PASSWORD = 57855
-const FIXED = 57856
-const DYNAMIC = 57857
-const COMPRESSED = 57858
-const REDUNDANT = 57859
-const COMPACT = 57860
-const ROW_FORMAT = 57861
-const STATS_AUTO_RECALC = 57862
-const STATS_PERSISTENT = 57863
-const STATS_SAMPLE_PAGES = 57864
-const STORAGE = 57865
-const MEMORY = 57866
-const DISK = 57867
-const PARTITIONS = 57868
-const LINEAR = 57869
-const RANGE = 57870
-const LIST = 57871
-const SUBPARTITION = 57872
-const SUBPARTITIONS = 57873
-const HASH = 57874
+const CUME_DIST = 57424
+const DENSE_RANK = 57425
+const FIRST_VALUE = 57426
+const LAG = 57427
+const LAST_VALUE = 57428
+const LEAD = 57429
+const NTH_VALUE = 57430
+const NTILE = 57431
+const PERCENT_RANK = 57432
+const RANK = 57433
+const ROW_NUMBER = 57434
+const ID = 57435
+const AT_ID = 57436
+const AT_AT_ID = 57437
+const HEX = 57438
+const STRING = 57439
+const NCHAR_STRING = 57440
+const INTEGRAL = 57441
+const FLOAT = 57442
+const HEXNUM = 57443
+const VALUE_ARG = 57444
+const LIST_ARG = 57445
+const COMMENT = 57446
+const COMMENT_KEYWORD = 57447
+const BIT_LITERAL = 57448
+const COMPRESSION = 57449
+const EXTRACT = 57450
+const NULL = 57451
+const TRUE = 57452
+const FALSE = 57453
+const OFF = 57454
+const DISCARD = 57455
+const IMPORT = 57456
+const ENABLE = 57457
+const DISABLE = 57458
+const TABLESPACE = 57459
+const VIRTUAL = 57460
+const STORED = 57461
+const EMPTY_FROM_CLAUSE = 57462
+const LOWER_THAN_CHARSET = 57463
+const CHARSET = 57464
+const UNIQUE = 57465
+const KEY = 57466
+const EXPRESSION_PREC_SETTER = 57467
+const OR = 57468
+const XOR = 57469
+const AND = 57470
+const NOT = 57471
+const BETWEEN = 57472
+const CASE = 57473
+const WHEN = 57474
+const THEN = 57475
+const ELSE = 57476
+const END = 57477
+const LE = 57478
+const GE = 57479
+const NE = 57480
+const NULL_SAFE_EQUAL = 57481
+const IS = 57482
+const LIKE = 57483
+const REGEXP = 57484
+const IN = 57485
+const SHIFT_LEFT = 57486
+const SHIFT_RIGHT = 57487
+const DIV = 57488
+const MOD = 57489
+const UNARY = 57490
+const COLLATE = 57491
+const BINARY = 57492
+const UNDERSCORE_ARMSCII8 = 57493
+const UNDERSCORE_ASCII = 57494
+const UNDERSCORE_BIG5 = 57495
+const UNDERSCORE_BINARY = 57496
+const UNDERSCORE_CP1250 = 57497
+const UNDERSCORE_CP1251 = 57498
+const UNDERSCORE_CP1256 = 57499
+const UNDERSCORE_CP1257 = 57500
+const UNDERSCORE_CP850 = 57501
+const UNDERSCORE_CP852 = 57502
+const UNDERSCORE_CP866 = 57503
+const UNDERSCORE_CP932 = 57504
+const UNDERSCORE_DEC8 = 57505
+const UNDERSCORE_EUCJPMS = 57506
+const UNDERSCORE_EUCKR = 57507
+const UNDERSCORE_GB18030 = 57508
+const UNDERSCORE_GB2312 = 57509
+const UNDERSCORE_GBK = 57510
+const UNDERSCORE_GEOSTD8 = 57511
+const UNDERSCORE_GREEK = 57512
+const UNDERSCORE_HEBREW = 57513
+const UNDERSCORE_HP8 = 57514
+const UNDERSCORE_KEYBCS2 = 57515
+const UNDERSCORE_KOI8R = 57516
+const UNDERSCORE_KOI8U = 57517
+const UNDERSCORE_LATIN1 = 57518
+const UNDERSCORE_LATIN2 = 57519
+const UNDERSCORE_LATIN5 = 57520
+const UNDERSCORE_LATIN7 = 57521
+const UNDERSCORE_MACCE = 57522
+const UNDERSCORE_MACROMAN = 57523
+const UNDERSCORE_SJIS = 57524
+const UNDERSCORE_SWE7 = 57525
+const UNDERSCORE_TIS620 = 57526
+const UNDERSCORE_UCS2 = 57527
+const UNDERSCORE_UJIS = 57528
+const UNDERSCORE_UTF16 = 57529
+const UNDERSCORE_UTF16LE = 57530
+const UNDERSCORE_UTF32 = 57531
+const UNDERSCORE_UTF8 = 57532
+const UNDERSCORE_UTF8MB4 = 57533
+const INTERVAL = 57534
+const JSON_EXTRACT_OP = 57535
+const JSON_UNQUOTE_EXTRACT_OP = 57536
+const CREATE = 57537
+const ALTER = 57538
+const DROP = 57539
+const RENAME = 57540
+const ANALYZE = 57541
+const ADD = 57542
+const FLUSH = 57543
+const CHANGE = 57544
+const MODIFY = 57545
+const REVERT = 57546
+const SCHEMA = 57547
+const TABLE = 57548
+const INDEX = 57549
+const VIEW = 57550
+const TO = 57551
+const IGNORE = 57552
+const IF = 57553
+const PRIMARY = 57554
+const COLUMN = 57555
+const SPATIAL = 57556
+const FULLTEXT = 57557
+const KEY_BLOCK_SIZE = 57558
+const CHECK = 57559
+const INDEXES = 57560
+const ACTION = 57561
+const CASCADE = 57562
+const CONSTRAINT = 57563
+const FOREIGN = 57564
+const NO = 57565
+const REFERENCES = 57566
+const RESTRICT = 57567
+const SHOW = 57568
+const DESCRIBE = 57569
+const EXPLAIN = 57570
+const DATE = 57571
+const ESCAPE = 57572
+const REPAIR = 57573
+const OPTIMIZE = 57574
+const TRUNCATE = 57575
+const COALESCE = 57576
+const EXCHANGE = 57577
+const REBUILD = 57578
+const PARTITIONING = 57579
+const REMOVE = 57580
+const MAXVALUE = 57581
+const PARTITION = 57582
+const REORGANIZE = 57583
+const LESS = 57584
+const THAN = 57585
+const PROCEDURE = 57586
+const TRIGGER = 57587
+const VINDEX = 57588
+const VINDEXES = 57589
+const DIRECTORY = 57590
+const NAME = 57591
+const UPGRADE = 57592
+const STATUS = 57593
+const VARIABLES = 57594
+const WARNINGS = 57595
+const CASCADED = 57596
+const DEFINER = 57597
+const OPTION = 57598
+const SQL = 57599
+const UNDEFINED = 57600
+const SEQUENCE = 57601
+const MERGE = 57602
+const TEMPORARY = 57603
+const TEMPTABLE = 57604
+const INVOKER = 57605
+const SECURITY = 57606
+const FIRST = 57607
+const AFTER = 57608
+const LAST = 57609
+const VITESS_MIGRATION = 57610
+const CANCEL = 57611
+const RETRY = 57612
+const COMPLETE = 57613
+const CLEANUP = 57614
+const BEGIN = 57615
+const START = 57616
+const TRANSACTION = 57617
+const COMMIT = 57618
+const ROLLBACK = 57619
+const SAVEPOINT = 57620
+const RELEASE = 57621
+const WORK = 57622
+const BIT = 57623
+const TINYINT = 57624
+const SMALLINT = 57625
+const MEDIUMINT = 57626
+const INT = 57627
+const INTEGER = 57628
+const BIGINT = 57629
+const INTNUM = 57630
+const REAL = 57631
+const DOUBLE = 57632
+const FLOAT_TYPE = 57633
+const DECIMAL = 57634
+const NUMERIC = 57635
+const TIME = 57636
+const TIMESTAMP = 57637
+const DATETIME = 57638
+const YEAR = 57639
+const CHAR = 57640
+const VARCHAR = 57641
+const BOOL = 57642
+const CHARACTER = 57643
+const VARBINARY = 57644
+const NCHAR = 57645
+const TEXT = 57646
+const TINYTEXT = 57647
+const MEDIUMTEXT = 57648
+const LONGTEXT = 57649
+const BLOB = 57650
+const TINYBLOB = 57651
+const MEDIUMBLOB = 57652
+const LONGBLOB = 57653
+const JSON = 57654
+const ENUM = 57655
+const GEOMETRY = 57656
+const POINT = 57657
+const LINESTRING = 57658
+const POLYGON = 57659
+const GEOMETRYCOLLECTION = 57660
+const MULTIPOINT = 57661
+const MULTILINESTRING = 57662
+const MULTIPOLYGON = 57663
+const NULLX = 57664
+const AUTO_INCREMENT = 57665
+const APPROXNUM = 57666
+const SIGNED = 57667
+const UNSIGNED = 57668
+const ZEROFILL = 57669
+const CODE = 57670
+const COLLATION = 57671
+const COLUMNS = 57672
+const DATABASES = 57673
+const ENGINES = 57674
+const EVENT = 57675
+const EXTENDED = 57676
+const FIELDS = 57677
+const FULL = 57678
+const FUNCTION = 57679
+const GTID_EXECUTED = 57680
+const KEYSPACES = 57681
+const OPEN = 57682
+const PLUGINS = 57683
+const PRIVILEGES = 57684
+const PROCESSLIST = 57685
+const SCHEMAS = 57686
+const TABLES = 57687
+const TRIGGERS = 57688
+const USER = 57689
+const VGTID_EXECUTED = 57690
+const VITESS_KEYSPACES = 57691
+const VITESS_METADATA = 57692
+const VITESS_MIGRATIONS = 57693
+const VITESS_REPLICATION_STATUS = 57694
+const VITESS_SHARDS = 57695
+const VITESS_TABLETS = 57696
+const VSCHEMA = 57697
+const NAMES = 57698
+const GLOBAL = 57699
+const SESSION = 57700
+const ISOLATION = 57701
+const LEVEL = 57702
+const READ = 57703
+const WRITE = 57704
+const ONLY = 57705
+const REPEATABLE = 57706
+const COMMITTED = 57707
+const UNCOMMITTED = 57708
+const SERIALIZABLE = 57709
+const CURRENT_TIMESTAMP = 57710
+const DATABASE = 57711
+const CURRENT_DATE = 57712
+const CURRENT_TIME = 57713
+const LOCALTIME = 57714
+const LOCALTIMESTAMP = 57715
+const CURRENT_USER = 57716
+const UTC_DATE = 57717
+const UTC_TIME = 57718
+const UTC_TIMESTAMP = 57719
+const DAY = 57720
+const DAY_HOUR = 57721
+const DAY_MICROSECOND = 57722
+const DAY_MINUTE = 57723
+const DAY_SECOND = 57724
+const HOUR = 57725
+const HOUR_MICROSECOND = 57726
+const HOUR_MINUTE = 57727
+const HOUR_SECOND = 57728
+const MICROSECOND = 57729
+const MINUTE = 57730
+const MINUTE_MICROSECOND = 57731
+const MINUTE_SECOND = 57732
+const MONTH = 57733
+const QUARTER = 57734
+const SECOND = 57735
+const SECOND_MICROSECOND = 57736
+const YEAR_MONTH = 57737
+const WEEK = 57738
+const REPLACE = 57739
+const CONVERT = 57740
+const CAST = 57741
+const SUBSTR = 57742
+const SUBSTRING = 57743
+const GROUP_CONCAT = 57744
+const SEPARATOR = 57745
+const TIMESTAMPADD = 57746
+const TIMESTAMPDIFF = 57747
+const MATCH = 57748
+const AGAINST = 57749
+const BOOLEAN = 57750
+const LANGUAGE = 57751
+const WITH = 57752
+const QUERY = 57753
+const EXPANSION = 57754
+const WITHOUT = 57755
+const VALIDATION = 57756
+const OVER = 57757
+const WINDOW = 57758
+const ROWS = 57759
+const ROW = 57760
+const CURRENT = 57761
+const UNBOUNDED = 57762
+const PRECEDING = 57763
+const FOLLOWING = 57764
+const NULLS = 57765
+const RESPECT = 57766
+const UNUSED = 57767
+const ARRAY = 57768
+const DESCRIPTION = 57769
+const EMPTY = 57770
+const EXCEPT = 57771
+const GROUPING = 57772
+const GROUPS = 57773
+const JSON_TABLE = 57774
+const LATERAL = 57775
+const MEMBER = 57776
+const OF = 57777
+const RECURSIVE = 57778
+const SYSTEM = 57779
+const ACTIVE = 57780
+const ADMIN = 57781
+const BUCKETS = 57782
+const CLONE = 57783
+const COMPONENT = 57784
+const DEFINITION = 57785
+const ENFORCED = 57786
+const EXCLUDE = 57787
+const GEOMCOLLECTION = 57788
+const GET_MASTER_PUBLIC_KEY = 57789
+const HISTOGRAM = 57790
+const HISTORY = 57791
+const INACTIVE = 57792
+const INVISIBLE = 57793
+const LOCKED = 57794
+const MASTER_COMPRESSION_ALGORITHMS = 57795
+const MASTER_PUBLIC_KEY_PATH = 57796
+const MASTER_TLS_CIPHERSUITES = 57797
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57798
+const NESTED = 57799
+const NETWORK_NAMESPACE = 57800
+const NOWAIT = 57801
+const OJ = 57802
+const OLD = 57803
+const OPTIONAL = 57804
+const ORDINALITY = 57805
+const ORGANIZATION = 57806
+const OTHERS = 57807
+const PATH = 57808
+const PERSIST = 57809
+const PERSIST_ONLY = 57810
+const PRIVILEGE_CHECKS_USER = 57811
+const PROCESS = 57812
+const RANDOM = 57813
+const REFERENCE = 57814
+const REQUIRE_ROW_FORMAT = 57815
+const RESOURCE = 57816
+const RESTART = 57817
+const RETAIN = 57818
+const REUSE = 57819
+const ROLE = 57820
+const SECONDARY = 57821
+const SECONDARY_ENGINE = 57822
+const SECONDARY_LOAD = 57823
+const SECONDARY_UNLOAD = 57824
+const SKIP = 57825
+const SRID = 57826
+const THREAD_PRIORITY = 57827
+const TIES = 57828
+const VCPU = 57829
+const VISIBLE = 57830
+const FORMAT = 57831
+const TREE = 57832
+const VITESS = 57833
+const TRADITIONAL = 57834
+const LOCAL = 57835
+const LOW_PRIORITY = 57836
+const NO_WRITE_TO_BINLOG = 57837
+const LOGS = 57838
+const ERROR = 57839
+const GENERAL = 57840
+const HOSTS = 57841
+const OPTIMIZER_COSTS = 57842
+const USER_RESOURCES = 57843
+const SLOW = 57844
+const CHANNEL = 57845
+const RELAY = 57846
+const EXPORT = 57847
+const AVG_ROW_LENGTH = 57848
+const CONNECTION = 57849
+const CHECKSUM = 57850
+const DELAY_KEY_WRITE = 57851
+const ENCRYPTION = 57852
+const ENGINE = 57853
+const INSERT_METHOD = 57854
+const MAX_ROWS = 57855
+const MIN_ROWS = 57856
+const PACK_KEYS = 57857
+const PASSWORD = 57858
+const FIXED = 57859
+const DYNAMIC = 57860
+const COMPRESSED = 57861
+const REDUNDANT = 57862
+const COMPACT = 57863
+const ROW_FORMAT = 57864
+const STATS_AUTO_RECALC = 57865
+const STATS_PERSISTENT = 57866
+const STATS_SAMPLE_PAGES = 57867
+const STORAGE = 57868
+const MEMORY = 57869
+const DISK = 57870
+const PARTITIONS = 57871
+const LINEAR = 57872
+const RANGE = 57873
+const LIST = 57874
+const SUBPARTITION = 57875
+const SUBPARTITIONS = 57876
+const HASH = 57877
 
 var yyToknames = [...]string{
 	"$end",
@@ -657,6 +660,17 @@ var yyToknames = [...]string{
 	"SHARED",
 	"EXCLUSIVE",
 	"SUBQUERY_AS_EXPR",
+	"CUME_DIST",
+	"DENSE_RANK",
+	"FIRST_VALUE",
+	"LAG",
+	"LAST_VALUE",
+	"LEAD",
+	"NTH_VALUE",
+	"NTILE",
+	"PERCENT_RANK",
+	"RANK",
+	"ROW_NUMBER",
 	"'('",
 	"','",
 	"')'",
@@ -996,32 +1010,29 @@ var yyToknames = [...]string{
 	"EXPANSION",
 	"WITHOUT",
 	"VALIDATION",
+	"OVER",
+	"WINDOW",
+	"ROWS",
+	"ROW",
+	"CURRENT",
+	"UNBOUNDED",
+	"PRECEDING",
+	"FOLLOWING",
+	"NULLS",
+	"RESPECT",
 	"UNUSED",
 	"ARRAY",
-	"CUME_DIST",
 	"DESCRIPTION",
-	"DENSE_RANK",
 	"EMPTY",
 	"EXCEPT",
-	"FIRST_VALUE",
 	"GROUPING",
 	"GROUPS",
 	"JSON_TABLE",
-	"LAG",
-	"LAST_VALUE",
 	"LATERAL",
-	"LEAD",
 	"MEMBER",
-	"NTH_VALUE",
-	"NTILE",
 	"OF",
-	"OVER",
-	"PERCENT_RANK",
-	"RANK",
 	"RECURSIVE",
-	"ROW_NUMBER",
 	"SYSTEM",
-	"WINDOW",
 	"ACTIVE",
 	"ADMIN",
 	"BUCKETS",
@@ -1030,7 +1041,6 @@ var yyToknames = [...]string{
 	"DEFINITION",
 	"ENFORCED",
 	"EXCLUDE",
-	"FOLLOWING",
 	"GEOMCOLLECTION",
 	"GET_MASTER_PUBLIC_KEY",
 	"HISTOGRAM",
@@ -1045,7 +1055,6 @@ var yyToknames = [...]string{
 	"NESTED",
 	"NETWORK_NAMESPACE",
 	"NOWAIT",
-	"NULLS",
 	"OJ",
 	"OLD",
 	"OPTIONAL",
@@ -1055,14 +1064,12 @@ var yyToknames = [...]string{
 	"PATH",
 	"PERSIST",
 	"PERSIST_ONLY",
-	"PRECEDING",
 	"PRIVILEGE_CHECKS_USER",
 	"PROCESS",
 	"RANDOM",
 	"REFERENCE",
 	"REQUIRE_ROW_FORMAT",
 	"RESOURCE",
-	"RESPECT",
 	"RESTART",
 	"RETAIN",
 	"REUSE",
@@ -1075,7 +1082,6 @@ var yyToknames = [...]string{
 	"SRID",
 	"THREAD_PRIORITY",
 	"TIES",
-	"UNBOUNDED",
 	"VCPU",
 	"VISIBLE",
 	"FORMAT",
@@ -1145,1550 +1151,2889 @@ var yyExca = [...]int{
 	-2, 0,
 	-1, 42,
 	1, 135,
-	550, 135,
+	553, 135,
 	-2, 141,
 	-1, 43,
-	114, 141,
-	154, 141,
-	307, 141,
+	125, 141,
+	165, 141,
+	318, 141,
 	-2, 439,
 	-1, 50,
 	32, 612,
-	213, 612,
 	224, 612,
-	257, 626,
-	258, 626,
+	235, 612,
+	268, 626,
+	269, 626,
 	-2, 614,
 	-1, 55,
-	215, 636,
+	226, 636,
 	-2, 634,
 	-1, 105,
-	212, 1081,
+	223, 1131,
 	-2, 114,
 	-1, 107,
 	1, 136,
-	550, 136,
+	553, 136,
 	-2, 141,
 	-1, 117,
-	115, 342,
-	218, 342,
+	126, 342,
+	229, 342,
 	-2, 433,
 	-1, 136,
-	114, 141,
-	154, 141,
-	307, 141,
+	125, 141,
+	165, 141,
+	318, 141,
 	-2, 448,
-	-1, 595,
-	198, 1102,
-	-2, 1098,
-	-1, 596,
-	198, 1103,
-	-2, 1099,
-	-1, 666,
+	-1, 621,
+	209, 1152,
+	-2, 1148,
+	-1, 622,
+	209, 1153,
+	-2, 1149,
+	-1, 692,
 	56, 704,
 	-2, 712,
-	-1, 702,
-	130, 1460,
+	-1, 728,
+	141, 1513,
 	-2, 107,
-	-1, 703,
-	130, 1337,
+	-1, 729,
+	141, 1380,
 	-2, 108,
-	-1, 709,
-	130, 1391,
-	-2, 1075,
-	-1, 851,
-	130, 1270,
-	-2, 1072,
-	-1, 887,
-	223, 37,
-	228, 37,
+	-1, 735,
+	141, 1437,
+	-2, 1125,
+	-1, 866,
+	141, 1309,
+	-2, 1122,
+	-1, 902,
+	234, 37,
+	239, 37,
 	-2, 353,
-	-1, 964,
+	-1, 979,
 	1, 487,
-	550, 487,
+	553, 487,
 	-2, 141,
-	-1, 1160,
+	-1, 1186,
 	56, 705,
 	-2, 717,
-	-1, 1161,
+	-1, 1187,
 	56, 706,
 	-2, 718,
-	-1, 1212,
-	114, 141,
-	154, 141,
-	307, 141,
+	-1, 1238,
+	125, 141,
+	165, 141,
+	318, 141,
 	-2, 383,
-	-1, 1215,
+	-1, 1241,
 	22, 160,
 	-2, 162,
-	-1, 1288,
-	115, 342,
-	218, 342,
+	-1, 1314,
+	126, 342,
+	229, 342,
 	-2, 433,
-	-1, 1297,
-	223, 38,
-	228, 38,
+	-1, 1323,
+	234, 38,
+	239, 38,
 	-2, 354,
-	-1, 1547,
-	198, 1107,
-	-2, 1101,
-	-1, 1621,
-	114, 141,
-	154, 141,
-	307, 141,
+	-1, 1573,
+	209, 1157,
+	-2, 1151,
+	-1, 1658,
+	125, 141,
+	165, 141,
+	318, 141,
 	-2, 384,
-	-1, 1855,
+	-1, 1906,
 	74, 89,
-	83, 89,
+	94, 89,
 	-2, 770,
-	-1, 2023,
-	46, 1043,
-	-2, 1037,
-	-1, 2210,
+	-1, 2088,
+	46, 1093,
+	-2, 1087,
+	-1, 2241,
+	15, 1503,
+	95, 1503,
+	257, 1503,
+	434, 1503,
+	548, 1503,
+	-2, 914,
+	-1, 2296,
 	5, 49,
 	15, 49,
 	17, 49,
-	84, 49,
+	95, 49,
 	-2, 745,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 33015
+const yyLast = 34528
 
 var yyAct = [...]int{
-	595, 2391, 2475, 2361, 1578, 2363, 2255, 2446, 2216, 2410,
-	2130, 2118, 2397, 3, 2327, 1027, 2424, 1875, 590, 88,
-	2032, 1142, 1882, 2035, 1802, 2279, 1884, 2119, 1175, 2036,
-	2033, 2181, 2174, 2284, 2271, 1561, 1582, 2014, 681, 547,
-	551, 1594, 598, 1851, 2201, 2030, 173, 544, 1822, 173,
-	2024, 511, 173, 589, 33, 1653, 1901, 527, 659, 173,
-	1924, 975, 1962, 587, 588, 2077, 1903, 173, 1673, 854,
-	1658, 1902, 573, 1598, 707, 682, 1607, 32, 545, 173,
-	543, 145, 1840, 34, 539, 1599, 1814, 1162, 661, 1618,
-	917, 1492, 1451, 1686, 1541, 1978, 131, 1499, 1718, 1672,
-	527, 1660, 1895, 527, 173, 527, 882, 1204, 556, 87,
-	1857, 1184, 1563, 1601, 1402, 704, 1511, 83, 1145, 1469,
-	1045, 1399, 861, 663, 1302, 667, 888, 858, 1295, 1670,
-	862, 1587, 1649, 883, 1201, 1188, 884, 1385, 1203, 673,
-	1025, 1264, 1287, 684, 895, 885, 1020, 114, 1407, 668,
-	115, 90, 694, 960, 671, 669, 89, 68, 148, 534,
-	1554, 1583, 1111, 1115, 1269, 2459, 175, 176, 177, 1311,
-	2309, 81, 108, 2476, 2218, 2219, 2220, 2364, 77, 109,
-	2392, 2218, 1942, 1941, 1716, 7, 116, 670, 69, 1970,
-	6, 5, 689, 82, 1971, 514, 1558, 1559, 1371, 1046,
-	675, 110, 175, 176, 177, 1458, 1457, 1004, 1456, 855,
-	1455, 1454, 484, 1453, 1440, 537, 1445, 538, 922, 1046,
-	535, 2438, 1800, 2020, 919, 660, 2232, 662, 94, 1824,
-	870, 865, 2099, 2323, 701, 2322, 501, 933, 934, 921,
-	937, 938, 939, 940, 676, 920, 943, 944, 945, 946,
-	947, 948, 949, 950, 951, 952, 953, 954, 955, 956,
-	957, 708, 2469, 899, 898, 96, 110, 99, 683, 1665,
-	105, 2250, 877, 170, 2251, 169, 479, 2420, 2464, 2379,
-	876, 70, 500, 923, 924, 925, 2454, 875, 2256, 930,
-	1756, 2411, 1663, 498, 1704, 2419, 658, 70, 111, 666,
-	2378, 1056, 1977, 2162, 1831, 1278, 2069, 2070, 1801, 1949,
-	935, 153, 1205, 1948, 1206, 70, 2068, 72, 70, 1969,
-	1077, 1056, 874, 1753, 969, 970, 696, 697, 1612, 1832,
-	110, 495, 963, 1866, 994, 656, 1865, 1560, 655, 1867,
-	509, 2332, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1086,
-	1085, 1087, 1088, 1872, 1754, 506, 2311, 79, 1011, 982,
-	1013, 1613, 1614, 1544, 983, 1540, 2297, 150, 1892, 151,
-	982, 1023, 981, 79, 980, 983, 1633, 1632, 872, 168,
-	514, 2154, 869, 2152, 2178, 871, 525, 1444, 515, 529,
-	514, 79, 1052, 514, 79, 1044, 1010, 1012, 995, 988,
-	2132, 959, 1446, 1447, 1448, 523, 999, 1000, 1730, 1727,
-	1729, 1728, 1052, 1149, 1391, 1925, 485, 1662, 487, 502,
-	936, 517, 1687, 516, 491, 1945, 489, 493, 503, 494,
-	878, 488, 1719, 499, 2463, 2126, 490, 504, 505, 507,
-	521, 520, 508, 2127, 497, 518, 1532, 1521, 1522, 1523,
-	1524, 1534, 1525, 1526, 1527, 1539, 1535, 1528, 1529, 1536,
-	1537, 1538, 1530, 1531, 1533, 1361, 874, 958, 2439, 1022,
-	579, 1724, 175, 176, 177, 996, 989, 2133, 874, 173,
-	866, 173, 1386, 154, 173, 1008, 873, 868, 867, 1009,
-	997, 998, 159, 1001, 965, 1017, 1732, 1003, 1733, 1014,
-	1734, 514, 1015, 1002, 1957, 1735, 942, 1362, 941, 1363,
-	2134, 2319, 527, 527, 527, 1721, 2245, 1301, 906, 1689,
-	904, 1007, 879, 1595, 1725, 1723, 1871, 915, 914, 962,
-	527, 527, 913, 912, 872, 911, 910, 1089, 909, 908,
-	903, 1281, 916, 1089, 1888, 1038, 992, 978, 2460, 984,
-	985, 986, 987, 2452, 1051, 1048, 1049, 1050, 1055, 1057,
-	1054, 859, 1053, 2098, 2450, 1722, 891, 897, 859, 1047,
-	890, 1400, 1024, 515, 1051, 1048, 1049, 1050, 1055, 1057,
-	1054, 695, 1053, 515, 1671, 33, 515, 859, 519, 1047,
-	1958, 857, 1710, 1754, 1396, 932, 1032, 926, 2458, 146,
-	1392, 2006, 1961, 2308, 1947, 2106, 512, 1944, 2005, 2004,
-	2312, 1664, 897, 1276, 1803, 1805, 961, 2333, 1275, 1973,
-	1300, 513, 1089, 1274, 1934, 1767, 1397, 1016, 1272, 596,
-	873, 1092, 1093, 1094, 1095, 483, 478, 1390, 2356, 1706,
-	2215, 1100, 873, 1103, 2377, 1135, 2179, 1956, 1090, 1091,
-	1955, 2197, 1862, 1029, 1030, 907, 173, 905, 1829, 1792,
-	1140, 1553, 1192, 1122, 896, 973, 1619, 1096, 1088, 900,
-	890, 897, 107, 173, 2067, 174, 678, 1021, 174, 901,
-	2390, 174, 661, 1155, 1141, 977, 528, 1755, 174, 897,
-	2373, 78, 527, 1979, 515, 173, 174, 902, 1150, 2191,
-	527, 1373, 1372, 1374, 1375, 1376, 527, 78, 174, 896,
-	971, 968, 73, 1018, 1152, 704, 979, 1041, 1156, 69,
-	897, 1153, 1039, 1040, 663, 78, 918, 991, 78, 528,
-	1005, 1408, 528, 174, 528, 1113, 1964, 1114, 993, 1154,
-	1117, 1963, 1141, 87, 591, 1964, 574, 576, 592, 593,
-	1963, 572, 575, 594, 102, 1128, 1129, 1130, 1131, 1804,
-	1720, 2448, 1393, 1207, 2449, 1387, 2447, 1388, 896, 1146,
-	1389, 897, 1042, 1981, 890, 893, 894, 1994, 859, 1512,
-	577, 578, 887, 891, 1914, 90, 896, 1061, 931, 1474,
+	621, 717, 2617, 1615, 2485, 2524, 2341, 2487, 2233, 2586,
+	2562, 687, 2302, 2513, 2183, 2543, 2530, 2195, 3, 2362,
+	2433, 1926, 1933, 1835, 1042, 2467, 707, 2097, 1853, 1168,
+	685, 2060, 1935, 2385, 616, 2184, 2236, 566, 2100, 559,
+	2267, 2079, 2390, 2260, 1201, 88, 173, 2101, 2377, 173,
+	2234, 525, 173, 1598, 561, 1631, 2098, 541, 624, 173,
+	2287, 1619, 2095, 2089, 554, 557, 1873, 173, 1902, 615,
+	33, 1690, 1975, 1952, 613, 990, 2013, 614, 2142, 173,
+	1695, 1953, 588, 1710, 1954, 932, 1644, 558, 708, 1891,
+	34, 145, 553, 711, 869, 1188, 32, 1636, 1295, 2029,
+	541, 1865, 1635, 541, 173, 541, 1655, 1518, 733, 1321,
+	1723, 131, 1755, 1525, 1697, 1709, 1946, 897, 1567, 1210,
+	1230, 1908, 87, 571, 730, 1638, 1477, 1537, 1171, 1495,
+	83, 1600, 1060, 1425, 1428, 1328, 873, 910, 900, 689,
+	1337, 693, 876, 903, 1707, 1411, 1624, 898, 899, 877,
+	1433, 148, 1229, 108, 1214, 699, 109, 1313, 1686, 710,
+	1620, 114, 695, 1290, 90, 1227, 1040, 720, 694, 1035,
+	975, 697, 1130, 548, 2600, 1126, 115, 2415, 81, 1591,
+	77, 2618, 92, 2304, 2305, 2306, 2304, 2525, 2359, 696,
+	69, 2488, 68, 7, 110, 1993, 1992, 6, 89, 5,
+	1753, 116, 2021, 2022, 1595, 1596, 1484, 1483, 715, 1482,
+	1481, 498, 82, 701, 175, 176, 177, 1480, 1479, 934,
+	870, 1466, 551, 1471, 552, 2576, 1851, 2085, 937, 1875,
+	880, 2164, 948, 949, 549, 952, 953, 954, 955, 1570,
+	2318, 958, 959, 960, 961, 962, 963, 964, 965, 966,
+	967, 968, 969, 970, 971, 972, 914, 913, 94, 110,
+	734, 891, 688, 702, 890, 727, 1397, 686, 1019, 2245,
+	709, 892, 2062, 1061, 885, 2244, 938, 939, 940, 2450,
+	2451, 2447, 945, 2448, 2449, 2468, 1836, 2429, 2428, 936,
+	1061, 2336, 169, 935, 2337, 96, 70, 99, 2367, 2611,
+	105, 2368, 2242, 170, 2553, 1793, 493, 70, 2605, 950,
+	2503, 1702, 70, 2367, 72, 111, 2368, 2594, 2342, 1882,
+	2544, 70, 1741, 110, 169, 2552, 684, 2028, 153, 692,
+	2502, 2363, 2227, 1304, 1971, 2000, 1231, 1597, 1232, 1999,
+	2134, 2135, 1650, 1651, 1883, 1700, 1026, 111, 1028, 133,
+	889, 1852, 984, 985, 978, 2403, 722, 723, 1917, 2133,
+	153, 1916, 2020, 1790, 1918, 1649, 1009, 682, 681, 884,
+	1038, 997, 886, 997, 1943, 1071, 998, 2264, 998, 594,
+	1791, 1923, 2417, 79, 1025, 1027, 996, 2219, 995, 528,
+	528, 143, 1071, 2217, 79, 150, 132, 151, 539, 79,
+	528, 1472, 1473, 1474, 2369, 1010, 887, 168, 79, 1014,
+	1015, 2030, 1003, 1670, 1669, 2197, 1470, 543, 537, 2369,
+	1767, 1764, 1766, 1765, 2240, 1092, 1417, 150, 1175, 151,
+	951, 1976, 175, 176, 177, 1315, 1316, 142, 141, 168,
+	893, 2459, 1724, 2460, 1996, 974, 2438, 1093, 1094, 1095,
+	1096, 1097, 1098, 1099, 1101, 1100, 1102, 1103, 1769, 1387,
+	1770, 528, 1771, 2191, 2577, 889, 1067, 881, 1037, 1059,
+	1699, 2192, 1761, 1023, 883, 882, 1756, 1024, 2604, 1412,
+	1012, 1013, 1011, 1067, 1032, 1018, 2061, 1029, 980, 1004,
+	2008, 2032, 2198, 173, 1772, 173, 1016, 957, 173, 889,
+	973, 1388, 1760, 1389, 2199, 956, 1017, 1758, 2425, 1022,
+	2331, 154, 1726, 1632, 888, 1922, 1030, 1307, 930, 929,
+	159, 887, 1104, 928, 927, 1762, 541, 541, 541, 921,
+	919, 926, 137, 1317, 144, 925, 1314, 924, 138, 139,
+	923, 918, 1759, 154, 541, 541, 894, 931, 1791, 1939,
+	874, 905, 159, 2601, 2034, 906, 2038, 1077, 2033, 2163,
+	2031, 2012, 977, 1426, 1053, 2036, 874, 1007, 912, 1104,
+	872, 2592, 2590, 874, 2035, 993, 1708, 999, 1000, 1001,
+	1002, 1327, 529, 529, 1854, 1856, 1124, 2037, 2039, 721,
+	2009, 1747, 1422, 529, 1047, 947, 941, 2171, 1995, 2599,
+	1039, 2359, 2414, 2071, 2024, 2070, 912, 1107, 1108, 1109,
+	1110, 2069, 1418, 1302, 1301, 33, 1300, 1115, 1985, 1118,
+	1066, 1063, 1064, 1065, 1070, 1072, 1069, 146, 1068, 888,
+	1998, 1031, 2364, 2365, 554, 1062, 2418, 1066, 1063, 1064,
+	1065, 1070, 1072, 1069, 1423, 1068, 1298, 2364, 2365, 976,
+	2265, 1804, 1062, 617, 529, 589, 591, 618, 619, 146,
+	587, 590, 620, 888, 1701, 911, 922, 920, 1161, 497,
+	492, 905, 908, 909, 2007, 874, 1104, 2006, 1743, 902,
+	906, 2480, 173, 2301, 1326, 2501, 1105, 1106, 1656, 592,
+	593, 2015, 912, 1044, 1045, 1416, 2014, 1111, 901, 173,
+	912, 1181, 1166, 911, 2015, 88, 2283, 1913, 1880, 2014,
+	1829, 1590, 1176, 1792, 1211, 2242, 1218, 78, 541, 1179,
+	73, 173, 2439, 1137, 983, 988, 541, 2132, 78, 1167,
+	994, 1103, 541, 78, 140, 69, 1020, 1434, 704, 1056,
+	1855, 107, 78, 1054, 1036, 1055, 134, 102, 992, 135,
+	730, 175, 176, 177, 2523, 1520, 1178, 2497, 2277, 1006,
+	1182, 933, 1757, 1128, 1132, 1129, 689, 1419, 986, 912,
+	1008, 1233, 1399, 1398, 1400, 1401, 1402, 1057, 1180, 2045,
+	2588, 1538, 87, 2589, 2504, 2587, 1500, 1167, 1033, 911,
+	1154, 1155, 1156, 1157, 915, 905, 1538, 911, 1818, 946,
+	1501, 1502, 1499, 103, 916, 912, 1074, 1172, 1075, 1076,
 	147, 152, 149, 155, 156, 157, 158, 160, 161, 162,
-	163, 886, 2293, 1475, 1476, 1473, 164, 165, 166, 167,
-	103, 976, 2403, 1705, 1143, 2088, 2401, 896, 1512, 660,
-	1781, 2087, 900, 890, 1693, 2405, 2406, 1174, 662, 1171,
-	1886, 1887, 901, 1777, 1060, 1061, 1983, 2402, 1987, 1151,
-	1982, 2432, 1980, 1059, 964, 1060, 1061, 1985, 1310, 1197,
-	1198, 175, 176, 177, 173, 1494, 1984, 1309, 1265, 1006,
-	1409, 708, 175, 176, 177, 2473, 1826, 1273, 896, 1986,
-	1988, 1299, 1703, 1701, 890, 893, 894, 906, 859, 1588,
-	1589, 1772, 887, 891, 1698, 904, 527, 691, 1297, 1059,
-	1771, 1060, 1061, 2073, 1157, 1193, 1306, 2478, 1996, 1059,
-	1308, 1060, 1061, 527, 527, 1776, 527, 2433, 527, 527,
-	1702, 527, 527, 527, 527, 527, 527, 1464, 1466, 1467,
-	2382, 1495, 1698, 2157, 1169, 1059, 527, 1060, 1061, 1380,
-	173, 1344, 1827, 1885, 1169, 2349, 1202, 2423, 1465, 1307,
-	1059, 1516, 1060, 1061, 1770, 1888, 173, 2461, 1700, 79,
-	540, 2383, 1759, 1760, 1761, 1293, 2165, 527, 1059, 173,
-	1060, 1061, 1472, 2393, 1339, 1340, 2350, 1378, 1279, 1280,
-	1398, 609, 610, 1059, 173, 1060, 1061, 2231, 1286, 685,
-	1079, 1080, 1081, 1082, 1083, 1084, 1086, 1085, 1087, 1088,
-	173, 1059, 1379, 1060, 1061, 1341, 2353, 173, 1081, 1082,
-	1083, 1084, 1086, 1085, 1087, 1088, 173, 173, 173, 173,
-	173, 173, 173, 173, 173, 527, 527, 527, 1271, 1304,
-	2462, 2230, 2104, 1283, 1305, 1899, 1347, 1348, 1284, 1296,
-	1377, 1282, 1353, 1354, 1169, 1898, 699, 1668, 1303, 1303,
-	1059, 2352, 1060, 1061, 1381, 173, 1366, 1182, 1404, 1365,
-	1412, 1083, 1084, 1086, 1085, 1087, 1088, 1416, 1357, 1418,
-	1419, 1420, 1421, 1364, 2421, 1355, 1425, 1349, 1059, 1346,
-	1060, 1061, 1345, 1313, 1320, 1314, 2351, 1316, 1318, 2164,
-	1439, 1322, 1324, 1326, 1328, 1330, 2292, 2290, 1342, 1368,
-	1493, 1470, 2268, 1401, 2228, 175, 176, 177, 1059, 1277,
-	1060, 1061, 2084, 1502, 527, 175, 176, 177, 174, 2085,
-	174, 1908, 1181, 174, 1059, 1896, 1060, 1061, 1169, 527,
-	527, 1714, 1477, 110, 1479, 1480, 1481, 1482, 1483, 1484,
-	1485, 1486, 1487, 1488, 1489, 1490, 1491, 876, 1545, 1414,
-	1713, 528, 528, 528, 875, 1468, 2394, 1059, 173, 1060,
-	1061, 1513, 1367, 1581, 1478, 1435, 1436, 1437, 1178, 528,
-	528, 1878, 1567, 1497, 1438, 175, 176, 177, 1496, 1869,
-	1566, 2159, 1441, 1405, 173, 1369, 1356, 527, 1352, 1351,
-	1059, 1471, 1060, 1061, 1350, 1180, 1019, 173, 1410, 1411,
-	527, 2129, 1568, 84, 1569, 2317, 173, 1547, 173, 173,
-	527, 2316, 1415, 527, 85, 2254, 1879, 1179, 1545, 1422,
-	1423, 1424, 1820, 2477, 527, 704, 87, 2015, 704, 1549,
-	1550, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1063, 1858,
-	1881, 1926, 87, 1911, 1876, 2015, 1169, 1546, 175, 176,
-	177, 1597, 1681, 175, 176, 177, 86, 1679, 1886, 1887,
-	1574, 2248, 2457, 1820, 2443, 1877, 1820, 2427, 1169, 1820,
-	2417, 2315, 2062, 1639, 1640, 1641, 1642, 1547, 1627, 527,
-	1059, 1754, 1060, 1061, 93, 1674, 1675, 1676, 1900, 2190,
-	1678, 1680, 2192, 1623, 92, 174, 91, 1883, 1837, 675,
-	1058, 1605, 1859, 527, 1622, 1059, 2372, 1060, 1061, 527,
-	1306, 1861, 174, 1306, 1169, 1306, 2190, 1593, 1699, 1820,
-	2386, 1697, 1059, 1576, 1060, 1061, 93, 1820, 2367, 1655,
-	1626, 528, 1688, 1820, 174, 1591, 92, 1837, 91, 528,
-	2339, 1169, 2248, 1169, 1609, 528, 1661, 86, 2094, 1610,
-	1611, 527, 1768, 1493, 1077, 1786, 1972, 1625, 1493, 1493,
-	1624, 1885, 2472, 1169, 1820, 2246, 1698, 1169, 2195, 1169,
-	1785, 708, 1685, 1888, 708, 1698, 1078, 1079, 1080, 1081,
-	1082, 1083, 1084, 1086, 1085, 1087, 1088, 2096, 2095, 2092,
-	2093, 2092, 2091, 1816, 173, 1634, 1698, 1635, 1636, 1637,
-	1638, 173, 1656, 1651, 1652, 1169, 173, 173, 86, 1666,
-	173, 1667, 173, 1645, 1646, 1647, 1648, 1677, 173, 1669,
-	1707, 1837, 1169, 1768, 1169, 173, 1754, 1943, 1268, 1928,
-	2031, 1691, 1682, 1690, 1656, 1858, 1694, 92, 1692, 1062,
-	2190, 1695, 1586, 1696, 1709, 1708, 899, 898, 1173, 1711,
-	1712, 1922, 1923, 173, 527, 1556, 1303, 84, 1820, 1819,
-	1058, 1169, 1268, 1267, 86, 1768, 1449, 1109, 85, 1213,
-	1212, 1498, 1880, 1836, 1395, 1199, 881, 880, 1504, 1505,
-	665, 2425, 1745, 1746, 2467, 2233, 1717, 1748, 1335, 1077,
-	2389, 79, 1073, 174, 1074, 2366, 1749, 1548, 1859, 2360,
-	1551, 1552, 1470, 2329, 540, 1768, 2362, 1754, 1075, 1076,
-	1072, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1086, 1085,
-	1087, 1088, 1169, 1176, 2304, 528, 2237, 1837, 2225, 1738,
-	1270, 1654, 1763, 1573, 1765, 2234, 2235, 2236, 1336, 1337,
-	1338, 2128, 528, 528, 2090, 528, 1929, 528, 528, 1650,
-	528, 528, 528, 528, 528, 528, 79, 1644, 1643, 1383,
-	1298, 1294, 1266, 104, 1764, 528, 1905, 173, 963, 174,
-	1904, 2131, 1332, 2238, 2239, 173, 2202, 2203, 2330, 1798,
-	1752, 1665, 2429, 527, 1185, 174, 2398, 2205, 2111, 2110,
-	2109, 2031, 1471, 1915, 1825, 1739, 528, 1442, 174, 2055,
-	2208, 1846, 1847, 1762, 1842, 1845, 1846, 1847, 1843, 2207,
-	1844, 1848, 2050, 174, 173, 173, 2053, 1905, 1833, 1333,
-	1334, 2054, 2051, 2049, 2441, 2418, 1580, 2052, 1821, 174,
-	1868, 1177, 1572, 2025, 2027, 2196, 174, 1780, 1547, 2115,
-	2012, 2011, 2028, 2348, 2283, 174, 174, 174, 174, 174,
-	174, 174, 174, 174, 528, 528, 528, 2285, 33, 1842,
-	1845, 1846, 1847, 1843, 2186, 1844, 1848, 1853, 2022, 2202,
-	2203, 2183, 1817, 1394, 1167, 1163, 527, 654, 1546, 2182,
-	1146, 173, 1893, 1894, 174, 1799, 1890, 1807, 173, 1164,
-	1630, 1852, 1508, 1909, 527, 679, 1818, 928, 1921, 927,
-	527, 1813, 2141, 680, 1306, 1306, 1509, 1856, 1828, 527,
-	1904, 1167, 1163, 1873, 1570, 1571, 1166, 84, 1165, 84,
-	1967, 1940, 1031, 1936, 86, 580, 1164, 1860, 85, 1863,
-	85, 1935, 173, 173, 173, 173, 173, 111, 1661, 2188,
-	1874, 1588, 1589, 528, 86, 2107, 1742, 2369, 2325, 173,
-	173, 1160, 1161, 1166, 93, 1165, 1889, 1897, 528, 528,
-	1850, 1577, 1731, 1907, 92, 173, 91, 1758, 1077, 2010,
-	1766, 1906, 687, 688, 91, 86, 2426, 2009, 1912, 1938,
-	2291, 2289, 526, 1493, 1916, 1917, 1918, 174, 2288, 1286,
-	1078, 1079, 1080, 1081, 1082, 1083, 1084, 1086, 1085, 1087,
-	1088, 2281, 93, 527, 2187, 2185, 2112, 2071, 661, 1939,
-	1683, 1937, 92, 174, 91, 686, 528, 527, 2003, 92,
-	2280, 2175, 2015, 1816, 93, 706, 174, 173, 856, 528,
-	863, 527, 1930, 1931, 92, 174, 1787, 174, 174, 528,
-	527, 1959, 528, 2431, 2430, 527, 527, 1993, 173, 173,
-	173, 173, 173, 528, 1186, 2003, 1975, 1974, 97, 98,
-	173, 2037, 2431, 2354, 2083, 173, 173, 2043, 173, 677,
-	2016, 173, 173, 173, 1990, 1406, 1976, 1989, 95, 80,
-	1, 1778, 2034, 604, 2400, 496, 1557, 2034, 1144, 510,
-	2396, 1370, 1360, 2257, 2086, 2326, 2002, 1659, 667, 173,
-	889, 2061, 136, 1620, 1621, 2413, 101, 852, 528, 100,
-	2013, 2063, 892, 990, 2064, 2017, 1789, 1790, 1156, 1684,
-	2105, 2044, 668, 2249, 2047, 1891, 173, 1631, 669, 1219,
-	1217, 1218, 528, 527, 2045, 2046, 1216, 2048, 528, 87,
-	527, 2056, 2060, 1221, 2117, 173, 1220, 1404, 2065, 1215,
-	1443, 524, 2080, 2079, 1849, 173, 1459, 1460, 1461, 1462,
-	171, 2076, 1965, 1208, 2072, 1966, 1187, 929, 486, 173,
-	2097, 1715, 173, 492, 1101, 2008, 2114, 1864, 705, 2100,
-	528, 698, 2142, 2039, 2180, 2101, 2007, 2021, 2023, 1823,
-	2026, 2019, 2347, 2282, 2368, 1628, 1500, 1501, 1168, 1183,
-	1779, 1108, 2113, 1510, 1506, 2102, 2103, 1661, 2123, 2116,
-	2080, 2079, 2121, 1602, 1565, 2042, 1463, 549, 548, 173,
-	546, 1809, 1830, 174, 1064, 599, 1194, 2137, 1841, 1839,
-	174, 1838, 2136, 1740, 1606, 174, 174, 2139, 2140, 174,
-	2204, 174, 2200, 1600, 1815, 557, 540, 174, 550, 2150,
-	542, 597, 2075, 2078, 174, 1629, 1946, 2125, 1043, 1159,
-	536, 2173, 2144, 2143, 864, 1507, 2331, 1757, 2161, 1158,
-	1519, 1520, 2310, 173, 1870, 58, 36, 531, 2177, 1584,
-	1585, 2437, 174, 528, 2184, 1034, 693, 31, 2189, 30,
-	29, 2199, 28, 27, 22, 21, 2209, 20, 19, 18,
-	24, 17, 2206, 16, 15, 1617, 106, 45, 42, 40,
-	113, 2213, 2214, 2211, 112, 43, 2212, 173, 39, 966,
-	173, 173, 173, 527, 37, 26, 25, 2244, 14, 13,
-	12, 11, 10, 2224, 2222, 2223, 9, 8, 2227, 4,
-	2229, 1037, 527, 527, 527, 527, 1077, 23, 2, 2217,
-	0, 0, 0, 0, 2147, 2148, 0, 2149, 0, 2264,
-	2151, 0, 2153, 2253, 1657, 0, 0, 0, 1078, 1079,
-	1080, 1081, 1082, 1083, 1084, 1086, 1085, 1087, 1088, 527,
-	527, 527, 173, 1078, 1079, 1080, 1081, 1082, 1083, 1084,
-	1086, 1085, 1087, 1088, 0, 2263, 174, 0, 0, 0,
-	0, 0, 0, 2262, 174, 0, 527, 0, 527, 0,
-	2267, 0, 528, 0, 2275, 2276, 2278, 2277, 2298, 0,
-	0, 2037, 2287, 2286, 0, 2037, 0, 0, 0, 661,
-	2300, 2294, 2296, 0, 0, 0, 527, 706, 706, 706,
-	2314, 2034, 0, 174, 174, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1033, 1035, 0, 33, 2306,
-	2307, 0, 0, 0, 0, 0, 0, 527, 2302, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2318,
-	0, 0, 2328, 2320, 0, 0, 2321, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 528, 0, 0, 0, 0,
-	174, 0, 2343, 2346, 2344, 527, 0, 174, 0, 2358,
-	0, 0, 0, 528, 0, 0, 2037, 0, 0, 528,
-	1138, 2355, 0, 0, 0, 0, 661, 0, 528, 0,
-	527, 173, 0, 0, 2374, 0, 0, 0, 0, 2371,
-	527, 2357, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 174, 174, 174, 174, 174, 0, 527, 0, 0,
-	0, 0, 0, 0, 0, 2359, 0, 527, 174, 174,
-	0, 2395, 0, 527, 527, 2384, 0, 0, 0, 33,
-	0, 0, 0, 2407, 174, 0, 2399, 2412, 2328, 2414,
-	527, 2404, 0, 0, 2422, 2034, 0, 0, 2387, 0,
-	1782, 0, 0, 0, 0, 0, 0, 1190, 0, 2428,
-	0, 0, 0, 0, 0, 706, 0, 2434, 0, 0,
-	0, 1209, 528, 33, 0, 0, 0, 2442, 2440, 0,
-	0, 0, 0, 0, 0, 2445, 528, 2444, 0, 2451,
-	0, 0, 0, 0, 2455, 0, 174, 2453, 0, 2456,
-	528, 0, 0, 0, 0, 0, 0, 0, 2466, 528,
-	0, 581, 0, 2468, 528, 528, 0, 174, 174, 174,
-	174, 174, 2465, 527, 1185, 0, 0, 2474, 0, 174,
-	2479, 0, 0, 2470, 174, 174, 0, 174, 0, 0,
-	174, 174, 174, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 169, 172, 0, 0,
-	482, 0, 0, 522, 0, 0, 0, 0, 174, 0,
-	482, 0, 0, 0, 0, 0, 0, 0, 482, 111,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	674, 0, 153, 0, 0, 174, 0, 0, 0, 0,
-	0, 0, 528, 0, 0, 0, 692, 0, 0, 528,
-	0, 0, 0, 0, 174, 482, 0, 0, 0, 0,
-	0, 0, 0, 0, 174, 0, 0, 0, 0, 0,
-	70, 35, 72, 0, 0, 0, 0, 0, 174, 0,
-	0, 174, 0, 0, 0, 0, 0, 0, 150, 76,
-	151, 856, 0, 38, 64, 65, 0, 62, 66, 0,
-	168, 0, 0, 0, 1138, 0, 63, 0, 1312, 1312,
-	0, 1312, 0, 1312, 1312, 0, 1321, 1312, 1312, 1312,
-	1312, 1312, 0, 0, 0, 0, 0, 0, 174, 1138,
-	1138, 856, 0, 0, 0, 51, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 79, 1170, 1172, 0,
-	0, 0, 0, 0, 1991, 1992, 0, 0, 0, 1995,
-	0, 0, 1382, 1997, 1998, 1999, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	163, 1521, 917, 1965, 90, 1076, 164, 165, 166, 167,
+	2224, 1075, 1076, 2399, 1413, 2153, 1414, 2152, 1730, 1415,
+	1336, 1335, 147, 152, 149, 155, 156, 157, 158, 160,
+	161, 162, 163, 1074, 1169, 1075, 1076, 1325, 164, 165,
+	166, 167, 2047, 1742, 1740, 1738, 911, 1177, 921, 173,
+	1200, 915, 905, 1291, 1197, 919, 1021, 1435, 1735, 2570,
+	2536, 916, 1299, 1807, 2534, 991, 734, 686, 1735, 688,
+	1223, 1224, 2138, 2538, 2539, 1219, 2571, 2515, 1937, 1938,
+	2230, 541, 911, 1323, 1739, 2535, 1195, 2473, 905, 908,
+	909, 1332, 874, 979, 1737, 1334, 902, 906, 541, 541,
+	2620, 541, 2602, 541, 541, 1406, 541, 541, 541, 541,
+	541, 541, 175, 176, 177, 1074, 1877, 1075, 1076, 2516,
+	1074, 541, 1075, 1076, 2561, 173, 1370, 1625, 1626, 2474,
+	1183, 1333, 1096, 1097, 1098, 1099, 1101, 1100, 1102, 1103,
+	79, 173, 1094, 1095, 1096, 1097, 1098, 1099, 1101, 1100,
+	1102, 1103, 541, 1498, 173, 1404, 1365, 1366, 1305, 1306,
+	1796, 1797, 1798, 635, 636, 1424, 1490, 1492, 1493, 173,
+	1074, 1394, 1075, 1076, 1432, 1319, 175, 176, 177, 1405,
+	2150, 1936, 1878, 2250, 1195, 173, 2603, 1491, 1312, 2526,
+	2317, 2477, 173, 1939, 1098, 1099, 1101, 1100, 1102, 1103,
+	1331, 173, 173, 173, 173, 173, 173, 173, 173, 173,
+	541, 541, 541, 2316, 2169, 1367, 1373, 1374, 1074, 1950,
+	1075, 1076, 1379, 1380, 1195, 1330, 1329, 1329, 2615, 1403,
+	1297, 1542, 1074, 1309, 1075, 1076, 1949, 1310, 1705, 1339,
+	173, 1340, 1195, 1342, 1344, 1393, 1322, 1348, 1350, 1352,
+	1354, 1356, 1308, 2247, 1195, 1485, 1486, 1487, 1488, 1438,
+	1407, 2194, 1074, 1392, 1075, 1076, 1442, 1391, 1444, 1445,
+	1446, 1447, 1383, 1390, 1381, 1451, 1074, 1430, 1075, 1076,
+	1375, 1372, 1368, 2222, 1195, 1519, 1371, 1346, 1074, 1465,
+	1075, 1076, 1814, 1204, 2476, 1526, 1527, 2475, 1528, 541,
+	1427, 2398, 1496, 1532, 2559, 2396, 2354, 2314, 2149, 1195,
+	1504, 110, 1959, 891, 541, 541, 890, 1947, 1074, 1751,
+	1075, 1076, 1750, 1618, 1303, 1494, 175, 176, 177, 1604,
+	1584, 1585, 1586, 1587, 1588, 1589, 1440, 1523, 1074, 1522,
+	1075, 1076, 1571, 1539, 1080, 1081, 1082, 1083, 1084, 1085,
+	1086, 1078, 1467, 1205, 173, 1431, 554, 1503, 88, 1505,
+	1506, 1507, 1508, 1509, 1510, 1511, 1512, 1513, 1514, 1515,
+	1516, 1517, 1395, 1382, 88, 1813, 1461, 1462, 1463, 1929,
+	173, 2423, 1464, 541, 1603, 2527, 1605, 1378, 1606, 1621,
+	1622, 1497, 1377, 173, 1845, 1195, 541, 1376, 1809, 1843,
+	1195, 1206, 173, 1034, 173, 173, 541, 1871, 2619, 541,
+	1074, 2229, 1075, 1076, 2422, 1654, 84, 2340, 1808, 1074,
+	541, 1075, 1076, 1571, 1930, 1977, 1573, 85, 1962, 1074,
+	730, 1075, 1076, 730, 1074, 87, 1075, 1076, 1575, 1576,
+	2334, 2598, 1228, 1664, 1436, 1437, 1074, 2096, 1075, 1076,
+	84, 87, 1572, 1074, 2421, 1075, 1076, 86, 1441, 1932,
+	2276, 85, 1208, 1927, 1611, 1448, 1449, 1450, 2276, 175,
+	176, 177, 1634, 1920, 1694, 541, 2557, 1937, 1938, 1871,
+	2583, 1711, 1712, 1713, 1928, 1951, 1715, 1717, 1074, 2278,
+	1075, 1076, 1195, 1073, 1676, 1677, 1678, 1679, 2127, 541,
+	175, 176, 177, 86, 1718, 541, 1332, 1573, 701, 1332,
+	1659, 1332, 1871, 2565, 1660, 2496, 1934, 1734, 1791, 1074,
+	1871, 1075, 1076, 1871, 2550, 1671, 1195, 1672, 1673, 1674,
+	1675, 1642, 1524, 1630, 92, 1613, 1725, 1663, 1207, 1530,
+	1531, 1692, 725, 1682, 1683, 1684, 1685, 541, 1848, 1519,
+	1628, 1871, 2519, 1888, 1519, 1519, 2159, 1698, 1574, 1647,
+	1648, 1577, 1578, 1871, 2491, 1805, 734, 1662, 1661, 734,
+	1823, 1646, 1195, 1074, 1822, 1075, 1076, 2461, 1195, 1735,
+	1936, 622, 1719, 1074, 1623, 1075, 1076, 175, 176, 177,
+	173, 1716, 1939, 1722, 2334, 1195, 1199, 173, 1871, 2332,
+	93, 1593, 173, 173, 1475, 1610, 173, 1693, 173, 1421,
+	92, 1225, 91, 1805, 173, 1729, 1735, 1195, 1732, 896,
+	1733, 173, 1704, 1706, 1703, 1688, 1689, 174, 1714, 895,
+	174, 2281, 1195, 174, 2614, 914, 913, 2080, 542, 1693,
+	174, 1744, 1092, 1731, 2023, 1329, 1727, 1728, 174, 173,
+	541, 2161, 2160, 2157, 2158, 2563, 1746, 1566, 1745, 2609,
+	174, 1748, 1749, 79, 1093, 1094, 1095, 1096, 1097, 1098,
+	1099, 1101, 1100, 1102, 1103, 2157, 2156, 1888, 1195, 1805,
+	1195, 542, 1791, 1994, 542, 174, 542, 1294, 1979, 93,
+	1195, 1931, 1782, 1783, 1973, 1974, 2522, 1785, 2080, 92,
+	1867, 91, 1871, 1870, 1092, 691, 1786, 1088, 1909, 1089,
+	86, 1073, 1195, 1909, 1496, 86, 1754, 1294, 1293, 1888,
+	1819, 1801, 2490, 1090, 1091, 1087, 1093, 1094, 1095, 1096,
+	1097, 1098, 1099, 1101, 1100, 1102, 1103, 1775, 1558, 1547,
+	1548, 1549, 1550, 1560, 1551, 1552, 1553, 1565, 1561, 1554,
+	1555, 1562, 1563, 1564, 1556, 1557, 1559, 1093, 1094, 1095,
+	1096, 1097, 1098, 1099, 1101, 1100, 1102, 1103, 1800, 1887,
+	1802, 1910, 1239, 1238, 173, 1736, 1910, 2196, 2484, 1195,
+	2276, 2435, 173, 1805, 1837, 1838, 1839, 1840, 1789, 1202,
+	541, 1912, 79, 2410, 2311, 1849, 1791, 1296, 1691, 2193,
+	2155, 1876, 2486, 1497, 1980, 1211, 1687, 1681, 1680, 1799,
+	1409, 1324, 1320, 1292, 104, 1956, 978, 2288, 2289, 2436,
+	1702, 173, 173, 1092, 2319, 1803, 2567, 2531, 2291, 2176,
+	1884, 1955, 2175, 2174, 1888, 2096, 1966, 1919, 2323, 1092,
+	1776, 1361, 1468, 1735, 1817, 1093, 1094, 1095, 1096, 1097,
+	1098, 1099, 1101, 1100, 1102, 1103, 2120, 2294, 1897, 1898,
+	1872, 1093, 1094, 1095, 1096, 1097, 1098, 1099, 1101, 1100,
+	1102, 1103, 1358, 1203, 2320, 2321, 2322, 2118, 1956, 1573,
+	2293, 33, 2119, 541, 2116, 2324, 2325, 1868, 173, 2117,
+	1904, 1362, 1363, 1364, 2115, 173, 2114, 2579, 2551, 1172,
+	1617, 541, 1850, 1609, 1972, 1572, 2282, 541, 2180, 2077,
+	2076, 1332, 1332, 1858, 1944, 1945, 541, 1903, 1869, 1359,
+	1360, 1864, 2472, 1879, 2389, 2391, 2272, 2087, 1991, 1420,
+	1924, 1893, 1896, 1897, 1898, 1894, 2269, 1895, 1899, 173,
+	173, 173, 173, 173, 2268, 1911, 2090, 2092, 1907, 1914,
+	680, 705, 1941, 1815, 1667, 2093, 173, 173, 1925, 706,
+	1698, 1960, 943, 942, 2206, 1958, 1955, 1534, 2018, 1046,
+	1987, 84, 173, 1893, 1896, 1897, 1898, 1894, 1948, 1895,
+	1899, 1535, 85, 2288, 2289, 1986, 84, 111, 1826, 1827,
+	1519, 1957, 2274, 86, 86, 2042, 2043, 85, 1989, 1963,
+	2046, 1625, 1626, 2172, 2048, 2049, 2050, 1967, 1968, 1969,
+	541, 1312, 1779, 1193, 1189, 1768, 2558, 541, 2493, 2431,
+	1981, 1982, 1940, 1901, 2026, 2063, 2044, 2065, 1190, 2052,
+	2068, 1614, 2075, 1990, 2056, 1988, 713, 714, 541, 1795,
+	2074, 91, 2564, 2397, 2395, 2059, 2394, 2387, 173, 2025,
+	2370, 2273, 541, 1607, 1608, 1192, 2064, 1191, 2066, 2067,
+	2271, 541, 1193, 1189, 2177, 2136, 541, 541, 93, 173,
+	173, 173, 173, 173, 174, 1720, 174, 1190, 92, 174,
+	91, 173, 2010, 712, 92, 2056, 173, 173, 2040, 173,
+	2386, 2261, 173, 173, 173, 2108, 88, 2041, 2102, 2027,
+	2099, 93, 1186, 1187, 1192, 2099, 1191, 542, 542, 542,
+	2080, 92, 2376, 91, 93, 2151, 2081, 2569, 2568, 95,
+	173, 1867, 86, 1824, 92, 542, 542, 2055, 1212, 97,
+	98, 2569, 2478, 2148, 703, 693, 80, 1, 630, 2533,
+	2126, 2170, 510, 1594, 2082, 1170, 2078, 173, 524, 2529,
+	1396, 1386, 2343, 2432, 541, 1182, 695, 2128, 1696, 904,
+	2129, 541, 694, 136, 2182, 1657, 173, 2110, 2111, 2121,
+	2113, 2109, 1658, 87, 2112, 2546, 173, 101, 2072, 2130,
+	867, 100, 2125, 907, 2145, 1005, 1721, 2144, 2335, 1942,
+	173, 1668, 2141, 173, 2179, 2137, 1245, 1243, 1244, 1242,
+	1247, 595, 1430, 2207, 1246, 1241, 1469, 2107, 538, 1900,
+	171, 1234, 1213, 944, 500, 2162, 2166, 1752, 2165, 506,
+	1116, 2167, 2168, 2073, 1915, 731, 724, 2104, 2266, 2228,
+	2016, 2086, 2088, 2017, 1874, 2091, 2084, 2471, 2388, 541,
+	2181, 2188, 2145, 1698, 2209, 2144, 2186, 2492, 2178, 1665,
+	2237, 1209, 1816, 1123, 173, 1536, 1639, 1602, 540, 1489,
+	2512, 2375, 2262, 174, 2243, 2202, 2239, 2201, 2248, 2208,
+	2251, 2252, 2204, 2205, 2366, 2238, 2051, 564, 563, 562,
+	174, 560, 1860, 1881, 1079, 554, 2215, 625, 1220, 1892,
+	1890, 1889, 1777, 1643, 2290, 2286, 1637, 1866, 572, 542,
+	2259, 732, 174, 565, 871, 556, 878, 542, 173, 2212,
+	2213, 623, 2214, 542, 2140, 2216, 2143, 2218, 1666, 1997,
+	2190, 2307, 1058, 1185, 550, 879, 1533, 2437, 1794, 2226,
+	2285, 2263, 2270, 1184, 1545, 1546, 2416, 2312, 1921, 58,
+	2275, 36, 545, 2575, 1049, 719, 31, 2295, 30, 29,
+	2299, 2300, 173, 2292, 28, 173, 173, 173, 541, 27,
+	22, 2330, 2297, 21, 20, 19, 18, 24, 17, 2338,
+	16, 15, 106, 45, 42, 40, 2298, 541, 541, 541,
+	541, 113, 112, 2310, 43, 2308, 2309, 39, 981, 37,
+	26, 25, 14, 13, 2350, 12, 11, 10, 2313, 9,
+	2315, 8, 4, 1052, 2351, 23, 2352, 2, 2303, 0,
+	0, 2355, 2356, 0, 2339, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2237, 0, 2372,
+	0, 0, 2374, 2353, 0, 541, 541, 541, 173, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2361,
+	174, 0, 0, 0, 2360, 2349, 0, 0, 2371, 2401,
+	0, 2373, 541, 0, 541, 0, 2358, 0, 0, 0,
+	2409, 0, 0, 2411, 0, 0, 0, 0, 2348, 2404,
+	0, 0, 542, 2384, 2381, 2382, 0, 2393, 2392, 0,
+	0, 2383, 541, 2102, 2099, 2420, 2408, 2102, 0, 542,
+	542, 2406, 542, 0, 542, 542, 2402, 542, 542, 542,
+	542, 542, 542, 2400, 0, 0, 0, 0, 0, 2412,
+	2413, 0, 542, 541, 0, 0, 174, 0, 0, 0,
+	33, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 174, 0, 0, 2426, 0, 2434, 0, 0,
+	2427, 2424, 554, 542, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 2446, 0, 0, 0, 1194, 2469, 554,
+	174, 0, 0, 0, 0, 2454, 0, 2456, 2445, 0,
+	2453, 0, 0, 0, 0, 0, 174, 2458, 0, 2452,
+	0, 541, 2465, 174, 2482, 0, 0, 2470, 2466, 2489,
+	0, 0, 174, 174, 174, 174, 174, 174, 174, 174,
+	174, 542, 542, 542, 0, 2481, 541, 173, 2102, 2498,
+	0, 0, 2479, 2483, 0, 0, 0, 0, 0, 169,
+	2495, 0, 0, 541, 0, 0, 0, 0, 0, 0,
+	0, 174, 541, 0, 0, 0, 0, 0, 0, 541,
+	0, 0, 111, 0, 0, 0, 0, 0, 0, 0,
+	0, 541, 2508, 0, 0, 153, 2521, 2506, 0, 2507,
+	0, 541, 2511, 0, 2528, 0, 2541, 541, 541, 0,
+	0, 33, 0, 0, 0, 0, 2517, 0, 0, 0,
+	2520, 0, 2540, 0, 2532, 2537, 2099, 2545, 0, 0,
+	542, 2434, 2547, 541, 2554, 0, 2560, 0, 0, 0,
+	2555, 2556, 0, 0, 0, 542, 542, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2566, 732, 732, 732,
+	0, 0, 150, 0, 151, 0, 2572, 0, 541, 0,
+	0, 0, 0, 33, 168, 1048, 1050, 2582, 2578, 0,
+	0, 2580, 0, 0, 0, 174, 0, 2585, 2584, 2591,
+	0, 0, 541, 0, 0, 2593, 0, 0, 2596, 0,
+	2595, 2597, 0, 2237, 0, 0, 0, 0, 0, 0,
+	0, 174, 0, 2608, 542, 2610, 0, 2607, 0, 0,
+	0, 2613, 0, 0, 174, 541, 0, 542, 2616, 2612,
+	0, 0, 2621, 174, 0, 174, 174, 542, 0, 0,
+	542, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 542, 0, 0, 0, 0, 0, 169, 0, 0,
+	0, 0, 1164, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 154, 0,
+	111, 0, 133, 0, 0, 0, 0, 159, 0, 0,
+	0, 0, 0, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 542, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 143, 0, 0, 0, 0, 132,
+	542, 0, 0, 0, 0, 0, 542, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1216,
+	0, 0, 0, 0, 0, 0, 0, 732, 0, 0,
+	150, 0, 151, 1235, 0, 0, 0, 0, 120, 121,
+	142, 141, 168, 0, 0, 0, 0, 0, 542, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 174, 0, 0, 0, 0, 0, 0, 174, 0,
+	0, 0, 0, 174, 174, 0, 0, 174, 0, 174,
+	0, 0, 0, 0, 0, 174, 0, 0, 0, 0,
 	0, 0, 174, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 137, 118, 144, 125, 117,
+	0, 138, 139, 0, 0, 0, 154, 0, 0, 0,
+	174, 542, 0, 0, 0, 159, 126, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 127, 122, 123, 124, 128, 0, 0, 0, 0,
+	119, 0, 0, 0, 0, 0, 0, 0, 0, 130,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 871, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1164, 0, 0, 0, 1338,
+	1338, 0, 1338, 0, 1338, 1338, 0, 1347, 1338, 1338,
+	1338, 1338, 1338, 0, 0, 0, 0, 0, 0, 0,
+	1164, 1164, 871, 0, 0, 0, 0, 147, 152, 149,
+	155, 156, 157, 158, 160, 161, 162, 163, 0, 0,
+	0, 0, 146, 164, 165, 166, 167, 0, 0, 0,
+	0, 0, 0, 1408, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 174, 0, 0, 0, 0, 0, 0,
+	0, 542, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 174, 174, 0, 0, 0, 0, 0, 0,
+	0, 732, 732, 732, 0, 0, 0, 140, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	0, 0, 135, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1196, 1198, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 542, 0, 0, 0, 0, 174,
+	0, 0, 0, 0, 0, 0, 174, 0, 0, 0,
+	0, 0, 542, 0, 0, 0, 0, 0, 542, 0,
+	0, 0, 0, 0, 0, 0, 0, 542, 0, 0,
+	1529, 0, 0, 0, 0, 0, 0, 1164, 0, 0,
+	0, 0, 0, 0, 0, 1543, 1544, 0, 0, 732,
+	174, 174, 174, 174, 174, 147, 152, 149, 155, 156,
+	157, 158, 160, 161, 162, 163, 0, 174, 174, 0,
+	0, 164, 165, 166, 167, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 175, 176, 177, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1616, 0, 0, 0, 0, 0,
+	0, 542, 0, 0, 0, 528, 0, 1216, 542, 0,
+	732, 0, 0, 0, 0, 0, 0, 732, 0, 0,
+	732, 0, 0, 0, 0, 0, 0, 0, 0, 542,
+	0, 871, 0, 0, 0, 0, 0, 0, 0, 174,
+	0, 0, 0, 542, 0, 0, 515, 0, 0, 0,
+	0, 0, 542, 0, 0, 0, 0, 542, 542, 0,
+	174, 174, 174, 174, 174, 169, 0, 0, 0, 0,
+	0, 0, 174, 0, 0, 1311, 0, 174, 174, 0,
+	174, 0, 0, 174, 174, 174, 878, 0, 111, 0,
+	133, 0, 514, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 0, 512, 0, 0, 0, 0, 0, 0,
+	871, 174, 0, 0, 0, 0, 878, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 143, 0, 0, 0, 0, 132, 174, 0,
+	0, 509, 0, 0, 0, 542, 0, 0, 0, 0,
+	523, 0, 542, 0, 0, 1173, 0, 174, 871, 0,
+	0, 0, 0, 0, 0, 520, 0, 174, 150, 0,
+	151, 0, 0, 0, 0, 0, 1315, 1316, 142, 141,
+	168, 174, 0, 0, 174, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 529, 0,
+	0, 0, 0, 0, 495, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 544, 0, 0, 0, 0, 0,
+	0, 0, 683, 0, 632, 71, 499, 0, 501, 516,
+	542, 531, 0, 530, 505, 0, 503, 507, 517, 508,
+	0, 502, 0, 513, 0, 174, 504, 518, 519, 521,
+	535, 534, 522, 0, 511, 532, 0, 0, 0, 875,
+	1262, 1788, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 137, 1317, 144, 0, 1314, 0, 138,
+	139, 0, 0, 0, 154, 1540, 0, 0, 0, 1541,
+	0, 0, 0, 159, 690, 0, 71, 0, 0, 174,
+	0, 1579, 1580, 1581, 1582, 1583, 0, 0, 0, 0,
+	0, 0, 0, 0, 690, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1196, 1592, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 0, 0, 174, 174, 174, 542,
+	0, 0, 0, 0, 0, 1612, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 542, 542,
+	542, 542, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 732, 0, 0, 0, 0, 0, 0, 0,
+	533, 0, 0, 0, 0, 0, 0, 1250, 0, 0,
+	146, 1861, 0, 0, 0, 0, 0, 0, 526, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 527, 0, 0, 542, 542, 542, 174,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 542, 0, 542, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 140, 0, 1263, 0, 0,
+	0, 0, 0, 542, 0, 0, 0, 134, 0, 0,
+	135, 0, 0, 0, 1961, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1616, 0, 542, 0, 0, 0, 1978, 0,
+	0, 0, 0, 0, 0, 0, 0, 1983, 0, 1276,
+	1279, 1280, 1281, 1282, 1283, 1284, 0, 1285, 1286, 1287,
+	1288, 1289, 1264, 1265, 1266, 1267, 1248, 1249, 1277, 0,
+	1251, 0, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259,
+	1260, 1261, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 542, 147, 152, 149, 155, 156, 157, 158,
+	160, 161, 162, 163, 0, 0, 0, 0, 0, 164,
+	165, 166, 167, 0, 0, 0, 0, 542, 174, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 732, 0, 0, 542, 0, 0, 0, 2057, 0,
+	0, 0, 0, 542, 0, 0, 0, 0, 0, 0,
+	542, 0, 0, 0, 0, 0, 0, 0, 982, 1338,
+	987, 0, 542, 989, 0, 0, 1278, 0, 0, 0,
+	0, 0, 542, 2083, 0, 0, 0, 0, 542, 542,
+	0, 0, 732, 0, 1164, 0, 0, 2106, 1338, 1164,
+	0, 0, 1806, 0, 0, 0, 1810, 0, 1811, 1812,
+	0, 0, 0, 0, 542, 0, 0, 1820, 0, 0,
+	1821, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1825, 0, 0, 0, 542,
+	0, 0, 1830, 1831, 1832, 1833, 1834, 0, 0, 0,
+	0, 0, 1841, 1842, 1844, 1846, 1847, 0, 0, 1612,
+	1041, 1041, 1041, 542, 0, 0, 0, 0, 0, 0,
+	1859, 0, 0, 0, 0, 871, 0, 0, 1164, 0,
+	71, 0, 1616, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 542, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 690, 1112,
+	1113, 1114, 0, 1117, 0, 1119, 1120, 1121, 1122, 0,
+	1125, 1127, 1127, 0, 1127, 1131, 1131, 1133, 1134, 1135,
+	1136, 0, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145,
+	1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153, 0, 0,
+	0, 0, 1131, 1131, 1131, 1131, 0, 596, 0, 0,
+	2235, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1222, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 0, 496, 0, 0, 536,
+	0, 0, 0, 0, 0, 0, 496, 0, 1174, 0,
+	0, 690, 0, 0, 496, 690, 0, 0, 0, 0,
+	0, 690, 0, 0, 0, 0, 700, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 718, 0, 0, 0, 0, 0, 0, 0,
+	0, 496, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1616,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2053, 2054, 0, 0, 2344, 2345,
+	2346, 2347, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1240, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2105, 0, 0, 2379, 2379, 2379, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2123, 2124, 0, 0, 0, 0, 0, 0, 1164, 0,
+	0, 0, 0, 2405, 0, 2407, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 154, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 0, 0, 0, 0, 0,
-	706, 706, 706, 0, 0, 0, 174, 1147, 0, 174,
-	174, 174, 528, 0, 0, 0, 0, 0, 0, 0,
-	606, 71, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 528, 528, 528, 528, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 41, 44, 47, 46, 49,
-	0, 61, 0, 0, 67, 0, 481, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 530, 0, 528, 528,
-	528, 174, 0, 0, 657, 0, 50, 75, 74, 0,
-	0, 59, 60, 48, 0, 0, 0, 0, 0, 1503,
-	664, 0, 71, 0, 0, 528, 1138, 528, 0, 0,
-	146, 0, 0, 0, 1517, 1518, 0, 0, 706, 0,
-	664, 860, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 52, 53, 528, 54, 55, 56, 57,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2163, 0, 528, 0, 0, 0,
-	0, 0, 1579, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2176, 0, 1190, 0, 0, 706, 0,
-	0, 0, 0, 0, 0, 706, 0, 0, 706, 0,
-	0, 0, 0, 0, 0, 0, 540, 0, 0, 856,
-	0, 0, 0, 0, 528, 0, 0, 0, 0, 0,
-	482, 0, 482, 0, 0, 482, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 528,
-	174, 0, 2221, 0, 0, 0, 0, 0, 0, 528,
-	0, 0, 0, 0, 0, 0, 0, 73, 2226, 0,
-	0, 0, 0, 0, 863, 0, 528, 0, 0, 0,
-	78, 0, 0, 0, 0, 0, 528, 0, 0, 0,
-	0, 0, 528, 528, 0, 0, 0, 0, 856, 0,
-	2252, 0, 0, 0, 863, 0, 0, 0, 0, 528,
-	0, 147, 152, 149, 155, 156, 157, 158, 160, 161,
-	162, 163, 0, 0, 0, 0, 0, 164, 165, 166,
-	167, 0, 0, 0, 0, 2265, 0, 2266, 0, 0,
-	0, 0, 2269, 2270, 0, 0, 856, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1139, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2295,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2303, 0, 0, 2305, 0, 0, 0, 0, 0, 0,
-	0, 1514, 528, 0, 0, 1515, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 482, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1170, 1555, 0, 0, 674, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 482, 0, 0, 1751,
-	1575, 0, 0, 0, 0, 0, 0, 0, 2345, 540,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2365,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 967, 0, 972, 0,
-	0, 974, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2388, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2408, 0,
-	0, 0, 1026, 1026, 1026, 0, 0, 0, 0, 706,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 71, 0, 0, 0, 0, 0, 1810, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 664,
-	1097, 1098, 1099, 0, 1102, 482, 1104, 1105, 1106, 1107,
-	0, 1110, 1112, 1112, 0, 1112, 1116, 1116, 1118, 1119,
-	1120, 1121, 0, 1123, 1124, 1125, 1126, 1127, 0, 0,
-	0, 0, 1116, 1116, 1116, 1116, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2471, 0, 0, 0, 0,
-	1139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1910, 0, 0, 0, 1139, 1139, 0, 0, 0,
-	0, 482, 0, 0, 0, 0, 0, 0, 0, 1579,
-	0, 0, 0, 0, 0, 1927, 0, 1358, 1148, 0,
-	0, 664, 0, 0, 1932, 664, 1236, 0, 0, 0,
-	482, 664, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1196, 0, 0, 1403, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 482, 0, 0, 0, 0, 0, 0, 482, 0,
-	0, 0, 0, 0, 0, 0, 0, 1426, 1427, 482,
-	482, 482, 482, 482, 482, 482, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1769, 0, 0,
-	0, 1773, 0, 1774, 1775, 0, 0, 0, 0, 0,
-	0, 0, 1783, 0, 0, 1784, 482, 0, 706, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1312, 0, 0, 0, 0, 0, 0, 0,
-	1788, 0, 0, 0, 0, 0, 2018, 1793, 1794, 1795,
-	1796, 1797, 1224, 1575, 0, 706, 0, 1138, 0, 0,
-	2041, 1312, 1138, 0, 1808, 0, 0, 0, 0, 692,
-	0, 0, 0, 0, 0, 0, 692, 692, 0, 0,
-	0, 0, 1139, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 692, 1403, 692, 692, 692, 692, 692,
-	0, 1214, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1358,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 692, 1237, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 674, 0, 0, 856, 0,
-	0, 1138, 0, 0, 0, 1579, 0, 0, 482, 0,
-	0, 0, 0, 0, 1403, 0, 0, 482, 0, 482,
-	1608, 0, 0, 0, 0, 0, 0, 1343, 0, 0,
-	0, 0, 0, 0, 1250, 1253, 1254, 1255, 1256, 1257,
-	1258, 0, 1259, 1260, 1261, 1262, 1263, 1238, 1239, 1240,
-	1241, 1222, 1223, 1251, 0, 1225, 1384, 1226, 1227, 1228,
-	1229, 1230, 1231, 1232, 1233, 1234, 1235, 1242, 1243, 1244,
-	1245, 1246, 1247, 1248, 1249, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1413, 0, 0,
-	0, 0, 0, 0, 1417, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1428, 1429, 1430, 1431, 1432,
-	1433, 1434, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1026, 1026, 1026, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2000, 2001,
-	0, 0, 1452, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1252, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2040, 0, 0, 0, 0, 1579, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2058, 2059, 0, 0, 0, 482, 0, 2258, 2259, 2260,
-	2261, 0, 482, 0, 0, 0, 0, 482, 482, 0,
-	0, 482, 0, 1743, 0, 0, 0, 0, 0, 482,
-	0, 0, 0, 0, 0, 0, 482, 0, 0, 0,
-	0, 0, 0, 0, 2273, 2273, 2273, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 482, 0, 1138, 0, 0, 0,
-	0, 2299, 0, 2301, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1590, 0, 0, 0, 0, 0,
-	0, 0, 0, 1596, 0, 0, 1452, 0, 0, 0,
-	0, 1579, 0, 0, 0, 0, 0, 0, 1603, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2146, 0, 692,
-	0, 0, 706, 0, 0, 0, 0, 0, 2155, 2156,
-	2158, 2160, 0, 0, 0, 0, 0, 0, 2166, 0,
-	0, 2167, 0, 0, 0, 0, 2171, 0, 0, 0,
-	0, 0, 0, 0, 692, 692, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1403, 0, 0, 482, 0,
-	1579, 0, 0, 0, 0, 0, 1358, 2193, 2194, 0,
-	0, 2198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1579, 0, 0, 0, 2210,
-	0, 0, 0, 0, 0, 2380, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 482, 482, 0, 0, 0,
-	1138, 0, 2385, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1579, 0, 0, 0, 0, 0, 706, 706,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1579, 0, 2247, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1452, 0, 0, 0, 0, 0, 0, 1726, 0,
-	0, 0, 482, 1736, 1737, 0, 0, 1741, 0, 1919,
-	0, 0, 0, 0, 0, 1744, 0, 0, 0, 0,
-	0, 0, 1747, 0, 0, 0, 0, 2272, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1750, 0, 0, 482, 482, 482, 482, 482, 1579, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	482, 482, 0, 0, 0, 0, 0, 0, 0, 0,
-	2313, 0, 0, 0, 0, 0, 482, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	692, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2324, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 692, 2334, 2335, 2336, 0, 2337, 2338,
-	2340, 0, 0, 0, 2341, 2342, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 482, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1139, 0, 0, 1791, 0, 1139, 482,
-	482, 482, 482, 482, 0, 0, 0, 0, 0, 0,
-	0, 2057, 0, 1806, 0, 0, 482, 1358, 0, 482,
-	2376, 0, 482, 2066, 1403, 0, 0, 0, 664, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1855, 0, 0, 1834, 1835, 0, 0, 0,
-	482, 0, 0, 0, 1854, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 482, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1139, 0, 0,
-	0, 0, 0, 0, 0, 0, 482, 2435, 2436, 0,
-	0, 0, 0, 0, 0, 0, 482, 0, 1913, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	482, 0, 0, 482, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 169, 0, 0, 0, 0, 0, 0, 0,
-	1933, 0, 0, 0, 0, 0, 0, 0, 0, 1950,
-	1951, 1952, 1953, 1954, 0, 111, 0, 133, 0, 0,
-	482, 0, 0, 0, 0, 0, 1452, 1960, 153, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1968, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 0, 0, 0, 132, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 482, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 150, 0, 151, 0, 0, 0,
-	0, 0, 120, 121, 142, 141, 168, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1603, 482, 0,
-	0, 482, 482, 482, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2038, 0, 71, 0, 0, 1603, 1603,
-	1603, 1603, 1603, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1854, 0, 0, 1603, 0,
-	0, 1603, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2089, 0, 0, 2074,
-	0, 0, 0, 1358, 0, 0, 0, 0, 0, 137,
-	118, 144, 125, 117, 0, 138, 139, 0, 0, 0,
-	154, 0, 1139, 2108, 0, 0, 0, 0, 0, 159,
-	126, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2120, 0, 129, 127, 122, 123, 124, 128,
-	0, 0, 2124, 0, 119, 0, 0, 2122, 0, 0,
-	0, 0, 0, 130, 0, 0, 2135, 0, 0, 2138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2145, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2172, 0, 0, 0,
-	0, 0, 0, 0, 0, 2168, 2169, 2170, 0, 0,
-	0, 0, 0, 0, 0, 0, 146, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 79, 0,
-	0, 0, 482, 0, 600, 607, 608, 609, 610, 601,
-	603, 0, 0, 0, 602, 0, 0, 605, 611, 612,
-	0, 0, 0, 1603, 0, 0, 1139, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 140, 0, 0, 2240, 0, 0, 2241, 2242, 2243,
-	2081, 2082, 0, 134, 0, 0, 135, 0, 0, 0,
-	0, 0, 613, 614, 615, 616, 617, 618, 619, 620,
-	621, 622, 623, 624, 625, 626, 627, 628, 629, 630,
-	631, 632, 633, 634, 635, 636, 637, 638, 639, 640,
-	641, 642, 643, 644, 645, 646, 647, 648, 649, 650,
-	651, 652, 653, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1369, 0, 0, 1616, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	70, 35, 72, 0, 0, 0, 0, 0, 0, 1410,
+	0, 0, 0, 0, 732, 0, 0, 0, 0, 76,
+	0, 0, 0, 38, 64, 65, 0, 62, 66, 0,
+	0, 0, 0, 0, 0, 0, 63, 0, 0, 0,
+	1439, 0, 0, 0, 0, 0, 0, 1443, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1454, 1455,
+	1456, 1457, 1458, 1459, 1460, 51, 0, 2211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2220, 2221,
+	2223, 2225, 1616, 0, 0, 0, 0, 79, 2231, 0,
+	0, 0, 0, 2232, 0, 1478, 0, 0, 0, 0,
+	0, 2246, 0, 2249, 0, 0, 2253, 1616, 0, 0,
+	2257, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2505, 0, 0, 0, 0, 0,
+	0, 0, 0, 2509, 1041, 1041, 1041, 0, 0, 0,
+	2514, 2279, 2280, 0, 0, 2284, 0, 0, 0, 0,
+	1164, 0, 2518, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1616, 2296, 0, 0, 0, 0, 732, 732,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2038, 0, 71, 0, 2038, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 147, 152, 149,
-	155, 156, 157, 158, 160, 161, 162, 163, 0, 0,
-	0, 0, 0, 164, 165, 166, 167, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 834, 820, 406, 768, 837, 738, 756, 847, 759,
-	762, 802, 717, 781, 329, 753, 0, 742, 713, 748,
-	714, 740, 770, 233, 737, 822, 785, 836, 285, 230,
-	719, 743, 343, 758, 184, 804, 382, 218, 295, 292,
-	413, 244, 236, 232, 217, 269, 301, 341, 400, 335,
-	843, 289, 791, 0, 391, 314, 0, 0, 2038, 772,
-	826, 779, 816, 767, 803, 727, 790, 838, 754, 799,
-	839, 275, 216, 183, 326, 392, 248, 0, 2375, 0,
-	2370, 175, 176, 177, 0, 2415, 71, 2416, 0, 0,
-	0, 0, 0, 207, 0, 214, 750, 796, 833, 751,
-	798, 228, 273, 235, 227, 410, 844, 825, 0, 0,
-	199, 835, 774, 0, 801, 0, 850, 712, 793, 0,
-	715, 718, 846, 829, 746, 238, 0, 0, 0, 0,
-	71, 0, 0, 771, 780, 813, 765, 0, 0, 0,
-	0, 0, 0, 0, 744, 0, 789, 0, 0, 0,
-	723, 716, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 769, 0, 0, 0, 726, 0, 745,
-	814, 0, 710, 256, 720, 315, 0, 818, 828, 766,
-	442, 832, 764, 763, 808, 724, 824, 757, 284, 722,
-	281, 179, 195, 0, 755, 325, 365, 371, 823, 741,
-	749, 219, 747, 369, 339, 427, 203, 246, 362, 344,
-	367, 788, 806, 368, 290, 415, 357, 425, 443, 444,
-	226, 319, 433, 404, 439, 455, 196, 223, 333, 397,
-	430, 388, 312, 411, 412, 280, 387, 254, 182, 288,
-	449, 194, 377, 211, 201, 187, 399, 423, 208, 380,
-	0, 0, 457, 189, 421, 396, 308, 277, 278, 188,
-	0, 361, 231, 252, 221, 328, 418, 419, 220, 458,
-	198, 438, 191, 1028, 437, 321, 414, 422, 309, 300,
-	190, 420, 307, 299, 283, 242, 263, 355, 293, 356,
-	264, 317, 316, 318, 0, 185, 0, 393, 431, 459,
-	204, 205, 206, 736, 241, 245, 251, 253, 259, 260,
-	267, 286, 332, 354, 352, 358, 819, 409, 426, 434,
-	441, 447, 448, 450, 451, 452, 453, 454, 320, 266,
-	389, 282, 291, 811, 849, 338, 370, 209, 429, 390,
-	731, 735, 729, 730, 783, 784, 732, 840, 841, 842,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 0, 815,
-	725, 0, 733, 734, 0, 821, 830, 831, 787, 178,
-	192, 287, 845, 359, 249, 456, 436, 432, 711, 728,
-	225, 739, 0, 0, 752, 760, 761, 773, 775, 776,
-	777, 778, 311, 794, 795, 797, 805, 807, 810, 812,
-	817, 827, 848, 180, 181, 193, 202, 212, 224, 239,
-	247, 257, 262, 265, 270, 271, 274, 279, 297, 302,
-	303, 304, 305, 322, 323, 324, 327, 330, 331, 334,
-	336, 337, 340, 347, 348, 349, 350, 351, 353, 360,
-	364, 372, 373, 374, 375, 376, 378, 379, 383, 384,
-	385, 386, 394, 398, 416, 417, 428, 440, 445, 258,
-	424, 446, 0, 296, 786, 792, 298, 243, 261, 272,
-	800, 435, 395, 197, 366, 250, 186, 215, 200, 222,
-	237, 240, 276, 306, 313, 342, 346, 255, 234, 213,
-	363, 210, 381, 401, 402, 403, 405, 310, 229, 345,
-	782, 809, 294, 407, 408, 268, 834, 820, 406, 768,
-	837, 738, 756, 847, 759, 762, 802, 717, 781, 329,
-	753, 0, 742, 713, 748, 714, 740, 770, 233, 737,
-	822, 785, 836, 285, 230, 719, 743, 343, 758, 184,
-	804, 382, 218, 295, 292, 413, 244, 236, 232, 217,
-	269, 301, 341, 400, 335, 843, 289, 791, 0, 391,
-	314, 0, 0, 0, 772, 826, 779, 816, 767, 803,
-	727, 790, 838, 754, 799, 839, 275, 216, 183, 326,
-	392, 248, 0, 0, 0, 0, 175, 176, 177, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1616, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 41, 44, 47, 46,
+	49, 0, 61, 0, 0, 67, 0, 0, 0, 0,
+	496, 2333, 496, 0, 0, 496, 0, 0, 0, 2514,
+	0, 0, 0, 0, 0, 0, 0, 50, 75, 74,
+	0, 0, 59, 60, 48, 0, 0, 0, 1627, 0,
+	0, 0, 0, 2235, 0, 0, 0, 1633, 0, 0,
+	1478, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2357, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 52, 53, 1616, 54, 55, 56,
+	57, 0, 2378, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1640, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2419, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1165, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2430, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2440,
+	2441, 2442, 0, 2443, 2444, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 73, 496,
+	2455, 0, 2457, 0, 0, 2462, 0, 0, 0, 2463,
+	2464, 78, 0, 0, 0, 0, 700, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1478, 0, 0, 496, 0,
+	0, 0, 1763, 0, 0, 0, 0, 1773, 1774, 0,
+	0, 1778, 0, 0, 0, 0, 0, 0, 0, 1781,
+	0, 0, 0, 0, 0, 2500, 1784, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1787, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2573,
+	2574, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 496, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2606, 0, 0, 0, 0, 0, 0,
+	0, 1165, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1165, 1165, 0, 0,
+	0, 0, 496, 0, 0, 0, 1828, 1906, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1384, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 496, 0, 0, 1857, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1429, 0, 0, 690,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 496, 0, 0, 0, 1885, 1886, 0, 496,
+	0, 0, 0, 1964, 0, 1905, 0, 0, 1452, 1453,
+	496, 496, 496, 496, 496, 496, 496, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 496, 0, 0,
+	0, 0, 0, 0, 2001, 2002, 2003, 2004, 2005, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1478, 2011, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2019, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	718, 1984, 0, 0, 0, 0, 0, 718, 718, 0,
+	0, 0, 0, 1165, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 718, 1429, 718, 718, 718, 718,
+	718, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1384, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 718, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 700, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	496, 2058, 0, 0, 0, 0, 1429, 0, 0, 496,
+	0, 496, 1645, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1640, 0, 0, 2154, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2103, 0,
+	71, 0, 0, 1640, 1640, 1640, 1640, 1640, 0, 0,
+	0, 0, 2173, 0, 0, 0, 0, 0, 0, 0,
+	1905, 0, 0, 1640, 0, 0, 1640, 0, 0, 0,
+	0, 2185, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2189, 0, 0, 2139, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2200, 0, 0, 2203, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2187, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2258,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2210, 0, 0, 0, 496, 0, 0,
+	0, 0, 0, 0, 496, 0, 0, 0, 0, 496,
+	496, 0, 0, 496, 0, 1780, 0, 0, 0, 0,
+	0, 496, 0, 0, 0, 0, 0, 0, 496, 0,
+	0, 0, 0, 0, 2254, 2255, 2256, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 496, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2326, 0, 0,
+	2327, 2328, 2329, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1640, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 718, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 718, 718, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 1429, 0,
+	0, 496, 626, 633, 634, 635, 636, 627, 629, 1384,
+	0, 0, 628, 0, 0, 631, 637, 638, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 496, 496,
+	0, 0, 0, 0, 0, 0, 0, 0, 2146, 2147,
+	0, 0, 0, 2103, 0, 71, 0, 2103, 0, 0,
+	639, 640, 641, 642, 643, 644, 645, 646, 647, 648,
+	649, 650, 651, 652, 653, 654, 655, 656, 657, 658,
+	659, 660, 661, 662, 663, 664, 665, 666, 667, 668,
+	669, 670, 671, 672, 673, 674, 675, 676, 677, 678,
+	679, 0, 0, 0, 0, 496, 0, 0, 0, 0,
+	0, 0, 1970, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2499, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 496, 496, 496, 496,
+	496, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 496, 496, 0, 0, 0, 2103, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 496,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2494, 0, 0, 718, 0, 0, 71, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 718, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 496, 0, 0, 71, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1165, 0, 0, 0, 0, 1165, 496, 496, 496, 496,
+	496, 0, 0, 0, 0, 0, 0, 0, 2122, 0,
+	0, 0, 0, 496, 1384, 0, 496, 0, 0, 496,
+	2131, 1429, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2581, 0, 0, 0, 496, 79, 0,
+	0, 0, 0, 0, 626, 633, 634, 635, 636, 627,
+	629, 0, 0, 0, 628, 0, 0, 631, 637, 638,
+	0, 0, 0, 0, 496, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1165, 0, 0, 0, 0, 0,
+	0, 0, 0, 496, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 496, 0, 0, 0, 0, 0, 0,
+	2146, 2147, 0, 0, 0, 0, 0, 496, 0, 0,
+	496, 0, 639, 640, 641, 642, 643, 644, 645, 646,
+	647, 648, 649, 650, 651, 652, 653, 654, 655, 656,
+	657, 658, 659, 660, 661, 662, 663, 664, 665, 666,
+	667, 668, 669, 670, 671, 672, 673, 674, 675, 676,
+	677, 678, 679, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 496, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 496, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 496,
+	0, 0, 496, 496, 496, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	617, 0, 0, 0, 618, 619, 0, 0, 0, 620,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1384, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1165, 849, 835, 420, 791, 852,
+	763, 779, 862, 782, 785, 820, 743, 801, 338, 776,
+	0, 766, 739, 772, 740, 764, 793, 236, 762, 837,
+	805, 851, 289, 233, 745, 767, 352, 781, 184, 822,
+	396, 220, 302, 299, 427, 247, 239, 235, 217, 273,
+	308, 350, 414, 344, 858, 294, 811, 0, 405, 321,
+	0, 0, 0, 795, 841, 799, 832, 790, 821, 753,
+	810, 853, 777, 817, 854, 279, 216, 183, 333, 406,
+	251, 0, 218, 227, 255, 291, 295, 296, 334, 335,
+	357, 371, 395, 0, 0, 0, 175, 176, 177, 0,
+	2548, 0, 2549, 0, 0, 0, 0, 0, 207, 0,
+	214, 774, 814, 848, 775, 816, 231, 277, 238, 230,
+	424, 859, 840, 0, 0, 199, 850, 797, 0, 819,
+	0, 865, 738, 813, 0, 741, 744, 861, 844, 770,
+	241, 0, 0, 0, 0, 0, 0, 0, 794, 800,
+	829, 788, 0, 0, 0, 0, 0, 0, 0, 768,
+	0, 809, 0, 0, 496, 749, 742, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1165, 0, 792, 0,
+	0, 0, 752, 0, 769, 830, 0, 736, 260, 746,
+	322, 0, 833, 843, 789, 456, 847, 787, 786, 825,
+	750, 839, 780, 288, 748, 285, 179, 195, 0, 778,
+	332, 376, 382, 838, 765, 773, 221, 771, 380, 348,
+	441, 203, 249, 373, 353, 378, 808, 824, 379, 297,
+	429, 367, 439, 457, 458, 229, 326, 447, 418, 453,
+	469, 196, 225, 342, 411, 444, 402, 319, 425, 426,
+	284, 401, 258, 182, 293, 463, 194, 388, 211, 201,
+	187, 413, 437, 208, 391, 0, 0, 471, 189, 435,
+	410, 315, 281, 282, 188, 0, 372, 234, 256, 223,
+	337, 432, 433, 222, 472, 198, 452, 191, 1043, 451,
+	328, 428, 436, 316, 307, 190, 434, 314, 306, 287,
+	245, 267, 365, 300, 366, 268, 324, 323, 325, 0,
+	185, 0, 407, 445, 473, 204, 205, 206, 761, 244,
+	248, 254, 257, 263, 264, 271, 290, 341, 364, 362,
+	368, 834, 423, 440, 448, 455, 461, 462, 464, 465,
+	466, 467, 468, 327, 270, 403, 286, 298, 827, 864,
+	347, 381, 209, 443, 404, 756, 760, 754, 755, 803,
+	804, 757, 855, 856, 857, 474, 475, 476, 477, 478,
+	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 490, 491, 0, 831, 751, 0, 758, 759, 0,
+	836, 845, 846, 807, 178, 192, 292, 860, 369, 252,
+	470, 450, 823, 863, 393, 392, 219, 442, 360, 261,
+	336, 385, 446, 737, 228, 0, 0, 783, 784, 796,
+	798, 318, 815, 828, 842, 180, 181, 193, 202, 212,
+	226, 242, 250, 266, 269, 274, 275, 278, 283, 304,
+	309, 310, 311, 312, 329, 330, 331, 339, 340, 343,
+	345, 346, 349, 356, 358, 359, 361, 363, 370, 375,
+	383, 384, 386, 387, 389, 390, 397, 398, 399, 400,
+	408, 412, 430, 431, 454, 459, 262, 438, 460, 0,
+	303, 806, 812, 305, 246, 265, 276, 818, 449, 409,
+	197, 377, 253, 186, 215, 200, 224, 240, 243, 280,
+	313, 320, 351, 355, 259, 237, 213, 374, 210, 394,
+	415, 416, 417, 419, 317, 232, 354, 802, 826, 301,
+	421, 422, 272, 849, 835, 420, 791, 852, 763, 779,
+	862, 782, 785, 820, 743, 801, 338, 776, 0, 766,
+	739, 772, 740, 764, 793, 236, 762, 837, 805, 851,
+	289, 233, 745, 767, 352, 781, 184, 822, 396, 220,
+	302, 299, 427, 247, 239, 235, 217, 273, 308, 350,
+	414, 344, 858, 294, 811, 0, 405, 321, 0, 0,
+	0, 795, 841, 799, 832, 790, 821, 753, 810, 853,
+	777, 817, 854, 279, 216, 183, 333, 406, 251, 0,
+	218, 227, 255, 291, 295, 296, 334, 335, 357, 371,
+	395, 0, 0, 0, 175, 176, 177, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 207, 0, 214, 774,
+	814, 848, 775, 816, 231, 277, 238, 230, 424, 859,
+	840, 0, 0, 199, 850, 797, 0, 819, 0, 865,
+	738, 813, 0, 741, 744, 861, 844, 770, 241, 0,
+	0, 0, 0, 0, 0, 0, 794, 800, 829, 788,
+	0, 0, 0, 0, 0, 2132, 0, 768, 0, 809,
+	0, 0, 0, 749, 742, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 792, 0, 0, 0,
+	752, 0, 769, 830, 0, 736, 260, 746, 322, 0,
+	833, 843, 789, 456, 847, 787, 786, 825, 750, 839,
+	780, 288, 748, 285, 179, 195, 0, 778, 332, 376,
+	382, 838, 765, 773, 221, 771, 380, 348, 441, 203,
+	249, 373, 353, 378, 808, 824, 379, 297, 429, 367,
+	439, 457, 458, 229, 326, 447, 418, 453, 469, 196,
+	225, 342, 411, 444, 402, 319, 425, 426, 284, 401,
+	258, 182, 293, 463, 194, 388, 211, 201, 187, 413,
+	437, 208, 391, 0, 0, 471, 189, 435, 410, 315,
+	281, 282, 188, 0, 372, 234, 256, 223, 337, 432,
+	433, 222, 472, 198, 452, 191, 1043, 451, 328, 428,
+	436, 316, 307, 190, 434, 314, 306, 287, 245, 267,
+	365, 300, 366, 268, 324, 323, 325, 0, 185, 0,
+	407, 445, 473, 204, 205, 206, 761, 244, 248, 254,
+	257, 263, 264, 271, 290, 341, 364, 362, 368, 834,
+	423, 440, 448, 455, 461, 462, 464, 465, 466, 467,
+	468, 327, 270, 403, 286, 298, 827, 864, 347, 381,
+	209, 443, 404, 756, 760, 754, 755, 803, 804, 757,
+	855, 856, 857, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
+	491, 0, 831, 751, 0, 758, 759, 0, 836, 845,
+	846, 807, 178, 192, 292, 860, 369, 252, 470, 450,
+	823, 863, 393, 392, 219, 442, 360, 261, 336, 385,
+	446, 737, 228, 0, 0, 783, 784, 796, 798, 318,
+	815, 828, 842, 180, 181, 193, 202, 212, 226, 242,
+	250, 266, 269, 274, 275, 278, 283, 304, 309, 310,
+	311, 312, 329, 330, 331, 339, 340, 343, 345, 346,
+	349, 356, 358, 359, 361, 363, 370, 375, 383, 384,
+	386, 387, 389, 390, 397, 398, 399, 400, 408, 412,
+	430, 431, 454, 459, 262, 438, 460, 0, 303, 806,
+	812, 305, 246, 265, 276, 818, 449, 409, 197, 377,
+	253, 186, 215, 200, 224, 240, 243, 280, 313, 320,
+	351, 355, 259, 237, 213, 374, 210, 394, 415, 416,
+	417, 419, 317, 232, 354, 802, 826, 301, 421, 422,
+	272, 849, 835, 420, 791, 852, 763, 779, 862, 782,
+	785, 820, 743, 801, 338, 776, 0, 766, 739, 772,
+	740, 764, 793, 236, 762, 837, 805, 851, 289, 233,
+	745, 767, 352, 781, 184, 822, 396, 220, 302, 299,
+	427, 247, 239, 235, 217, 273, 308, 350, 414, 344,
+	858, 294, 811, 0, 405, 321, 0, 0, 0, 795,
+	841, 799, 832, 790, 821, 753, 810, 853, 777, 817,
+	854, 279, 216, 183, 333, 406, 251, 0, 218, 227,
+	255, 291, 295, 296, 334, 335, 357, 371, 395, 0,
+	0, 0, 175, 176, 177, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 207, 0, 214, 774, 814, 848,
+	775, 816, 231, 277, 238, 230, 424, 859, 840, 0,
+	0, 199, 850, 797, 0, 819, 0, 865, 738, 813,
+	0, 741, 744, 861, 844, 770, 241, 0, 0, 0,
+	0, 0, 0, 0, 794, 800, 829, 788, 0, 0,
+	0, 0, 0, 2094, 0, 768, 0, 809, 0, 0,
+	0, 749, 742, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 792, 0, 0, 0, 752, 0,
+	769, 830, 0, 736, 260, 746, 322, 0, 833, 843,
+	789, 456, 847, 787, 786, 825, 750, 839, 780, 288,
+	748, 285, 179, 195, 0, 778, 332, 376, 382, 838,
+	765, 773, 221, 771, 380, 348, 441, 203, 249, 373,
+	353, 378, 808, 824, 379, 297, 429, 367, 439, 457,
+	458, 229, 326, 447, 418, 453, 469, 196, 225, 342,
+	411, 444, 402, 319, 425, 426, 284, 401, 258, 182,
+	293, 463, 194, 388, 211, 201, 187, 413, 437, 208,
+	391, 0, 0, 471, 189, 435, 410, 315, 281, 282,
+	188, 0, 372, 234, 256, 223, 337, 432, 433, 222,
+	472, 198, 452, 191, 1043, 451, 328, 428, 436, 316,
+	307, 190, 434, 314, 306, 287, 245, 267, 365, 300,
+	366, 268, 324, 323, 325, 0, 185, 0, 407, 445,
+	473, 204, 205, 206, 761, 244, 248, 254, 257, 263,
+	264, 271, 290, 341, 364, 362, 368, 834, 423, 440,
+	448, 455, 461, 462, 464, 465, 466, 467, 468, 327,
+	270, 403, 286, 298, 827, 864, 347, 381, 209, 443,
+	404, 756, 760, 754, 755, 803, 804, 757, 855, 856,
+	857, 474, 475, 476, 477, 478, 479, 480, 481, 482,
+	483, 484, 485, 486, 487, 488, 489, 490, 491, 0,
+	831, 751, 0, 758, 759, 0, 836, 845, 846, 807,
+	178, 192, 292, 860, 369, 252, 470, 450, 823, 863,
+	393, 392, 219, 442, 360, 261, 336, 385, 446, 737,
+	228, 0, 0, 783, 784, 796, 798, 318, 815, 828,
+	842, 180, 181, 193, 202, 212, 226, 242, 250, 266,
+	269, 274, 275, 278, 283, 304, 309, 310, 311, 312,
+	329, 330, 331, 339, 340, 343, 345, 346, 349, 356,
+	358, 359, 361, 363, 370, 375, 383, 384, 386, 387,
+	389, 390, 397, 398, 399, 400, 408, 412, 430, 431,
+	454, 459, 262, 438, 460, 0, 303, 806, 812, 305,
+	246, 265, 276, 818, 449, 409, 197, 377, 253, 186,
+	215, 200, 224, 240, 243, 280, 313, 320, 351, 355,
+	259, 237, 213, 374, 210, 394, 415, 416, 417, 419,
+	317, 232, 354, 802, 826, 301, 421, 422, 272, 849,
+	835, 420, 791, 852, 763, 779, 862, 782, 785, 820,
+	743, 801, 338, 776, 0, 766, 739, 772, 740, 764,
+	793, 236, 762, 837, 805, 851, 289, 233, 745, 767,
+	352, 781, 184, 822, 396, 220, 302, 299, 427, 247,
+	239, 235, 217, 273, 308, 350, 414, 344, 858, 294,
+	811, 0, 405, 321, 0, 0, 0, 795, 841, 799,
+	832, 790, 821, 753, 810, 853, 777, 817, 854, 279,
+	216, 183, 333, 406, 251, 0, 218, 227, 255, 291,
+	295, 296, 334, 335, 357, 371, 395, 0, 0, 0,
+	175, 176, 177, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 207, 0, 214, 774, 814, 848, 775, 816,
+	231, 277, 238, 230, 424, 859, 840, 0, 0, 199,
+	850, 797, 0, 819, 0, 865, 738, 813, 0, 741,
+	744, 861, 844, 770, 241, 0, 0, 0, 0, 0,
+	0, 0, 794, 800, 829, 788, 0, 0, 0, 0,
+	0, 1629, 0, 768, 0, 809, 0, 0, 0, 749,
+	742, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 792, 0, 0, 0, 752, 0, 769, 830,
+	0, 736, 260, 746, 322, 0, 833, 843, 789, 456,
+	847, 787, 786, 825, 750, 839, 780, 288, 748, 285,
+	179, 195, 0, 778, 332, 376, 382, 838, 765, 773,
+	221, 771, 380, 348, 441, 203, 249, 373, 353, 378,
+	808, 824, 379, 297, 429, 367, 439, 457, 458, 229,
+	326, 447, 418, 453, 469, 196, 225, 342, 411, 444,
+	402, 319, 425, 426, 284, 401, 258, 182, 293, 463,
+	194, 388, 211, 201, 187, 413, 437, 208, 391, 0,
+	0, 471, 189, 435, 410, 315, 281, 282, 188, 0,
+	372, 234, 256, 223, 337, 432, 433, 222, 472, 198,
+	452, 191, 1043, 451, 328, 428, 436, 316, 307, 190,
+	434, 314, 306, 287, 245, 267, 365, 300, 366, 268,
+	324, 323, 325, 0, 185, 0, 407, 445, 473, 204,
+	205, 206, 761, 244, 248, 254, 257, 263, 264, 271,
+	290, 341, 364, 362, 368, 834, 423, 440, 448, 455,
+	461, 462, 464, 465, 466, 467, 468, 327, 270, 403,
+	286, 298, 827, 864, 347, 381, 209, 443, 404, 756,
+	760, 754, 755, 803, 804, 757, 855, 856, 857, 474,
+	475, 476, 477, 478, 479, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 490, 491, 0, 831, 751,
+	0, 758, 759, 0, 836, 845, 846, 807, 178, 192,
+	292, 860, 369, 252, 470, 450, 823, 863, 393, 392,
+	219, 442, 360, 261, 336, 385, 446, 737, 228, 0,
+	0, 783, 784, 796, 798, 318, 815, 828, 842, 180,
+	181, 193, 202, 212, 226, 242, 250, 266, 269, 274,
+	275, 278, 283, 304, 309, 310, 311, 312, 329, 330,
+	331, 339, 340, 343, 345, 346, 349, 356, 358, 359,
+	361, 363, 370, 375, 383, 384, 386, 387, 389, 390,
+	397, 398, 399, 400, 408, 412, 430, 431, 454, 459,
+	262, 438, 460, 0, 303, 806, 812, 305, 246, 265,
+	276, 818, 449, 409, 197, 377, 253, 186, 215, 200,
+	224, 240, 243, 280, 313, 320, 351, 355, 259, 237,
+	213, 374, 210, 394, 415, 416, 417, 419, 317, 232,
+	354, 802, 826, 301, 421, 422, 272, 849, 835, 420,
+	791, 852, 763, 779, 862, 782, 785, 820, 743, 801,
+	338, 776, 0, 766, 739, 772, 740, 764, 793, 236,
+	762, 837, 805, 851, 289, 233, 745, 767, 352, 781,
+	184, 822, 396, 220, 302, 299, 427, 247, 239, 235,
+	217, 273, 308, 350, 414, 344, 858, 294, 811, 0,
+	405, 321, 0, 0, 0, 795, 841, 799, 832, 790,
+	821, 753, 810, 853, 777, 817, 854, 279, 216, 183,
+	333, 406, 251, 0, 218, 227, 255, 291, 295, 296,
+	334, 335, 357, 371, 395, 79, 0, 0, 175, 176,
+	177, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	207, 0, 214, 774, 814, 848, 775, 816, 231, 277,
+	238, 230, 424, 859, 840, 0, 0, 199, 850, 797,
+	0, 819, 0, 865, 738, 813, 0, 741, 744, 861,
+	844, 770, 241, 0, 0, 0, 0, 0, 0, 0,
+	794, 800, 829, 788, 0, 0, 0, 0, 0, 0,
+	0, 768, 0, 809, 0, 0, 0, 749, 742, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	792, 0, 0, 0, 752, 0, 769, 830, 0, 736,
+	260, 746, 322, 0, 833, 843, 789, 456, 847, 787,
+	786, 825, 750, 839, 780, 288, 748, 285, 179, 195,
+	0, 778, 332, 376, 382, 838, 765, 773, 221, 771,
+	380, 348, 441, 203, 249, 373, 353, 378, 808, 824,
+	379, 297, 429, 367, 439, 457, 458, 229, 326, 447,
+	418, 453, 469, 196, 225, 342, 411, 444, 402, 319,
+	425, 426, 284, 401, 258, 182, 293, 463, 194, 388,
+	211, 201, 187, 413, 437, 208, 391, 0, 0, 471,
+	189, 435, 410, 315, 281, 282, 188, 0, 372, 234,
+	256, 223, 337, 432, 433, 222, 472, 198, 452, 191,
+	1043, 451, 328, 428, 436, 316, 307, 190, 434, 314,
+	306, 287, 245, 267, 365, 300, 366, 268, 324, 323,
+	325, 0, 185, 0, 407, 445, 473, 204, 205, 206,
+	761, 244, 248, 254, 257, 263, 264, 271, 290, 341,
+	364, 362, 368, 834, 423, 440, 448, 455, 461, 462,
+	464, 465, 466, 467, 468, 327, 270, 403, 286, 298,
+	827, 864, 347, 381, 209, 443, 404, 756, 760, 754,
+	755, 803, 804, 757, 855, 856, 857, 474, 475, 476,
+	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 0, 831, 751, 0, 758,
+	759, 0, 836, 845, 846, 807, 178, 192, 292, 860,
+	369, 252, 470, 450, 823, 863, 393, 392, 219, 442,
+	360, 261, 336, 385, 446, 737, 228, 0, 0, 783,
+	784, 796, 798, 318, 815, 828, 842, 180, 181, 193,
+	202, 212, 226, 242, 250, 266, 269, 274, 275, 278,
+	283, 304, 309, 310, 311, 312, 329, 330, 331, 339,
+	340, 343, 345, 346, 349, 356, 358, 359, 361, 363,
+	370, 375, 383, 384, 386, 387, 389, 390, 397, 398,
+	399, 400, 408, 412, 430, 431, 454, 459, 262, 438,
+	460, 0, 303, 806, 812, 305, 246, 265, 276, 818,
+	449, 409, 197, 377, 253, 186, 215, 200, 224, 240,
+	243, 280, 313, 320, 351, 355, 259, 237, 213, 374,
+	210, 394, 415, 416, 417, 419, 317, 232, 354, 802,
+	826, 301, 421, 422, 272, 849, 835, 420, 791, 852,
+	763, 779, 862, 782, 785, 820, 743, 801, 338, 776,
+	0, 766, 739, 772, 740, 764, 793, 236, 762, 837,
+	805, 851, 289, 233, 745, 767, 352, 781, 184, 822,
+	396, 220, 302, 299, 427, 247, 239, 235, 217, 273,
+	308, 350, 414, 344, 858, 294, 811, 0, 405, 321,
+	0, 0, 0, 795, 841, 799, 832, 790, 821, 753,
+	810, 853, 777, 817, 854, 279, 216, 183, 333, 406,
+	251, 0, 218, 227, 255, 291, 295, 296, 334, 335,
+	357, 371, 395, 0, 0, 0, 175, 176, 177, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
-	214, 750, 796, 833, 751, 798, 228, 273, 235, 227,
-	410, 844, 825, 0, 0, 199, 835, 774, 0, 801,
-	0, 850, 712, 793, 0, 715, 718, 846, 829, 746,
-	238, 0, 0, 0, 0, 0, 0, 0, 771, 780,
-	813, 765, 0, 0, 0, 0, 0, 2067, 0, 744,
-	0, 789, 0, 0, 0, 723, 716, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 769, 0,
-	0, 0, 726, 0, 745, 814, 0, 710, 256, 720,
-	315, 0, 818, 828, 766, 442, 832, 764, 763, 808,
-	724, 824, 757, 284, 722, 281, 179, 195, 0, 755,
-	325, 365, 371, 823, 741, 749, 219, 747, 369, 339,
-	427, 203, 246, 362, 344, 367, 788, 806, 368, 290,
-	415, 357, 425, 443, 444, 226, 319, 433, 404, 439,
-	455, 196, 223, 333, 397, 430, 388, 312, 411, 412,
-	280, 387, 254, 182, 288, 449, 194, 377, 211, 201,
-	187, 399, 423, 208, 380, 0, 0, 457, 189, 421,
-	396, 308, 277, 278, 188, 0, 361, 231, 252, 221,
-	328, 418, 419, 220, 458, 198, 438, 191, 1028, 437,
-	321, 414, 422, 309, 300, 190, 420, 307, 299, 283,
-	242, 263, 355, 293, 356, 264, 317, 316, 318, 0,
-	185, 0, 393, 431, 459, 204, 205, 206, 736, 241,
-	245, 251, 253, 259, 260, 267, 286, 332, 354, 352,
-	358, 819, 409, 426, 434, 441, 447, 448, 450, 451,
-	452, 453, 454, 320, 266, 389, 282, 291, 811, 849,
-	338, 370, 209, 429, 390, 731, 735, 729, 730, 783,
-	784, 732, 840, 841, 842, 460, 461, 462, 463, 464,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 0, 815, 725, 0, 733, 734, 0,
-	821, 830, 831, 787, 178, 192, 287, 845, 359, 249,
-	456, 436, 432, 711, 728, 225, 739, 0, 0, 752,
-	760, 761, 773, 775, 776, 777, 778, 311, 794, 795,
-	797, 805, 807, 810, 812, 817, 827, 848, 180, 181,
-	193, 202, 212, 224, 239, 247, 257, 262, 265, 270,
-	271, 274, 279, 297, 302, 303, 304, 305, 322, 323,
-	324, 327, 330, 331, 334, 336, 337, 340, 347, 348,
-	349, 350, 351, 353, 360, 364, 372, 373, 374, 375,
-	376, 378, 379, 383, 384, 385, 386, 394, 398, 416,
-	417, 428, 440, 445, 258, 424, 446, 0, 296, 786,
-	792, 298, 243, 261, 272, 800, 435, 395, 197, 366,
-	250, 186, 215, 200, 222, 237, 240, 276, 306, 313,
-	342, 346, 255, 234, 213, 363, 210, 381, 401, 402,
-	403, 405, 310, 229, 345, 782, 809, 294, 407, 408,
-	268, 834, 820, 406, 768, 837, 738, 756, 847, 759,
-	762, 802, 717, 781, 329, 753, 0, 742, 713, 748,
-	714, 740, 770, 233, 737, 822, 785, 836, 285, 230,
-	719, 743, 343, 758, 184, 804, 382, 218, 295, 292,
-	413, 244, 236, 232, 217, 269, 301, 341, 400, 335,
-	843, 289, 791, 0, 391, 314, 0, 0, 0, 772,
-	826, 779, 816, 767, 803, 727, 790, 838, 754, 799,
-	839, 275, 216, 183, 326, 392, 248, 0, 0, 0,
+	214, 774, 814, 848, 775, 816, 231, 277, 238, 230,
+	424, 859, 840, 0, 0, 199, 850, 797, 0, 819,
+	0, 865, 738, 813, 0, 741, 744, 861, 844, 770,
+	241, 0, 0, 0, 0, 0, 0, 0, 794, 800,
+	829, 788, 0, 0, 0, 0, 0, 0, 0, 768,
+	0, 809, 0, 0, 0, 749, 742, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 792, 0,
+	0, 0, 752, 0, 769, 830, 0, 736, 260, 746,
+	322, 0, 833, 843, 789, 456, 847, 787, 786, 825,
+	750, 839, 780, 288, 748, 285, 179, 195, 0, 778,
+	332, 376, 382, 838, 765, 773, 221, 771, 380, 348,
+	441, 203, 249, 373, 353, 378, 808, 824, 379, 297,
+	429, 367, 439, 457, 458, 229, 326, 447, 418, 453,
+	469, 196, 225, 342, 411, 444, 402, 319, 425, 426,
+	284, 401, 258, 182, 293, 463, 194, 388, 211, 201,
+	187, 413, 437, 208, 391, 0, 0, 471, 189, 435,
+	410, 315, 281, 282, 188, 0, 372, 234, 256, 223,
+	337, 432, 433, 222, 472, 198, 452, 191, 1043, 451,
+	328, 428, 436, 316, 307, 190, 434, 314, 306, 287,
+	245, 267, 365, 300, 366, 268, 324, 323, 325, 0,
+	185, 0, 407, 445, 473, 204, 205, 206, 761, 244,
+	248, 254, 257, 263, 264, 271, 290, 341, 364, 362,
+	368, 834, 423, 440, 448, 455, 461, 462, 464, 465,
+	466, 467, 468, 327, 270, 403, 286, 298, 827, 864,
+	347, 381, 209, 443, 404, 756, 760, 754, 755, 803,
+	804, 757, 855, 856, 857, 474, 475, 476, 477, 478,
+	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 490, 491, 0, 831, 751, 0, 758, 759, 0,
+	836, 845, 846, 807, 178, 192, 292, 860, 369, 252,
+	470, 450, 823, 863, 393, 392, 219, 442, 360, 261,
+	336, 385, 446, 737, 228, 0, 0, 783, 784, 796,
+	798, 318, 815, 828, 842, 180, 181, 193, 202, 212,
+	226, 242, 250, 266, 269, 274, 275, 278, 283, 304,
+	309, 310, 311, 312, 329, 330, 331, 339, 340, 343,
+	345, 346, 349, 356, 358, 359, 361, 363, 370, 375,
+	383, 384, 386, 387, 389, 390, 397, 398, 399, 400,
+	408, 412, 430, 431, 454, 459, 262, 438, 460, 0,
+	303, 806, 812, 305, 246, 265, 276, 818, 449, 409,
+	197, 377, 253, 186, 215, 200, 224, 240, 243, 280,
+	313, 320, 351, 355, 259, 237, 213, 374, 210, 394,
+	415, 416, 417, 419, 317, 232, 354, 802, 826, 301,
+	421, 422, 272, 849, 835, 420, 791, 852, 763, 779,
+	862, 782, 785, 820, 743, 801, 338, 776, 0, 766,
+	739, 772, 740, 764, 793, 236, 762, 837, 805, 851,
+	289, 233, 745, 767, 352, 781, 184, 822, 396, 220,
+	302, 299, 427, 247, 239, 235, 217, 273, 308, 350,
+	414, 344, 858, 294, 811, 0, 405, 321, 0, 0,
+	0, 795, 841, 799, 832, 790, 821, 753, 810, 853,
+	777, 817, 854, 279, 216, 183, 333, 406, 251, 0,
+	218, 227, 255, 291, 295, 296, 334, 335, 357, 371,
+	395, 0, 0, 0, 175, 176, 177, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 207, 0, 214, 774,
+	814, 848, 775, 816, 231, 277, 238, 230, 424, 859,
+	840, 0, 0, 866, 850, 797, 0, 819, 0, 865,
+	738, 813, 0, 741, 744, 861, 844, 770, 241, 0,
+	0, 0, 0, 0, 0, 0, 794, 800, 829, 788,
+	0, 0, 0, 0, 0, 0, 0, 768, 0, 809,
+	0, 0, 0, 749, 742, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 792, 0, 0, 0,
+	752, 0, 769, 830, 0, 736, 260, 746, 322, 0,
+	833, 843, 789, 456, 847, 787, 786, 825, 750, 839,
+	780, 288, 748, 285, 179, 195, 0, 778, 332, 376,
+	382, 838, 765, 773, 221, 771, 380, 348, 441, 203,
+	249, 373, 353, 378, 808, 824, 379, 297, 429, 367,
+	439, 457, 458, 229, 326, 447, 418, 453, 469, 196,
+	225, 342, 411, 444, 402, 319, 425, 426, 284, 401,
+	258, 182, 293, 463, 194, 388, 211, 201, 187, 413,
+	437, 208, 391, 0, 0, 471, 189, 435, 410, 315,
+	281, 282, 188, 0, 372, 234, 256, 223, 337, 432,
+	433, 222, 472, 198, 452, 191, 747, 451, 328, 428,
+	436, 316, 307, 190, 434, 314, 306, 287, 245, 267,
+	365, 300, 366, 268, 324, 323, 325, 0, 185, 0,
+	407, 445, 473, 204, 205, 206, 761, 244, 248, 254,
+	257, 263, 264, 271, 290, 341, 364, 362, 368, 834,
+	423, 440, 448, 455, 461, 462, 464, 465, 466, 467,
+	468, 735, 729, 728, 286, 298, 827, 864, 347, 381,
+	209, 443, 404, 756, 760, 754, 755, 803, 804, 757,
+	855, 856, 857, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
+	491, 0, 831, 751, 0, 758, 759, 0, 836, 845,
+	846, 807, 178, 192, 292, 860, 369, 252, 470, 450,
+	823, 863, 393, 392, 219, 442, 360, 261, 336, 385,
+	446, 737, 228, 0, 0, 783, 784, 796, 798, 318,
+	815, 828, 842, 180, 181, 193, 202, 212, 226, 242,
+	250, 266, 269, 274, 275, 278, 283, 304, 309, 310,
+	311, 312, 329, 330, 331, 339, 340, 343, 345, 346,
+	349, 356, 358, 359, 361, 363, 370, 375, 383, 384,
+	386, 387, 389, 390, 397, 398, 399, 400, 408, 412,
+	430, 431, 454, 459, 262, 438, 460, 0, 303, 806,
+	812, 305, 246, 265, 276, 818, 449, 409, 197, 377,
+	253, 186, 215, 200, 224, 240, 243, 280, 313, 320,
+	351, 355, 259, 237, 213, 374, 210, 394, 415, 416,
+	417, 419, 317, 232, 354, 802, 826, 301, 421, 422,
+	272, 849, 835, 420, 791, 852, 763, 779, 862, 782,
+	785, 820, 743, 801, 338, 776, 0, 766, 739, 772,
+	740, 764, 793, 236, 762, 837, 805, 851, 289, 233,
+	745, 767, 352, 781, 184, 822, 396, 220, 302, 299,
+	427, 247, 239, 235, 217, 273, 308, 350, 414, 344,
+	858, 294, 811, 0, 405, 321, 0, 0, 0, 795,
+	841, 799, 832, 790, 821, 753, 810, 853, 777, 817,
+	854, 279, 216, 183, 333, 406, 251, 0, 218, 227,
+	255, 291, 295, 296, 334, 335, 357, 371, 395, 0,
+	0, 0, 175, 176, 177, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 207, 0, 214, 774, 814, 848,
+	775, 816, 231, 277, 238, 230, 424, 859, 840, 0,
+	0, 866, 850, 797, 0, 819, 0, 865, 738, 813,
+	0, 741, 744, 861, 844, 770, 241, 0, 0, 0,
+	0, 0, 0, 0, 794, 800, 829, 788, 0, 0,
+	0, 0, 0, 0, 0, 768, 0, 809, 0, 0,
+	0, 749, 742, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 792, 0, 0, 0, 752, 0,
+	769, 830, 0, 736, 260, 746, 322, 0, 833, 843,
+	789, 456, 847, 787, 786, 825, 750, 839, 780, 288,
+	748, 285, 179, 195, 0, 778, 332, 376, 382, 838,
+	765, 773, 221, 771, 380, 348, 441, 203, 249, 373,
+	353, 378, 808, 824, 379, 297, 429, 367, 439, 457,
+	458, 229, 326, 447, 418, 453, 469, 196, 225, 342,
+	411, 444, 402, 319, 425, 426, 284, 401, 258, 182,
+	293, 463, 194, 388, 211, 201, 187, 413, 1226, 208,
+	391, 0, 0, 471, 189, 435, 410, 315, 281, 282,
+	188, 0, 372, 234, 256, 223, 337, 432, 433, 222,
+	472, 198, 452, 191, 747, 451, 328, 428, 436, 316,
+	307, 190, 434, 314, 306, 287, 245, 267, 365, 300,
+	366, 268, 324, 323, 325, 0, 185, 0, 407, 445,
+	473, 204, 205, 206, 761, 244, 248, 254, 257, 263,
+	264, 271, 290, 341, 364, 362, 368, 834, 423, 440,
+	448, 455, 461, 462, 464, 465, 466, 467, 468, 735,
+	729, 728, 286, 298, 827, 864, 347, 381, 209, 443,
+	404, 756, 760, 754, 755, 803, 804, 757, 855, 856,
+	857, 474, 475, 476, 477, 478, 479, 480, 481, 482,
+	483, 484, 485, 486, 487, 488, 489, 490, 491, 0,
+	831, 751, 0, 758, 759, 0, 836, 845, 846, 807,
+	178, 192, 292, 860, 369, 252, 470, 450, 823, 863,
+	393, 392, 219, 442, 360, 261, 336, 385, 446, 737,
+	228, 0, 0, 783, 784, 796, 798, 318, 815, 828,
+	842, 180, 181, 193, 202, 212, 226, 242, 250, 266,
+	269, 274, 275, 278, 283, 304, 309, 310, 311, 312,
+	329, 330, 331, 339, 340, 343, 345, 346, 349, 356,
+	358, 359, 361, 363, 370, 375, 383, 384, 386, 387,
+	389, 390, 397, 398, 399, 400, 408, 412, 430, 431,
+	454, 459, 262, 438, 460, 0, 303, 806, 812, 305,
+	246, 265, 276, 818, 449, 409, 197, 377, 253, 186,
+	215, 200, 224, 240, 243, 280, 313, 320, 351, 355,
+	259, 237, 213, 374, 210, 394, 415, 416, 417, 419,
+	317, 232, 354, 802, 826, 301, 421, 422, 272, 849,
+	835, 420, 791, 852, 763, 779, 862, 782, 785, 820,
+	743, 801, 338, 776, 0, 766, 739, 772, 740, 764,
+	793, 236, 762, 837, 805, 851, 289, 233, 745, 767,
+	352, 781, 184, 822, 396, 220, 302, 299, 427, 247,
+	239, 235, 217, 273, 308, 350, 414, 344, 858, 294,
+	811, 0, 405, 321, 0, 0, 0, 795, 841, 799,
+	832, 790, 821, 753, 810, 853, 777, 817, 854, 279,
+	216, 183, 333, 406, 251, 0, 218, 227, 255, 291,
+	295, 296, 334, 335, 357, 371, 395, 0, 0, 0,
+	175, 176, 177, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 207, 0, 214, 774, 814, 848, 775, 816,
+	231, 277, 238, 230, 424, 859, 840, 0, 0, 866,
+	850, 797, 0, 819, 0, 865, 738, 813, 0, 741,
+	744, 861, 844, 770, 241, 0, 0, 0, 0, 0,
+	0, 0, 794, 800, 829, 788, 0, 0, 0, 0,
+	0, 0, 0, 768, 0, 809, 0, 0, 0, 749,
+	742, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 792, 0, 0, 0, 752, 0, 769, 830,
+	0, 736, 260, 746, 322, 0, 833, 843, 789, 456,
+	847, 787, 786, 825, 750, 839, 780, 288, 748, 285,
+	179, 195, 0, 778, 332, 376, 382, 838, 765, 773,
+	221, 771, 380, 348, 441, 203, 249, 373, 353, 378,
+	808, 824, 379, 297, 429, 367, 439, 457, 458, 229,
+	326, 447, 418, 453, 469, 196, 225, 342, 411, 444,
+	402, 319, 425, 426, 284, 401, 258, 182, 293, 463,
+	194, 388, 211, 201, 187, 413, 726, 208, 391, 0,
+	0, 471, 189, 435, 410, 315, 281, 282, 188, 0,
+	372, 234, 256, 223, 337, 432, 433, 222, 472, 198,
+	452, 191, 747, 451, 328, 428, 436, 316, 307, 190,
+	434, 314, 306, 287, 245, 267, 365, 300, 366, 268,
+	324, 323, 325, 0, 185, 0, 407, 445, 473, 204,
+	205, 206, 761, 244, 248, 254, 257, 263, 264, 271,
+	290, 341, 364, 362, 368, 834, 423, 440, 448, 455,
+	461, 462, 464, 465, 466, 467, 468, 735, 729, 728,
+	286, 298, 827, 864, 347, 381, 209, 443, 404, 756,
+	760, 754, 755, 803, 804, 757, 855, 856, 857, 474,
+	475, 476, 477, 478, 479, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 490, 491, 0, 831, 751,
+	0, 758, 759, 0, 836, 845, 846, 807, 178, 192,
+	292, 860, 369, 252, 470, 450, 823, 863, 393, 392,
+	219, 442, 360, 261, 336, 385, 446, 737, 228, 0,
+	0, 783, 784, 796, 798, 318, 815, 828, 842, 180,
+	181, 193, 202, 212, 226, 242, 250, 266, 269, 274,
+	275, 278, 283, 304, 309, 310, 311, 312, 329, 330,
+	331, 339, 340, 343, 345, 346, 349, 356, 358, 359,
+	361, 363, 370, 375, 383, 384, 386, 387, 389, 390,
+	397, 398, 399, 400, 408, 412, 430, 431, 454, 459,
+	262, 438, 460, 0, 303, 806, 812, 305, 246, 265,
+	276, 818, 449, 409, 197, 377, 253, 186, 215, 200,
+	224, 240, 243, 280, 313, 320, 351, 355, 259, 237,
+	213, 374, 210, 394, 415, 416, 417, 419, 317, 232,
+	354, 802, 826, 301, 421, 422, 272, 420, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 338, 0,
+	0, 1568, 0, 573, 0, 0, 0, 236, 578, 0,
+	0, 0, 289, 233, 0, 1569, 352, 0, 184, 0,
+	396, 220, 302, 299, 427, 247, 239, 235, 217, 273,
+	308, 350, 414, 344, 585, 294, 0, 0, 405, 321,
+	0, 0, 0, 0, 0, 580, 581, 0, 0, 0,
+	0, 0, 0, 0, 0, 279, 216, 183, 333, 406,
+	251, 0, 605, 604, 610, 608, 611, 609, 612, 607,
+	606, 603, 602, 79, 0, 0, 175, 176, 177, 626,
+	633, 634, 635, 636, 627, 629, 0, 0, 207, 628,
+	214, 594, 631, 637, 638, 0, 231, 277, 238, 230,
+	424, 0, 0, 0, 0, 199, 0, 0, 0, 0,
+	0, 0, 0, 555, 570, 0, 584, 0, 0, 0,
+	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 567, 568, 716, 0, 0,
+	0, 600, 0, 569, 0, 0, 577, 639, 640, 641,
+	642, 643, 644, 645, 646, 647, 648, 649, 650, 651,
+	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
+	662, 663, 664, 665, 666, 667, 668, 669, 670, 671,
+	672, 673, 674, 675, 676, 677, 678, 679, 579, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
+	322, 0, 599, 0, 0, 456, 0, 0, 597, 0,
+	0, 0, 0, 288, 0, 285, 179, 195, 0, 0,
+	332, 376, 382, 0, 0, 0, 221, 0, 380, 348,
+	441, 203, 249, 373, 353, 378, 0, 0, 379, 297,
+	429, 367, 439, 457, 458, 229, 326, 447, 418, 453,
+	469, 196, 225, 342, 411, 444, 402, 319, 425, 426,
+	284, 401, 258, 182, 293, 463, 194, 388, 211, 201,
+	187, 413, 437, 208, 391, 0, 0, 471, 189, 435,
+	410, 315, 281, 282, 188, 0, 372, 234, 256, 223,
+	337, 432, 433, 222, 472, 198, 452, 191, 0, 451,
+	328, 428, 436, 316, 307, 190, 434, 314, 306, 287,
+	245, 267, 365, 300, 366, 268, 324, 323, 325, 0,
+	185, 0, 407, 445, 473, 204, 205, 206, 0, 244,
+	248, 254, 257, 263, 264, 271, 290, 341, 364, 362,
+	368, 0, 423, 440, 448, 455, 461, 462, 464, 465,
+	466, 467, 468, 327, 270, 403, 286, 298, 0, 0,
+	347, 381, 209, 443, 404, 617, 598, 589, 591, 618,
+	619, 586, 587, 590, 620, 474, 475, 476, 477, 478,
+	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 490, 491, 0, 601, 576, 575, 0, 582, 583,
+	0, 592, 593, 574, 178, 192, 292, 0, 369, 252,
+	470, 450, 0, 0, 393, 392, 219, 442, 360, 261,
+	336, 385, 446, 0, 228, 0, 0, 0, 0, 0,
+	0, 318, 0, 0, 0, 180, 181, 193, 202, 212,
+	226, 242, 250, 266, 269, 274, 275, 278, 283, 304,
+	309, 310, 311, 312, 329, 330, 331, 339, 340, 343,
+	345, 346, 349, 356, 358, 359, 361, 363, 370, 375,
+	383, 384, 386, 387, 389, 390, 397, 398, 399, 400,
+	408, 412, 430, 431, 454, 459, 262, 438, 460, 0,
+	303, 0, 0, 305, 246, 265, 276, 0, 449, 409,
+	197, 377, 253, 186, 215, 200, 224, 240, 243, 280,
+	313, 320, 351, 355, 259, 237, 213, 374, 210, 394,
+	415, 416, 417, 419, 317, 232, 354, 420, 0, 301,
+	421, 422, 272, 0, 0, 0, 0, 0, 338, 0,
+	0, 0, 0, 573, 0, 0, 0, 236, 578, 0,
+	0, 0, 289, 233, 0, 0, 352, 0, 184, 0,
+	396, 220, 302, 299, 427, 247, 239, 235, 217, 273,
+	308, 350, 414, 344, 585, 294, 0, 0, 405, 321,
+	0, 0, 0, 0, 0, 580, 581, 0, 0, 0,
+	0, 0, 0, 1652, 0, 279, 216, 183, 333, 406,
+	251, 0, 605, 604, 610, 608, 611, 609, 612, 607,
+	606, 603, 602, 79, 0, 0, 175, 176, 177, 626,
+	633, 634, 635, 636, 627, 629, 0, 0, 207, 628,
+	214, 594, 631, 637, 638, 1653, 231, 277, 238, 230,
+	424, 0, 0, 0, 0, 199, 0, 0, 0, 0,
+	0, 0, 0, 555, 570, 0, 584, 0, 0, 0,
+	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 567, 568, 0, 0, 0,
+	0, 600, 0, 569, 0, 0, 577, 639, 640, 641,
+	642, 643, 644, 645, 646, 647, 648, 649, 650, 651,
+	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
+	662, 663, 664, 665, 666, 667, 668, 669, 670, 671,
+	672, 673, 674, 675, 676, 677, 678, 679, 579, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
+	322, 0, 599, 0, 0, 456, 0, 0, 597, 0,
+	0, 0, 0, 288, 0, 285, 179, 195, 0, 0,
+	332, 376, 382, 0, 0, 0, 221, 0, 380, 348,
+	441, 203, 249, 373, 353, 378, 0, 0, 379, 297,
+	429, 367, 439, 457, 458, 229, 326, 447, 418, 453,
+	469, 196, 225, 342, 411, 444, 402, 319, 425, 426,
+	284, 401, 258, 182, 293, 463, 194, 388, 211, 201,
+	187, 413, 437, 208, 391, 0, 0, 471, 189, 435,
+	410, 315, 281, 282, 188, 0, 372, 234, 256, 223,
+	337, 432, 433, 222, 472, 198, 452, 191, 0, 451,
+	328, 428, 436, 316, 307, 190, 434, 314, 306, 287,
+	245, 267, 365, 300, 366, 268, 324, 323, 325, 0,
+	185, 0, 407, 445, 473, 204, 205, 206, 0, 244,
+	248, 254, 257, 263, 264, 271, 290, 341, 364, 362,
+	368, 0, 423, 440, 448, 455, 461, 462, 464, 465,
+	466, 467, 468, 327, 270, 403, 286, 298, 0, 0,
+	347, 381, 209, 443, 404, 617, 598, 589, 591, 618,
+	619, 586, 587, 590, 620, 474, 475, 476, 477, 478,
+	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 490, 491, 0, 601, 576, 575, 0, 582, 583,
+	0, 592, 593, 574, 178, 192, 292, 0, 369, 252,
+	470, 450, 0, 0, 393, 392, 219, 442, 360, 261,
+	336, 385, 446, 0, 228, 0, 0, 0, 0, 0,
+	0, 318, 0, 0, 0, 180, 181, 193, 202, 212,
+	226, 242, 250, 266, 269, 274, 275, 278, 283, 304,
+	309, 310, 311, 312, 329, 330, 331, 339, 340, 343,
+	345, 346, 349, 356, 358, 359, 361, 363, 370, 375,
+	383, 384, 386, 387, 389, 390, 397, 398, 399, 400,
+	408, 412, 430, 431, 454, 459, 262, 438, 460, 0,
+	303, 0, 0, 305, 246, 265, 276, 0, 449, 409,
+	197, 377, 253, 186, 215, 200, 224, 240, 243, 280,
+	313, 320, 351, 355, 259, 237, 213, 374, 210, 394,
+	415, 416, 417, 419, 317, 232, 354, 70, 420, 301,
+	421, 422, 272, 0, 0, 0, 0, 0, 0, 338,
+	0, 0, 0, 0, 573, 0, 0, 0, 236, 578,
+	0, 0, 0, 289, 233, 0, 0, 352, 0, 184,
+	0, 396, 220, 302, 299, 427, 247, 239, 235, 217,
+	273, 308, 350, 414, 344, 585, 294, 0, 0, 405,
+	321, 0, 0, 0, 0, 0, 580, 581, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 216, 183, 333,
+	406, 251, 0, 605, 604, 610, 608, 611, 609, 612,
+	607, 606, 603, 602, 79, 0, 0, 175, 176, 177,
+	626, 633, 634, 635, 636, 627, 629, 0, 0, 207,
+	628, 214, 594, 631, 637, 638, 0, 231, 277, 238,
+	230, 424, 0, 0, 0, 0, 199, 0, 0, 0,
+	0, 0, 0, 0, 555, 570, 0, 584, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 567, 568, 0, 0,
+	0, 0, 600, 0, 569, 0, 0, 577, 639, 640,
+	641, 642, 643, 644, 645, 646, 647, 648, 649, 650,
+	651, 652, 653, 654, 655, 656, 657, 658, 659, 660,
+	661, 662, 663, 664, 665, 666, 667, 668, 669, 670,
+	671, 672, 673, 674, 675, 676, 677, 678, 679, 579,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 322, 0, 599, 0, 0, 456, 0, 0, 597,
+	0, 0, 0, 0, 288, 0, 285, 179, 195, 0,
+	0, 332, 376, 382, 0, 0, 0, 221, 0, 380,
+	348, 441, 203, 249, 373, 353, 378, 0, 0, 379,
+	297, 429, 367, 439, 457, 458, 229, 326, 447, 418,
+	453, 469, 196, 225, 342, 411, 444, 402, 319, 425,
+	426, 284, 401, 258, 182, 293, 463, 194, 388, 211,
+	201, 187, 413, 437, 208, 391, 0, 0, 471, 189,
+	435, 410, 315, 281, 282, 188, 0, 372, 234, 256,
+	223, 337, 432, 433, 222, 472, 198, 452, 191, 0,
+	451, 328, 428, 436, 316, 307, 190, 434, 314, 306,
+	287, 245, 267, 365, 300, 366, 268, 324, 323, 325,
+	0, 185, 0, 407, 445, 473, 204, 205, 206, 0,
+	244, 248, 254, 257, 263, 264, 271, 290, 341, 364,
+	362, 368, 0, 423, 440, 448, 455, 461, 462, 464,
+	465, 466, 467, 468, 327, 270, 403, 286, 298, 0,
+	0, 347, 381, 209, 443, 404, 617, 598, 589, 591,
+	618, 619, 586, 587, 590, 620, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 0, 601, 576, 575, 0, 582,
+	583, 0, 592, 593, 574, 178, 192, 292, 78, 369,
+	252, 470, 450, 0, 0, 393, 392, 219, 442, 360,
+	261, 336, 385, 446, 0, 228, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 180, 181, 193, 202,
+	212, 226, 242, 250, 266, 269, 274, 275, 278, 283,
+	304, 309, 310, 311, 312, 329, 330, 331, 339, 340,
+	343, 345, 346, 349, 356, 358, 359, 361, 363, 370,
+	375, 383, 384, 386, 387, 389, 390, 397, 398, 399,
+	400, 408, 412, 430, 431, 454, 459, 262, 438, 460,
+	0, 303, 0, 0, 305, 246, 265, 276, 0, 449,
+	409, 197, 377, 253, 186, 215, 200, 224, 240, 243,
+	280, 313, 320, 351, 355, 259, 237, 213, 374, 210,
+	394, 415, 416, 417, 419, 317, 232, 354, 420, 0,
+	301, 421, 422, 272, 0, 0, 0, 0, 0, 338,
+	0, 0, 0, 0, 573, 0, 0, 0, 236, 578,
+	0, 0, 0, 289, 233, 0, 0, 352, 0, 184,
+	0, 396, 220, 302, 299, 427, 247, 239, 235, 217,
+	273, 308, 350, 414, 344, 585, 294, 0, 0, 405,
+	321, 0, 0, 0, 0, 0, 580, 581, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 216, 183, 333,
+	406, 251, 0, 605, 604, 610, 608, 611, 609, 612,
+	607, 606, 603, 602, 79, 0, 0, 175, 176, 177,
+	626, 633, 634, 635, 636, 627, 629, 0, 0, 207,
+	628, 214, 594, 631, 637, 638, 0, 231, 277, 238,
+	230, 424, 0, 0, 0, 0, 199, 0, 0, 0,
+	0, 0, 0, 0, 555, 570, 0, 584, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 567, 568, 0, 0,
+	0, 0, 600, 0, 569, 0, 0, 577, 639, 640,
+	641, 642, 643, 644, 645, 646, 647, 648, 649, 650,
+	651, 652, 653, 654, 655, 656, 657, 658, 659, 660,
+	661, 662, 663, 664, 665, 666, 667, 668, 669, 670,
+	671, 672, 673, 674, 675, 676, 677, 678, 679, 579,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 322, 0, 599, 0, 0, 456, 0, 0, 597,
+	0, 0, 0, 0, 288, 0, 285, 179, 195, 0,
+	0, 332, 376, 382, 0, 0, 0, 221, 0, 380,
+	348, 441, 203, 249, 373, 353, 378, 2542, 0, 379,
+	297, 429, 367, 439, 457, 458, 229, 326, 447, 418,
+	453, 469, 196, 225, 342, 411, 444, 402, 319, 425,
+	426, 284, 401, 258, 182, 293, 463, 194, 388, 211,
+	201, 187, 413, 437, 208, 391, 0, 0, 471, 189,
+	435, 410, 315, 281, 282, 188, 0, 372, 234, 256,
+	223, 337, 432, 433, 222, 472, 198, 452, 191, 0,
+	451, 328, 428, 436, 316, 307, 190, 434, 314, 306,
+	287, 245, 267, 365, 300, 366, 268, 324, 323, 325,
+	0, 185, 0, 407, 445, 473, 204, 205, 206, 0,
+	244, 248, 254, 257, 263, 264, 271, 290, 341, 364,
+	362, 368, 0, 423, 440, 448, 455, 461, 462, 464,
+	465, 466, 467, 468, 327, 270, 403, 286, 298, 0,
+	0, 347, 381, 209, 443, 404, 617, 598, 589, 591,
+	618, 619, 586, 587, 590, 620, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 0, 601, 576, 575, 0, 582,
+	583, 0, 592, 593, 574, 178, 192, 292, 0, 369,
+	252, 470, 450, 0, 0, 393, 392, 219, 442, 360,
+	261, 336, 385, 446, 0, 228, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 180, 181, 193, 202,
+	212, 226, 242, 250, 266, 269, 274, 275, 278, 283,
+	304, 309, 310, 311, 312, 329, 330, 331, 339, 340,
+	343, 345, 346, 349, 356, 358, 359, 361, 363, 370,
+	375, 383, 384, 386, 387, 389, 390, 397, 398, 399,
+	400, 408, 412, 430, 431, 454, 459, 262, 438, 460,
+	0, 303, 0, 0, 305, 246, 265, 276, 0, 449,
+	409, 197, 377, 253, 186, 215, 200, 224, 240, 243,
+	280, 313, 320, 351, 355, 259, 237, 213, 374, 210,
+	394, 415, 416, 417, 419, 317, 232, 354, 420, 0,
+	301, 421, 422, 272, 0, 0, 0, 0, 0, 338,
+	0, 0, 0, 0, 573, 0, 0, 0, 236, 578,
+	0, 0, 0, 289, 233, 0, 0, 352, 0, 184,
+	0, 396, 220, 302, 299, 427, 247, 239, 235, 217,
+	273, 308, 350, 414, 344, 585, 294, 0, 0, 405,
+	321, 0, 0, 0, 0, 0, 580, 581, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 216, 183, 333,
+	406, 251, 0, 605, 604, 610, 608, 611, 609, 612,
+	607, 606, 603, 602, 79, 0, 1195, 175, 176, 177,
+	626, 633, 634, 635, 636, 627, 629, 0, 0, 207,
+	628, 214, 594, 631, 637, 638, 0, 231, 277, 238,
+	230, 424, 0, 0, 0, 0, 199, 0, 0, 0,
+	0, 0, 0, 0, 555, 570, 0, 584, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 567, 568, 0, 0,
+	0, 0, 600, 0, 569, 0, 0, 577, 639, 640,
+	641, 642, 643, 644, 645, 646, 647, 648, 649, 650,
+	651, 652, 653, 654, 655, 656, 657, 658, 659, 660,
+	661, 662, 663, 664, 665, 666, 667, 668, 669, 670,
+	671, 672, 673, 674, 675, 676, 677, 678, 679, 579,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 322, 0, 599, 0, 0, 456, 0, 0, 597,
+	0, 0, 0, 0, 288, 0, 285, 179, 195, 0,
+	0, 332, 376, 382, 0, 0, 0, 221, 0, 380,
+	348, 441, 203, 249, 373, 353, 378, 0, 0, 379,
+	297, 429, 367, 439, 457, 458, 229, 326, 447, 418,
+	453, 469, 196, 225, 342, 411, 444, 402, 319, 425,
+	426, 284, 401, 258, 182, 293, 463, 194, 388, 211,
+	201, 187, 413, 437, 208, 391, 0, 0, 471, 189,
+	435, 410, 315, 281, 282, 188, 0, 372, 234, 256,
+	223, 337, 432, 433, 222, 472, 198, 452, 191, 0,
+	451, 328, 428, 436, 316, 307, 190, 434, 314, 306,
+	287, 245, 267, 365, 300, 366, 268, 324, 323, 325,
+	0, 185, 0, 407, 445, 473, 204, 205, 206, 0,
+	244, 248, 254, 257, 263, 264, 271, 290, 341, 364,
+	362, 368, 0, 423, 440, 448, 455, 461, 462, 464,
+	465, 466, 467, 468, 327, 270, 403, 286, 298, 0,
+	0, 347, 381, 209, 443, 404, 617, 598, 589, 591,
+	618, 619, 586, 587, 590, 620, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 0, 601, 576, 575, 0, 582,
+	583, 0, 592, 593, 574, 178, 192, 292, 0, 369,
+	252, 470, 450, 0, 0, 393, 392, 219, 442, 360,
+	261, 336, 385, 446, 0, 228, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 180, 181, 193, 202,
+	212, 226, 242, 250, 266, 269, 274, 275, 278, 283,
+	304, 309, 310, 311, 312, 329, 330, 331, 339, 340,
+	343, 345, 346, 349, 356, 358, 359, 361, 363, 370,
+	375, 383, 384, 386, 387, 389, 390, 397, 398, 399,
+	400, 408, 412, 430, 431, 454, 459, 262, 438, 460,
+	0, 303, 0, 0, 305, 246, 265, 276, 0, 449,
+	409, 197, 377, 253, 186, 215, 200, 224, 240, 243,
+	280, 313, 320, 351, 355, 259, 237, 213, 374, 210,
+	394, 415, 416, 417, 419, 317, 232, 354, 420, 0,
+	301, 421, 422, 272, 0, 0, 0, 0, 0, 338,
+	0, 0, 0, 0, 573, 0, 0, 0, 236, 578,
+	0, 0, 0, 289, 233, 0, 0, 352, 0, 184,
+	0, 396, 220, 302, 299, 427, 247, 239, 235, 217,
+	273, 308, 350, 414, 344, 585, 294, 0, 0, 405,
+	321, 0, 0, 0, 0, 0, 580, 581, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 216, 183, 333,
+	406, 251, 0, 605, 604, 610, 608, 611, 609, 612,
+	607, 606, 603, 602, 79, 0, 0, 175, 176, 177,
+	626, 633, 634, 635, 636, 627, 629, 0, 0, 207,
+	628, 214, 594, 631, 637, 638, 0, 231, 277, 238,
+	230, 424, 0, 0, 0, 0, 199, 0, 0, 0,
+	0, 0, 0, 0, 555, 570, 0, 584, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 567, 568, 716, 0,
+	0, 0, 600, 0, 569, 0, 0, 577, 639, 640,
+	641, 642, 643, 644, 645, 646, 647, 648, 649, 650,
+	651, 652, 653, 654, 655, 656, 657, 658, 659, 660,
+	661, 662, 663, 664, 665, 666, 667, 668, 669, 670,
+	671, 672, 673, 674, 675, 676, 677, 678, 679, 579,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 322, 0, 599, 0, 0, 456, 0, 0, 597,
+	0, 0, 0, 0, 288, 0, 285, 179, 195, 0,
+	0, 332, 376, 382, 0, 0, 0, 221, 0, 380,
+	348, 441, 203, 249, 373, 353, 378, 0, 0, 379,
+	297, 429, 367, 439, 457, 458, 229, 326, 447, 418,
+	453, 469, 196, 225, 342, 411, 444, 402, 319, 425,
+	426, 284, 401, 258, 182, 293, 463, 194, 388, 211,
+	201, 187, 413, 437, 208, 391, 0, 0, 471, 189,
+	435, 410, 315, 281, 282, 188, 0, 372, 234, 256,
+	223, 337, 432, 433, 222, 472, 198, 452, 191, 0,
+	451, 328, 428, 436, 316, 307, 190, 434, 314, 306,
+	287, 245, 267, 365, 300, 366, 268, 324, 323, 325,
+	0, 185, 0, 407, 445, 473, 204, 205, 206, 0,
+	244, 248, 254, 257, 263, 264, 271, 290, 341, 364,
+	362, 368, 0, 423, 440, 448, 455, 461, 462, 464,
+	465, 466, 467, 468, 327, 270, 403, 286, 298, 0,
+	0, 347, 381, 209, 443, 404, 617, 598, 589, 591,
+	618, 619, 586, 587, 590, 620, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 0, 601, 576, 575, 0, 582,
+	583, 0, 592, 593, 574, 178, 192, 292, 0, 369,
+	252, 470, 450, 0, 0, 393, 392, 219, 442, 360,
+	261, 336, 385, 446, 0, 228, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 180, 181, 193, 202,
+	212, 226, 242, 250, 266, 269, 274, 275, 278, 283,
+	304, 309, 310, 311, 312, 329, 330, 331, 339, 340,
+	343, 345, 346, 349, 356, 358, 359, 361, 363, 370,
+	375, 383, 384, 386, 387, 389, 390, 397, 398, 399,
+	400, 408, 412, 430, 431, 454, 459, 262, 438, 460,
+	0, 303, 0, 0, 305, 246, 265, 276, 0, 449,
+	409, 197, 377, 253, 186, 215, 200, 224, 240, 243,
+	280, 313, 320, 351, 355, 259, 237, 213, 374, 210,
+	394, 415, 416, 417, 419, 317, 232, 354, 420, 0,
+	301, 421, 422, 272, 0, 0, 0, 0, 0, 338,
+	0, 0, 0, 0, 573, 0, 0, 0, 236, 578,
+	0, 0, 0, 289, 233, 0, 0, 352, 0, 184,
+	0, 396, 220, 302, 299, 427, 247, 239, 235, 217,
+	273, 308, 350, 414, 344, 585, 294, 0, 0, 405,
+	321, 0, 0, 0, 0, 0, 580, 581, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 216, 183, 333,
+	406, 251, 0, 605, 604, 610, 608, 611, 609, 612,
+	607, 606, 603, 602, 79, 0, 0, 175, 176, 177,
+	626, 633, 634, 635, 636, 627, 629, 0, 0, 207,
+	628, 214, 594, 631, 637, 638, 0, 231, 277, 238,
+	230, 424, 0, 0, 0, 0, 199, 0, 0, 0,
+	0, 0, 0, 0, 555, 570, 0, 584, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 567, 568, 0, 0,
+	0, 0, 600, 0, 569, 0, 0, 577, 639, 640,
+	641, 642, 643, 644, 645, 646, 647, 648, 649, 650,
+	651, 652, 653, 654, 655, 656, 657, 658, 659, 660,
+	661, 662, 663, 664, 665, 666, 667, 668, 669, 670,
+	671, 672, 673, 674, 675, 676, 677, 678, 679, 579,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 322, 0, 599, 0, 0, 456, 0, 0, 597,
+	0, 0, 0, 0, 288, 0, 285, 179, 195, 0,
+	0, 332, 376, 382, 0, 0, 0, 221, 0, 380,
+	348, 441, 203, 249, 373, 353, 378, 0, 0, 379,
+	297, 429, 367, 439, 457, 458, 229, 326, 447, 418,
+	453, 469, 196, 225, 342, 411, 444, 402, 319, 425,
+	426, 284, 401, 258, 182, 293, 463, 194, 388, 211,
+	201, 187, 413, 437, 208, 391, 0, 0, 471, 189,
+	435, 410, 315, 281, 282, 188, 0, 372, 234, 256,
+	223, 337, 432, 433, 222, 472, 198, 452, 191, 0,
+	451, 328, 428, 436, 316, 307, 190, 434, 314, 306,
+	287, 245, 267, 365, 300, 366, 268, 324, 323, 325,
+	0, 185, 0, 407, 445, 473, 204, 205, 206, 0,
+	244, 248, 254, 257, 263, 264, 271, 290, 341, 364,
+	362, 368, 0, 423, 440, 448, 455, 461, 462, 464,
+	465, 466, 467, 468, 327, 270, 403, 286, 298, 0,
+	0, 347, 381, 209, 443, 404, 617, 598, 589, 591,
+	618, 619, 586, 587, 590, 620, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 0, 601, 576, 575, 0, 582,
+	583, 0, 592, 593, 574, 178, 192, 292, 0, 369,
+	252, 470, 450, 0, 0, 393, 392, 219, 442, 360,
+	261, 336, 385, 446, 0, 228, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 180, 181, 193, 202,
+	212, 226, 242, 250, 266, 269, 274, 275, 278, 283,
+	304, 309, 310, 311, 312, 329, 330, 331, 339, 340,
+	343, 345, 346, 349, 356, 358, 359, 361, 363, 370,
+	375, 383, 384, 386, 387, 389, 390, 397, 398, 399,
+	400, 408, 412, 430, 431, 454, 459, 262, 438, 460,
+	0, 303, 0, 0, 305, 246, 265, 276, 0, 449,
+	409, 197, 377, 253, 186, 215, 200, 224, 240, 243,
+	280, 313, 320, 351, 355, 259, 237, 213, 374, 210,
+	394, 415, 416, 417, 419, 317, 232, 354, 420, 0,
+	301, 421, 422, 272, 0, 0, 0, 0, 0, 338,
+	0, 0, 0, 0, 573, 0, 0, 0, 236, 578,
+	0, 0, 0, 289, 233, 0, 0, 352, 0, 184,
+	0, 396, 220, 302, 299, 427, 247, 239, 235, 217,
+	273, 308, 350, 414, 344, 585, 294, 0, 0, 405,
+	321, 0, 0, 0, 0, 0, 580, 581, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 216, 183, 333,
+	406, 251, 0, 605, 604, 610, 608, 611, 609, 612,
+	607, 606, 603, 602, 79, 0, 0, 175, 176, 177,
+	626, 633, 634, 635, 636, 627, 629, 0, 0, 207,
+	628, 214, 594, 631, 637, 638, 0, 231, 277, 238,
+	230, 424, 0, 0, 0, 0, 199, 0, 0, 0,
+	0, 0, 0, 0, 0, 570, 0, 584, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 567, 568, 0, 0,
+	0, 0, 600, 0, 569, 0, 0, 577, 639, 640,
+	641, 642, 643, 644, 645, 646, 647, 648, 649, 650,
+	651, 652, 653, 654, 655, 656, 657, 658, 659, 660,
+	661, 662, 663, 664, 665, 666, 667, 668, 669, 670,
+	671, 672, 673, 674, 675, 676, 677, 678, 679, 579,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 322, 0, 599, 0, 0, 456, 0, 0, 597,
+	0, 0, 0, 0, 288, 0, 285, 179, 195, 0,
+	0, 332, 376, 382, 0, 0, 0, 221, 0, 380,
+	348, 441, 203, 249, 373, 353, 378, 0, 0, 379,
+	297, 429, 367, 439, 457, 458, 229, 326, 447, 418,
+	453, 469, 196, 225, 342, 411, 444, 402, 319, 425,
+	426, 284, 401, 258, 182, 293, 463, 194, 388, 211,
+	201, 187, 413, 437, 208, 391, 0, 0, 471, 189,
+	435, 410, 315, 281, 282, 188, 0, 372, 234, 256,
+	223, 337, 432, 433, 222, 472, 198, 452, 191, 0,
+	451, 328, 428, 436, 316, 307, 190, 434, 314, 306,
+	287, 245, 267, 365, 300, 366, 268, 324, 323, 325,
+	0, 185, 0, 407, 445, 473, 204, 205, 206, 0,
+	244, 248, 254, 257, 263, 264, 271, 290, 341, 364,
+	362, 368, 0, 423, 440, 448, 455, 461, 462, 464,
+	465, 466, 467, 468, 327, 270, 403, 286, 298, 0,
+	0, 347, 381, 209, 443, 404, 617, 598, 589, 591,
+	618, 619, 586, 587, 590, 620, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 0, 601, 576, 575, 0, 582,
+	583, 0, 592, 593, 574, 178, 192, 292, 0, 369,
+	252, 470, 450, 0, 0, 393, 392, 219, 442, 360,
+	261, 336, 385, 446, 0, 228, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 180, 181, 193, 202,
+	212, 226, 242, 250, 266, 269, 274, 275, 278, 283,
+	304, 309, 310, 311, 312, 329, 330, 331, 339, 340,
+	343, 345, 346, 349, 356, 358, 359, 361, 363, 370,
+	375, 383, 384, 386, 387, 389, 390, 397, 398, 399,
+	400, 408, 412, 430, 431, 454, 459, 262, 438, 460,
+	0, 303, 0, 0, 305, 246, 265, 276, 0, 449,
+	409, 197, 377, 253, 186, 215, 200, 224, 240, 243,
+	280, 313, 320, 351, 355, 259, 237, 213, 374, 210,
+	394, 415, 416, 417, 419, 317, 232, 354, 420, 0,
+	301, 421, 422, 272, 0, 0, 0, 0, 0, 338,
+	0, 0, 0, 0, 0, 0, 0, 0, 236, 0,
+	0, 0, 0, 289, 233, 0, 0, 352, 0, 184,
+	0, 396, 220, 302, 299, 427, 247, 239, 235, 217,
+	273, 308, 350, 414, 344, 0, 294, 0, 0, 405,
+	321, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 216, 183, 333,
+	406, 251, 0, 218, 227, 255, 291, 295, 296, 334,
+	335, 357, 371, 395, 0, 0, 0, 175, 176, 177,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 207,
+	0, 214, 0, 0, 0, 0, 0, 231, 277, 238,
+	230, 424, 0, 0, 0, 0, 199, 0, 912, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 322, 0, 0, 0, 911, 456, 0, 0, 0,
+	0, 0, 908, 909, 288, 874, 285, 179, 195, 902,
+	906, 332, 376, 382, 0, 0, 0, 221, 0, 380,
+	348, 441, 203, 249, 373, 353, 378, 0, 0, 379,
+	297, 429, 367, 439, 457, 458, 229, 326, 447, 418,
+	453, 469, 196, 225, 342, 411, 444, 402, 319, 425,
+	426, 284, 401, 258, 182, 293, 463, 194, 388, 211,
+	201, 187, 413, 437, 208, 391, 0, 0, 471, 189,
+	435, 410, 315, 281, 282, 188, 0, 372, 234, 256,
+	223, 337, 432, 433, 222, 472, 198, 452, 191, 0,
+	451, 328, 428, 436, 316, 307, 190, 434, 314, 306,
+	287, 245, 267, 365, 300, 366, 268, 324, 323, 325,
+	0, 185, 0, 407, 445, 473, 204, 205, 206, 0,
+	244, 248, 254, 257, 263, 264, 271, 290, 341, 364,
+	362, 368, 0, 423, 440, 448, 455, 461, 462, 464,
+	465, 466, 467, 468, 327, 270, 403, 286, 298, 0,
+	0, 347, 381, 209, 443, 404, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 178, 192, 292, 0, 369,
+	252, 470, 450, 0, 0, 393, 392, 219, 442, 360,
+	261, 336, 385, 446, 0, 228, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 180, 181, 193, 202,
+	212, 226, 242, 250, 266, 269, 274, 275, 278, 283,
+	304, 309, 310, 311, 312, 329, 330, 331, 339, 340,
+	343, 345, 346, 349, 356, 358, 359, 361, 363, 370,
+	375, 383, 384, 386, 387, 389, 390, 397, 398, 399,
+	400, 408, 412, 430, 431, 454, 459, 262, 438, 460,
+	0, 303, 0, 0, 305, 246, 265, 276, 0, 449,
+	409, 197, 377, 253, 186, 215, 200, 224, 240, 243,
+	280, 313, 320, 351, 355, 259, 237, 213, 374, 210,
+	394, 415, 416, 417, 419, 317, 232, 354, 420, 0,
+	301, 421, 422, 272, 0, 0, 0, 0, 0, 338,
+	0, 0, 0, 1215, 0, 0, 0, 0, 236, 0,
+	0, 0, 0, 289, 233, 0, 0, 352, 0, 184,
+	0, 396, 220, 302, 299, 427, 247, 239, 235, 217,
+	273, 308, 350, 414, 344, 0, 294, 0, 0, 405,
+	321, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 216, 183, 333,
+	406, 251, 0, 218, 227, 255, 291, 295, 296, 334,
+	335, 357, 371, 395, 0, 0, 0, 175, 176, 177,
+	0, 1217, 0, 0, 0, 0, 0, 0, 0, 207,
+	0, 214, 0, 0, 0, 0, 0, 231, 277, 238,
+	230, 424, 0, 0, 0, 0, 199, 0, 0, 0,
+	1074, 0, 1075, 1076, 0, 0, 0, 0, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 322, 0, 0, 0, 0, 456, 0, 0, 0,
+	0, 0, 0, 0, 288, 0, 285, 179, 195, 0,
+	0, 332, 376, 382, 0, 0, 0, 221, 0, 380,
+	348, 441, 203, 249, 373, 353, 378, 0, 0, 379,
+	297, 429, 367, 439, 457, 458, 229, 326, 447, 418,
+	453, 469, 196, 225, 342, 411, 444, 402, 319, 425,
+	426, 284, 401, 258, 182, 293, 463, 194, 388, 211,
+	201, 187, 413, 437, 208, 391, 0, 0, 471, 189,
+	435, 410, 315, 281, 282, 188, 0, 372, 234, 256,
+	223, 337, 432, 433, 222, 472, 198, 452, 191, 0,
+	451, 328, 428, 436, 316, 307, 190, 434, 314, 306,
+	287, 245, 267, 365, 300, 366, 268, 324, 323, 325,
+	0, 185, 0, 407, 445, 473, 204, 205, 206, 0,
+	244, 248, 254, 257, 263, 264, 271, 290, 341, 364,
+	362, 368, 0, 423, 440, 448, 455, 461, 462, 464,
+	465, 466, 467, 468, 327, 270, 403, 286, 298, 0,
+	0, 347, 381, 209, 443, 404, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 178, 192, 292, 0, 369,
+	252, 470, 450, 0, 0, 393, 392, 219, 442, 360,
+	261, 336, 385, 446, 0, 228, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 180, 181, 193, 202,
+	212, 226, 242, 250, 266, 269, 274, 275, 278, 283,
+	304, 309, 310, 311, 312, 329, 330, 331, 339, 340,
+	343, 345, 346, 349, 356, 358, 359, 361, 363, 370,
+	375, 383, 384, 386, 387, 389, 390, 397, 398, 399,
+	400, 408, 412, 430, 431, 454, 459, 262, 438, 460,
+	0, 303, 0, 0, 305, 246, 265, 276, 0, 449,
+	409, 197, 377, 253, 186, 215, 200, 224, 240, 243,
+	280, 313, 320, 351, 355, 259, 237, 213, 374, 210,
+	394, 415, 416, 417, 419, 317, 232, 354, 420, 0,
+	301, 421, 422, 272, 0, 0, 0, 0, 0, 338,
+	0, 0, 0, 0, 0, 0, 0, 0, 236, 0,
+	0, 0, 0, 289, 233, 0, 0, 352, 0, 184,
+	0, 396, 220, 302, 299, 427, 247, 239, 235, 217,
+	273, 308, 350, 414, 344, 0, 294, 0, 0, 405,
+	321, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 216, 183, 333,
+	406, 251, 0, 218, 227, 255, 291, 295, 296, 334,
+	335, 357, 371, 395, 0, 0, 0, 175, 176, 177,
+	1160, 1163, 0, 0, 0, 1159, 1162, 0, 0, 207,
+	1158, 214, 0, 0, 0, 0, 0, 231, 277, 238,
+	230, 424, 0, 0, 0, 0, 199, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
+	0, 322, 0, 0, 0, 0, 456, 0, 0, 0,
+	0, 0, 0, 0, 288, 0, 285, 179, 195, 0,
+	0, 332, 376, 382, 0, 0, 0, 221, 0, 380,
+	348, 441, 203, 249, 373, 353, 378, 0, 0, 379,
+	297, 429, 367, 439, 457, 458, 229, 326, 447, 418,
+	453, 469, 196, 225, 342, 411, 444, 402, 319, 425,
+	426, 284, 401, 258, 182, 293, 463, 194, 388, 211,
+	201, 187, 413, 437, 208, 391, 0, 0, 471, 189,
+	435, 410, 315, 281, 282, 188, 0, 372, 234, 256,
+	223, 337, 432, 433, 222, 472, 198, 452, 191, 0,
+	451, 328, 428, 436, 316, 307, 190, 434, 314, 306,
+	287, 245, 267, 365, 300, 366, 268, 324, 323, 325,
+	0, 185, 0, 407, 445, 473, 204, 205, 206, 0,
+	244, 248, 254, 257, 263, 264, 271, 290, 341, 364,
+	362, 368, 0, 423, 440, 448, 455, 461, 462, 464,
+	465, 466, 467, 468, 327, 270, 403, 286, 298, 0,
+	0, 347, 381, 209, 443, 404, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 178, 192, 292, 0, 369,
+	252, 470, 450, 0, 0, 393, 392, 219, 442, 360,
+	261, 336, 385, 446, 0, 228, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 180, 181, 193, 202,
+	212, 226, 242, 250, 266, 269, 274, 275, 278, 283,
+	304, 309, 310, 311, 312, 329, 330, 331, 339, 340,
+	343, 345, 346, 349, 356, 358, 359, 361, 363, 370,
+	375, 383, 384, 386, 387, 389, 390, 397, 398, 399,
+	400, 408, 412, 430, 431, 454, 459, 262, 438, 460,
+	0, 303, 0, 0, 305, 246, 265, 276, 0, 449,
+	409, 197, 377, 253, 186, 215, 200, 224, 240, 243,
+	280, 313, 320, 351, 355, 259, 237, 213, 374, 210,
+	394, 415, 416, 417, 419, 317, 232, 354, 70, 420,
+	301, 421, 422, 272, 0, 0, 0, 0, 0, 0,
+	338, 0, 0, 0, 0, 0, 0, 0, 0, 236,
+	0, 0, 0, 0, 289, 233, 0, 0, 352, 0,
+	184, 0, 396, 220, 302, 299, 427, 247, 239, 235,
+	217, 273, 308, 350, 414, 344, 0, 294, 0, 0,
+	405, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 216, 183,
+	333, 406, 251, 0, 218, 227, 255, 291, 295, 296,
+	334, 335, 357, 371, 395, 79, 0, 1195, 175, 176,
+	177, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	207, 0, 214, 0, 0, 0, 0, 0, 231, 277,
+	238, 230, 424, 0, 0, 0, 0, 199, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 241, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	260, 0, 322, 0, 0, 0, 0, 456, 0, 0,
+	0, 0, 0, 0, 0, 288, 0, 285, 179, 195,
+	0, 0, 332, 376, 382, 0, 0, 0, 221, 0,
+	380, 348, 441, 203, 249, 373, 353, 378, 0, 0,
+	379, 297, 429, 367, 439, 457, 458, 229, 326, 447,
+	418, 453, 469, 196, 225, 342, 411, 444, 402, 319,
+	425, 426, 284, 401, 258, 182, 293, 463, 194, 388,
+	211, 201, 187, 413, 437, 208, 391, 0, 0, 471,
+	189, 435, 410, 315, 281, 282, 188, 0, 372, 234,
+	256, 223, 337, 432, 433, 222, 472, 198, 452, 191,
+	0, 451, 328, 428, 436, 316, 307, 190, 434, 314,
+	306, 287, 245, 267, 365, 300, 366, 268, 324, 323,
+	325, 0, 185, 0, 407, 445, 473, 204, 205, 206,
+	0, 244, 248, 254, 257, 263, 264, 271, 290, 341,
+	364, 362, 368, 0, 423, 440, 448, 455, 461, 462,
+	464, 465, 466, 467, 468, 327, 270, 403, 286, 298,
+	0, 0, 347, 381, 209, 443, 404, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 474, 475, 476,
+	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 178, 192, 292, 78,
+	369, 252, 470, 450, 0, 0, 393, 392, 219, 442,
+	360, 261, 336, 385, 446, 0, 228, 0, 0, 0,
+	0, 0, 0, 318, 0, 0, 0, 180, 181, 193,
+	202, 212, 226, 242, 250, 266, 269, 274, 275, 278,
+	283, 304, 309, 310, 311, 312, 329, 330, 331, 339,
+	340, 343, 345, 346, 349, 356, 358, 359, 361, 363,
+	370, 375, 383, 384, 386, 387, 389, 390, 397, 398,
+	399, 400, 408, 412, 430, 431, 454, 459, 262, 438,
+	460, 0, 303, 0, 0, 305, 246, 265, 276, 0,
+	449, 409, 197, 377, 253, 186, 215, 200, 224, 240,
+	243, 280, 313, 320, 351, 355, 259, 237, 213, 374,
+	210, 394, 415, 416, 417, 419, 317, 232, 354, 420,
+	0, 301, 421, 422, 272, 0, 0, 92, 0, 0,
+	338, 0, 0, 0, 0, 0, 0, 0, 0, 236,
+	0, 0, 0, 0, 289, 233, 0, 0, 352, 0,
+	184, 0, 396, 220, 302, 299, 427, 247, 239, 235,
+	217, 273, 308, 350, 414, 344, 0, 294, 0, 0,
+	405, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 216, 183,
+	333, 406, 251, 0, 218, 227, 255, 291, 295, 296,
+	334, 335, 357, 371, 395, 0, 0, 0, 175, 176,
+	177, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	207, 0, 214, 0, 0, 0, 0, 0, 231, 277,
+	238, 230, 424, 0, 0, 0, 0, 199, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 241, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	260, 0, 322, 0, 0, 0, 0, 456, 0, 0,
+	0, 0, 0, 0, 0, 288, 0, 285, 179, 195,
+	0, 0, 332, 376, 382, 0, 0, 0, 221, 0,
+	380, 348, 441, 203, 249, 373, 353, 378, 0, 2240,
+	379, 297, 429, 367, 439, 457, 458, 229, 326, 447,
+	418, 453, 469, 196, 225, 342, 411, 444, 402, 319,
+	425, 426, 284, 401, 258, 182, 293, 463, 194, 388,
+	211, 201, 187, 413, 437, 208, 391, 0, 0, 471,
+	189, 435, 410, 315, 281, 282, 188, 0, 372, 234,
+	256, 223, 337, 432, 433, 222, 472, 198, 452, 191,
+	0, 451, 328, 428, 436, 316, 307, 190, 434, 314,
+	306, 287, 245, 267, 365, 300, 366, 268, 324, 323,
+	325, 0, 185, 0, 407, 445, 473, 204, 205, 206,
+	0, 244, 248, 254, 257, 263, 264, 271, 290, 341,
+	364, 362, 368, 0, 423, 440, 448, 455, 461, 462,
+	464, 465, 466, 467, 468, 327, 270, 403, 286, 298,
+	0, 0, 347, 381, 209, 443, 404, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 474, 475, 476,
+	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 178, 192, 292, 0,
+	369, 252, 470, 450, 0, 0, 2241, 392, 219, 442,
+	360, 261, 336, 385, 446, 0, 228, 0, 0, 0,
+	0, 0, 0, 318, 0, 0, 0, 180, 181, 193,
+	202, 212, 226, 242, 250, 266, 269, 274, 275, 278,
+	283, 304, 309, 310, 311, 312, 329, 330, 331, 339,
+	340, 343, 345, 346, 349, 356, 358, 359, 361, 363,
+	370, 375, 383, 384, 386, 387, 389, 390, 397, 398,
+	399, 400, 408, 412, 430, 431, 454, 459, 262, 438,
+	460, 0, 303, 0, 0, 305, 246, 265, 276, 0,
+	449, 409, 197, 377, 253, 186, 215, 200, 224, 240,
+	243, 280, 313, 320, 351, 355, 259, 237, 213, 374,
+	210, 394, 415, 416, 417, 419, 317, 232, 354, 0,
+	2242, 301, 421, 422, 272, 70, 420, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 338, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 289, 233, 0, 0, 352, 0, 184, 0, 396,
+	220, 302, 299, 427, 247, 239, 235, 217, 273, 308,
+	350, 414, 344, 0, 294, 0, 0, 405, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 216, 183, 333, 406, 251,
+	0, 218, 227, 255, 291, 295, 296, 334, 335, 357,
+	371, 395, 79, 0, 0, 175, 176, 177, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
+	0, 0, 0, 0, 0, 231, 277, 238, 230, 424,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 322,
+	0, 0, 0, 0, 456, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 285, 179, 195, 0, 0, 332,
+	376, 382, 0, 0, 0, 221, 0, 380, 348, 441,
+	203, 249, 373, 353, 378, 0, 0, 379, 297, 429,
+	367, 439, 457, 458, 229, 326, 447, 418, 453, 469,
+	196, 225, 342, 411, 444, 402, 319, 425, 426, 284,
+	401, 258, 182, 293, 463, 194, 388, 211, 201, 187,
+	413, 437, 208, 391, 0, 0, 471, 189, 435, 410,
+	315, 281, 282, 188, 0, 372, 234, 256, 223, 337,
+	432, 433, 222, 472, 198, 452, 191, 0, 451, 328,
+	428, 436, 316, 307, 190, 434, 314, 306, 287, 245,
+	267, 365, 300, 366, 268, 324, 323, 325, 0, 185,
+	0, 407, 445, 473, 204, 205, 206, 0, 244, 248,
+	254, 257, 263, 264, 271, 290, 341, 364, 362, 368,
+	0, 423, 440, 448, 455, 461, 462, 464, 465, 466,
+	467, 468, 327, 270, 403, 286, 298, 0, 0, 347,
+	381, 209, 443, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 192, 292, 78, 369, 252, 470,
+	450, 0, 0, 393, 392, 219, 442, 360, 261, 336,
+	385, 446, 0, 228, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 180, 181, 193, 202, 212, 226,
+	242, 250, 266, 269, 274, 275, 278, 283, 304, 309,
+	310, 311, 312, 329, 330, 331, 339, 340, 343, 345,
+	346, 349, 356, 358, 359, 361, 363, 370, 375, 383,
+	384, 386, 387, 389, 390, 397, 398, 399, 400, 408,
+	412, 430, 431, 454, 459, 262, 438, 460, 0, 303,
+	0, 0, 305, 246, 265, 276, 0, 449, 409, 197,
+	377, 253, 186, 215, 200, 224, 240, 243, 280, 313,
+	320, 351, 355, 259, 237, 213, 374, 210, 394, 415,
+	416, 417, 419, 317, 232, 354, 420, 0, 301, 421,
+	422, 272, 0, 0, 0, 0, 0, 338, 0, 0,
+	0, 1601, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 289, 233, 0, 0, 352, 0, 184, 0, 396,
+	220, 302, 299, 427, 247, 239, 235, 217, 273, 308,
+	350, 414, 344, 0, 294, 0, 0, 405, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 216, 183, 333, 406, 251,
+	0, 218, 227, 255, 291, 295, 296, 334, 335, 357,
+	371, 395, 0, 0, 0, 175, 176, 177, 0, 1385,
+	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
+	0, 0, 0, 0, 0, 231, 277, 238, 230, 424,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 322,
+	0, 0, 0, 0, 456, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 285, 179, 195, 0, 0, 332,
+	376, 382, 0, 0, 0, 221, 0, 380, 348, 441,
+	203, 249, 373, 353, 378, 0, 1599, 379, 297, 429,
+	367, 439, 457, 458, 229, 326, 447, 418, 453, 469,
+	196, 225, 342, 411, 444, 402, 319, 425, 426, 284,
+	401, 258, 182, 293, 463, 194, 388, 211, 201, 187,
+	413, 437, 208, 391, 0, 0, 471, 189, 435, 410,
+	315, 281, 282, 188, 0, 372, 234, 256, 223, 337,
+	432, 433, 222, 472, 198, 452, 191, 0, 451, 328,
+	428, 436, 316, 307, 190, 434, 314, 306, 287, 245,
+	267, 365, 300, 366, 268, 324, 323, 325, 0, 185,
+	0, 407, 445, 473, 204, 205, 206, 0, 244, 248,
+	254, 257, 263, 264, 271, 290, 341, 364, 362, 368,
+	0, 423, 440, 448, 455, 461, 462, 464, 465, 466,
+	467, 468, 327, 270, 403, 286, 298, 0, 0, 347,
+	381, 209, 443, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 192, 292, 0, 369, 252, 470,
+	450, 0, 0, 393, 392, 219, 442, 360, 261, 336,
+	385, 446, 0, 228, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 180, 181, 193, 202, 212, 226,
+	242, 250, 266, 269, 274, 275, 278, 283, 304, 309,
+	310, 311, 312, 329, 330, 331, 339, 340, 343, 345,
+	346, 349, 356, 358, 359, 361, 363, 370, 375, 383,
+	384, 386, 387, 389, 390, 397, 398, 399, 400, 408,
+	412, 430, 431, 454, 459, 262, 438, 460, 0, 303,
+	0, 0, 305, 246, 265, 276, 0, 449, 409, 197,
+	377, 253, 186, 215, 200, 224, 240, 243, 280, 313,
+	320, 351, 355, 259, 237, 213, 374, 210, 394, 415,
+	416, 417, 419, 317, 232, 354, 420, 0, 301, 421,
+	422, 272, 0, 0, 0, 0, 0, 338, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 289, 233, 0, 0, 352, 0, 184, 0, 396,
+	220, 302, 299, 427, 247, 239, 235, 217, 273, 308,
+	350, 414, 344, 0, 294, 0, 0, 405, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 216, 183, 333, 406, 251,
+	0, 218, 227, 255, 291, 295, 296, 334, 335, 357,
+	371, 395, 0, 0, 0, 175, 176, 177, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
+	0, 0, 0, 0, 0, 231, 277, 238, 230, 424,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 868, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 322,
+	0, 0, 0, 0, 456, 0, 0, 0, 0, 0,
+	0, 0, 288, 874, 285, 179, 195, 872, 0, 332,
+	376, 382, 0, 0, 0, 221, 0, 380, 348, 441,
+	203, 249, 373, 353, 378, 0, 0, 379, 297, 429,
+	367, 439, 457, 458, 229, 326, 447, 418, 453, 469,
+	196, 225, 342, 411, 444, 402, 319, 425, 426, 284,
+	401, 258, 182, 293, 463, 194, 388, 211, 201, 187,
+	413, 437, 208, 391, 0, 0, 471, 189, 435, 410,
+	315, 281, 282, 188, 0, 372, 234, 256, 223, 337,
+	432, 433, 222, 472, 198, 452, 191, 0, 451, 328,
+	428, 436, 316, 307, 190, 434, 314, 306, 287, 245,
+	267, 365, 300, 366, 268, 324, 323, 325, 0, 185,
+	0, 407, 445, 473, 204, 205, 206, 0, 244, 248,
+	254, 257, 263, 264, 271, 290, 341, 364, 362, 368,
+	0, 423, 440, 448, 455, 461, 462, 464, 465, 466,
+	467, 468, 327, 270, 403, 286, 298, 0, 0, 347,
+	381, 209, 443, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 192, 292, 0, 369, 252, 470,
+	450, 0, 0, 393, 392, 219, 442, 360, 261, 336,
+	385, 446, 0, 228, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 180, 181, 193, 202, 212, 226,
+	242, 250, 266, 269, 274, 275, 278, 283, 304, 309,
+	310, 311, 312, 329, 330, 331, 339, 340, 343, 345,
+	346, 349, 356, 358, 359, 361, 363, 370, 375, 383,
+	384, 386, 387, 389, 390, 397, 398, 399, 400, 408,
+	412, 430, 431, 454, 459, 262, 438, 460, 0, 303,
+	0, 0, 305, 246, 265, 276, 0, 449, 409, 197,
+	377, 253, 186, 215, 200, 224, 240, 243, 280, 313,
+	320, 351, 355, 259, 237, 213, 374, 210, 394, 415,
+	416, 417, 419, 317, 232, 354, 420, 0, 301, 421,
+	422, 272, 0, 0, 0, 0, 0, 338, 0, 0,
+	0, 1601, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 289, 233, 0, 0, 352, 0, 184, 0, 396,
+	220, 302, 299, 427, 247, 239, 235, 217, 273, 308,
+	350, 414, 344, 0, 294, 0, 0, 405, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 216, 183, 333, 406, 251,
+	0, 218, 227, 255, 291, 295, 296, 334, 335, 357,
+	371, 395, 0, 0, 0, 175, 176, 177, 0, 1385,
+	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
+	0, 0, 0, 0, 0, 231, 277, 238, 230, 424,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 322,
+	0, 0, 0, 0, 456, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 285, 179, 195, 0, 0, 332,
+	376, 382, 0, 0, 0, 221, 0, 380, 348, 441,
+	203, 249, 373, 353, 378, 0, 0, 379, 297, 429,
+	367, 439, 457, 458, 229, 326, 447, 418, 453, 469,
+	196, 225, 342, 411, 444, 402, 319, 425, 426, 284,
+	401, 258, 182, 293, 463, 194, 388, 211, 201, 187,
+	413, 437, 208, 391, 0, 0, 471, 189, 435, 410,
+	315, 281, 282, 188, 0, 372, 234, 256, 223, 337,
+	432, 433, 222, 472, 198, 452, 191, 0, 451, 328,
+	428, 436, 316, 307, 190, 434, 314, 306, 287, 245,
+	267, 365, 300, 366, 268, 324, 323, 325, 0, 185,
+	0, 407, 445, 473, 204, 205, 206, 0, 244, 248,
+	254, 257, 263, 264, 271, 290, 341, 364, 362, 368,
+	0, 423, 440, 448, 455, 461, 462, 464, 465, 466,
+	467, 468, 327, 270, 403, 286, 298, 0, 0, 347,
+	381, 209, 443, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 192, 292, 0, 369, 252, 470,
+	450, 0, 0, 393, 392, 219, 442, 360, 261, 336,
+	385, 446, 0, 228, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 180, 181, 193, 202, 212, 226,
+	242, 250, 266, 269, 274, 275, 278, 283, 304, 309,
+	310, 311, 312, 329, 330, 331, 339, 340, 343, 345,
+	346, 349, 356, 358, 359, 361, 363, 370, 375, 383,
+	384, 386, 387, 389, 390, 397, 398, 399, 400, 408,
+	412, 430, 431, 454, 459, 262, 438, 460, 0, 303,
+	0, 0, 305, 246, 265, 276, 0, 449, 409, 197,
+	377, 253, 186, 215, 200, 224, 240, 243, 280, 313,
+	320, 351, 355, 259, 237, 213, 374, 210, 394, 415,
+	416, 417, 419, 317, 232, 354, 420, 0, 301, 421,
+	422, 272, 0, 0, 0, 0, 0, 338, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 289, 233, 0, 0, 352, 0, 184, 0, 396,
+	220, 302, 299, 427, 247, 239, 235, 217, 273, 308,
+	350, 414, 344, 0, 294, 0, 0, 405, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 216, 183, 333, 406, 251,
+	0, 218, 227, 255, 291, 295, 296, 334, 335, 357,
+	371, 395, 0, 0, 1195, 175, 176, 177, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
+	0, 0, 0, 0, 0, 231, 277, 238, 230, 424,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 322,
+	0, 0, 0, 0, 456, 0, 0, 0, 2380, 0,
+	0, 0, 288, 0, 285, 179, 195, 0, 0, 332,
+	376, 382, 0, 0, 0, 221, 0, 380, 348, 441,
+	203, 249, 373, 353, 378, 0, 0, 379, 297, 429,
+	367, 439, 457, 458, 229, 326, 447, 418, 453, 469,
+	196, 225, 342, 411, 444, 402, 319, 425, 426, 284,
+	401, 258, 182, 293, 463, 194, 388, 211, 201, 187,
+	413, 437, 208, 391, 0, 0, 471, 189, 435, 410,
+	315, 281, 282, 188, 0, 372, 234, 256, 223, 337,
+	432, 433, 222, 472, 198, 452, 191, 0, 451, 328,
+	428, 436, 316, 307, 190, 434, 314, 306, 287, 245,
+	267, 365, 300, 366, 268, 324, 323, 325, 0, 185,
+	0, 407, 445, 473, 204, 205, 206, 0, 244, 248,
+	254, 257, 263, 264, 271, 290, 341, 364, 362, 368,
+	0, 423, 440, 448, 455, 461, 462, 464, 465, 466,
+	467, 468, 327, 270, 403, 286, 298, 0, 0, 347,
+	381, 209, 443, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 192, 292, 0, 369, 252, 470,
+	450, 0, 0, 393, 392, 219, 442, 360, 261, 336,
+	385, 446, 0, 228, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 180, 181, 193, 202, 212, 226,
+	242, 250, 266, 269, 274, 275, 278, 283, 304, 309,
+	310, 311, 312, 329, 330, 331, 339, 340, 343, 345,
+	346, 349, 356, 358, 359, 361, 363, 370, 375, 383,
+	384, 386, 387, 389, 390, 397, 398, 399, 400, 408,
+	412, 430, 431, 454, 459, 262, 438, 460, 0, 303,
+	0, 0, 305, 246, 265, 276, 0, 449, 409, 197,
+	377, 253, 186, 215, 200, 224, 240, 243, 280, 313,
+	320, 351, 355, 259, 237, 213, 374, 210, 394, 415,
+	416, 417, 419, 317, 232, 354, 420, 0, 301, 421,
+	422, 272, 0, 0, 0, 0, 0, 338, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 289, 233, 0, 0, 352, 0, 184, 0, 396,
+	220, 302, 299, 427, 247, 239, 235, 217, 273, 308,
+	350, 414, 344, 0, 294, 0, 0, 405, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 216, 183, 333, 406, 251,
+	0, 218, 227, 255, 291, 295, 296, 334, 335, 357,
+	371, 395, 0, 0, 0, 175, 176, 177, 0, 0,
+	0, 1862, 0, 0, 1863, 0, 0, 207, 0, 214,
+	0, 0, 0, 0, 0, 231, 277, 238, 230, 424,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 322,
+	0, 0, 0, 0, 456, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 285, 179, 195, 0, 0, 332,
+	376, 382, 0, 0, 0, 221, 0, 380, 348, 441,
+	203, 249, 373, 353, 378, 0, 0, 379, 297, 429,
+	367, 439, 457, 458, 229, 326, 447, 418, 453, 469,
+	196, 225, 342, 411, 444, 402, 319, 425, 426, 284,
+	401, 258, 182, 293, 463, 194, 388, 211, 201, 187,
+	413, 437, 208, 391, 0, 0, 471, 189, 435, 410,
+	315, 281, 282, 188, 0, 372, 234, 256, 223, 337,
+	432, 433, 222, 472, 198, 452, 191, 0, 451, 328,
+	428, 436, 316, 307, 190, 434, 314, 306, 287, 245,
+	267, 365, 300, 366, 268, 324, 323, 325, 0, 185,
+	0, 407, 445, 473, 204, 205, 206, 0, 244, 248,
+	254, 257, 263, 264, 271, 290, 341, 364, 362, 368,
+	0, 423, 440, 448, 455, 461, 462, 464, 465, 466,
+	467, 468, 327, 270, 403, 286, 298, 0, 0, 347,
+	381, 209, 443, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 192, 292, 0, 369, 252, 470,
+	450, 0, 0, 393, 392, 219, 442, 360, 261, 336,
+	385, 446, 0, 228, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 180, 181, 193, 202, 212, 226,
+	242, 250, 266, 269, 274, 275, 278, 283, 304, 309,
+	310, 311, 312, 329, 330, 331, 339, 340, 343, 345,
+	346, 349, 356, 358, 359, 361, 363, 370, 375, 383,
+	384, 386, 387, 389, 390, 397, 398, 399, 400, 408,
+	412, 430, 431, 454, 459, 262, 438, 460, 0, 303,
+	0, 0, 305, 246, 265, 276, 0, 449, 409, 197,
+	377, 253, 186, 215, 200, 224, 240, 243, 280, 313,
+	320, 351, 355, 259, 237, 213, 374, 210, 394, 415,
+	416, 417, 419, 317, 232, 354, 420, 0, 301, 421,
+	422, 272, 0, 0, 0, 0, 0, 338, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 1237, 0, 0,
+	0, 289, 233, 0, 0, 352, 0, 184, 0, 396,
+	220, 302, 299, 427, 247, 239, 235, 217, 273, 308,
+	350, 414, 344, 0, 294, 0, 0, 405, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 216, 183, 333, 406, 251,
+	0, 218, 227, 255, 291, 295, 296, 334, 335, 357,
+	371, 395, 0, 0, 0, 175, 176, 177, 0, 1236,
+	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
+	0, 0, 0, 0, 0, 231, 277, 238, 230, 424,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 322,
+	0, 0, 0, 0, 456, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 285, 179, 195, 0, 0, 332,
+	376, 382, 0, 0, 0, 221, 0, 380, 348, 441,
+	203, 249, 373, 353, 378, 0, 0, 379, 297, 429,
+	367, 439, 457, 458, 229, 326, 447, 418, 453, 469,
+	196, 225, 342, 411, 444, 402, 319, 425, 426, 284,
+	401, 258, 182, 293, 463, 194, 388, 211, 201, 187,
+	413, 437, 208, 391, 0, 0, 471, 189, 435, 410,
+	315, 281, 282, 188, 0, 372, 234, 256, 223, 337,
+	432, 433, 222, 472, 198, 452, 191, 0, 451, 328,
+	428, 436, 316, 307, 190, 434, 314, 306, 287, 245,
+	267, 365, 300, 366, 268, 324, 323, 325, 0, 185,
+	0, 407, 445, 473, 204, 205, 206, 0, 244, 248,
+	254, 257, 263, 264, 271, 290, 341, 364, 362, 368,
+	0, 423, 440, 448, 455, 461, 462, 464, 465, 466,
+	467, 468, 327, 270, 403, 286, 298, 0, 0, 347,
+	381, 209, 443, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 192, 292, 0, 369, 252, 470,
+	450, 0, 0, 393, 392, 219, 442, 360, 261, 336,
+	385, 446, 0, 228, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 180, 181, 193, 202, 212, 226,
+	242, 250, 266, 269, 274, 275, 278, 283, 304, 309,
+	310, 311, 312, 329, 330, 331, 339, 340, 343, 345,
+	346, 349, 356, 358, 359, 361, 363, 370, 375, 383,
+	384, 386, 387, 389, 390, 397, 398, 399, 400, 408,
+	412, 430, 431, 454, 459, 262, 438, 460, 0, 303,
+	0, 0, 305, 246, 265, 276, 0, 449, 409, 197,
+	377, 253, 186, 215, 200, 224, 240, 243, 280, 313,
+	320, 351, 355, 259, 237, 213, 374, 210, 394, 415,
+	416, 417, 419, 317, 232, 354, 420, 0, 301, 421,
+	422, 272, 0, 0, 0, 0, 0, 338, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 289, 233, 0, 0, 352, 0, 184, 0, 396,
+	220, 302, 299, 427, 247, 239, 235, 217, 273, 308,
+	350, 414, 344, 0, 294, 0, 0, 405, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 216, 183, 333, 406, 251,
+	0, 218, 227, 255, 291, 295, 296, 334, 335, 357,
+	371, 395, 0, 0, 0, 175, 176, 177, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
+	0, 0, 0, 0, 0, 231, 277, 238, 230, 424,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 322,
+	0, 0, 0, 0, 456, 0, 0, 0, 2510, 0,
+	0, 0, 288, 0, 285, 179, 195, 0, 0, 332,
+	376, 382, 0, 0, 0, 221, 0, 380, 348, 441,
+	203, 249, 373, 353, 378, 0, 0, 379, 297, 429,
+	367, 439, 457, 458, 229, 326, 447, 418, 453, 469,
+	196, 225, 342, 411, 444, 402, 319, 425, 426, 284,
+	401, 258, 182, 293, 463, 194, 388, 211, 201, 187,
+	413, 437, 208, 391, 0, 0, 471, 189, 435, 410,
+	315, 281, 282, 188, 0, 372, 234, 256, 223, 337,
+	432, 433, 222, 472, 198, 452, 191, 0, 451, 328,
+	428, 436, 316, 307, 190, 434, 314, 306, 287, 245,
+	267, 365, 300, 366, 268, 324, 323, 325, 0, 185,
+	0, 407, 445, 473, 204, 205, 206, 0, 244, 248,
+	254, 257, 263, 264, 271, 290, 341, 364, 362, 368,
+	0, 423, 440, 448, 455, 461, 462, 464, 465, 466,
+	467, 468, 327, 270, 403, 286, 298, 0, 0, 347,
+	381, 209, 443, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 192, 292, 0, 369, 252, 470,
+	450, 0, 0, 393, 392, 219, 442, 360, 261, 336,
+	385, 446, 0, 228, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 180, 181, 193, 202, 212, 226,
+	242, 250, 266, 269, 274, 275, 278, 283, 304, 309,
+	310, 311, 312, 329, 330, 331, 339, 340, 343, 345,
+	346, 349, 356, 358, 359, 361, 363, 370, 375, 383,
+	384, 386, 387, 389, 390, 397, 398, 399, 400, 408,
+	412, 430, 431, 454, 459, 262, 438, 460, 0, 303,
+	0, 0, 305, 246, 265, 276, 0, 449, 409, 197,
+	377, 253, 186, 215, 200, 224, 240, 243, 280, 313,
+	320, 351, 355, 259, 237, 213, 374, 210, 394, 415,
+	416, 417, 419, 317, 232, 354, 420, 0, 301, 421,
+	422, 272, 0, 0, 0, 0, 0, 338, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 289, 233, 0, 0, 352, 0, 184, 0, 396,
+	220, 302, 299, 427, 247, 239, 235, 217, 273, 308,
+	350, 414, 344, 0, 294, 0, 0, 405, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 216, 183, 333, 406, 251,
+	0, 218, 227, 255, 291, 295, 296, 334, 335, 357,
+	371, 395, 0, 0, 0, 175, 176, 177, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
+	0, 0, 0, 0, 0, 231, 277, 238, 230, 424,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1104, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 322,
+	0, 0, 0, 0, 456, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 285, 179, 195, 0, 0, 332,
+	376, 382, 0, 0, 0, 221, 0, 380, 348, 441,
+	203, 249, 373, 353, 378, 0, 0, 379, 297, 429,
+	367, 439, 457, 458, 229, 326, 447, 418, 453, 469,
+	196, 225, 342, 411, 444, 402, 319, 425, 426, 284,
+	401, 258, 182, 293, 463, 194, 388, 211, 201, 187,
+	413, 437, 208, 391, 0, 0, 471, 189, 435, 410,
+	315, 281, 282, 188, 0, 372, 234, 256, 223, 337,
+	432, 433, 222, 472, 198, 452, 191, 0, 451, 328,
+	428, 436, 316, 307, 190, 434, 314, 306, 287, 245,
+	267, 365, 300, 366, 268, 324, 323, 325, 0, 185,
+	0, 407, 445, 473, 204, 205, 206, 0, 244, 248,
+	254, 257, 263, 264, 271, 290, 341, 364, 362, 368,
+	0, 423, 440, 448, 455, 461, 462, 464, 465, 466,
+	467, 468, 327, 270, 403, 286, 298, 0, 0, 347,
+	381, 209, 443, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 192, 292, 0, 369, 252, 470,
+	450, 0, 0, 393, 392, 219, 442, 360, 261, 336,
+	385, 446, 0, 228, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 180, 181, 193, 202, 212, 226,
+	242, 250, 266, 269, 274, 275, 278, 283, 304, 309,
+	310, 311, 312, 329, 330, 331, 339, 340, 343, 345,
+	346, 349, 356, 358, 359, 361, 363, 370, 375, 383,
+	384, 386, 387, 389, 390, 397, 398, 399, 400, 408,
+	412, 430, 431, 454, 459, 262, 438, 460, 0, 303,
+	0, 0, 305, 246, 265, 276, 0, 449, 409, 197,
+	377, 253, 186, 215, 200, 224, 240, 243, 280, 313,
+	320, 351, 355, 259, 237, 213, 374, 210, 394, 415,
+	416, 417, 419, 317, 232, 354, 420, 0, 301, 421,
+	422, 272, 0, 0, 0, 0, 0, 338, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 289, 233, 0, 0, 352, 0, 184, 0, 396,
+	220, 302, 299, 427, 247, 239, 235, 217, 273, 308,
+	350, 414, 344, 0, 294, 0, 0, 405, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 216, 183, 333, 406, 251,
+	0, 218, 227, 255, 291, 295, 296, 334, 335, 357,
+	371, 395, 0, 0, 0, 175, 176, 177, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
+	0, 0, 0, 0, 0, 231, 277, 238, 230, 424,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 322,
+	0, 0, 0, 0, 456, 0, 0, 0, 2380, 0,
+	0, 0, 288, 0, 285, 179, 195, 0, 0, 332,
+	376, 382, 0, 0, 0, 221, 0, 380, 348, 441,
+	203, 249, 373, 353, 378, 0, 0, 379, 297, 429,
+	367, 439, 457, 458, 229, 326, 447, 418, 453, 469,
+	196, 225, 342, 411, 444, 402, 319, 425, 426, 284,
+	401, 258, 182, 293, 463, 194, 388, 211, 201, 187,
+	413, 437, 208, 391, 0, 0, 471, 189, 435, 410,
+	315, 281, 282, 188, 0, 372, 234, 256, 223, 337,
+	432, 433, 222, 472, 198, 452, 191, 0, 451, 328,
+	428, 436, 316, 307, 190, 434, 314, 306, 287, 245,
+	267, 365, 300, 366, 268, 324, 323, 325, 0, 185,
+	0, 407, 445, 473, 204, 205, 206, 0, 244, 248,
+	254, 257, 263, 264, 271, 290, 341, 364, 362, 368,
+	0, 423, 440, 448, 455, 461, 462, 464, 465, 466,
+	467, 468, 327, 270, 403, 286, 298, 0, 0, 347,
+	381, 209, 443, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 192, 292, 0, 369, 252, 470,
+	450, 0, 0, 393, 392, 219, 442, 360, 261, 336,
+	385, 446, 0, 228, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 180, 181, 193, 202, 212, 226,
+	242, 250, 266, 269, 274, 275, 278, 283, 304, 309,
+	310, 311, 312, 329, 330, 331, 339, 340, 343, 345,
+	346, 349, 356, 358, 359, 361, 363, 370, 375, 383,
+	384, 386, 387, 389, 390, 397, 398, 399, 400, 408,
+	412, 430, 431, 454, 459, 262, 438, 460, 0, 303,
+	0, 0, 305, 246, 265, 276, 0, 449, 409, 197,
+	377, 253, 186, 215, 200, 224, 240, 243, 280, 313,
+	320, 351, 355, 259, 237, 213, 374, 210, 394, 415,
+	416, 417, 419, 317, 232, 354, 420, 0, 301, 421,
+	422, 272, 0, 0, 0, 0, 0, 338, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 289, 233, 0, 0, 352, 0, 184, 0, 396,
+	220, 302, 299, 427, 247, 239, 235, 217, 273, 308,
+	350, 414, 344, 0, 294, 0, 0, 405, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 216, 183, 333, 406, 251,
+	0, 218, 227, 255, 291, 295, 296, 334, 335, 357,
+	371, 395, 79, 0, 0, 175, 176, 177, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
+	0, 0, 0, 0, 0, 231, 277, 238, 230, 424,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 322,
+	0, 0, 0, 0, 456, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 285, 179, 195, 0, 0, 332,
+	376, 382, 0, 0, 0, 221, 0, 380, 348, 441,
+	203, 249, 373, 353, 378, 0, 0, 379, 297, 429,
+	367, 439, 457, 458, 229, 326, 447, 418, 453, 469,
+	196, 225, 342, 411, 444, 402, 319, 425, 426, 284,
+	401, 258, 182, 293, 463, 194, 388, 211, 201, 187,
+	413, 437, 208, 391, 0, 0, 471, 189, 435, 410,
+	315, 281, 282, 188, 0, 372, 234, 256, 223, 337,
+	432, 433, 222, 472, 198, 452, 191, 0, 451, 328,
+	428, 436, 316, 307, 190, 434, 314, 306, 287, 245,
+	267, 365, 300, 366, 268, 324, 323, 325, 0, 185,
+	0, 407, 445, 473, 204, 205, 206, 0, 244, 248,
+	254, 257, 263, 264, 271, 290, 341, 364, 362, 368,
+	0, 423, 440, 448, 455, 461, 462, 464, 465, 466,
+	467, 468, 327, 270, 403, 286, 298, 0, 0, 347,
+	381, 209, 443, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 192, 292, 0, 369, 252, 470,
+	450, 0, 0, 393, 392, 219, 442, 360, 261, 336,
+	385, 446, 0, 228, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 180, 181, 193, 202, 212, 226,
+	242, 250, 266, 269, 274, 275, 278, 283, 304, 309,
+	310, 311, 312, 329, 330, 331, 339, 340, 343, 345,
+	346, 349, 356, 358, 359, 361, 363, 370, 375, 383,
+	384, 386, 387, 389, 390, 397, 398, 399, 400, 408,
+	412, 430, 431, 454, 459, 262, 438, 460, 0, 303,
+	0, 0, 305, 246, 265, 276, 0, 449, 409, 197,
+	377, 253, 186, 215, 200, 224, 240, 243, 280, 313,
+	320, 351, 355, 259, 237, 213, 374, 210, 394, 415,
+	416, 417, 419, 317, 232, 354, 420, 0, 301, 421,
+	422, 272, 0, 0, 0, 0, 0, 338, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 289, 233, 0, 0, 352, 0, 184, 0, 396,
+	220, 302, 299, 427, 247, 239, 235, 217, 273, 308,
+	350, 414, 344, 0, 294, 0, 0, 405, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 216, 183, 333, 406, 251,
+	0, 218, 227, 255, 291, 295, 296, 334, 335, 357,
+	371, 395, 0, 0, 0, 175, 176, 177, 0, 1385,
+	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
+	0, 0, 0, 0, 0, 231, 277, 238, 230, 424,
+	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 260, 0, 322,
+	0, 0, 0, 0, 456, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 285, 179, 195, 0, 0, 332,
+	376, 382, 0, 0, 0, 221, 0, 380, 348, 441,
+	203, 249, 373, 353, 378, 0, 0, 379, 297, 429,
+	367, 439, 457, 458, 229, 326, 447, 418, 453, 469,
+	196, 225, 342, 411, 444, 402, 319, 425, 426, 284,
+	401, 258, 182, 293, 463, 194, 388, 211, 201, 187,
+	413, 437, 208, 391, 0, 0, 471, 189, 435, 410,
+	315, 281, 282, 188, 0, 372, 234, 256, 223, 337,
+	432, 433, 222, 472, 198, 452, 191, 0, 451, 328,
+	428, 436, 316, 307, 190, 434, 314, 306, 287, 245,
+	267, 365, 300, 366, 268, 324, 323, 325, 0, 185,
+	0, 407, 445, 473, 204, 205, 206, 0, 244, 248,
+	254, 257, 263, 264, 271, 290, 341, 364, 362, 368,
+	0, 423, 440, 448, 455, 461, 462, 464, 465, 466,
+	467, 468, 327, 270, 403, 286, 298, 0, 0, 347,
+	381, 209, 443, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 192, 292, 0, 369, 252, 470,
+	450, 0, 0, 393, 392, 219, 442, 360, 261, 336,
+	385, 446, 0, 228, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 180, 181, 193, 202, 212, 226,
+	242, 250, 266, 269, 274, 275, 278, 283, 304, 309,
+	310, 311, 312, 329, 330, 331, 339, 340, 343, 345,
+	346, 349, 356, 358, 359, 361, 363, 370, 375, 383,
+	384, 386, 387, 389, 390, 397, 398, 399, 400, 408,
+	412, 430, 431, 454, 459, 262, 438, 460, 0, 303,
+	0, 0, 305, 246, 265, 276, 0, 449, 409, 197,
+	377, 253, 186, 215, 200, 224, 240, 243, 280, 313,
+	320, 351, 355, 259, 237, 213, 374, 210, 394, 415,
+	416, 417, 419, 317, 232, 354, 0, 0, 301, 421,
+	422, 272, 420, 0, 0, 0, 1641, 0, 0, 0,
+	0, 0, 0, 338, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
 	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 207, 0, 214, 750, 796, 833, 751,
-	798, 228, 273, 235, 227, 410, 844, 825, 0, 0,
-	199, 835, 774, 0, 801, 0, 850, 712, 793, 0,
-	715, 718, 846, 829, 746, 238, 0, 0, 0, 0,
-	0, 0, 0, 771, 780, 813, 765, 0, 0, 0,
-	0, 0, 2029, 0, 744, 0, 789, 0, 0, 0,
-	723, 716, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 769, 0, 0, 0, 726, 0, 745,
-	814, 0, 710, 256, 720, 315, 0, 818, 828, 766,
-	442, 832, 764, 763, 808, 724, 824, 757, 284, 722,
-	281, 179, 195, 0, 755, 325, 365, 371, 823, 741,
-	749, 219, 747, 369, 339, 427, 203, 246, 362, 344,
-	367, 788, 806, 368, 290, 415, 357, 425, 443, 444,
-	226, 319, 433, 404, 439, 455, 196, 223, 333, 397,
-	430, 388, 312, 411, 412, 280, 387, 254, 182, 288,
-	449, 194, 377, 211, 201, 187, 399, 423, 208, 380,
-	0, 0, 457, 189, 421, 396, 308, 277, 278, 188,
-	0, 361, 231, 252, 221, 328, 418, 419, 220, 458,
-	198, 438, 191, 1028, 437, 321, 414, 422, 309, 300,
-	190, 420, 307, 299, 283, 242, 263, 355, 293, 356,
-	264, 317, 316, 318, 0, 185, 0, 393, 431, 459,
-	204, 205, 206, 736, 241, 245, 251, 253, 259, 260,
-	267, 286, 332, 354, 352, 358, 819, 409, 426, 434,
-	441, 447, 448, 450, 451, 452, 453, 454, 320, 266,
-	389, 282, 291, 811, 849, 338, 370, 209, 429, 390,
-	731, 735, 729, 730, 783, 784, 732, 840, 841, 842,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 0, 815,
-	725, 0, 733, 734, 0, 821, 830, 831, 787, 178,
-	192, 287, 845, 359, 249, 456, 436, 432, 711, 728,
-	225, 739, 0, 0, 752, 760, 761, 773, 775, 776,
-	777, 778, 311, 794, 795, 797, 805, 807, 810, 812,
-	817, 827, 848, 180, 181, 193, 202, 212, 224, 239,
-	247, 257, 262, 265, 270, 271, 274, 279, 297, 302,
-	303, 304, 305, 322, 323, 324, 327, 330, 331, 334,
-	336, 337, 340, 347, 348, 349, 350, 351, 353, 360,
-	364, 372, 373, 374, 375, 376, 378, 379, 383, 384,
-	385, 386, 394, 398, 416, 417, 428, 440, 445, 258,
-	424, 446, 0, 296, 786, 792, 298, 243, 261, 272,
-	800, 435, 395, 197, 366, 250, 186, 215, 200, 222,
-	237, 240, 276, 306, 313, 342, 346, 255, 234, 213,
-	363, 210, 381, 401, 402, 403, 405, 310, 229, 345,
-	782, 809, 294, 407, 408, 268, 834, 820, 406, 768,
-	837, 738, 756, 847, 759, 762, 802, 717, 781, 329,
-	753, 0, 742, 713, 748, 714, 740, 770, 233, 737,
-	822, 785, 836, 285, 230, 719, 743, 343, 758, 184,
-	804, 382, 218, 295, 292, 413, 244, 236, 232, 217,
-	269, 301, 341, 400, 335, 843, 289, 791, 0, 391,
-	314, 0, 0, 0, 772, 826, 779, 816, 767, 803,
-	727, 790, 838, 754, 799, 839, 275, 216, 183, 326,
-	392, 248, 0, 0, 0, 0, 175, 176, 177, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
-	214, 750, 796, 833, 751, 798, 228, 273, 235, 227,
-	410, 844, 825, 0, 0, 199, 835, 774, 0, 801,
-	0, 850, 712, 793, 0, 715, 718, 846, 829, 746,
-	238, 0, 0, 0, 0, 0, 0, 0, 771, 780,
-	813, 765, 0, 0, 0, 0, 0, 1592, 0, 744,
-	0, 789, 0, 0, 0, 723, 716, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 769, 0,
-	0, 0, 726, 0, 745, 814, 0, 710, 256, 720,
-	315, 0, 818, 828, 766, 442, 832, 764, 763, 808,
-	724, 824, 757, 284, 722, 281, 179, 195, 0, 755,
-	325, 365, 371, 823, 741, 749, 219, 747, 369, 339,
-	427, 203, 246, 362, 344, 367, 788, 806, 368, 290,
-	415, 357, 425, 443, 444, 226, 319, 433, 404, 439,
-	455, 196, 223, 333, 397, 430, 388, 312, 411, 412,
-	280, 387, 254, 182, 288, 449, 194, 377, 211, 201,
-	187, 399, 423, 208, 380, 0, 0, 457, 189, 421,
-	396, 308, 277, 278, 188, 0, 361, 231, 252, 221,
-	328, 418, 419, 220, 458, 198, 438, 191, 1028, 437,
-	321, 414, 422, 309, 300, 190, 420, 307, 299, 283,
-	242, 263, 355, 293, 356, 264, 317, 316, 318, 0,
-	185, 0, 393, 431, 459, 204, 205, 206, 736, 241,
-	245, 251, 253, 259, 260, 267, 286, 332, 354, 352,
-	358, 819, 409, 426, 434, 441, 447, 448, 450, 451,
-	452, 453, 454, 320, 266, 389, 282, 291, 811, 849,
-	338, 370, 209, 429, 390, 731, 735, 729, 730, 783,
-	784, 732, 840, 841, 842, 460, 461, 462, 463, 464,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 0, 815, 725, 0, 733, 734, 0,
-	821, 830, 831, 787, 178, 192, 287, 845, 359, 249,
-	456, 436, 432, 711, 728, 225, 739, 0, 0, 752,
-	760, 761, 773, 775, 776, 777, 778, 311, 794, 795,
-	797, 805, 807, 810, 812, 817, 827, 848, 180, 181,
-	193, 202, 212, 224, 239, 247, 257, 262, 265, 270,
-	271, 274, 279, 297, 302, 303, 304, 305, 322, 323,
-	324, 327, 330, 331, 334, 336, 337, 340, 347, 348,
-	349, 350, 351, 353, 360, 364, 372, 373, 374, 375,
-	376, 378, 379, 383, 384, 385, 386, 394, 398, 416,
-	417, 428, 440, 445, 258, 424, 446, 0, 296, 786,
-	792, 298, 243, 261, 272, 800, 435, 395, 197, 366,
-	250, 186, 215, 200, 222, 237, 240, 276, 306, 313,
-	342, 346, 255, 234, 213, 363, 210, 381, 401, 402,
-	403, 405, 310, 229, 345, 782, 809, 294, 407, 408,
-	268, 834, 820, 406, 768, 837, 738, 756, 847, 759,
-	762, 802, 717, 781, 329, 753, 0, 742, 713, 748,
-	714, 740, 770, 233, 737, 822, 785, 836, 285, 230,
-	719, 743, 343, 758, 184, 804, 382, 218, 295, 292,
-	413, 244, 236, 232, 217, 269, 301, 341, 400, 335,
-	843, 289, 791, 0, 391, 314, 0, 0, 0, 772,
-	826, 779, 816, 767, 803, 727, 790, 838, 754, 799,
-	839, 275, 216, 183, 326, 392, 248, 0, 79, 0,
+	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
+	0, 175, 176, 177, 0, 1217, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
 	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 207, 0, 214, 750, 796, 833, 751,
-	798, 228, 273, 235, 227, 410, 844, 825, 0, 0,
-	199, 835, 774, 0, 801, 0, 850, 712, 793, 0,
-	715, 718, 846, 829, 746, 238, 0, 0, 0, 0,
-	0, 0, 0, 771, 780, 813, 765, 0, 0, 0,
-	0, 0, 0, 0, 744, 0, 789, 0, 0, 0,
-	723, 716, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 769, 0, 0, 0, 726, 0, 745,
-	814, 0, 710, 256, 720, 315, 0, 818, 828, 766,
-	442, 832, 764, 763, 808, 724, 824, 757, 284, 722,
-	281, 179, 195, 0, 755, 325, 365, 371, 823, 741,
-	749, 219, 747, 369, 339, 427, 203, 246, 362, 344,
-	367, 788, 806, 368, 290, 415, 357, 425, 443, 444,
-	226, 319, 433, 404, 439, 455, 196, 223, 333, 397,
-	430, 388, 312, 411, 412, 280, 387, 254, 182, 288,
-	449, 194, 377, 211, 201, 187, 399, 423, 208, 380,
-	0, 0, 457, 189, 421, 396, 308, 277, 278, 188,
-	0, 361, 231, 252, 221, 328, 418, 419, 220, 458,
-	198, 438, 191, 1028, 437, 321, 414, 422, 309, 300,
-	190, 420, 307, 299, 283, 242, 263, 355, 293, 356,
-	264, 317, 316, 318, 0, 185, 0, 393, 431, 459,
-	204, 205, 206, 736, 241, 245, 251, 253, 259, 260,
-	267, 286, 332, 354, 352, 358, 819, 409, 426, 434,
-	441, 447, 448, 450, 451, 452, 453, 454, 320, 266,
-	389, 282, 291, 811, 849, 338, 370, 209, 429, 390,
-	731, 735, 729, 730, 783, 784, 732, 840, 841, 842,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 0, 815,
-	725, 0, 733, 734, 0, 821, 830, 831, 787, 178,
-	192, 287, 845, 359, 249, 456, 436, 432, 711, 728,
-	225, 739, 0, 0, 752, 760, 761, 773, 775, 776,
-	777, 778, 311, 794, 795, 797, 805, 807, 810, 812,
-	817, 827, 848, 180, 181, 193, 202, 212, 224, 239,
-	247, 257, 262, 265, 270, 271, 274, 279, 297, 302,
-	303, 304, 305, 322, 323, 324, 327, 330, 331, 334,
-	336, 337, 340, 347, 348, 349, 350, 351, 353, 360,
-	364, 372, 373, 374, 375, 376, 378, 379, 383, 384,
-	385, 386, 394, 398, 416, 417, 428, 440, 445, 258,
-	424, 446, 0, 296, 786, 792, 298, 243, 261, 272,
-	800, 435, 395, 197, 366, 250, 186, 215, 200, 222,
-	237, 240, 276, 306, 313, 342, 346, 255, 234, 213,
-	363, 210, 381, 401, 402, 403, 405, 310, 229, 345,
-	782, 809, 294, 407, 408, 268, 834, 820, 406, 768,
-	837, 738, 756, 847, 759, 762, 802, 717, 781, 329,
-	753, 0, 742, 713, 748, 714, 740, 770, 233, 737,
-	822, 785, 836, 285, 230, 719, 743, 343, 758, 184,
-	804, 382, 218, 295, 292, 413, 244, 236, 232, 217,
-	269, 301, 341, 400, 335, 843, 289, 791, 0, 391,
-	314, 0, 0, 0, 772, 826, 779, 816, 767, 803,
-	727, 790, 838, 754, 799, 839, 275, 216, 183, 326,
-	392, 248, 0, 0, 0, 0, 175, 176, 177, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
-	214, 750, 796, 833, 751, 798, 228, 273, 235, 227,
-	410, 844, 825, 0, 0, 199, 835, 774, 0, 801,
-	0, 850, 712, 793, 0, 715, 718, 846, 829, 746,
-	238, 0, 0, 0, 0, 0, 0, 0, 771, 780,
-	813, 765, 0, 0, 0, 0, 0, 0, 0, 744,
-	0, 789, 0, 0, 0, 723, 716, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 769, 0,
-	0, 0, 726, 0, 745, 814, 0, 710, 256, 720,
-	315, 0, 818, 828, 766, 442, 832, 764, 763, 808,
-	724, 824, 757, 284, 722, 281, 179, 195, 0, 755,
-	325, 365, 371, 823, 741, 749, 219, 747, 369, 339,
-	427, 203, 246, 362, 344, 367, 788, 806, 368, 290,
-	415, 357, 425, 443, 444, 226, 319, 433, 404, 439,
-	455, 196, 223, 333, 397, 430, 388, 312, 411, 412,
-	280, 387, 254, 182, 288, 449, 194, 377, 211, 201,
-	187, 399, 423, 208, 380, 0, 0, 457, 189, 421,
-	396, 308, 277, 278, 188, 0, 361, 231, 252, 221,
-	328, 418, 419, 220, 458, 198, 438, 191, 1028, 437,
-	321, 414, 422, 309, 300, 190, 420, 307, 299, 283,
-	242, 263, 355, 293, 356, 264, 317, 316, 318, 0,
-	185, 0, 393, 431, 459, 204, 205, 206, 736, 241,
-	245, 251, 253, 259, 260, 267, 286, 332, 354, 352,
-	358, 819, 409, 426, 434, 441, 447, 448, 450, 451,
-	452, 453, 454, 320, 266, 389, 282, 291, 811, 849,
-	338, 370, 209, 429, 390, 731, 735, 729, 730, 783,
-	784, 732, 840, 841, 842, 460, 461, 462, 463, 464,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 0, 815, 725, 0, 733, 734, 0,
-	821, 830, 831, 787, 178, 192, 287, 845, 359, 249,
-	456, 436, 432, 711, 728, 225, 739, 0, 0, 752,
-	760, 761, 773, 775, 776, 777, 778, 311, 794, 795,
-	797, 805, 807, 810, 812, 817, 827, 848, 180, 181,
-	193, 202, 212, 224, 239, 247, 257, 262, 265, 270,
-	271, 274, 279, 297, 302, 303, 304, 305, 322, 323,
-	324, 327, 330, 331, 334, 336, 337, 340, 347, 348,
-	349, 350, 351, 353, 360, 364, 372, 373, 374, 375,
-	376, 378, 379, 383, 384, 385, 386, 394, 398, 416,
-	417, 428, 440, 445, 258, 424, 446, 0, 296, 786,
-	792, 298, 243, 261, 272, 800, 435, 395, 197, 366,
-	250, 186, 215, 200, 222, 237, 240, 276, 306, 313,
-	342, 346, 255, 234, 213, 363, 210, 381, 401, 402,
-	403, 405, 310, 229, 345, 782, 809, 294, 407, 408,
-	268, 834, 820, 406, 768, 837, 738, 756, 847, 759,
-	762, 802, 717, 781, 329, 753, 0, 742, 713, 748,
-	714, 740, 770, 233, 737, 822, 785, 836, 285, 230,
-	719, 743, 343, 758, 184, 804, 382, 218, 295, 292,
-	413, 244, 236, 232, 217, 269, 301, 341, 400, 335,
-	843, 289, 791, 0, 391, 314, 0, 0, 0, 772,
-	826, 779, 816, 767, 803, 727, 790, 838, 754, 799,
-	839, 275, 216, 183, 326, 392, 248, 0, 0, 0,
+	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	192, 292, 1476, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 1357, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
 	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 207, 0, 214, 750, 796, 833, 751,
-	798, 228, 273, 235, 227, 410, 844, 825, 0, 0,
-	851, 835, 774, 0, 801, 0, 850, 712, 793, 0,
-	715, 718, 846, 829, 746, 238, 0, 0, 0, 0,
-	0, 0, 0, 771, 780, 813, 765, 0, 0, 0,
-	0, 0, 0, 0, 744, 0, 789, 0, 0, 0,
-	723, 716, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 769, 0, 0, 0, 726, 0, 745,
-	814, 0, 710, 256, 720, 315, 0, 818, 828, 766,
-	442, 832, 764, 763, 808, 724, 824, 757, 284, 722,
-	281, 179, 195, 0, 755, 325, 365, 371, 823, 741,
-	749, 219, 747, 369, 339, 427, 203, 246, 362, 344,
-	367, 788, 806, 368, 290, 415, 357, 425, 443, 444,
-	226, 319, 433, 404, 439, 455, 196, 223, 333, 397,
-	430, 388, 312, 411, 412, 280, 387, 254, 182, 288,
-	449, 194, 377, 211, 201, 187, 399, 423, 208, 380,
-	0, 0, 457, 189, 421, 396, 308, 277, 278, 188,
-	0, 361, 231, 252, 221, 328, 418, 419, 220, 458,
-	198, 438, 191, 721, 437, 321, 414, 422, 309, 300,
-	190, 420, 307, 299, 283, 242, 263, 355, 293, 356,
-	264, 317, 316, 318, 0, 185, 0, 393, 431, 459,
-	204, 205, 206, 736, 241, 245, 251, 253, 259, 260,
-	267, 286, 332, 354, 352, 358, 819, 409, 426, 434,
-	441, 447, 448, 450, 451, 452, 453, 454, 709, 703,
-	702, 282, 291, 811, 849, 338, 370, 209, 429, 390,
-	731, 735, 729, 730, 783, 784, 732, 840, 841, 842,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 0, 815,
-	725, 0, 733, 734, 0, 821, 830, 831, 787, 178,
-	192, 287, 845, 359, 249, 456, 436, 432, 711, 728,
-	225, 739, 0, 0, 752, 760, 761, 773, 775, 776,
-	777, 778, 311, 794, 795, 797, 805, 807, 810, 812,
-	817, 827, 848, 180, 181, 193, 202, 212, 224, 239,
-	247, 257, 262, 265, 270, 271, 274, 279, 297, 302,
-	303, 304, 305, 322, 323, 324, 327, 330, 331, 334,
-	336, 337, 340, 347, 348, 349, 350, 351, 353, 360,
-	364, 372, 373, 374, 375, 376, 378, 379, 383, 384,
-	385, 386, 394, 398, 416, 417, 428, 440, 445, 258,
-	424, 446, 0, 296, 786, 792, 298, 243, 261, 272,
-	800, 435, 395, 197, 366, 250, 186, 215, 200, 222,
-	237, 240, 276, 306, 313, 342, 346, 255, 234, 213,
-	363, 210, 381, 401, 402, 403, 405, 310, 229, 345,
-	782, 809, 294, 407, 408, 268, 834, 820, 406, 768,
-	837, 738, 756, 847, 759, 762, 802, 717, 781, 329,
-	753, 0, 742, 713, 748, 714, 740, 770, 233, 737,
-	822, 785, 836, 285, 230, 719, 743, 343, 758, 184,
-	804, 382, 218, 295, 292, 413, 244, 236, 232, 217,
-	269, 301, 341, 400, 335, 843, 289, 791, 0, 391,
-	314, 0, 0, 0, 772, 826, 779, 816, 767, 803,
-	727, 790, 838, 754, 799, 839, 275, 216, 183, 326,
-	392, 248, 0, 0, 0, 0, 175, 176, 177, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
-	214, 750, 796, 833, 751, 798, 228, 273, 235, 227,
-	410, 844, 825, 0, 0, 851, 835, 774, 0, 801,
-	0, 850, 712, 793, 0, 715, 718, 846, 829, 746,
-	238, 0, 0, 0, 0, 0, 0, 0, 771, 780,
-	813, 765, 0, 0, 0, 0, 0, 0, 0, 744,
-	0, 789, 0, 0, 0, 723, 716, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 769, 0,
-	0, 0, 726, 0, 745, 814, 0, 710, 256, 720,
-	315, 0, 818, 828, 766, 442, 832, 764, 763, 808,
-	724, 824, 757, 284, 722, 281, 179, 195, 0, 755,
-	325, 365, 371, 823, 741, 749, 219, 747, 369, 339,
-	427, 203, 246, 362, 344, 367, 788, 806, 368, 290,
-	415, 357, 425, 443, 444, 226, 319, 433, 404, 439,
-	455, 196, 223, 333, 397, 430, 388, 312, 411, 412,
-	280, 387, 254, 182, 288, 449, 194, 377, 211, 201,
-	187, 399, 1200, 208, 380, 0, 0, 457, 189, 421,
-	396, 308, 277, 278, 188, 0, 361, 231, 252, 221,
-	328, 418, 419, 220, 458, 198, 438, 191, 721, 437,
-	321, 414, 422, 309, 300, 190, 420, 307, 299, 283,
-	242, 263, 355, 293, 356, 264, 317, 316, 318, 0,
-	185, 0, 393, 431, 459, 204, 205, 206, 736, 241,
-	245, 251, 253, 259, 260, 267, 286, 332, 354, 352,
-	358, 819, 409, 426, 434, 441, 447, 448, 450, 451,
-	452, 453, 454, 709, 703, 702, 282, 291, 811, 849,
-	338, 370, 209, 429, 390, 731, 735, 729, 730, 783,
-	784, 732, 840, 841, 842, 460, 461, 462, 463, 464,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 0, 815, 725, 0, 733, 734, 0,
-	821, 830, 831, 787, 178, 192, 287, 845, 359, 249,
-	456, 436, 432, 711, 728, 225, 739, 0, 0, 752,
-	760, 761, 773, 775, 776, 777, 778, 311, 794, 795,
-	797, 805, 807, 810, 812, 817, 827, 848, 180, 181,
-	193, 202, 212, 224, 239, 247, 257, 262, 265, 270,
-	271, 274, 279, 297, 302, 303, 304, 305, 322, 323,
-	324, 327, 330, 331, 334, 336, 337, 340, 347, 348,
-	349, 350, 351, 353, 360, 364, 372, 373, 374, 375,
-	376, 378, 379, 383, 384, 385, 386, 394, 398, 416,
-	417, 428, 440, 445, 258, 424, 446, 0, 296, 786,
-	792, 298, 243, 261, 272, 800, 435, 395, 197, 366,
-	250, 186, 215, 200, 222, 237, 240, 276, 306, 313,
-	342, 346, 255, 234, 213, 363, 210, 381, 401, 402,
-	403, 405, 310, 229, 345, 782, 809, 294, 407, 408,
-	268, 834, 820, 406, 768, 837, 738, 756, 847, 759,
-	762, 802, 717, 781, 329, 753, 0, 742, 713, 748,
-	714, 740, 770, 233, 737, 822, 785, 836, 285, 230,
-	719, 743, 343, 758, 184, 804, 382, 218, 295, 292,
-	413, 244, 236, 232, 217, 269, 301, 341, 400, 335,
-	843, 289, 791, 0, 391, 314, 0, 0, 0, 772,
-	826, 779, 816, 767, 803, 727, 790, 838, 754, 799,
-	839, 275, 216, 183, 326, 392, 248, 0, 0, 0,
+	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 1355, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
 	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 207, 0, 214, 750, 796, 833, 751,
-	798, 228, 273, 235, 227, 410, 844, 825, 0, 0,
-	851, 835, 774, 0, 801, 0, 850, 712, 793, 0,
-	715, 718, 846, 829, 746, 238, 0, 0, 0, 0,
-	0, 0, 0, 771, 780, 813, 765, 0, 0, 0,
-	0, 0, 0, 0, 744, 0, 789, 0, 0, 0,
-	723, 716, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 769, 0, 0, 0, 726, 0, 745,
-	814, 0, 710, 256, 720, 315, 0, 818, 828, 766,
-	442, 832, 764, 763, 808, 724, 824, 757, 284, 722,
-	281, 179, 195, 0, 755, 325, 365, 371, 823, 741,
-	749, 219, 747, 369, 339, 427, 203, 246, 362, 344,
-	367, 788, 806, 368, 290, 415, 357, 425, 443, 444,
-	226, 319, 433, 404, 439, 455, 196, 223, 333, 397,
-	430, 388, 312, 411, 412, 280, 387, 254, 182, 288,
-	449, 194, 377, 211, 201, 187, 399, 700, 208, 380,
-	0, 0, 457, 189, 421, 396, 308, 277, 278, 188,
-	0, 361, 231, 252, 221, 328, 418, 419, 220, 458,
-	198, 438, 191, 721, 437, 321, 414, 422, 309, 300,
-	190, 420, 307, 299, 283, 242, 263, 355, 293, 356,
-	264, 317, 316, 318, 0, 185, 0, 393, 431, 459,
-	204, 205, 206, 736, 241, 245, 251, 253, 259, 260,
-	267, 286, 332, 354, 352, 358, 819, 409, 426, 434,
-	441, 447, 448, 450, 451, 452, 453, 454, 709, 703,
-	702, 282, 291, 811, 849, 338, 370, 209, 429, 390,
-	731, 735, 729, 730, 783, 784, 732, 840, 841, 842,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 0, 815,
-	725, 0, 733, 734, 0, 821, 830, 831, 787, 178,
-	192, 287, 845, 359, 249, 456, 436, 432, 711, 728,
-	225, 739, 0, 0, 752, 760, 761, 773, 775, 776,
-	777, 778, 311, 794, 795, 797, 805, 807, 810, 812,
-	817, 827, 848, 180, 181, 193, 202, 212, 224, 239,
-	247, 257, 262, 265, 270, 271, 274, 279, 297, 302,
-	303, 304, 305, 322, 323, 324, 327, 330, 331, 334,
-	336, 337, 340, 347, 348, 349, 350, 351, 353, 360,
-	364, 372, 373, 374, 375, 376, 378, 379, 383, 384,
-	385, 386, 394, 398, 416, 417, 428, 440, 445, 258,
-	424, 446, 0, 296, 786, 792, 298, 243, 261, 272,
-	800, 435, 395, 197, 366, 250, 186, 215, 200, 222,
-	237, 240, 276, 306, 313, 342, 346, 255, 234, 213,
-	363, 210, 381, 401, 402, 403, 405, 310, 229, 345,
-	782, 809, 294, 407, 408, 268, 406, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 329, 0, 0,
-	1542, 0, 558, 0, 0, 0, 233, 563, 0, 0,
-	0, 285, 230, 0, 1543, 343, 0, 184, 0, 382,
-	218, 295, 292, 413, 244, 236, 232, 217, 269, 301,
-	341, 400, 335, 570, 289, 0, 0, 391, 314, 0,
-	0, 0, 0, 0, 565, 566, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 216, 183, 326, 392, 248,
-	0, 79, 0, 0, 175, 176, 177, 600, 607, 608,
-	609, 610, 601, 603, 0, 0, 207, 602, 214, 579,
-	605, 611, 612, 0, 228, 273, 235, 227, 410, 0,
-	0, 0, 0, 199, 0, 0, 0, 0, 0, 0,
-	0, 541, 555, 0, 569, 0, 0, 0, 238, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 552, 553, 690, 0, 0, 0, 585,
-	0, 554, 0, 0, 562, 613, 614, 615, 616, 617,
-	618, 619, 620, 621, 622, 623, 624, 625, 626, 627,
-	628, 629, 630, 631, 632, 633, 634, 635, 636, 637,
-	638, 639, 640, 641, 642, 643, 644, 645, 646, 647,
-	648, 649, 650, 651, 652, 653, 564, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 256, 0, 315, 0,
-	584, 0, 0, 442, 0, 0, 582, 0, 0, 0,
-	0, 284, 0, 281, 179, 195, 0, 0, 325, 365,
-	371, 0, 0, 0, 219, 0, 369, 339, 427, 203,
-	246, 362, 344, 367, 0, 0, 368, 290, 415, 357,
-	425, 443, 444, 226, 319, 433, 404, 439, 455, 196,
-	223, 333, 397, 430, 388, 312, 411, 412, 280, 387,
-	254, 182, 288, 449, 194, 377, 211, 201, 187, 399,
-	423, 208, 380, 0, 0, 457, 189, 421, 396, 308,
-	277, 278, 188, 0, 361, 231, 252, 221, 328, 418,
-	419, 220, 458, 198, 438, 191, 0, 437, 321, 414,
-	422, 309, 300, 190, 420, 307, 299, 283, 242, 263,
-	355, 293, 356, 264, 317, 316, 318, 0, 185, 0,
-	393, 431, 459, 204, 205, 206, 0, 241, 245, 251,
-	253, 259, 260, 267, 286, 332, 354, 352, 358, 0,
-	409, 426, 434, 441, 447, 448, 450, 451, 452, 453,
-	454, 320, 266, 389, 282, 291, 0, 0, 338, 370,
-	209, 429, 390, 591, 583, 574, 576, 592, 593, 571,
-	572, 575, 594, 460, 461, 462, 463, 464, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 0, 586, 561, 560, 0, 567, 568, 0, 577,
-	578, 559, 178, 192, 287, 0, 359, 249, 456, 436,
-	432, 0, 0, 225, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 311, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 180, 181, 193, 202,
-	212, 224, 239, 247, 257, 262, 265, 270, 271, 274,
-	279, 297, 302, 303, 304, 305, 322, 323, 324, 327,
-	330, 331, 334, 336, 337, 340, 347, 348, 349, 350,
-	351, 353, 360, 364, 372, 373, 374, 375, 376, 378,
-	379, 383, 384, 385, 386, 394, 398, 416, 417, 428,
-	440, 445, 258, 424, 446, 0, 296, 0, 0, 298,
-	243, 261, 272, 0, 435, 395, 197, 366, 250, 186,
-	215, 200, 222, 237, 240, 276, 306, 313, 342, 346,
-	255, 234, 213, 363, 210, 381, 401, 402, 403, 405,
-	310, 229, 345, 406, 0, 294, 407, 408, 268, 0,
-	0, 0, 0, 0, 329, 0, 0, 0, 0, 558,
-	0, 0, 0, 233, 563, 0, 0, 0, 285, 230,
-	0, 0, 343, 0, 184, 0, 382, 218, 295, 292,
-	413, 244, 236, 232, 217, 269, 301, 341, 400, 335,
-	570, 289, 0, 0, 391, 314, 0, 0, 0, 0,
-	0, 565, 566, 0, 0, 0, 0, 0, 0, 1615,
-	0, 275, 216, 183, 326, 392, 248, 0, 79, 0,
-	0, 175, 176, 177, 600, 607, 608, 609, 610, 601,
-	603, 0, 0, 207, 602, 214, 579, 605, 611, 612,
-	1616, 228, 273, 235, 227, 410, 0, 0, 0, 0,
-	199, 0, 0, 0, 0, 0, 0, 0, 541, 555,
-	0, 569, 0, 0, 0, 238, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	552, 553, 0, 0, 0, 0, 585, 0, 554, 0,
-	0, 562, 613, 614, 615, 616, 617, 618, 619, 620,
-	621, 622, 623, 624, 625, 626, 627, 628, 629, 630,
-	631, 632, 633, 634, 635, 636, 637, 638, 639, 640,
-	641, 642, 643, 644, 645, 646, 647, 648, 649, 650,
-	651, 652, 653, 564, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 315, 0, 584, 0, 0,
-	442, 0, 0, 582, 0, 0, 0, 0, 284, 0,
-	281, 179, 195, 0, 0, 325, 365, 371, 0, 0,
-	0, 219, 0, 369, 339, 427, 203, 246, 362, 344,
-	367, 0, 0, 368, 290, 415, 357, 425, 443, 444,
-	226, 319, 433, 404, 439, 455, 196, 223, 333, 397,
-	430, 388, 312, 411, 412, 280, 387, 254, 182, 288,
-	449, 194, 377, 211, 201, 187, 399, 423, 208, 380,
-	0, 0, 457, 189, 421, 396, 308, 277, 278, 188,
-	0, 361, 231, 252, 221, 328, 418, 419, 220, 458,
-	198, 438, 191, 0, 437, 321, 414, 422, 309, 300,
-	190, 420, 307, 299, 283, 242, 263, 355, 293, 356,
-	264, 317, 316, 318, 0, 185, 0, 393, 431, 459,
-	204, 205, 206, 0, 241, 245, 251, 253, 259, 260,
-	267, 286, 332, 354, 352, 358, 0, 409, 426, 434,
-	441, 447, 448, 450, 451, 452, 453, 454, 320, 266,
-	389, 282, 291, 0, 0, 338, 370, 209, 429, 390,
-	591, 583, 574, 576, 592, 593, 571, 572, 575, 594,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 0, 586,
-	561, 560, 0, 567, 568, 0, 577, 578, 559, 178,
-	192, 287, 0, 359, 249, 456, 436, 432, 0, 0,
-	225, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 311, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 180, 181, 193, 202, 212, 224, 239,
-	247, 257, 262, 265, 270, 271, 274, 279, 297, 302,
-	303, 304, 305, 322, 323, 324, 327, 330, 331, 334,
-	336, 337, 340, 347, 348, 349, 350, 351, 353, 360,
-	364, 372, 373, 374, 375, 376, 378, 379, 383, 384,
-	385, 386, 394, 398, 416, 417, 428, 440, 445, 258,
-	424, 446, 0, 296, 0, 0, 298, 243, 261, 272,
-	0, 435, 395, 197, 366, 250, 186, 215, 200, 222,
-	237, 240, 276, 306, 313, 342, 346, 255, 234, 213,
-	363, 210, 381, 401, 402, 403, 405, 310, 229, 345,
-	70, 406, 294, 407, 408, 268, 0, 0, 0, 0,
-	0, 0, 329, 0, 0, 0, 0, 558, 0, 0,
-	0, 233, 563, 0, 0, 0, 285, 230, 0, 0,
-	343, 0, 184, 0, 382, 218, 295, 292, 413, 244,
-	236, 232, 217, 269, 301, 341, 400, 335, 570, 289,
-	0, 0, 391, 314, 0, 0, 0, 0, 0, 565,
-	566, 0, 0, 0, 0, 0, 0, 0, 0, 275,
-	216, 183, 326, 392, 248, 0, 79, 0, 0, 175,
-	176, 177, 600, 607, 608, 609, 610, 601, 603, 0,
-	0, 207, 602, 214, 579, 605, 611, 612, 0, 228,
-	273, 235, 227, 410, 0, 0, 0, 0, 199, 0,
-	0, 0, 0, 0, 0, 0, 541, 555, 0, 569,
-	0, 0, 0, 238, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 552, 553,
-	0, 0, 0, 0, 585, 0, 554, 0, 0, 562,
-	613, 614, 615, 616, 617, 618, 619, 620, 621, 622,
-	623, 624, 625, 626, 627, 628, 629, 630, 631, 632,
-	633, 634, 635, 636, 637, 638, 639, 640, 641, 642,
-	643, 644, 645, 646, 647, 648, 649, 650, 651, 652,
-	653, 564, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 315, 0, 584, 0, 0, 442, 0,
-	0, 582, 0, 0, 0, 0, 284, 0, 281, 179,
-	195, 0, 0, 325, 365, 371, 0, 0, 0, 219,
-	0, 369, 339, 427, 203, 246, 362, 344, 367, 0,
-	0, 368, 290, 415, 357, 425, 443, 444, 226, 319,
-	433, 404, 439, 455, 196, 223, 333, 397, 430, 388,
-	312, 411, 412, 280, 387, 254, 182, 288, 449, 194,
-	377, 211, 201, 187, 399, 423, 208, 380, 0, 0,
-	457, 189, 421, 396, 308, 277, 278, 188, 0, 361,
-	231, 252, 221, 328, 418, 419, 220, 458, 198, 438,
-	191, 0, 437, 321, 414, 422, 309, 300, 190, 420,
-	307, 299, 283, 242, 263, 355, 293, 356, 264, 317,
-	316, 318, 0, 185, 0, 393, 431, 459, 204, 205,
-	206, 0, 241, 245, 251, 253, 259, 260, 267, 286,
-	332, 354, 352, 358, 0, 409, 426, 434, 441, 447,
-	448, 450, 451, 452, 453, 454, 320, 266, 389, 282,
-	291, 0, 0, 338, 370, 209, 429, 390, 591, 583,
-	574, 576, 592, 593, 571, 572, 575, 594, 460, 461,
-	462, 463, 464, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 474, 475, 476, 477, 0, 586, 561, 560,
-	0, 567, 568, 0, 577, 578, 559, 178, 192, 287,
-	78, 359, 249, 456, 436, 432, 0, 0, 225, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	311, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 180, 181, 193, 202, 212, 224, 239, 247, 257,
-	262, 265, 270, 271, 274, 279, 297, 302, 303, 304,
-	305, 322, 323, 324, 327, 330, 331, 334, 336, 337,
-	340, 347, 348, 349, 350, 351, 353, 360, 364, 372,
-	373, 374, 375, 376, 378, 379, 383, 384, 385, 386,
-	394, 398, 416, 417, 428, 440, 445, 258, 424, 446,
-	0, 296, 0, 0, 298, 243, 261, 272, 0, 435,
-	395, 197, 366, 250, 186, 215, 200, 222, 237, 240,
-	276, 306, 313, 342, 346, 255, 234, 213, 363, 210,
-	381, 401, 402, 403, 405, 310, 229, 345, 406, 0,
-	294, 407, 408, 268, 0, 0, 0, 0, 0, 329,
-	0, 0, 0, 0, 558, 0, 0, 0, 233, 563,
-	0, 0, 0, 285, 230, 0, 0, 343, 0, 184,
-	0, 382, 218, 295, 292, 413, 244, 236, 232, 217,
-	269, 301, 341, 400, 335, 570, 289, 0, 0, 391,
-	314, 0, 0, 0, 0, 0, 565, 566, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 216, 183, 326,
-	392, 248, 0, 79, 0, 0, 175, 176, 177, 600,
-	607, 608, 609, 610, 601, 603, 0, 0, 207, 602,
-	214, 579, 605, 611, 612, 0, 228, 273, 235, 227,
-	410, 0, 0, 0, 0, 199, 0, 0, 0, 0,
-	0, 0, 0, 541, 555, 0, 569, 0, 0, 0,
-	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 552, 553, 0, 0, 0,
-	0, 585, 0, 554, 0, 0, 562, 613, 614, 615,
-	616, 617, 618, 619, 620, 621, 622, 623, 624, 625,
-	626, 627, 628, 629, 630, 631, 632, 633, 634, 635,
-	636, 637, 638, 639, 640, 641, 642, 643, 644, 645,
-	646, 647, 648, 649, 650, 651, 652, 653, 564, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 256, 0,
-	315, 0, 584, 0, 0, 442, 0, 0, 582, 0,
-	0, 0, 0, 284, 0, 281, 179, 195, 0, 0,
-	325, 365, 371, 0, 0, 0, 219, 0, 369, 339,
-	427, 203, 246, 362, 344, 367, 2409, 0, 368, 290,
-	415, 357, 425, 443, 444, 226, 319, 433, 404, 439,
-	455, 196, 223, 333, 397, 430, 388, 312, 411, 412,
-	280, 387, 254, 182, 288, 449, 194, 377, 211, 201,
-	187, 399, 423, 208, 380, 0, 0, 457, 189, 421,
-	396, 308, 277, 278, 188, 0, 361, 231, 252, 221,
-	328, 418, 419, 220, 458, 198, 438, 191, 0, 437,
-	321, 414, 422, 309, 300, 190, 420, 307, 299, 283,
-	242, 263, 355, 293, 356, 264, 317, 316, 318, 0,
-	185, 0, 393, 431, 459, 204, 205, 206, 0, 241,
-	245, 251, 253, 259, 260, 267, 286, 332, 354, 352,
-	358, 0, 409, 426, 434, 441, 447, 448, 450, 451,
-	452, 453, 454, 320, 266, 389, 282, 291, 0, 0,
-	338, 370, 209, 429, 390, 591, 583, 574, 576, 592,
-	593, 571, 572, 575, 594, 460, 461, 462, 463, 464,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 0, 586, 561, 560, 0, 567, 568,
-	0, 577, 578, 559, 178, 192, 287, 0, 359, 249,
-	456, 436, 432, 0, 0, 225, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 311, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 180, 181,
-	193, 202, 212, 224, 239, 247, 257, 262, 265, 270,
-	271, 274, 279, 297, 302, 303, 304, 305, 322, 323,
-	324, 327, 330, 331, 334, 336, 337, 340, 347, 348,
-	349, 350, 351, 353, 360, 364, 372, 373, 374, 375,
-	376, 378, 379, 383, 384, 385, 386, 394, 398, 416,
-	417, 428, 440, 445, 258, 424, 446, 0, 296, 0,
-	0, 298, 243, 261, 272, 0, 435, 395, 197, 366,
-	250, 186, 215, 200, 222, 237, 240, 276, 306, 313,
-	342, 346, 255, 234, 213, 363, 210, 381, 401, 402,
-	403, 405, 310, 229, 345, 406, 0, 294, 407, 408,
-	268, 0, 0, 0, 0, 0, 329, 0, 0, 0,
-	0, 558, 0, 0, 0, 233, 563, 0, 0, 0,
-	285, 230, 0, 0, 343, 0, 184, 0, 382, 218,
-	295, 292, 413, 244, 236, 232, 217, 269, 301, 341,
-	400, 335, 570, 289, 0, 0, 391, 314, 0, 0,
-	0, 0, 0, 565, 566, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 216, 183, 326, 392, 248, 0,
-	79, 0, 1169, 175, 176, 177, 600, 607, 608, 609,
-	610, 601, 603, 0, 0, 207, 602, 214, 579, 605,
-	611, 612, 0, 228, 273, 235, 227, 410, 0, 0,
-	0, 0, 199, 0, 0, 0, 0, 0, 0, 0,
-	541, 555, 0, 569, 0, 0, 0, 238, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 552, 553, 0, 0, 0, 0, 585, 0,
-	554, 0, 0, 562, 613, 614, 615, 616, 617, 618,
-	619, 620, 621, 622, 623, 624, 625, 626, 627, 628,
-	629, 630, 631, 632, 633, 634, 635, 636, 637, 638,
-	639, 640, 641, 642, 643, 644, 645, 646, 647, 648,
-	649, 650, 651, 652, 653, 564, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 315, 0, 584,
-	0, 0, 442, 0, 0, 582, 0, 0, 0, 0,
-	284, 0, 281, 179, 195, 0, 0, 325, 365, 371,
-	0, 0, 0, 219, 0, 369, 339, 427, 203, 246,
-	362, 344, 367, 0, 0, 368, 290, 415, 357, 425,
-	443, 444, 226, 319, 433, 404, 439, 455, 196, 223,
-	333, 397, 430, 388, 312, 411, 412, 280, 387, 254,
-	182, 288, 449, 194, 377, 211, 201, 187, 399, 423,
-	208, 380, 0, 0, 457, 189, 421, 396, 308, 277,
-	278, 188, 0, 361, 231, 252, 221, 328, 418, 419,
-	220, 458, 198, 438, 191, 0, 437, 321, 414, 422,
-	309, 300, 190, 420, 307, 299, 283, 242, 263, 355,
-	293, 356, 264, 317, 316, 318, 0, 185, 0, 393,
-	431, 459, 204, 205, 206, 0, 241, 245, 251, 253,
-	259, 260, 267, 286, 332, 354, 352, 358, 0, 409,
-	426, 434, 441, 447, 448, 450, 451, 452, 453, 454,
-	320, 266, 389, 282, 291, 0, 0, 338, 370, 209,
-	429, 390, 591, 583, 574, 576, 592, 593, 571, 572,
-	575, 594, 460, 461, 462, 463, 464, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	0, 586, 561, 560, 0, 567, 568, 0, 577, 578,
-	559, 178, 192, 287, 0, 359, 249, 456, 436, 432,
-	0, 0, 225, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 311, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 180, 181, 193, 202, 212,
-	224, 239, 247, 257, 262, 265, 270, 271, 274, 279,
-	297, 302, 303, 304, 305, 322, 323, 324, 327, 330,
-	331, 334, 336, 337, 340, 347, 348, 349, 350, 351,
-	353, 360, 364, 372, 373, 374, 375, 376, 378, 379,
-	383, 384, 385, 386, 394, 398, 416, 417, 428, 440,
-	445, 258, 424, 446, 0, 296, 0, 0, 298, 243,
-	261, 272, 0, 435, 395, 197, 366, 250, 186, 215,
-	200, 222, 237, 240, 276, 306, 313, 342, 346, 255,
-	234, 213, 363, 210, 381, 401, 402, 403, 405, 310,
-	229, 345, 406, 0, 294, 407, 408, 268, 0, 0,
-	0, 0, 0, 329, 0, 0, 0, 0, 558, 0,
-	0, 0, 233, 563, 0, 0, 0, 285, 230, 0,
-	0, 343, 0, 184, 0, 382, 218, 295, 292, 413,
-	244, 236, 232, 217, 269, 301, 341, 400, 335, 570,
-	289, 0, 0, 391, 314, 0, 0, 0, 0, 0,
-	565, 566, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 216, 183, 326, 392, 248, 0, 79, 0, 0,
-	175, 176, 177, 600, 607, 608, 609, 610, 601, 603,
-	0, 0, 207, 602, 214, 579, 605, 611, 612, 0,
-	228, 273, 235, 227, 410, 0, 0, 0, 0, 199,
-	0, 0, 0, 0, 0, 0, 0, 541, 555, 0,
-	569, 0, 0, 0, 238, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 552,
-	553, 690, 0, 0, 0, 585, 0, 554, 0, 0,
-	562, 613, 614, 615, 616, 617, 618, 619, 620, 621,
-	622, 623, 624, 625, 626, 627, 628, 629, 630, 631,
-	632, 633, 634, 635, 636, 637, 638, 639, 640, 641,
-	642, 643, 644, 645, 646, 647, 648, 649, 650, 651,
-	652, 653, 564, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 256, 0, 315, 0, 584, 0, 0, 442,
-	0, 0, 582, 0, 0, 0, 0, 284, 0, 281,
-	179, 195, 0, 0, 325, 365, 371, 0, 0, 0,
-	219, 0, 369, 339, 427, 203, 246, 362, 344, 367,
-	0, 0, 368, 290, 415, 357, 425, 443, 444, 226,
-	319, 433, 404, 439, 455, 196, 223, 333, 397, 430,
-	388, 312, 411, 412, 280, 387, 254, 182, 288, 449,
-	194, 377, 211, 201, 187, 399, 423, 208, 380, 0,
-	0, 457, 189, 421, 396, 308, 277, 278, 188, 0,
-	361, 231, 252, 221, 328, 418, 419, 220, 458, 198,
-	438, 191, 0, 437, 321, 414, 422, 309, 300, 190,
-	420, 307, 299, 283, 242, 263, 355, 293, 356, 264,
-	317, 316, 318, 0, 185, 0, 393, 431, 459, 204,
-	205, 206, 0, 241, 245, 251, 253, 259, 260, 267,
-	286, 332, 354, 352, 358, 0, 409, 426, 434, 441,
-	447, 448, 450, 451, 452, 453, 454, 320, 266, 389,
-	282, 291, 0, 0, 338, 370, 209, 429, 390, 591,
-	583, 574, 576, 592, 593, 571, 572, 575, 594, 460,
-	461, 462, 463, 464, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 0, 586, 561,
-	560, 0, 567, 568, 0, 577, 578, 559, 178, 192,
-	287, 0, 359, 249, 456, 436, 432, 0, 0, 225,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 311, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 181, 193, 202, 212, 224, 239, 247,
-	257, 262, 265, 270, 271, 274, 279, 297, 302, 303,
-	304, 305, 322, 323, 324, 327, 330, 331, 334, 336,
-	337, 340, 347, 348, 349, 350, 351, 353, 360, 364,
-	372, 373, 374, 375, 376, 378, 379, 383, 384, 385,
-	386, 394, 398, 416, 417, 428, 440, 445, 258, 424,
-	446, 0, 296, 0, 0, 298, 243, 261, 272, 0,
-	435, 395, 197, 366, 250, 186, 215, 200, 222, 237,
-	240, 276, 306, 313, 342, 346, 255, 234, 213, 363,
-	210, 381, 401, 402, 403, 405, 310, 229, 345, 406,
-	0, 294, 407, 408, 268, 0, 0, 0, 0, 0,
-	329, 0, 0, 0, 0, 558, 0, 0, 0, 233,
-	563, 0, 0, 0, 285, 230, 0, 0, 343, 0,
-	184, 0, 382, 218, 295, 292, 413, 244, 236, 232,
-	217, 269, 301, 341, 400, 335, 570, 289, 0, 0,
-	391, 314, 0, 0, 0, 0, 0, 565, 566, 0,
-	0, 0, 0, 0, 0, 0, 0, 275, 216, 183,
-	326, 392, 248, 0, 79, 0, 0, 175, 176, 177,
-	600, 607, 608, 609, 610, 601, 603, 0, 0, 207,
-	602, 214, 579, 605, 611, 612, 0, 228, 273, 235,
-	227, 410, 0, 0, 0, 0, 199, 0, 0, 0,
-	0, 0, 0, 0, 541, 555, 0, 569, 0, 0,
-	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 552, 553, 0, 0,
-	0, 0, 585, 0, 554, 0, 0, 562, 613, 614,
-	615, 616, 617, 618, 619, 620, 621, 622, 623, 624,
-	625, 626, 627, 628, 629, 630, 631, 632, 633, 634,
-	635, 636, 637, 638, 639, 640, 641, 642, 643, 644,
-	645, 646, 647, 648, 649, 650, 651, 652, 653, 564,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	0, 315, 0, 584, 0, 0, 442, 0, 0, 582,
-	0, 0, 0, 0, 284, 0, 281, 179, 195, 0,
-	0, 325, 365, 371, 0, 0, 0, 219, 0, 369,
-	339, 427, 203, 246, 362, 344, 367, 0, 0, 368,
-	290, 415, 357, 425, 443, 444, 226, 319, 433, 404,
-	439, 455, 196, 223, 333, 397, 430, 388, 312, 411,
-	412, 280, 387, 254, 182, 288, 449, 194, 377, 211,
-	201, 187, 399, 423, 208, 380, 0, 0, 457, 189,
-	421, 396, 308, 277, 278, 188, 0, 361, 231, 252,
-	221, 328, 418, 419, 220, 458, 198, 438, 191, 0,
-	437, 321, 414, 422, 309, 300, 190, 420, 307, 299,
-	283, 242, 263, 355, 293, 356, 264, 317, 316, 318,
-	0, 185, 0, 393, 431, 459, 204, 205, 206, 0,
-	241, 245, 251, 253, 259, 260, 267, 286, 332, 354,
-	352, 358, 0, 409, 426, 434, 441, 447, 448, 450,
-	451, 452, 453, 454, 320, 266, 389, 282, 291, 0,
-	0, 338, 370, 209, 429, 390, 591, 583, 574, 576,
-	592, 593, 571, 572, 575, 594, 460, 461, 462, 463,
-	464, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 0, 586, 561, 560, 0, 567,
-	568, 0, 577, 578, 559, 178, 192, 287, 0, 359,
-	249, 456, 436, 432, 0, 0, 225, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 311, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 180,
-	181, 193, 202, 212, 224, 239, 247, 257, 262, 265,
-	270, 271, 274, 279, 297, 302, 303, 304, 305, 322,
-	323, 324, 327, 330, 331, 334, 336, 337, 340, 347,
-	348, 349, 350, 351, 353, 360, 364, 372, 373, 374,
-	375, 376, 378, 379, 383, 384, 385, 386, 394, 398,
-	416, 417, 428, 440, 445, 258, 424, 446, 0, 296,
-	0, 0, 298, 243, 261, 272, 0, 435, 395, 197,
-	366, 250, 186, 215, 200, 222, 237, 240, 276, 306,
-	313, 342, 346, 255, 234, 213, 363, 210, 381, 401,
-	402, 403, 405, 310, 229, 345, 406, 0, 294, 407,
-	408, 268, 0, 0, 0, 0, 0, 329, 0, 0,
-	0, 0, 558, 0, 0, 0, 233, 563, 0, 0,
-	0, 285, 230, 0, 0, 343, 0, 184, 0, 382,
-	218, 295, 292, 413, 244, 236, 232, 217, 269, 301,
-	341, 400, 335, 570, 289, 0, 0, 391, 314, 0,
-	0, 0, 0, 0, 565, 566, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 216, 183, 326, 392, 248,
-	0, 79, 0, 0, 175, 176, 177, 600, 607, 608,
-	609, 610, 601, 603, 0, 0, 207, 602, 214, 579,
-	605, 611, 612, 0, 228, 273, 235, 227, 410, 0,
-	0, 0, 0, 199, 0, 0, 0, 0, 0, 0,
-	0, 0, 555, 0, 569, 0, 0, 0, 238, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 552, 553, 0, 0, 0, 0, 585,
-	0, 554, 0, 0, 562, 613, 614, 615, 616, 617,
-	618, 619, 620, 621, 622, 623, 624, 625, 626, 627,
-	628, 629, 630, 631, 632, 633, 634, 635, 636, 637,
-	638, 639, 640, 641, 642, 643, 644, 645, 646, 647,
-	648, 649, 650, 651, 652, 653, 564, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 256, 0, 315, 0,
-	584, 0, 0, 442, 0, 0, 582, 0, 0, 0,
-	0, 284, 0, 281, 179, 195, 0, 0, 325, 365,
-	371, 0, 0, 0, 219, 0, 369, 339, 427, 203,
-	246, 362, 344, 367, 0, 0, 368, 290, 415, 357,
-	425, 443, 444, 226, 319, 433, 404, 439, 455, 196,
-	223, 333, 397, 430, 388, 312, 411, 412, 280, 387,
-	254, 182, 288, 449, 194, 377, 211, 201, 187, 399,
-	423, 208, 380, 0, 0, 457, 189, 421, 396, 308,
-	277, 278, 188, 0, 361, 231, 252, 221, 328, 418,
-	419, 220, 458, 198, 438, 191, 0, 437, 321, 414,
-	422, 309, 300, 190, 420, 307, 299, 283, 242, 263,
-	355, 293, 356, 264, 317, 316, 318, 0, 185, 0,
-	393, 431, 459, 204, 205, 206, 0, 241, 245, 251,
-	253, 259, 260, 267, 286, 332, 354, 352, 358, 0,
-	409, 426, 434, 441, 447, 448, 450, 451, 452, 453,
-	454, 320, 266, 389, 282, 291, 0, 0, 338, 370,
-	209, 429, 390, 591, 583, 574, 576, 592, 593, 571,
-	572, 575, 594, 460, 461, 462, 463, 464, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 0, 586, 561, 560, 0, 567, 568, 0, 577,
-	578, 559, 178, 192, 287, 0, 359, 249, 456, 436,
-	432, 0, 0, 225, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 311, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 180, 181, 193, 202,
-	212, 224, 239, 247, 257, 262, 265, 270, 271, 274,
-	279, 297, 302, 303, 304, 305, 322, 323, 324, 327,
-	330, 331, 334, 336, 337, 340, 347, 348, 349, 350,
-	351, 353, 360, 364, 372, 373, 374, 375, 376, 378,
-	379, 383, 384, 385, 386, 394, 398, 416, 417, 428,
-	440, 445, 258, 424, 446, 0, 296, 0, 0, 298,
-	243, 261, 272, 0, 435, 395, 197, 366, 250, 186,
-	215, 200, 222, 237, 240, 276, 306, 313, 342, 346,
-	255, 234, 213, 363, 210, 381, 401, 402, 403, 405,
-	310, 229, 345, 406, 0, 294, 407, 408, 268, 0,
-	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 0, 0, 0, 0, 285, 230,
-	0, 0, 343, 0, 184, 0, 382, 218, 295, 292,
-	413, 244, 236, 232, 217, 269, 301, 341, 400, 335,
-	0, 289, 0, 0, 391, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 216, 183, 326, 392, 248, 0, 0, 0,
+	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 1353, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
 	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
-	0, 228, 273, 235, 227, 410, 0, 0, 0, 0,
-	199, 0, 897, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 238, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -2696,214 +4041,53 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 315, 0, 0, 0, 896,
-	442, 0, 0, 0, 0, 0, 893, 894, 284, 859,
-	281, 179, 195, 887, 891, 325, 365, 371, 0, 0,
-	0, 219, 0, 369, 339, 427, 203, 246, 362, 344,
-	367, 0, 0, 368, 290, 415, 357, 425, 443, 444,
-	226, 319, 433, 404, 439, 455, 196, 223, 333, 397,
-	430, 388, 312, 411, 412, 280, 387, 254, 182, 288,
-	449, 194, 377, 211, 201, 187, 399, 423, 208, 380,
-	0, 0, 457, 189, 421, 396, 308, 277, 278, 188,
-	0, 361, 231, 252, 221, 328, 418, 419, 220, 458,
-	198, 438, 191, 0, 437, 321, 414, 422, 309, 300,
-	190, 420, 307, 299, 283, 242, 263, 355, 293, 356,
-	264, 317, 316, 318, 0, 185, 0, 393, 431, 459,
-	204, 205, 206, 0, 241, 245, 251, 253, 259, 260,
-	267, 286, 332, 354, 352, 358, 0, 409, 426, 434,
-	441, 447, 448, 450, 451, 452, 453, 454, 320, 266,
-	389, 282, 291, 0, 0, 338, 370, 209, 429, 390,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
-	192, 287, 0, 359, 249, 456, 436, 432, 0, 0,
-	225, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 311, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 180, 181, 193, 202, 212, 224, 239,
-	247, 257, 262, 265, 270, 271, 274, 279, 297, 302,
-	303, 304, 305, 322, 323, 324, 327, 330, 331, 334,
-	336, 337, 340, 347, 348, 349, 350, 351, 353, 360,
-	364, 372, 373, 374, 375, 376, 378, 379, 383, 384,
-	385, 386, 394, 398, 416, 417, 428, 440, 445, 258,
-	424, 446, 0, 296, 0, 0, 298, 243, 261, 272,
-	0, 435, 395, 197, 366, 250, 186, 215, 200, 222,
-	237, 240, 276, 306, 313, 342, 346, 255, 234, 213,
-	363, 210, 381, 401, 402, 403, 405, 310, 229, 345,
-	406, 0, 294, 407, 408, 268, 0, 0, 0, 0,
-	0, 329, 0, 0, 0, 1189, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 285, 230, 0, 0, 343,
-	0, 184, 0, 382, 218, 295, 292, 413, 244, 236,
-	232, 217, 269, 301, 341, 400, 335, 0, 289, 0,
-	0, 391, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 216,
-	183, 326, 392, 248, 0, 0, 0, 0, 175, 176,
-	177, 0, 1191, 0, 0, 0, 0, 0, 0, 0,
-	207, 0, 214, 0, 0, 0, 0, 0, 228, 273,
-	235, 227, 410, 0, 0, 0, 0, 199, 0, 0,
-	0, 1059, 0, 1060, 1061, 0, 0, 0, 0, 0,
-	0, 0, 238, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	256, 0, 315, 0, 0, 0, 0, 442, 0, 0,
-	0, 0, 0, 0, 0, 284, 0, 281, 179, 195,
-	0, 0, 325, 365, 371, 0, 0, 0, 219, 0,
-	369, 339, 427, 203, 246, 362, 344, 367, 0, 0,
-	368, 290, 415, 357, 425, 443, 444, 226, 319, 433,
-	404, 439, 455, 196, 223, 333, 397, 430, 388, 312,
-	411, 412, 280, 387, 254, 182, 288, 449, 194, 377,
-	211, 201, 187, 399, 423, 208, 380, 0, 0, 457,
-	189, 421, 396, 308, 277, 278, 188, 0, 361, 231,
-	252, 221, 328, 418, 419, 220, 458, 198, 438, 191,
-	0, 437, 321, 414, 422, 309, 300, 190, 420, 307,
-	299, 283, 242, 263, 355, 293, 356, 264, 317, 316,
-	318, 0, 185, 0, 393, 431, 459, 204, 205, 206,
-	0, 241, 245, 251, 253, 259, 260, 267, 286, 332,
-	354, 352, 358, 0, 409, 426, 434, 441, 447, 448,
-	450, 451, 452, 453, 454, 320, 266, 389, 282, 291,
-	0, 0, 338, 370, 209, 429, 390, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 460, 461, 462,
-	463, 464, 465, 466, 467, 468, 469, 470, 471, 472,
-	473, 474, 475, 476, 477, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 192, 287, 0,
-	359, 249, 456, 436, 432, 0, 0, 225, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 311,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	180, 181, 193, 202, 212, 224, 239, 247, 257, 262,
-	265, 270, 271, 274, 279, 297, 302, 303, 304, 305,
-	322, 323, 324, 327, 330, 331, 334, 336, 337, 340,
-	347, 348, 349, 350, 351, 353, 360, 364, 372, 373,
-	374, 375, 376, 378, 379, 383, 384, 385, 386, 394,
-	398, 416, 417, 428, 440, 445, 258, 424, 446, 0,
-	296, 0, 0, 298, 243, 261, 272, 0, 435, 395,
-	197, 366, 250, 186, 215, 200, 222, 237, 240, 276,
-	306, 313, 342, 346, 255, 234, 213, 363, 210, 381,
-	401, 402, 403, 405, 310, 229, 345, 406, 0, 294,
-	407, 408, 268, 0, 0, 0, 0, 0, 329, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 0, 0,
-	0, 0, 285, 230, 0, 0, 343, 0, 184, 0,
-	382, 218, 295, 292, 413, 244, 236, 232, 217, 269,
-	301, 341, 400, 335, 0, 289, 0, 0, 391, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 216, 183, 326, 392,
-	248, 0, 0, 0, 0, 175, 176, 177, 1134, 1137,
-	0, 0, 0, 1133, 1136, 0, 0, 207, 1132, 214,
-	0, 0, 0, 0, 0, 228, 273, 235, 227, 410,
-	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 238,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 256, 0, 315,
-	0, 0, 0, 0, 442, 0, 0, 0, 0, 0,
-	0, 0, 284, 0, 281, 179, 195, 0, 0, 325,
-	365, 371, 0, 0, 0, 219, 0, 369, 339, 427,
-	203, 246, 362, 344, 367, 0, 0, 368, 290, 415,
-	357, 425, 443, 444, 226, 319, 433, 404, 439, 455,
-	196, 223, 333, 397, 430, 388, 312, 411, 412, 280,
-	387, 254, 182, 288, 449, 194, 377, 211, 201, 187,
-	399, 423, 208, 380, 0, 0, 457, 189, 421, 396,
-	308, 277, 278, 188, 0, 361, 231, 252, 221, 328,
-	418, 419, 220, 458, 198, 438, 191, 0, 437, 321,
-	414, 422, 309, 300, 190, 420, 307, 299, 283, 242,
-	263, 355, 293, 356, 264, 317, 316, 318, 0, 185,
-	0, 393, 431, 459, 204, 205, 206, 0, 241, 245,
-	251, 253, 259, 260, 267, 286, 332, 354, 352, 358,
-	0, 409, 426, 434, 441, 447, 448, 450, 451, 452,
-	453, 454, 320, 266, 389, 282, 291, 0, 0, 338,
-	370, 209, 429, 390, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 460, 461, 462, 463, 464, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 192, 287, 0, 359, 249, 456,
-	436, 432, 0, 0, 225, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 311, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 180, 181, 193,
-	202, 212, 224, 239, 247, 257, 262, 265, 270, 271,
-	274, 279, 297, 302, 303, 304, 305, 322, 323, 324,
-	327, 330, 331, 334, 336, 337, 340, 347, 348, 349,
-	350, 351, 353, 360, 364, 372, 373, 374, 375, 376,
-	378, 379, 383, 384, 385, 386, 394, 398, 416, 417,
-	428, 440, 445, 258, 424, 446, 0, 296, 0, 0,
-	298, 243, 261, 272, 0, 435, 395, 197, 366, 250,
-	186, 215, 200, 222, 237, 240, 276, 306, 313, 342,
-	346, 255, 234, 213, 363, 210, 381, 401, 402, 403,
-	405, 310, 229, 345, 70, 406, 294, 407, 408, 268,
-	0, 0, 0, 0, 0, 0, 329, 0, 0, 0,
-	0, 0, 0, 0, 0, 233, 0, 0, 0, 0,
-	285, 230, 0, 0, 343, 0, 184, 0, 382, 218,
-	295, 292, 413, 244, 236, 232, 217, 269, 301, 341,
-	400, 335, 0, 289, 0, 0, 391, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 216, 183, 326, 392, 248, 0,
-	79, 0, 1169, 175, 176, 177, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 214, 0, 0,
-	0, 0, 0, 228, 273, 235, 227, 410, 0, 0,
-	0, 0, 199, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 238, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 315, 0, 0,
-	0, 0, 442, 0, 0, 0, 0, 0, 0, 0,
-	284, 0, 281, 179, 195, 0, 0, 325, 365, 371,
-	0, 0, 0, 219, 0, 369, 339, 427, 203, 246,
-	362, 344, 367, 0, 0, 368, 290, 415, 357, 425,
-	443, 444, 226, 319, 433, 404, 439, 455, 196, 223,
-	333, 397, 430, 388, 312, 411, 412, 280, 387, 254,
-	182, 288, 449, 194, 377, 211, 201, 187, 399, 423,
-	208, 380, 0, 0, 457, 189, 421, 396, 308, 277,
-	278, 188, 0, 361, 231, 252, 221, 328, 418, 419,
-	220, 458, 198, 438, 191, 0, 437, 321, 414, 422,
-	309, 300, 190, 420, 307, 299, 283, 242, 263, 355,
-	293, 356, 264, 317, 316, 318, 0, 185, 0, 393,
-	431, 459, 204, 205, 206, 0, 241, 245, 251, 253,
-	259, 260, 267, 286, 332, 354, 352, 358, 0, 409,
-	426, 434, 441, 447, 448, 450, 451, 452, 453, 454,
-	320, 266, 389, 282, 291, 0, 0, 338, 370, 209,
-	429, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 460, 461, 462, 463, 464, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 178, 192, 287, 78, 359, 249, 456, 436, 432,
-	0, 0, 225, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 311, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 180, 181, 193, 202, 212,
-	224, 239, 247, 257, 262, 265, 270, 271, 274, 279,
-	297, 302, 303, 304, 305, 322, 323, 324, 327, 330,
-	331, 334, 336, 337, 340, 347, 348, 349, 350, 351,
-	353, 360, 364, 372, 373, 374, 375, 376, 378, 379,
-	383, 384, 385, 386, 394, 398, 416, 417, 428, 440,
-	445, 258, 424, 446, 0, 296, 0, 0, 298, 243,
-	261, 272, 0, 435, 395, 197, 366, 250, 186, 215,
-	200, 222, 237, 240, 276, 306, 313, 342, 346, 255,
-	234, 213, 363, 210, 381, 401, 402, 403, 405, 310,
-	229, 345, 70, 406, 294, 407, 408, 268, 0, 0,
-	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 0, 0, 0, 0, 285, 230,
-	0, 0, 343, 0, 184, 0, 382, 218, 295, 292,
-	413, 244, 236, 232, 217, 269, 301, 341, 400, 335,
-	0, 289, 0, 0, 391, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 216, 183, 326, 392, 248, 0, 79, 0,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 1351, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
 	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
-	0, 228, 273, 235, 227, 410, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
 	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -2911,536 +4095,53 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 315, 0, 0, 0, 0,
-	442, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 179, 195, 0, 0, 325, 365, 371, 0, 0,
-	0, 219, 0, 369, 339, 427, 203, 246, 362, 344,
-	367, 0, 0, 368, 290, 415, 357, 425, 443, 444,
-	226, 319, 433, 404, 439, 455, 196, 223, 333, 397,
-	430, 388, 312, 411, 412, 280, 387, 254, 182, 288,
-	449, 194, 377, 211, 201, 187, 399, 423, 208, 380,
-	0, 0, 457, 189, 421, 396, 308, 277, 278, 188,
-	0, 361, 231, 252, 221, 328, 418, 419, 220, 458,
-	198, 438, 191, 0, 437, 321, 414, 422, 309, 300,
-	190, 420, 307, 299, 283, 242, 263, 355, 293, 356,
-	264, 317, 316, 318, 0, 185, 0, 393, 431, 459,
-	204, 205, 206, 0, 241, 245, 251, 253, 259, 260,
-	267, 286, 332, 354, 352, 358, 0, 409, 426, 434,
-	441, 447, 448, 450, 451, 452, 453, 454, 320, 266,
-	389, 282, 291, 0, 0, 338, 370, 209, 429, 390,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
-	192, 287, 78, 359, 249, 456, 436, 432, 0, 0,
-	225, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 311, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 180, 181, 193, 202, 212, 224, 239,
-	247, 257, 262, 265, 270, 271, 274, 279, 297, 302,
-	303, 304, 305, 322, 323, 324, 327, 330, 331, 334,
-	336, 337, 340, 347, 348, 349, 350, 351, 353, 360,
-	364, 372, 373, 374, 375, 376, 378, 379, 383, 384,
-	385, 386, 394, 398, 416, 417, 428, 440, 445, 258,
-	424, 446, 0, 296, 0, 0, 298, 243, 261, 272,
-	0, 435, 395, 197, 366, 250, 186, 215, 200, 222,
-	237, 240, 276, 306, 313, 342, 346, 255, 234, 213,
-	363, 210, 381, 401, 402, 403, 405, 310, 229, 345,
-	406, 0, 294, 407, 408, 268, 0, 0, 0, 0,
-	0, 329, 0, 0, 0, 1564, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 285, 230, 0, 0, 343,
-	0, 184, 0, 382, 218, 295, 292, 413, 244, 236,
-	232, 217, 269, 301, 341, 400, 335, 0, 289, 0,
-	0, 391, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 216,
-	183, 326, 392, 248, 0, 0, 0, 0, 175, 176,
-	177, 0, 1359, 0, 0, 0, 0, 0, 0, 0,
-	207, 0, 214, 0, 0, 0, 0, 0, 228, 273,
-	235, 227, 410, 0, 0, 0, 0, 199, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 238, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	256, 0, 315, 0, 0, 0, 0, 442, 0, 0,
-	0, 0, 0, 0, 0, 284, 0, 281, 179, 195,
-	0, 0, 325, 365, 371, 0, 0, 0, 219, 0,
-	369, 339, 427, 203, 246, 362, 344, 367, 0, 1562,
-	368, 290, 415, 357, 425, 443, 444, 226, 319, 433,
-	404, 439, 455, 196, 223, 333, 397, 430, 388, 312,
-	411, 412, 280, 387, 254, 182, 288, 449, 194, 377,
-	211, 201, 187, 399, 423, 208, 380, 0, 0, 457,
-	189, 421, 396, 308, 277, 278, 188, 0, 361, 231,
-	252, 221, 328, 418, 419, 220, 458, 198, 438, 191,
-	0, 437, 321, 414, 422, 309, 300, 190, 420, 307,
-	299, 283, 242, 263, 355, 293, 356, 264, 317, 316,
-	318, 0, 185, 0, 393, 431, 459, 204, 205, 206,
-	0, 241, 245, 251, 253, 259, 260, 267, 286, 332,
-	354, 352, 358, 0, 409, 426, 434, 441, 447, 448,
-	450, 451, 452, 453, 454, 320, 266, 389, 282, 291,
-	0, 0, 338, 370, 209, 429, 390, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 460, 461, 462,
-	463, 464, 465, 466, 467, 468, 469, 470, 471, 472,
-	473, 474, 475, 476, 477, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 192, 287, 0,
-	359, 249, 456, 436, 432, 0, 0, 225, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 311,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	180, 181, 193, 202, 212, 224, 239, 247, 257, 262,
-	265, 270, 271, 274, 279, 297, 302, 303, 304, 305,
-	322, 323, 324, 327, 330, 331, 334, 336, 337, 340,
-	347, 348, 349, 350, 351, 353, 360, 364, 372, 373,
-	374, 375, 376, 378, 379, 383, 384, 385, 386, 394,
-	398, 416, 417, 428, 440, 445, 258, 424, 446, 0,
-	296, 0, 0, 298, 243, 261, 272, 0, 435, 395,
-	197, 366, 250, 186, 215, 200, 222, 237, 240, 276,
-	306, 313, 342, 346, 255, 234, 213, 363, 210, 381,
-	401, 402, 403, 405, 310, 229, 345, 406, 0, 294,
-	407, 408, 268, 0, 0, 0, 0, 0, 329, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 0, 0,
-	0, 0, 285, 230, 0, 0, 343, 0, 184, 0,
-	382, 218, 295, 292, 413, 244, 236, 232, 217, 269,
-	301, 341, 400, 335, 0, 289, 0, 0, 391, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 216, 183, 326, 392,
-	248, 0, 0, 0, 0, 175, 176, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
-	0, 0, 0, 0, 0, 228, 273, 235, 227, 410,
-	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 238,
-	0, 0, 0, 0, 0, 0, 0, 0, 853, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 256, 0, 315,
-	0, 0, 0, 0, 442, 0, 0, 0, 0, 0,
-	0, 0, 284, 859, 281, 179, 195, 857, 0, 325,
-	365, 371, 0, 0, 0, 219, 0, 369, 339, 427,
-	203, 246, 362, 344, 367, 0, 0, 368, 290, 415,
-	357, 425, 443, 444, 226, 319, 433, 404, 439, 455,
-	196, 223, 333, 397, 430, 388, 312, 411, 412, 280,
-	387, 254, 182, 288, 449, 194, 377, 211, 201, 187,
-	399, 423, 208, 380, 0, 0, 457, 189, 421, 396,
-	308, 277, 278, 188, 0, 361, 231, 252, 221, 328,
-	418, 419, 220, 458, 198, 438, 191, 0, 437, 321,
-	414, 422, 309, 300, 190, 420, 307, 299, 283, 242,
-	263, 355, 293, 356, 264, 317, 316, 318, 0, 185,
-	0, 393, 431, 459, 204, 205, 206, 0, 241, 245,
-	251, 253, 259, 260, 267, 286, 332, 354, 352, 358,
-	0, 409, 426, 434, 441, 447, 448, 450, 451, 452,
-	453, 454, 320, 266, 389, 282, 291, 0, 0, 338,
-	370, 209, 429, 390, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 460, 461, 462, 463, 464, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 192, 287, 0, 359, 249, 456,
-	436, 432, 0, 0, 225, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 311, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 180, 181, 193,
-	202, 212, 224, 239, 247, 257, 262, 265, 270, 271,
-	274, 279, 297, 302, 303, 304, 305, 322, 323, 324,
-	327, 330, 331, 334, 336, 337, 340, 347, 348, 349,
-	350, 351, 353, 360, 364, 372, 373, 374, 375, 376,
-	378, 379, 383, 384, 385, 386, 394, 398, 416, 417,
-	428, 440, 445, 258, 424, 446, 0, 296, 0, 0,
-	298, 243, 261, 272, 0, 435, 395, 197, 366, 250,
-	186, 215, 200, 222, 237, 240, 276, 306, 313, 342,
-	346, 255, 234, 213, 363, 210, 381, 401, 402, 403,
-	405, 310, 229, 345, 406, 0, 294, 407, 408, 268,
-	0, 0, 0, 0, 0, 329, 0, 0, 0, 1564,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 285,
-	230, 0, 0, 343, 0, 184, 0, 382, 218, 295,
-	292, 413, 244, 236, 232, 217, 269, 301, 341, 400,
-	335, 0, 289, 0, 0, 391, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 216, 183, 326, 392, 248, 0, 0,
-	0, 0, 175, 176, 177, 0, 1359, 0, 0, 0,
-	0, 0, 0, 0, 207, 0, 214, 0, 0, 0,
-	0, 0, 228, 273, 235, 227, 410, 0, 0, 0,
-	0, 199, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 238, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 256, 0, 315, 0, 0, 0,
-	0, 442, 0, 0, 0, 0, 0, 0, 0, 284,
-	0, 281, 179, 195, 0, 0, 325, 365, 371, 0,
-	0, 0, 219, 0, 369, 339, 427, 203, 246, 362,
-	344, 367, 0, 0, 368, 290, 415, 357, 425, 443,
-	444, 226, 319, 433, 404, 439, 455, 196, 223, 333,
-	397, 430, 388, 312, 411, 412, 280, 387, 254, 182,
-	288, 449, 194, 377, 211, 201, 187, 399, 423, 208,
-	380, 0, 0, 457, 189, 421, 396, 308, 277, 278,
-	188, 0, 361, 231, 252, 221, 328, 418, 419, 220,
-	458, 198, 438, 191, 0, 437, 321, 414, 422, 309,
-	300, 190, 420, 307, 299, 283, 242, 263, 355, 293,
-	356, 264, 317, 316, 318, 0, 185, 0, 393, 431,
-	459, 204, 205, 206, 0, 241, 245, 251, 253, 259,
-	260, 267, 286, 332, 354, 352, 358, 0, 409, 426,
-	434, 441, 447, 448, 450, 451, 452, 453, 454, 320,
-	266, 389, 282, 291, 0, 0, 338, 370, 209, 429,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 460, 461, 462, 463, 464, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 475, 476, 477, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	178, 192, 287, 0, 359, 249, 456, 436, 432, 0,
-	0, 225, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 311, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 180, 181, 193, 202, 212, 224,
-	239, 247, 257, 262, 265, 270, 271, 274, 279, 297,
-	302, 303, 304, 305, 322, 323, 324, 327, 330, 331,
-	334, 336, 337, 340, 347, 348, 349, 350, 351, 353,
-	360, 364, 372, 373, 374, 375, 376, 378, 379, 383,
-	384, 385, 386, 394, 398, 416, 417, 428, 440, 445,
-	258, 424, 446, 0, 296, 0, 0, 298, 243, 261,
-	272, 0, 435, 395, 197, 366, 250, 186, 215, 200,
-	222, 237, 240, 276, 306, 313, 342, 346, 255, 234,
-	213, 363, 210, 381, 401, 402, 403, 405, 310, 229,
-	345, 406, 0, 294, 407, 408, 268, 0, 0, 0,
-	0, 0, 329, 0, 0, 0, 0, 0, 0, 0,
-	0, 233, 0, 0, 0, 0, 285, 230, 0, 0,
-	343, 0, 184, 0, 382, 218, 295, 292, 413, 244,
-	236, 232, 217, 269, 301, 341, 400, 335, 0, 289,
-	0, 0, 391, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 275,
-	216, 183, 326, 392, 248, 0, 0, 0, 1169, 175,
-	176, 177, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 207, 0, 214, 0, 0, 0, 0, 0, 228,
-	273, 235, 227, 410, 0, 0, 0, 0, 199, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 238, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 315, 0, 0, 0, 0, 442, 0,
-	0, 0, 2274, 0, 0, 0, 284, 0, 281, 179,
-	195, 0, 0, 325, 365, 371, 0, 0, 0, 219,
-	0, 369, 339, 427, 203, 246, 362, 344, 367, 0,
-	0, 368, 290, 415, 357, 425, 443, 444, 226, 319,
-	433, 404, 439, 455, 196, 223, 333, 397, 430, 388,
-	312, 411, 412, 280, 387, 254, 182, 288, 449, 194,
-	377, 211, 201, 187, 399, 423, 208, 380, 0, 0,
-	457, 189, 421, 396, 308, 277, 278, 188, 0, 361,
-	231, 252, 221, 328, 418, 419, 220, 458, 198, 438,
-	191, 0, 437, 321, 414, 422, 309, 300, 190, 420,
-	307, 299, 283, 242, 263, 355, 293, 356, 264, 317,
-	316, 318, 0, 185, 0, 393, 431, 459, 204, 205,
-	206, 0, 241, 245, 251, 253, 259, 260, 267, 286,
-	332, 354, 352, 358, 0, 409, 426, 434, 441, 447,
-	448, 450, 451, 452, 453, 454, 320, 266, 389, 282,
-	291, 0, 0, 338, 370, 209, 429, 390, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 460, 461,
-	462, 463, 464, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 474, 475, 476, 477, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 178, 192, 287,
-	0, 359, 249, 456, 436, 432, 0, 0, 225, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	311, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 180, 181, 193, 202, 212, 224, 239, 247, 257,
-	262, 265, 270, 271, 274, 279, 297, 302, 303, 304,
-	305, 322, 323, 324, 327, 330, 331, 334, 336, 337,
-	340, 347, 348, 349, 350, 351, 353, 360, 364, 372,
-	373, 374, 375, 376, 378, 379, 383, 384, 385, 386,
-	394, 398, 416, 417, 428, 440, 445, 258, 424, 446,
-	0, 296, 0, 0, 298, 243, 261, 272, 0, 435,
-	395, 197, 366, 250, 186, 215, 200, 222, 237, 240,
-	276, 306, 313, 342, 346, 255, 234, 213, 363, 210,
-	381, 401, 402, 403, 405, 310, 229, 345, 406, 0,
-	294, 407, 408, 268, 0, 0, 0, 0, 0, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 285, 230, 0, 0, 343, 0, 184,
-	0, 382, 218, 295, 292, 413, 244, 236, 232, 217,
-	269, 301, 341, 400, 335, 0, 289, 0, 0, 391,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 216, 183, 326,
-	392, 248, 0, 0, 0, 0, 175, 176, 177, 0,
-	0, 0, 1811, 0, 0, 1812, 0, 0, 207, 0,
-	214, 0, 0, 0, 0, 0, 228, 273, 235, 227,
-	410, 0, 0, 0, 0, 199, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 256, 0,
-	315, 0, 0, 0, 0, 442, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 179, 195, 0, 0,
-	325, 365, 371, 0, 0, 0, 219, 0, 369, 339,
-	427, 203, 246, 362, 344, 367, 0, 0, 368, 290,
-	415, 357, 425, 443, 444, 226, 319, 433, 404, 439,
-	455, 196, 223, 333, 397, 430, 388, 312, 411, 412,
-	280, 387, 254, 182, 288, 449, 194, 377, 211, 201,
-	187, 399, 423, 208, 380, 0, 0, 457, 189, 421,
-	396, 308, 277, 278, 188, 0, 361, 231, 252, 221,
-	328, 418, 419, 220, 458, 198, 438, 191, 0, 437,
-	321, 414, 422, 309, 300, 190, 420, 307, 299, 283,
-	242, 263, 355, 293, 356, 264, 317, 316, 318, 0,
-	185, 0, 393, 431, 459, 204, 205, 206, 0, 241,
-	245, 251, 253, 259, 260, 267, 286, 332, 354, 352,
-	358, 0, 409, 426, 434, 441, 447, 448, 450, 451,
-	452, 453, 454, 320, 266, 389, 282, 291, 0, 0,
-	338, 370, 209, 429, 390, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 460, 461, 462, 463, 464,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 192, 287, 0, 359, 249,
-	456, 436, 432, 0, 0, 225, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 311, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 180, 181,
-	193, 202, 212, 224, 239, 247, 257, 262, 265, 270,
-	271, 274, 279, 297, 302, 303, 304, 305, 322, 323,
-	324, 327, 330, 331, 334, 336, 337, 340, 347, 348,
-	349, 350, 351, 353, 360, 364, 372, 373, 374, 375,
-	376, 378, 379, 383, 384, 385, 386, 394, 398, 416,
-	417, 428, 440, 445, 258, 424, 446, 0, 296, 0,
-	0, 298, 243, 261, 272, 0, 435, 395, 197, 366,
-	250, 186, 215, 200, 222, 237, 240, 276, 306, 313,
-	342, 346, 255, 234, 213, 363, 210, 381, 401, 402,
-	403, 405, 310, 229, 345, 406, 0, 294, 407, 408,
-	268, 0, 0, 0, 0, 0, 329, 0, 0, 0,
-	0, 0, 0, 0, 0, 233, 1211, 0, 0, 0,
-	285, 230, 0, 0, 343, 0, 184, 0, 382, 218,
-	295, 292, 413, 244, 236, 232, 217, 269, 301, 341,
-	400, 335, 0, 289, 0, 0, 391, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 216, 183, 326, 392, 248, 0,
-	0, 0, 0, 175, 176, 177, 0, 1210, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 214, 0, 0,
-	0, 0, 0, 228, 273, 235, 227, 410, 0, 0,
-	0, 0, 199, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 238, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 315, 0, 0,
-	0, 0, 442, 0, 0, 0, 0, 0, 0, 0,
-	284, 0, 281, 179, 195, 0, 0, 325, 365, 371,
-	0, 0, 0, 219, 0, 369, 339, 427, 203, 246,
-	362, 344, 367, 0, 0, 368, 290, 415, 357, 425,
-	443, 444, 226, 319, 433, 404, 439, 455, 196, 223,
-	333, 397, 430, 388, 312, 411, 412, 280, 387, 254,
-	182, 288, 449, 194, 377, 211, 201, 187, 399, 423,
-	208, 380, 0, 0, 457, 189, 421, 396, 308, 277,
-	278, 188, 0, 361, 231, 252, 221, 328, 418, 419,
-	220, 458, 198, 438, 191, 0, 437, 321, 414, 422,
-	309, 300, 190, 420, 307, 299, 283, 242, 263, 355,
-	293, 356, 264, 317, 316, 318, 0, 185, 0, 393,
-	431, 459, 204, 205, 206, 0, 241, 245, 251, 253,
-	259, 260, 267, 286, 332, 354, 352, 358, 0, 409,
-	426, 434, 441, 447, 448, 450, 451, 452, 453, 454,
-	320, 266, 389, 282, 291, 0, 0, 338, 370, 209,
-	429, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 460, 461, 462, 463, 464, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 178, 192, 287, 0, 359, 249, 456, 436, 432,
-	0, 0, 225, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 311, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 180, 181, 193, 202, 212,
-	224, 239, 247, 257, 262, 265, 270, 271, 274, 279,
-	297, 302, 303, 304, 305, 322, 323, 324, 327, 330,
-	331, 334, 336, 337, 340, 347, 348, 349, 350, 351,
-	353, 360, 364, 372, 373, 374, 375, 376, 378, 379,
-	383, 384, 385, 386, 394, 398, 416, 417, 428, 440,
-	445, 258, 424, 446, 0, 296, 0, 0, 298, 243,
-	261, 272, 0, 435, 395, 197, 366, 250, 186, 215,
-	200, 222, 237, 240, 276, 306, 313, 342, 346, 255,
-	234, 213, 363, 210, 381, 401, 402, 403, 405, 310,
-	229, 345, 406, 0, 294, 407, 408, 268, 0, 0,
-	0, 0, 0, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 285, 230, 0,
-	0, 343, 0, 184, 0, 382, 218, 295, 292, 413,
-	244, 236, 232, 217, 269, 301, 341, 400, 335, 0,
-	289, 0, 0, 391, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 216, 183, 326, 392, 248, 0, 0, 0, 0,
-	175, 176, 177, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 207, 0, 214, 0, 0, 0, 0, 0,
-	228, 273, 235, 227, 410, 0, 0, 0, 0, 199,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 238, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 256, 0, 315, 0, 0, 0, 0, 442,
-	0, 0, 0, 2381, 0, 0, 0, 284, 0, 281,
-	179, 195, 0, 0, 325, 365, 371, 0, 0, 0,
-	219, 0, 369, 339, 427, 203, 246, 362, 344, 367,
-	0, 0, 368, 290, 415, 357, 425, 443, 444, 226,
-	319, 433, 404, 439, 455, 196, 223, 333, 397, 430,
-	388, 312, 411, 412, 280, 387, 254, 182, 288, 449,
-	194, 377, 211, 201, 187, 399, 423, 208, 380, 0,
-	0, 457, 189, 421, 396, 308, 277, 278, 188, 0,
-	361, 231, 252, 221, 328, 418, 419, 220, 458, 198,
-	438, 191, 0, 437, 321, 414, 422, 309, 300, 190,
-	420, 307, 299, 283, 242, 263, 355, 293, 356, 264,
-	317, 316, 318, 0, 185, 0, 393, 431, 459, 204,
-	205, 206, 0, 241, 245, 251, 253, 259, 260, 267,
-	286, 332, 354, 352, 358, 0, 409, 426, 434, 441,
-	447, 448, 450, 451, 452, 453, 454, 320, 266, 389,
-	282, 291, 0, 0, 338, 370, 209, 429, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 460,
-	461, 462, 463, 464, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 178, 192,
-	287, 0, 359, 249, 456, 436, 432, 0, 0, 225,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 311, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 181, 193, 202, 212, 224, 239, 247,
-	257, 262, 265, 270, 271, 274, 279, 297, 302, 303,
-	304, 305, 322, 323, 324, 327, 330, 331, 334, 336,
-	337, 340, 347, 348, 349, 350, 351, 353, 360, 364,
-	372, 373, 374, 375, 376, 378, 379, 383, 384, 385,
-	386, 394, 398, 416, 417, 428, 440, 445, 258, 424,
-	446, 0, 296, 0, 0, 298, 243, 261, 272, 0,
-	435, 395, 197, 366, 250, 186, 215, 200, 222, 237,
-	240, 276, 306, 313, 342, 346, 255, 234, 213, 363,
-	210, 381, 401, 402, 403, 405, 310, 229, 345, 406,
-	0, 294, 407, 408, 268, 0, 0, 0, 0, 0,
-	329, 0, 0, 0, 0, 0, 0, 0, 0, 233,
-	0, 0, 0, 0, 285, 230, 0, 0, 343, 0,
-	184, 0, 382, 218, 295, 292, 413, 244, 236, 232,
-	217, 269, 301, 341, 400, 335, 0, 289, 0, 0,
-	391, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 275, 216, 183,
-	326, 392, 248, 0, 0, 0, 0, 175, 176, 177,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 207,
-	0, 214, 0, 0, 0, 0, 0, 228, 273, 235,
-	227, 410, 0, 0, 0, 0, 199, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	0, 315, 0, 0, 0, 0, 442, 0, 0, 0,
-	2274, 0, 0, 0, 284, 0, 281, 179, 195, 0,
-	0, 325, 365, 371, 0, 0, 0, 219, 0, 369,
-	339, 427, 203, 246, 362, 344, 367, 0, 0, 368,
-	290, 415, 357, 425, 443, 444, 226, 319, 433, 404,
-	439, 455, 196, 223, 333, 397, 430, 388, 312, 411,
-	412, 280, 387, 254, 182, 288, 449, 194, 377, 211,
-	201, 187, 399, 423, 208, 380, 0, 0, 457, 189,
-	421, 396, 308, 277, 278, 188, 0, 361, 231, 252,
-	221, 328, 418, 419, 220, 458, 198, 438, 191, 0,
-	437, 321, 414, 422, 309, 300, 190, 420, 307, 299,
-	283, 242, 263, 355, 293, 356, 264, 317, 316, 318,
-	0, 185, 0, 393, 431, 459, 204, 205, 206, 0,
-	241, 245, 251, 253, 259, 260, 267, 286, 332, 354,
-	352, 358, 0, 409, 426, 434, 441, 447, 448, 450,
-	451, 452, 453, 454, 320, 266, 389, 282, 291, 0,
-	0, 338, 370, 209, 429, 390, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 460, 461, 462, 463,
-	464, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 178, 192, 287, 0, 359,
-	249, 456, 436, 432, 0, 0, 225, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 311, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 180,
-	181, 193, 202, 212, 224, 239, 247, 257, 262, 265,
-	270, 271, 274, 279, 297, 302, 303, 304, 305, 322,
-	323, 324, 327, 330, 331, 334, 336, 337, 340, 347,
-	348, 349, 350, 351, 353, 360, 364, 372, 373, 374,
-	375, 376, 378, 379, 383, 384, 385, 386, 394, 398,
-	416, 417, 428, 440, 445, 258, 424, 446, 0, 296,
-	0, 0, 298, 243, 261, 272, 0, 435, 395, 197,
-	366, 250, 186, 215, 200, 222, 237, 240, 276, 306,
-	313, 342, 346, 255, 234, 213, 363, 210, 381, 401,
-	402, 403, 405, 310, 229, 345, 406, 0, 294, 407,
-	408, 268, 0, 0, 0, 0, 0, 329, 0, 0,
-	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 285, 230, 0, 0, 343, 0, 184, 0, 382,
-	218, 295, 292, 413, 244, 236, 232, 217, 269, 301,
-	341, 400, 335, 0, 289, 0, 0, 391, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 216, 183, 326, 392, 248,
-	0, 79, 0, 0, 175, 176, 177, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 207, 0, 214, 0,
-	0, 0, 0, 0, 228, 273, 235, 227, 410, 0,
-	0, 0, 0, 199, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 238, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 256, 0, 315, 0,
-	0, 0, 0, 442, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 179, 195, 0, 0, 325, 365,
-	371, 0, 0, 0, 219, 0, 369, 339, 427, 203,
-	246, 362, 344, 367, 0, 0, 368, 290, 415, 357,
-	425, 443, 444, 226, 319, 433, 404, 439, 455, 196,
-	223, 333, 397, 430, 388, 312, 411, 412, 280, 387,
-	254, 182, 288, 449, 194, 377, 211, 201, 187, 399,
-	423, 208, 380, 0, 0, 457, 189, 421, 396, 308,
-	277, 278, 188, 0, 361, 231, 252, 221, 328, 418,
-	419, 220, 458, 198, 438, 191, 0, 437, 321, 414,
-	422, 309, 300, 190, 420, 307, 299, 283, 242, 263,
-	355, 293, 356, 264, 317, 316, 318, 0, 185, 0,
-	393, 431, 459, 204, 205, 206, 0, 241, 245, 251,
-	253, 259, 260, 267, 286, 332, 354, 352, 358, 0,
-	409, 426, 434, 441, 447, 448, 450, 451, 452, 453,
-	454, 320, 266, 389, 282, 291, 0, 0, 338, 370,
-	209, 429, 390, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 460, 461, 462, 463, 464, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 192, 287, 0, 359, 249, 456, 436,
-	432, 0, 0, 225, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 311, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 180, 181, 193, 202,
-	212, 224, 239, 247, 257, 262, 265, 270, 271, 274,
-	279, 297, 302, 303, 304, 305, 322, 323, 324, 327,
-	330, 331, 334, 336, 337, 340, 347, 348, 349, 350,
-	351, 353, 360, 364, 372, 373, 374, 375, 376, 378,
-	379, 383, 384, 385, 386, 394, 398, 416, 417, 428,
-	440, 445, 258, 424, 446, 0, 296, 0, 0, 298,
-	243, 261, 272, 0, 435, 395, 197, 366, 250, 186,
-	215, 200, 222, 237, 240, 276, 306, 313, 342, 346,
-	255, 234, 213, 363, 210, 381, 401, 402, 403, 405,
-	310, 229, 345, 406, 0, 294, 407, 408, 268, 0,
-	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 0, 0, 0, 0, 285, 230,
-	0, 0, 343, 0, 184, 0, 382, 218, 295, 292,
-	413, 244, 236, 232, 217, 269, 301, 341, 400, 335,
-	0, 289, 0, 0, 391, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 216, 183, 326, 392, 248, 0, 0, 0,
-	0, 175, 176, 177, 0, 1359, 0, 0, 0, 0,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 1349, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
+	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
-	0, 228, 273, 235, 227, 410, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
 	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3448,107 +4149,53 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 315, 0, 0, 0, 0,
-	442, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 179, 195, 0, 0, 325, 365, 371, 0, 0,
-	0, 219, 0, 369, 339, 427, 203, 246, 362, 344,
-	367, 0, 0, 368, 290, 415, 357, 425, 443, 444,
-	226, 319, 433, 404, 439, 455, 196, 223, 333, 397,
-	430, 388, 312, 411, 412, 280, 387, 254, 182, 288,
-	449, 194, 377, 211, 201, 187, 399, 423, 208, 380,
-	0, 0, 457, 189, 421, 396, 308, 277, 278, 188,
-	0, 361, 231, 252, 221, 328, 418, 419, 220, 458,
-	198, 438, 191, 0, 437, 321, 414, 422, 309, 300,
-	190, 420, 307, 299, 283, 242, 263, 355, 293, 356,
-	264, 317, 316, 318, 0, 185, 0, 393, 431, 459,
-	204, 205, 206, 0, 241, 245, 251, 253, 259, 260,
-	267, 286, 332, 354, 352, 358, 0, 409, 426, 434,
-	441, 447, 448, 450, 451, 452, 453, 454, 320, 266,
-	389, 282, 291, 0, 0, 338, 370, 209, 429, 390,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
-	192, 287, 0, 359, 249, 456, 436, 432, 0, 0,
-	225, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 311, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 180, 181, 193, 202, 212, 224, 239,
-	247, 257, 262, 265, 270, 271, 274, 279, 297, 302,
-	303, 304, 305, 322, 323, 324, 327, 330, 331, 334,
-	336, 337, 340, 347, 348, 349, 350, 351, 353, 360,
-	364, 372, 373, 374, 375, 376, 378, 379, 383, 384,
-	385, 386, 394, 398, 416, 417, 428, 440, 445, 258,
-	424, 446, 0, 296, 0, 0, 298, 243, 261, 272,
-	0, 435, 395, 197, 366, 250, 186, 215, 200, 222,
-	237, 240, 276, 306, 313, 342, 346, 255, 234, 213,
-	363, 210, 381, 401, 402, 403, 405, 310, 229, 345,
-	0, 0, 294, 407, 408, 268, 406, 0, 0, 0,
-	1604, 0, 0, 0, 0, 0, 0, 329, 0, 0,
-	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 285, 230, 0, 0, 343, 0, 184, 0, 382,
-	218, 295, 292, 413, 244, 236, 232, 217, 269, 301,
-	341, 400, 335, 0, 289, 0, 0, 391, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 216, 183, 326, 392, 248,
-	0, 0, 0, 0, 175, 176, 177, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 207, 0, 214, 0,
-	0, 0, 0, 0, 228, 273, 235, 227, 410, 0,
-	0, 0, 0, 199, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 238, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 256, 0, 315, 0,
-	0, 0, 0, 442, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 179, 195, 0, 0, 325, 365,
-	371, 0, 0, 0, 219, 0, 369, 339, 427, 203,
-	246, 362, 344, 367, 0, 0, 368, 290, 415, 357,
-	425, 443, 444, 226, 319, 433, 404, 439, 455, 196,
-	223, 333, 397, 430, 388, 312, 411, 412, 280, 387,
-	254, 182, 288, 449, 194, 377, 211, 201, 187, 399,
-	423, 208, 380, 0, 0, 457, 189, 421, 396, 308,
-	277, 278, 188, 0, 361, 231, 252, 221, 328, 418,
-	419, 220, 458, 198, 438, 191, 0, 437, 321, 414,
-	422, 309, 300, 190, 420, 307, 299, 283, 242, 263,
-	355, 293, 356, 264, 317, 316, 318, 0, 185, 0,
-	393, 431, 459, 204, 205, 206, 0, 241, 245, 251,
-	253, 259, 260, 267, 286, 332, 354, 352, 358, 0,
-	409, 426, 434, 441, 447, 448, 450, 451, 452, 453,
-	454, 320, 266, 389, 282, 291, 0, 0, 338, 370,
-	209, 429, 390, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 460, 461, 462, 463, 464, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 192, 287, 0, 359, 249, 456, 436,
-	432, 0, 0, 225, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 311, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 180, 181, 193, 202,
-	212, 224, 239, 247, 257, 262, 265, 270, 271, 274,
-	279, 297, 302, 303, 304, 305, 322, 323, 324, 327,
-	330, 331, 334, 336, 337, 340, 347, 348, 349, 350,
-	351, 353, 360, 364, 372, 373, 374, 375, 376, 378,
-	379, 383, 384, 385, 386, 394, 398, 416, 417, 428,
-	440, 445, 258, 424, 446, 0, 296, 0, 0, 298,
-	243, 261, 272, 0, 435, 395, 197, 366, 250, 186,
-	215, 200, 222, 237, 240, 276, 306, 313, 342, 346,
-	255, 234, 213, 363, 210, 381, 401, 402, 403, 405,
-	310, 229, 345, 406, 0, 294, 407, 408, 268, 0,
-	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 0, 0, 0, 0, 285, 230,
-	0, 0, 343, 0, 184, 0, 382, 218, 295, 292,
-	413, 244, 236, 232, 217, 269, 301, 341, 400, 335,
-	0, 289, 0, 0, 391, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 216, 183, 326, 392, 248, 0, 0, 0,
-	0, 175, 176, 177, 0, 1191, 0, 0, 0, 0,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 1345, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
+	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
-	0, 228, 273, 235, 227, 410, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
 	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3556,536 +4203,53 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 315, 0, 0, 0, 0,
-	442, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 179, 195, 0, 0, 325, 365, 371, 0, 0,
-	0, 219, 0, 369, 339, 427, 203, 246, 362, 344,
-	367, 0, 0, 368, 290, 415, 357, 425, 443, 444,
-	226, 319, 433, 404, 439, 455, 196, 223, 333, 397,
-	430, 388, 312, 411, 412, 280, 387, 254, 182, 288,
-	449, 194, 377, 211, 201, 187, 399, 423, 208, 380,
-	0, 0, 457, 189, 421, 396, 308, 277, 278, 188,
-	0, 361, 231, 252, 221, 328, 418, 419, 220, 458,
-	198, 438, 191, 0, 437, 321, 414, 422, 309, 300,
-	190, 420, 307, 299, 283, 242, 263, 355, 293, 356,
-	264, 317, 316, 318, 0, 185, 0, 393, 431, 459,
-	204, 205, 206, 0, 241, 245, 251, 253, 259, 260,
-	267, 286, 332, 354, 352, 358, 0, 409, 426, 434,
-	441, 447, 448, 450, 451, 452, 453, 454, 320, 266,
-	389, 282, 291, 0, 0, 338, 370, 209, 429, 390,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
-	192, 287, 0, 359, 249, 456, 436, 432, 0, 0,
-	225, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 311, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 180, 181, 193, 202, 212, 224, 239,
-	247, 257, 262, 265, 270, 271, 274, 279, 297, 302,
-	303, 304, 305, 322, 323, 324, 327, 330, 331, 334,
-	336, 337, 340, 347, 348, 349, 350, 351, 353, 360,
-	364, 372, 373, 374, 375, 376, 378, 379, 383, 384,
-	385, 386, 394, 398, 416, 417, 428, 440, 445, 258,
-	424, 446, 0, 296, 0, 0, 298, 243, 261, 272,
-	0, 435, 395, 197, 366, 250, 186, 215, 200, 222,
-	237, 240, 276, 306, 313, 342, 346, 255, 234, 213,
-	363, 210, 381, 401, 402, 403, 405, 310, 229, 345,
-	406, 0, 294, 407, 408, 268, 0, 0, 0, 0,
-	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 285, 230, 0, 0, 343,
-	0, 184, 0, 382, 218, 295, 292, 413, 244, 236,
-	232, 217, 269, 301, 341, 400, 335, 0, 289, 0,
-	0, 391, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 216,
-	183, 326, 392, 248, 0, 0, 0, 0, 175, 176,
-	177, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	207, 0, 214, 0, 0, 0, 0, 0, 228, 273,
-	235, 227, 410, 0, 0, 0, 0, 199, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 238, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1089, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	256, 0, 315, 0, 0, 0, 0, 442, 0, 0,
-	0, 0, 0, 0, 0, 284, 0, 281, 179, 195,
-	0, 0, 325, 365, 371, 0, 0, 0, 219, 0,
-	369, 339, 427, 203, 246, 362, 344, 367, 0, 0,
-	368, 290, 415, 357, 425, 443, 444, 226, 319, 433,
-	404, 439, 455, 196, 223, 333, 397, 430, 388, 312,
-	411, 412, 280, 387, 254, 182, 288, 449, 194, 377,
-	211, 201, 187, 399, 423, 208, 380, 0, 0, 457,
-	189, 421, 396, 308, 277, 278, 188, 0, 361, 231,
-	252, 221, 328, 418, 419, 220, 458, 198, 438, 191,
-	0, 437, 321, 414, 422, 309, 300, 190, 420, 307,
-	299, 283, 242, 263, 355, 293, 356, 264, 317, 316,
-	318, 0, 185, 0, 393, 431, 459, 204, 205, 206,
-	0, 241, 245, 251, 253, 259, 260, 267, 286, 332,
-	354, 352, 358, 0, 409, 426, 434, 441, 447, 448,
-	450, 451, 452, 453, 454, 320, 266, 389, 282, 291,
-	0, 0, 338, 370, 209, 429, 390, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 460, 461, 462,
-	463, 464, 465, 466, 467, 468, 469, 470, 471, 472,
-	473, 474, 475, 476, 477, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 192, 287, 0,
-	359, 249, 456, 436, 432, 0, 0, 225, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 311,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	180, 181, 193, 202, 212, 224, 239, 247, 257, 262,
-	265, 270, 271, 274, 279, 297, 302, 303, 304, 305,
-	322, 323, 324, 327, 330, 331, 334, 336, 337, 340,
-	347, 348, 349, 350, 351, 353, 360, 364, 372, 373,
-	374, 375, 376, 378, 379, 383, 384, 385, 386, 394,
-	398, 416, 417, 428, 440, 445, 258, 424, 446, 0,
-	296, 0, 0, 298, 243, 261, 272, 0, 435, 395,
-	197, 366, 250, 186, 215, 200, 222, 237, 240, 276,
-	306, 313, 342, 346, 255, 234, 213, 363, 210, 381,
-	401, 402, 403, 405, 310, 229, 345, 406, 0, 294,
-	407, 408, 268, 0, 0, 0, 0, 0, 329, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 0, 0,
-	0, 0, 285, 230, 0, 0, 343, 0, 184, 0,
-	382, 218, 295, 292, 413, 244, 236, 232, 217, 269,
-	301, 341, 400, 335, 0, 289, 0, 0, 391, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 216, 183, 326, 392,
-	248, 0, 0, 0, 0, 175, 176, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
-	0, 0, 0, 0, 0, 228, 273, 235, 227, 410,
-	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 238,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 256, 0, 315,
-	0, 0, 0, 0, 442, 0, 0, 0, 0, 0,
-	0, 0, 284, 0, 281, 179, 195, 0, 0, 325,
-	365, 371, 0, 0, 0, 219, 0, 369, 339, 427,
-	203, 246, 362, 344, 367, 0, 0, 368, 290, 415,
-	357, 425, 443, 444, 226, 319, 433, 404, 439, 455,
-	196, 223, 333, 397, 430, 388, 312, 411, 412, 280,
-	387, 254, 182, 288, 449, 194, 377, 211, 201, 187,
-	399, 423, 208, 380, 0, 0, 457, 189, 421, 396,
-	308, 277, 278, 188, 0, 361, 231, 252, 221, 328,
-	418, 419, 220, 458, 198, 438, 191, 0, 437, 321,
-	414, 422, 309, 300, 190, 420, 307, 299, 283, 242,
-	263, 355, 293, 356, 264, 317, 316, 318, 0, 185,
-	0, 393, 431, 459, 204, 205, 206, 0, 241, 245,
-	251, 253, 259, 260, 267, 286, 332, 354, 352, 358,
-	0, 409, 426, 434, 441, 447, 448, 450, 451, 452,
-	453, 454, 320, 266, 389, 282, 291, 0, 0, 338,
-	370, 209, 429, 390, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 460, 461, 462, 463, 464, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 192, 287, 1450, 359, 249, 456,
-	436, 432, 0, 0, 225, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 311, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 180, 181, 193,
-	202, 212, 224, 239, 247, 257, 262, 265, 270, 271,
-	274, 279, 297, 302, 303, 304, 305, 322, 323, 324,
-	327, 330, 331, 334, 336, 337, 340, 347, 348, 349,
-	350, 351, 353, 360, 364, 372, 373, 374, 375, 376,
-	378, 379, 383, 384, 385, 386, 394, 398, 416, 417,
-	428, 440, 445, 258, 424, 446, 0, 296, 0, 0,
-	298, 243, 261, 272, 0, 435, 395, 197, 366, 250,
-	186, 215, 200, 222, 237, 240, 276, 306, 313, 342,
-	346, 255, 234, 213, 363, 210, 381, 401, 402, 403,
-	405, 310, 229, 345, 406, 0, 294, 407, 408, 268,
-	0, 0, 0, 0, 0, 329, 0, 1331, 0, 0,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 285,
-	230, 0, 0, 343, 0, 184, 0, 382, 218, 295,
-	292, 413, 244, 236, 232, 217, 269, 301, 341, 400,
-	335, 0, 289, 0, 0, 391, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 216, 183, 326, 392, 248, 0, 0,
-	0, 0, 175, 176, 177, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 207, 0, 214, 0, 0, 0,
-	0, 0, 228, 273, 235, 227, 410, 0, 0, 0,
-	0, 199, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 238, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 256, 0, 315, 0, 0, 0,
-	0, 442, 0, 0, 0, 0, 0, 0, 0, 284,
-	0, 281, 179, 195, 0, 0, 325, 365, 371, 0,
-	0, 0, 219, 0, 369, 339, 427, 203, 246, 362,
-	344, 367, 0, 0, 368, 290, 415, 357, 425, 443,
-	444, 226, 319, 433, 404, 439, 455, 196, 223, 333,
-	397, 430, 388, 312, 411, 412, 280, 387, 254, 182,
-	288, 449, 194, 377, 211, 201, 187, 399, 423, 208,
-	380, 0, 0, 457, 189, 421, 396, 308, 277, 278,
-	188, 0, 361, 231, 252, 221, 328, 418, 419, 220,
-	458, 198, 438, 191, 0, 437, 321, 414, 422, 309,
-	300, 190, 420, 307, 299, 283, 242, 263, 355, 293,
-	356, 264, 317, 316, 318, 0, 185, 0, 393, 431,
-	459, 204, 205, 206, 0, 241, 245, 251, 253, 259,
-	260, 267, 286, 332, 354, 352, 358, 0, 409, 426,
-	434, 441, 447, 448, 450, 451, 452, 453, 454, 320,
-	266, 389, 282, 291, 0, 0, 338, 370, 209, 429,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 460, 461, 462, 463, 464, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 475, 476, 477, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	178, 192, 287, 0, 359, 249, 456, 436, 432, 0,
-	0, 225, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 311, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 180, 181, 193, 202, 212, 224,
-	239, 247, 257, 262, 265, 270, 271, 274, 279, 297,
-	302, 303, 304, 305, 322, 323, 324, 327, 330, 331,
-	334, 336, 337, 340, 347, 348, 349, 350, 351, 353,
-	360, 364, 372, 373, 374, 375, 376, 378, 379, 383,
-	384, 385, 386, 394, 398, 416, 417, 428, 440, 445,
-	258, 424, 446, 0, 296, 0, 0, 298, 243, 261,
-	272, 0, 435, 395, 197, 366, 250, 186, 215, 200,
-	222, 237, 240, 276, 306, 313, 342, 346, 255, 234,
-	213, 363, 210, 381, 401, 402, 403, 405, 310, 229,
-	345, 406, 0, 294, 407, 408, 268, 0, 0, 0,
-	0, 0, 329, 0, 1329, 0, 0, 0, 0, 0,
-	0, 233, 0, 0, 0, 0, 285, 230, 0, 0,
-	343, 0, 184, 0, 382, 218, 295, 292, 413, 244,
-	236, 232, 217, 269, 301, 341, 400, 335, 0, 289,
-	0, 0, 391, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 275,
-	216, 183, 326, 392, 248, 0, 0, 0, 0, 175,
-	176, 177, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 207, 0, 214, 0, 0, 0, 0, 0, 228,
-	273, 235, 227, 410, 0, 0, 0, 0, 199, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 238, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 315, 0, 0, 0, 0, 442, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 179,
-	195, 0, 0, 325, 365, 371, 0, 0, 0, 219,
-	0, 369, 339, 427, 203, 246, 362, 344, 367, 0,
-	0, 368, 290, 415, 357, 425, 443, 444, 226, 319,
-	433, 404, 439, 455, 196, 223, 333, 397, 430, 388,
-	312, 411, 412, 280, 387, 254, 182, 288, 449, 194,
-	377, 211, 201, 187, 399, 423, 208, 380, 0, 0,
-	457, 189, 421, 396, 308, 277, 278, 188, 0, 361,
-	231, 252, 221, 328, 418, 419, 220, 458, 198, 438,
-	191, 0, 437, 321, 414, 422, 309, 300, 190, 420,
-	307, 299, 283, 242, 263, 355, 293, 356, 264, 317,
-	316, 318, 0, 185, 0, 393, 431, 459, 204, 205,
-	206, 0, 241, 245, 251, 253, 259, 260, 267, 286,
-	332, 354, 352, 358, 0, 409, 426, 434, 441, 447,
-	448, 450, 451, 452, 453, 454, 320, 266, 389, 282,
-	291, 0, 0, 338, 370, 209, 429, 390, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 460, 461,
-	462, 463, 464, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 474, 475, 476, 477, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 178, 192, 287,
-	0, 359, 249, 456, 436, 432, 0, 0, 225, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	311, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 180, 181, 193, 202, 212, 224, 239, 247, 257,
-	262, 265, 270, 271, 274, 279, 297, 302, 303, 304,
-	305, 322, 323, 324, 327, 330, 331, 334, 336, 337,
-	340, 347, 348, 349, 350, 351, 353, 360, 364, 372,
-	373, 374, 375, 376, 378, 379, 383, 384, 385, 386,
-	394, 398, 416, 417, 428, 440, 445, 258, 424, 446,
-	0, 296, 0, 0, 298, 243, 261, 272, 0, 435,
-	395, 197, 366, 250, 186, 215, 200, 222, 237, 240,
-	276, 306, 313, 342, 346, 255, 234, 213, 363, 210,
-	381, 401, 402, 403, 405, 310, 229, 345, 406, 0,
-	294, 407, 408, 268, 0, 0, 0, 0, 0, 329,
-	0, 1327, 0, 0, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 285, 230, 0, 0, 343, 0, 184,
-	0, 382, 218, 295, 292, 413, 244, 236, 232, 217,
-	269, 301, 341, 400, 335, 0, 289, 0, 0, 391,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 216, 183, 326,
-	392, 248, 0, 0, 0, 0, 175, 176, 177, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
-	214, 0, 0, 0, 0, 0, 228, 273, 235, 227,
-	410, 0, 0, 0, 0, 199, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 256, 0,
-	315, 0, 0, 0, 0, 442, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 179, 195, 0, 0,
-	325, 365, 371, 0, 0, 0, 219, 0, 369, 339,
-	427, 203, 246, 362, 344, 367, 0, 0, 368, 290,
-	415, 357, 425, 443, 444, 226, 319, 433, 404, 439,
-	455, 196, 223, 333, 397, 430, 388, 312, 411, 412,
-	280, 387, 254, 182, 288, 449, 194, 377, 211, 201,
-	187, 399, 423, 208, 380, 0, 0, 457, 189, 421,
-	396, 308, 277, 278, 188, 0, 361, 231, 252, 221,
-	328, 418, 419, 220, 458, 198, 438, 191, 0, 437,
-	321, 414, 422, 309, 300, 190, 420, 307, 299, 283,
-	242, 263, 355, 293, 356, 264, 317, 316, 318, 0,
-	185, 0, 393, 431, 459, 204, 205, 206, 0, 241,
-	245, 251, 253, 259, 260, 267, 286, 332, 354, 352,
-	358, 0, 409, 426, 434, 441, 447, 448, 450, 451,
-	452, 453, 454, 320, 266, 389, 282, 291, 0, 0,
-	338, 370, 209, 429, 390, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 460, 461, 462, 463, 464,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 192, 287, 0, 359, 249,
-	456, 436, 432, 0, 0, 225, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 311, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 180, 181,
-	193, 202, 212, 224, 239, 247, 257, 262, 265, 270,
-	271, 274, 279, 297, 302, 303, 304, 305, 322, 323,
-	324, 327, 330, 331, 334, 336, 337, 340, 347, 348,
-	349, 350, 351, 353, 360, 364, 372, 373, 374, 375,
-	376, 378, 379, 383, 384, 385, 386, 394, 398, 416,
-	417, 428, 440, 445, 258, 424, 446, 0, 296, 0,
-	0, 298, 243, 261, 272, 0, 435, 395, 197, 366,
-	250, 186, 215, 200, 222, 237, 240, 276, 306, 313,
-	342, 346, 255, 234, 213, 363, 210, 381, 401, 402,
-	403, 405, 310, 229, 345, 406, 0, 294, 407, 408,
-	268, 0, 0, 0, 0, 0, 329, 0, 1325, 0,
-	0, 0, 0, 0, 0, 233, 0, 0, 0, 0,
-	285, 230, 0, 0, 343, 0, 184, 0, 382, 218,
-	295, 292, 413, 244, 236, 232, 217, 269, 301, 341,
-	400, 335, 0, 289, 0, 0, 391, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 216, 183, 326, 392, 248, 0,
-	0, 0, 0, 175, 176, 177, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 214, 0, 0,
-	0, 0, 0, 228, 273, 235, 227, 410, 0, 0,
-	0, 0, 199, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 238, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 315, 0, 0,
-	0, 0, 442, 0, 0, 0, 0, 0, 0, 0,
-	284, 0, 281, 179, 195, 0, 0, 325, 365, 371,
-	0, 0, 0, 219, 0, 369, 339, 427, 203, 246,
-	362, 344, 367, 0, 0, 368, 290, 415, 357, 425,
-	443, 444, 226, 319, 433, 404, 439, 455, 196, 223,
-	333, 397, 430, 388, 312, 411, 412, 280, 387, 254,
-	182, 288, 449, 194, 377, 211, 201, 187, 399, 423,
-	208, 380, 0, 0, 457, 189, 421, 396, 308, 277,
-	278, 188, 0, 361, 231, 252, 221, 328, 418, 419,
-	220, 458, 198, 438, 191, 0, 437, 321, 414, 422,
-	309, 300, 190, 420, 307, 299, 283, 242, 263, 355,
-	293, 356, 264, 317, 316, 318, 0, 185, 0, 393,
-	431, 459, 204, 205, 206, 0, 241, 245, 251, 253,
-	259, 260, 267, 286, 332, 354, 352, 358, 0, 409,
-	426, 434, 441, 447, 448, 450, 451, 452, 453, 454,
-	320, 266, 389, 282, 291, 0, 0, 338, 370, 209,
-	429, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 460, 461, 462, 463, 464, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 178, 192, 287, 0, 359, 249, 456, 436, 432,
-	0, 0, 225, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 311, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 180, 181, 193, 202, 212,
-	224, 239, 247, 257, 262, 265, 270, 271, 274, 279,
-	297, 302, 303, 304, 305, 322, 323, 324, 327, 330,
-	331, 334, 336, 337, 340, 347, 348, 349, 350, 351,
-	353, 360, 364, 372, 373, 374, 375, 376, 378, 379,
-	383, 384, 385, 386, 394, 398, 416, 417, 428, 440,
-	445, 258, 424, 446, 0, 296, 0, 0, 298, 243,
-	261, 272, 0, 435, 395, 197, 366, 250, 186, 215,
-	200, 222, 237, 240, 276, 306, 313, 342, 346, 255,
-	234, 213, 363, 210, 381, 401, 402, 403, 405, 310,
-	229, 345, 406, 0, 294, 407, 408, 268, 0, 0,
-	0, 0, 0, 329, 0, 1323, 0, 0, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 285, 230, 0,
-	0, 343, 0, 184, 0, 382, 218, 295, 292, 413,
-	244, 236, 232, 217, 269, 301, 341, 400, 335, 0,
-	289, 0, 0, 391, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 216, 183, 326, 392, 248, 0, 0, 0, 0,
-	175, 176, 177, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 207, 0, 214, 0, 0, 0, 0, 0,
-	228, 273, 235, 227, 410, 0, 0, 0, 0, 199,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 238, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 256, 0, 315, 0, 0, 0, 0, 442,
-	0, 0, 0, 0, 0, 0, 0, 284, 0, 281,
-	179, 195, 0, 0, 325, 365, 371, 0, 0, 0,
-	219, 0, 369, 339, 427, 203, 246, 362, 344, 367,
-	0, 0, 368, 290, 415, 357, 425, 443, 444, 226,
-	319, 433, 404, 439, 455, 196, 223, 333, 397, 430,
-	388, 312, 411, 412, 280, 387, 254, 182, 288, 449,
-	194, 377, 211, 201, 187, 399, 423, 208, 380, 0,
-	0, 457, 189, 421, 396, 308, 277, 278, 188, 0,
-	361, 231, 252, 221, 328, 418, 419, 220, 458, 198,
-	438, 191, 0, 437, 321, 414, 422, 309, 300, 190,
-	420, 307, 299, 283, 242, 263, 355, 293, 356, 264,
-	317, 316, 318, 0, 185, 0, 393, 431, 459, 204,
-	205, 206, 0, 241, 245, 251, 253, 259, 260, 267,
-	286, 332, 354, 352, 358, 0, 409, 426, 434, 441,
-	447, 448, 450, 451, 452, 453, 454, 320, 266, 389,
-	282, 291, 0, 0, 338, 370, 209, 429, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 460,
-	461, 462, 463, 464, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 178, 192,
-	287, 0, 359, 249, 456, 436, 432, 0, 0, 225,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 311, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 181, 193, 202, 212, 224, 239, 247,
-	257, 262, 265, 270, 271, 274, 279, 297, 302, 303,
-	304, 305, 322, 323, 324, 327, 330, 331, 334, 336,
-	337, 340, 347, 348, 349, 350, 351, 353, 360, 364,
-	372, 373, 374, 375, 376, 378, 379, 383, 384, 385,
-	386, 394, 398, 416, 417, 428, 440, 445, 258, 424,
-	446, 0, 296, 0, 0, 298, 243, 261, 272, 0,
-	435, 395, 197, 366, 250, 186, 215, 200, 222, 237,
-	240, 276, 306, 313, 342, 346, 255, 234, 213, 363,
-	210, 381, 401, 402, 403, 405, 310, 229, 345, 406,
-	0, 294, 407, 408, 268, 0, 0, 0, 0, 0,
-	329, 0, 1319, 0, 0, 0, 0, 0, 0, 233,
-	0, 0, 0, 0, 285, 230, 0, 0, 343, 0,
-	184, 0, 382, 218, 295, 292, 413, 244, 236, 232,
-	217, 269, 301, 341, 400, 335, 0, 289, 0, 0,
-	391, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 275, 216, 183,
-	326, 392, 248, 0, 0, 0, 0, 175, 176, 177,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 207,
-	0, 214, 0, 0, 0, 0, 0, 228, 273, 235,
-	227, 410, 0, 0, 0, 0, 199, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	0, 315, 0, 0, 0, 0, 442, 0, 0, 0,
-	0, 0, 0, 0, 284, 0, 281, 179, 195, 0,
-	0, 325, 365, 371, 0, 0, 0, 219, 0, 369,
-	339, 427, 203, 246, 362, 344, 367, 0, 0, 368,
-	290, 415, 357, 425, 443, 444, 226, 319, 433, 404,
-	439, 455, 196, 223, 333, 397, 430, 388, 312, 411,
-	412, 280, 387, 254, 182, 288, 449, 194, 377, 211,
-	201, 187, 399, 423, 208, 380, 0, 0, 457, 189,
-	421, 396, 308, 277, 278, 188, 0, 361, 231, 252,
-	221, 328, 418, 419, 220, 458, 198, 438, 191, 0,
-	437, 321, 414, 422, 309, 300, 190, 420, 307, 299,
-	283, 242, 263, 355, 293, 356, 264, 317, 316, 318,
-	0, 185, 0, 393, 431, 459, 204, 205, 206, 0,
-	241, 245, 251, 253, 259, 260, 267, 286, 332, 354,
-	352, 358, 0, 409, 426, 434, 441, 447, 448, 450,
-	451, 452, 453, 454, 320, 266, 389, 282, 291, 0,
-	0, 338, 370, 209, 429, 390, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 460, 461, 462, 463,
-	464, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 178, 192, 287, 0, 359,
-	249, 456, 436, 432, 0, 0, 225, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 311, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 180,
-	181, 193, 202, 212, 224, 239, 247, 257, 262, 265,
-	270, 271, 274, 279, 297, 302, 303, 304, 305, 322,
-	323, 324, 327, 330, 331, 334, 336, 337, 340, 347,
-	348, 349, 350, 351, 353, 360, 364, 372, 373, 374,
-	375, 376, 378, 379, 383, 384, 385, 386, 394, 398,
-	416, 417, 428, 440, 445, 258, 424, 446, 0, 296,
-	0, 0, 298, 243, 261, 272, 0, 435, 395, 197,
-	366, 250, 186, 215, 200, 222, 237, 240, 276, 306,
-	313, 342, 346, 255, 234, 213, 363, 210, 381, 401,
-	402, 403, 405, 310, 229, 345, 406, 0, 294, 407,
-	408, 268, 0, 0, 0, 0, 0, 329, 0, 1317,
-	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 285, 230, 0, 0, 343, 0, 184, 0, 382,
-	218, 295, 292, 413, 244, 236, 232, 217, 269, 301,
-	341, 400, 335, 0, 289, 0, 0, 391, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 216, 183, 326, 392, 248,
-	0, 0, 0, 0, 175, 176, 177, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 207, 0, 214, 0,
-	0, 0, 0, 0, 228, 273, 235, 227, 410, 0,
-	0, 0, 0, 199, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 238, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 256, 0, 315, 0,
-	0, 0, 0, 442, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 179, 195, 0, 0, 325, 365,
-	371, 0, 0, 0, 219, 0, 369, 339, 427, 203,
-	246, 362, 344, 367, 0, 0, 368, 290, 415, 357,
-	425, 443, 444, 226, 319, 433, 404, 439, 455, 196,
-	223, 333, 397, 430, 388, 312, 411, 412, 280, 387,
-	254, 182, 288, 449, 194, 377, 211, 201, 187, 399,
-	423, 208, 380, 0, 0, 457, 189, 421, 396, 308,
-	277, 278, 188, 0, 361, 231, 252, 221, 328, 418,
-	419, 220, 458, 198, 438, 191, 0, 437, 321, 414,
-	422, 309, 300, 190, 420, 307, 299, 283, 242, 263,
-	355, 293, 356, 264, 317, 316, 318, 0, 185, 0,
-	393, 431, 459, 204, 205, 206, 0, 241, 245, 251,
-	253, 259, 260, 267, 286, 332, 354, 352, 358, 0,
-	409, 426, 434, 441, 447, 448, 450, 451, 452, 453,
-	454, 320, 266, 389, 282, 291, 0, 0, 338, 370,
-	209, 429, 390, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 460, 461, 462, 463, 464, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 192, 287, 0, 359, 249, 456, 436,
-	432, 0, 0, 225, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 311, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 180, 181, 193, 202,
-	212, 224, 239, 247, 257, 262, 265, 270, 271, 274,
-	279, 297, 302, 303, 304, 305, 322, 323, 324, 327,
-	330, 331, 334, 336, 337, 340, 347, 348, 349, 350,
-	351, 353, 360, 364, 372, 373, 374, 375, 376, 378,
-	379, 383, 384, 385, 386, 394, 398, 416, 417, 428,
-	440, 445, 258, 424, 446, 0, 296, 0, 0, 298,
-	243, 261, 272, 0, 435, 395, 197, 366, 250, 186,
-	215, 200, 222, 237, 240, 276, 306, 313, 342, 346,
-	255, 234, 213, 363, 210, 381, 401, 402, 403, 405,
-	310, 229, 345, 406, 0, 294, 407, 408, 268, 0,
-	0, 0, 0, 0, 329, 0, 1315, 0, 0, 0,
-	0, 0, 0, 233, 0, 0, 0, 0, 285, 230,
-	0, 0, 343, 0, 184, 0, 382, 218, 295, 292,
-	413, 244, 236, 232, 217, 269, 301, 341, 400, 335,
-	0, 289, 0, 0, 391, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 216, 183, 326, 392, 248, 0, 0, 0,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 1343, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
 	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
-	0, 228, 273, 235, 227, 410, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
 	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4093,548 +4257,515 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 315, 0, 0, 0, 0,
-	442, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 179, 195, 0, 0, 325, 365, 371, 0, 0,
-	0, 219, 0, 369, 339, 427, 203, 246, 362, 344,
-	367, 0, 0, 368, 290, 415, 357, 425, 443, 444,
-	226, 319, 433, 404, 439, 455, 196, 223, 333, 397,
-	430, 388, 312, 411, 412, 280, 387, 254, 182, 288,
-	449, 194, 377, 211, 201, 187, 399, 423, 208, 380,
-	0, 0, 457, 189, 421, 396, 308, 277, 278, 188,
-	0, 361, 231, 252, 221, 328, 418, 419, 220, 458,
-	198, 438, 191, 0, 437, 321, 414, 422, 309, 300,
-	190, 420, 307, 299, 283, 242, 263, 355, 293, 356,
-	264, 317, 316, 318, 0, 185, 0, 393, 431, 459,
-	204, 205, 206, 0, 241, 245, 251, 253, 259, 260,
-	267, 286, 332, 354, 352, 358, 0, 409, 426, 434,
-	441, 447, 448, 450, 451, 452, 453, 454, 320, 266,
-	389, 282, 291, 0, 0, 338, 370, 209, 429, 390,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
-	192, 287, 0, 359, 249, 456, 436, 432, 0, 0,
-	225, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 311, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 180, 181, 193, 202, 212, 224, 239,
-	247, 257, 262, 265, 270, 271, 274, 279, 297, 302,
-	303, 304, 305, 322, 323, 324, 327, 330, 331, 334,
-	336, 337, 340, 347, 348, 349, 350, 351, 353, 360,
-	364, 372, 373, 374, 375, 376, 378, 379, 383, 384,
-	385, 386, 394, 398, 416, 417, 428, 440, 445, 258,
-	424, 446, 0, 296, 0, 0, 298, 243, 261, 272,
-	0, 435, 395, 197, 366, 250, 186, 215, 200, 222,
-	237, 240, 276, 306, 313, 342, 346, 255, 234, 213,
-	363, 210, 381, 401, 402, 403, 405, 310, 229, 345,
-	406, 0, 294, 407, 408, 268, 0, 0, 0, 0,
-	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 285, 230, 0, 0, 343,
-	0, 184, 0, 382, 218, 295, 292, 413, 244, 236,
-	232, 217, 269, 301, 341, 400, 335, 0, 289, 0,
-	0, 391, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 216,
-	183, 326, 392, 248, 0, 1292, 0, 0, 175, 176,
-	177, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	207, 0, 214, 0, 0, 0, 0, 0, 228, 273,
-	235, 227, 410, 0, 0, 0, 0, 199, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 238, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	256, 0, 315, 0, 0, 0, 0, 442, 0, 0,
-	0, 0, 0, 0, 0, 284, 0, 281, 179, 195,
-	0, 0, 325, 365, 371, 0, 0, 0, 219, 0,
-	369, 339, 427, 203, 246, 362, 344, 367, 0, 0,
-	368, 290, 415, 357, 425, 443, 444, 226, 319, 433,
-	404, 439, 455, 196, 223, 333, 397, 430, 388, 312,
-	411, 412, 280, 387, 254, 182, 288, 449, 194, 377,
-	211, 201, 187, 399, 423, 208, 380, 0, 0, 457,
-	189, 421, 396, 308, 277, 278, 188, 0, 361, 231,
-	252, 221, 328, 418, 419, 220, 458, 198, 438, 191,
-	0, 437, 321, 414, 422, 309, 300, 190, 420, 307,
-	299, 283, 242, 263, 355, 293, 356, 264, 317, 316,
-	318, 0, 185, 0, 393, 431, 459, 204, 205, 206,
-	0, 241, 245, 251, 253, 259, 260, 267, 286, 332,
-	354, 352, 358, 0, 409, 426, 434, 441, 447, 448,
-	450, 451, 452, 453, 454, 320, 266, 389, 282, 291,
-	0, 0, 338, 370, 209, 429, 390, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 460, 461, 462,
-	463, 464, 465, 466, 467, 468, 469, 470, 471, 472,
-	473, 474, 475, 476, 477, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 192, 287, 0,
-	359, 249, 456, 436, 432, 0, 0, 225, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 311,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	180, 181, 193, 202, 212, 224, 239, 247, 257, 262,
-	265, 270, 271, 274, 279, 297, 302, 303, 304, 305,
-	322, 323, 324, 327, 330, 331, 334, 336, 337, 340,
-	347, 348, 349, 350, 351, 353, 360, 364, 372, 373,
-	374, 375, 376, 378, 379, 383, 384, 385, 386, 394,
-	398, 416, 417, 428, 440, 445, 258, 424, 446, 0,
-	296, 0, 0, 298, 243, 261, 272, 0, 435, 395,
-	197, 366, 250, 186, 215, 200, 222, 237, 240, 276,
-	306, 313, 342, 346, 255, 234, 213, 363, 210, 381,
-	401, 402, 403, 405, 310, 229, 345, 406, 0, 294,
-	407, 408, 268, 0, 0, 0, 0, 0, 329, 0,
-	0, 0, 0, 0, 0, 0, 1195, 233, 0, 0,
-	0, 0, 285, 230, 0, 0, 343, 0, 184, 0,
-	382, 218, 295, 292, 413, 244, 236, 232, 217, 269,
-	301, 341, 400, 335, 0, 289, 0, 0, 391, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 216, 183, 326, 392,
-	248, 0, 0, 0, 0, 175, 176, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 207, 0, 214,
-	0, 0, 0, 0, 0, 228, 273, 235, 227, 410,
-	0, 0, 0, 0, 199, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 238,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 256, 0, 315,
-	0, 0, 0, 0, 442, 0, 0, 0, 0, 0,
-	0, 0, 284, 0, 281, 179, 195, 0, 0, 325,
-	365, 371, 0, 0, 0, 219, 0, 369, 339, 427,
-	203, 246, 362, 344, 367, 0, 0, 368, 290, 415,
-	357, 425, 443, 444, 226, 319, 433, 404, 439, 455,
-	196, 223, 333, 397, 430, 388, 312, 411, 412, 280,
-	387, 254, 182, 288, 449, 194, 377, 211, 201, 187,
-	399, 423, 208, 380, 0, 0, 457, 189, 421, 396,
-	308, 277, 278, 188, 0, 361, 231, 252, 221, 328,
-	418, 419, 220, 458, 198, 438, 191, 0, 437, 321,
-	414, 422, 309, 300, 190, 420, 307, 299, 283, 242,
-	263, 355, 293, 356, 264, 317, 316, 318, 0, 185,
-	0, 393, 431, 459, 204, 205, 206, 0, 241, 245,
-	251, 253, 259, 260, 267, 286, 332, 354, 352, 358,
-	0, 409, 426, 434, 441, 447, 448, 450, 451, 452,
-	453, 454, 320, 266, 389, 282, 291, 0, 0, 338,
-	370, 209, 429, 390, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 460, 461, 462, 463, 464, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 192, 287, 0, 359, 249, 456,
-	436, 432, 0, 0, 225, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 311, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 180, 181, 193,
-	202, 212, 224, 239, 247, 257, 262, 265, 270, 271,
-	274, 279, 297, 302, 303, 304, 305, 322, 323, 324,
-	327, 330, 331, 334, 336, 337, 340, 347, 348, 349,
-	350, 351, 353, 360, 364, 372, 373, 374, 375, 376,
-	378, 379, 383, 384, 385, 386, 394, 398, 416, 417,
-	428, 440, 445, 258, 424, 446, 0, 296, 0, 0,
-	298, 243, 261, 272, 0, 435, 395, 197, 366, 250,
-	186, 215, 200, 222, 237, 240, 276, 306, 313, 342,
-	346, 255, 234, 213, 363, 210, 381, 401, 402, 403,
-	405, 310, 229, 345, 406, 0, 294, 407, 408, 268,
-	0, 0, 0, 0, 0, 329, 0, 0, 0, 0,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 285,
-	230, 0, 0, 343, 0, 184, 0, 382, 218, 295,
-	292, 413, 244, 236, 232, 217, 269, 301, 341, 400,
-	335, 0, 289, 0, 0, 391, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 216, 183, 326, 392, 248, 0, 0,
-	0, 0, 175, 176, 177, 0, 1036, 0, 0, 0,
-	0, 0, 0, 0, 207, 0, 214, 0, 0, 0,
-	0, 0, 228, 273, 235, 227, 410, 0, 0, 0,
-	0, 199, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 238, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 256, 0, 315, 0, 0, 0,
-	0, 442, 0, 0, 0, 0, 0, 0, 0, 284,
-	0, 281, 179, 195, 0, 0, 325, 365, 371, 0,
-	0, 0, 219, 0, 369, 339, 427, 203, 246, 362,
-	344, 367, 0, 0, 368, 290, 415, 357, 425, 443,
-	444, 226, 319, 433, 404, 439, 455, 196, 223, 333,
-	397, 430, 388, 312, 411, 412, 280, 387, 254, 182,
-	288, 449, 194, 377, 211, 201, 187, 399, 423, 208,
-	380, 0, 0, 457, 189, 421, 396, 308, 277, 278,
-	188, 0, 361, 231, 252, 221, 328, 418, 419, 220,
-	458, 198, 438, 191, 0, 437, 321, 414, 422, 309,
-	300, 190, 420, 307, 299, 283, 242, 263, 355, 293,
-	356, 264, 317, 316, 318, 0, 185, 0, 393, 431,
-	459, 204, 205, 206, 0, 241, 245, 251, 253, 259,
-	260, 267, 286, 332, 354, 352, 358, 0, 409, 426,
-	434, 441, 447, 448, 450, 451, 452, 453, 454, 320,
-	266, 389, 282, 291, 0, 0, 338, 370, 209, 429,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 460, 461, 462, 463, 464, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 475, 476, 477, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	178, 192, 287, 0, 359, 249, 456, 436, 432, 0,
-	0, 225, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 311, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 180, 181, 193, 202, 212, 224,
-	239, 247, 257, 262, 265, 270, 271, 274, 279, 297,
-	302, 303, 304, 305, 322, 323, 324, 327, 330, 331,
-	334, 336, 337, 340, 347, 348, 349, 350, 351, 353,
-	360, 364, 372, 373, 374, 375, 376, 378, 379, 383,
-	384, 385, 386, 394, 398, 416, 417, 428, 440, 445,
-	258, 424, 446, 0, 296, 0, 0, 298, 243, 261,
-	272, 0, 435, 395, 197, 366, 250, 186, 215, 200,
-	222, 237, 240, 276, 306, 313, 342, 346, 255, 234,
-	213, 363, 210, 381, 401, 402, 403, 405, 310, 229,
-	345, 406, 0, 294, 407, 408, 268, 0, 0, 0,
-	0, 0, 329, 0, 0, 0, 0, 0, 0, 0,
-	0, 233, 0, 0, 0, 0, 285, 230, 0, 0,
-	343, 0, 184, 0, 382, 218, 295, 292, 413, 244,
-	236, 232, 217, 269, 301, 341, 400, 335, 0, 289,
-	0, 0, 391, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 275,
-	216, 183, 326, 392, 248, 0, 0, 0, 0, 175,
-	176, 177, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 207, 0, 214, 0, 0, 0, 0, 0, 228,
-	273, 235, 227, 410, 0, 0, 0, 0, 199, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 238, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 315, 0, 0, 0, 0, 442, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 179,
-	195, 0, 0, 325, 365, 371, 0, 0, 0, 219,
-	0, 369, 339, 427, 203, 246, 362, 344, 367, 0,
-	0, 368, 290, 415, 357, 425, 443, 444, 226, 319,
-	433, 404, 439, 455, 196, 223, 333, 397, 430, 388,
-	312, 411, 412, 280, 387, 254, 182, 288, 449, 194,
-	377, 211, 201, 187, 399, 423, 208, 380, 0, 0,
-	457, 189, 421, 396, 308, 277, 278, 188, 0, 361,
-	231, 252, 221, 328, 418, 419, 220, 458, 198, 438,
-	191, 0, 437, 321, 414, 422, 309, 300, 190, 420,
-	307, 299, 283, 242, 263, 355, 293, 356, 264, 317,
-	316, 318, 0, 185, 0, 393, 431, 459, 204, 205,
-	206, 0, 241, 245, 251, 253, 259, 260, 267, 286,
-	332, 354, 352, 358, 0, 409, 426, 434, 441, 447,
-	448, 450, 451, 452, 453, 454, 320, 266, 389, 282,
-	291, 0, 0, 338, 370, 209, 429, 390, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 460, 461,
-	462, 463, 464, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 474, 475, 476, 477, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 178, 192, 287,
-	0, 359, 249, 456, 436, 432, 0, 0, 225, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	311, 0, 0, 0, 0, 0, 0, 672, 0, 0,
-	0, 180, 181, 193, 202, 212, 224, 239, 247, 257,
-	262, 265, 270, 271, 274, 279, 297, 302, 303, 304,
-	305, 322, 323, 324, 327, 330, 331, 334, 336, 337,
-	340, 347, 348, 349, 350, 351, 353, 360, 364, 372,
-	373, 374, 375, 376, 378, 379, 383, 384, 385, 386,
-	394, 398, 416, 417, 428, 440, 445, 258, 424, 446,
-	0, 296, 0, 0, 298, 243, 261, 272, 0, 435,
-	395, 197, 366, 250, 186, 215, 200, 222, 237, 240,
-	276, 306, 313, 342, 346, 255, 234, 213, 363, 210,
-	381, 401, 402, 403, 405, 310, 229, 345, 406, 0,
-	294, 407, 408, 268, 0, 0, 0, 0, 0, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 285, 230, 0, 0, 343, 0, 184,
-	0, 382, 218, 295, 292, 413, 244, 236, 232, 217,
-	269, 301, 341, 400, 335, 0, 289, 0, 0, 391,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 216, 183, 326,
-	392, 248, 0, 0, 0, 0, 175, 176, 177, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
-	214, 0, 0, 0, 0, 0, 228, 273, 235, 227,
-	410, 0, 0, 0, 0, 199, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 533, 0, 256, 0,
-	315, 0, 0, 0, 0, 442, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 179, 195, 0, 0,
-	325, 365, 371, 0, 0, 0, 219, 0, 369, 339,
-	427, 203, 246, 362, 344, 367, 0, 0, 368, 290,
-	415, 357, 425, 443, 444, 226, 319, 433, 404, 439,
-	455, 196, 223, 333, 397, 430, 388, 312, 411, 412,
-	280, 387, 254, 182, 288, 449, 194, 377, 211, 201,
-	187, 399, 423, 208, 380, 0, 0, 457, 189, 421,
-	396, 308, 277, 278, 188, 0, 361, 231, 252, 221,
-	328, 418, 419, 220, 458, 198, 438, 191, 0, 437,
-	321, 414, 422, 309, 300, 190, 420, 307, 299, 283,
-	242, 263, 355, 293, 356, 264, 317, 316, 318, 0,
-	185, 0, 393, 431, 459, 204, 205, 206, 0, 241,
-	245, 251, 253, 259, 260, 267, 286, 332, 354, 352,
-	358, 0, 409, 426, 434, 441, 447, 448, 450, 451,
-	452, 453, 454, 320, 266, 389, 282, 291, 0, 0,
-	338, 370, 209, 429, 390, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 460, 461, 462, 463, 464,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 192, 287, 0, 359, 249,
-	456, 436, 432, 0, 0, 225, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 311, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 180, 181,
-	193, 202, 212, 224, 239, 247, 257, 262, 265, 270,
-	271, 274, 279, 297, 302, 303, 304, 305, 322, 323,
-	324, 327, 330, 331, 334, 336, 337, 340, 347, 348,
-	349, 350, 351, 353, 360, 364, 372, 373, 374, 375,
-	376, 378, 379, 383, 384, 385, 386, 394, 398, 416,
-	417, 428, 440, 445, 532, 424, 446, 0, 296, 0,
-	0, 298, 243, 261, 272, 0, 435, 395, 197, 366,
-	250, 186, 215, 200, 222, 237, 240, 276, 306, 313,
-	342, 346, 255, 234, 213, 363, 210, 381, 401, 402,
-	403, 405, 310, 229, 345, 406, 0, 294, 407, 408,
-	268, 0, 0, 0, 0, 0, 329, 0, 0, 0,
-	0, 0, 0, 0, 0, 233, 0, 0, 0, 0,
-	285, 230, 0, 0, 343, 0, 184, 0, 382, 218,
-	295, 292, 413, 244, 236, 232, 217, 269, 301, 341,
-	400, 335, 0, 289, 0, 0, 391, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 216, 183, 326, 392, 248, 0,
-	0, 0, 0, 175, 176, 177, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 214, 0, 0,
-	0, 0, 0, 228, 273, 235, 227, 410, 0, 0,
-	0, 0, 199, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 238, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 315, 0, 0,
-	480, 0, 442, 0, 0, 0, 0, 0, 0, 0,
-	284, 0, 281, 179, 195, 0, 0, 325, 365, 371,
-	0, 0, 0, 219, 0, 369, 339, 427, 203, 246,
-	362, 344, 367, 0, 0, 368, 290, 415, 357, 425,
-	443, 444, 226, 319, 433, 404, 439, 455, 196, 223,
-	333, 397, 430, 388, 312, 411, 412, 280, 387, 254,
-	182, 288, 449, 194, 377, 211, 201, 187, 399, 423,
-	208, 380, 0, 0, 457, 189, 421, 396, 308, 277,
-	278, 188, 0, 361, 231, 252, 221, 328, 418, 419,
-	220, 458, 198, 438, 191, 0, 437, 321, 414, 422,
-	309, 300, 190, 420, 307, 299, 283, 242, 263, 355,
-	293, 356, 264, 317, 316, 318, 0, 185, 0, 393,
-	431, 459, 204, 205, 206, 0, 241, 245, 251, 253,
-	259, 260, 267, 286, 332, 354, 352, 358, 0, 409,
-	426, 434, 441, 447, 448, 450, 451, 452, 453, 454,
-	320, 266, 389, 282, 291, 0, 0, 338, 370, 209,
-	429, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 460, 461, 462, 463, 464, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 178, 192, 287, 0, 359, 249, 456, 436, 432,
-	0, 0, 225, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 311, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 180, 181, 193, 202, 212,
-	224, 239, 247, 257, 262, 265, 270, 271, 274, 279,
-	297, 302, 303, 304, 305, 322, 323, 324, 327, 330,
-	331, 334, 336, 337, 340, 347, 348, 349, 350, 351,
-	353, 360, 364, 372, 373, 374, 375, 376, 378, 379,
-	383, 384, 385, 386, 394, 398, 416, 417, 428, 440,
-	445, 258, 424, 446, 0, 296, 0, 0, 298, 243,
-	261, 272, 0, 435, 395, 197, 366, 250, 186, 215,
-	200, 222, 237, 240, 276, 306, 313, 342, 346, 255,
-	234, 213, 363, 210, 381, 401, 402, 403, 405, 310,
-	229, 345, 406, 0, 294, 407, 408, 268, 0, 0,
-	0, 0, 0, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 285, 230, 0,
-	0, 343, 0, 184, 0, 382, 218, 295, 292, 413,
-	244, 236, 232, 217, 269, 301, 341, 400, 335, 0,
-	289, 0, 0, 391, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 216, 183, 326, 392, 248, 0, 0, 0, 0,
-	175, 176, 177, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 207, 0, 214, 0, 0, 0, 0, 0,
-	228, 273, 235, 227, 410, 0, 0, 0, 0, 199,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 1341, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
+	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 238, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 256, 0, 315, 0, 0, 0, 0, 442,
-	0, 0, 0, 0, 0, 0, 0, 284, 0, 281,
-	179, 195, 0, 0, 325, 365, 371, 0, 0, 0,
-	219, 0, 369, 339, 427, 203, 246, 362, 344, 367,
-	0, 0, 368, 290, 415, 357, 425, 443, 444, 226,
-	319, 433, 404, 439, 455, 196, 223, 333, 397, 430,
-	388, 312, 411, 412, 280, 387, 254, 182, 288, 449,
-	194, 377, 211, 201, 187, 399, 423, 208, 380, 0,
-	0, 457, 189, 421, 396, 308, 277, 278, 188, 0,
-	361, 231, 252, 221, 328, 418, 419, 220, 458, 198,
-	438, 191, 0, 437, 321, 414, 422, 309, 300, 190,
-	420, 307, 299, 283, 242, 263, 355, 293, 356, 264,
-	317, 316, 318, 0, 185, 0, 393, 431, 459, 204,
-	205, 206, 0, 241, 245, 251, 253, 259, 260, 267,
-	286, 332, 354, 352, 358, 0, 409, 426, 434, 441,
-	447, 448, 450, 451, 452, 453, 454, 320, 266, 389,
-	282, 291, 0, 0, 338, 370, 209, 429, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 460,
-	461, 462, 463, 464, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 178, 192,
-	287, 0, 359, 249, 456, 436, 432, 0, 0, 225,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 1318, 0,
+	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 311, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 181, 193, 202, 212, 224, 239, 247,
-	257, 262, 265, 270, 271, 274, 279, 297, 302, 303,
-	304, 305, 322, 323, 324, 327, 330, 331, 334, 336,
-	337, 340, 347, 348, 349, 350, 351, 353, 360, 364,
-	372, 373, 374, 375, 376, 378, 379, 383, 384, 385,
-	386, 394, 398, 416, 417, 428, 440, 445, 258, 424,
-	446, 0, 296, 0, 0, 298, 243, 261, 272, 0,
-	435, 395, 197, 366, 250, 186, 215, 200, 222, 237,
-	240, 276, 306, 313, 342, 346, 255, 234, 213, 363,
-	210, 381, 401, 402, 403, 405, 310, 229, 345, 169,
-	0, 294, 407, 408, 268, 0, 0, 0, 0, 1920,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	169, 0, 111, 0, 133, 0, 0, 0, 0, 0,
-	1285, 0, 0, 0, 0, 153, 0, 0, 0, 0,
-	0, 0, 0, 111, 0, 133, 0, 0, 0, 0,
-	0, 0, 0, 79, 0, 0, 153, 0, 0, 600,
-	607, 608, 609, 610, 601, 603, 143, 0, 0, 602,
-	0, 132, 605, 611, 612, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 143, 0, 0,
-	0, 150, 132, 151, 0, 0, 0, 0, 0, 1289,
-	1290, 142, 141, 168, 0, 0, 0, 0, 0, 0,
-	0, 0, 150, 0, 151, 2081, 2082, 0, 0, 0,
-	1289, 1290, 142, 141, 168, 0, 0, 613, 614, 615,
-	616, 617, 618, 619, 620, 621, 622, 623, 624, 625,
-	626, 627, 628, 629, 630, 631, 632, 633, 634, 635,
-	636, 637, 638, 639, 640, 641, 642, 643, 644, 645,
-	646, 647, 648, 649, 650, 651, 652, 653, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 137, 1291, 144, 0,
-	1288, 0, 138, 139, 0, 0, 0, 154, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 137, 1291, 144,
-	0, 1288, 0, 138, 139, 0, 0, 0, 154, 0,
-	0, 0, 0, 0, 0, 0, 0, 159, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 0, 0, 0, 0, 0,
+	0, 1221, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
+	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 146, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 146, 591, 0, 0, 0, 592,
-	593, 0, 0, 0, 594, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 140, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
+	0, 175, 176, 177, 0, 1051, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	134, 0, 0, 135, 0, 0, 0, 0, 0, 140,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 134, 0, 0, 135, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
+	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 147, 152, 149, 155, 156, 157,
-	158, 160, 161, 162, 163, 0, 0, 0, 0, 0,
-	164, 165, 166, 167, 0, 147, 152, 149, 155, 156,
-	157, 158, 160, 161, 162, 163, 0, 0, 0, 0,
-	0, 164, 165, 166, 167,
-}
-
-var yyPact = [...]int{
-	2574, -1000, -379, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1688, 1739, -1000, -1000, -1000, 1839, -1000, 672,
-	1471, -1000, 1699, 4437, -1000, 31915, 424, -1000, 31378, 423,
-	81, 31915, -1000, 124, -1000, 100, 31915, 105, 30841, -1000,
-	-1000, -292, 13112, 1626, -13, -16, 31915, -1000, 1809, 1464,
-	-1000, 291, -1000, -1000, -1000, -1000, -1000, -1000, 30304, -1000,
-	-1000, -1000, 1708, 1690, 1850, 536, 1646, -1000, 1747, 1464,
-	-1000, 13112, 1789, 1742, 12575, -1000, 365, -1000, -1000, 9346,
-	-1000, -1000, 17410, 31915, 31915, 267, -1000, 1699, -1000, -1000,
-	276, -1000, 278, 1384, -1000, 1383, -1000, 555, 451, 294,
-	411, 409, 293, 292, 290, 289, 287, 286, 282, 281,
-	299, -1000, 596, 596, -175, -181, 2501, 351, 351, 351,
-	382, 1657, 1655, -1000, 573, -1000, 596, 596, 266, 596,
-	596, 596, 596, 254, 252, 596, 596, 596, 596, 596,
-	596, 596, 596, 596, 596, 596, 596, 596, 596, 596,
-	255, 1699, 238, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 698, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
+	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 547, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 546, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
+	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 322, 0, 0, 494, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 420, 0, 301, 421, 422, 272, 0, 0,
+	0, 0, 0, 338, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 289, 233, 0,
+	0, 352, 0, 184, 0, 396, 220, 302, 299, 427,
+	247, 239, 235, 217, 273, 308, 350, 414, 344, 0,
+	294, 0, 0, 405, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 216, 183, 333, 406, 251, 0, 218, 227, 255,
+	291, 295, 296, 334, 335, 357, 371, 395, 0, 0,
+	0, 175, 176, 177, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 214, 0, 0, 0, 0,
+	0, 231, 277, 238, 230, 424, 0, 0, 0, 0,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 0, 322, 0, 0, 0, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	285, 179, 195, 0, 0, 332, 376, 382, 0, 0,
+	0, 221, 0, 380, 348, 441, 203, 249, 373, 353,
+	378, 0, 0, 379, 297, 429, 367, 439, 457, 458,
+	229, 326, 447, 418, 453, 469, 196, 225, 342, 411,
+	444, 402, 319, 425, 426, 284, 401, 258, 182, 293,
+	463, 194, 388, 211, 201, 187, 413, 437, 208, 391,
+	0, 0, 471, 189, 435, 410, 315, 281, 282, 188,
+	0, 372, 234, 256, 223, 337, 432, 433, 222, 472,
+	198, 452, 191, 0, 451, 328, 428, 436, 316, 307,
+	190, 434, 314, 306, 287, 245, 267, 365, 300, 366,
+	268, 324, 323, 325, 0, 185, 0, 407, 445, 473,
+	204, 205, 206, 0, 244, 248, 254, 257, 263, 264,
+	271, 290, 341, 364, 362, 368, 0, 423, 440, 448,
+	455, 461, 462, 464, 465, 466, 467, 468, 327, 270,
+	403, 286, 298, 0, 0, 347, 381, 209, 443, 404,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	192, 292, 0, 369, 252, 470, 450, 0, 0, 393,
+	392, 219, 442, 360, 261, 336, 385, 446, 0, 228,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	180, 181, 193, 202, 212, 226, 242, 250, 266, 269,
+	274, 275, 278, 283, 304, 309, 310, 311, 312, 329,
+	330, 331, 339, 340, 343, 345, 346, 349, 356, 358,
+	359, 361, 363, 370, 375, 383, 384, 386, 387, 389,
+	390, 397, 398, 399, 400, 408, 412, 430, 431, 454,
+	459, 262, 438, 460, 0, 303, 0, 0, 305, 246,
+	265, 276, 0, 449, 409, 197, 377, 253, 186, 215,
+	200, 224, 240, 243, 280, 313, 320, 351, 355, 259,
+	237, 213, 374, 210, 394, 415, 416, 417, 419, 317,
+	232, 354, 0, 0, 301, 421, 422, 272,
+}
+
+var yyPact = [...]int{
+	4344, -1000, -375, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1787, 1916, -1000, -1000, -1000, 1940, -1000, 654,
+	1541, -1000, 1779, 2642, -1000, 33975, 447, -1000, 33435, 446,
+	3110, 33975, -1000, 126, -1000, 101, 33975, 122, 32895, -1000,
+	-1000, -288, 14521, 1729, 6, 5, 33975, -1000, 1929, 1519,
+	-1000, 315, -1000, -1000, -1000, -1000, -1000, -1000, 32355, -1000,
+	-1000, -1000, 1788, 1772, 1945, 587, 1732, -1000, 1844, 1519,
+	-1000, 14521, 1887, 1836, 13981, -1000, 362, -1000, -1000, 10734,
+	-1000, -1000, 19389, 33975, 33975, 243, -1000, 1779, -1000, -1000,
+	275, -1000, 291, 1355, -1000, 1345, -1000, 441, 565, 284,
+	410, 409, 283, 280, 278, 274, 267, 266, 262, 261,
+	293, -1000, 620, 620, -138, -142, 2444, 321, 321, 321,
+	370, 1751, 1750, -1000, 573, -1000, 620, 620, 265, 620,
+	620, 620, 620, 240, 232, 620, 620, 620, 620, 620,
+	620, 620, 620, 620, 620, 620, 620, 620, 620, 620,
+	277, 1779, 221, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 31915, 111,
-	31915, -1000, 467, 31915, 673, 673, 23, 673, 673, 673,
-	673, 142, 514, -17, -1000, 141, 233, 149, 240, 719,
-	146, 117, -1000, -1000, 237, 719, 1097, 539, 118, -1000,
-	673, 7166, 7166, 7166, -1000, 1683, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 381, -1000, -1000, -1000, -1000, 31915,
-	29767, 309, 642, -1000, -1000, -1000, 44, -1000, -1000, 1207,
-	725, 13112, 1091, -1000, 1360, 468, -1000, -1000, -1000, -1000,
-	-1000, 449, 13649, 13649, 13649, 13649, -1000, -1000, 1399, 1399,
-	1399, 1399, 13649, 1399, 13649, 1399, 1399, 1399, 1399, 13112,
-	1399, 1399, 1399, -1000, 1399, 1399, 1399, 1399, 1399, 1399,
-	1399, 465, 1399, 1399, 1399, 1399, 1399, -1000, -1000, -1000,
-	-1000, 1399, 1399, 1399, 1399, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 15260, -1000, 10964, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 31915, -1000, 1399, 139, 1747,
-	1464, -1000, 1809, 1787, 291, -1000, 1681, 1311, 1428, 1034,
-	1464, 1355, 31915, -1000, 1431, -1000, -1000, -1000, 1563, 1118,
-	1096, -1000, -1000, -1000, -1000, 1029, 13112, -1000, -1000, 1833,
-	-1000, 14723, 464, 799, 29230, -1000, 365, 365, 1382, 8801,
-	-53, -1000, -1000, -1000, 633, 19558, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1683, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4644,210 +4775,260 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 33975, 128, 33975, -1000, 516, 33975, 736, 736,
+	26, 736, 736, 736, 736, 144, 535, 4, -1000, 137,
+	212, 141, 217, 725, 123, 118, -1000, -1000, 215, 725,
+	1123, 595, 106, -1000, 736, 8542, 8542, 8542, -1000, 1760,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 368, -1000,
+	-1000, -1000, -1000, 33975, 31815, 306, 636, -1000, -1000, -1000,
+	107, -1000, -1000, 1219, 677, 14521, 1023, -1000, 1394, 511,
+	-1000, -1000, -1000, -1000, -1000, -1000, 476, 15061, 15061, 15061,
+	15061, -1000, -1000, 1390, 1390, 1390, 1390, 15061, 1390, 15061,
+	1390, 1390, 1390, 1390, 14521, 1390, 1390, 1390, -1000, 1390,
+	1390, 1390, 1390, 1390, 1390, 1390, 514, 1390, 1390, 1390,
+	1390, 1390, 1390, 1390, 1390, 1390, 1390, 1390, 1390, 1390,
+	1390, 1390, 1390, -1000, -1000, -1000, -1000, 1390, 1390, 1390,
+	1390, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	16681, -1000, 12361, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1376, 31915, -1000, -1000, 3387, 1010, -1000, 1470,
-	-1000, 1369, -1000, 1438, 1481, 416, 1010, 410, 405, 400,
-	-1000, -98, -1000, -1000, -1000, -1000, -1000, 596, 596, 298,
-	4437, 32475, -1000, -1000, -1000, 28693, 1469, 1010, -1000, 1468,
-	-1000, 755, 402, 496, 496, 1010, -1000, -1000, 31915, 1010,
-	741, 732, 31915, 31915, -1000, 28156, -1000, 27619, 27082, 983,
-	31915, 26545, 26008, 25471, 24934, 24397, -1000, 1534, -1000, 1450,
-	-1000, -1000, -1000, 31915, 31915, 31915, 279, -1000, -1000, 31915,
-	1010, -1000, -1000, 981, 978, 596, 596, 976, 1095, 1090,
-	1089, 596, 596, 974, 1087, 21706, 236, 972, 958, 955,
-	1061, 1086, 170, 939, 901, 953, 31915, 1467, 31915, -1000,
-	221, 564, 325, 632, 1699, 1622, 1381, 379, 414, 1010,
-	354, 354, -1000, 7711, -1000, -1000, 1084, 13112, -1000, 720,
-	719, 719, -1000, -1000, -1000, -1000, -1000, -1000, 673, 31915,
-	720, -1000, -1000, -1000, 719, 673, 31915, 673, 673, 673,
-	673, 719, 719, 719, 673, 31915, 31915, 31915, 31915, 31915,
-	31915, 31915, 31915, 31915, 7166, 7166, 7166, 539, 673, -296,
-	-1000, 1083, -1000, 1514, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 103, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -102, 1373, 23860, -1000, -297, -299, -300, -302,
-	-1000, -1000, -1000, -304, -305, -1000, -1000, -1000, 13112, 13112,
-	13112, 13112, -1000, 816, 13649, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 867, 665, 13649, 13649, 13649, 13649, 13649, 13649,
-	13649, 13649, 13649, 13649, 13649, 13649, 13649, 13649, 13649, 766,
-	1079, 1074, 468, 468, 468, 468, -1000, 12575, 13112, 13112,
-	468, -1000, 1010, 23323, 12575, 12575, 13112, 1661, 653, 725,
-	31915, -1000, 1034, -1000, -1000, -1000, 850, -1000, 31915, 31915,
-	62, 9889, 7711, 12575, 12575, 12575, 12575, 12575, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 463,
-	1269, 1174, 1367, -1000, 1362, -1000, -171, 16873, 13112, 1073,
-	-1000, -1000, -1000, 1747, -1000, 1747, 1269, 1644, 1566, 12575,
-	-1000, -1000, 1644, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1220, -1000, 31915, 1355, 1729, 31915, 1557, 1064, 279,
-	-1000, 13112, 13112, 1349, -1000, 855, 31915, -1000, -1000, 22786,
-	-1000, -1000, 6621, -1000, 277, 31915, -1000, 21169, 22249, 8256,
-	-53, -1000, 8256, 1257, -1000, -38, -7, 10426, 512, -1000,
-	-1000, -1000, 2501, 14186, 1184, 1643, 45, -1000, -1000, -1000,
-	1438, -1000, 1438, 1438, 1438, 1438, 279, 279, 279, 279,
-	-1000, -1000, -1000, -1000, -1000, 1466, 1465, -1000, 1438, 1438,
-	1438, 1438, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1457,
-	1457, 1457, 1439, 1439, 345, -1000, 13112, 195, 31915, 1707,
-	946, 221, 367, 1497, 1010, 1010, 1010, 367, -1000, 1158,
-	1153, -1000, 1339, -1000, -1000, 1784, -1000, -1000, 655, 776,
-	768, 604, 31915, 151, 273, -1000, 338, -1000, 31915, 1010,
-	708, 496, 1010, -1000, 1010, -1000, -1000, -1000, -1000, -1000,
-	1010, 1303, -1000, 1282, 839, 764, 801, 763, 1303, -1000,
-	-1000, -122, 1303, -1000, 1303, -1000, 1303, -1000, 1303, -1000,
-	1303, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 610,
-	31915, 151, 766, -1000, 377, -1000, -1000, 766, 766, -1000,
-	-1000, -1000, -1000, 1051, 1032, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 33975, -1000, 1390, 143, 1844, 1519, -1000, 1929, 1883,
+	315, -1000, 1862, 1504, 1251, 1034, 1519, 1322, 33975, -1000,
+	1516, -1000, -1000, -1000, 1635, 1073, 1121, -1000, -1000, -1000,
+	-1000, 1264, 14521, -1000, -1000, 1937, -1000, 16141, 507, 788,
+	31275, -1000, 362, 362, 1337, 10186, -40, -1000, -1000, -1000,
+	630, 21549, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1760, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -356, 31915, -1000, 169, 630, 263, 313, 259,
-	31915, 133, 1732, 232, 251, 31915, 31915, 354, 1512, 31915,
-	1713, 31915, -1000, -1000, -1000, -1000, 725, 31915, -1000, -1000,
-	673, 673, -1000, -1000, 31915, 673, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 673, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 31915, 31915, -1000, -1000, -1000, -1000, -1000, 64,
-	-44, 271, -1000, -1000, -1000, -1000, -1000, 1738, -1000, 725,
-	714, 666, -1000, -1000, -1000, 851, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 867, 13649, 13649, 13649, 1639, 389, 2017,
-	2032, 838, 854, 854, 905, 905, 517, 517, 517, 517,
-	517, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1330, -1000,
-	922, 807, 1034, -1000, 1330, 1330, 822, 12575, -1000, -1000,
-	692, -1000, 13112, 1034, -1000, -1000, 1034, 1277, 1262, 1815,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1034, 12575, 12575, 1259, 1399, 461, -1000, 1330, 1034,
-	1034, 1330, 1330, 7711, 1034, -1000, 31915, -1000, -285, -1000,
-	-60, 543, 1399, -1000, 21706, 1034, 1207, -1000, -1000, -1000,
-	-1000, -1000, 19021, 1372, 1644, -1000, -1000, 1399, 1365, -1000,
-	-1000, 279, 34, 777, 725, 725, 13112, -1000, -1000, -1000,
-	-1000, -1000, -1000, 460, 275, 1399, -1000, 1434, 1531, -1000,
-	-1000, -1000, 1728, 16336, 31915, 1414, 1218, -1000, 454, -1000,
-	1257, -53, -34, -1000, -1000, -1000, -1000, 725, -1000, 1080,
-	280, 270, -1000, 364, -1000, -1000, -1000, -1000, 1133, 1724,
-	1638, 35, -1000, -1000, -1000, 279, 279, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1026, 1026, -1000, -1000, -1000, -1000, -1000,
-	944, -1000, -1000, -1000, 934, -1000, -1000, 1194, 1530, 195,
-	-1000, -1000, 596, 1022, 1647, 31915, -1000, -1000, 1149, 169,
-	31915, 662, 1510, -1000, 1497, 1497, 1497, 31915, -1000, -1000,
-	-1000, -1000, 32454, 31915, 1358, -1000, 143, -1000, 1147, 31915,
-	-1000, 1335, 1454, 1010, 1010, -1000, -1000, -1000, 31915, 1399,
-	-1000, -1000, -1000, -1000, 412, 1693, 1685, 151, 143, 512,
-	1010, -1000, -1000, -1000, -1000, -1000, -359, 1333, 393, 155,
-	224, 31915, 31915, 31915, 31915, 31915, 444, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 249, 375, -1000, 31915, 31915,
-	519, -1000, -1000, -1000, 719, -1000, -1000, 719, -1000, -1000,
-	-1000, -1000, -1000, 1680, 31915, -48, -330, -1000, -323, -1000,
-	-1000, -1000, -1000, 1225, 383, 2017, 13649, 13649, 12575, -111,
-	538, 538, 766, -1000, -1000, -1000, 13112, 13112, 1412, 648,
-	-1000, 13112, 771, -1000, -1000, 13112, 13112, 13112, -1000, 1330,
-	1330, 12575, 7711, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 396, 395, 388, 31915, -1000, -1000, 1748,
-	-1000, 1577, 1576, 1802, 1800, -1000, 21169, 1644, -1000, -1000,
-	31915, -280, -1000, 1615, 1579, -1000, -1000, -1000, -1000, 6076,
-	1508, 31915, 1399, -1000, 15798, 31915, 31915, 21169, 21169, 21169,
-	21169, 21169, -1000, 1550, 1539, -1000, 1549, 1543, 1526, 31915,
-	-1000, 1328, 1034, 1787, 16336, 17947, 1188, 21169, -1000, -1000,
-	21169, 31915, 5531, -1000, -1000, -51, -65, -1000, -1000, -1000,
-	-1000, 1781, 2501, -1000, -1000, -1000, -1000, 792, 32431, 1845,
-	-1000, 1013, -1000, 1020, -1000, 705, 699, -1000, 31915, 1452,
-	-1000, -1000, -1000, -1000, -1000, 1298, -1000, 1296, 1255, 1294,
-	110, -1000, 1479, 1670, 596, 596, -1000, 931, -1000, 1010,
-	-1000, -1000, 391, -1000, 1712, 31915, 1507, 1506, 1505, -1000,
-	1780, 1240, 31915, -1000, -1000, 31915, -1000, 1575, 195, 31915,
-	-1000, -1000, -1000, 273, 31915, -1000, 4706, 143, -1000, -1000,
-	-1000, -1000, -1000, -1000, 31915, 174, -1000, 1449, 1105, -1000,
-	1487, -1000, -1000, -1000, -1000, 148, 258, -1000, 31915, 510,
-	1530, 31915, -1000, -1000, -1000, 673, 673, -1000, -1000, 1662,
-	-1000, 1010, 13649, 13649, -1000, 468, -1000, 1399, 1034, 1438,
-	1438, -1000, 1438, 1439, -1000, 1438, 91, 1438, 89, 1034,
-	1034, 840, 1152, -106, -1000, 725, 13112, 996, 873, 950,
-	-1000, -1000, 1034, -1000, 1399, 1399, 1399, 1273, 31915, -1000,
-	-1000, -1000, -1000, 1800, 1798, 13112, 1244, -1000, -1000, 34,
-	336, -1000, 1617, 1579, -1000, 1779, 1608, 1778, -1000, -1000,
-	-1000, 1702, 1347, -1000, 569, 1199, -1000, -1000, 12038, 1275,
-	1571, 453, 1273, 1223, 1531, 1493, 1504, 1586, -1000, -1000,
-	-1000, -1000, 1536, -1000, 1527, -1000, -1000, 1431, -1000, -1000,
-	1174, 277, 21169, 1205, 1205, -1000, 442, -1000, -1000, -1000,
-	-1000, -370, -1000, -1000, 13112, -1000, -1000, -1000, -1000, -1000,
-	-1000, 880, 880, 370, -1000, -1000, -1000, -1000, -1000, 1436,
-	13112, 279, 1005, 279, 930, -1000, 886, -1000, -1000, -227,
-	-1000, -1000, 1447, 1488, -1000, -1000, 31915, -1000, -1000, 31915,
-	31915, 31915, 31915, -1000, -1000, 268, -1000, 1271, 1249, -1000,
-	-145, -1000, 13112, -1000, 1431, -1000, -1000, -1000, 1121, -1000,
-	-128, 31915, 31915, 31915, 31915, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 468, 13649, -1000, -1000, 387, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 13112, -1000, 13112,
-	-1000, 1747, 1003, 725, 13112, 13112, -1000, -1000, 18484, 20632,
-	20632, 17947, -1000, 1798, 1796, 1775, 725, 1584, 1598, 1598,
-	1617, -1000, 1762, 1755, -1000, 998, 1754, 997, 686, -1000,
-	31915, 13112, 1399, -1000, 312, 31915, 1399, 31915, -1000, 1794,
-	-1000, -1000, 13112, 1432, -1000, 13112, -1000, -1000, -1000, -1000,
-	-1000, 1800, 1205, -1000, -1000, 528, 54, 274, -1000, -1000,
-	-1000, 950, -1000, -1000, -1000, 31915, 1177, -1000, -1000, -1000,
-	1117, 1111, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1431, -1000, -1000, -1000, 1240, 262, 314, -1000, 273, -1000,
-	-185, -187, 950, 1716, -1000, -1000, 7711, -1000, -1000, 1411,
-	1494, -1000, 201, -1000, -1000, 950, 950, 1034, -1000, 950,
-	950, 1247, -1000, -1000, -1000, 1247, 1247, 543, 1796, -1000,
-	13112, 13112, 1582, 862, -1000, -1000, -1000, -1000, 987, 952,
-	-1000, 907, -1000, 1844, -1000, 725, -1000, 1399, -1000, 440,
-	1199, -1000, 1747, 725, 31915, 725, 1794, -1000, 1407, 1419,
-	-366, 13112, 1403, -1000, 1234, -1000, -1000, -1000, 1715, 1399,
-	-1000, -1000, -1000, -1000, -1000, 291, 1213, -1000, 560, 31915,
-	31915, 1034, 230, -138, -1000, -1000, -1000, -1000, -1000, 20095,
-	-1000, -1000, -1000, -1000, -1000, 725, 1207, -1000, 847, -1000,
-	-1000, -1000, -1000, -1000, 31915, 1199, 31915, -1000, 1226, 1747,
-	13112, 1398, 550, -367, 872, 1062, 31915, 1503, 715, 291,
-	11501, -125, 7711, 4986, 1176, -1000, -1000, 1556, -120, -141,
-	-1000, -1000, -1000, -1000, 1196, -1000, -1000, -1000, 980, 31915,
-	846, 1389, 1750, -1000, -1000, 1173, 1499, -1000, 1824, -1000,
-	-1000, -1000, 740, 818, -1000, -1000, -1000, -125, 950, 1034,
-	-1000, -39, -1000, -1000, -1000, -1000, -1000, 1487, -1000, 1555,
-	-1000, -366, 1170, -1000, -1000, 273, -363, -1000, -1000, 1843,
-	535, 535, -1000, -1000, -1000, -1000, -1000, 330, -1000, -1000,
-	-128, -130, -367, -366, 1168, 49, -1000, -1000, -1000, 323,
-	919, -1000, 172, -1000, -139, 1389, -367, -1000, 1392, 1419,
-	-1000, -1000, -1000, -1000, -156, -1000, 1389, 13112, 1270, -1000,
-	-1000, 781, 31915, -375, 1129, -1000, 806, -375, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1498, 33975, -1000,
+	-1000, 3461, 1050, -1000, 1540, -1000, 1443, -1000, 1524, 1559,
+	423, 1050, 392, 390, 389, -1000, -81, -1000, -1000, -1000,
+	-1000, -1000, 620, 620, 263, 2642, 3290, -1000, -1000, -1000,
+	30735, 1539, 1050, -1000, 1538, -1000, 730, 455, 479, 479,
+	1050, -1000, -1000, 33975, 1050, 714, 713, 33975, 33975, -1000,
+	30195, -1000, 29655, 29115, 1005, 33975, 28575, 28035, 27495, 26955,
+	26415, -1000, 1664, -1000, 1633, -1000, -1000, -1000, 33975, 33975,
+	33975, 264, -1000, -1000, 33975, 1050, -1000, -1000, 1004, 999,
+	620, 620, 998, 1117, 1112, 1107, 620, 620, 992, 1093,
+	24249, 219, 991, 985, 981, 963, 1092, 238, 947, 897,
+	978, 33975, 1537, 33975, -1000, 207, 622, 326, 626, 1779,
+	1708, 1335, 366, 421, 1050, 335, 335, -1000, 9090, -1000,
+	-1000, 1075, 14521, -1000, 726, 725, 725, -1000, -1000, -1000,
+	-1000, -1000, -1000, 736, 33975, 726, -1000, -1000, -1000, 725,
+	736, 33975, 736, 736, 736, 736, 725, 725, 725, 736,
+	33975, 33975, 33975, 33975, 33975, 33975, 33975, 33975, 33975, 8542,
+	8542, 8542, 595, 736, -292, -1000, 1072, -1000, 1589, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 121, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -106, 1330, 25875,
+	-1000, -295, -296, -303, -304, -1000, -1000, -1000, -306, -307,
+	-1000, -1000, -1000, 14521, 14521, 14521, 14521, -1000, 874, 15061,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 867, 651, 15061,
+	15061, 15061, 15061, 15061, 15061, 15061, 15061, 15061, 15061, 15061,
+	15061, 15061, 15061, 15061, 655, 1059, 1057, 511, 511, 511,
+	511, -1000, 13981, 14521, 14521, 511, -1000, 1050, 22629, 13981,
+	13981, 14521, 1766, 644, 677, 33975, -1000, 1034, -1000, -1000,
+	-1000, 949, -1000, 33975, 33975, 1163, 11280, 9090, 13981, 13981,
+	13981, 13981, 13981, 1034, 1034, 1034, 1034, 1034, 14521, 14521,
+	14521, 14521, 14521, 14521, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 502, 1415, 1217, 1437, -1000,
+	1327, -1000, -174, 18849, 14521, 1049, -1000, -1000, -1000, 1844,
+	-1000, 1844, 1415, 1813, 1667, 13981, -1000, -1000, 1813, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1297, -1000, 33975,
+	1322, 1829, 33975, 1661, 1043, 264, -1000, 14521, 14521, 1310,
+	-1000, 923, 33975, -1000, -1000, 25335, -1000, -1000, 7994, -1000,
+	256, 33975, -1000, 23709, 24795, 9638, -40, -1000, 9638, 1286,
+	-1000, -12, -37, 11820, 523, -1000, -1000, -1000, 2444, 15601,
+	1168, 1737, 71, -1000, -1000, -1000, 1524, -1000, 1524, 1524,
+	1524, 1524, 264, 264, 264, 264, -1000, -1000, -1000, -1000,
+	-1000, 1535, 1534, -1000, 1524, 1524, 1524, 1524, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1533, 1533, 1533, 1525, 1525,
+	339, -1000, 14521, 237, 33975, 1797, 956, 207, 348, 1566,
+	1050, 1050, 1050, 348, -1000, 1311, 1224, -1000, 1308, -1000,
+	-1000, 1879, -1000, -1000, 678, 755, 748, 642, 33975, 160,
+	255, -1000, 316, -1000, 33975, 1050, 711, 479, 1050, -1000,
+	1050, -1000, -1000, -1000, -1000, -1000, 1050, 1305, -1000, 1569,
+	794, 745, 784, 744, 1305, -1000, -1000, -105, 1305, -1000,
+	1305, -1000, 1305, -1000, 1305, -1000, 1305, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 649, 33975, 160, 655, -1000,
+	365, -1000, -1000, 655, 655, -1000, -1000, -1000, -1000, 1042,
+	1039, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -343, 33975,
+	-1000, 202, 621, 244, 279, 249, 33975, 134, 1815, 183,
+	229, 33975, 33975, 335, 1587, 33975, 1809, 33975, -1000, -1000,
+	-1000, -1000, 677, 33975, -1000, -1000, 736, 736, -1000, -1000,
+	33975, 736, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	736, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 33975, 33975,
+	-1000, -1000, -1000, -1000, -1000, 124, -15, 286, -1000, -1000,
+	-1000, -1000, -1000, 1840, -1000, 677, 700, 693, -1000, -1000,
+	-1000, 868, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 867,
+	15061, 15061, 15061, 1513, 404, 1529, 1425, 809, 797, 797,
+	857, 857, 569, 569, 569, 569, 569, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1405, -1000, 861, 1144, 1034, -1000,
+	1405, 1405, 1101, 13981, -1000, -1000, 659, -1000, 14521, 1034,
+	-1000, -1000, 1034, 1300, 1296, 1932, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1034, 13981, 13981,
+	1291, 1390, 501, -1000, 1405, 1034, 1034, 1405, 1405, -146,
+	-146, -146, -146, -146, 967, 1125, 1120, 967, 967, 1274,
+	9090, 1034, -1000, 33975, -1000, -284, -1000, -28, 513, 1390,
+	-1000, 24249, 1034, 1219, -1000, -1000, -1000, -1000, -1000, 21009,
+	1509, 1813, -1000, -1000, 1390, 1428, -1000, -1000, 264, 24,
+	836, 677, 677, 14521, -1000, -1000, -1000, -1000, -1000, -1000,
+	499, 290, 1390, -1000, 1560, 1688, -1000, -1000, -1000, 1821,
+	18309, 33975, 1522, 1517, -1000, 498, -1000, 1286, -40, -20,
+	-1000, -1000, -1000, -1000, 677, -1000, 1193, 258, 287, -1000,
+	332, -1000, -1000, -1000, -1000, 1171, 1820, 1734, 30, -1000,
+	-1000, -1000, 264, 264, -1000, -1000, -1000, -1000, -1000, -1000,
+	1037, 1037, -1000, -1000, -1000, -1000, -1000, 954, -1000, -1000,
+	-1000, 937, -1000, -1000, 1210, 1621, 237, -1000, -1000, 620,
+	1032, 1745, 33975, -1000, -1000, 1153, 202, 33975, 690, 1583,
+	-1000, 1566, 1566, 1566, 33975, -1000, -1000, -1000, -1000, 319,
+	33975, 1420, -1000, 148, -1000, 1150, 33975, -1000, 1413, 1531,
+	1050, 1050, -1000, -1000, -1000, 33975, 1390, -1000, -1000, -1000,
+	-1000, 395, 1777, 1762, 160, 148, 523, 1050, -1000, -1000,
+	-1000, -1000, -1000, -349, 1408, 373, 163, 239, 33975, 33975,
+	33975, 33975, 33975, 460, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 224, 364, -1000, 33975, 33975, 467, -1000, -1000,
+	-1000, 725, -1000, -1000, 725, -1000, -1000, -1000, -1000, -1000,
+	1758, 33975, -16, -320, -1000, -317, -1000, -1000, -1000, -1000,
+	1332, 357, 1529, 15061, 15061, 13981, -97, 245, 245, 655,
+	-1000, -1000, -1000, 14521, 14521, 1339, 639, -1000, 14521, 724,
+	-1000, -1000, 14521, 14521, 14521, -146, 1405, 1405, 13981, 9090,
+	-1000, -1000, -1000, -1000, -1000, -1000, 23709, -1000, -1000, -1000,
+	-1000, -146, 45, 14521, 45, 14521, 45, 45, 14521, -1000,
+	-1000, -1000, -1000, -1000, 387, 381, 379, 33975, -1000, -1000,
+	1841, -1000, 1676, 1675, 1930, 1918, -1000, 23709, 1813, -1000,
+	-1000, 33975, -279, -1000, 1704, 1722, -1000, -1000, -1000, -1000,
+	7446, 1582, 33975, 1390, -1000, 17222, 33975, 33975, 23709, 23709,
+	23709, 23709, 23709, -1000, 1653, 1651, -1000, 1641, 1634, 1613,
+	33975, -1000, 1403, 1034, 1883, 18309, 19929, 1244, 23709, -1000,
+	-1000, 23709, 33975, 6898, -1000, -1000, -19, -42, -1000, -1000,
+	-1000, -1000, 1869, 2444, -1000, -1000, -1000, -1000, 780, 5925,
+	1944, -1000, 1028, -1000, 900, -1000, 710, 708, -1000, 33975,
+	1527, -1000, -1000, -1000, -1000, -1000, 1401, -1000, 1379, 1282,
+	1377, 98, -1000, 1558, 1756, 620, 620, -1000, 932, -1000,
+	1050, -1000, -1000, 372, -1000, 1800, 33975, 1580, 1579, 1576,
+	-1000, 1868, 1246, 33975, -1000, -1000, 33975, -1000, 1674, 237,
+	33975, -1000, -1000, -1000, 255, 33975, -1000, 5573, 148, -1000,
+	-1000, -1000, -1000, -1000, -1000, 33975, 191, -1000, 1526, 984,
+	-1000, 1523, -1000, -1000, -1000, -1000, 152, 241, -1000, 33975,
+	454, 1621, 33975, -1000, -1000, -1000, 736, 736, -1000, -1000,
+	1754, -1000, 1050, 15061, 15061, -1000, 511, -1000, 1390, 1034,
+	1524, 1524, -1000, 1524, 1525, -1000, 1524, 90, 1524, 84,
+	1034, 1034, 1009, 811, -88, -1000, 677, 14521, 1137, 806,
+	967, -1000, -1000, -1000, -1000, 1034, -1000, -1000, 17762, -1000,
+	-146, -165, -171, 979, -146, 909, -146, -146, 967, 1390,
+	1390, 1390, 1342, 33975, -1000, -1000, -1000, -1000, 1918, 1898,
+	14521, 1279, -1000, -1000, 24, 329, -1000, 1712, 1722, -1000,
+	1864, 1700, 1855, -1000, -1000, -1000, 1785, 1194, -1000, 617,
+	1215, -1000, -1000, 13441, 1357, 1672, 497, 1342, 1506, 1688,
+	1564, 1575, 1730, -1000, -1000, -1000, -1000, 1637, -1000, 1614,
+	-1000, -1000, 1516, -1000, -1000, 1217, 256, 23709, 1445, 1445,
+	-1000, 474, -1000, -1000, -1000, -1000, -364, -1000, -1000, 14521,
+	-1000, -1000, -1000, -1000, -1000, -1000, 881, 881, 268, -1000,
+	-1000, -1000, -1000, -1000, 1521, 14521, 264, 1027, 264, 931,
+	-1000, 908, -1000, -1000, -221, -1000, -1000, 1616, 1630, -1000,
+	-1000, 33975, -1000, -1000, 33975, 33975, 33975, 33975, -1000, -1000,
+	251, -1000, 1324, 1320, -1000, -136, -1000, 14521, -1000, 1516,
+	-1000, -1000, -1000, 1142, -1000, -109, 33975, 33975, 33975, 33975,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 511,
+	15061, -1000, -1000, 336, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 14521, -1000, 14521, -1000, 1844, 1026, 677, 14521,
+	14521, -1000, -1000, 1034, -1000, 167, -1000, -246, 1889, 196,
+	1854, -1000, -1000, -1000, -1000, -1000, 45, 14521, -1000, 45,
+	14521, -1000, -1000, 1921, 20469, 23169, 23169, 19929, -1000, 1898,
+	1896, 1851, 677, 1694, 1696, 1696, 1712, -1000, 1850, 1848,
+	-1000, 1025, 1847, 1021, 706, -1000, 33975, 14521, 1390, -1000,
+	301, 33975, 1390, 33975, -1000, 1889, -1000, -1000, 14521, 1520,
+	-1000, 14521, -1000, -1000, -1000, -1000, -1000, 1918, 1445, -1000,
+	-1000, 570, 50, 289, -1000, -1000, -1000, 967, -1000, -1000,
+	-1000, 33975, 1179, -1000, -1000, -1000, 1139, 1106, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1516, -1000, -1000, -1000,
+	1246, 248, 308, -1000, 255, -1000, -143, -144, 967, 1817,
+	-1000, -1000, 9090, -1000, -1000, 1508, 1565, -1000, 295, -1000,
+	-1000, 967, 967, 1034, -1000, 967, 967, -1000, -1000, -1000,
+	-1000, -246, -1000, 211, -154, -155, -159, -1000, -1000, 15061,
+	14521, -146, 967, -146, 967, 45, 159, 1303, -1000, -1000,
+	-1000, 1303, 1303, 513, 1896, -148, 14521, 14521, 1691, 834,
+	-1000, -1000, -1000, -1000, 1017, 1014, -1000, 911, -1000, 1943,
+	-1000, 677, -1000, 1390, -1000, 472, 1215, -1000, 1844, 677,
+	33975, 677, 1889, -1000, 1505, 1545, -355, 14521, 1449, -1000,
+	1289, -1000, -1000, -1000, 1816, 1390, -1000, -1000, -1000, -1000,
+	-1000, 315, 1241, -1000, 616, 33975, 33975, 1034, 260, -118,
+	-1000, -1000, -1000, -1000, -1000, -1000, 652, -1000, -1000, -1000,
+	-1000, -1000, 22629, 1219, -1000, 45, -1000, 45, -146, -1000,
+	-1000, 22089, -1000, -1000, -1000, -1000, -148, -1000, 33975, 677,
+	1219, -1000, 824, -1000, -1000, -1000, -1000, -1000, 33975, 1215,
+	33975, -1000, 1277, 1844, 14521, 1423, 613, -363, 907, 1110,
+	33975, 1574, 772, 315, 12901, -107, 9090, 6350, 1249, -1000,
+	-1000, 1659, -101, -125, 211, -1000, -146, -146, -1000, -1000,
+	-1000, -1000, 1202, -1000, 1814, -1000, -1000, 1186, -1000, -1000,
+	-1000, 1029, 33975, 842, 1382, 1846, -1000, -1000, 1238, 1573,
+	-1000, 1928, -1000, -1000, -1000, 767, 796, -1000, -1000, -1000,
+	-107, 967, 1034, -1000, -46, -1000, -1000, -1000, -1000, -1000,
+	1523, -1000, 1658, -1000, -1000, -1000, -1000, 33975, 1390, -355,
+	1205, -1000, -1000, 255, -361, -1000, -1000, 1942, 543, 543,
+	-1000, -1000, -1000, -1000, -1000, 337, -1000, -1000, -109, -110,
+	-1000, 17762, -363, -355, 1166, 47, -1000, -1000, -1000, 317,
+	894, -1000, 205, -1000, -120, 1034, 1382, -363, -1000, 1386,
+	1545, -1000, -1000, -1000, -1000, -130, -1000, -1000, 1382, 14521,
+	1361, -1000, -1000, 953, 33975, -370, 1133, -1000, 818, -370,
+	-1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 8, 2139, 5, 2, 3, 2138, 53, 77, 157,
-	13, 178, 83, 2137, 2131, 2129, 191, 190, 185, 2127,
-	2126, 2122, 2121, 2120, 2119, 2118, 2116, 2115, 2114, 187,
-	139, 154, 2109, 2108, 2105, 96, 142, 71, 66, 150,
-	2104, 2100, 56, 2099, 2098, 2097, 179, 172, 672, 2096,
-	158, 93, 2094, 2093, 2091, 2090, 2089, 2088, 2087, 2085,
-	2084, 2083, 2082, 2080, 2079, 2077, 228, 2076, 2075, 9,
-	2071, 62, 2067, 2066, 2065, 2064, 2062, 1, 2061, 2060,
-	2059, 2058, 120, 2057, 2056, 2055, 156, 2054, 2050, 363,
-	94, 97, 2049, 2048, 87, 153, 2047, 98, 137, 2046,
-	2045, 887, 2043, 65, 64, 2042, 47, 78, 63, 42,
-	2041, 2040, 2038, 72, 80, 2035, 86, 43, 2034, 73,
-	85, 2033, 44, 2032, 2030, 92, 2024, 2023, 2021, 82,
-	2019, 2018, 2737, 2016, 76, 113, 24, 37, 2015, 2014,
-	2012, 2011, 2010, 39, 2008, 2007, 2006, 119, 21, 2004,
-	23, 18, 29, 108, 2003, 40, 60, 1993, 116, 1991,
-	1990, 32, 25, 58, 1989, 19, 111, 131, 38, 75,
-	117, 1985, 1984, 33, 48, 1983, 1982, 1981, 1980, 1979,
-	1978, 50, 1977, 31, 1974, 160, 1973, 4, 28, 34,
-	41, 169, 45, 20, 1971, 161, 1968, 30, 134, 107,
-	138, 1967, 1965, 1964, 152, 229, 1963, 1961, 61, 146,
-	121, 129, 1960, 231, 1958, 1957, 74, 629, 1715, 15,
-	135, 1956, 1953, 2461, 114, 112, 35, 1950, 207, 1944,
-	1941, 1940, 159, 140, 91, 936, 95, 1939, 1936, 1933,
-	1926, 1921, 1920, 1919, 164, 163, 36, 89, 132, 55,
-	1917, 1915, 1913, 102, 69, 1909, 136, 133, 106, 144,
-	1903, 148, 124, 128, 1902, 90, 1899, 1897, 1896, 1895,
-	81, 1894, 1893, 1892, 1890, 130, 122, 101, 68, 1887,
-	70, 99, 126, 127, 11, 16, 27, 147, 14, 1885,
-	6, 0, 1883, 10, 141, 230, 145, 1882, 1881, 7,
-	1880, 12, 1879, 1878, 118, 1876, 1875, 1874, 22, 26,
-	17, 1873, 1870, 1869, 2750, 1988, 110, 1868, 162,
-}
-
-//line sql.y:5987
+	0, 12, 2228, 7, 2, 4, 2227, 69, 96, 192,
+	18, 180, 90, 2225, 2223, 2222, 199, 197, 193, 2221,
+	2219, 2217, 2216, 2215, 2213, 2212, 2211, 2210, 2209, 189,
+	155, 171, 2208, 2207, 2204, 111, 157, 81, 84, 176,
+	2202, 2201, 73, 2195, 2194, 2193, 156, 153, 741, 2192,
+	151, 110, 2191, 2190, 2188, 2187, 2186, 2185, 2184, 2183,
+	2180, 2179, 2174, 2169, 2168, 2166, 258, 2165, 2164, 15,
+	2163, 76, 2162, 2161, 2159, 2158, 2156, 5, 2155, 2154,
+	2153, 2149, 132, 2148, 2147, 2146, 198, 2145, 2144, 239,
+	118, 113, 2143, 2142, 95, 170, 2140, 112, 145, 2139,
+	2138, 1, 2136, 78, 77, 2134, 87, 39, 74, 58,
+	2131, 2125, 2123, 82, 65, 2118, 101, 68, 2117, 102,
+	97, 2116, 60, 2115, 2114, 126, 2113, 2112, 2111, 89,
+	2110, 2109, 3385, 2108, 86, 125, 28, 41, 2107, 2104,
+	2103, 2102, 2101, 54, 2099, 2098, 2097, 23, 2096, 8,
+	50, 2095, 36, 19, 2094, 2086, 2081, 31, 13, 25,
+	2080, 2079, 129, 29, 2077, 38, 34, 47, 123, 2076,
+	37, 72, 2075, 127, 2073, 2072, 43, 33, 30, 2071,
+	11, 119, 146, 26, 88, 130, 2069, 2067, 42, 66,
+	2058, 2057, 2056, 2055, 2054, 2052, 63, 2051, 40, 2048,
+	179, 2047, 3, 44, 48, 55, 140, 62, 27, 2046,
+	160, 2045, 56, 165, 120, 152, 2044, 2043, 2040, 167,
+	229, 2039, 2037, 75, 169, 133, 144, 2035, 230, 2034,
+	2033, 108, 1401, 2021, 24, 154, 2032, 2031, 4077, 134,
+	131, 53, 2030, 268, 2029, 2028, 2026, 173, 166, 107,
+	1262, 99, 2025, 2024, 2020, 2019, 2018, 2017, 2016, 98,
+	172, 61, 106, 158, 71, 2011, 2009, 2008, 116, 94,
+	2006, 148, 147, 117, 137, 2005, 150, 135, 109, 2003,
+	85, 2001, 2000, 1997, 1995, 91, 1992, 1985, 1983, 1979,
+	149, 142, 114, 83, 1978, 80, 115, 143, 136, 14,
+	10, 35, 161, 20, 1973, 6, 0, 1972, 17, 163,
+	274, 138, 1971, 1970, 9, 1969, 16, 1968, 1965, 128,
+	1963, 1962, 1959, 22, 32, 21, 1958, 1957, 1956, 3454,
+	2387, 121, 1939, 175,
+}
+
+//line sql.y:6256
 type yySymType struct {
 	union             interface{}
 	empty             struct{}
@@ -5029,6 +5210,21 @@ func (st *yySymType) exprsUnion() Exprs {
 	return v
 }
 
+func (st *yySymType) frameClauseUnion() *FrameClause {
+	v, _ := st.union.(*FrameClause)
+	return v
+}
+
+func (st *yySymType) framePointUnion() *FramePoint {
+	v, _ := st.union.(*FramePoint)
+	return v
+}
+
+func (st *yySymType) frameUnitUnion() FrameUnitType {
+	v, _ := st.union.(FrameUnitType)
+	return v
+}
+
 func (st *yySymType) ignoreUnion() Ignore {
 	v, _ := st.union.(Ignore)
 	return v
@@ -5149,6 +5345,11 @@ func (st *yySymType) orderDirectionUnion() OrderDirection {
 	return v
 }
 
+func (st *yySymType) overClauseUnion() *OverClause {
+	v, _ := st.union.(*OverClause)
+	return v
+}
+
 func (st *yySymType) partDefUnion() *PartitionDefinition {
 	v, _ := st.union.(*PartitionDefinition)
 	return v
@@ -5324,90 +5525,105 @@ func (st *yySymType) whensUnion() []*When {
 	return v
 }
 
+func (st *yySymType) windowDefinitionUnion() *WindowDefinition {
+	v, _ := st.union.(*WindowDefinition)
+	return v
+}
+
+func (st *yySymType) windowDefinitionsUnion() WindowDefinitions {
+	v, _ := st.union.(WindowDefinitions)
+	return v
+}
+
+func (st *yySymType) windowSpecUnion() *WindowSpecification {
+	v, _ := st.union.(*WindowSpecification)
+	return v
+}
+
 func (st *yySymType) withUnion() *With {
 	v, _ := st.union.(*With)
 	return v
 }
 
 var yyR1 = [...]int{
-	0, 312, 313, 313, 6, 6, 6, 6, 6, 6,
+	0, 327, 328, 328, 6, 6, 6, 6, 6, 6,
 	6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
 	6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-	6, 6, 6, 6, 291, 291, 291, 294, 294, 27,
+	6, 6, 6, 6, 306, 306, 306, 309, 309, 27,
 	61, 29, 29, 28, 28, 31, 31, 30, 7, 7,
 	7, 8, 8, 8, 8, 8, 8, 8, 8, 9,
 	9, 9, 9, 9, 10, 10, 10, 10, 12, 12,
 	12, 12, 12, 15, 11, 11, 16, 16, 73, 73,
-	17, 18, 18, 18, 18, 316, 316, 127, 127, 125,
-	125, 126, 126, 190, 190, 19, 20, 20, 200, 200,
-	199, 199, 199, 201, 201, 201, 201, 235, 235, 21,
-	21, 21, 21, 21, 87, 87, 293, 293, 292, 290,
-	290, 289, 289, 288, 33, 34, 43, 43, 43, 43,
-	44, 45, 295, 295, 266, 49, 49, 48, 48, 48,
-	48, 50, 50, 47, 47, 46, 46, 268, 268, 255,
-	255, 267, 267, 267, 267, 267, 267, 267, 254, 254,
-	100, 100, 171, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 307, 307, 172, 172, 172, 172, 172,
-	172, 105, 105, 113, 113, 113, 113, 103, 103, 104,
+	17, 18, 18, 18, 18, 331, 331, 127, 127, 125,
+	125, 126, 126, 205, 205, 19, 20, 20, 215, 215,
+	214, 214, 214, 216, 216, 216, 216, 250, 250, 21,
+	21, 21, 21, 21, 87, 87, 308, 308, 307, 305,
+	305, 304, 304, 303, 33, 34, 43, 43, 43, 43,
+	44, 45, 310, 310, 281, 49, 49, 48, 48, 48,
+	48, 50, 50, 47, 47, 46, 46, 283, 283, 270,
+	270, 282, 282, 282, 282, 282, 282, 282, 269, 269,
+	100, 100, 186, 186, 186, 186, 186, 186, 186, 186,
+	186, 186, 186, 322, 322, 187, 187, 187, 187, 187,
+	187, 105, 105, 113, 113, 113, 113, 103, 103, 104,
 	102, 102, 102, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 311, 311, 311, 311, 311,
-	311, 311, 311, 311, 311, 311, 311, 311, 311, 311,
-	311, 311, 311, 311, 311, 311, 311, 311, 311, 311,
-	311, 311, 311, 311, 311, 311, 311, 311, 311, 311,
-	311, 311, 311, 311, 311, 311, 112, 112, 109, 109,
-	110, 110, 110, 308, 308, 308, 308, 237, 237, 237,
-	237, 240, 240, 238, 238, 238, 238, 238, 238, 238,
-	238, 238, 239, 239, 239, 239, 239, 241, 241, 241,
-	241, 241, 242, 242, 242, 242, 242, 242, 242, 242,
-	242, 242, 242, 242, 242, 242, 242, 243, 243, 243,
-	243, 243, 243, 243, 243, 253, 253, 244, 244, 248,
-	248, 249, 249, 249, 250, 250, 250, 251, 251, 246,
-	246, 246, 246, 247, 247, 247, 256, 280, 280, 279,
-	279, 277, 277, 277, 277, 265, 265, 274, 274, 274,
-	274, 274, 264, 264, 260, 260, 260, 261, 261, 262,
-	262, 259, 259, 263, 263, 276, 276, 275, 257, 257,
-	258, 258, 282, 309, 309, 309, 309, 310, 310, 283,
-	300, 301, 299, 299, 299, 299, 299, 71, 71, 71,
-	212, 212, 212, 272, 272, 271, 271, 271, 273, 273,
-	270, 270, 270, 270, 270, 270, 270, 270, 270, 270,
-	270, 270, 270, 270, 270, 270, 270, 270, 270, 270,
-	270, 270, 270, 270, 270, 270, 270, 270, 207, 207,
-	207, 298, 298, 298, 298, 298, 298, 297, 297, 297,
-	269, 269, 269, 296, 296, 51, 51, 156, 156, 40,
+	108, 108, 108, 108, 108, 326, 326, 326, 326, 326,
+	326, 326, 326, 326, 326, 326, 326, 326, 326, 326,
+	326, 326, 326, 326, 326, 326, 326, 326, 326, 326,
+	326, 326, 326, 326, 326, 326, 326, 326, 326, 326,
+	326, 326, 326, 326, 326, 326, 112, 112, 109, 109,
+	110, 110, 110, 323, 323, 323, 323, 252, 252, 252,
+	252, 255, 255, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 254, 254, 254, 254, 254, 256, 256, 256,
+	256, 256, 257, 257, 257, 257, 257, 257, 257, 257,
+	257, 257, 257, 257, 257, 257, 257, 258, 258, 258,
+	258, 258, 258, 258, 258, 268, 268, 259, 259, 263,
+	263, 264, 264, 264, 265, 265, 265, 266, 266, 261,
+	261, 261, 261, 262, 262, 262, 271, 295, 295, 294,
+	294, 292, 292, 292, 292, 280, 280, 289, 289, 289,
+	289, 289, 279, 279, 275, 275, 275, 276, 276, 277,
+	277, 274, 274, 278, 278, 291, 291, 290, 272, 272,
+	273, 273, 297, 324, 324, 324, 324, 325, 325, 298,
+	315, 316, 314, 314, 314, 314, 314, 71, 71, 71,
+	227, 227, 227, 287, 287, 286, 286, 286, 288, 288,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 222, 222,
+	222, 313, 313, 313, 313, 313, 313, 312, 312, 312,
+	284, 284, 284, 311, 311, 51, 51, 171, 171, 40,
 	40, 40, 40, 40, 40, 39, 39, 39, 35, 35,
 	35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
 	35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
 	35, 41, 41, 36, 36, 36, 36, 36, 36, 36,
 	36, 36, 22, 22, 22, 22, 22, 22, 22, 22,
 	22, 22, 22, 22, 22, 22, 22, 22, 22, 22,
-	22, 22, 75, 75, 75, 75, 77, 77, 77, 285,
-	285, 1, 1, 5, 5, 2, 2, 76, 76, 3,
-	3, 4, 4, 287, 287, 287, 287, 287, 287, 287,
-	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
-	287, 287, 287, 287, 287, 252, 252, 252, 284, 284,
-	286, 286, 23, 32, 32, 24, 24, 24, 24, 25,
+	22, 22, 75, 75, 75, 75, 77, 77, 77, 300,
+	300, 1, 1, 5, 5, 2, 2, 76, 76, 3,
+	3, 4, 4, 302, 302, 302, 302, 302, 302, 302,
+	302, 302, 302, 302, 302, 302, 302, 302, 302, 302,
+	302, 302, 302, 302, 302, 267, 267, 267, 299, 299,
+	301, 301, 23, 32, 32, 24, 24, 24, 24, 25,
 	25, 52, 53, 53, 53, 53, 53, 53, 53, 53,
 	53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
 	53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
 	53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
 	53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
-	302, 302, 206, 206, 214, 214, 205, 205, 228, 228,
-	228, 208, 208, 208, 209, 209, 306, 306, 306, 54,
-	54, 56, 56, 57, 58, 58, 230, 230, 231, 231,
+	317, 317, 221, 221, 229, 229, 220, 220, 243, 243,
+	243, 223, 223, 223, 224, 224, 321, 321, 321, 54,
+	54, 56, 56, 57, 58, 58, 245, 245, 246, 246,
 	59, 60, 72, 72, 72, 72, 72, 72, 74, 74,
 	74, 14, 14, 14, 14, 68, 68, 68, 13, 13,
-	55, 55, 62, 303, 303, 304, 305, 305, 305, 305,
+	55, 55, 62, 318, 318, 319, 320, 320, 320, 320,
 	63, 65, 26, 26, 26, 26, 26, 26, 93, 93,
 	82, 82, 82, 82, 82, 82, 82, 82, 82, 82,
-	82, 82, 88, 88, 88, 83, 83, 317, 66, 67,
+	82, 82, 88, 88, 88, 83, 83, 332, 66, 67,
 	67, 86, 86, 86, 80, 80, 80, 85, 85, 85,
 	90, 90, 92, 92, 92, 92, 92, 94, 94, 94,
 	94, 94, 94, 94, 89, 89, 91, 91, 91, 91,
-	221, 221, 221, 220, 220, 116, 116, 118, 117, 117,
-	119, 119, 120, 120, 120, 154, 135, 135, 188, 188,
-	187, 187, 189, 189, 189, 189, 191, 191, 121, 121,
-	121, 121, 122, 122, 123, 123, 124, 124, 229, 229,
-	226, 226, 226, 225, 225, 128, 128, 128, 130, 129,
+	236, 236, 236, 235, 235, 116, 116, 118, 117, 117,
+	119, 119, 120, 120, 120, 169, 135, 135, 203, 203,
+	202, 202, 204, 204, 204, 204, 206, 206, 121, 121,
+	121, 121, 122, 122, 123, 123, 124, 124, 244, 244,
+	241, 241, 241, 240, 240, 128, 128, 128, 130, 129,
 	129, 129, 129, 131, 131, 133, 133, 132, 132, 134,
 	136, 136, 136, 136, 136, 137, 137, 101, 101, 101,
 	101, 101, 101, 111, 111, 111, 111, 114, 114, 114,
@@ -5415,77 +5631,82 @@ var yyR1 = [...]int{
 	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
 	106, 107, 107, 107, 107, 107, 107, 107, 107, 107,
 	107, 107, 107, 107, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 203, 203, 138, 138, 146, 146,
-	146, 146, 139, 139, 139, 139, 139, 139, 139, 147,
-	147, 147, 153, 148, 148, 144, 144, 144, 144, 142,
+	107, 107, 107, 107, 107, 218, 218, 138, 138, 161,
+	161, 161, 161, 139, 139, 139, 139, 139, 139, 139,
+	162, 162, 162, 168, 163, 163, 144, 144, 144, 144,
+	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
+	146, 146, 146, 146, 146, 157, 157, 157, 156, 156,
+	156, 148, 148, 147, 147, 149, 149, 150, 150, 150,
+	151, 152, 152, 152, 155, 155, 153, 153, 153, 153,
+	153, 154, 154, 154, 159, 159, 160, 160, 158, 142,
 	142, 142, 142, 142, 142, 142, 142, 142, 142, 143,
 	143, 143, 143, 143, 143, 143, 143, 79, 79, 79,
 	79, 79, 79, 79, 79, 79, 79, 79, 79, 78,
-	78, 78, 78, 78, 78, 78, 78, 78, 318, 318,
-	245, 245, 245, 145, 145, 145, 145, 145, 84, 84,
-	84, 84, 84, 234, 234, 234, 236, 236, 236, 236,
-	236, 236, 236, 236, 236, 236, 236, 236, 236, 159,
-	159, 81, 81, 157, 157, 158, 160, 160, 155, 155,
-	155, 141, 141, 141, 161, 161, 162, 162, 163, 163,
-	165, 164, 164, 166, 167, 167, 167, 168, 168, 169,
-	169, 169, 42, 42, 42, 42, 42, 37, 37, 37,
+	78, 78, 78, 78, 78, 78, 78, 78, 333, 333,
+	260, 260, 260, 145, 145, 145, 145, 145, 84, 84,
+	84, 84, 84, 249, 249, 249, 251, 251, 251, 251,
+	251, 251, 251, 251, 251, 251, 251, 251, 251, 174,
+	174, 81, 81, 172, 172, 173, 175, 175, 170, 170,
+	170, 141, 141, 141, 176, 176, 177, 177, 178, 178,
+	180, 179, 179, 181, 182, 182, 182, 183, 183, 184,
+	184, 184, 42, 42, 42, 42, 42, 37, 37, 37,
 	37, 38, 38, 38, 95, 95, 95, 95, 97, 97,
 	96, 96, 69, 69, 70, 70, 70, 98, 98, 99,
-	99, 99, 99, 185, 185, 170, 170, 170, 177, 177,
-	177, 173, 173, 175, 175, 175, 176, 176, 176, 174,
-	182, 182, 184, 184, 183, 183, 179, 179, 180, 180,
-	181, 181, 181, 178, 178, 140, 140, 140, 140, 140,
-	186, 186, 186, 186, 192, 192, 150, 150, 152, 152,
-	151, 115, 193, 193, 197, 194, 194, 198, 198, 198,
-	198, 198, 195, 195, 196, 196, 222, 222, 222, 202,
-	202, 213, 213, 210, 210, 211, 211, 204, 204, 215,
-	215, 215, 64, 149, 149, 281, 281, 278, 218, 218,
-	219, 219, 223, 223, 227, 227, 224, 224, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 217,
-	217, 217, 217, 217, 217, 217, 217, 217, 217, 314,
-	315, 232, 233, 233, 233,
+	99, 99, 99, 200, 200, 185, 185, 185, 192, 192,
+	192, 188, 188, 190, 190, 190, 191, 191, 191, 189,
+	197, 197, 199, 199, 198, 198, 194, 194, 195, 195,
+	196, 196, 196, 193, 193, 140, 140, 140, 140, 140,
+	201, 201, 201, 201, 207, 207, 165, 165, 167, 167,
+	166, 115, 208, 208, 212, 209, 209, 213, 213, 213,
+	213, 213, 210, 210, 211, 211, 237, 237, 237, 217,
+	217, 228, 228, 225, 225, 226, 226, 219, 219, 230,
+	230, 230, 64, 164, 164, 296, 296, 293, 233, 233,
+	234, 234, 238, 238, 242, 242, 239, 239, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 329, 330, 247, 248, 248, 248,
 }
 
 var yyR2 = [...]int{
@@ -5496,7 +5717,7 @@ var yyR2 = [...]int{
 	3, 2, 3, 0, 1, 3, 1, 4, 3, 3,
 	4, 3, 2, 3, 4, 3, 4, 2, 7, 1,
 	3, 3, 3, 3, 1, 2, 1, 1, 3, 2,
-	3, 3, 2, 5, 9, 8, 7, 8, 1, 1,
+	3, 3, 2, 5, 10, 9, 7, 8, 1, 1,
 	10, 11, 9, 8, 8, 1, 1, 1, 3, 1,
 	3, 1, 3, 0, 4, 3, 5, 4, 1, 3,
 	3, 2, 2, 2, 2, 2, 1, 1, 1, 2,
@@ -5572,11 +5793,16 @@ var yyR2 = [...]int{
 	2, 3, 1, 3, 4, 3, 1, 3, 4, 5,
 	6, 3, 4, 5, 6, 3, 4, 1, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	1, 1, 1, 1, 1, 3, 1, 1, 2, 2,
-	2, 2, 1, 1, 2, 9, 6, 6, 6, 2,
-	2, 3, 3, 3, 0, 3, 1, 1, 1, 2,
-	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 4, 5, 5, 6, 4,
+	1, 1, 1, 1, 1, 1, 3, 1, 1, 2,
+	2, 2, 2, 1, 1, 2, 9, 6, 6, 6,
+	2, 2, 3, 3, 3, 0, 3, 1, 1, 1,
+	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 5, 5, 5, 6,
+	4, 4, 4, 4, 4, 5, 6, 8, 10, 6,
+	8, 10, 6, 6, 9, 0, 2, 2, 0, 2,
+	2, 0, 1, 2, 4, 1, 2, 1, 2, 3,
+	3, 0, 2, 5, 1, 1, 2, 2, 2, 2,
+	2, 1, 1, 3, 0, 2, 1, 3, 5, 4,
 	4, 8, 6, 8, 6, 8, 5, 4, 2, 2,
 	1, 2, 2, 2, 8, 8, 6, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -5644,258 +5870,273 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 0, 0, 1, 1,
+	1, 1, 1, 1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -312, -6, -10, -15, -16, -17, -18, -19, -20,
+	-1000, -327, -6, -10, -15, -16, -17, -18, -19, -20,
 	-21, -22, -23, -24, -25, -52, -53, -54, -56, -57,
 	-58, -59, -60, -13, -55, -26, -27, -61, -62, -63,
 	-64, -65, -8, -7, -12, 7, -73, -28, 29, -33,
-	-43, 201, -44, -34, 202, -45, 204, 203, 239, 205,
-	232, 71, 279, 280, 282, 283, 284, 285, -74, 237,
-	238, 207, 33, 42, 30, 31, 34, 210, -9, -29,
-	6, -314, 8, 403, 234, 233, 25, -11, 416, 82,
-	-313, 550, -185, -170, 19, 30, 26, -169, -165, -86,
-	-170, 17, 15, 5, -66, -317, -66, 9, 10, -66,
-	-266, -268, 82, 138, 82, -66, -49, -48, -47, -46,
-	-50, 28, -40, -41, -287, -39, -36, 206, 203, 247,
-	105, 106, 239, 240, 241, 205, 223, 238, 242, 237,
-	256, -35, 77, 30, 416, 419, -273, 202, 208, 209,
-	404, 108, 107, 72, 204, -270, 329, 520, -50, 522,
-	97, 99, 521, 41, 213, 523, 524, 525, 526, 222,
-	527, 528, 529, 530, 536, 537, 538, 539, 109, 5,
-	-66, -227, -223, -291, -217, 85, 86, 87, 413, 225,
-	447, 448, 272, 77, 38, 329, 520, 279, 293, 287,
-	314, 306, 414, 449, 275, 226, 260, 517, 304, 114,
-	522, 278, 450, 240, 334, 335, 336, 97, 282, 371,
-	535, 277, 451, 533, 99, 521, 76, 48, 41, 235,
-	302, 298, 523, 261, 452, 424, 254, 108, 105, 542,
-	33, 296, 47, 27, 532, 107, 46, 524, 129, 453,
-	525, 338, 319, 511, 45, 339, 241, 454, 80, 418,
-	519, 340, 297, 341, 271, 531, 207, 455, 503, 342,
-	343, 512, 456, 320, 324, 457, 363, 344, 549, 49,
-	458, 459, 513, 106, 460, 75, 526, 291, 292, 461,
-	269, 224, 365, 318, 222, 32, 345, 415, 273, 55,
-	248, 366, 43, 322, 546, 42, 507, 462, 510, 317,
-	313, 50, 463, 464, 465, 466, 527, 316, 290, 312,
-	541, 436, 266, 528, 59, 209, 326, 325, 327, 255,
-	362, 309, 467, 468, 469, 229, 78, 470, 299, 18,
-	471, 472, 346, 262, 473, 53, 474, 475, 369, 238,
-	476, 51, 529, 36, 243, 543, 530, 477, 478, 479,
-	480, 481, 348, 482, 347, 321, 323, 250, 349, 417,
-	483, 295, 242, 534, 484, 230, 518, 244, 247, 237,
-	370, 231, 485, 486, 487, 488, 489, 276, 490, 491,
-	283, 536, 40, 492, 493, 494, 495, 270, 265, 364,
-	373, 58, 79, 331, 496, 516, 289, 263, 497, 280,
-	52, 537, 538, 539, 257, 540, 7, 547, 548, 351,
-	109, 267, 268, 44, 310, 249, 498, 499, 300, 301,
-	315, 288, 311, 281, 504, 251, 352, 239, 500, 372,
-	264, 332, 421, 256, 353, 515, 420, 308, 305, 258,
-	501, 354, 214, 252, 253, 502, 505, 355, 356, 274,
-	357, 358, 359, 360, 361, 259, 419, 286, 303, 333,
-	384, 385, 386, 387, 388, 389, 390, 391, 392, 393,
-	394, 395, 396, 397, 398, 399, 400, 401, 212, -66,
-	212, -132, -223, 212, -195, 335, -214, 337, 350, 345,
-	355, 343, -206, 346, 348, 250, -306, 363, 212, 352,
-	201, 155, 338, 347, 356, 357, 274, 358, 361, 259,
-	-302, -291, 525, 540, 114, 307, 342, 340, 364, 507,
-	360, 359, -223, 281, -230, 286, -218, -291, -217, 284,
-	-132, -72, 503, 205, -232, -232, -88, 507, 509, -148,
-	-101, 122, -111, -114, -106, -107, -142, -143, -144, -145,
-	-112, -155, 144, 145, 152, 123, -153, -115, 23, 412,
-	405, 404, 155, 28, 197, 65, 66, 407, 408, 125,
-	54, 380, 381, -113, 376, 382, 377, 410, 411, 100,
-	-218, -223, 217, 375, 211, 150, 403, -108, -104, -7,
-	-151, 374, 378, 379, 383, -291, -217, -110, -109, -138,
-	88, 93, 98, 94, -311, 101, -314, 89, 90, 91,
-	92, 102, 103, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 175, 176, 177, 178, 179, 180, 181, 182,
-	183, 184, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 41, 351, 351, -132, -66, -163,
-	-86, -165, -9, -7, -314, 6, -66, -7, -8, -12,
-	-29, -31, 443, -30, -223, -170, -185, 9, 140, 39,
-	47, -168, -169, -11, -7, -101, 16, 20, 21, -91,
-	146, -101, -223, -67, -204, 216, -66, -66, -194, -235,
-	281, -198, 364, 363, -219, -196, -218, -216, -195, 362,
-	206, 422, 121, 22, 24, 124, 155, 16, 125, 34,
-	208, 307, 223, 154, 219, 404, 201, 69, 423, 376,
-	377, 374, 380, 406, 407, 375, 337, 28, 10, 425,
-	25, 233, 21, 35, 148, 203, 128, 236, 23, 234,
-	100, 103, 428, 19, 72, 228, 11, 221, 37, 13,
-	429, 430, 14, 217, 216, 140, 213, 67, 8, 197,
-	26, 137, 63, 431, 116, 432, 433, 434, 435, 65,
-	138, 17, 544, 378, 379, 30, 508, 412, 245, 150,
-	70, 56, 509, 122, 437, 438, 101, 439, 104, 73,
-	514, 118, 15, 68, 39, 440, 246, 441, 218, 545,
-	442, 367, 443, 139, 204, 403, 66, 444, 211, 350,
-	6, 409, 29, 232, 220, 111, 64, 445, 212, 127,
-	410, 411, 215, 102, 5, 115, 31, 9, 71, 74,
-	381, 382, 383, 54, 110, 416, 126, 12, 446, 368,
-	120, 114, -267, 138, -254, -258, -218, 227, -283, 223,
-	-132, -276, -275, -218, -87, -213, 213, 221, 220, 115,
-	-295, 118, 267, 375, 211, -46, -47, -195, 154, 244,
-	83, 83, -258, -257, -256, -296, 246, 227, -282, -274,
-	219, 228, -264, 220, 221, -259, 213, 116, -296, -259,
-	218, 228, 246, 246, 109, 246, 109, 246, 246, 246,
-	246, 246, 246, 246, 246, 246, 243, -265, 130, -265,
-	420, 420, -270, -296, -296, -296, 215, 32, 32, -215,
-	-259, 215, 22, -265, -265, -195, 154, -265, -265, -265,
-	-265, 254, 254, -265, -265, -265, -265, -265, -265, -265,
-	-265, -265, -265, -265, -265, -265, -265, -265, 212, -295,
-	-95, 361, 274, 77, -48, 256, -32, -132, -213, 213,
-	214, -295, -132, 198, -132, -208, 138, 12, -208, -205,
-	351, 349, 336, 341, -208, -208, -208, -208, 257, 334,
-	-260, 213, 32, 224, 351, 257, 334, 257, 258, 257,
-	258, 344, 354, 257, -228, 11, 140, 375, 339, 343,
-	250, 212, 251, 214, 353, -291, 510, 258, -228, 89,
-	-209, 138, 351, 253, -208, -233, -314, -219, 307, -233,
-	-233, 29, 215, -218, -68, -218, 89, -14, -10, -17,
-	-16, -18, 130, -93, 351, -82, 155, 525, 511, 512,
-	513, 510, 348, 518, 516, 514, 257, 515, 83, 118,
-	120, 121, -101, 137, -139, 130, 131, 132, 133, 134,
-	135, 136, 140, 122, 124, 138, 139, 119, 141, 142,
-	143, 144, 145, 146, 147, 149, 148, 150, 151, 154,
-	199, 200, -107, -107, -107, -107, -153, -314, -314, -314,
-	-107, -203, -314, -107, -314, -314, -314, -314, -159, -101,
-	-314, -318, -314, -318, -318, -245, -314, -245, -314, -314,
-	-314, -314, 198, -314, -314, -314, -314, -314, -245, -245,
-	-245, -245, 98, 93, 88, -155, 94, 89, -218, -223,
-	-7, -8, -148, -232, -303, -304, -135, -132, -314, 274,
-	-168, -11, -7, -163, -169, -165, -7, -66, -80, -92,
-	60, 61, -94, 21, 35, 64, 62, 20, -315, 84,
-	-315, -185, -315, 83, -31, -188, 82, 58, 40, 89,
-	89, 83, 18, -164, -166, -101, 11, -221, -220, 22,
-	-218, 89, 198, 96, -133, 26, -132, -204, -204, 83,
-	281, -198, -235, -200, -199, 365, 367, 130, -222, -218,
-	89, 28, 84, 83, -132, -237, -240, -242, -241, -243,
-	-238, -239, 304, 305, 155, 308, 310, 311, 312, 313,
-	314, 315, 316, 317, 318, 319, 29, 235, 300, 301,
-	302, 303, 320, 321, 322, 323, 324, 325, 326, 327,
-	287, 306, 414, 288, 289, 290, 291, 292, 293, 295,
-	296, 297, 298, 299, -294, -291, 82, 84, 83, -244,
-	82, -95, 212, -291, 213, 213, 213, -66, 403, -265,
-	-265, 243, -39, -36, -287, 15, -35, -36, 206, 105,
-	106, 203, 82, -254, 82, -263, -294, -291, 82, 116,
-	218, 115, -262, -259, -262, -263, -291, -155, -291, 116,
-	116, -191, -218, -191, -191, 20, -191, 20, -191, 20,
-	91, -218, -191, 20, -191, 20, -191, 20, -191, 20,
-	-191, 20, 28, 75, 76, 28, 78, 79, 80, -155,
-	-155, -254, -195, -132, -291, 91, 91, -265, -265, 91,
-	89, 89, 89, -265, -265, 91, 89, -225, -223, 89,
-	-297, 229, 271, 273, 91, 91, 91, 91, 28, 89,
-	-298, 28, 532, 531, 533, 534, 535, 91, 28, 91,
-	28, 91, -218, 82, -132, -98, 261, 201, 203, 206,
-	73, 89, 275, 130, 41, 83, 215, 212, -291, -210,
-	217, -210, -224, -223, -216, 89, -101, -261, 11, 140,
-	-228, -228, -208, -132, -261, -228, -208, -132, -208, -208,
-	-208, -208, -228, -228, -228, -208, -223, -223, -132, -132,
-	-132, -132, -132, -132, -132, -233, -233, -233, -209, -208,
-	510, 89, 73, -231, 284, 318, 504, 505, 506, 83,
-	416, -125, -132, 510, 510, 510, 510, 510, 510, -101,
-	-101, -101, -101, -146, 101, 122, 102, 103, -114, -147,
-	-151, -153, 95, 140, 124, 138, 139, -106, -107, -106,
-	-106, -106, -106, -106, -106, -106, -106, -106, -106, -106,
-	-106, -106, -234, -291, 89, 155, 89, 89, -89, -91,
-	-101, -101, -291, -218, -89, -89, -101, -85, 21, 35,
-	-157, -158, 126, -155, -315, -315, 91, -218, -218, -79,
-	-78, 385, 386, 387, 388, 390, 391, 392, 395, 396,
-	400, 401, 384, 402, 389, 394, 397, 398, 399, 393,
-	303, -90, 21, 35, -89, -219, -224, -216, -89, -90,
-	-90, -89, -89, 198, -185, -315, 83, -305, 367, 368,
-	508, -226, 246, -225, 22, -149, -148, 89, -168, -168,
-	60, 61, 56, -89, -94, -315, -30, 22, -187, -218,
-	59, 89, -246, -195, -101, -101, 83, -167, 24, 25,
-	-132, -220, 146, -224, -190, 246, -132, -117, -119, -120,
-	-121, -135, -154, -314, 11, -125, -126, -134, -223, -198,
-	-200, 83, 366, 368, 369, 73, 104, -101, -247, 154,
-	-272, -271, -270, -254, -256, -257, -258, 84, -171, -100,
-	37, -250, 332, 331, -244, -244, -244, -244, -244, -246,
-	-246, -246, -246, 82, 82, -244, -244, -244, -244, -248,
-	82, -248, -248, -249, 82, -249, -283, -101, -280, -279,
-	-277, -278, 222, 97, 416, 74, -275, -167, 91, -98,
-	-211, 217, -281, -278, -291, -291, -291, -211, -291, 89,
-	-291, 89, 83, 16, -255, -254, -51, 271, -286, 246,
-	-282, -276, -263, 116, -262, -263, -263, -291, 83, 26,
-	109, 109, 109, 109, 416, 203, 29, -254, -51, -234,
-	215, -234, -234, 89, 89, -207, 540, -125, -97, 263,
-	130, 252, 252, 212, 212, 265, -132, 276, 278, 277,
-	275, 20, 264, 266, 268, 254, -132, -132, -210, 73,
-	-127, -132, 23, -223, -132, -208, -208, -132, -208, -208,
-	-132, -218, -82, 367, 83, 416, 19, -83, 19, 101,
-	102, 103, -147, -106, -107, -106, 121, 236, 83, -315,
-	22, 83, 74, -315, -315, -315, 83, 11, -89, -160,
-	-158, 128, -101, -315, -315, 83, 83, 11, -315, -89,
-	-89, -314, 198, -315, -315, -315, -315, -315, -219, -304,
-	507, 368, -136, 71, 216, 72, -314, -225, -315, -141,
-	-218, 91, 94, -170, -116, -118, 11, -94, -153, 84,
-	83, -246, -174, -179, -205, -291, 89, 155, -166, 198,
-	-140, 29, 54, -10, -314, -314, 29, 83, -128, -130,
-	-129, -131, 63, 67, 69, 64, 65, 66, 70, -229,
-	22, -117, -8, -7, -314, -132, -125, -316, 11, 74,
-	-316, 83, 198, -199, -201, 370, 367, 373, -291, 89,
-	-75, 246, 83, -270, -258, -310, 101, 122, 28, 73,
-	329, 97, -308, 154, -309, 218, 115, 116, 230, 22,
-	38, -251, 333, -246, -246, -253, 89, -253, 91, 91,
-	84, -42, -37, -38, 30, 77, -277, -265, 89, 36,
-	-218, 84, -97, -132, 122, 73, -281, -281, -281, -223,
-	15, -187, 83, 84, -156, 272, 84, -218, 84, 82,
-	-263, -263, -218, -314, 212, 28, 28, -51, -156, -247,
-	-291, 542, 541, 84, 214, 270, -99, 380, 89, 85,
-	-132, -132, -132, -132, -132, 206, 203, 255, 215, -125,
-	-132, 83, -71, 231, 226, -228, -228, 30, -132, 367,
-	519, 517, 121, 236, -114, -107, -91, 413, -236, 155,
-	304, 235, 302, 298, 318, 309, 331, 300, 332, -236,
-	-234, -101, -101, -163, 129, -101, 127, -101, -101, -101,
-	-315, -315, -90, -219, 213, 213, 213, -191, -202, 19,
-	11, 54, 54, -116, -137, 12, -117, -94, -218, -177,
-	503, -182, 43, -180, -181, 44, -178, 45, 53, 146,
-	-192, 73, -193, -197, -155, -150, -152, -151, -314, -186,
-	-315, -218, -191, -193, -119, -120, -120, -119, -120, 63,
-	63, 63, 68, 63, 68, 63, -129, -223, -315, -315,
-	-8, -226, 74, -117, -117, -134, -223, 146, 367, 371,
-	372, 16, -270, 101, -314, -105, -113, -103, -102, -104,
-	-108, 144, 145, 9, 89, 89, -291, 116, 116, -132,
-	82, 84, 83, 84, 83, 84, 83, -212, 453, 122,
-	-38, -37, -265, -265, 91, -291, 214, 23, -132, 73,
-	73, 73, 16, -254, -155, 54, -280, -187, -284, -286,
-	-132, -103, -314, -156, -132, -96, 261, 269, 82, 86,
-	-293, 74, 252, 329, 252, -132, -71, -42, -132, -208,
-	-208, 30, -291, -114, -107, -314, -315, -244, -244, -244,
-	-249, -244, 292, -244, 292, -315, -315, 83, -315, 19,
-	-315, -81, 409, -101, 83, 83, -315, -315, -314, -314,
-	-314, -315, -132, -137, -161, 13, -101, -174, 48, 310,
-	-184, -183, 52, 44, -181, 16, 46, 16, 27, -192,
-	83, 130, 83, -315, -315, 83, 54, 198, -315, -137,
-	-123, -122, 73, 74, -124, 73, -122, 63, 63, -188,
-	-315, -190, -117, -137, -137, 198, -1, -2, 544, 545,
-	546, -101, -109, -109, -143, 82, -101, -246, 89, -246,
-	91, 91, 453, 28, 78, 79, 80, 28, 75, 76,
-	-132, -132, -132, -132, -187, 248, 84, -315, 83, -252,
-	416, 419, -101, -188, 84, -290, 416, -292, -218, -218,
-	-218, -218, -106, -246, -291, -101, -101, -168, 89, -101,
-	-101, -189, -315, -218, 218, -189, -189, -226, -161, -162,
-	14, 16, -175, 50, -173, 49, -173, -183, 16, 16,
-	89, 16, 89, 116, -197, -101, -152, 54, -10, -218,
-	-150, -218, -163, -101, 82, -101, -137, -137, 549, 116,
-	-76, 82, 336, -315, -187, 84, 84, 84, -188, 249,
-	-309, -286, 420, 420, -315, 22, -289, -288, -219, 82,
-	74, -84, 140, 416, -315, -315, -315, -315, -315, 83,
-	-315, -315, -315, -136, -162, -101, -148, -176, 51, 73,
-	104, 89, 89, 89, 9, -150, 198, -168, -187, -163,
-	82, -5, 77, -3, 543, -101, 82, 84, -172, 22,
-	-314, -10, 83, 130, -187, -132, -315, 414, 70, 417,
-	-218, 218, 73, 104, -193, -218, 84, -168, -101, 82,
-	130, -77, 547, 91, 84, -187, -300, -301, 73, -310,
-	-307, 101, 122, 97, -308, 110, 111, -10, -101, 245,
-	-69, 416, -288, -269, -219, 89, 91, 84, 59, 415,
-	418, 84, -187, 91, -285, 82, 16, 84, -301, 73,
-	10, 9, 101, 89, -69, -315, -315, -70, 260, 507,
-	-293, 59, -3, 84, -284, -1, -299, 231, 226, 229,
-	29, -299, 223, -290, 416, -77, -3, 84, 549, 116,
-	225, 28, 101, 262, 417, -285, -77, 82, -5, 418,
-	-285, -101, 82, 84, -187, -4, 548, 84, 91, -4,
+	-43, 212, -44, -34, 213, -45, 215, 214, 250, 216,
+	243, 71, 290, 291, 293, 294, 295, 296, -74, 248,
+	249, 218, 33, 42, 30, 31, 34, 221, -9, -29,
+	6, -329, 8, 414, 245, 244, 25, -11, 427, 93,
+	-328, 553, -200, -185, 19, 30, 26, -184, -180, -86,
+	-185, 17, 15, 5, -66, -332, -66, 9, 10, -66,
+	-281, -283, 93, 149, 93, -66, -49, -48, -47, -46,
+	-50, 28, -40, -41, -302, -39, -36, 217, 214, 258,
+	116, 117, 250, 251, 252, 216, 234, 249, 253, 248,
+	267, -35, 77, 30, 427, 430, -288, 213, 219, 220,
+	415, 119, 118, 72, 215, -285, 340, 523, -50, 525,
+	108, 110, 524, 41, 224, 526, 527, 528, 529, 233,
+	530, 531, 532, 533, 539, 540, 541, 542, 120, 5,
+	-66, -242, -238, -306, -232, 96, 97, 98, 424, 236,
+	455, 456, 283, 77, 38, 340, 523, 290, 304, 298,
+	325, 317, 425, 457, 286, 237, 271, 520, 315, 125,
+	525, 289, 458, 251, 345, 346, 347, 108, 293, 382,
+	538, 288, 459, 536, 110, 524, 76, 48, 82, 436,
+	41, 246, 313, 309, 526, 272, 460, 83, 444, 265,
+	119, 116, 545, 33, 307, 47, 27, 535, 118, 46,
+	527, 140, 461, 528, 349, 330, 514, 45, 350, 252,
+	462, 80, 429, 522, 351, 84, 308, 352, 282, 534,
+	218, 439, 506, 353, 354, 515, 463, 331, 335, 464,
+	374, 355, 552, 49, 465, 466, 516, 117, 467, 75,
+	529, 302, 303, 468, 280, 235, 376, 329, 233, 32,
+	356, 85, 426, 284, 55, 86, 87, 259, 377, 43,
+	333, 549, 42, 510, 469, 513, 328, 324, 50, 470,
+	471, 472, 473, 530, 327, 301, 323, 544, 451, 277,
+	531, 59, 220, 337, 336, 338, 266, 373, 320, 474,
+	475, 476, 240, 78, 88, 89, 440, 310, 18, 477,
+	478, 357, 273, 479, 53, 480, 481, 380, 249, 482,
+	51, 532, 36, 254, 546, 533, 483, 90, 484, 485,
+	438, 486, 359, 487, 358, 332, 334, 261, 360, 428,
+	488, 91, 306, 253, 537, 489, 241, 521, 255, 258,
+	248, 381, 242, 490, 491, 441, 492, 493, 287, 494,
+	495, 294, 435, 434, 539, 92, 40, 496, 497, 498,
+	499, 281, 276, 375, 384, 58, 79, 342, 500, 519,
+	300, 274, 501, 291, 52, 540, 541, 542, 268, 543,
+	7, 550, 551, 362, 120, 278, 279, 44, 321, 260,
+	502, 503, 311, 312, 326, 299, 322, 292, 507, 262,
+	363, 250, 437, 383, 275, 343, 442, 267, 364, 518,
+	431, 319, 316, 269, 504, 365, 225, 263, 264, 505,
+	508, 366, 367, 285, 368, 369, 370, 371, 372, 270,
+	430, 297, 314, 344, 395, 396, 397, 398, 399, 400,
+	401, 402, 403, 404, 405, 406, 407, 408, 409, 410,
+	411, 412, 223, -66, 223, -132, -238, 223, -210, 346,
+	-229, 348, 361, 356, 366, 354, -221, 357, 359, 261,
+	-321, 374, 223, 363, 212, 166, 349, 358, 367, 368,
+	285, 369, 372, 270, -317, -306, 528, 543, 125, 318,
+	353, 351, 375, 510, 371, 370, -238, 292, -245, 297,
+	-233, -306, -232, 295, -132, -72, 506, 216, -247, -247,
+	-88, 510, 512, -163, -101, 133, -111, -114, -106, -107,
+	-142, -143, -144, -145, -146, -112, -170, 155, 156, 163,
+	134, -168, -115, 23, 423, 416, 415, 166, 28, 208,
+	65, 66, 418, 419, 136, 54, 391, 392, -113, 387,
+	393, 388, 421, 422, 111, -233, -238, 228, 386, 222,
+	161, 414, 92, 91, 83, 82, 90, 89, 85, 87,
+	84, 86, 88, -108, -104, -7, -166, 385, 389, 390,
+	394, -306, -232, -110, -109, -138, 99, 104, 109, 105,
+	-326, 112, -329, 100, 101, 102, 103, 113, 114, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
+	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
+	198, 199, 200, 201, 202, 203, 204, 205, 206, 207,
+	41, 362, 362, -132, -66, -178, -86, -180, -9, -7,
+	-329, 6, -66, -7, -8, -12, -29, -31, 453, -30,
+	-238, -185, -200, 9, 151, 39, 47, -183, -184, -11,
+	-7, -101, 16, 20, 21, -91, 157, -101, -238, -67,
+	-219, 227, -66, -66, -209, -250, 292, -213, 375, 374,
+	-234, -211, -233, -231, -210, 373, 217, 443, 132, 22,
+	24, 135, 166, 16, 136, 34, 219, 318, 234, 165,
+	230, 415, 212, 69, 387, 388, 385, 391, 417, 418,
+	386, 348, 28, 10, 25, 244, 21, 35, 159, 214,
+	139, 247, 23, 245, 111, 114, 19, 72, 239, 11,
+	232, 37, 13, 447, 448, 14, 228, 227, 151, 224,
+	67, 8, 208, 26, 148, 63, 449, 127, 450, 65,
+	149, 17, 547, 389, 390, 30, 511, 423, 256, 161,
+	70, 56, 512, 133, 112, 452, 115, 73, 517, 129,
+	15, 68, 39, 432, 257, 229, 548, 378, 453, 150,
+	215, 414, 66, 222, 361, 6, 420, 29, 243, 231,
+	122, 64, 454, 223, 138, 421, 422, 226, 113, 5,
+	126, 31, 9, 71, 74, 392, 393, 394, 54, 121,
+	427, 137, 12, 433, 379, 131, 125, -282, 149, -269,
+	-273, -233, 238, -298, 234, -132, -291, -290, -233, -87,
+	-228, 224, 232, 231, 126, -310, 129, 278, 386, 222,
+	-46, -47, -210, 165, 255, 94, 94, -273, -272, -271,
+	-311, 257, 238, -297, -289, 230, 239, -279, 231, 232,
+	-274, 224, 127, -311, -274, 229, 239, 257, 257, 120,
+	257, 120, 257, 257, 257, 257, 257, 257, 257, 257,
+	257, 254, -280, 141, -280, 431, 431, -285, -311, -311,
+	-311, 226, 32, 32, -230, -274, 226, 22, -280, -280,
+	-210, 165, -280, -280, -280, -280, 265, 265, -280, -280,
+	-280, -280, -280, -280, -280, -280, -280, -280, -280, -280,
+	-280, -280, -280, 223, -310, -95, 372, 285, 77, -48,
+	267, -32, -132, -228, 224, 225, -310, -132, 209, -132,
+	-223, 149, 12, -223, -220, 362, 360, 347, 352, -223,
+	-223, -223, -223, 268, 345, -275, 224, 32, 235, 362,
+	268, 345, 268, 269, 268, 269, 355, 365, 268, -243,
+	11, 151, 386, 350, 354, 261, 223, 262, 225, 364,
+	-306, 513, 269, -243, 100, -224, 149, 362, 264, -223,
+	-248, -329, -234, 318, -248, -248, 29, 226, -233, -68,
+	-233, 100, -14, -10, -17, -16, -18, 141, -93, 362,
+	-82, 166, 528, 514, 515, 516, 513, 359, 521, 519,
+	517, 268, 518, 94, 129, 131, 132, -101, 148, -139,
+	141, 142, 143, 144, 145, 146, 147, 151, 133, 135,
+	149, 150, 130, 152, 153, 154, 155, 156, 157, 158,
+	160, 159, 161, 162, 165, 210, 211, -107, -107, -107,
+	-107, -168, -329, -329, -329, -107, -218, -329, -107, -329,
+	-329, -329, -329, -174, -101, -329, -333, -329, -333, -333,
+	-260, -329, -260, -329, -329, -329, -329, 209, -329, -329,
+	-329, -329, -329, -329, -329, -329, -329, -329, -329, -329,
+	-329, -329, -329, -329, -260, -260, -260, -260, 109, 104,
+	99, -170, 105, 100, -233, -238, -7, -8, -163, -247,
+	-318, -319, -135, -132, -329, 285, -183, -11, -7, -178,
+	-184, -180, -7, -66, -80, -92, 60, 61, -94, 21,
+	35, 64, 62, 20, -330, 95, -330, -200, -330, 94,
+	-31, -203, 93, 58, 40, 100, 100, 94, 18, -179,
+	-181, -101, 11, -236, -235, 22, -233, 100, 209, 107,
+	-133, 26, -132, -219, -219, 94, 292, -213, -250, -215,
+	-214, 376, 378, 141, -237, -233, 100, 28, 95, 94,
+	-132, -252, -255, -257, -256, -258, -253, -254, 315, 316,
+	166, 319, 321, 322, 323, 324, 325, 326, 327, 328,
+	329, 330, 29, 246, 311, 312, 313, 314, 331, 332,
+	333, 334, 335, 336, 337, 338, 298, 317, 425, 299,
+	300, 301, 302, 303, 304, 306, 307, 308, 309, 310,
+	-309, -306, 93, 95, 94, -259, 93, -95, 223, -306,
+	224, 224, 224, -66, 414, -280, -280, 254, -39, -36,
+	-302, 15, -35, -36, 217, 116, 117, 214, 93, -269,
+	93, -278, -309, -306, 93, 127, 229, 126, -277, -274,
+	-277, -278, -306, -170, -306, 127, 127, -206, -233, -206,
+	-206, 20, -206, 20, -206, 20, 102, -233, -206, 20,
+	-206, 20, -206, 20, -206, 20, -206, 20, 28, 75,
+	76, 28, 78, 79, 80, -170, -170, -269, -210, -132,
+	-306, 102, 102, -280, -280, 102, 100, 100, 100, -280,
+	-280, 102, 100, -240, -238, 100, -312, 240, 282, 284,
+	102, 102, 102, 102, 28, 100, -313, 28, 535, 534,
+	536, 537, 538, 102, 28, 102, 28, 102, -233, 93,
+	-132, -98, 272, 212, 214, 217, 73, 100, 286, 141,
+	41, 94, 226, 223, -306, -225, 228, -225, -239, -238,
+	-231, 100, -101, -276, 11, 151, -243, -243, -223, -132,
+	-276, -243, -223, -132, -223, -223, -223, -223, -243, -243,
+	-243, -223, -238, -238, -132, -132, -132, -132, -132, -132,
+	-132, -248, -248, -248, -224, -223, 513, 100, 73, -246,
+	295, 329, 507, 508, 509, 94, 427, -125, -132, 513,
+	513, 513, 513, 513, 513, -101, -101, -101, -101, -161,
+	112, 133, 113, 114, -114, -162, -166, -168, 106, 151,
+	135, 149, 150, -106, -107, -106, -106, -106, -106, -106,
+	-106, -106, -106, -106, -106, -106, -106, -106, -249, -306,
+	100, 166, 100, 100, -89, -91, -101, -101, -306, -233,
+	-89, -89, -101, -85, 21, 35, -172, -173, 137, -170,
+	-330, -330, 102, -233, -233, -79, -78, 396, 397, 398,
+	399, 401, 402, 403, 406, 407, 411, 412, 395, 413,
+	400, 405, 408, 409, 410, 404, 314, -90, 21, 35,
+	-89, -234, -239, -231, -89, -90, -90, -89, -89, -330,
+	-330, -330, -330, -330, -101, -101, -101, -101, -101, -101,
+	209, -200, -330, 94, -320, 378, 379, 511, -241, 257,
+	-240, 22, -164, -163, 100, -183, -183, 60, 61, 56,
+	-89, -94, -330, -30, 22, -202, -233, 59, 100, -261,
+	-210, -101, -101, 94, -182, 24, 25, -132, -235, 157,
+	-239, -205, 257, -132, -117, -119, -120, -121, -135, -169,
+	-329, 11, -125, -126, -134, -238, -213, -215, 94, 377,
+	379, 380, 73, 115, -101, -262, 165, -287, -286, -285,
+	-269, -271, -272, -273, 95, -186, -100, 37, -265, 343,
+	342, -259, -259, -259, -259, -259, -261, -261, -261, -261,
+	93, 93, -259, -259, -259, -259, -263, 93, -263, -263,
+	-264, 93, -264, -298, -101, -295, -294, -292, -293, 233,
+	108, 427, 74, -290, -182, 102, -98, -226, 228, -296,
+	-293, -306, -306, -306, -226, -306, 100, -306, 100, 94,
+	16, -270, -269, -51, 282, -301, 257, -297, -291, -278,
+	127, -277, -278, -278, -306, 94, 26, 120, 120, 120,
+	120, 427, 214, 29, -269, -51, -249, 226, -249, -249,
+	100, 100, -222, 543, -125, -97, 274, 141, 263, 263,
+	223, 223, 276, -132, 287, 289, 288, 286, 20, 275,
+	277, 279, 265, -132, -132, -225, 73, -127, -132, 23,
+	-238, -132, -223, -223, -132, -223, -223, -132, -233, -82,
+	378, 94, 427, 19, -83, 19, 112, 113, 114, -162,
+	-106, -107, -106, 132, 247, 94, -330, 22, 94, 74,
+	-330, -330, -330, 94, 11, -89, -175, -173, 139, -101,
+	-330, -330, 94, 94, 11, -330, -89, -89, -329, 209,
+	-330, -330, -330, -330, -330, -147, 432, -147, -147, -147,
+	-147, -330, -330, 94, -330, 94, -330, -330, 94, -234,
+	-319, 510, 379, -136, 71, 227, 72, -329, -240, -330,
+	-141, -233, 102, 105, -185, -116, -118, 11, -94, -168,
+	95, 94, -261, -189, -194, -220, -306, 100, 166, -181,
+	209, -140, 29, 54, -10, -329, -329, 29, 94, -128,
+	-130, -129, -131, 63, 67, 69, 64, 65, 66, 70,
+	-244, 22, -117, -8, -7, -329, -132, -125, -331, 11,
+	74, -331, 94, 209, -214, -216, 381, 378, 384, -306,
+	100, -75, 257, 94, -285, -273, -325, 112, 133, 28,
+	73, 340, 108, -323, 165, -324, 229, 126, 127, 241,
+	22, 38, -266, 344, -261, -261, -268, 100, -268, 102,
+	102, 95, -42, -37, -38, 30, 77, -292, -280, 100,
+	36, -233, 95, -97, -132, 133, 73, -296, -296, -296,
+	-238, 15, -202, 94, 95, -171, 283, 95, -233, 95,
+	93, -278, -278, -233, -329, 223, 28, 28, -51, -171,
+	-262, -306, 545, 544, 95, 225, 281, -99, 391, 100,
+	96, -132, -132, -132, -132, -132, 217, 214, 266, 226,
+	-125, -132, 94, -71, 242, 237, -243, -243, 30, -132,
+	378, 522, 520, 132, 247, -114, -107, -91, 424, -251,
+	166, 315, 246, 313, 309, 329, 320, 342, 311, 343,
+	-251, -249, -101, -101, -178, 140, -101, 138, -101, -101,
+	-101, -148, -147, -330, -330, -90, -234, -233, -329, -147,
+	-157, 441, 227, -101, -157, -101, -157, -157, -101, 224,
+	224, 224, -206, -217, 19, 11, 54, 54, -116, -137,
+	12, -117, -94, -233, -192, 506, -197, 43, -195, -196,
+	44, -193, 45, 53, 157, -207, 73, -208, -212, -170,
+	-165, -167, -166, -329, -201, -330, -233, -206, -208, -119,
+	-120, -120, -119, -120, 63, 63, 63, 68, 63, 68,
+	63, -129, -238, -330, -330, -8, -241, 74, -117, -117,
+	-134, -238, 157, 378, 382, 383, 16, -285, 112, -329,
+	-105, -113, -103, -102, -104, -108, 155, 156, 9, 100,
+	100, -306, 127, 127, -132, 93, 95, 94, 95, 94,
+	95, 94, -227, 461, 133, -38, -37, -280, -280, 102,
+	-306, 225, 23, -132, 73, 73, 73, 16, -269, -170,
+	54, -295, -202, -299, -301, -132, -103, -329, -171, -132,
+	-96, 272, 280, 93, 97, -308, 74, 263, 340, 263,
+	-132, -71, -42, -132, -223, -223, 30, -306, -114, -107,
+	-329, -330, -259, -259, -259, -264, -259, 303, -259, 303,
+	-330, -330, 94, -330, 19, -330, -81, 420, -101, 94,
+	94, -330, -330, -149, -150, -233, -152, -180, -151, -155,
+	257, 434, 548, -147, 440, 440, -330, 94, -147, -330,
+	94, -147, -147, -330, -329, -329, -329, -330, -132, -137,
+	-176, 13, -101, -189, 48, 321, -199, -198, 52, 44,
+	-196, 16, 46, 16, 27, -207, 94, 141, 94, -330,
+	-330, 94, 54, 209, -330, -137, -123, -122, 73, 74,
+	-124, 73, -122, 63, 63, -203, -330, -205, -117, -137,
+	-137, 209, -1, -2, 547, 548, 549, -101, -109, -109,
+	-143, 93, -101, -261, 100, -261, 102, 102, 461, 28,
+	78, 79, 80, 28, 75, 76, -132, -132, -132, -132,
+	-202, 259, 95, -330, 94, -267, 427, 430, -101, -203,
+	95, -305, 427, -307, -233, -233, -233, -233, -106, -261,
+	-306, -101, -101, -183, 100, -101, -101, -330, -150, 434,
+	-152, -178, -153, 135, 436, 437, -154, 102, 105, 208,
+	16, -157, -101, -157, -101, -156, 11, -204, -330, -233,
+	229, -204, -204, -241, -176, -177, 14, 16, -190, 50,
+	-188, 49, -188, -198, 16, 16, 100, 16, 100, 127,
+	-212, -101, -167, 54, -10, -233, -165, -233, -178, -101,
+	93, -101, -137, -137, 552, 127, -76, 93, 347, -330,
+	-202, 95, 95, 95, -203, 260, -324, -301, 431, 431,
+	-330, 22, -304, -303, -234, 93, 74, -84, 151, 427,
+	-330, -330, -330, -330, -330, -152, -153, 435, 438, 439,
+	438, 439, -107, -163, -147, -330, -147, -330, -157, 282,
+	284, 94, -330, -330, -330, -136, -177, -159, 433, -101,
+	-163, -191, 51, 73, 115, 100, 100, 100, 9, -165,
+	209, -183, -202, -178, 93, -5, 77, -3, 546, -101,
+	93, 95, -187, 22, -329, -10, 94, 141, -202, -132,
+	-330, 425, 70, 428, 132, -233, -157, -157, -147, -233,
+	229, -159, -160, -158, -233, 73, 115, -208, -233, 95,
+	-183, -101, 93, 141, -77, 550, 102, 95, -202, -315,
+	-316, 73, -325, -322, 112, 133, 108, -323, 121, 122,
+	-10, -101, 256, -69, 427, -303, -284, -234, 100, 102,
+	95, 59, 426, 429, -153, -147, -147, 94, 22, 95,
+	-202, 102, -300, 93, 16, 95, -316, 73, 10, 9,
+	112, 100, -69, -330, -330, -70, 271, 510, -308, 59,
+	-158, -329, -3, 95, -299, -1, -314, 242, 237, 240,
+	29, -314, 234, -305, 427, -149, -77, -3, 95, 552,
+	127, 236, 28, 112, 273, 428, -330, -300, -77, 93,
+	-5, 429, -300, -101, 93, 95, -202, -4, 551, 95,
+	102, -4,
 }
 
 var yyDef = [...]int{
@@ -5903,266 +6144,281 @@ var yyDef = [...]int{
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
 	31, 32, 64, 66, 67, 697, 697, 0, 697, 0,
-	0, 697, -2, -2, 697, 1104, 0, 697, 0, 0,
-	-2, 630, 631, 0, 633, -2, 0, 0, 642, 1551,
-	1551, 692, 0, 0, 0, 0, 0, 697, 968, 44,
-	697, 0, 78, 79, 648, 649, 650, 59, 0, 1549,
-	1, 3, 65, 69, 0, 0, 0, 52, 977, 0,
-	72, 0, 0, 701, 0, 699, 1087, 697, 697, 0,
+	0, 697, -2, -2, 697, 1154, 0, 697, 0, 0,
+	-2, 630, 631, 0, 633, -2, 0, 0, 642, 1604,
+	1604, 692, 0, 0, 0, 0, 0, 697, 1018, 44,
+	697, 0, 78, 79, 648, 649, 650, 59, 0, 1602,
+	1, 3, 65, 69, 0, 0, 0, 52, 1027, 0,
+	72, 0, 0, 701, 0, 699, 1137, 697, 697, 0,
 	109, 110, 0, 0, 0, -2, 113, -2, 137, 138,
 	0, 142, 482, 442, 485, 440, 471, -2, 433, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 445, 335, 335, 0, 0, -2, 433, 433, 433,
-	0, 0, 0, 468, 1089, 388, 335, 335, 0, 335,
+	0, 0, 0, 468, 1139, 388, 335, 335, 0, 335,
 	335, 335, 335, 0, 0, 335, 335, 335, 335, 335,
 	335, 335, 335, 335, 335, 335, 335, 335, 335, 335,
-	994, 141, 1105, 1102, 1103, 34, 35, 36, 1249, 1250,
-	1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260,
-	1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270,
-	1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280,
-	1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290,
-	1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300,
-	1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310,
-	1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320,
-	1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330,
-	1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340,
-	1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350,
-	1351, 1352, 1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360,
-	1361, 1362, 1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370,
-	1371, 1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380,
-	1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390,
-	1391, 1392, 1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400,
-	1401, 1402, 1403, 1404, 1405, 1406, 1407, 1408, 1409, 1410,
-	1411, 1412, 1413, 1414, 1415, 1416, 1417, 1418, 1419, 1420,
-	1421, 1422, 1423, 1424, 1425, 1426, 1427, 1428, 1429, 1430,
-	1431, 1432, 1433, 1434, 1435, 1436, 1437, 1438, 1439, 1440,
-	1441, 1442, 1443, 1444, 1445, 1446, 1447, 1448, 1449, 1450,
-	1451, 1452, 1453, 1454, 1455, 1456, 1457, 1458, 1459, 1460,
-	1461, 1462, 1463, 1464, 1465, 1466, 1467, 1468, 1469, 1470,
-	1471, 1472, 1473, 1474, 1475, 1476, 1477, 1478, 1479, 1480,
-	1481, 1482, 1483, 1484, 1485, 1486, 1487, 1488, 1489, 1490,
-	1491, 1492, 1493, 1494, 1495, 1496, 1497, 1498, 1499, 1500,
-	1501, 1502, 1503, 1504, 1505, 1506, 1507, 1508, 1509, 1510,
-	1511, 1512, 1513, 1514, 1515, 1516, 1517, 1518, 1519, 1520,
-	1521, 1522, 1523, 1524, 1525, 1526, 1527, 1528, 1529, 1530,
-	1531, 1532, 1533, 1534, 1535, 1536, 1537, 1538, 1539, 1540,
-	1541, 1542, 1543, 1544, 1545, 1546, 1547, 1548, 0, 1081,
-	0, 560, 787, 0, 621, 621, 0, 621, 621, 621,
-	621, 0, 0, 0, 572, 0, 0, 0, 0, 618,
-	0, 0, 591, 593, 0, 618, 0, 624, 0, 605,
-	621, 1552, 1552, 1552, 1072, 0, 615, 613, 627, 628,
-	610, 611, 629, 632, 0, 637, 640, 1098, 1099, 0,
-	655, 43, 1329, 647, 660, 661, 0, 693, 694, 39,
-	873, 0, 802, 806, 817, 830, 831, 832, 833, 834,
-	836, 837, 0, 0, 0, 0, 842, 843, 0, 0,
-	0, 0, 0, 854, 0, 0, 0, 0, 0, 949,
-	0, 918, 918, 890, 918, 920, 920, 0, 0, 0,
-	958, 0, 0, 0, 0, 0, 0, 246, 247, 872,
-	1061, 920, 920, 920, 920, -2, -2, 193, 194, 195,
-	196, 197, 198, 199, 0, 189, 0, 250, 251, 248,
-	249, 856, 857, 205, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
-	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 235, 236, 237, 238, 239, 240, 241,
-	242, 243, 244, 245, 1551, 0, 670, 0, 0, 977,
-	0, 969, 968, 57, 0, 697, -2, 0, 0, 0,
-	0, 41, 0, 46, 748, 71, 70, 1013, 0, 0,
-	0, 53, 978, 61, 63, 979, 0, 702, 703, 0,
-	726, 730, 0, 698, 0, 1088, 1087, 1087, 95, 0,
-	1494, 1065, -2, -2, 0, 0, 1100, 1101, 1074, -2,
-	1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117,
-	1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127,
-	1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137,
-	1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147,
-	1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157,
-	1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167,
-	1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177,
-	1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187,
-	1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197,
-	1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207,
-	1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217,
-	1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227,
-	1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237,
-	1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247,
-	1248, -2, 0, 0, 151, 152, 0, 37, 361, 0,
-	147, 0, 355, 307, 994, 0, 0, 0, 0, 0,
-	697, 0, 1082, 132, 133, 139, 140, 335, 335, 0,
-	141, 141, 449, 450, 451, 0, 0, -2, 359, 0,
-	434, 0, 0, 349, 349, 353, 351, 352, 0, 0,
-	0, 0, 0, 0, 462, 0, 463, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 544, 0, 336, 0,
-	480, 481, 389, 0, 0, 0, 0, 460, 461, 0,
-	0, 1090, 1091, 0, 0, 335, 335, 0, 0, 0,
-	0, 335, 335, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 131,
-	1007, 0, 0, 0, -2, 0, 552, 0, 0, 0,
-	1083, 1083, 559, 0, 561, 562, 0, 0, 563, 0,
-	618, 618, 616, 617, 565, 566, 567, 568, 621, 0,
-	0, 344, 345, 346, 618, 621, 0, 621, 621, 621,
-	621, 618, 618, 618, 621, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1552, 1552, 1552, 624, 621, 0,
-	601, 0, 602, 603, 606, 607, 1553, 1554, 1119, 608,
-	609, 1073, 638, 641, 658, 656, 657, 659, 651, 652,
-	653, 654, 0, 672, 673, 678, 0, 0, 0, 0,
-	684, 685, 686, 0, 0, 689, 690, 691, 0, 0,
-	0, 0, 800, 0, 0, 862, 863, 864, 865, 866,
-	867, 868, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 838, 839, 840, 841, 844, 0, 0, 0,
-	849, 850, 0, 0, 0, 0, 0, 707, 0, 950,
-	0, 888, 0, 889, 891, 892, 0, 893, 0, 0,
-	0, 710, 0, 0, 710, 710, 0, 0, 183, 184,
-	185, 186, 200, 201, 202, 203, 204, 252, 958, 0,
-	872, 0, 0, 40, 662, 663, 0, 770, 1093, 0,
-	51, 60, 62, 977, 55, 977, 0, 712, 0, 0,
-	-2, -2, 713, 719, 720, 721, 722, 723, 48, 1550,
-	49, 0, 68, 0, 42, 0, 0, 0, 0, 319,
-	1016, 0, 0, 970, 971, 974, 0, 727, 731, 0,
-	733, 734, 0, 700, 93, 0, 786, 0, 0, 0,
-	1494, 1071, 0, 97, 98, 0, 0, 0, 323, 1076,
-	1077, 1078, -2, 342, 0, -2, 314, 258, 259, 260,
-	307, 262, 307, 307, 307, 307, 319, 319, 319, 319,
-	290, 291, 292, 293, 294, 0, 0, 277, 307, 307,
-	307, 307, 297, 298, 299, 300, 301, 302, 303, 304,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 309,
-	309, 309, 311, 311, 0, 38, 0, 327, 0, 974,
-	0, 1007, 1085, 1095, 0, 0, 0, 1085, 115, 0,
-	0, 483, 443, 472, 484, 0, 446, 447, -2, 0,
-	0, 433, 0, 435, 0, 343, 0, -2, 0, 353,
-	0, 349, 353, 350, 353, 341, 354, 464, 465, 466,
-	0, 524, 756, 0, 0, 0, 0, 0, 530, 531,
-	532, 0, 534, 535, 536, 537, 538, 539, 540, 541,
-	542, 543, 473, 474, 475, 476, 477, 478, 479, 0,
-	0, 435, 0, 469, 0, 390, 391, 0, 0, 394,
-	395, 396, 397, 0, 0, 400, 401, 402, 773, 774,
-	403, 427, 428, 429, 404, 405, 406, 407, 408, 409,
-	410, 421, 422, 423, 424, 425, 426, 411, 412, 413,
-	414, 415, 418, 0, 125, 998, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1083, 0, 0,
-	0, 0, 788, 1106, 1107, 622, 623, 0, 347, 348,
-	621, 621, 569, 592, 0, 621, 573, 594, 574, 576,
-	575, 577, 596, 597, 621, 580, 619, 620, 581, 582,
-	583, 584, 585, 586, 587, 588, 589, 590, 598, 599,
-	600, 625, 0, 0, 639, 643, 644, 645, 646, 0,
-	0, 675, 89, 680, 681, 682, 683, 695, 688, 874,
-	797, 798, 799, 801, 803, 0, 858, 860, 805, 807,
-	869, 870, 871, 0, 0, 0, 0, 0, 811, 815,
-	818, 819, 820, 821, 822, 823, 824, 825, 826, 827,
-	828, 829, 835, 933, 934, 935, 852, 853, 0, 724,
-	0, 0, 0, 851, 0, 0, 0, 0, 708, 709,
-	956, 953, 0, 0, 919, 921, 0, 0, 0, 0,
-	897, 898, 899, 900, 901, 902, 903, 904, 905, 906,
-	907, 908, 909, 910, 911, 912, 913, 914, 915, 916,
-	917, 0, 0, 0, 711, 959, 0, -2, 0, 0,
-	0, 0, 0, 0, 0, 1060, 0, 665, 666, 668,
-	0, 790, 0, 771, 0, 0, 1094, 671, 54, 56,
-	717, 718, 0, 735, 714, 50, 45, 0, 0, 750,
-	1014, 319, 1036, 0, 980, 981, 0, 973, 975, 976,
-	73, 732, 728, 0, 0, 0, 785, 0, 738, 740,
-	741, 742, 768, 0, 0, 0, 0, 91, 787, 1066,
-	96, 0, 0, 101, 102, 1067, 1068, 1069, 1070, 0,
-	502, -2, 385, 153, 155, 156, 157, 148, 367, 0,
-	0, 317, 315, 316, 261, 319, 319, 284, 285, 286,
-	287, 288, 289, 0, 0, 278, 279, 280, 281, 272,
-	0, 273, 274, 275, 0, 276, 360, 0, 982, 328,
-	329, 331, 335, 0, 0, 0, 356, 357, 0, 998,
-	0, 0, 0, 1096, 1095, 1095, 1095, 0, 143, 144,
-	145, 146, 141, 0, 0, 149, 437, 436, 0, 0,
-	358, 0, 0, 353, 353, 338, 339, 467, 0, 0,
-	526, 527, 528, 529, 0, 0, 0, 435, 437, 323,
-	0, 392, 393, 398, 399, 416, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 497, 498, 499,
-	500, 501, 995, 996, 997, 0, 0, 553, 0, 0,
-	377, 87, 1084, 558, 618, 579, 595, 618, 571, 578,
-	604, 635, 679, 0, 0, 0, 0, 687, 0, 804,
-	859, 861, 808, 0, 812, 816, 0, 0, 0, 0,
-	0, 0, 0, 855, 879, 880, 0, 0, 968, 0,
-	954, 0, 0, 887, 922, 0, 0, 0, 875, 0,
-	0, 710, 0, 923, 924, 925, 926, 927, 959, 664,
-	667, 669, 746, 0, 0, 0, 0, 772, 1092, 0,
-	961, 0, 0, 735, 795, 736, 0, 715, 47, 749,
-	0, 1018, 1017, 1030, 1043, 320, 321, 322, 972, 0,
-	1054, 0, 0, 1046, 0, 0, 0, 0, 0, 0,
-	0, 0, 775, 0, 0, 778, 0, 0, 0, 0,
-	769, 0, 0, 0, 0, -2, 0, 0, 85, 86,
-	0, 0, 0, 99, 100, 0, 0, 106, 324, 325,
-	134, 0, 141, 387, 154, 158, 163, 0, 0, 0,
-	168, 0, 170, 0, 368, 0, 254, 256, 0, 0,
-	161, 257, 318, 282, 283, 0, 305, 0, 0, 0,
-	380, 111, 986, 985, 335, 335, 330, 0, 333, 0,
-	1097, 308, 0, 124, 0, 0, 0, 0, 0, 130,
-	0, 441, 0, 452, 453, 0, 523, 0, 327, 0,
-	337, 340, 757, 0, 0, 454, 0, 437, 458, 459,
-	470, 419, 420, 417, 0, 0, 1008, 1009, 0, 1012,
-	116, 490, 492, 491, 495, 0, 0, 488, 0, 377,
-	982, 0, 557, 378, 379, 621, 621, 674, 90, 0,
-	677, 0, 0, 0, 809, 813, 725, 0, 0, 307,
-	307, 939, 307, 311, 942, 307, 944, 307, 947, 0,
-	0, 0, 0, 951, 886, 957, 0, 0, 0, 0,
-	876, 877, 0, 960, 0, 0, 0, 0, 0, 1079,
-	1080, 962, 963, 795, 964, 0, 737, 716, 751, 1036,
-	0, 1029, 0, -2, 1038, 0, 0, 0, 1044, 729,
-	76, 0, 1054, 1062, 0, 1045, 1056, 1058, 0, 0,
-	0, 1050, 0, 795, 739, 764, 766, 0, 761, 776,
-	777, 779, 0, 781, 0, 783, 784, 748, 744, 745,
-	0, 93, 0, 795, 795, 92, 0, 789, 103, 104,
-	105, 511, 386, 164, 0, 166, 181, 182, 187, 188,
-	190, 0, 0, 0, 169, 171, 172, 253, 255, 0,
-	0, 319, 0, 319, 0, 312, 0, 369, 381, 0,
-	983, 984, 0, 0, 332, 334, 0, 1086, 126, 0,
-	0, 0, 0, 150, 438, 0, 326, 0, 0, 548,
-	545, 455, 0, 457, 748, 999, 1000, 1001, 0, 1011,
-	119, 0, 0, 0, 0, 554, 555, 556, 88, 564,
-	570, 676, 696, 810, 814, 0, 846, 936, 319, 940,
-	941, 943, 945, 946, 948, 847, 848, 0, 882, 0,
-	884, 977, 0, 955, 0, 0, 896, 878, 0, 0,
-	0, 770, 58, 964, 966, 0, 796, 1023, 1021, 1021,
-	1031, 1032, 0, 0, 1039, 0, 0, 0, 0, 77,
-	0, 0, 0, 1059, 0, 0, 0, 0, 94, 968,
-	758, 765, 0, 0, 759, 0, 760, 780, 782, 743,
-	-2, 795, 795, 83, 84, 0, 0, 0, 512, 515,
-	516, 0, 191, 192, 167, 0, 0, 295, 306, 296,
-	0, 0, 382, 987, 988, 989, 990, 991, 992, 993,
-	748, 127, 128, 129, 444, 0, 0, 525, 0, 533,
-	0, 0, 0, 0, 1010, 489, 0, 117, 118, 0,
-	0, 494, 928, 937, 938, 0, 0, 0, 952, 0,
-	0, 0, 792, 752, 753, 0, 0, 790, 966, 75,
-	0, 0, 1026, 0, 1019, 1022, 1020, 1033, 0, 0,
-	1040, 0, 1042, 0, 1063, 1064, 1057, 0, 1049, 1052,
-	1048, 1051, 977, 762, 0, 767, 968, 82, 0, 513,
-	519, 0, 0, 165, 0, 175, 310, 313, 0, 0,
-	362, 549, 546, 547, 456, 0, 120, 121, 0, 0,
-	0, 0, 0, 0, 881, 883, 885, 894, 895, 0,
-	791, 793, 794, 747, 74, 967, 965, 1015, 0, 1024,
-	1025, 1034, 1035, 1041, 0, 1047, 0, 80, 0, 977,
-	0, 0, 0, 506, 0, 0, 0, 363, 367, 0,
-	0, 1002, 0, 0, 0, 496, 845, 0, 0, 0,
-	754, 755, 1027, 1028, 1055, 1053, 763, 81, 0, 0,
-	0, 509, 0, 520, 517, 0, 364, 365, 0, 159,
-	176, 177, 0, 0, 180, 173, 174, 1002, 0, 0,
-	486, 1004, 122, 123, 430, 431, 432, 116, 929, 0,
-	932, 519, 0, 514, 505, 0, 511, 518, 366, 0,
-	0, 0, 178, 179, 112, 550, 551, 0, 1005, 1006,
-	119, 930, 506, 519, 0, 0, 370, 372, 373, 0,
-	0, 371, 0, 493, 0, 509, 506, 510, 0, 513,
-	374, 375, 376, 1003, 0, 503, 509, 0, 0, 931,
-	504, 0, 0, 521, 0, 507, 0, 521, 522, 508,
+	1044, 141, 1155, 1152, 1153, 34, 35, 36, 1288, 1289,
+	1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299,
+	1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309,
+	1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319,
+	1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329,
+	1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339,
+	1340, 1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348, 1349,
+	1350, 1351, 1352, 1353, 1354, 1355, 1356, 1357, 1358, 1359,
+	1360, 1361, 1362, 1363, 1364, 1365, 1366, 1367, 1368, 1369,
+	1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379,
+	1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388, 1389,
+	1390, 1391, 1392, 1393, 1394, 1395, 1396, 1397, 1398, 1399,
+	1400, 1401, 1402, 1403, 1404, 1405, 1406, 1407, 1408, 1409,
+	1410, 1411, 1412, 1413, 1414, 1415, 1416, 1417, 1418, 1419,
+	1420, 1421, 1422, 1423, 1424, 1425, 1426, 1427, 1428, 1429,
+	1430, 1431, 1432, 1433, 1434, 1435, 1436, 1437, 1438, 1439,
+	1440, 1441, 1442, 1443, 1444, 1445, 1446, 1447, 1448, 1449,
+	1450, 1451, 1452, 1453, 1454, 1455, 1456, 1457, 1458, 1459,
+	1460, 1461, 1462, 1463, 1464, 1465, 1466, 1467, 1468, 1469,
+	1470, 1471, 1472, 1473, 1474, 1475, 1476, 1477, 1478, 1479,
+	1480, 1481, 1482, 1483, 1484, 1485, 1486, 1487, 1488, 1489,
+	1490, 1491, 1492, 1493, 1494, 1495, 1496, 1497, 1498, 1499,
+	1500, 1501, 1502, 1503, 1504, 1505, 1506, 1507, 1508, 1509,
+	1510, 1511, 1512, 1513, 1514, 1515, 1516, 1517, 1518, 1519,
+	1520, 1521, 1522, 1523, 1524, 1525, 1526, 1527, 1528, 1529,
+	1530, 1531, 1532, 1533, 1534, 1535, 1536, 1537, 1538, 1539,
+	1540, 1541, 1542, 1543, 1544, 1545, 1546, 1547, 1548, 1549,
+	1550, 1551, 1552, 1553, 1554, 1555, 1556, 1557, 1558, 1559,
+	1560, 1561, 1562, 1563, 1564, 1565, 1566, 1567, 1568, 1569,
+	1570, 1571, 1572, 1573, 1574, 1575, 1576, 1577, 1578, 1579,
+	1580, 1581, 1582, 1583, 1584, 1585, 1586, 1587, 1588, 1589,
+	1590, 1591, 1592, 1593, 1594, 1595, 1596, 1597, 1598, 1599,
+	1600, 1601, 0, 1131, 0, 560, 787, 0, 621, 621,
+	0, 621, 621, 621, 621, 0, 0, 0, 572, 0,
+	0, 0, 0, 618, 0, 0, 591, 593, 0, 618,
+	0, 624, 0, 605, 621, 1605, 1605, 1605, 1122, 0,
+	615, 613, 627, 628, 610, 611, 629, 632, 0, 637,
+	640, 1148, 1149, 0, 655, 43, 1372, 647, 660, 661,
+	0, 693, 694, 39, 874, 0, 802, 806, 817, 830,
+	831, 832, 833, 834, 835, 837, 838, 0, 0, 0,
+	0, 843, 844, 0, 0, 0, 0, 0, 855, 0,
+	0, 0, 0, 0, 999, 0, 968, 968, 940, 968,
+	970, 970, 0, 0, 0, 1008, 0, 0, 0, 0,
+	0, 0, 1505, 1481, 1337, 1328, 1467, 1445, 1401, 1406,
+	1365, 1405, 1444, 246, 247, 873, 1111, 970, 970, 970,
+	970, -2, -2, 193, 194, 195, 196, 197, 198, 199,
+	0, 189, 0, 250, 251, 248, 249, 857, 858, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 239, 240, 241, 242, 243, 244, 245,
+	1604, 0, 670, 0, 0, 1027, 0, 1019, 1018, 57,
+	0, 697, -2, 0, 0, 0, 0, 41, 0, 46,
+	748, 71, 70, 1063, 0, 0, 0, 53, 1028, 61,
+	63, 1029, 0, 702, 703, 0, 726, 730, 0, 698,
+	0, 1138, 1137, 1137, 95, 0, 1547, 1115, -2, -2,
+	0, 0, 1150, 1151, 1124, -2, 1158, 1159, 1160, 1161,
+	1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171,
+	1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
+	1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191,
+	1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201,
+	1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211,
+	1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221,
+	1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231,
+	1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241,
+	1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251,
+	1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261,
+	1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271,
+	1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281,
+	1282, 1283, 1284, 1285, 1286, 1287, -2, 0, 0, 151,
+	152, 0, 37, 361, 0, 147, 0, 355, 307, 1044,
+	0, 0, 0, 0, 0, 697, 0, 1132, 132, 133,
+	139, 140, 335, 335, 0, 141, 141, 449, 450, 451,
+	0, 0, -2, 359, 0, 434, 0, 0, 349, 349,
+	353, 351, 352, 0, 0, 0, 0, 0, 0, 462,
+	0, 463, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 544, 0, 336, 0, 480, 481, 389, 0, 0,
+	0, 0, 460, 461, 0, 0, 1140, 1141, 0, 0,
+	335, 335, 0, 0, 0, 0, 335, 335, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 131, 1057, 0, 0, 0, -2,
+	0, 552, 0, 0, 0, 1133, 1133, 559, 0, 561,
+	562, 0, 0, 563, 0, 618, 618, 616, 617, 565,
+	566, 567, 568, 621, 0, 0, 344, 345, 346, 618,
+	621, 0, 621, 621, 621, 621, 618, 618, 618, 621,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1605,
+	1605, 1605, 624, 621, 0, 601, 0, 602, 603, 606,
+	607, 1606, 1607, 1169, 608, 609, 1123, 638, 641, 658,
+	656, 657, 659, 651, 652, 653, 654, 0, 672, 673,
+	678, 0, 0, 0, 0, 684, 685, 686, 0, 0,
+	689, 690, 691, 0, 0, 0, 0, 800, 0, 0,
+	863, 864, 865, 866, 867, 868, 869, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 839, 840, 841,
+	842, 845, 0, 0, 0, 850, 851, 0, 0, 0,
+	0, 0, 707, 0, 1000, 0, 938, 0, 939, 941,
+	942, 0, 943, 0, 0, 0, 710, 0, 0, 710,
+	710, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 183, 184, 185, 186, 200, 201,
+	202, 203, 204, 252, 1008, 0, 873, 0, 0, 40,
+	662, 663, 0, 770, 1143, 0, 51, 60, 62, 1027,
+	55, 1027, 0, 712, 0, 0, -2, -2, 713, 719,
+	720, 721, 722, 723, 48, 1603, 49, 0, 68, 0,
+	42, 0, 0, 0, 0, 319, 1066, 0, 0, 1020,
+	1021, 1024, 0, 727, 731, 0, 733, 734, 0, 700,
+	93, 0, 786, 0, 0, 0, 1547, 1121, 0, 97,
+	98, 0, 0, 0, 323, 1126, 1127, 1128, -2, 342,
+	0, -2, 314, 258, 259, 260, 307, 262, 307, 307,
+	307, 307, 319, 319, 319, 319, 290, 291, 292, 293,
+	294, 0, 0, 277, 307, 307, 307, 307, 297, 298,
+	299, 300, 301, 302, 303, 304, 263, 264, 265, 266,
+	267, 268, 269, 270, 271, 309, 309, 309, 311, 311,
+	0, 38, 0, 327, 0, 1024, 0, 1057, 1135, 1145,
+	0, 0, 0, 1135, 115, 0, 0, 483, 443, 472,
+	484, 0, 446, 447, -2, 0, 0, 433, 0, 435,
+	0, 343, 0, -2, 0, 353, 0, 349, 353, 350,
+	353, 341, 354, 464, 465, 466, 0, 524, 756, 0,
+	0, 0, 0, 0, 530, 531, 532, 0, 534, 535,
+	536, 537, 538, 539, 540, 541, 542, 543, 473, 474,
+	475, 476, 477, 478, 479, 0, 0, 435, 0, 469,
+	0, 390, 391, 0, 0, 394, 395, 396, 397, 0,
+	0, 400, 401, 402, 773, 774, 403, 427, 428, 429,
+	404, 405, 406, 407, 408, 409, 410, 421, 422, 423,
+	424, 425, 426, 411, 412, 413, 414, 415, 418, 0,
+	125, 1048, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1133, 0, 0, 0, 0, 788, 1156,
+	1157, 622, 623, 0, 347, 348, 621, 621, 569, 592,
+	0, 621, 573, 594, 574, 576, 575, 577, 596, 597,
+	621, 580, 619, 620, 581, 582, 583, 584, 585, 586,
+	587, 588, 589, 590, 598, 599, 600, 625, 0, 0,
+	639, 643, 644, 645, 646, 0, 0, 675, 89, 680,
+	681, 682, 683, 695, 688, 875, 797, 798, 799, 801,
+	803, 0, 859, 861, 805, 807, 870, 871, 872, 0,
+	0, 0, 0, 0, 811, 815, 818, 819, 820, 821,
+	822, 823, 824, 825, 826, 827, 828, 829, 836, 983,
+	984, 985, 853, 854, 0, 724, 0, 0, 0, 852,
+	0, 0, 0, 0, 708, 709, 1006, 1003, 0, 0,
+	969, 971, 0, 0, 0, 0, 947, 948, 949, 950,
+	951, 952, 953, 954, 955, 956, 957, 958, 959, 960,
+	961, 962, 963, 964, 965, 966, 967, 0, 0, 0,
+	711, 1009, 0, -2, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1110, 0, 665, 666, 668, 0, 790, 0,
+	771, 0, 0, 1144, 671, 54, 56, 717, 718, 0,
+	735, 714, 50, 45, 0, 0, 750, 1064, 319, 1086,
+	0, 1030, 1031, 0, 1023, 1025, 1026, 73, 732, 728,
+	0, 0, 0, 785, 0, 738, 740, 741, 742, 768,
+	0, 0, 0, 0, 91, 787, 1116, 96, 0, 0,
+	101, 102, 1117, 1118, 1119, 1120, 0, 502, -2, 385,
+	153, 155, 156, 157, 148, 367, 0, 0, 317, 315,
+	316, 261, 319, 319, 284, 285, 286, 287, 288, 289,
+	0, 0, 278, 279, 280, 281, 272, 0, 273, 274,
+	275, 0, 276, 360, 0, 1032, 328, 329, 331, 335,
+	0, 0, 0, 356, 357, 0, 1048, 0, 0, 0,
+	1146, 1145, 1145, 1145, 0, 143, 144, 145, 146, 141,
+	0, 0, 149, 437, 436, 0, 0, 358, 0, 0,
+	353, 353, 338, 339, 467, 0, 0, 526, 527, 528,
+	529, 0, 0, 0, 435, 437, 323, 0, 392, 393,
+	398, 399, 416, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 497, 498, 499, 500, 501, 1045,
+	1046, 1047, 0, 0, 553, 0, 0, 377, 87, 1134,
+	558, 618, 579, 595, 618, 571, 578, 604, 635, 679,
+	0, 0, 0, 0, 687, 0, 804, 860, 862, 808,
+	0, 812, 816, 0, 0, 0, 0, 0, 0, 0,
+	856, 929, 930, 0, 0, 1018, 0, 1004, 0, 0,
+	937, 972, 0, 0, 0, 901, 0, 0, 710, 0,
+	973, 974, 975, 976, 977, 880, 0, 881, 882, 883,
+	884, 0, 895, 0, 895, 0, 895, 895, 0, 1009,
+	664, 667, 669, 746, 0, 0, 0, 0, 772, 1142,
+	0, 1011, 0, 0, 735, 795, 736, 0, 715, 47,
+	749, 0, 1068, 1067, 1080, 1093, 320, 321, 322, 1022,
+	0, 1104, 0, 0, 1096, 0, 0, 0, 0, 0,
+	0, 0, 0, 775, 0, 0, 778, 0, 0, 0,
+	0, 769, 0, 0, 0, 0, -2, 0, 0, 85,
+	86, 0, 0, 0, 99, 100, 0, 0, 106, 324,
+	325, 134, 0, 141, 387, 154, 158, 163, 0, 0,
+	0, 168, 0, 170, 0, 368, 0, 254, 256, 0,
+	0, 161, 257, 318, 282, 283, 0, 305, 0, 0,
+	0, 380, 111, 1036, 1035, 335, 335, 330, 0, 333,
+	0, 1147, 308, 0, 124, 0, 0, 0, 0, 0,
+	130, 0, 441, 0, 452, 453, 0, 523, 0, 327,
+	0, 337, 340, 757, 0, 0, 454, 0, 437, 458,
+	459, 470, 419, 420, 417, 0, 0, 1058, 1059, 0,
+	1062, 116, 490, 492, 491, 495, 0, 0, 488, 0,
+	377, 1032, 0, 557, 378, 379, 621, 621, 674, 90,
+	0, 677, 0, 0, 0, 809, 813, 725, 0, 0,
+	307, 307, 989, 307, 311, 992, 307, 994, 307, 997,
+	0, 0, 0, 0, 1001, 936, 1007, 0, 0, 0,
+	0, 876, 902, 877, 878, 0, 1010, 903, 911, 885,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1129, 1130, 1012, 1013, 795, 1014,
+	0, 737, 716, 751, 1086, 0, 1079, 0, -2, 1088,
+	0, 0, 0, 1094, 729, 76, 0, 1104, 1112, 0,
+	1095, 1106, 1108, 0, 0, 0, 1100, 0, 795, 739,
+	764, 766, 0, 761, 776, 777, 779, 0, 781, 0,
+	783, 784, 748, 744, 745, 0, 93, 0, 795, 795,
+	92, 0, 789, 103, 104, 105, 511, 386, 164, 0,
+	166, 181, 182, 187, 188, 190, 0, 0, 0, 169,
+	171, 172, 253, 255, 0, 0, 319, 0, 319, 0,
+	312, 0, 369, 381, 0, 1033, 1034, 0, 0, 332,
+	334, 0, 1136, 126, 0, 0, 0, 0, 150, 438,
+	0, 326, 0, 0, 548, 545, 455, 0, 457, 748,
+	1049, 1050, 1051, 0, 1061, 119, 0, 0, 0, 0,
+	554, 555, 556, 88, 564, 570, 676, 696, 810, 814,
+	0, 847, 986, 319, 990, 991, 993, 995, 996, 998,
+	848, 849, 0, 932, 0, 934, 1027, 0, 1005, 0,
+	0, 946, 879, 0, 905, 911, 907, 911, 1018, 0,
+	0, -2, 915, 886, 896, 897, 895, 0, 889, 895,
+	0, 892, 893, 898, 0, 0, 0, 770, 58, 1014,
+	1016, 0, 796, 1073, 1071, 1071, 1081, 1082, 0, 0,
+	1089, 0, 0, 0, 0, 77, 0, 0, 0, 1109,
+	0, 0, 0, 0, 94, 1018, 758, 765, 0, 0,
+	759, 0, 760, 780, 782, 743, -2, 795, 795, 83,
+	84, 0, 0, 0, 512, 515, 516, 0, 191, 192,
+	167, 0, 0, 295, 306, 296, 0, 0, 382, 1037,
+	1038, 1039, 1040, 1041, 1042, 1043, 748, 127, 128, 129,
+	444, 0, 0, 525, 0, 533, 0, 0, 0, 0,
+	1060, 489, 0, 117, 118, 0, 0, 494, 978, 987,
+	988, 0, 0, 0, 1002, 0, 0, 904, 906, 914,
+	908, 911, 912, 0, 0, 0, 0, 921, 922, 0,
+	0, 0, 0, 0, 0, 895, 0, 0, 792, 752,
+	753, 0, 0, 790, 1016, 924, 0, 0, 1076, 0,
+	1069, 1072, 1070, 1083, 0, 0, 1090, 0, 1092, 0,
+	1113, 1114, 1107, 0, 1099, 1102, 1098, 1101, 1027, 762,
+	0, 767, 1018, 82, 0, 513, 519, 0, 0, 165,
+	0, 175, 310, 313, 0, 0, 362, 549, 546, 547,
+	456, 0, 120, 121, 0, 0, 0, 0, 0, 0,
+	931, 933, 935, 944, 945, 909, 0, 916, 917, 918,
+	919, 920, 0, 910, 887, 895, 890, 895, 0, 899,
+	900, 0, 791, 793, 794, 747, 924, 75, 0, 1017,
+	1015, 1065, 0, 1074, 1075, 1084, 1085, 1091, 0, 1097,
+	0, 80, 0, 1027, 0, 0, 0, 506, 0, 0,
+	0, 363, 367, 0, 0, 1052, 0, 0, 0, 496,
+	846, 0, 0, 0, 0, 923, 0, 0, 894, 754,
+	755, 74, 925, 926, 0, 1077, 1078, 1105, 1103, 763,
+	81, 0, 0, 0, 509, 0, 520, 517, 0, 364,
+	365, 0, 159, 176, 177, 0, 0, 180, 173, 174,
+	1052, 0, 0, 486, 1054, 122, 123, 430, 431, 432,
+	116, 979, 0, 982, 913, 888, 891, 0, 0, 519,
+	0, 514, 505, 0, 511, 518, 366, 0, 0, 0,
+	178, 179, 112, 550, 551, 0, 1055, 1056, 119, 980,
+	927, 911, 506, 519, 0, 0, 370, 372, 373, 0,
+	0, 371, 0, 493, 0, 0, 509, 506, 510, 0,
+	513, 374, 375, 376, 1053, 0, 928, 503, 509, 0,
+	0, 981, 504, 0, 0, 521, 0, 507, 0, 521,
+	522, 508,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 123, 3, 3, 3, 149, 141, 3,
-	82, 84, 146, 144, 83, 145, 198, 147, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 550,
-	131, 130, 132, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 134, 3, 3, 3, 160, 152, 3,
+	93, 95, 157, 155, 94, 156, 209, 158, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 553,
+	142, 141, 143, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 151, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 162, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 119, 3, 152,
+	3, 3, 3, 3, 130, 3, 163,
 }
 
 var yyTok2 = [...]int{
@@ -6174,18 +6430,18 @@ var yyTok2 = [...]int{
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
 	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
 	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	82, 83, 84, 85, 86, 87, 88, 89, 90, 91,
+	92, 96, 97, 98, 99, 100, 101, 102, 103, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 120, 121, 122, 124, 125, 126,
-	127, 128, 129, 133, 134, 135, 136, 137, 138, 139,
-	140, 142, 143, 148, 150, 153, 154, 155, 156, 157,
-	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 131, 132, 133, 135, 136,
+	137, 138, 139, 140, 144, 145, 146, 147, 148, 149,
+	150, 151, 153, 154, 159, 161, 164, 165, 166, 167,
 	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
 	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
 	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	198, 199, 200, 201, 202, 203, 204, 205, 206, 207,
+	208, 210, 211, 212, 213, 214, 215, 216, 217, 218,
 	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
 	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
 	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
@@ -6250,7 +6506,7 @@ var yyTok3 = [...]int{
 	57860, 535, 57861, 536, 57862, 537, 57863, 538, 57864, 539,
 	57865, 540, 57866, 541, 57867, 542, 57868, 543, 57869, 544,
 	57870, 545, 57871, 546, 57872, 547, 57873, 548, 57874, 549,
-	0,
+	57875, 550, 57876, 551, 57877, 552, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -6600,68 +6856,68 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:462
+//line sql.y:485
 		{
 			setParseTree(yylex, yyDollar[1].statementUnion())
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:467
+//line sql.y:490
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:468
+//line sql.y:491
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:472
+//line sql.y:495
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 33:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:504
+//line sql.y:527
 		{
 			setParseTree(yylex, nil)
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:510
+//line sql.y:533
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), NoAt)
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:514
+//line sql.y:537
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), SingleAt)
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:518
+//line sql.y:541
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), DoubleAt)
 		}
 	case 37:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:523
+//line sql.y:546
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:527
+//line sql.y:550
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 39:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:533
+//line sql.y:556
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
@@ -6669,7 +6925,7 @@ yydefault:
 	case 40:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:539
+//line sql.y:562
 		{
 			yyLOCAL = &Load{}
 		}
@@ -6677,23 +6933,23 @@ yydefault:
 	case 41:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *With
-//line sql.y:545
+//line sql.y:568
 		{
-			yyLOCAL = &With{ctes: yyDollar[2].ctesUnion(), Recursive: false}
+			yyLOCAL = &With{Ctes: yyDollar[2].ctesUnion(), Recursive: false}
 		}
 		yyVAL.union = yyLOCAL
 	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *With
-//line sql.y:549
+//line sql.y:572
 		{
-			yyLOCAL = &With{ctes: yyDollar[3].ctesUnion(), Recursive: true}
+			yyLOCAL = &With{Ctes: yyDollar[3].ctesUnion(), Recursive: true}
 		}
 		yyVAL.union = yyLOCAL
 	case 43:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *With
-//line sql.y:554
+//line sql.y:577
 		{
 			yyLOCAL = nil
 		}
@@ -6701,14 +6957,14 @@ yydefault:
 	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *With
-//line sql.y:558
+//line sql.y:581
 		{
 			yyLOCAL = yyDollar[1].withUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 45:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:564
+//line sql.y:587
 		{
 			yySLICE := (*[]*CommonTableExpr)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].cteUnion())
@@ -6716,7 +6972,7 @@ yydefault:
 	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*CommonTableExpr
-//line sql.y:568
+//line sql.y:591
 		{
 			yyLOCAL = []*CommonTableExpr{yyDollar[1].cteUnion()}
 		}
@@ -6724,7 +6980,7 @@ yydefault:
 	case 47:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *CommonTableExpr
-//line sql.y:574
+//line sql.y:597
 		{
 			yyLOCAL = &CommonTableExpr{TableID: yyDollar[1].tableIdent, Columns: yyDollar[2].columnsUnion(), Subquery: yyDollar[4].subqueryUnion()}
 		}
@@ -6732,7 +6988,7 @@ yydefault:
 	case 48:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:580
+//line sql.y:603
 		{
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
@@ -6740,7 +6996,7 @@ yydefault:
 	case 49:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:584
+//line sql.y:607
 		{
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
@@ -6748,7 +7004,7 @@ yydefault:
 	case 50:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:588
+//line sql.y:611
 		{
 			setLockInSelect(yyDollar[2].selStmtUnion(), yyDollar[3].lockUnion())
 			yyLOCAL = yyDollar[2].selStmtUnion()
@@ -6757,7 +7013,7 @@ yydefault:
 	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:611
+//line sql.y:634
 		{
 			yyDollar[1].selStmtUnion().SetOrderBy(yyDollar[2].orderByUnion())
 			yyDollar[1].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
@@ -6767,7 +7023,7 @@ yydefault:
 	case 52:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:617
+//line sql.y:640
 		{
 			yyDollar[1].selStmtUnion().SetLimit(yyDollar[2].limitUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
@@ -6776,7 +7032,7 @@ yydefault:
 	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:622
+//line sql.y:645
 		{
 			yyDollar[1].selStmtUnion().SetOrderBy(yyDollar[2].orderByUnion())
 			yyDollar[1].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
@@ -6786,7 +7042,7 @@ yydefault:
 	case 54:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:628
+//line sql.y:651
 		{
 			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
 			yyDollar[2].selStmtUnion().SetOrderBy(yyDollar[3].orderByUnion())
@@ -6797,7 +7053,7 @@ yydefault:
 	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:635
+//line sql.y:658
 		{
 			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
 			yyDollar[2].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
@@ -6807,7 +7063,7 @@ yydefault:
 	case 56:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:641
+//line sql.y:664
 		{
 			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
 			yyDollar[2].selStmtUnion().SetOrderBy(yyDollar[3].orderByUnion())
@@ -6817,14 +7073,14 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 57:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:648
+//line sql.y:671
 		{
 			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
 		}
 	case 58:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:652
+//line sql.y:675
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), SelectExprs{&Nextval{Expr: yyDollar[5].exprUnion()}}, []string{yyDollar[3].str} /*options*/, nil, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
@@ -6832,7 +7088,7 @@ yydefault:
 	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:658
+//line sql.y:681
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
@@ -6840,7 +7096,7 @@ yydefault:
 	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:662
+//line sql.y:685
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
@@ -6848,7 +7104,7 @@ yydefault:
 	case 61:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:666
+//line sql.y:689
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
@@ -6856,7 +7112,7 @@ yydefault:
 	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:670
+//line sql.y:693
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
@@ -6864,7 +7120,7 @@ yydefault:
 	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:674
+//line sql.y:697
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
@@ -6872,7 +7128,7 @@ yydefault:
 	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:680
+//line sql.y:703
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
@@ -6880,7 +7136,7 @@ yydefault:
 	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:684
+//line sql.y:707
 		{
 			setLockInSelect(yyDollar[1].selStmtUnion(), yyDollar[2].lockUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
@@ -6889,7 +7145,7 @@ yydefault:
 	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:689
+//line sql.y:712
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
@@ -6897,7 +7153,7 @@ yydefault:
 	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:693
+//line sql.y:716
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
@@ -6905,7 +7161,7 @@ yydefault:
 	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:699
+//line sql.y:722
 		{
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
@@ -6913,7 +7169,7 @@ yydefault:
 	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:703
+//line sql.y:726
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
@@ -6922,7 +7178,7 @@ yydefault:
 	case 70:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:708
+//line sql.y:731
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
 			yyDollar[1].selStmtUnion().SetLock(yyDollar[3].lockUnion())
@@ -6932,7 +7188,7 @@ yydefault:
 	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:714
+//line sql.y:737
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[3].selectIntoUnion())
 			yyDollar[1].selStmtUnion().SetLock(yyDollar[2].lockUnion())
@@ -6942,7 +7198,7 @@ yydefault:
 	case 72:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:720
+//line sql.y:743
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
@@ -6951,31 +7207,35 @@ yydefault:
 	case 73:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:727
+//line sql.y:750
 		{
 			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 74:
-		yyDollar = yyS[yypt-9 : yypt+1]
+		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:735
+//line sql.y:758
 		{
-			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].selectIntoUnion() /*into*/, yyDollar[6].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[7].exprUnion()), GroupBy(yyDollar[8].exprsUnion()), NewWhere(HavingClause, yyDollar[9].exprUnion()))
+			sel := NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].selectIntoUnion() /*into*/, yyDollar[6].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[7].exprUnion()), GroupBy(yyDollar[8].exprsUnion()), NewWhere(HavingClause, yyDollar[9].exprUnion()))
+			sel.Windows = yyDollar[10].windowDefinitionsUnion()
+			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
 	case 75:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:739
+//line sql.y:764
 		{
-			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, nil, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
+			sel := NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, nil, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
+			sel.Windows = yyDollar[9].windowDefinitionsUnion()
+			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
 	case 76:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:747
+//line sql.y:774
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].insUnion()
@@ -6991,7 +7251,7 @@ yydefault:
 	case 77:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:759
+//line sql.y:786
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprsUnion()))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprsUnion()))
@@ -7005,7 +7265,7 @@ yydefault:
 	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:771
+//line sql.y:798
 		{
 			yyLOCAL = InsertAct
 		}
@@ -7013,7 +7273,7 @@ yydefault:
 	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:775
+//line sql.y:802
 		{
 			yyLOCAL = ReplaceAct
 		}
@@ -7021,7 +7281,7 @@ yydefault:
 	case 80:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:781
+//line sql.y:808
 		{
 			yyLOCAL = &Update{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), TableExprs: yyDollar[5].tableExprsUnion(), Exprs: yyDollar[7].updateExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion()), OrderBy: yyDollar[9].orderByUnion(), Limit: yyDollar[10].limitUnion()}
 		}
@@ -7029,7 +7289,7 @@ yydefault:
 	case 81:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:787
+//line sql.y:814
 		{
 			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[6].tableName, As: yyDollar[7].tableIdent}}, Partitions: yyDollar[8].partitionsUnion(), Where: NewWhere(WhereClause, yyDollar[9].exprUnion()), OrderBy: yyDollar[10].orderByUnion(), Limit: yyDollar[11].limitUnion()}
 		}
@@ -7037,7 +7297,7 @@ yydefault:
 	case 82:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:791
+//line sql.y:818
 		{
 			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[6].tableNamesUnion(), TableExprs: yyDollar[8].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[9].exprUnion())}
 		}
@@ -7045,7 +7305,7 @@ yydefault:
 	case 83:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:795
+//line sql.y:822
 		{
 			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
@@ -7053,32 +7313,32 @@ yydefault:
 	case 84:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:799
+//line sql.y:826
 		{
 			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
 	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:804
+//line sql.y:831
 		{
 		}
 	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:805
+//line sql.y:832
 		{
 		}
 	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:809
+//line sql.y:836
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
 		yyVAL.union = yyLOCAL
 	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:813
+//line sql.y:840
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName.ToViewName())
@@ -7086,14 +7346,14 @@ yydefault:
 	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:819
+//line sql.y:846
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:823
+//line sql.y:850
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
@@ -7101,14 +7361,14 @@ yydefault:
 	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:829
+//line sql.y:856
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 92:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:833
+//line sql.y:860
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
@@ -7116,7 +7376,7 @@ yydefault:
 	case 93:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:838
+//line sql.y:865
 		{
 			yyLOCAL = nil
 		}
@@ -7124,7 +7384,7 @@ yydefault:
 	case 94:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:842
+//line sql.y:869
 		{
 			yyLOCAL = yyDollar[3].partitionsUnion()
 		}
@@ -7132,7 +7392,7 @@ yydefault:
 	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:848
+//line sql.y:875
 		{
 			yyLOCAL = &Set{Comments: Comments(yyDollar[2].strs), Exprs: yyDollar[3].setExprsUnion()}
 		}
@@ -7140,7 +7400,7 @@ yydefault:
 	case 96:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:854
+//line sql.y:881
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Scope: yyDollar[3].scopeUnion(), Characteristics: yyDollar[5].characteristicsUnion()}
 		}
@@ -7148,7 +7408,7 @@ yydefault:
 	case 97:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:858
+//line sql.y:885
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Characteristics: yyDollar[4].characteristicsUnion(), Scope: ImplicitScope}
 		}
@@ -7156,14 +7416,14 @@ yydefault:
 	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []Characteristic
-//line sql.y:864
+//line sql.y:891
 		{
 			yyLOCAL = []Characteristic{yyDollar[1].characteristicUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:868
+//line sql.y:895
 		{
 			yySLICE := (*[]Characteristic)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].characteristicUnion())
@@ -7171,7 +7431,7 @@ yydefault:
 	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:874
+//line sql.y:901
 		{
 			yyLOCAL = yyDollar[3].isolationLevelUnion()
 		}
@@ -7179,7 +7439,7 @@ yydefault:
 	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:878
+//line sql.y:905
 		{
 			yyLOCAL = ReadWrite
 		}
@@ -7187,7 +7447,7 @@ yydefault:
 	case 102:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:882
+//line sql.y:909
 		{
 			yyLOCAL = ReadOnly
 		}
@@ -7195,7 +7455,7 @@ yydefault:
 	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:888
+//line sql.y:915
 		{
 			yyLOCAL = RepeatableRead
 		}
@@ -7203,7 +7463,7 @@ yydefault:
 	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:892
+//line sql.y:919
 		{
 			yyLOCAL = ReadCommitted
 		}
@@ -7211,7 +7471,7 @@ yydefault:
 	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:896
+//line sql.y:923
 		{
 			yyLOCAL = ReadUncommitted
 		}
@@ -7219,7 +7479,7 @@ yydefault:
 	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:900
+//line sql.y:927
 		{
 			yyLOCAL = Serializable
 		}
@@ -7227,7 +7487,7 @@ yydefault:
 	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:906
+//line sql.y:933
 		{
 			yyLOCAL = SessionScope
 		}
@@ -7235,7 +7495,7 @@ yydefault:
 	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:910
+//line sql.y:937
 		{
 			yyLOCAL = GlobalScope
 		}
@@ -7243,7 +7503,7 @@ yydefault:
 	case 109:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:916
+//line sql.y:943
 		{
 			yyDollar[1].createTableUnion().TableSpec = yyDollar[2].tableSpecUnion()
 			yyDollar[1].createTableUnion().FullyParsed = true
@@ -7253,7 +7513,7 @@ yydefault:
 	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:922
+//line sql.y:949
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTableUnion().OptLike = yyDollar[2].optLikeUnion()
@@ -7264,7 +7524,7 @@ yydefault:
 	case 111:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:929
+//line sql.y:956
 		{
 			indexDef := yyDollar[1].alterTableUnion().AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumnsUnion()
@@ -7277,7 +7537,7 @@ yydefault:
 	case 112:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:938
+//line sql.y:965
 		{
 			yyLOCAL = &CreateView{ViewName: yyDollar[8].tableName.ToViewName(), IsReplace: yyDollar[3].booleanUnion(), Algorithm: yyDollar[4].str, Definer: yyDollar[5].str, Security: yyDollar[6].str, Columns: yyDollar[9].columnsUnion(), Select: yyDollar[11].selStmtUnion(), CheckOption: yyDollar[12].str}
 		}
@@ -7285,7 +7545,7 @@ yydefault:
 	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:942
+//line sql.y:969
 		{
 			yyDollar[1].createDatabaseUnion().FullyParsed = true
 			yyDollar[1].createDatabaseUnion().CreateOptions = yyDollar[2].collateAndCharsetsUnion()
@@ -7295,7 +7555,7 @@ yydefault:
 	case 114:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:949
+//line sql.y:976
 		{
 			yyLOCAL = false
 		}
@@ -7303,33 +7563,33 @@ yydefault:
 	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:953
+//line sql.y:980
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 116:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:958
+//line sql.y:985
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
 	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:962
+//line sql.y:989
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
 	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:968
+//line sql.y:995
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 119:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:973
+//line sql.y:1000
 		{
 			var v []VindexParam
 			yyLOCAL = v
@@ -7338,7 +7598,7 @@ yydefault:
 	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:978
+//line sql.y:1005
 		{
 			yyLOCAL = yyDollar[2].vindexParamsUnion()
 		}
@@ -7346,7 +7606,7 @@ yydefault:
 	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:984
+//line sql.y:1011
 		{
 			yyLOCAL = make([]VindexParam, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].vindexParam)
@@ -7354,21 +7614,21 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 122:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:989
+//line sql.y:1016
 		{
 			yySLICE := (*[]VindexParam)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].vindexParam)
 		}
 	case 123:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:995
+//line sql.y:1022
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
 	case 124:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateTable
-//line sql.y:1001
+//line sql.y:1028
 		{
 			yyLOCAL = &CreateTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[6].tableName, IfNotExists: yyDollar[5].booleanUnion(), Temp: yyDollar[3].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
@@ -7377,7 +7637,7 @@ yydefault:
 	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1008
+//line sql.y:1035
 		{
 			yyLOCAL = &AlterTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[4].tableName}
 			setDDL(yylex, yyLOCAL)
@@ -7386,7 +7646,7 @@ yydefault:
 	case 126:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1015
+//line sql.y:1042
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[3].str)}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -7395,7 +7655,7 @@ yydefault:
 	case 127:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1020
+//line sql.y:1047
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Fulltext: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -7404,7 +7664,7 @@ yydefault:
 	case 128:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1025
+//line sql.y:1052
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Spatial: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -7413,7 +7673,7 @@ yydefault:
 	case 129:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1030
+//line sql.y:1057
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Unique: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -7422,7 +7682,7 @@ yydefault:
 	case 130:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateDatabase
-//line sql.y:1037
+//line sql.y:1064
 		{
 			yyLOCAL = &CreateDatabase{Comments: Comments(yyDollar[4].strs), DBName: yyDollar[6].tableIdent, IfNotExists: yyDollar[5].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
@@ -7431,7 +7691,7 @@ yydefault:
 	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AlterDatabase
-//line sql.y:1044
+//line sql.y:1071
 		{
 			yyLOCAL = &AlterDatabase{}
 			setDDL(yylex, yyLOCAL)
@@ -7440,7 +7700,7 @@ yydefault:
 	case 134:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1055
+//line sql.y:1082
 		{
 			yyLOCAL = yyDollar[2].tableSpecUnion()
 			yyLOCAL.Options = yyDollar[4].tableOptionsUnion()
@@ -7450,7 +7710,7 @@ yydefault:
 	case 135:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:1062
+//line sql.y:1089
 		{
 			yyLOCAL = nil
 		}
@@ -7458,7 +7718,7 @@ yydefault:
 	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:1066
+//line sql.y:1093
 		{
 			yyLOCAL = yyDollar[1].collateAndCharsetsUnion()
 		}
@@ -7466,7 +7726,7 @@ yydefault:
 	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:1072
+//line sql.y:1099
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
@@ -7474,21 +7734,21 @@ yydefault:
 	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:1076
+//line sql.y:1103
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
 	case 139:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1080
+//line sql.y:1107
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
 	case 140:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1084
+//line sql.y:1111
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
@@ -7496,7 +7756,7 @@ yydefault:
 	case 141:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1090
+//line sql.y:1117
 		{
 			yyLOCAL = false
 		}
@@ -7504,39 +7764,39 @@ yydefault:
 	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1094
+//line sql.y:1121
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 143:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1100
+//line sql.y:1127
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 144:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1104
+//line sql.y:1131
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 145:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1110
+//line sql.y:1137
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 146:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1114
+//line sql.y:1141
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 147:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:1121
+//line sql.y:1148
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
@@ -7544,7 +7804,7 @@ yydefault:
 	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:1125
+//line sql.y:1152
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
@@ -7552,14 +7812,14 @@ yydefault:
 	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*ColumnDefinition
-//line sql.y:1131
+//line sql.y:1158
 		{
 			yyLOCAL = []*ColumnDefinition{yyDollar[1].columnDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1135
+//line sql.y:1162
 		{
 			yySLICE := (*[]*ColumnDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].columnDefinitionUnion())
@@ -7567,7 +7827,7 @@ yydefault:
 	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1141
+//line sql.y:1168
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddColumn(yyDollar[1].columnDefinitionUnion())
@@ -7576,7 +7836,7 @@ yydefault:
 	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1146
+//line sql.y:1173
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddConstraint(yyDollar[1].constraintDefinitionUnion())
@@ -7584,39 +7844,39 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1151
+//line sql.y:1178
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 		}
 	case 154:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1155
+//line sql.y:1182
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[4].constraintDefinitionUnion())
 		}
 	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1160
+//line sql.y:1187
 		{
 			yyVAL.tableSpecUnion().AddIndex(yyDollar[3].indexDefinitionUnion())
 		}
 	case 156:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1164
+//line sql.y:1191
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
 	case 157:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1168
+//line sql.y:1195
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
 	case 158:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1174
+//line sql.y:1201
 		{
 			yyDollar[2].columnType.Options = yyDollar[3].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.Reference = yyDollar[4].referenceDefinitionUnion()
@@ -7626,7 +7886,7 @@ yydefault:
 	case 159:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1180
+//line sql.y:1207
 		{
 			yyDollar[2].columnType.Options = yyDollar[8].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.As = yyDollar[6].exprUnion()
@@ -7636,20 +7896,20 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 160:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1188
+//line sql.y:1215
 		{
 			yyVAL.str = ""
 		}
 	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1192
+//line sql.y:1219
 		{
 			yyVAL.str = ""
 		}
 	case 162:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1201
+//line sql.y:1228
 		{
 			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil, As: nil}
 		}
@@ -7657,7 +7917,7 @@ yydefault:
 	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1205
+//line sql.y:1232
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -7667,7 +7927,7 @@ yydefault:
 	case 164:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1211
+//line sql.y:1238
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -7677,7 +7937,7 @@ yydefault:
 	case 165:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1217
+//line sql.y:1244
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7686,7 +7946,7 @@ yydefault:
 	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1222
+//line sql.y:1249
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[3].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7695,7 +7955,7 @@ yydefault:
 	case 167:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1227
+//line sql.y:1254
 		{
 			yyDollar[1].columnTypeOptionsUnion().OnUpdate = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7704,7 +7964,7 @@ yydefault:
 	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1232
+//line sql.y:1259
 		{
 			yyDollar[1].columnTypeOptionsUnion().Autoincrement = true
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7713,7 +7973,7 @@ yydefault:
 	case 169:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1237
+//line sql.y:1264
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7722,7 +7982,7 @@ yydefault:
 	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1242
+//line sql.y:1269
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7730,14 +7990,14 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1247
+//line sql.y:1274
 		{
 			yyDollar[1].columnTypeOptionsUnion().Collate = encodeSQLString(yyDollar[3].str)
 		}
 	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1251
+//line sql.y:1278
 		{
 			yyDollar[1].columnTypeOptionsUnion().Collate = string(yyDollar[3].colIdent.String())
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7746,7 +8006,7 @@ yydefault:
 	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1258
+//line sql.y:1285
 		{
 			yyLOCAL = VirtualStorage
 		}
@@ -7754,7 +8014,7 @@ yydefault:
 	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1262
+//line sql.y:1289
 		{
 			yyLOCAL = StoredStorage
 		}
@@ -7762,7 +8022,7 @@ yydefault:
 	case 175:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1267
+//line sql.y:1294
 		{
 			yyLOCAL = &ColumnTypeOptions{}
 		}
@@ -7770,7 +8030,7 @@ yydefault:
 	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1271
+//line sql.y:1298
 		{
 			yyDollar[1].columnTypeOptionsUnion().Storage = yyDollar[2].columnStorageUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7779,7 +8039,7 @@ yydefault:
 	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1276
+//line sql.y:1303
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -7789,7 +8049,7 @@ yydefault:
 	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1282
+//line sql.y:1309
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -7799,7 +8059,7 @@ yydefault:
 	case 179:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1288
+//line sql.y:1315
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7808,7 +8068,7 @@ yydefault:
 	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1293
+//line sql.y:1320
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -7817,7 +8077,7 @@ yydefault:
 	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1300
+//line sql.y:1327
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -7825,7 +8085,7 @@ yydefault:
 	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1307
+//line sql.y:1334
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
@@ -7833,7 +8093,7 @@ yydefault:
 	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1311
+//line sql.y:1338
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].literalUnion()}
 		}
@@ -7841,7 +8101,7 @@ yydefault:
 	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1315
+//line sql.y:1342
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
@@ -7849,7 +8109,7 @@ yydefault:
 	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1319
+//line sql.y:1346
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
@@ -7857,7 +8117,7 @@ yydefault:
 	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1330
+//line sql.y:1357
 		{
 			yyLOCAL = &NullVal{}
 		}
@@ -7865,7 +8125,7 @@ yydefault:
 	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1337
+//line sql.y:1364
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
@@ -7873,7 +8133,7 @@ yydefault:
 	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1341
+//line sql.y:1368
 		{
 			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
 		}
@@ -7881,7 +8141,7 @@ yydefault:
 	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1347
+//line sql.y:1374
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -7889,7 +8149,7 @@ yydefault:
 	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1351
+//line sql.y:1378
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -7897,7 +8157,7 @@ yydefault:
 	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1355
+//line sql.y:1382
 		{
 			yyLOCAL = yyDollar[1].boolValUnion()
 		}
@@ -7905,7 +8165,7 @@ yydefault:
 	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1359
+//line sql.y:1386
 		{
 			yyLOCAL = NewHexLiteral(yyDollar[1].str)
 		}
@@ -7913,7 +8173,7 @@ yydefault:
 	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1363
+//line sql.y:1390
 		{
 			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
 		}
@@ -7921,7 +8181,7 @@ yydefault:
 	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1367
+//line sql.y:1394
 		{
 			yyLOCAL = NewBitLiteral(yyDollar[1].str)
 		}
@@ -7929,7 +8189,7 @@ yydefault:
 	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1371
+//line sql.y:1398
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
@@ -7938,7 +8198,7 @@ yydefault:
 	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1376
+//line sql.y:1403
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewBitLiteral(yyDollar[2].str)}
 		}
@@ -7946,7 +8206,7 @@ yydefault:
 	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1380
+//line sql.y:1407
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewHexNumLiteral(yyDollar[2].str)}
 		}
@@ -7954,7 +8214,7 @@ yydefault:
 	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1384
+//line sql.y:1411
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewHexLiteral(yyDollar[2].str)}
 		}
@@ -7962,7 +8222,7 @@ yydefault:
 	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1388
+//line sql.y:1415
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: yyDollar[2].colNameUnion()}
 		}
@@ -7970,7 +8230,7 @@ yydefault:
 	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1392
+//line sql.y:1419
 		{
 			bindVariable(yylex, yyDollar[2].str[1:])
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewArgument(yyDollar[2].str[1:])}
@@ -7978,254 +8238,254 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1399
+//line sql.y:1426
 		{
 			yyVAL.str = Armscii8Str
 		}
 	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1403
+//line sql.y:1430
 		{
 			yyVAL.str = ASCIIStr
 		}
 	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1407
+//line sql.y:1434
 		{
 			yyVAL.str = Big5Str
 		}
 	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1411
+//line sql.y:1438
 		{
 			yyVAL.str = UBinaryStr
 		}
 	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1415
+//line sql.y:1442
 		{
 			yyVAL.str = Cp1250Str
 		}
 	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1419
+//line sql.y:1446
 		{
 			yyVAL.str = Cp1251Str
 		}
 	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1423
+//line sql.y:1450
 		{
 			yyVAL.str = Cp1256Str
 		}
 	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1427
+//line sql.y:1454
 		{
 			yyVAL.str = Cp1257Str
 		}
 	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1431
+//line sql.y:1458
 		{
 			yyVAL.str = Cp850Str
 		}
 	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1435
+//line sql.y:1462
 		{
 			yyVAL.str = Cp852Str
 		}
 	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1439
+//line sql.y:1466
 		{
 			yyVAL.str = Cp866Str
 		}
 	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1443
+//line sql.y:1470
 		{
 			yyVAL.str = Cp932Str
 		}
 	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1447
+//line sql.y:1474
 		{
 			yyVAL.str = Dec8Str
 		}
 	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1451
+//line sql.y:1478
 		{
 			yyVAL.str = EucjpmsStr
 		}
 	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1455
+//line sql.y:1482
 		{
 			yyVAL.str = EuckrStr
 		}
 	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1459
+//line sql.y:1486
 		{
 			yyVAL.str = Gb18030Str
 		}
 	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1463
+//line sql.y:1490
 		{
 			yyVAL.str = Gb2312Str
 		}
 	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1467
+//line sql.y:1494
 		{
 			yyVAL.str = GbkStr
 		}
 	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1471
+//line sql.y:1498
 		{
 			yyVAL.str = Geostd8Str
 		}
 	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1475
+//line sql.y:1502
 		{
 			yyVAL.str = GreekStr
 		}
 	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1479
+//line sql.y:1506
 		{
 			yyVAL.str = HebrewStr
 		}
 	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1483
+//line sql.y:1510
 		{
 			yyVAL.str = Hp8Str
 		}
 	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1487
+//line sql.y:1514
 		{
 			yyVAL.str = Keybcs2Str
 		}
 	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1491
+//line sql.y:1518
 		{
 			yyVAL.str = Koi8rStr
 		}
 	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1495
+//line sql.y:1522
 		{
 			yyVAL.str = Koi8uStr
 		}
 	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1499
+//line sql.y:1526
 		{
 			yyVAL.str = Latin1Str
 		}
 	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1503
+//line sql.y:1530
 		{
 			yyVAL.str = Latin2Str
 		}
 	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1507
+//line sql.y:1534
 		{
 			yyVAL.str = Latin5Str
 		}
 	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1511
+//line sql.y:1538
 		{
 			yyVAL.str = Latin7Str
 		}
 	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1515
+//line sql.y:1542
 		{
 			yyVAL.str = MacceStr
 		}
 	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1519
+//line sql.y:1546
 		{
 			yyVAL.str = MacromanStr
 		}
 	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1523
+//line sql.y:1550
 		{
 			yyVAL.str = SjisStr
 		}
 	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1527
+//line sql.y:1554
 		{
 			yyVAL.str = Swe7Str
 		}
 	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1531
+//line sql.y:1558
 		{
 			yyVAL.str = Tis620Str
 		}
 	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1535
+//line sql.y:1562
 		{
 			yyVAL.str = Ucs2Str
 		}
 	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1539
+//line sql.y:1566
 		{
 			yyVAL.str = UjisStr
 		}
 	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1543
+//line sql.y:1570
 		{
 			yyVAL.str = Utf16Str
 		}
 	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1547
+//line sql.y:1574
 		{
 			yyVAL.str = Utf16leStr
 		}
 	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1551
+//line sql.y:1578
 		{
 			yyVAL.str = Utf32Str
 		}
 	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1555
+//line sql.y:1582
 		{
 			yyVAL.str = Utf8Str
 		}
 	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1559
+//line sql.y:1586
 		{
 			yyVAL.str = Utf8mb4Str
 		}
 	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1569
+//line sql.y:1596
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
@@ -8233,7 +8493,7 @@ yydefault:
 	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1573
+//line sql.y:1600
 		{
 			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
 		}
@@ -8241,7 +8501,7 @@ yydefault:
 	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1580
+//line sql.y:1607
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
@@ -8249,7 +8509,7 @@ yydefault:
 	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1584
+//line sql.y:1611
 		{
 			yyLOCAL = &UnaryExpr{Operator: NStringOp, Expr: NewStrLiteral(yyDollar[1].str)}
 		}
@@ -8257,7 +8517,7 @@ yydefault:
 	case 252:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1588
+//line sql.y:1615
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewStrLiteral(yyDollar[2].str)}
 		}
@@ -8265,7 +8525,7 @@ yydefault:
 	case 253:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1594
+//line sql.y:1621
 		{
 			yyLOCAL = colKeyPrimary
 		}
@@ -8273,7 +8533,7 @@ yydefault:
 	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1598
+//line sql.y:1625
 		{
 			yyLOCAL = colKeyUnique
 		}
@@ -8281,7 +8541,7 @@ yydefault:
 	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1602
+//line sql.y:1629
 		{
 			yyLOCAL = colKeyUniqueKey
 		}
@@ -8289,14 +8549,14 @@ yydefault:
 	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1606
+//line sql.y:1633
 		{
 			yyLOCAL = colKey
 		}
 		yyVAL.union = yyLOCAL
 	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1612
+//line sql.y:1639
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
@@ -8304,74 +8564,74 @@ yydefault:
 		}
 	case 261:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1623
+//line sql.y:1650
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literalUnion()
 		}
 	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1628
+//line sql.y:1655
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1634
+//line sql.y:1661
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1638
+//line sql.y:1665
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1642
+//line sql.y:1669
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1646
+//line sql.y:1673
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1650
+//line sql.y:1677
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1654
+//line sql.y:1681
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1658
+//line sql.y:1685
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1662
+//line sql.y:1689
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1666
+//line sql.y:1693
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 272:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1672
+//line sql.y:1699
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -8379,7 +8639,7 @@ yydefault:
 		}
 	case 273:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1678
+//line sql.y:1705
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -8387,7 +8647,7 @@ yydefault:
 		}
 	case 274:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1684
+//line sql.y:1711
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -8395,7 +8655,7 @@ yydefault:
 		}
 	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1690
+//line sql.y:1717
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -8403,7 +8663,7 @@ yydefault:
 		}
 	case 276:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1696
+//line sql.y:1723
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -8411,189 +8671,189 @@ yydefault:
 		}
 	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1704
+//line sql.y:1731
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1708
+//line sql.y:1735
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 279:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1712
+//line sql.y:1739
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 280:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1716
+//line sql.y:1743
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 281:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1720
+//line sql.y:1747
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1726
+//line sql.y:1753
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
 	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1730
+//line sql.y:1757
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
 	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1734
+//line sql.y:1761
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1738
+//line sql.y:1765
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 286:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1742
+//line sql.y:1769
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
 	case 287:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1746
+//line sql.y:1773
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
 	case 288:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1750
+//line sql.y:1777
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
 	case 289:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1754
+//line sql.y:1781
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
 	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1758
+//line sql.y:1785
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1762
+//line sql.y:1789
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1766
+//line sql.y:1793
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1770
+//line sql.y:1797
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1774
+//line sql.y:1801
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 295:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1778
+//line sql.y:1805
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str}
 		}
 	case 296:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1783
+//line sql.y:1810
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str}
 		}
 	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1789
+//line sql.y:1816
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1793
+//line sql.y:1820
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1797
+//line sql.y:1824
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1801
+//line sql.y:1828
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1805
+//line sql.y:1832
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1809
+//line sql.y:1836
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1813
+//line sql.y:1840
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 304:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1817
+//line sql.y:1844
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1823
+//line sql.y:1850
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, encodeSQLString(yyDollar[1].str))
 		}
 	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1828
+//line sql.y:1855
 		{
 			yyVAL.strs = append(yyDollar[1].strs, encodeSQLString(yyDollar[3].str))
 		}
 	case 307:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1833
+//line sql.y:1860
 		{
 			yyLOCAL = nil
 		}
@@ -8601,20 +8861,20 @@ yydefault:
 	case 308:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1837
+//line sql.y:1864
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
 	case 309:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1842
+//line sql.y:1869
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 310:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1846
+//line sql.y:1873
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -8623,13 +8883,13 @@ yydefault:
 		}
 	case 311:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1854
+//line sql.y:1881
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1858
+//line sql.y:1885
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -8637,7 +8897,7 @@ yydefault:
 		}
 	case 313:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1864
+//line sql.y:1891
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -8647,7 +8907,7 @@ yydefault:
 	case 314:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1872
+//line sql.y:1899
 		{
 			yyLOCAL = false
 		}
@@ -8655,7 +8915,7 @@ yydefault:
 	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1876
+//line sql.y:1903
 		{
 			yyLOCAL = true
 		}
@@ -8663,7 +8923,7 @@ yydefault:
 	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1880
+//line sql.y:1907
 		{
 			yyLOCAL = false
 		}
@@ -8671,7 +8931,7 @@ yydefault:
 	case 317:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1885
+//line sql.y:1912
 		{
 			yyLOCAL = false
 		}
@@ -8679,57 +8939,57 @@ yydefault:
 	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1889
+//line sql.y:1916
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 319:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1894
+//line sql.y:1921
 		{
 			yyVAL.str = ""
 		}
 	case 320:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1898
+//line sql.y:1925
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
 	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1902
+//line sql.y:1929
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
 	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1906
+//line sql.y:1933
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
 	case 323:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1911
+//line sql.y:1938
 		{
 			yyVAL.str = ""
 		}
 	case 324:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1915
+//line sql.y:1942
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
 	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1919
+//line sql.y:1946
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
 	case 326:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexDefinition
-//line sql.y:1926
+//line sql.y:1953
 		{
 			yyLOCAL = &IndexDefinition{Info: yyDollar[1].indexInfoUnion(), Columns: yyDollar[3].indexColumnsUnion(), Options: yyDollar[5].indexOptionsUnion()}
 		}
@@ -8737,7 +8997,7 @@ yydefault:
 	case 327:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1931
+//line sql.y:1958
 		{
 			yyLOCAL = nil
 		}
@@ -8745,7 +9005,7 @@ yydefault:
 	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1935
+//line sql.y:1962
 		{
 			yyLOCAL = yyDollar[1].indexOptionsUnion()
 		}
@@ -8753,14 +9013,14 @@ yydefault:
 	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1941
+//line sql.y:1968
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1945
+//line sql.y:1972
 		{
 			yySLICE := (*[]*IndexOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexOptionUnion())
@@ -8768,7 +9028,7 @@ yydefault:
 	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1951
+//line sql.y:1978
 		{
 			yyLOCAL = yyDollar[1].indexOptionUnion()
 		}
@@ -8776,7 +9036,7 @@ yydefault:
 	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1955
+//line sql.y:1982
 		{
 			// should not be string
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
@@ -8785,7 +9045,7 @@ yydefault:
 	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1960
+//line sql.y:1987
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[2].str)}
 		}
@@ -8793,27 +9053,27 @@ yydefault:
 	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1964
+//line sql.y:1991
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str) + " " + string(yyDollar[2].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
 	case 335:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1970
+//line sql.y:1997
 		{
 			yyVAL.str = ""
 		}
 	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1974
+//line sql.y:2001
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 337:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1980
+//line sql.y:2007
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
@@ -8821,7 +9081,7 @@ yydefault:
 	case 338:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1984
+//line sql.y:2011
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
@@ -8829,7 +9089,7 @@ yydefault:
 	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1988
+//line sql.y:2015
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
@@ -8837,7 +9097,7 @@ yydefault:
 	case 340:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1992
+//line sql.y:2019
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
@@ -8845,100 +9105,100 @@ yydefault:
 	case 341:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1996
+//line sql.y:2023
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 		yyVAL.union = yyLOCAL
 	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2001
+//line sql.y:2028
 		{
 			yyVAL.str = ""
 		}
 	case 343:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2005
+//line sql.y:2032
 		{
 			yyVAL.str = yyDollar[2].str
 		}
 	case 344:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2011
+//line sql.y:2038
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2015
+//line sql.y:2042
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 346:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2019
+//line sql.y:2046
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 347:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2026
+//line sql.y:2053
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2030
+//line sql.y:2057
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 349:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2035
+//line sql.y:2062
 		{
 			yyVAL.str = "key"
 		}
 	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2039
+//line sql.y:2066
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2045
+//line sql.y:2072
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2049
+//line sql.y:2076
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 353:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2054
+//line sql.y:2081
 		{
 			yyVAL.str = ""
 		}
 	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2058
+//line sql.y:2085
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
 	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexColumn
-//line sql.y:2064
+//line sql.y:2091
 		{
 			yyLOCAL = []*IndexColumn{yyDollar[1].indexColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2068
+//line sql.y:2095
 		{
 			yySLICE := (*[]*IndexColumn)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].indexColumnUnion())
@@ -8946,7 +9206,7 @@ yydefault:
 	case 357:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexColumn
-//line sql.y:2074
+//line sql.y:2101
 		{
 			yyLOCAL = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literalUnion(), Direction: yyDollar[3].orderDirectionUnion()}
 		}
@@ -8954,7 +9214,7 @@ yydefault:
 	case 358:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2080
+//line sql.y:2107
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
@@ -8962,7 +9222,7 @@ yydefault:
 	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2084
+//line sql.y:2111
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
@@ -8970,7 +9230,7 @@ yydefault:
 	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2090
+//line sql.y:2117
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
@@ -8978,7 +9238,7 @@ yydefault:
 	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2094
+//line sql.y:2121
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
@@ -8986,7 +9246,7 @@ yydefault:
 	case 362:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:2100
+//line sql.y:2127
 		{
 			yyLOCAL = &ForeignKeyDefinition{IndexName: NewColIdent(yyDollar[3].str), Source: yyDollar[5].columnsUnion(), ReferenceDefinition: yyDollar[7].referenceDefinitionUnion()}
 		}
@@ -8994,7 +9254,7 @@ yydefault:
 	case 363:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2106
+//line sql.y:2133
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion()}
 		}
@@ -9002,7 +9262,7 @@ yydefault:
 	case 364:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2110
+//line sql.y:2137
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion()}
 		}
@@ -9010,7 +9270,7 @@ yydefault:
 	case 365:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2114
+//line sql.y:2141
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnUpdate: yyDollar[6].ReferenceActionUnion()}
 		}
@@ -9018,7 +9278,7 @@ yydefault:
 	case 366:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2118
+//line sql.y:2145
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion(), OnUpdate: yyDollar[7].ReferenceActionUnion()}
 		}
@@ -9026,7 +9286,7 @@ yydefault:
 	case 367:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2123
+//line sql.y:2150
 		{
 			yyLOCAL = nil
 		}
@@ -9034,7 +9294,7 @@ yydefault:
 	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2127
+//line sql.y:2154
 		{
 			yyLOCAL = yyDollar[1].referenceDefinitionUnion()
 		}
@@ -9042,7 +9302,7 @@ yydefault:
 	case 369:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:2133
+//line sql.y:2160
 		{
 			yyLOCAL = &CheckConstraintDefinition{Expr: yyDollar[3].exprUnion(), Enforced: yyDollar[5].booleanUnion()}
 		}
@@ -9050,7 +9310,7 @@ yydefault:
 	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2139
+//line sql.y:2166
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
@@ -9058,7 +9318,7 @@ yydefault:
 	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2145
+//line sql.y:2172
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
@@ -9066,7 +9326,7 @@ yydefault:
 	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2151
+//line sql.y:2178
 		{
 			yyLOCAL = Restrict
 		}
@@ -9074,7 +9334,7 @@ yydefault:
 	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2155
+//line sql.y:2182
 		{
 			yyLOCAL = Cascade
 		}
@@ -9082,7 +9342,7 @@ yydefault:
 	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2159
+//line sql.y:2186
 		{
 			yyLOCAL = NoAction
 		}
@@ -9090,7 +9350,7 @@ yydefault:
 	case 375:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2163
+//line sql.y:2190
 		{
 			yyLOCAL = SetDefault
 		}
@@ -9098,33 +9358,33 @@ yydefault:
 	case 376:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2167
+//line sql.y:2194
 		{
 			yyLOCAL = SetNull
 		}
 		yyVAL.union = yyLOCAL
 	case 377:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2172
+//line sql.y:2199
 		{
 			yyVAL.str = ""
 		}
 	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2176
+//line sql.y:2203
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2180
+//line sql.y:2207
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 380:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2185
+//line sql.y:2212
 		{
 			yyLOCAL = true
 		}
@@ -9132,7 +9392,7 @@ yydefault:
 	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2189
+//line sql.y:2216
 		{
 			yyLOCAL = true
 		}
@@ -9140,7 +9400,7 @@ yydefault:
 	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2193
+//line sql.y:2220
 		{
 			yyLOCAL = false
 		}
@@ -9148,7 +9408,7 @@ yydefault:
 	case 383:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2198
+//line sql.y:2225
 		{
 			yyLOCAL = nil
 		}
@@ -9156,7 +9416,7 @@ yydefault:
 	case 384:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2202
+//line sql.y:2229
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
@@ -9164,21 +9424,21 @@ yydefault:
 	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2208
+//line sql.y:2235
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 386:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2212
+//line sql.y:2239
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableOptionUnion())
 		}
 	case 387:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2216
+//line sql.y:2243
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
@@ -9186,14 +9446,14 @@ yydefault:
 	case 388:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2222
+//line sql.y:2249
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 389:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2226
+//line sql.y:2253
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
@@ -9201,7 +9461,7 @@ yydefault:
 	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2232
+//line sql.y:2259
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9209,7 +9469,7 @@ yydefault:
 	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2236
+//line sql.y:2263
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9217,7 +9477,7 @@ yydefault:
 	case 392:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2240
+//line sql.y:2267
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[2].str)), String: yyDollar[4].str}
 		}
@@ -9225,7 +9485,7 @@ yydefault:
 	case 393:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2244
+//line sql.y:2271
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[2].str), String: yyDollar[4].str}
 		}
@@ -9233,7 +9493,7 @@ yydefault:
 	case 394:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2248
+//line sql.y:2275
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9241,7 +9501,7 @@ yydefault:
 	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2252
+//line sql.y:2279
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -9249,7 +9509,7 @@ yydefault:
 	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2256
+//line sql.y:2283
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -9257,7 +9517,7 @@ yydefault:
 	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2260
+//line sql.y:2287
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -9265,7 +9525,7 @@ yydefault:
 	case 398:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2264
+//line sql.y:2291
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
@@ -9273,7 +9533,7 @@ yydefault:
 	case 399:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2268
+//line sql.y:2295
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
@@ -9281,7 +9541,7 @@ yydefault:
 	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2272
+//line sql.y:2299
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9289,7 +9549,7 @@ yydefault:
 	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2276
+//line sql.y:2303
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -9297,7 +9557,7 @@ yydefault:
 	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2280
+//line sql.y:2307
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: yyDollar[3].tableIdent.String()}
 		}
@@ -9305,7 +9565,7 @@ yydefault:
 	case 403:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2284
+//line sql.y:2311
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -9313,7 +9573,7 @@ yydefault:
 	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2288
+//line sql.y:2315
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9321,7 +9581,7 @@ yydefault:
 	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2292
+//line sql.y:2319
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9329,7 +9589,7 @@ yydefault:
 	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2296
+//line sql.y:2323
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9337,7 +9597,7 @@ yydefault:
 	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2300
+//line sql.y:2327
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9345,7 +9605,7 @@ yydefault:
 	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2304
+//line sql.y:2331
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -9353,7 +9613,7 @@ yydefault:
 	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2308
+//line sql.y:2335
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -9361,7 +9621,7 @@ yydefault:
 	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2312
+//line sql.y:2339
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -9369,7 +9629,7 @@ yydefault:
 	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2316
+//line sql.y:2343
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9377,7 +9637,7 @@ yydefault:
 	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2320
+//line sql.y:2347
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -9385,7 +9645,7 @@ yydefault:
 	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2324
+//line sql.y:2351
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9393,7 +9653,7 @@ yydefault:
 	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2328
+//line sql.y:2355
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -9401,7 +9661,7 @@ yydefault:
 	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2332
+//line sql.y:2359
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -9409,7 +9669,7 @@ yydefault:
 	case 416:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2336
+//line sql.y:2363
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
@@ -9417,57 +9677,57 @@ yydefault:
 	case 417:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2340
+//line sql.y:2367
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 418:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2345
+//line sql.y:2372
 		{
 			yyVAL.str = ""
 		}
 	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2349
+//line sql.y:2376
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 420:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2353
+//line sql.y:2380
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 430:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2372
+//line sql.y:2399
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
 	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2376
+//line sql.y:2403
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
 	case 432:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2380
+//line sql.y:2407
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 433:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2385
+//line sql.y:2412
 		{
 			yyVAL.str = ""
 		}
 	case 435:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2391
+//line sql.y:2418
 		{
 			yyLOCAL = false
 		}
@@ -9475,7 +9735,7 @@ yydefault:
 	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2395
+//line sql.y:2422
 		{
 			yyLOCAL = true
 		}
@@ -9483,7 +9743,7 @@ yydefault:
 	case 437:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:2400
+//line sql.y:2427
 		{
 			yyLOCAL = nil
 		}
@@ -9491,7 +9751,7 @@ yydefault:
 	case 438:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:2404
+//line sql.y:2431
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
@@ -9499,7 +9759,7 @@ yydefault:
 	case 439:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2409
+//line sql.y:2436
 		{
 			yyLOCAL = nil
 		}
@@ -9507,14 +9767,14 @@ yydefault:
 	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2413
+//line sql.y:2440
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 441:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2417
+//line sql.y:2444
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &OrderByOption{Cols: yyDollar[5].columnsUnion()})
@@ -9522,14 +9782,14 @@ yydefault:
 	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2421
+//line sql.y:2448
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 443:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2425
+//line sql.y:2452
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionsUnion()...)
@@ -9537,7 +9797,7 @@ yydefault:
 	case 444:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2429
+//line sql.y:2456
 		{
 			yyLOCAL = append(append(yyDollar[1].alterOptionsUnion(), yyDollar[3].alterOptionsUnion()...), &OrderByOption{Cols: yyDollar[7].columnsUnion()})
 		}
@@ -9545,21 +9805,21 @@ yydefault:
 	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2435
+//line sql.y:2462
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2439
+//line sql.y:2466
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
 	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2443
+//line sql.y:2470
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
@@ -9567,7 +9827,7 @@ yydefault:
 	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2449
+//line sql.y:2476
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
@@ -9575,7 +9835,7 @@ yydefault:
 	case 449:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2453
+//line sql.y:2480
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
@@ -9583,7 +9843,7 @@ yydefault:
 	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2457
+//line sql.y:2484
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
@@ -9591,7 +9851,7 @@ yydefault:
 	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2461
+//line sql.y:2488
 		{
 			yyLOCAL = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinitionUnion()}
 		}
@@ -9599,7 +9859,7 @@ yydefault:
 	case 452:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2465
+//line sql.y:2492
 		{
 			yyLOCAL = &AddColumns{Columns: yyDollar[4].columnDefinitionsUnion()}
 		}
@@ -9607,7 +9867,7 @@ yydefault:
 	case 453:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2469
+//line sql.y:2496
 		{
 			yyLOCAL = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinitionUnion()}, First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
@@ -9615,7 +9875,7 @@ yydefault:
 	case 454:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2473
+//line sql.y:2500
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: true}
 		}
@@ -9623,7 +9883,7 @@ yydefault:
 	case 455:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2477
+//line sql.y:2504
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[6].exprUnion()}
 		}
@@ -9631,7 +9891,7 @@ yydefault:
 	case 456:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2481
+//line sql.y:2508
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[7].exprUnion()}
 		}
@@ -9639,7 +9899,7 @@ yydefault:
 	case 457:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2485
+//line sql.y:2512
 		{
 			yyLOCAL = &ChangeColumn{OldColumn: yyDollar[3].colNameUnion(), NewColDefinition: yyDollar[4].columnDefinitionUnion(), First: yyDollar[5].booleanUnion(), After: yyDollar[6].colNameUnion()}
 		}
@@ -9647,7 +9907,7 @@ yydefault:
 	case 458:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2489
+//line sql.y:2516
 		{
 			yyLOCAL = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinitionUnion(), First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
@@ -9655,7 +9915,7 @@ yydefault:
 	case 459:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2493
+//line sql.y:2520
 		{
 			yyLOCAL = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
@@ -9663,7 +9923,7 @@ yydefault:
 	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2497
+//line sql.y:2524
 		{
 			yyLOCAL = &KeyState{Enable: false}
 		}
@@ -9671,7 +9931,7 @@ yydefault:
 	case 461:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2501
+//line sql.y:2528
 		{
 			yyLOCAL = &KeyState{Enable: true}
 		}
@@ -9679,7 +9939,7 @@ yydefault:
 	case 462:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2505
+//line sql.y:2532
 		{
 			yyLOCAL = &TablespaceOperation{Import: false}
 		}
@@ -9687,7 +9947,7 @@ yydefault:
 	case 463:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2509
+//line sql.y:2536
 		{
 			yyLOCAL = &TablespaceOperation{Import: true}
 		}
@@ -9695,7 +9955,7 @@ yydefault:
 	case 464:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2513
+//line sql.y:2540
 		{
 			yyLOCAL = &DropColumn{Name: yyDollar[3].colNameUnion()}
 		}
@@ -9703,7 +9963,7 @@ yydefault:
 	case 465:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2517
+//line sql.y:2544
 		{
 			yyLOCAL = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent}
 		}
@@ -9711,7 +9971,7 @@ yydefault:
 	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2521
+//line sql.y:2548
 		{
 			yyLOCAL = &DropKey{Type: PrimaryKeyType}
 		}
@@ -9719,7 +9979,7 @@ yydefault:
 	case 467:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2525
+//line sql.y:2552
 		{
 			yyLOCAL = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent}
 		}
@@ -9727,7 +9987,7 @@ yydefault:
 	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2529
+//line sql.y:2556
 		{
 			yyLOCAL = &Force{}
 		}
@@ -9735,7 +9995,7 @@ yydefault:
 	case 469:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2533
+//line sql.y:2560
 		{
 			yyLOCAL = &RenameTableName{Table: yyDollar[3].tableName}
 		}
@@ -9743,7 +10003,7 @@ yydefault:
 	case 470:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2537
+//line sql.y:2564
 		{
 			yyLOCAL = &RenameIndex{OldName: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
@@ -9751,14 +10011,14 @@ yydefault:
 	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2543
+//line sql.y:2570
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2547
+//line sql.y:2574
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
@@ -9766,7 +10026,7 @@ yydefault:
 	case 473:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2553
+//line sql.y:2580
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
@@ -9774,7 +10034,7 @@ yydefault:
 	case 474:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2557
+//line sql.y:2584
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
@@ -9782,7 +10042,7 @@ yydefault:
 	case 475:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2561
+//line sql.y:2588
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
@@ -9790,7 +10050,7 @@ yydefault:
 	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2565
+//line sql.y:2592
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
@@ -9798,7 +10058,7 @@ yydefault:
 	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2569
+//line sql.y:2596
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
@@ -9806,7 +10066,7 @@ yydefault:
 	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2573
+//line sql.y:2600
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
@@ -9814,7 +10074,7 @@ yydefault:
 	case 479:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2577
+//line sql.y:2604
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
@@ -9822,7 +10082,7 @@ yydefault:
 	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2581
+//line sql.y:2608
 		{
 			yyLOCAL = &Validation{With: true}
 		}
@@ -9830,7 +10090,7 @@ yydefault:
 	case 481:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2585
+//line sql.y:2612
 		{
 			yyLOCAL = &Validation{With: false}
 		}
@@ -9838,7 +10098,7 @@ yydefault:
 	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2591
+//line sql.y:2618
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -9848,7 +10108,7 @@ yydefault:
 	case 483:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2597
+//line sql.y:2624
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -9859,7 +10119,7 @@ yydefault:
 	case 484:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2604
+//line sql.y:2631
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -9870,7 +10130,7 @@ yydefault:
 	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2611
+//line sql.y:2638
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().PartitionSpec = yyDollar[2].partSpecUnion()
@@ -9880,7 +10140,7 @@ yydefault:
 	case 486:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2617
+//line sql.y:2644
 		{
 			yyLOCAL = &AlterView{ViewName: yyDollar[7].tableName.ToViewName(), Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
 		}
@@ -9888,7 +10148,7 @@ yydefault:
 	case 487:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2621
+//line sql.y:2648
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -9899,7 +10159,7 @@ yydefault:
 	case 488:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2628
+//line sql.y:2655
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -9910,7 +10170,7 @@ yydefault:
 	case 489:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2635
+//line sql.y:2662
 		{
 			yyLOCAL = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -9926,7 +10186,7 @@ yydefault:
 	case 490:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2647
+//line sql.y:2674
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -9940,7 +10200,7 @@ yydefault:
 	case 491:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2657
+//line sql.y:2684
 		{
 			yyLOCAL = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
@@ -9948,7 +10208,7 @@ yydefault:
 	case 492:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2661
+//line sql.y:2688
 		{
 			yyLOCAL = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
@@ -9956,7 +10216,7 @@ yydefault:
 	case 493:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2665
+//line sql.y:2692
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -9973,7 +10233,7 @@ yydefault:
 	case 494:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2678
+//line sql.y:2705
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -9987,7 +10247,7 @@ yydefault:
 	case 495:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2688
+//line sql.y:2715
 		{
 			yyLOCAL = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[6].tableName}
 		}
@@ -9995,7 +10255,7 @@ yydefault:
 	case 496:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2692
+//line sql.y:2719
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -10010,7 +10270,7 @@ yydefault:
 	case 497:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2703
+//line sql.y:2730
 		{
 			yyLOCAL = &AlterMigration{
 				Type: RetryMigrationType,
@@ -10021,7 +10281,7 @@ yydefault:
 	case 498:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2710
+//line sql.y:2737
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CleanupMigrationType,
@@ -10032,7 +10292,7 @@ yydefault:
 	case 499:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2717
+//line sql.y:2744
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CompleteMigrationType,
@@ -10043,7 +10303,7 @@ yydefault:
 	case 500:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2724
+//line sql.y:2751
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelMigrationType,
@@ -10054,7 +10314,7 @@ yydefault:
 	case 501:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2731
+//line sql.y:2758
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelAllMigrationType,
@@ -10064,7 +10324,7 @@ yydefault:
 	case 502:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2738
+//line sql.y:2765
 		{
 			yyLOCAL = nil
 		}
@@ -10072,7 +10332,7 @@ yydefault:
 	case 503:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2743
+//line sql.y:2770
 		{
 			yyLOCAL = &PartitionOption{
 				Linear:       yyDollar[3].str,
@@ -10087,7 +10347,7 @@ yydefault:
 	case 504:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2755
+//line sql.y:2782
 		{
 			yyLOCAL = &PartitionOption{
 				Linear:       yyDollar[3].str,
@@ -10103,7 +10363,7 @@ yydefault:
 	case 505:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2768
+//line sql.y:2795
 		{
 			yyLOCAL = &PartitionOption{
 				RangeOrList:  yyDollar[3].str,
@@ -10117,7 +10377,7 @@ yydefault:
 	case 506:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2779
+//line sql.y:2806
 		{
 			yyLOCAL = nil
 		}
@@ -10125,7 +10385,7 @@ yydefault:
 	case 507:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2783
+//line sql.y:2810
 		{
 			yyLOCAL = &SubPartition{
 				Linear:        yyDollar[3].str,
@@ -10138,7 +10398,7 @@ yydefault:
 	case 508:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2792
+//line sql.y:2819
 		{
 			yyLOCAL = &SubPartition{
 				Linear:        yyDollar[3].str,
@@ -10152,7 +10412,7 @@ yydefault:
 	case 509:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2803
+//line sql.y:2830
 		{
 			yyLOCAL = nil
 		}
@@ -10160,51 +10420,51 @@ yydefault:
 	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2807
+//line sql.y:2834
 		{
 			yyLOCAL = yyDollar[2].partDefsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 511:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2812
+//line sql.y:2839
 		{
 			yyVAL.str = ""
 		}
 	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2816
+//line sql.y:2843
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 513:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2821
+//line sql.y:2848
 		{
 			yyVAL.str = ""
 		}
 	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2825
+//line sql.y:2852
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
 	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2831
+//line sql.y:2858
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 516:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2835
+//line sql.y:2862
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 517:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ExprOrColumns
-//line sql.y:2841
+//line sql.y:2868
 		{
 			yyLOCAL = &ExprOrColumns{Expr: yyDollar[2].exprUnion()}
 		}
@@ -10212,39 +10472,39 @@ yydefault:
 	case 518:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ExprOrColumns
-//line sql.y:2845
+//line sql.y:2872
 		{
 			yyLOCAL = &ExprOrColumns{ColumnList: yyDollar[3].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 519:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2850
+//line sql.y:2877
 		{
 			yyVAL.str = ""
 		}
 	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2854
+//line sql.y:2881
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
 	case 521:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2859
+//line sql.y:2886
 		{
 			yyVAL.str = ""
 		}
 	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2863
+//line sql.y:2890
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
 	case 523:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2869
+//line sql.y:2896
 		{
 			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
 		}
@@ -10252,7 +10512,7 @@ yydefault:
 	case 524:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2873
+//line sql.y:2900
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10260,7 +10520,7 @@ yydefault:
 	case 525:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2877
+//line sql.y:2904
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
@@ -10268,7 +10528,7 @@ yydefault:
 	case 526:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2881
+//line sql.y:2908
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10276,7 +10536,7 @@ yydefault:
 	case 527:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2885
+//line sql.y:2912
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
@@ -10284,7 +10544,7 @@ yydefault:
 	case 528:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2889
+//line sql.y:2916
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10292,7 +10552,7 @@ yydefault:
 	case 529:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2893
+//line sql.y:2920
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
@@ -10300,7 +10560,7 @@ yydefault:
 	case 530:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2897
+//line sql.y:2924
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10308,7 +10568,7 @@ yydefault:
 	case 531:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2901
+//line sql.y:2928
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
@@ -10316,7 +10576,7 @@ yydefault:
 	case 532:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2905
+//line sql.y:2932
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -10324,7 +10584,7 @@ yydefault:
 	case 533:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2909
+//line sql.y:2936
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
@@ -10332,7 +10592,7 @@ yydefault:
 	case 534:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2913
+//line sql.y:2940
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10340,7 +10600,7 @@ yydefault:
 	case 535:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2917
+//line sql.y:2944
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
@@ -10348,7 +10608,7 @@ yydefault:
 	case 536:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2921
+//line sql.y:2948
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10356,7 +10616,7 @@ yydefault:
 	case 537:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2925
+//line sql.y:2952
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
@@ -10364,7 +10624,7 @@ yydefault:
 	case 538:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2929
+//line sql.y:2956
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10372,7 +10632,7 @@ yydefault:
 	case 539:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2933
+//line sql.y:2960
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
@@ -10380,7 +10640,7 @@ yydefault:
 	case 540:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2937
+//line sql.y:2964
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10388,7 +10648,7 @@ yydefault:
 	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2941
+//line sql.y:2968
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
@@ -10396,7 +10656,7 @@ yydefault:
 	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2945
+//line sql.y:2972
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -10404,7 +10664,7 @@ yydefault:
 	case 543:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2949
+//line sql.y:2976
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
@@ -10412,7 +10672,7 @@ yydefault:
 	case 544:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2953
+//line sql.y:2980
 		{
 			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
 		}
@@ -10420,7 +10680,7 @@ yydefault:
 	case 545:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2958
+//line sql.y:2985
 		{
 			yyLOCAL = false
 		}
@@ -10428,7 +10688,7 @@ yydefault:
 	case 546:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2962
+//line sql.y:2989
 		{
 			yyLOCAL = false
 		}
@@ -10436,7 +10696,7 @@ yydefault:
 	case 547:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2966
+//line sql.y:2993
 		{
 			yyLOCAL = true
 		}
@@ -10444,14 +10704,14 @@ yydefault:
 	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2973
+//line sql.y:3000
 		{
 			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 549:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2977
+//line sql.y:3004
 		{
 			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
@@ -10459,7 +10719,7 @@ yydefault:
 	case 550:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2983
+//line sql.y:3010
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].exprUnion()}
 		}
@@ -10467,7 +10727,7 @@ yydefault:
 	case 551:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2987
+//line sql.y:3014
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
@@ -10475,7 +10735,7 @@ yydefault:
 	case 552:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2993
+//line sql.y:3020
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
@@ -10483,14 +10743,14 @@ yydefault:
 	case 553:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:2999
+//line sql.y:3026
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
 	case 554:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3003
+//line sql.y:3030
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
@@ -10498,7 +10758,7 @@ yydefault:
 	case 555:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3009
+//line sql.y:3036
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[6].tableNamesUnion(), IfExists: yyDollar[5].booleanUnion(), Comments: Comments(yyDollar[2].strs), Temp: yyDollar[3].booleanUnion()}
 		}
@@ -10506,7 +10766,7 @@ yydefault:
 	case 556:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3013
+//line sql.y:3040
 		{
 			// Change this to an alter statement
 			if yyDollar[4].colIdent.Lowered() == "primary" {
@@ -10519,7 +10779,7 @@ yydefault:
 	case 557:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3022
+//line sql.y:3049
 		{
 			yyLOCAL = &DropView{FromTables: yyDollar[5].tableNamesUnion(), IfExists: yyDollar[4].booleanUnion()}
 		}
@@ -10527,7 +10787,7 @@ yydefault:
 	case 558:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3026
+//line sql.y:3053
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[2].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
@@ -10535,7 +10795,7 @@ yydefault:
 	case 559:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3032
+//line sql.y:3059
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
@@ -10543,7 +10803,7 @@ yydefault:
 	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3036
+//line sql.y:3063
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
@@ -10551,7 +10811,7 @@ yydefault:
 	case 561:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3041
+//line sql.y:3068
 		{
 			yyLOCAL = &OtherRead{}
 		}
@@ -10559,7 +10819,7 @@ yydefault:
 	case 562:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3047
+//line sql.y:3074
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -10567,7 +10827,7 @@ yydefault:
 	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3051
+//line sql.y:3078
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -10575,7 +10835,7 @@ yydefault:
 	case 564:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3055
+//line sql.y:3082
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
@@ -10583,7 +10843,7 @@ yydefault:
 	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3059
+//line sql.y:3086
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -10591,7 +10851,7 @@ yydefault:
 	case 566:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3063
+//line sql.y:3090
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -10599,7 +10859,7 @@ yydefault:
 	case 567:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3067
+//line sql.y:3094
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -10607,7 +10867,7 @@ yydefault:
 	case 568:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3071
+//line sql.y:3098
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -10615,7 +10875,7 @@ yydefault:
 	case 569:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3075
+//line sql.y:3102
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10623,7 +10883,7 @@ yydefault:
 	case 570:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3079
+//line sql.y:3106
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
@@ -10631,7 +10891,7 @@ yydefault:
 	case 571:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3083
+//line sql.y:3110
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
@@ -10639,7 +10899,7 @@ yydefault:
 	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3087
+//line sql.y:3114
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
@@ -10647,7 +10907,7 @@ yydefault:
 	case 573:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3091
+//line sql.y:3118
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10655,7 +10915,7 @@ yydefault:
 	case 574:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3095
+//line sql.y:3122
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10663,7 +10923,7 @@ yydefault:
 	case 575:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3099
+//line sql.y:3126
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10671,7 +10931,7 @@ yydefault:
 	case 576:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3103
+//line sql.y:3130
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10679,7 +10939,7 @@ yydefault:
 	case 577:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3107
+//line sql.y:3134
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10687,7 +10947,7 @@ yydefault:
 	case 578:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3111
+//line sql.y:3138
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
@@ -10695,7 +10955,7 @@ yydefault:
 	case 579:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3115
+//line sql.y:3142
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
@@ -10703,7 +10963,7 @@ yydefault:
 	case 580:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3119
+//line sql.y:3146
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -10711,7 +10971,7 @@ yydefault:
 	case 581:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3123
+//line sql.y:3150
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
@@ -10719,7 +10979,7 @@ yydefault:
 	case 582:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3127
+//line sql.y:3154
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
@@ -10727,7 +10987,7 @@ yydefault:
 	case 583:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3131
+//line sql.y:3158
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
@@ -10735,7 +10995,7 @@ yydefault:
 	case 584:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3135
+//line sql.y:3162
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
@@ -10743,7 +11003,7 @@ yydefault:
 	case 585:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3139
+//line sql.y:3166
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
@@ -10751,7 +11011,7 @@ yydefault:
 	case 586:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3143
+//line sql.y:3170
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
@@ -10759,7 +11019,7 @@ yydefault:
 	case 587:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3147
+//line sql.y:3174
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
@@ -10767,7 +11027,7 @@ yydefault:
 	case 588:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3151
+//line sql.y:3178
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
@@ -10775,7 +11035,7 @@ yydefault:
 	case 589:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3155
+//line sql.y:3182
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
@@ -10783,7 +11043,7 @@ yydefault:
 	case 590:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3159
+//line sql.y:3186
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
@@ -10791,7 +11051,7 @@ yydefault:
 	case 591:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3163
+//line sql.y:3190
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
@@ -10799,7 +11059,7 @@ yydefault:
 	case 592:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3167
+//line sql.y:3194
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
@@ -10807,7 +11067,7 @@ yydefault:
 	case 593:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3171
+//line sql.y:3198
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
@@ -10815,7 +11075,7 @@ yydefault:
 	case 594:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3175
+//line sql.y:3202
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
@@ -10823,7 +11083,7 @@ yydefault:
 	case 595:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3179
+//line sql.y:3206
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
@@ -10831,7 +11091,7 @@ yydefault:
 	case 596:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3183
+//line sql.y:3210
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
@@ -10839,7 +11099,7 @@ yydefault:
 	case 597:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3187
+//line sql.y:3214
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
@@ -10847,7 +11107,7 @@ yydefault:
 	case 598:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3191
+//line sql.y:3218
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
@@ -10856,7 +11116,7 @@ yydefault:
 	case 599:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3196
+//line sql.y:3223
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
@@ -10864,7 +11124,7 @@ yydefault:
 	case 600:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3200
+//line sql.y:3227
 		{
 			yyLOCAL = &ShowMigrationLogs{UUID: string(yyDollar[3].str)}
 		}
@@ -10872,7 +11132,7 @@ yydefault:
 	case 601:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3204
+//line sql.y:3231
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope, ShowTablesOpt: showTablesOpt}}
@@ -10881,7 +11141,7 @@ yydefault:
 	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3209
+//line sql.y:3236
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
@@ -10889,7 +11149,7 @@ yydefault:
 	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3213
+//line sql.y:3240
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
@@ -10897,7 +11157,7 @@ yydefault:
 	case 604:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3217
+//line sql.y:3244
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
@@ -10905,7 +11165,7 @@ yydefault:
 	case 605:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3221
+//line sql.y:3248
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Warnings}}
 		}
@@ -10913,7 +11173,7 @@ yydefault:
 	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3226
+//line sql.y:3253
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -10924,7 +11184,7 @@ yydefault:
 	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3240
+//line sql.y:3267
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
@@ -10932,7 +11192,7 @@ yydefault:
 	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3244
+//line sql.y:3271
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
@@ -10940,39 +11200,39 @@ yydefault:
 	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3248
+//line sql.y:3275
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
 	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3254
+//line sql.y:3281
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3258
+//line sql.y:3285
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 612:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3264
+//line sql.y:3291
 		{
 			yyVAL.str = ""
 		}
 	case 613:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3268
+//line sql.y:3295
 		{
 			yyVAL.str = "extended "
 		}
 	case 614:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3274
+//line sql.y:3301
 		{
 			yyLOCAL = false
 		}
@@ -10980,45 +11240,45 @@ yydefault:
 	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3278
+//line sql.y:3305
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3284
+//line sql.y:3311
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3288
+//line sql.y:3315
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 618:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3294
+//line sql.y:3321
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
 	case 619:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3298
+//line sql.y:3325
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
 	case 620:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3302
+//line sql.y:3329
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
 	case 621:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3308
+//line sql.y:3335
 		{
 			yyLOCAL = nil
 		}
@@ -11026,7 +11286,7 @@ yydefault:
 	case 622:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3312
+//line sql.y:3339
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
@@ -11034,7 +11294,7 @@ yydefault:
 	case 623:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3316
+//line sql.y:3343
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
@@ -11042,7 +11302,7 @@ yydefault:
 	case 624:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3322
+//line sql.y:3349
 		{
 			yyLOCAL = nil
 		}
@@ -11050,33 +11310,33 @@ yydefault:
 	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3326
+//line sql.y:3353
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
 	case 626:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3332
+//line sql.y:3359
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3336
+//line sql.y:3363
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3340
+//line sql.y:3367
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 629:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3346
+//line sql.y:3373
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
@@ -11084,7 +11344,7 @@ yydefault:
 	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3350
+//line sql.y:3377
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
@@ -11092,7 +11352,7 @@ yydefault:
 	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3356
+//line sql.y:3383
 		{
 			yyLOCAL = &Begin{}
 		}
@@ -11100,7 +11360,7 @@ yydefault:
 	case 632:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3360
+//line sql.y:3387
 		{
 			yyLOCAL = &Begin{}
 		}
@@ -11108,7 +11368,7 @@ yydefault:
 	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3366
+//line sql.y:3393
 		{
 			yyLOCAL = &Commit{}
 		}
@@ -11116,7 +11376,7 @@ yydefault:
 	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3372
+//line sql.y:3399
 		{
 			yyLOCAL = &Rollback{}
 		}
@@ -11124,39 +11384,39 @@ yydefault:
 	case 635:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3376
+//line sql.y:3403
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
 	case 636:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3381
+//line sql.y:3408
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3383
+//line sql.y:3410
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 638:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3386
+//line sql.y:3413
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3388
+//line sql.y:3415
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 640:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3393
+//line sql.y:3420
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
@@ -11164,7 +11424,7 @@ yydefault:
 	case 641:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3399
+//line sql.y:3426
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
@@ -11172,7 +11432,7 @@ yydefault:
 	case 642:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3404
+//line sql.y:3431
 		{
 			yyLOCAL = EmptyType
 		}
@@ -11180,7 +11440,7 @@ yydefault:
 	case 643:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3408
+//line sql.y:3435
 		{
 			yyLOCAL = JSONType
 		}
@@ -11188,7 +11448,7 @@ yydefault:
 	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3412
+//line sql.y:3439
 		{
 			yyLOCAL = TreeType
 		}
@@ -11196,7 +11456,7 @@ yydefault:
 	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3416
+//line sql.y:3443
 		{
 			yyLOCAL = VitessType
 		}
@@ -11204,7 +11464,7 @@ yydefault:
 	case 646:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3420
+//line sql.y:3447
 		{
 			yyLOCAL = TraditionalType
 		}
@@ -11212,33 +11472,33 @@ yydefault:
 	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3424
+//line sql.y:3451
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
 	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3430
+//line sql.y:3457
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3434
+//line sql.y:3461
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3438
+//line sql.y:3465
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3444
+//line sql.y:3471
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
@@ -11246,7 +11506,7 @@ yydefault:
 	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3448
+//line sql.y:3475
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -11254,7 +11514,7 @@ yydefault:
 	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3452
+//line sql.y:3479
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -11262,33 +11522,33 @@ yydefault:
 	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3456
+//line sql.y:3483
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 655:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3461
+//line sql.y:3488
 		{
 			yyVAL.str = ""
 		}
 	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3465
+//line sql.y:3492
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
 	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3469
+//line sql.y:3496
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
 	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3475
+//line sql.y:3502
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
@@ -11296,7 +11556,7 @@ yydefault:
 	case 659:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3479
+//line sql.y:3506
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
@@ -11304,7 +11564,7 @@ yydefault:
 	case 660:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3485
+//line sql.y:3512
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
@@ -11312,7 +11572,7 @@ yydefault:
 	case 661:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3489
+//line sql.y:3516
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
@@ -11320,7 +11580,7 @@ yydefault:
 	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3495
+//line sql.y:3522
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
@@ -11328,14 +11588,14 @@ yydefault:
 	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:3501
+//line sql.y:3528
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 664:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3505
+//line sql.y:3532
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
@@ -11343,7 +11603,7 @@ yydefault:
 	case 665:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:3511
+//line sql.y:3538
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
@@ -11351,7 +11611,7 @@ yydefault:
 	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3517
+//line sql.y:3544
 		{
 			yyLOCAL = Read
 		}
@@ -11359,7 +11619,7 @@ yydefault:
 	case 667:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3521
+//line sql.y:3548
 		{
 			yyLOCAL = ReadLocal
 		}
@@ -11367,7 +11627,7 @@ yydefault:
 	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3525
+//line sql.y:3552
 		{
 			yyLOCAL = Write
 		}
@@ -11375,7 +11635,7 @@ yydefault:
 	case 669:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3529
+//line sql.y:3556
 		{
 			yyLOCAL = LowPriorityWrite
 		}
@@ -11383,7 +11643,7 @@ yydefault:
 	case 670:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3535
+//line sql.y:3562
 		{
 			yyLOCAL = &UnlockTables{}
 		}
@@ -11391,7 +11651,7 @@ yydefault:
 	case 671:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3541
+//line sql.y:3568
 		{
 			yyLOCAL = &RevertMigration{Comments: Comments(yyDollar[2].strs), UUID: string(yyDollar[4].str)}
 		}
@@ -11399,7 +11659,7 @@ yydefault:
 	case 672:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3547
+//line sql.y:3574
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
 		}
@@ -11407,7 +11667,7 @@ yydefault:
 	case 673:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3551
+//line sql.y:3578
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
@@ -11415,7 +11675,7 @@ yydefault:
 	case 674:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3555
+//line sql.y:3582
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
@@ -11423,7 +11683,7 @@ yydefault:
 	case 675:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3559
+//line sql.y:3586
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
@@ -11431,7 +11691,7 @@ yydefault:
 	case 676:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3563
+//line sql.y:3590
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
@@ -11439,99 +11699,99 @@ yydefault:
 	case 677:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3567
+//line sql.y:3594
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
 	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3573
+//line sql.y:3600
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
 	case 679:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3577
+//line sql.y:3604
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
 	case 680:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3583
+//line sql.y:3610
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 681:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3587
+//line sql.y:3614
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 682:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3591
+//line sql.y:3618
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 683:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3595
+//line sql.y:3622
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3599
+//line sql.y:3626
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3603
+//line sql.y:3630
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3607
+//line sql.y:3634
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 687:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3611
+//line sql.y:3638
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
 	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3615
+//line sql.y:3642
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3619
+//line sql.y:3646
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3623
+//line sql.y:3650
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3627
+//line sql.y:3654
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 692:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3632
+//line sql.y:3659
 		{
 			yyLOCAL = false
 		}
@@ -11539,7 +11799,7 @@ yydefault:
 	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3636
+//line sql.y:3663
 		{
 			yyLOCAL = true
 		}
@@ -11547,52 +11807,52 @@ yydefault:
 	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3640
+//line sql.y:3667
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 695:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3645
+//line sql.y:3672
 		{
 			yyVAL.str = ""
 		}
 	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3649
+//line sql.y:3676
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
 	case 697:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3654
+//line sql.y:3681
 		{
 			setAllowComments(yylex, true)
 		}
 	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3658
+//line sql.y:3685
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
 	case 699:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3664
+//line sql.y:3691
 		{
 			yyVAL.strs = nil
 		}
 	case 700:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3668
+//line sql.y:3695
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
 	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3674
+//line sql.y:3701
 		{
 			yyLOCAL = true
 		}
@@ -11600,7 +11860,7 @@ yydefault:
 	case 702:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3678
+//line sql.y:3705
 		{
 			yyLOCAL = false
 		}
@@ -11608,33 +11868,33 @@ yydefault:
 	case 703:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3682
+//line sql.y:3709
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 704:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3687
+//line sql.y:3714
 		{
 			yyVAL.str = ""
 		}
 	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3691
+//line sql.y:3718
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
 	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3695
+//line sql.y:3722
 		{
 			yyVAL.str = SQLCacheStr
 		}
 	case 707:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3700
+//line sql.y:3727
 		{
 			yyLOCAL = false
 		}
@@ -11642,7 +11902,7 @@ yydefault:
 	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3704
+//line sql.y:3731
 		{
 			yyLOCAL = true
 		}
@@ -11650,7 +11910,7 @@ yydefault:
 	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3708
+//line sql.y:3735
 		{
 			yyLOCAL = true
 		}
@@ -11658,7 +11918,7 @@ yydefault:
 	case 710:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3713
+//line sql.y:3740
 		{
 			yyLOCAL = nil
 		}
@@ -11666,94 +11926,94 @@ yydefault:
 	case 711:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3717
+//line sql.y:3744
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 712:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3722
+//line sql.y:3749
 		{
 			yyVAL.strs = nil
 		}
 	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3726
+//line sql.y:3753
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
 	case 714:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3730
+//line sql.y:3757
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
 	case 715:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3734
+//line sql.y:3761
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
 	case 716:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3738
+//line sql.y:3765
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
 	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3744
+//line sql.y:3771
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
 	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3748
+//line sql.y:3775
 		{
 			yyVAL.str = SQLCacheStr
 		}
 	case 719:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3752
+//line sql.y:3779
 		{
 			yyVAL.str = DistinctStr
 		}
 	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3756
+//line sql.y:3783
 		{
 			yyVAL.str = DistinctStr
 		}
 	case 721:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3760
+//line sql.y:3787
 		{
 			yyVAL.str = StraightJoinHint
 		}
 	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3764
+//line sql.y:3791
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
 	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3768
+//line sql.y:3795
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
 	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3774
+//line sql.y:3801
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 725:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3778
+//line sql.y:3805
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
@@ -11761,7 +12021,7 @@ yydefault:
 	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3784
+//line sql.y:3811
 		{
 			yyLOCAL = &StarExpr{}
 		}
@@ -11769,7 +12029,7 @@ yydefault:
 	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3788
+//line sql.y:3815
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
@@ -11777,7 +12037,7 @@ yydefault:
 	case 728:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3792
+//line sql.y:3819
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
@@ -11785,39 +12045,39 @@ yydefault:
 	case 729:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3796
+//line sql.y:3823
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
 	case 730:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3801
+//line sql.y:3828
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
 	case 731:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3805
+//line sql.y:3832
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 732:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3809
+//line sql.y:3836
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
 	case 734:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3816
+//line sql.y:3843
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
 	case 735:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3821
+//line sql.y:3848
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
@@ -11825,7 +12085,7 @@ yydefault:
 	case 736:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3825
+//line sql.y:3852
 		{
 			yyLOCAL = yyDollar[1].tableExprsUnion()
 		}
@@ -11833,7 +12093,7 @@ yydefault:
 	case 737:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3831
+//line sql.y:3858
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
@@ -11841,14 +12101,14 @@ yydefault:
 	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3837
+//line sql.y:3864
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 739:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3841
+//line sql.y:3868
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
@@ -11856,7 +12116,7 @@ yydefault:
 	case 742:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3851
+//line sql.y:3878
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
@@ -11864,7 +12124,7 @@ yydefault:
 	case 743:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3855
+//line sql.y:3882
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent, Columns: yyDollar[4].columnsUnion()}
 		}
@@ -11872,7 +12132,7 @@ yydefault:
 	case 744:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3859
+//line sql.y:3886
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
@@ -11880,7 +12140,7 @@ yydefault:
 	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3865
+//line sql.y:3892
 		{
 			yyLOCAL = &DerivedTable{yyDollar[2].selStmtUnion()}
 		}
@@ -11888,7 +12148,7 @@ yydefault:
 	case 746:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3871
+//line sql.y:3898
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
@@ -11896,7 +12156,7 @@ yydefault:
 	case 747:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3875
+//line sql.y:3902
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
@@ -11904,7 +12164,7 @@ yydefault:
 	case 748:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3880
+//line sql.y:3907
 		{
 			yyLOCAL = nil
 		}
@@ -11912,7 +12172,7 @@ yydefault:
 	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3884
+//line sql.y:3911
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
@@ -11920,14 +12180,14 @@ yydefault:
 	case 750:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3890
+//line sql.y:3917
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
 	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3894
+//line sql.y:3921
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
@@ -11935,7 +12195,7 @@ yydefault:
 	case 752:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3900
+//line sql.y:3927
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
@@ -11943,21 +12203,21 @@ yydefault:
 	case 753:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3904
+//line sql.y:3931
 		{
 			yyLOCAL = Columns{NewColIdent(string(yyDollar[1].str))}
 		}
 		yyVAL.union = yyLOCAL
 	case 754:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3908
+//line sql.y:3935
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
 	case 755:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3912
+//line sql.y:3939
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, NewColIdent(string(yyDollar[3].str)))
@@ -11965,14 +12225,14 @@ yydefault:
 	case 756:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:3918
+//line sql.y:3945
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
 	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3922
+//line sql.y:3949
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
@@ -11980,7 +12240,7 @@ yydefault:
 	case 758:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3935
+//line sql.y:3962
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
@@ -11988,7 +12248,7 @@ yydefault:
 	case 759:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3939
+//line sql.y:3966
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
@@ -11996,7 +12256,7 @@ yydefault:
 	case 760:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3943
+//line sql.y:3970
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
@@ -12004,87 +12264,87 @@ yydefault:
 	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3947
+//line sql.y:3974
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 762:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3953
+//line sql.y:3980
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
 	case 763:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3955
+//line sql.y:3982
 		{
 			yyVAL.joinCondition = &JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
 	case 764:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3959
+//line sql.y:3986
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
 	case 765:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3961
+//line sql.y:3988
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
 	case 766:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3965
+//line sql.y:3992
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
 	case 767:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3967
+//line sql.y:3994
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
 	case 768:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3970
+//line sql.y:3997
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 769:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3972
+//line sql.y:3999
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 770:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3975
+//line sql.y:4002
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
 	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3979
+//line sql.y:4006
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
 	case 772:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3983
+//line sql.y:4010
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
 	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3990
+//line sql.y:4017
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
 	case 775:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3996
+//line sql.y:4023
 		{
 			yyLOCAL = NormalJoinType
 		}
@@ -12092,7 +12352,7 @@ yydefault:
 	case 776:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4000
+//line sql.y:4027
 		{
 			yyLOCAL = NormalJoinType
 		}
@@ -12100,7 +12360,7 @@ yydefault:
 	case 777:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4004
+//line sql.y:4031
 		{
 			yyLOCAL = NormalJoinType
 		}
@@ -12108,7 +12368,7 @@ yydefault:
 	case 778:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4010
+//line sql.y:4037
 		{
 			yyLOCAL = StraightJoinType
 		}
@@ -12116,7 +12376,7 @@ yydefault:
 	case 779:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4016
+//line sql.y:4043
 		{
 			yyLOCAL = LeftJoinType
 		}
@@ -12124,7 +12384,7 @@ yydefault:
 	case 780:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4020
+//line sql.y:4047
 		{
 			yyLOCAL = LeftJoinType
 		}
@@ -12132,7 +12392,7 @@ yydefault:
 	case 781:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4024
+//line sql.y:4051
 		{
 			yyLOCAL = RightJoinType
 		}
@@ -12140,7 +12400,7 @@ yydefault:
 	case 782:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4028
+//line sql.y:4055
 		{
 			yyLOCAL = RightJoinType
 		}
@@ -12148,7 +12408,7 @@ yydefault:
 	case 783:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4034
+//line sql.y:4061
 		{
 			yyLOCAL = NaturalJoinType
 		}
@@ -12156,7 +12416,7 @@ yydefault:
 	case 784:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4038
+//line sql.y:4065
 		{
 			if yyDollar[2].joinTypeUnion() == LeftJoinType {
 				yyLOCAL = NaturalLeftJoinType
@@ -12167,38 +12427,38 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 785:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4048
+//line sql.y:4075
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
 	case 786:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4052
+//line sql.y:4079
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
 	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4058
+//line sql.y:4085
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
 	case 788:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4062
+//line sql.y:4089
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
 	case 789:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4068
+//line sql.y:4095
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
 	case 790:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:4073
+//line sql.y:4100
 		{
 			yyLOCAL = nil
 		}
@@ -12206,7 +12466,7 @@ yydefault:
 	case 791:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:4077
+//line sql.y:4104
 		{
 			yyLOCAL = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columnsUnion()}
 		}
@@ -12214,7 +12474,7 @@ yydefault:
 	case 792:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:4081
+//line sql.y:4108
 		{
 			yyLOCAL = &IndexHints{Type: UseOp}
 		}
@@ -12222,7 +12482,7 @@ yydefault:
 	case 793:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:4085
+//line sql.y:4112
 		{
 			yyLOCAL = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columnsUnion()}
 		}
@@ -12230,7 +12490,7 @@ yydefault:
 	case 794:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:4089
+//line sql.y:4116
 		{
 			yyLOCAL = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columnsUnion()}
 		}
@@ -12238,7 +12498,7 @@ yydefault:
 	case 795:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4094
+//line sql.y:4121
 		{
 			yyLOCAL = nil
 		}
@@ -12246,7 +12506,7 @@ yydefault:
 	case 796:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4098
+//line sql.y:4125
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
@@ -12254,7 +12514,7 @@ yydefault:
 	case 797:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4105
+//line sql.y:4132
 		{
 			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
@@ -12262,7 +12522,7 @@ yydefault:
 	case 798:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4109
+//line sql.y:4136
 		{
 			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
@@ -12270,7 +12530,7 @@ yydefault:
 	case 799:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4113
+//line sql.y:4140
 		{
 			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
@@ -12278,7 +12538,7 @@ yydefault:
 	case 800:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4117
+//line sql.y:4144
 		{
 			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
 		}
@@ -12286,7 +12546,7 @@ yydefault:
 	case 801:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4121
+//line sql.y:4148
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].isExprOperatorUnion()}
 		}
@@ -12294,7 +12554,7 @@ yydefault:
 	case 802:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4125
+//line sql.y:4152
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12302,7 +12562,7 @@ yydefault:
 	case 803:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4132
+//line sql.y:4159
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNullOp}
 		}
@@ -12310,7 +12570,7 @@ yydefault:
 	case 804:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4136
+//line sql.y:4163
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNotNullOp}
 		}
@@ -12318,7 +12578,7 @@ yydefault:
 	case 805:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4140
+//line sql.y:4167
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
 		}
@@ -12326,7 +12586,7 @@ yydefault:
 	case 806:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4144
+//line sql.y:4171
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12334,7 +12594,7 @@ yydefault:
 	case 807:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4150
+//line sql.y:4177
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
 		}
@@ -12342,7 +12602,7 @@ yydefault:
 	case 808:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4154
+//line sql.y:4181
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
 		}
@@ -12350,7 +12610,7 @@ yydefault:
 	case 809:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4158
+//line sql.y:4185
 		{
 			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: true, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
 		}
@@ -12358,7 +12618,7 @@ yydefault:
 	case 810:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4162
+//line sql.y:4189
 		{
 			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: false, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
 		}
@@ -12366,7 +12626,7 @@ yydefault:
 	case 811:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4166
+//line sql.y:4193
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12374,7 +12634,7 @@ yydefault:
 	case 812:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4170
+//line sql.y:4197
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion()}
 		}
@@ -12382,7 +12642,7 @@ yydefault:
 	case 813:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4174
+//line sql.y:4201
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
@@ -12390,7 +12650,7 @@ yydefault:
 	case 814:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4178
+//line sql.y:4205
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[6].exprUnion()}
 		}
@@ -12398,7 +12658,7 @@ yydefault:
 	case 815:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4182
+//line sql.y:4209
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12406,7 +12666,7 @@ yydefault:
 	case 816:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4186
+//line sql.y:4213
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
@@ -12414,7 +12674,7 @@ yydefault:
 	case 817:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4190
+//line sql.y:4217
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12422,7 +12682,7 @@ yydefault:
 	case 818:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4196
+//line sql.y:4223
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12430,7 +12690,7 @@ yydefault:
 	case 819:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4200
+//line sql.y:4227
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12438,7 +12698,7 @@ yydefault:
 	case 820:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4204
+//line sql.y:4231
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12446,7 +12706,7 @@ yydefault:
 	case 821:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4208
+//line sql.y:4235
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12454,7 +12714,7 @@ yydefault:
 	case 822:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4212
+//line sql.y:4239
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12462,7 +12722,7 @@ yydefault:
 	case 823:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4216
+//line sql.y:4243
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12470,7 +12730,7 @@ yydefault:
 	case 824:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4220
+//line sql.y:4247
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12478,7 +12738,7 @@ yydefault:
 	case 825:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4224
+//line sql.y:4251
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12486,7 +12746,7 @@ yydefault:
 	case 826:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4228
+//line sql.y:4255
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12494,7 +12754,7 @@ yydefault:
 	case 827:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4232
+//line sql.y:4259
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12502,7 +12762,7 @@ yydefault:
 	case 828:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4236
+//line sql.y:4263
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12510,7 +12770,7 @@ yydefault:
 	case 829:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4240
+//line sql.y:4267
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
@@ -12518,7 +12778,7 @@ yydefault:
 	case 830:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4244
+//line sql.y:4271
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12526,7 +12786,7 @@ yydefault:
 	case 831:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4250
+//line sql.y:4277
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12534,7 +12794,7 @@ yydefault:
 	case 832:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4254
+//line sql.y:4281
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12542,7 +12802,7 @@ yydefault:
 	case 833:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4258
+//line sql.y:4285
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
@@ -12550,143 +12810,151 @@ yydefault:
 	case 834:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4262
+//line sql.y:4289
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 835:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4293
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 836:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4266
+//line sql.y:4297
 		{
 			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Collation: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 837:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4270
+//line sql.y:4301
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 838:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4274
+//line sql.y:4305
 		{
 			yyLOCAL = yyDollar[1].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 839:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4278
+//line sql.y:4309
 		{
 			yyLOCAL = yyDollar[2].exprUnion() // TODO: do we really want to ignore unary '+' before any kind of literals?
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 840:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4282
+//line sql.y:4313
 		{
 			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 841:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4286
+//line sql.y:4317
 		{
 			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 842:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4290
+//line sql.y:4321
 		{
 			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 843:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4294
+//line sql.y:4325
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 844:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4298
+//line sql.y:4329
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 845:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4302
+//line sql.y:4333
 		{
 			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 846:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4306
+//line sql.y:4337
 		{
 			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 847:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4310
+//line sql.y:4341
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 848:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4314
+//line sql.y:4345
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 849:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4318
+//line sql.y:4349
 		{
 			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 850:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4322
+//line sql.y:4353
 		{
 			yyLOCAL = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 851:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4326
+//line sql.y:4357
 		{
 			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 852:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4330
+//line sql.y:4361
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -12695,850 +12963,1243 @@ yydefault:
 			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 853:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4338
+//line sql.y:4369
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 854:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4342
+//line sql.y:4373
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 855:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4349
+//line sql.y:4380
 		{
 			yyVAL.str = ""
 		}
-	case 855:
+	case 856:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4353
+//line sql.y:4384
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 856:
+	case 857:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:4359
+//line sql.y:4390
 		{
 			yyLOCAL = BoolVal(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 858:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:4363
+//line sql.y:4394
 		{
 			yyLOCAL = BoolVal(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 859:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4370
+//line sql.y:4401
 		{
 			yyLOCAL = IsTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 860:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4374
+//line sql.y:4405
 		{
 			yyLOCAL = IsNotTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 861:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4378
+//line sql.y:4409
 		{
 			yyLOCAL = IsFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 862:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4382
+//line sql.y:4413
 		{
 			yyLOCAL = IsNotFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 863:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4388
+//line sql.y:4419
 		{
 			yyLOCAL = EqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4392
+//line sql.y:4423
 		{
 			yyLOCAL = LessThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4396
+//line sql.y:4427
 		{
 			yyLOCAL = GreaterThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 866:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4400
+//line sql.y:4431
 		{
 			yyLOCAL = LessEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 867:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4404
+//line sql.y:4435
 		{
 			yyLOCAL = GreaterEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 868:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4408
+//line sql.y:4439
 		{
 			yyLOCAL = NotEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 869:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4412
+//line sql.y:4443
 		{
 			yyLOCAL = NullSafeEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 870:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4418
+//line sql.y:4449
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 871:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4422
+//line sql.y:4453
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 872:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4426
+//line sql.y:4457
 		{
 			yyLOCAL = ListArg(yyDollar[1].str[2:])
 			bindVariable(yylex, yyDollar[1].str[2:])
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 873:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Subquery
-//line sql.y:4433
+//line sql.y:4464
 		{
 			yyLOCAL = &Subquery{yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 874:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4439
+//line sql.y:4470
 		{
 			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 875:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4443
+//line sql.y:4474
 		{
 			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
 		}
-	case 875:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4453
-		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
-		}
-		yyVAL.union = yyLOCAL
 	case 876:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4457
+//line sql.y:4484
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion(), Over: yyDollar[5].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 877:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4461
+//line sql.y:4488
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 878:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4465
+//line sql.y:4492
 		{
-			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 879:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4475
+//line sql.y:4496
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
+			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 880:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4479
+//line sql.y:4506
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
+			yyLOCAL = &WindowFuncExpr{Name: "row_number", Over: yyDollar[4].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 881:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4483
+//line sql.y:4510
 		{
-			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
+			yyLOCAL = &WindowFuncExpr{Name: "rank", Over: yyDollar[4].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 882:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4487
+//line sql.y:4514
 		{
-			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
+			yyLOCAL = &WindowFuncExpr{Name: "dense_rank", Over: yyDollar[4].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 883:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4491
+//line sql.y:4518
 		{
-			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
+			yyLOCAL = &WindowFuncExpr{Name: "cume_dist", Over: yyDollar[4].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 884:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4495
+//line sql.y:4522
 		{
-			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
+			yyLOCAL = &WindowFuncExpr{Name: "percent_rank", Over: yyDollar[4].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 885:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4499
+//line sql.y:4526
 		{
-			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
+			yyLOCAL = &WindowFuncExpr{Name: "ntile", Exprs: Exprs{yyDollar[3].exprUnion()}, Over: yyDollar[5].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 886:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4503
+//line sql.y:4530
 		{
-			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
+			yyLOCAL = &WindowFuncExpr{Name: "lag", Exprs: Exprs{yyDollar[3].exprUnion()}, IgnoreNulls: yyDollar[5].booleanUnion(), Over: yyDollar[6].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 887:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4507
+//line sql.y:4534
 		{
-			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
+			yyLOCAL = &WindowFuncExpr{Name: "lag", Exprs: Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()}, IgnoreNulls: yyDollar[7].booleanUnion(), Over: yyDollar[8].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 888:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4511
+//line sql.y:4538
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
+			yyLOCAL = &WindowFuncExpr{Name: "lag", Exprs: Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion(), yyDollar[7].exprUnion()}, IgnoreNulls: yyDollar[9].booleanUnion(), Over: yyDollar[10].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 889:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4522
+//line sql.y:4542
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
+			yyLOCAL = &WindowFuncExpr{Name: "lead", Exprs: Exprs{yyDollar[3].exprUnion()}, IgnoreNulls: yyDollar[5].booleanUnion(), Over: yyDollar[6].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 890:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4526
+//line sql.y:4546
 		{
-			yyLOCAL = yyDollar[1].exprUnion()
+			yyLOCAL = &WindowFuncExpr{Name: "lead", Exprs: Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()}, IgnoreNulls: yyDollar[7].booleanUnion(), Over: yyDollar[8].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 891:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4532
+//line sql.y:4550
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
+			yyLOCAL = &WindowFuncExpr{Name: "lead", Exprs: Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion(), yyDollar[7].exprUnion()}, IgnoreNulls: yyDollar[9].booleanUnion(), Over: yyDollar[10].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 892:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4536
+//line sql.y:4554
 		{
-			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].literalUnion()}
+			yyLOCAL = &WindowFuncExpr{Name: "first_value", Exprs: Exprs{yyDollar[3].exprUnion()}, IgnoreNulls: yyDollar[5].booleanUnion(), Over: yyDollar[6].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 893:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4541
+//line sql.y:4558
 		{
-			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].literalUnion()}
+			yyLOCAL = &WindowFuncExpr{Name: "last_value", Exprs: Exprs{yyDollar[3].exprUnion()}, IgnoreNulls: yyDollar[5].booleanUnion(), Over: yyDollar[6].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 894:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4545
+//line sql.y:4562
 		{
-			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
+			yyLOCAL = &WindowFuncExpr{Name: "nth_value", Exprs: Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()}, FromLast: yyDollar[7].booleanUnion(), IgnoreNulls: yyDollar[8].booleanUnion(), Over: yyDollar[9].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 895:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4549
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:4567
 		{
-			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
+			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
 	case 896:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4553
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:4571
 		{
-			yyLOCAL = &ExtractFuncExpr{IntervalTypes: yyDollar[3].intervalTypeUnion(), Expr: yyDollar[5].exprUnion()}
+			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
 	case 897:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4559
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:4575
 		{
+			yyLOCAL = true
 		}
+		yyVAL.union = yyLOCAL
 	case 898:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IntervalTypes
-//line sql.y:4561
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:4580
 		{
-			yyLOCAL = IntervalDayHour
+			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
 	case 899:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IntervalTypes
-//line sql.y:4565
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:4584
 		{
-			yyLOCAL = IntervalDayMicrosecond
+			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
 	case 900:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IntervalTypes
-//line sql.y:4569
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:4588
 		{
-			yyLOCAL = IntervalDayMinute
+			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 901:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IntervalTypes
-//line sql.y:4573
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4593
 		{
-			yyLOCAL = IntervalDaySecond
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
 	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IntervalTypes
-//line sql.y:4577
+		var yyLOCAL *OverClause
+//line sql.y:4597
 		{
-			yyLOCAL = IntervalHourMicrosecond
+			yyLOCAL = yyDollar[1].overClauseUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 903:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IntervalTypes
-//line sql.y:4581
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4603
 		{
-			yyLOCAL = IntervalHourMinute
+			yyLOCAL = &OverClause{WindowName: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
 	case 904:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IntervalTypes
-//line sql.y:4585
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4607
 		{
-			yyLOCAL = IntervalHourSecond
+			yyLOCAL = &OverClause{WindowSpec: yyDollar[3].windowSpecUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 905:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IntervalTypes
-//line sql.y:4589
+		var yyLOCAL *WindowSpecification
+//line sql.y:4613
 		{
-			yyLOCAL = IntervalMinuteMicrosecond
+			yyLOCAL = yyDollar[1].windowSpecUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 906:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IntervalTypes
-//line sql.y:4593
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *WindowSpecification
+//line sql.y:4617
 		{
-			yyLOCAL = IntervalMinuteSecond
+			yyDollar[2].windowSpecUnion().Name = yyDollar[1].colIdent
+			yyLOCAL = yyDollar[2].windowSpecUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 907:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IntervalTypes
-//line sql.y:4597
+		var yyLOCAL *WindowSpecification
+//line sql.y:4628
 		{
-			yyLOCAL = IntervalSecondMicrosecond
+			yyLOCAL = &WindowSpecification{FrameClause: yyDollar[1].frameClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 908:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IntervalTypes
-//line sql.y:4601
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *WindowSpecification
+//line sql.y:4632
 		{
-			yyLOCAL = IntervalYearMonth
+			yyLOCAL = &WindowSpecification{OrderClause: yyDollar[1].orderByUnion(), FrameClause: yyDollar[2].frameClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 909:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IntervalTypes
-//line sql.y:4607
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *WindowSpecification
+//line sql.y:4636
 		{
-			yyLOCAL = IntervalDay
+			yyLOCAL = &WindowSpecification{PartitionClause: yyDollar[1].exprsUnion(), OrderClause: yyDollar[2].orderByUnion(), FrameClause: yyDollar[3].frameClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 910:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IntervalTypes
-//line sql.y:4611
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4642
 		{
-			yyLOCAL = IntervalWeek
+			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 911:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4647
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 912:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4651
+		{
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitUnion(), Start: yyDollar[2].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 913:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4655
+		{
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitUnion(), Start: yyDollar[3].framePointUnion(), End: yyDollar[5].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 914:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL FrameUnitType
+//line sql.y:4661
+		{
+			yyLOCAL = RowsUnit
+		}
+		yyVAL.union = yyLOCAL
+	case 915:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL FrameUnitType
+//line sql.y:4665
+		{
+			yyLOCAL = RangeUnit
+		}
+		yyVAL.union = yyLOCAL
+	case 916:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4671
+		{
+			yyLOCAL = &FramePoint{Type: CurrentRowType}
+		}
+		yyVAL.union = yyLOCAL
+	case 917:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4675
+		{
+			yyLOCAL = &FramePoint{Type: UnboundedPrecedingType}
+		}
+		yyVAL.union = yyLOCAL
+	case 918:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4679
+		{
+			yyLOCAL = &FramePoint{Type: UnboundedFollowingType}
+		}
+		yyVAL.union = yyLOCAL
+	case 919:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4683
+		{
+			yyLOCAL = &FramePoint{Type: ExprPrecedingType, Expr: yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 920:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4687
+		{
+			yyLOCAL = &FramePoint{Type: ExprFollowingType, Expr: yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 921:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4697
+		{
+			yyLOCAL = NewIntLiteral(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 922:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4701
+		{
+			yyLOCAL = NewArgument(yyDollar[1].str[1:])
+			bindVariable(yylex, yyDollar[1].str[1:])
+		}
+		yyVAL.union = yyLOCAL
+	case 923:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4706
+		{
+			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
+		}
+		yyVAL.union = yyLOCAL
+	case 924:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL WindowDefinitions
+//line sql.y:4711
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 925:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL WindowDefinitions
+//line sql.y:4715
+		{
+			yyLOCAL = yyDollar[2].windowDefinitionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 926:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL WindowDefinitions
+//line sql.y:4721
+		{
+			yyLOCAL = WindowDefinitions{yyDollar[1].windowDefinitionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 927:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4725
+		{
+			yySLICE := (*WindowDefinitions)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].windowDefinitionUnion())
+		}
+	case 928:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *WindowDefinition
+//line sql.y:4731
+		{
+			yyLOCAL = &WindowDefinition{Name: yyDollar[1].colIdent, WindowSpec: yyDollar[4].windowSpecUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 929:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4741
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 930:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4745
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 931:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4749
+		{
+			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 932:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4753
+		{
+			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 933:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4757
+		{
+			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 934:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4761
+		{
+			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 935:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4765
+		{
+			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 936:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4769
+		{
+			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 937:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4773
+		{
+			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 938:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4777
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 939:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4788
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
+		}
+		yyVAL.union = yyLOCAL
+	case 940:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4792
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 941:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4798
+		{
+			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
+		}
+		yyVAL.union = yyLOCAL
+	case 942:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4802
+		{
+			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].literalUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 943:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4807
+		{
+			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].literalUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 944:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4811
+		{
+			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 945:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4815
+		{
+			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 946:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4819
+		{
+			yyLOCAL = &ExtractFuncExpr{IntervalTypes: yyDollar[3].intervalTypeUnion(), Expr: yyDollar[5].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 947:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4825
+		{
+		}
+	case 948:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IntervalTypes
+//line sql.y:4827
+		{
+			yyLOCAL = IntervalDayHour
+		}
+		yyVAL.union = yyLOCAL
+	case 949:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IntervalTypes
+//line sql.y:4831
+		{
+			yyLOCAL = IntervalDayMicrosecond
+		}
+		yyVAL.union = yyLOCAL
+	case 950:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IntervalTypes
+//line sql.y:4835
+		{
+			yyLOCAL = IntervalDayMinute
+		}
+		yyVAL.union = yyLOCAL
+	case 951:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IntervalTypes
+//line sql.y:4839
+		{
+			yyLOCAL = IntervalDaySecond
+		}
+		yyVAL.union = yyLOCAL
+	case 952:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IntervalTypes
+//line sql.y:4843
+		{
+			yyLOCAL = IntervalHourMicrosecond
+		}
+		yyVAL.union = yyLOCAL
+	case 953:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IntervalTypes
+//line sql.y:4847
+		{
+			yyLOCAL = IntervalHourMinute
+		}
+		yyVAL.union = yyLOCAL
+	case 954:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IntervalTypes
+//line sql.y:4851
+		{
+			yyLOCAL = IntervalHourSecond
+		}
+		yyVAL.union = yyLOCAL
+	case 955:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4615
+//line sql.y:4855
+		{
+			yyLOCAL = IntervalMinuteMicrosecond
+		}
+		yyVAL.union = yyLOCAL
+	case 956:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IntervalTypes
+//line sql.y:4859
+		{
+			yyLOCAL = IntervalMinuteSecond
+		}
+		yyVAL.union = yyLOCAL
+	case 957:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IntervalTypes
+//line sql.y:4863
+		{
+			yyLOCAL = IntervalSecondMicrosecond
+		}
+		yyVAL.union = yyLOCAL
+	case 958:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IntervalTypes
+//line sql.y:4867
+		{
+			yyLOCAL = IntervalYearMonth
+		}
+		yyVAL.union = yyLOCAL
+	case 959:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IntervalTypes
+//line sql.y:4873
+		{
+			yyLOCAL = IntervalDay
+		}
+		yyVAL.union = yyLOCAL
+	case 960:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IntervalTypes
+//line sql.y:4877
+		{
+			yyLOCAL = IntervalWeek
+		}
+		yyVAL.union = yyLOCAL
+	case 961:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IntervalTypes
+//line sql.y:4881
 		{
 			yyLOCAL = IntervalHour
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4619
+//line sql.y:4885
 		{
 			yyLOCAL = IntervalMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 963:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4623
+//line sql.y:4889
 		{
 			yyLOCAL = IntervalMonth
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4627
+//line sql.y:4893
 		{
 			yyLOCAL = IntervalQuarter
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 965:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4631
+//line sql.y:4897
 		{
 			yyLOCAL = IntervalSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4635
+//line sql.y:4901
 		{
 			yyLOCAL = IntervalMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4639
+//line sql.y:4905
 		{
 			yyLOCAL = IntervalYear
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 970:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4649
+//line sql.y:4915
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 971:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4653
+//line sql.y:4919
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 972:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4657
+//line sql.y:4923
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 973:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4667
+//line sql.y:4933
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 974:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4671
+//line sql.y:4937
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 975:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4675
+//line sql.y:4941
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 976:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4679
+//line sql.y:4945
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 977:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4683
+//line sql.y:4949
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 978:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4689
+//line sql.y:4955
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 979:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4693
+//line sql.y:4959
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 980:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4697
+//line sql.y:4963
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 981:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4701
+//line sql.y:4967
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 982:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4705
+//line sql.y:4971
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4711
+//line sql.y:4977
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 934:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4715
+//line sql.y:4981
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 935:
+	case 985:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4719
+//line sql.y:4985
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 936:
+	case 986:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4725
+//line sql.y:4991
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 987:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4729
+//line sql.y:4995
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 988:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4733
+//line sql.y:4999
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: string(yyDollar[3].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 989:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4737
+//line sql.y:5003
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 990:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4741
+//line sql.y:5007
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 991:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4745
+//line sql.y:5011
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 992:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4751
+//line sql.y:5017
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 993:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4755
+//line sql.y:5021
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 994:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4759
+//line sql.y:5025
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 995:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4763
+//line sql.y:5029
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 996:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4767
+//line sql.y:5033
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4771
+//line sql.y:5037
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 998:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4775
+//line sql.y:5041
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 999:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4780
+//line sql.y:5046
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 1000:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4784
+//line sql.y:5050
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 1001:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4789
+//line sql.y:5055
 		{
 			yyVAL.str = string("")
 		}
-	case 952:
+	case 1002:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4793
+//line sql.y:5059
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 953:
+	case 1003:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:4799
+//line sql.y:5065
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 1004:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4803
+//line sql.y:5069
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 955:
+	case 1005:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:4809
+//line sql.y:5075
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1006:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4814
+//line sql.y:5080
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1007:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4818
+//line sql.y:5084
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 1008:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4824
+//line sql.y:5090
 		{
 			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 1009:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4828
+//line sql.y:5094
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1010:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4832
+//line sql.y:5098
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1011:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4838
+//line sql.y:5104
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -13548,707 +14209,707 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1012:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4847
+//line sql.y:5113
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1013:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4851
+//line sql.y:5117
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1014:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4857
+//line sql.y:5123
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1015:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4861
+//line sql.y:5127
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1016:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4866
+//line sql.y:5132
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1017:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4870
+//line sql.y:5136
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1018:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4875
+//line sql.y:5141
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1019:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4879
+//line sql.y:5145
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1020:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4885
+//line sql.y:5151
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1021:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4891
+//line sql.y:5157
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1022:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4895
+//line sql.y:5161
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 973:
+	case 1023:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:4901
+//line sql.y:5167
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1024:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4906
+//line sql.y:5172
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1025:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4910
+//line sql.y:5176
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1026:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4914
+//line sql.y:5180
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1027:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4919
+//line sql.y:5185
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1028:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4923
+//line sql.y:5189
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1029:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4929
+//line sql.y:5195
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1030:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4933
+//line sql.y:5199
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1031:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4937
+//line sql.y:5203
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1032:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4942
+//line sql.y:5208
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1033:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4946
+//line sql.y:5212
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1034:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4950
+//line sql.y:5216
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1035:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4954
+//line sql.y:5220
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1036:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4958
+//line sql.y:5224
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1037:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4965
+//line sql.y:5231
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1038:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4969
+//line sql.y:5235
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1039:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4973
+//line sql.y:5239
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1040:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4977
+//line sql.y:5243
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1041:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4983
+//line sql.y:5249
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1042:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4987
+//line sql.y:5253
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1043:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4991
+//line sql.y:5257
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1044:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4996
+//line sql.y:5262
 		{
 			yyVAL.str = ""
 		}
-	case 995:
+	case 1045:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5000
+//line sql.y:5266
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 996:
+	case 1046:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5004
+//line sql.y:5270
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 997:
+	case 1047:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5008
+//line sql.y:5274
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 998:
+	case 1048:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5013
+//line sql.y:5279
 		{
 			yyVAL.str = ""
 		}
-	case 999:
+	case 1049:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5017
+//line sql.y:5283
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 1000:
+	case 1050:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5023
+//line sql.y:5289
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1001:
+	case 1051:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5027
+//line sql.y:5293
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1002:
+	case 1052:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5032
+//line sql.y:5298
 		{
 			yyVAL.str = ""
 		}
-	case 1003:
+	case 1053:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:5036
+//line sql.y:5302
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 1004:
+	case 1054:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5041
+//line sql.y:5307
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 1005:
+	case 1055:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5045
+//line sql.y:5311
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1006:
+	case 1056:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5049
+//line sql.y:5315
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1007:
+	case 1057:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5054
+//line sql.y:5320
 		{
 			yyVAL.str = ""
 		}
-	case 1008:
+	case 1058:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5058
+//line sql.y:5324
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 1009:
+	case 1059:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5064
+//line sql.y:5330
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1010:
+	case 1060:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5068
+//line sql.y:5334
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1011:
+	case 1061:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5072
+//line sql.y:5338
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str) + "@" + string(yyDollar[2].str)
 		}
-	case 1012:
+	case 1062:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5076
+//line sql.y:5342
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1013:
+	case 1063:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:5082
+//line sql.y:5348
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1064:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:5086
+//line sql.y:5352
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1065:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5092
+//line sql.y:5358
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1066:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5096
+//line sql.y:5362
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1067:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5100
+//line sql.y:5366
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1068:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5105
+//line sql.y:5371
 		{
 			yyVAL.str = ""
 		}
-	case 1019:
+	case 1069:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5109
+//line sql.y:5375
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 1020:
+	case 1070:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5113
+//line sql.y:5379
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 1021:
+	case 1071:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5118
+//line sql.y:5384
 		{
 			yyVAL.str = ""
 		}
-	case 1022:
+	case 1072:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5122
+//line sql.y:5388
 		{
 			yyVAL.str = " header"
 		}
-	case 1023:
+	case 1073:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5127
+//line sql.y:5393
 		{
 			yyVAL.str = ""
 		}
-	case 1024:
+	case 1074:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5131
+//line sql.y:5397
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 1025:
+	case 1075:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5135
+//line sql.y:5401
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 1026:
+	case 1076:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5140
+//line sql.y:5406
 		{
 			yyVAL.str = ""
 		}
-	case 1027:
+	case 1077:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5144
+//line sql.y:5410
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 1028:
+	case 1078:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5148
+//line sql.y:5414
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 1029:
+	case 1079:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5154
+//line sql.y:5420
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1030:
+	case 1080:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5159
+//line sql.y:5425
 		{
 			yyVAL.str = ""
 		}
-	case 1031:
+	case 1081:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5163
+//line sql.y:5429
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 1032:
+	case 1082:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5169
+//line sql.y:5435
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1033:
+	case 1083:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5173
+//line sql.y:5439
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1034:
+	case 1084:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5179
+//line sql.y:5445
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1035:
+	case 1085:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5183
+//line sql.y:5449
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1036:
+	case 1086:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5188
+//line sql.y:5454
 		{
 			yyVAL.str = ""
 		}
-	case 1037:
+	case 1087:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5192
+//line sql.y:5458
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 1038:
+	case 1088:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5198
+//line sql.y:5464
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1039:
+	case 1089:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5202
+//line sql.y:5468
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1040:
+	case 1090:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5208
+//line sql.y:5474
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1041:
+	case 1091:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:5212
+//line sql.y:5478
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 1042:
+	case 1092:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5216
+//line sql.y:5482
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1043:
+	case 1093:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5221
+//line sql.y:5487
 		{
 			yyVAL.str = ""
 		}
-	case 1044:
+	case 1094:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5225
+//line sql.y:5491
 		{
 			yyVAL.str = " optionally"
 		}
-	case 1045:
+	case 1095:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5238
+//line sql.y:5504
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1046:
+	case 1096:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5242
+//line sql.y:5508
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1047:
+	case 1097:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5246
+//line sql.y:5512
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1048:
+	case 1098:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5250
+//line sql.y:5516
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1049:
+	case 1099:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5254
+//line sql.y:5520
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1050:
+	case 1100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:5260
+//line sql.y:5526
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1051:
+	case 1101:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:5264
+//line sql.y:5530
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1052:
+	case 1102:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5268
+//line sql.y:5534
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 1053:
+	case 1103:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:5272
+//line sql.y:5538
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 1054:
+	case 1104:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5277
+//line sql.y:5543
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1055:
+	case 1105:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5281
+//line sql.y:5547
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1056:
+	case 1106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:5287
+//line sql.y:5553
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1057:
+	case 1107:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5291
+//line sql.y:5557
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 1058:
+	case 1108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5297
+//line sql.y:5563
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1059:
+	case 1109:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5301
+//line sql.y:5567
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1060:
+	case 1110:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5307
+//line sql.y:5573
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1061:
+	case 1111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5312
+//line sql.y:5578
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -14257,329 +14918,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1062:
+	case 1112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5322
+//line sql.y:5588
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1063:
+	case 1113:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5326
+//line sql.y:5592
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 1064:
+	case 1114:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:5332
+//line sql.y:5598
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1065:
+	case 1115:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:5338
+//line sql.y:5604
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1066:
+	case 1116:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5342
+//line sql.y:5608
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 1067:
+	case 1117:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5348
+//line sql.y:5614
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 1068:
+	case 1118:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5352
+//line sql.y:5618
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 1069:
+	case 1119:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5356
+//line sql.y:5622
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1070:
+	case 1120:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5360
+//line sql.y:5626
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1071:
+	case 1121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5364
+//line sql.y:5630
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1073:
+	case 1123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5372
+//line sql.y:5638
 		{
 			yyVAL.str = "charset"
 		}
-	case 1076:
+	case 1126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5382
+//line sql.y:5648
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 1077:
+	case 1127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5386
+//line sql.y:5652
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1078:
+	case 1128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5390
+//line sql.y:5656
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1081:
+	case 1131:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5399
+//line sql.y:5665
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1082:
+	case 1132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5401
+//line sql.y:5667
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1083:
+	case 1133:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5404
+//line sql.y:5670
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1084:
+	case 1134:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5406
+//line sql.y:5672
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1085:
+	case 1135:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5409
+//line sql.y:5675
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1086:
+	case 1136:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5411
+//line sql.y:5677
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1087:
+	case 1137:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:5414
+//line sql.y:5680
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1088:
+	case 1138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:5416
+//line sql.y:5682
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1089:
+	case 1139:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5419
+//line sql.y:5685
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1090:
+	case 1140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5421
+//line sql.y:5687
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1091:
+	case 1141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5423
+//line sql.y:5689
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1092:
+	case 1142:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:5427
+//line sql.y:5693
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1093:
+	case 1143:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5432
+//line sql.y:5698
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1094:
+	case 1144:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5436
+//line sql.y:5702
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1095:
+	case 1145:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:5441
+//line sql.y:5707
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1096:
+	case 1146:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:5443
+//line sql.y:5709
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1097:
+	case 1147:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:5447
+//line sql.y:5713
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5453
+//line sql.y:5719
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 1099:
+	case 1149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5457
+//line sql.y:5723
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 1101:
+	case 1151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5464
+//line sql.y:5730
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 1102:
+	case 1152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5470
+//line sql.y:5736
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 1103:
+	case 1153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5474
+//line sql.y:5740
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1104:
+	case 1154:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5480
+//line sql.y:5746
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 1105:
+	case 1155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5484
+//line sql.y:5750
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 1107:
+	case 1157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5491
+//line sql.y:5757
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1549:
+	case 1602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5957
+//line sql.y:6226
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1550:
+	case 1603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5966
+//line sql.y:6235
 		{
 			decNesting(yylex)
 		}
-	case 1551:
+	case 1604:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5971
+//line sql.y:6240
 		{
 			skipToEnd(yylex)
 		}
-	case 1552:
+	case 1605:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5976
+//line sql.y:6245
 		{
 			skipToEnd(yylex)
 		}
-	case 1553:
+	case 1606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5980
+//line sql.y:6249
 		{
 			skipToEnd(yylex)
 		}
-	case 1554:
+	case 1607:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5984
+//line sql.y:6253
 		{
 			skipToEnd(yylex)
 		}
@@ -167,7 +167,14 @@ func bindVariable(yylex yyLexer, bvar string) {
   ignore Ignore
   partitionOption *PartitionOption
   exprOrColumns *ExprOrColumns
+  frameUnit     FrameUnitType
   subPartition  *SubPartition
+  overClause    *OverClause
+  windowSpec    *WindowSpecification
+  frameClause   *FrameClause
+  framePoint    *FramePoint
+  windowDefinition *WindowDefinition
+  windowDefinitions WindowDefinitions
 }
 
 %token LEX_ERROR
@@ -183,6 +190,9 @@ func bindVariable(yylex yyLexer, bvar string) {
 %left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
 %left <str> ON USING INPLACE COPY ALGORITHM NONE SHARED EXCLUSIVE
 %left <str> SUBQUERY_AS_EXPR
+// Window function names are non-reserved, but are always parsed as a window
+// function when followed by '('.
+%nonassoc <str> CUME_DIST DENSE_RANK FIRST_VALUE LAG LAST_VALUE LEAD NTH_VALUE NTILE PERCENT_RANK RANK ROW_NUMBER
 %left <str> '(' ',' ')'
 %token <str> ID AT_ID AT_AT_ID HEX STRING NCHAR_STRING INTEGRAL FLOAT HEXNUM VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD BIT_LITERAL COMPRESSION
 %token <str> EXTRACT
@@ -281,14 +291,17 @@ func bindVariable(yylex yyLexer, bvar string) {
 // Match
 %token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION WITHOUT VALIDATION
 
+// Window functions
+%token <str> OVER WINDOW ROWS ROW CURRENT UNBOUNDED PRECEDING FOLLOWING NULLS RESPECT
+
 // MySQL reserved words that are unused by this grammar will map to this token.
-%token <str> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
-%token <str> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
-%token <str> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE FOLLOWING GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
+%token <str> UNUSED ARRAY DESCRIPTION EMPTY EXCEPT GROUPING GROUPS JSON_TABLE LATERAL MEMBER
+%token <str> OF RECURSIVE SYSTEM
+%token <str> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
 %token <str> INACTIVE INVISIBLE LOCKED MASTER_COMPRESSION_ALGORITHMS MASTER_PUBLIC_KEY_PATH MASTER_TLS_CIPHERSUITES MASTER_ZSTD_COMPRESSION_LEVEL
-%token <str> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRECEDING PRIVILEGE_CHECKS_USER PROCESS
-%token <str> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESPECT RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_LOAD SECONDARY_UNLOAD SKIP SRID
-%token <str> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
+%token <str> NESTED NETWORK_NAMESPACE NOWAIT OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRIVILEGE_CHECKS_USER PROCESS
+%token <str> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_LOAD SECONDARY_UNLOAD SKIP SRID
+%token <str> THREAD_PRIORITY TIES VCPU VISIBLE
 
 // Explain tokens
 %token <str> FORMAT TREE VITESS TRADITIONAL
@@ -361,7 +374,17 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <comparisonExprOperator> compare
 %type <ins> insert_data
 %type <expr> num_val
-%type <expr> function_call_keyword function_call_nonkeyword function_call_generic function_call_conflict
+%type <expr> function_call_keyword function_call_nonkeyword function_call_generic function_call_conflict function_call_window
+%type <overClause> over_clause over_clause_opt
+%type <windowSpec> window_spec window_spec_details
+%type <exprs> partition_clause
+%type <frameClause> frame_clause_opt
+%type <framePoint> frame_point
+%type <expr> frame_expression
+%type <frameUnit> frame_units
+%type <boolean> from_first_last_opt null_treatment_opt
+%type <windowDefinition> window_definition
+%type <windowDefinitions> window_clause_opt window_definition_list
 %type <isExprOperator> is_suffix
 %type <colTuple> col_tuple
 %type <exprs> expression_list expression_list_opt
@@ -543,11 +566,11 @@ load_statement:
 with_clause:
   WITH with_list
   {
-	$$ = &With{ctes: $2, Recursive: false}
+	$$ = &With{Ctes: $2, Recursive: false}
   }
 | WITH RECURSIVE with_list
   {
-	$$ = &With{ctes: $3, Recursive: true}
+	$$ = &With{Ctes: $3, Recursive: true}
   }
 
 with_clause_opt:
@@ -731,13 +754,17 @@ stream_statement:
 // query_primary is an unparenthesized SELECT with no order by clause or beyond.
 query_primary:
 //  1         2            3              4                    5             6                7           8
-  SELECT comment_opt select_options select_expression_list into_clause from_opt where_expression_opt group_by_opt having_opt
+  SELECT comment_opt select_options select_expression_list into_clause from_opt where_expression_opt group_by_opt having_opt window_clause_opt
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*into*/, $6/*from*/, NewWhere(WhereClause, $7), GroupBy($8), NewWhere(HavingClause, $9))
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*into*/, $6/*from*/, NewWhere(WhereClause, $7), GroupBy($8), NewWhere(HavingClause, $9))
+    sel.Windows = $10
+    $$ = sel
   }
-| SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt
+| SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt window_clause_opt
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, nil, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, nil, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel.Windows = $9
+    $$ = sel
   }
 
 
@@ -4262,6 +4289,10 @@ function_call_keyword
   {
   	$$ = $1
   }
+| function_call_window
+  {
+  	$$ = $1
+  }
 | simple_expr COLLATE charset %prec UNARY
   {
 	$$ = &CollateExpr{Expr: $1, Collation: $3}
@@ -4449,9 +4480,9 @@ expression_list:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_clause_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
 | sql_id openb DISTINCT select_expression_list closeb
   {
@@ -4466,6 +4497,241 @@ function_call_generic:
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
 
+/*
+  Window functions, which are all reserved keywords and always
+  require an OVER clause
+*/
+function_call_window:
+  ROW_NUMBER openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "row_number", Over: $4}
+  }
+| RANK openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "rank", Over: $4}
+  }
+| DENSE_RANK openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "dense_rank", Over: $4}
+  }
+| CUME_DIST openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "cume_dist", Over: $4}
+  }
+| PERCENT_RANK openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "percent_rank", Over: $4}
+  }
+| NTILE openb expression closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "ntile", Exprs: Exprs{$3}, Over: $5}
+  }
+| LAG openb expression closeb null_treatment_opt over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "lag", Exprs: Exprs{$3}, IgnoreNulls: $5, Over: $6}
+  }
+| LAG openb expression ',' expression closeb null_treatment_opt over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "lag", Exprs: Exprs{$3, $5}, IgnoreNulls: $7, Over: $8}
+  }
+| LAG openb expression ',' expression ',' expression closeb null_treatment_opt over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "lag", Exprs: Exprs{$3, $5, $7}, IgnoreNulls: $9, Over: $10}
+  }
+| LEAD openb expression closeb null_treatment_opt over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "lead", Exprs: Exprs{$3}, IgnoreNulls: $5, Over: $6}
+  }
+| LEAD openb expression ',' expression closeb null_treatment_opt over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "lead", Exprs: Exprs{$3, $5}, IgnoreNulls: $7, Over: $8}
+  }
+| LEAD openb expression ',' expression ',' expression closeb null_treatment_opt over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "lead", Exprs: Exprs{$3, $5, $7}, IgnoreNulls: $9, Over: $10}
+  }
+| FIRST_VALUE openb expression closeb null_treatment_opt over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "first_value", Exprs: Exprs{$3}, IgnoreNulls: $5, Over: $6}
+  }
+| LAST_VALUE openb expression closeb null_treatment_opt over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "last_value", Exprs: Exprs{$3}, IgnoreNulls: $5, Over: $6}
+  }
+| NTH_VALUE openb expression ',' expression closeb from_first_last_opt null_treatment_opt over_clause
+  {
+    $$ = &WindowFuncExpr{Name: "nth_value", Exprs: Exprs{$3, $5}, FromLast: $7, IgnoreNulls: $8, Over: $9}
+  }
+
+null_treatment_opt:
+  {
+    $$ = false
+  }
+| RESPECT NULLS
+  {
+    $$ = false
+  }
+| IGNORE NULLS
+  {
+    $$ = true
+  }
+
+from_first_last_opt:
+  {
+    $$ = false
+  }
+| FROM FIRST
+  {
+    $$ = false
+  }
+| FROM LAST
+  {
+    $$ = true
+  }
+
+over_clause_opt:
+  {
+    $$ = nil
+  }
+| over_clause
+  {
+    $$ = $1
+  }
+
+over_clause:
+  OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+| OVER openb window_spec closeb
+  {
+    $$ = &OverClause{WindowSpec: $3}
+  }
+
+window_spec:
+  window_spec_details
+  {
+    $$ = $1
+  }
+| sql_id window_spec_details
+  {
+    $2.Name = $1
+    $$ = $2
+  }
+
+/*
+  The optional clauses are spelled out instead of using empty rules, so that
+  the frame units (ROWS) can also be used as the name of the window
+*/
+window_spec_details:
+  frame_clause_opt
+  {
+    $$ = &WindowSpecification{FrameClause: $1}
+  }
+| order_by_clause frame_clause_opt
+  {
+    $$ = &WindowSpecification{OrderClause: $1, FrameClause: $2}
+  }
+| partition_clause order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpecification{PartitionClause: $1, OrderClause: $2, FrameClause: $3}
+  }
+
+partition_clause:
+  PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_units frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_units BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_units:
+  ROWS
+  {
+    $$ = RowsUnit
+  }
+| RANGE
+  {
+    $$ = RangeUnit
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowType}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingType}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingType}
+  }
+| frame_expression PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: $1}
+  }
+| frame_expression FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: $1}
+  }
+
+/*
+  MySQL only accepts unsigned integers, parameters and intervals as
+  frame offsets, which also keeps the frame grammar free of conflicts
+*/
+frame_expression:
+  INTEGRAL
+  {
+    $$ = NewIntLiteral($1)
+  }
+| VALUE_ARG
+  {
+    $$ = NewArgument($1[1:])
+    bindVariable(yylex, $1[1:])
+  }
+| INTERVAL simple_expr sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
+window_clause_opt:
+  {
+    $$ = nil
+  }
+| WINDOW window_definition_list
+  {
+    $$ = $2
+  }
+
+window_definition_list:
+  window_definition
+  {
+    $$ = WindowDefinitions{$1}
+  }
+| window_definition_list ',' window_definition
+  {
+    $$ = append($1, $3)
+  }
+
+window_definition:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &WindowDefinition{Name: $1, WindowSpec: $4}
+  }
+
 /*
   Function calls using reserved keywords, with dedicated grammar rules
   as a result
@@ -5519,7 +5785,6 @@ reserved_keyword:
 | CONVERT
 | CREATE
 | CROSS
-| CUME_DIST
 | CURRENT_DATE
 | CURRENT_TIME
 | CURRENT_TIMESTAMP
@@ -5530,7 +5795,6 @@ reserved_keyword:
 | DATABASES
 | DEFAULT
 | DELETE
-| DENSE_RANK
 | DESC
 | DESCRIBE
 | DISTINCT
@@ -5543,7 +5807,6 @@ reserved_keyword:
 | EXPLAIN
 | EXTRACT
 | FALSE
-| FIRST_VALUE
 | FOR
 | FORCE
 | FOREIGN
@@ -5566,10 +5829,7 @@ reserved_keyword:
 | JOIN
 | JSON_TABLE
 | KEY
-| LAG
-| LAST_VALUE
 | LATERAL
-| LEAD
 | LEFT
 | LIKE
 | LIMIT
@@ -5585,8 +5845,6 @@ reserved_keyword:
 | NEXT // next should be doable as non-reserved, but is not due to the special `select next num_val` query that vitess supports
 | NO_WRITE_TO_BINLOG
 | NOT
-| NTH_VALUE
-| NTILE
 | NULL
 | OF
 | OFF
@@ -5598,17 +5856,14 @@ reserved_keyword:
 | OUTFILE
 | OVER
 | PARTITION
-| PERCENT_RANK
 | PRIMARY
 | RANGE
-| RANK
 | READ
 | RECURSIVE
 | REGEXP
 | RENAME
 | REPLACE
 | RIGHT
-| ROW_NUMBER
 | SCHEMA
 | SCHEMAS
 | SELECT
@@ -5691,6 +5946,8 @@ non_reserved_keyword:
 | CONNECTION
 | COPY
 | CSV
+| CUME_DIST
+| CURRENT
 | DATA
 | DATE
 | DATETIME
@@ -5698,6 +5955,7 @@ non_reserved_keyword:
 | DELAY_KEY_WRITE
 | DEFINER
 | DEFINITION
+| DENSE_RANK
 | DESCRIPTION
 | DIRECTORY
 | DISABLE
@@ -5725,6 +5983,7 @@ non_reserved_keyword:
 | EXPANSION
 | EXPORT
 | EXTENDED
+| FIRST_VALUE
 | FLOAT_TYPE
 | FIELDS
 | FIRST
@@ -5760,9 +6019,12 @@ non_reserved_keyword:
 | KEY_BLOCK_SIZE
 | KEYS
 | KEYSPACES
+| LAG
 | LANGUAGE
 | LAST
 | LAST_INSERT_ID
+| LAST_VALUE
+| LEAD
 | LESS
 | LEVEL
 | LINES
@@ -5800,6 +6062,8 @@ non_reserved_keyword:
 | NOWAIT
 | NO
 | NONE
+| NTH_VALUE
+| NTILE
 | NULLS
 | NUMERIC
 | OFFSET
@@ -5821,6 +6085,7 @@ non_reserved_keyword:
 | PARTITIONS
 | PASSWORD
 | PATH
+| PERCENT_RANK
 | PERSIST
 | PERSIST_ONLY
 | PRECEDING
@@ -5834,6 +6099,7 @@ non_reserved_keyword:
 | PROCESSLIST
 | QUERY
 | RANDOM
+| RANK
 | REAL
 | REBUILD
 | REDUNDANT
@@ -5854,7 +6120,10 @@ non_reserved_keyword:
 | REUSE
 | ROLE
 | ROLLBACK
+| ROW
+| ROWS
 | ROW_FORMAT
+| ROW_NUMBER
 | S3
 | SECONDARY
 | SECONDARY_ENGINE
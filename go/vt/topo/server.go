@@ -77,6 +77,7 @@ const (
 	SrvKeyspaceFile      = "SrvKeyspace"
 	RoutingRulesFile     = "RoutingRules"
 	ExternalClustersFile = "ExternalClusters"
+	WorkflowCutoverFile  = "WorkflowCutover"
 )
 
 // Path for all object types.
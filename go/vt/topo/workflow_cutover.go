@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// This file provides the utility methods to save / retrieve the scheduled
+// cutovers of the vreplication workflows. They are stored in the global
+// cell, under the target keyspace of their workflow.
+
+func pathForWorkflowCutoverDir(keyspace, workflow string) string {
+	return path.Join(KeyspacesPath, keyspace, workflowsPath, workflow)
+}
+
+func pathForWorkflowCutover(keyspace, workflow string) string {
+	return path.Join(pathForWorkflowCutoverDir(keyspace, workflow), WorkflowCutoverFile)
+}
+
+// WorkflowCutoverInfo is a meta struct that contains the keyspace, workflow
+// and version of a WorkflowCutover.
+type WorkflowCutoverInfo struct {
+	keyspace string
+	workflow string
+	version  Version
+	*vtctldatapb.WorkflowCutover
+}
+
+// Keyspace returns the target keyspace of the workflow.
+func (wci *WorkflowCutoverInfo) Keyspace() string {
+	return wci.keyspace
+}
+
+// Workflow returns the name of the workflow.
+func (wci *WorkflowCutoverInfo) Workflow() string {
+	return wci.workflow
+}
+
+// GetWorkflowCutoverNames returns the names of the workflows of a keyspace
+// that have a cutover.
+func (ts *Server) GetWorkflowCutoverNames(ctx context.Context, keyspace string) ([]string, error) {
+	entries, err := ts.globalCell.ListDir(ctx, path.Join(KeyspacesPath, keyspace, workflowsPath), false /*full*/)
+	switch {
+	case IsErrType(err, NoNode):
+		return nil, nil
+	case err == nil:
+		return DirEntriesToStringArray(entries), nil
+	default:
+		return nil, err
+	}
+}
+
+// GetWorkflowCutover reads the cutover of a workflow from the global cell.
+func (ts *Server) GetWorkflowCutover(ctx context.Context, keyspace, workflow string) (*WorkflowCutoverInfo, error) {
+	contents, version, err := ts.globalCell.Get(ctx, pathForWorkflowCutover(keyspace, workflow))
+	if err != nil {
+		return nil, err
+	}
+
+	cutover := &vtctldatapb.WorkflowCutover{}
+	if err := proto.Unmarshal(contents, cutover); err != nil {
+		return nil, vterrors.Wrapf(err, "bad workflow cutover data: %q", contents)
+	}
+
+	return &WorkflowCutoverInfo{
+		keyspace:        keyspace,
+		workflow:        workflow,
+		version:         version,
+		WorkflowCutover: cutover,
+	}, nil
+}
+
+// SaveWorkflowCutover saves the cutover of a workflow, replacing the
+// existing one if any.
+func (ts *Server) SaveWorkflowCutover(ctx context.Context, keyspace, workflow string, cutover *vtctldatapb.WorkflowCutover) error {
+	contents, err := proto.Marshal(cutover)
+	if err != nil {
+		return err
+	}
+
+	_, err = ts.globalCell.Update(ctx, pathForWorkflowCutover(keyspace, workflow), contents, nil)
+	return err
+}
+
+// UpdateWorkflowCutover saves the WorkflowCutoverInfo object. If the version
+// is not good any more, ErrBadVersion is returned.
+func (ts *Server) UpdateWorkflowCutover(ctx context.Context, wci *WorkflowCutoverInfo) error {
+	contents, err := proto.Marshal(wci.WorkflowCutover)
+	if err != nil {
+		return err
+	}
+
+	version, err := ts.globalCell.Update(ctx, pathForWorkflowCutover(wci.keyspace, wci.workflow), contents, wci.version)
+	if err != nil {
+		return err
+	}
+
+	// Remember the new version.
+	wci.version = version
+	return nil
+}
+
+// DeleteWorkflowCutover deletes the cutover of a workflow. It is not an
+// error if the workflow has no cutover.
+func (ts *Server) DeleteWorkflowCutover(ctx context.Context, keyspace, workflow string) error {
+	err := ts.globalCell.Delete(ctx, pathForWorkflowCutover(keyspace, workflow), nil)
+	if err != nil && !IsErrType(err, NoNode) {
+		return err
+	}
+	return nil
+}
+
+// LockWorkflowCutover locks the cutover of a workflow, so a single vtctld
+// carries it out at a time. The workflow must have a cutover.
+func (ts *Server) LockWorkflowCutover(ctx context.Context, keyspace, workflow, contents string) (LockDescriptor, error) {
+	return ts.globalCell.Lock(ctx, pathForWorkflowCutoverDir(keyspace, workflow), contents)
+}
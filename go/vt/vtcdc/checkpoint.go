@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+var (
+	// CheckpointStoreImplementation is the name of the store of the checkpoints.
+	CheckpointStoreImplementation = flag.String("cdc_checkpoint_store", "file", "where the VGTID checkpoints are stored: "+strings.Join(checkpointStoreNames(), ", "))
+	checkpointFile                = flag.String("cdc_checkpoint_file", "vtcdc.checkpoint", "file of the file checkpoint store")
+)
+
+// CheckpointStore saves the position of a stream, so that it can resume
+// where it stopped.
+type CheckpointStore interface {
+	// Load returns the saved position, or nil if there is none.
+	Load(ctx context.Context) (*binlogdatapb.VGtid, error)
+
+	// Save saves the position.
+	Save(ctx context.Context, vgtid *binlogdatapb.VGtid) error
+
+	// Close releases the resources of the store.
+	Close() error
+}
+
+// CheckpointStoreFactory creates a checkpoint store. The stores that
+// need a database can use the vtgate connection of the stream.
+type CheckpointStoreFactory func(ctx context.Context, conn *vtgateconn.VTGateConn, name string) (CheckpointStore, error)
+
+var checkpointStoreFactories = map[string]CheckpointStoreFactory{
+	"file": func(ctx context.Context, conn *vtgateconn.VTGateConn, name string) (CheckpointStore, error) {
+		return &fileCheckpointStore{path: *checkpointFile}, nil
+	},
+	"table": newTableCheckpointStore,
+}
+
+// RegisterCheckpointStore registers a checkpoint store implementation.
+func RegisterCheckpointStore(name string, factory CheckpointStoreFactory) {
+	if _, ok := checkpointStoreFactories[name]; ok {
+		panic(fmt.Sprintf("checkpoint store %s is already registered", name))
+	}
+	checkpointStoreFactories[name] = factory
+}
+
+// NewCheckpointStore creates the store selected with -cdc_checkpoint_store
+// for the stream called name.
+func NewCheckpointStore(ctx context.Context, conn *vtgateconn.VTGateConn, name string) (CheckpointStore, error) {
+	factory, ok := checkpointStoreFactories[*CheckpointStoreImplementation]
+	if !ok {
+		return nil, fmt.Errorf("unknown checkpoint store %s, must be one of %v", *CheckpointStoreImplementation, checkpointStoreNames())
+	}
+	return factory(ctx, conn, name)
+}
+
+func checkpointStoreNames() []string {
+	var names []string
+	for name := range checkpointStoreFactories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// fileCheckpointStore saves the position as JSON in a local file. The
+// file is replaced atomically, so that a crash never leaves it partially
+// written.
+type fileCheckpointStore struct {
+	path string
+}
+
+// Load is part of the CheckpointStore interface.
+func (fcs *fileCheckpointStore) Load(ctx context.Context) (*binlogdatapb.VGtid, error) {
+	data, err := ioutil.ReadFile(fcs.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	vgtid := &binlogdatapb.VGtid{}
+	if err := json2.Unmarshal(data, vgtid); err != nil {
+		return nil, fmt.Errorf("cannot parse checkpoint file %s: %v", fcs.path, err)
+	}
+	return vgtid, nil
+}
+
+// Save is part of the CheckpointStore interface.
+func (fcs *fileCheckpointStore) Save(ctx context.Context, vgtid *binlogdatapb.VGtid) error {
+	data, err := json2.MarshalPB(vgtid)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(path.Dir(fcs.path), path.Base(fcs.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), fcs.path)
+}
+
+// Close is part of the CheckpointStore interface.
+func (fcs *fileCheckpointStore) Close() error {
+	return nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	checkpointTable  = flag.String("cdc_checkpoint_table", "vtcdc_checkpoint", "table of the table checkpoint store")
+	checkpointTarget = flag.String("cdc_checkpoint_target", "", "vtgate target of the table checkpoint store, usually an unsharded keyspace")
+)
+
+// executor runs queries through vtgate. It's implemented by
+// vtgateconn.VTGateSession.
+type executor interface {
+	Execute(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error)
+}
+
+// tableCheckpointStore saves the positions in a table through vtgate,
+// one row per stream. The table is created if it doesn't exist.
+type tableCheckpointStore struct {
+	exec  executor
+	table string
+	name  string
+}
+
+func newTableCheckpointStore(ctx context.Context, conn *vtgateconn.VTGateConn, name string) (CheckpointStore, error) {
+	if *checkpointTarget == "" {
+		return nil, fmt.Errorf("-cdc_checkpoint_target is required by the table checkpoint store")
+	}
+	tcs := &tableCheckpointStore{
+		exec:  conn.Session(*checkpointTarget+"@primary", nil),
+		table: *checkpointTable,
+		name:  name,
+	}
+	if err := tcs.init(ctx); err != nil {
+		return nil, err
+	}
+	return tcs, nil
+}
+
+func (tcs *tableCheckpointStore) init(ctx context.Context) error {
+	query := fmt.Sprintf(`create table if not exists %s (
+  name varbinary(255) not null,
+  vgtid mediumblob not null,
+  updated_at timestamp not null default current_timestamp on update current_timestamp,
+  primary key (name)
+)`, sqlparser.String(sqlparser.NewTableIdent(tcs.table)))
+	_, err := tcs.exec.Execute(ctx, query, nil)
+	return err
+}
+
+// Load is part of the CheckpointStore interface.
+func (tcs *tableCheckpointStore) Load(ctx context.Context) (*binlogdatapb.VGtid, error) {
+	query := fmt.Sprintf("select vgtid from %s where name = :name", sqlparser.String(sqlparser.NewTableIdent(tcs.table)))
+	qr, err := tcs.exec.Execute(ctx, query, map[string]*querypb.BindVariable{
+		"name": sqltypes.StringBindVariable(tcs.name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+	vgtid := &binlogdatapb.VGtid{}
+	if err := json2.Unmarshal(qr.Rows[0][0].Raw(), vgtid); err != nil {
+		return nil, fmt.Errorf("cannot parse checkpoint of %s: %v", tcs.name, err)
+	}
+	return vgtid, nil
+}
+
+// Save is part of the CheckpointStore interface.
+func (tcs *tableCheckpointStore) Save(ctx context.Context, vgtid *binlogdatapb.VGtid) error {
+	data, err := json2.MarshalPB(vgtid)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf("insert into %s(name, vgtid) values (:name, :vgtid) on duplicate key update vgtid = values(vgtid)", sqlparser.String(sqlparser.NewTableIdent(tcs.table)))
+	_, err = tcs.exec.Execute(ctx, query, map[string]*querypb.BindVariable{
+		"name":  sqltypes.StringBindVariable(tcs.name),
+		"vgtid": sqltypes.BytesBindVariable(data),
+	})
+	return err
+}
+
+// Close is part of the CheckpointStore interface.
+func (tcs *tableCheckpointStore) Close() error {
+	return nil
+}
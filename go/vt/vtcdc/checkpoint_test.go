@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestFileCheckpointStore(t *testing.T) {
+	ctx := context.Background()
+	store := &fileCheckpointStore{path: path.Join(t.TempDir(), "checkpoint")}
+	vgtid, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, vgtid)
+
+	want := newVGtid("-80", "pos1", "80-", "pos2")
+	require.NoError(t, store.Save(ctx, want))
+	vgtid, err = store.Load(ctx)
+	require.NoError(t, err)
+	utils.MustMatch(t, want, vgtid)
+}
+
+// fakeExecutor stores the checkpoints of a tableCheckpointStore.
+type fakeExecutor struct {
+	queries []string
+	rows    map[string][]byte
+}
+
+func (fe *fakeExecutor) Execute(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	fe.queries = append(fe.queries, query)
+	switch {
+	case bindVars["vgtid"] != nil:
+		fe.rows[string(bindVars["name"].Value)] = bindVars["vgtid"].Value
+	case bindVars["name"] != nil:
+		vgtid, ok := fe.rows[string(bindVars["name"].Value)]
+		if !ok {
+			return &sqltypes.Result{}, nil
+		}
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("vgtid", "varbinary"), string(vgtid)), nil
+	}
+	return &sqltypes.Result{}, nil
+}
+
+func TestTableCheckpointStore(t *testing.T) {
+	ctx := context.Background()
+	exec := &fakeExecutor{rows: make(map[string][]byte)}
+	store := &tableCheckpointStore{exec: exec, table: "vtcdc_checkpoint", name: "s1"}
+	require.NoError(t, store.init(ctx))
+	vgtid, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, vgtid)
+
+	want := newVGtid("-80", "pos1", "80-", "pos2")
+	require.NoError(t, store.Save(ctx, want))
+	vgtid, err = store.Load(ctx)
+	require.NoError(t, err)
+	utils.MustMatch(t, want, vgtid)
+
+	// The checkpoints of the other streams are separate.
+	other := &tableCheckpointStore{exec: exec, table: "vtcdc_checkpoint", name: "s2"}
+	vgtid, err = other.Load(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, vgtid)
+
+	assert.Contains(t, exec.queries[0], "create table if not exists vtcdc_checkpoint")
+	assert.Equal(t, []string{
+		"select vgtid from vtcdc_checkpoint where name = :name",
+		"insert into vtcdc_checkpoint(name, vgtid) values (:name, :vgtid) on duplicate key update vgtid = values(vgtid)",
+	}, exec.queries[1:3])
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"encoding/json"
+	"flag"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	debeziumServerName    = flag.String("cdc_debezium_server_name", "vitess", "logical server name used in the names of the debezium schemas")
+	debeziumIncludeSchema = flag.Bool("cdc_debezium_include_schema", true, "include the schema in the debezium envelopes, like the JsonConverter of Kafka Connect with schemas enabled")
+)
+
+// debeziumEnvelope is the value of a Debezium change event. The schema
+// uses the Kafka Connect types, and its names are valid Avro names so
+// that the envelopes can be converted to Avro records.
+type debeziumEnvelope struct {
+	Schema  *connectSchema   `json:"schema,omitempty"`
+	Payload *debeziumPayload `json:"payload"`
+}
+
+type debeziumPayload struct {
+	Before *debeziumRow    `json:"before"`
+	After  *debeziumRow    `json:"after"`
+	Source *debeziumSource `json:"source"`
+	Op     string          `json:"op"`
+	TsMs   int64           `json:"ts_ms"`
+}
+
+// debeziumRow is a row of a Debezium change event. Its JSON values are
+// strings, as the io.debezium.data.Json logical type expects.
+type debeziumRow Row
+
+// MarshalJSON implements json.Marshaler.
+func (r *debeziumRow) MarshalJSON() ([]byte, error) {
+	return (*Row)(r).marshalJSON(debeziumValue)
+}
+
+func debeziumValue(field *querypb.Field, v sqltypes.Value) ([]byte, error) {
+	if v.Type() != sqltypes.TypeJSON || v.IsNull() {
+		return jsonValue(field, v)
+	}
+	if !json.Valid(v.Raw()) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid JSON value for column %s", field.Name)
+	}
+	return json.Marshal(v.ToString())
+}
+
+type debeziumSource struct {
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	TsMs      int64  `json:"ts_ms"`
+	Snapshot  string `json:"snapshot"`
+	Db        string `json:"db"`
+	Keyspace  string `json:"keyspace"`
+	Table     string `json:"table"`
+	Shard     string `json:"shard"`
+	Gtid      string `json:"gtid"`
+}
+
+type connectSchema struct {
+	Type     string           `json:"type"`
+	Optional bool             `json:"optional"`
+	Name     string           `json:"name,omitempty"`
+	Field    string           `json:"field,omitempty"`
+	Fields   []*connectSchema `json:"fields,omitempty"`
+}
+
+func encodeDebezium(event *ChangeEvent) ([]byte, error) {
+	envelope := &debeziumEnvelope{
+		Payload: &debeziumPayload{
+			Before: (*debeziumRow)(event.Before),
+			After:  (*debeziumRow)(event.After),
+			Source: &debeziumSource{
+				Connector: "vitess",
+				Name:      *debeziumServerName,
+				TsMs:      event.Timestamp * 1000,
+				Snapshot:  "false",
+				Db:        event.Keyspace,
+				Keyspace:  event.Keyspace,
+				Table:     event.Table,
+				Shard:     event.Shard,
+				Gtid:      event.Gtid,
+			},
+			Op:   event.Op,
+			TsMs: event.Timestamp * 1000,
+		},
+	}
+	if *debeziumIncludeSchema {
+		envelope.Schema = envelopeSchema(event)
+	}
+	return json.Marshal(envelope)
+}
+
+func envelopeSchema(event *ChangeEvent) *connectSchema {
+	prefix := avroName(*debeziumServerName) + "." + avroName(event.Keyspace) + "." + avroName(event.Table)
+	var fields []*querypb.Field
+	if event.After != nil {
+		fields = event.After.Fields
+	} else {
+		fields = event.Before.Fields
+	}
+	value := func(name string) *connectSchema {
+		schema := &connectSchema{Type: "struct", Optional: true, Name: prefix + ".Value", Field: name}
+		for _, field := range fields {
+			schema.Fields = append(schema.Fields, fieldSchema(field))
+		}
+		return schema
+	}
+	source := &connectSchema{Type: "struct", Name: "io.vitess.connector.Source", Field: "source"}
+	for _, name := range []string{"connector", "name"} {
+		source.Fields = append(source.Fields, &connectSchema{Type: "string", Field: name})
+	}
+	source.Fields = append(source.Fields, &connectSchema{Type: "int64", Field: "ts_ms"})
+	for _, name := range []string{"snapshot", "db", "keyspace", "table", "shard", "gtid"} {
+		source.Fields = append(source.Fields, &connectSchema{Type: "string", Optional: true, Field: name})
+	}
+	return &connectSchema{
+		Type: "struct",
+		Name: prefix + ".Envelope",
+		Fields: []*connectSchema{
+			value("before"),
+			value("after"),
+			source,
+			{Type: "string", Field: "op"},
+			{Type: "int64", Optional: true, Field: "ts_ms"},
+		},
+	}
+}
+
+// fieldSchema returns the Kafka Connect schema of a column. The mapping
+// follows the default modes of the Debezium MySQL connector, except for
+// decimals which are always strings.
+func fieldSchema(field *querypb.Field) *connectSchema {
+	schema := &connectSchema{
+		Type:     "string",
+		Optional: field.Flags&uint32(querypb.MySqlFlag_NOT_NULL_FLAG) == 0,
+		Field:    field.Name,
+	}
+	switch field.Type {
+	case sqltypes.Int8, sqltypes.Uint8, sqltypes.Int16:
+		schema.Type = "int16"
+	case sqltypes.Uint16, sqltypes.Int24, sqltypes.Uint24, sqltypes.Int32:
+		schema.Type = "int32"
+	case sqltypes.Uint32, sqltypes.Int64, sqltypes.Uint64, sqltypes.Year:
+		schema.Type = "int64"
+	case sqltypes.Float32:
+		schema.Type = "float"
+	case sqltypes.Float64:
+		schema.Type = "double"
+	case sqltypes.TypeJSON:
+		schema.Name = "io.debezium.data.Json"
+	default:
+		if isBinary(field) {
+			schema.Type = "bytes"
+		}
+	}
+	return schema
+}
+
+// avroName replaces the characters that are not allowed in Avro names.
+func avroName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
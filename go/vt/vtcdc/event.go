@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The operations of a ChangeEvent. They are the ones used by Debezium.
+const (
+	OpInsert = "c"
+	OpUpdate = "u"
+	OpDelete = "d"
+)
+
+// ChangeEvent is a change of one row, as written to the sinks.
+type ChangeEvent struct {
+	Op       string `json:"op"`
+	Keyspace string `json:"keyspace"`
+	Shard    string `json:"shard"`
+	Table    string `json:"table"`
+	// Timestamp is the time of the commit in seconds since the epoch.
+	Timestamp int64 `json:"timestamp"`
+	// Gtid is the position of the shard after the transaction.
+	Gtid   string `json:"gtid"`
+	Before *Row   `json:"before,omitempty"`
+	After  *Row   `json:"after,omitempty"`
+}
+
+// Row is the image of a row. It is marshaled as a JSON object
+// that keeps the order of the columns.
+type Row struct {
+	Fields []*querypb.Field
+	Values []sqltypes.Value
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *Row) MarshalJSON() ([]byte, error) {
+	return r.marshalJSON(jsonValue)
+}
+
+// marshalJSON marshals the row, with valueFunc encoding its values.
+func (r *Row) marshalJSON(valueFunc func(*querypb.Field, sqltypes.Value) ([]byte, error)) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+	for i, field := range r.Fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		value, err := valueFunc(field, r.Values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// jsonValue returns the JSON encoding of a column value. Numbers are
+// kept as JSON numbers except for decimals, which would lose precision,
+// binary values are base64 encoded and JSON columns are inlined.
+func jsonValue(field *querypb.Field, v sqltypes.Value) ([]byte, error) {
+	switch {
+	case v.IsNull():
+		return []byte("null"), nil
+	case v.IsIntegral() || v.IsFloat():
+		return v.Raw(), nil
+	case v.Type() == sqltypes.TypeJSON:
+		if !json.Valid(v.Raw()) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid JSON value for column %s", field.Name)
+		}
+		return v.Raw(), nil
+	case isBinary(field):
+		return json.Marshal(v.Raw())
+	}
+	return json.Marshal(v.ToString())
+}
+
+// isBinary returns true if the values of the field are byte strings.
+func isBinary(field *querypb.Field) bool {
+	switch field.Type {
+	case sqltypes.Binary, sqltypes.VarBinary, sqltypes.Blob, sqltypes.Bit, sqltypes.Geometry:
+		return true
+	}
+	return false
+}
+
+// changeEvents converts a row event into change events.
+func changeEvents(rowEvent *binlogdatapb.RowEvent, fields []*querypb.Field, timestamp int64) ([]*ChangeEvent, error) {
+	// The table names of vtgate streams are qualified by their keyspace.
+	table := rowEvent.TableName
+	if idx := strings.IndexByte(table, '.'); idx >= 0 {
+		table = table[idx+1:]
+	}
+	events := make([]*ChangeEvent, 0, len(rowEvent.RowChanges))
+	for _, change := range rowEvent.RowChanges {
+		event := &ChangeEvent{
+			Keyspace:  rowEvent.Keyspace,
+			Shard:     rowEvent.Shard,
+			Table:     table,
+			Timestamp: timestamp,
+		}
+		var err error
+		if change.Before != nil {
+			if event.Before, err = newRow(fields, change.Before); err != nil {
+				return nil, err
+			}
+		}
+		if change.After != nil {
+			if event.After, err = newRow(fields, change.After); err != nil {
+				return nil, err
+			}
+		}
+		switch {
+		case event.Before == nil && event.After == nil:
+			continue
+		case event.Before == nil:
+			event.Op = OpInsert
+		case event.After == nil:
+			event.Op = OpDelete
+		default:
+			event.Op = OpUpdate
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func newRow(fields []*querypb.Field, row *querypb.Row) (*Row, error) {
+	if len(row.Lengths) != len(fields) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "row has %d values, but there are %d fields", len(row.Lengths), len(fields))
+	}
+	return &Row{Fields: fields, Values: sqltypes.MakeRowTrusted(fields, row)}, nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+var (
+	// SinkImplementation is the name of the sink the events are written to.
+	SinkImplementation = flag.String("cdc_sink", "ndjson", "where the change events are written: "+strings.Join(sinkNames(), ", "))
+	sinkFile           = flag.String("cdc_sink_file", "", "file the ndjson and debezium sinks append to (default stdout)")
+)
+
+// Sink receives the change events of the stream.
+type Sink interface {
+	// Write writes the events of one transaction. The events may be
+	// buffered until the next Flush.
+	Write(ctx context.Context, events []*ChangeEvent) error
+
+	// Flush makes the written events durable. The stream only saves
+	// its checkpoint after a successful Flush.
+	Flush(ctx context.Context) error
+
+	// Close releases the resources of the sink.
+	Close() error
+}
+
+// SinkFactory creates a sink.
+type SinkFactory func() (Sink, error)
+
+var sinkFactories = map[string]SinkFactory{
+	"ndjson":   func() (Sink, error) { return newFileSink(*sinkFile, encodeJSON) },
+	"debezium": func() (Sink, error) { return newFileSink(*sinkFile, encodeDebezium) },
+	"webhook":  newWebhookSink,
+}
+
+// RegisterSink registers a sink implementation.
+func RegisterSink(name string, factory SinkFactory) {
+	if _, ok := sinkFactories[name]; ok {
+		panic(fmt.Sprintf("sink %s is already registered", name))
+	}
+	sinkFactories[name] = factory
+}
+
+// NewSink creates the sink selected with -cdc_sink.
+func NewSink() (Sink, error) {
+	factory, ok := sinkFactories[*SinkImplementation]
+	if !ok {
+		return nil, fmt.Errorf("unknown sink %s, must be one of %v", *SinkImplementation, sinkNames())
+	}
+	return factory()
+}
+
+func sinkNames() []string {
+	var names []string
+	for name := range sinkFactories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// encoder returns the encoding of an event as one line of JSON.
+type encoder func(event *ChangeEvent) ([]byte, error)
+
+var encoders = map[string]encoder{
+	"json":     encodeJSON,
+	"debezium": encodeDebezium,
+}
+
+func encodeJSON(event *ChangeEvent) ([]byte, error) {
+	return json.Marshal(event)
+}
+
+// fileSink writes one event per line to a file.
+type fileSink struct {
+	file   *os.File
+	w      *bufio.Writer
+	encode encoder
+}
+
+func newFileSink(name string, encode encoder) (*fileSink, error) {
+	file := os.Stdout
+	if name != "" {
+		var err error
+		if file, err = os.OpenFile(name, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644); err != nil {
+			return nil, err
+		}
+	}
+	return &fileSink{
+		file:   file,
+		w:      bufio.NewWriter(file),
+		encode: encode,
+	}, nil
+}
+
+// Write is part of the Sink interface.
+func (fs *fileSink) Write(ctx context.Context, events []*ChangeEvent) error {
+	return writeLines(fs.w, events, fs.encode)
+}
+
+// Flush is part of the Sink interface.
+func (fs *fileSink) Flush(ctx context.Context) error {
+	if err := fs.w.Flush(); err != nil {
+		return err
+	}
+	if fs.file == os.Stdout {
+		return nil
+	}
+	return fs.file.Sync()
+}
+
+// Close is part of the Sink interface.
+func (fs *fileSink) Close() error {
+	if err := fs.w.Flush(); err != nil {
+		return err
+	}
+	if fs.file == os.Stdout {
+		return nil
+	}
+	return fs.file.Close()
+}
+
+func writeLines(w io.Writer, events []*ChangeEvent, encode encoder) error {
+	for _, event := range events {
+		line, err := encode(event)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
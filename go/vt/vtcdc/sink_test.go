@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func testEvents(t *testing.T) []*ChangeEvent {
+	t.Helper()
+	fields := sqltypes.MakeTestFields("id|price|data|doc|name", "int64|decimal|varbinary|json|varchar")
+	fields[0].Flags = uint32(querypb.MySqlFlag_NOT_NULL_FLAG)
+	rows := sqltypes.MakeTestResult(fields, `1|1.50|ab|{"a": 1}|x`, `1|2.00|null|null|y`).Rows
+	events, err := changeEvents(&binlogdatapb.RowEvent{
+		TableName: "ks.t1",
+		Keyspace:  "ks",
+		Shard:     "-80",
+		RowChanges: []*binlogdatapb.RowChange{
+			{After: sqltypes.RowToProto3(rows[0])},
+			{Before: sqltypes.RowToProto3(rows[0]), After: sqltypes.RowToProto3(rows[1])},
+			{Before: sqltypes.RowToProto3(rows[1])},
+		},
+	}, fields, 100)
+	require.NoError(t, err)
+	for _, event := range events {
+		event.Gtid = "pos1"
+	}
+	return events
+}
+
+func TestChangeEvents(t *testing.T) {
+	var lines []string
+	for _, event := range testEvents(t) {
+		line, err := encodeJSON(event)
+		require.NoError(t, err)
+		lines = append(lines, string(line))
+	}
+	assert.Equal(t, []string{
+		`{"op":"c","keyspace":"ks","shard":"-80","table":"t1","timestamp":100,"gtid":"pos1","after":{"id":1,"price":"1.50","data":"YWI=","doc":{"a":1},"name":"x"}}`,
+		`{"op":"u","keyspace":"ks","shard":"-80","table":"t1","timestamp":100,"gtid":"pos1","before":{"id":1,"price":"1.50","data":"YWI=","doc":{"a":1},"name":"x"},"after":{"id":1,"price":"2.00","data":null,"doc":null,"name":"y"}}`,
+		`{"op":"d","keyspace":"ks","shard":"-80","table":"t1","timestamp":100,"gtid":"pos1","before":{"id":1,"price":"2.00","data":null,"doc":null,"name":"y"}}`,
+	}, lines)
+}
+
+func TestDebeziumEnvelope(t *testing.T) {
+	events := testEvents(t)
+	data, err := encodeDebezium(events[1])
+	require.NoError(t, err)
+	var envelope map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &envelope))
+
+	payload := envelope["payload"].(map[string]interface{})
+	assert.Equal(t, "u", payload["op"])
+	assert.Equal(t, float64(100000), payload["ts_ms"])
+	assert.Equal(t, map[string]interface{}{"id": float64(1), "price": "2.00", "data": nil, "doc": nil, "name": "y"}, payload["after"])
+	// The JSON values are strings, as the io.debezium.data.Json type expects.
+	assert.Equal(t, map[string]interface{}{"id": float64(1), "price": "1.50", "data": "YWI=", "doc": `{"a": 1}`, "name": "x"}, payload["before"])
+	source := payload["source"].(map[string]interface{})
+	assert.Equal(t, "vitess", source["connector"])
+	assert.Equal(t, "ks", source["keyspace"])
+	assert.Equal(t, "-80", source["shard"])
+	assert.Equal(t, "pos1", source["gtid"])
+
+	schema := envelope["schema"].(map[string]interface{})
+	assert.Equal(t, "vitess.ks.t1.Envelope", schema["name"])
+	before := schema["fields"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "vitess.ks.t1.Value", before["name"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"type": "int64", "optional": false, "field": "id"},
+		map[string]interface{}{"type": "string", "optional": true, "field": "price"},
+		map[string]interface{}{"type": "bytes", "optional": true, "field": "data"},
+		map[string]interface{}{"type": "string", "optional": true, "field": "doc", "name": "io.debezium.data.Json"},
+		map[string]interface{}{"type": "string", "optional": true, "field": "name"},
+	}, before["fields"])
+
+	defer func(saved bool) { *debeziumIncludeSchema = saved }(*debeziumIncludeSchema)
+	*debeziumIncludeSchema = false
+	data, err = encodeDebezium(events[1])
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), `"schema"`)
+}
+
+func TestAvroName(t *testing.T) {
+	assert.Equal(t, "my_keyspace", avroName("my-keyspace"))
+	assert.Equal(t, "_1t", avroName("1t"))
+}
+
+func TestFileSink(t *testing.T) {
+	name := path.Join(t.TempDir(), "events.json")
+	sink, err := newFileSink(name, encodeJSON)
+	require.NoError(t, err)
+	events := testEvents(t)
+	require.NoError(t, sink.Write(context.Background(), events[:1]))
+	require.NoError(t, sink.Write(context.Background(), events[1:2]))
+	require.NoError(t, sink.Flush(context.Background()))
+	require.NoError(t, sink.Close())
+
+	data, err := ioutil.ReadFile(name)
+	require.NoError(t, err)
+	first, _ := encodeJSON(events[0])
+	second, _ := encodeJSON(events[1])
+	assert.Equal(t, string(first)+"\n"+string(second)+"\n", string(data))
+}
+
+func TestWebhookSink(t *testing.T) {
+	var bodies []string
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	defer func(saved string) { *webhookURL = saved }(*webhookURL)
+	defer func(saved int) { *webhookBatchSize = saved }(*webhookBatchSize)
+	*webhookURL = server.URL
+	*webhookBatchSize = 2
+	sink, err := newWebhookSink()
+	require.NoError(t, err)
+	defer sink.Close()
+
+	ctx := context.Background()
+	events := testEvents(t)
+	lines := make([]string, len(events))
+	for i, event := range events {
+		line, err := encodeJSON(event)
+		require.NoError(t, err)
+		lines[i] = string(line) + "\n"
+	}
+
+	// The events are posted in batches, and on Flush.
+	require.NoError(t, sink.Write(ctx, events[:1]))
+	assert.Empty(t, bodies)
+	require.NoError(t, sink.Write(ctx, events[1:2]))
+	assert.Equal(t, []string{lines[0] + lines[1]}, bodies)
+	require.NoError(t, sink.Write(ctx, events[2:]))
+	require.NoError(t, sink.Flush(ctx))
+	assert.Equal(t, []string{lines[0] + lines[1], lines[2]}, bodies)
+	require.NoError(t, sink.Flush(ctx))
+	assert.Len(t, bodies, 2)
+
+	status = http.StatusInternalServerError
+	require.NoError(t, sink.Write(ctx, events[:1]))
+	assert.Error(t, sink.Flush(ctx))
+}
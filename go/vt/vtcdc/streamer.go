@@ -0,0 +1,285 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vtcdc streams the row changes of a Vitess cluster from the
+// VStream API of vtgate to a pluggable Sink, and saves the position of
+// the stream in a pluggable CheckpointStore.
+//
+// The events are delivered at least once: after a restart or a
+// reconnection, the stream resumes from the last checkpoint, which is
+// only saved once the sink has flushed the events before it.
+package vtcdc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+var (
+	eventsWritten        = stats.NewCounter("CDCEventsWritten", "Number of change events written to the sink")
+	checkpoints          = stats.NewCounter("CDCCheckpoints", "Number of checkpoints saved")
+	reconnects           = stats.NewCounter("CDCReconnects", "Number of times the stream was restarted after an error")
+	reshards             = stats.NewCounter("CDCReshards", "Number of reshards the stream followed")
+	shutdownFlushTimeout = 30 * time.Second
+)
+
+// errReshard is returned by stream when the stream has to continue from
+// the target shards of a reshard.
+var errReshard = errors.New("the stream was resharded")
+
+// VStreamer starts a vtgate VStream. It's implemented by
+// vtgateconn.VTGateConn.
+type VStreamer interface {
+	VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (vtgateconn.VStreamReader, error)
+}
+
+// Params are the parameters of a Streamer.
+type Params struct {
+	// TabletType is the type of the tablets to stream from.
+	TabletType topodatapb.TabletType
+	// Filter selects the tables to stream.
+	Filter *binlogdatapb.Filter
+	// StartVGtid is the position of the stream when there is no checkpoint.
+	StartVGtid *binlogdatapb.VGtid
+	// MinimizeSkew and HeartbeatInterval are passed to vtgate.
+	MinimizeSkew      bool
+	HeartbeatInterval uint32
+	// RetryDelay is the time to wait before restarting the stream after
+	// an error.
+	RetryDelay time.Duration
+	// CheckpointInterval is the minimum time between two checkpoints.
+	// If zero, the position is saved after every transaction.
+	CheckpointInterval time.Duration
+}
+
+// Streamer streams the changes to a sink.
+type Streamer struct {
+	conn   VStreamer
+	store  CheckpointStore
+	sink   Sink
+	params Params
+
+	// vgtid is the position of the last event received.
+	vgtid *binlogdatapb.VGtid
+	// committed is the position of the last transaction written to the sink.
+	committed *binlogdatapb.VGtid
+	// checkpoint is the last saved position.
+	checkpoint *binlogdatapb.VGtid
+	lastSave   time.Time
+
+	fields   map[string][]*querypb.Field
+	pending  []*ChangeEvent
+	journals map[int64]int
+}
+
+// NewStreamer creates a Streamer.
+func NewStreamer(conn VStreamer, store CheckpointStore, sink Sink, params Params) *Streamer {
+	return &Streamer{
+		conn:   conn,
+		store:  store,
+		sink:   sink,
+		params: params,
+	}
+}
+
+// Run streams the changes until the context is canceled, restarting the
+// stream after errors. It flushes the sink and saves the position before
+// returning.
+func (s *Streamer) Run(ctx context.Context) error {
+	checkpoint, err := s.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot load the checkpoint: %v", err)
+	}
+	if checkpoint == nil {
+		if s.params.StartVGtid == nil {
+			return fmt.Errorf("there is no checkpoint and no start position")
+		}
+		checkpoint = s.params.StartVGtid
+	}
+	s.checkpoint = checkpoint
+	s.committed = checkpoint
+	log.Infof("Starting the stream at %v", checkpoint)
+
+	for ctx.Err() == nil {
+		err := s.stream(ctx)
+		if ctx.Err() != nil || err == errReshard {
+			continue
+		}
+		reconnects.Add(1)
+		log.Warningf("The stream failed, restarting it from %v in %v: %v", s.committed, s.params.RetryDelay, err)
+		select {
+		case <-ctx.Done():
+		case <-time.After(s.params.RetryDelay):
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownFlushTimeout)
+	defer cancel()
+	return s.saveCheckpoint(ctx)
+}
+
+// stream streams from the committed position until an error happens.
+func (s *Streamer) stream(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s.vgtid = s.committed
+	s.fields = make(map[string][]*querypb.Field)
+	s.pending = nil
+	s.journals = make(map[int64]int)
+	flags := &vtgatepb.VStreamFlags{
+		MinimizeSkew:      s.params.MinimizeSkew,
+		HeartbeatInterval: s.params.HeartbeatInterval,
+		// The journals are handled by applyJournal.
+		StopOnReshard: true,
+	}
+	reader, err := s.conn.VStream(ctx, s.params.TabletType, s.vgtid, s.params.Filter, flags)
+	if err != nil {
+		return err
+	}
+	for {
+		events, err := reader.Recv()
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := s.applyEvent(ctx, event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Streamer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent) error {
+	switch event.Type {
+	case binlogdatapb.VEventType_FIELD:
+		s.fields[event.FieldEvent.TableName] = event.FieldEvent.Fields
+	case binlogdatapb.VEventType_ROW:
+		fields, ok := s.fields[event.RowEvent.TableName]
+		if !ok {
+			return fmt.Errorf("no fields for table %s", event.RowEvent.TableName)
+		}
+		events, err := changeEvents(event.RowEvent, fields, event.Timestamp)
+		if err != nil {
+			return err
+		}
+		s.pending = append(s.pending, events...)
+	case binlogdatapb.VEventType_VGTID:
+		s.vgtid = event.Vgtid
+	case binlogdatapb.VEventType_COMMIT, binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_OTHER:
+		return s.commit(ctx)
+	case binlogdatapb.VEventType_JOURNAL:
+		return s.applyJournal(ctx, event.Journal)
+	}
+	return nil
+}
+
+// commit writes the events of the transaction to the sink.
+func (s *Streamer) commit(ctx context.Context) error {
+	if len(s.pending) != 0 {
+		for _, event := range s.pending {
+			event.Gtid = shardGtid(s.vgtid, event.Keyspace, event.Shard)
+		}
+		if err := s.sink.Write(ctx, s.pending); err != nil {
+			return s.sinkFailed(err)
+		}
+		eventsWritten.Add(int64(len(s.pending)))
+		s.pending = nil
+	}
+	s.committed = s.vgtid
+	if time.Since(s.lastSave) < s.params.CheckpointInterval {
+		return nil
+	}
+	return s.saveCheckpoint(ctx)
+}
+
+// applyJournal follows a reshard. Every source shard sends the journal
+// when it stops, and the stream continues from the target shards once
+// all of them did.
+func (s *Streamer) applyJournal(ctx context.Context, journal *binlogdatapb.Journal) error {
+	if journal.MigrationType != binlogdatapb.MigrationType_SHARDS {
+		return nil
+	}
+	s.journals[journal.Id]++
+	if s.journals[journal.Id] < len(journal.Participants) {
+		return nil
+	}
+	participants := make(map[string]bool)
+	for _, participant := range journal.Participants {
+		participants[participant.Keyspace+"/"+participant.Shard] = true
+	}
+	vgtid := &binlogdatapb.VGtid{}
+	for _, sgtid := range s.vgtid.ShardGtids {
+		if !participants[sgtid.Keyspace+"/"+sgtid.Shard] {
+			vgtid.ShardGtids = append(vgtid.ShardGtids, sgtid)
+		}
+	}
+	vgtid.ShardGtids = append(vgtid.ShardGtids, journal.ShardGtids...)
+	log.Infof("Following the reshard of %v to %v", journal.Participants, journal.ShardGtids)
+	reshards.Add(1)
+
+	s.vgtid = vgtid
+	s.committed = vgtid
+	if err := s.saveCheckpoint(ctx); err != nil {
+		return err
+	}
+	return errReshard
+}
+
+// saveCheckpoint flushes the sink and saves the committed position.
+func (s *Streamer) saveCheckpoint(ctx context.Context) error {
+	if proto.Equal(s.committed, s.checkpoint) {
+		return nil
+	}
+	if err := s.sink.Flush(ctx); err != nil {
+		return s.sinkFailed(err)
+	}
+	if err := s.store.Save(ctx, s.committed); err != nil {
+		return err
+	}
+	s.checkpoint = s.committed
+	s.lastSave = time.Now()
+	checkpoints.Add(1)
+	return nil
+}
+
+// sinkFailed rewinds the stream to the checkpoint, because the events
+// after it may have been lost by the sink.
+func (s *Streamer) sinkFailed(err error) error {
+	s.committed = s.checkpoint
+	return err
+}
+
+func shardGtid(vgtid *binlogdatapb.VGtid, keyspace, shard string) string {
+	for _, sgtid := range vgtid.ShardGtids {
+		if sgtid.Keyspace == keyspace && sgtid.Shard == shard {
+			return sgtid.Gtid
+		}
+	}
+	return ""
+}
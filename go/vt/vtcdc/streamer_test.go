@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// fakeVStreamer replays a list of streams. Each stream is a list of
+// batches of events, and ends with the error of the stream.
+type fakeVStreamer struct {
+	t       *testing.T
+	streams []*fakeStream
+	vgtids  []*binlogdatapb.VGtid
+	cancel  context.CancelFunc
+}
+
+type fakeStream struct {
+	batches [][]*binlogdatapb.VEvent
+	err     error
+}
+
+func (fvs *fakeVStreamer) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (vtgateconn.VStreamReader, error) {
+	assert.True(fvs.t, flags.StopOnReshard)
+	fvs.vgtids = append(fvs.vgtids, proto.Clone(vgtid).(*binlogdatapb.VGtid))
+	if len(fvs.streams) == 0 {
+		// All the streams were replayed: stop the streamer.
+		fvs.cancel()
+		return nil, ctx.Err()
+	}
+	stream := fvs.streams[0]
+	fvs.streams = fvs.streams[1:]
+	return stream, nil
+}
+
+func (fs *fakeStream) Recv() ([]*binlogdatapb.VEvent, error) {
+	if len(fs.batches) == 0 {
+		return nil, fs.err
+	}
+	batch := fs.batches[0]
+	fs.batches = fs.batches[1:]
+	return batch, nil
+}
+
+type fakeStore struct {
+	vgtid *binlogdatapb.VGtid
+	saved []string
+}
+
+func (fcs *fakeStore) Load(ctx context.Context) (*binlogdatapb.VGtid, error) {
+	return fcs.vgtid, nil
+}
+
+func (fcs *fakeStore) Save(ctx context.Context, vgtid *binlogdatapb.VGtid) error {
+	fcs.vgtid = vgtid
+	fcs.saved = append(fcs.saved, vgtidString(vgtid))
+	return nil
+}
+
+func (fcs *fakeStore) Close() error {
+	return nil
+}
+
+// fakeSink records the operations it receives. It fails the flushes
+// while failFlushes is positive.
+type fakeSink struct {
+	ops         []string
+	failFlushes int
+}
+
+func (fs *fakeSink) Write(ctx context.Context, events []*ChangeEvent) error {
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		fs.ops = append(fs.ops, string(data))
+	}
+	return nil
+}
+
+func (fs *fakeSink) Flush(ctx context.Context) error {
+	if fs.failFlushes > 0 {
+		fs.failFlushes--
+		fs.ops = append(fs.ops, "flush failed")
+		return fmt.Errorf("flush failed")
+	}
+	fs.ops = append(fs.ops, "flush")
+	return nil
+}
+
+func (fs *fakeSink) Close() error {
+	return nil
+}
+
+func vgtidString(vgtid *binlogdatapb.VGtid) string {
+	var s string
+	for _, sgtid := range vgtid.ShardGtids {
+		s += fmt.Sprintf("%s/%s:%s ", sgtid.Keyspace, sgtid.Shard, sgtid.Gtid)
+	}
+	return s
+}
+
+func newVGtid(shardGtids ...string) *binlogdatapb.VGtid {
+	vgtid := &binlogdatapb.VGtid{}
+	for i := 0; i < len(shardGtids); i += 2 {
+		vgtid.ShardGtids = append(vgtid.ShardGtids, &binlogdatapb.ShardGtid{Keyspace: "ks", Shard: shardGtids[i], Gtid: shardGtids[i+1]})
+	}
+	return vgtid
+}
+
+var testFields = sqltypes.MakeTestFields("id|name", "int64|varchar")
+
+func fieldEvent(shard string) *binlogdatapb.VEvent {
+	return &binlogdatapb.VEvent{
+		Type:       binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{TableName: "ks.t1", Fields: testFields, Keyspace: "ks", Shard: shard},
+	}
+}
+
+// transaction returns the events of a transaction inserting the rows on shard.
+func transaction(vgtid *binlogdatapb.VGtid, shard string, rows ...string) []*binlogdatapb.VEvent {
+	rowEvent := &binlogdatapb.RowEvent{TableName: "ks.t1", Keyspace: "ks", Shard: shard}
+	for _, row := range sqltypes.MakeTestResult(testFields, rows...).Rows {
+		rowEvent.RowChanges = append(rowEvent.RowChanges, &binlogdatapb.RowChange{After: sqltypes.RowToProto3(row)})
+	}
+	return []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_BEGIN},
+		{Type: binlogdatapb.VEventType_ROW, Timestamp: 10, RowEvent: rowEvent},
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: vgtid},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}
+}
+
+func runStreamer(t *testing.T, store *fakeStore, sink *fakeSink, streams ...*fakeStream) *fakeVStreamer {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fvs := &fakeVStreamer{t: t, streams: streams, cancel: cancel}
+	streamer := NewStreamer(fvs, store, sink, Params{StartVGtid: newVGtid("-80", "current", "80-", "current")})
+	require.NoError(t, streamer.Run(ctx))
+	return fvs
+}
+
+func TestStreamer(t *testing.T) {
+	store := &fakeStore{}
+	sink := &fakeSink{}
+	fvs := runStreamer(t, store, sink, &fakeStream{
+		batches: [][]*binlogdatapb.VEvent{
+			{fieldEvent("-80"), fieldEvent("80-")},
+			transaction(newVGtid("-80", "pos1", "80-", "current"), "-80", "1|a", "2|b"),
+			transaction(newVGtid("-80", "pos1", "80-", "pos2"), "80-", "3|c"),
+		},
+		err: io.EOF,
+	})
+
+	// The streamer restarts from the last position after an error.
+	assert.Equal(t, []string{"ks/-80:current ks/80-:current ", "ks/-80:pos1 ks/80-:pos2 "}, vgtidStrings(fvs.vgtids))
+	assert.Equal(t, []string{
+		`{"op":"c","keyspace":"ks","shard":"-80","table":"t1","timestamp":10,"gtid":"pos1","after":{"id":1,"name":"a"}}`,
+		`{"op":"c","keyspace":"ks","shard":"-80","table":"t1","timestamp":10,"gtid":"pos1","after":{"id":2,"name":"b"}}`,
+		"flush",
+		`{"op":"c","keyspace":"ks","shard":"80-","table":"t1","timestamp":10,"gtid":"pos2","after":{"id":3,"name":"c"}}`,
+		"flush",
+	}, sink.ops)
+	assert.Equal(t, []string{"ks/-80:pos1 ks/80-:current ", "ks/-80:pos1 ks/80-:pos2 "}, store.saved)
+}
+
+func TestStreamerResumesFromCheckpoint(t *testing.T) {
+	store := &fakeStore{vgtid: newVGtid("-80", "pos1", "80-", "pos2")}
+	fvs := runStreamer(t, store, &fakeSink{})
+	utils.MustMatch(t, []*binlogdatapb.VGtid{newVGtid("-80", "pos1", "80-", "pos2")}, fvs.vgtids)
+}
+
+func TestStreamerSinkFailure(t *testing.T) {
+	store := &fakeStore{}
+	sink := &fakeSink{failFlushes: 1}
+	fvs := runStreamer(t, store, sink, &fakeStream{
+		batches: [][]*binlogdatapb.VEvent{
+			{fieldEvent("-80")},
+			transaction(newVGtid("-80", "pos1", "80-", "current"), "-80", "1|a"),
+		},
+	}, &fakeStream{
+		batches: [][]*binlogdatapb.VEvent{
+			{fieldEvent("-80")},
+			transaction(newVGtid("-80", "pos1", "80-", "current"), "-80", "1|a"),
+		},
+		err: io.EOF,
+	})
+
+	// The events that the sink may have lost are streamed again.
+	assert.Equal(t, []string{"ks/-80:current ks/80-:current ", "ks/-80:current ks/80-:current ", "ks/-80:pos1 ks/80-:current "}, vgtidStrings(fvs.vgtids))
+	assert.Equal(t, []string{
+		`{"op":"c","keyspace":"ks","shard":"-80","table":"t1","timestamp":10,"gtid":"pos1","after":{"id":1,"name":"a"}}`,
+		"flush failed",
+		`{"op":"c","keyspace":"ks","shard":"-80","table":"t1","timestamp":10,"gtid":"pos1","after":{"id":1,"name":"a"}}`,
+		"flush",
+	}, sink.ops)
+	assert.Equal(t, []string{"ks/-80:pos1 ks/80-:current "}, store.saved)
+}
+
+func TestStreamerReshard(t *testing.T) {
+	journal := &binlogdatapb.VEvent{
+		Type: binlogdatapb.VEventType_JOURNAL,
+		Journal: &binlogdatapb.Journal{
+			Id:            1,
+			MigrationType: binlogdatapb.MigrationType_SHARDS,
+			Participants: []*binlogdatapb.KeyspaceShard{
+				{Keyspace: "ks", Shard: "-80"},
+				{Keyspace: "ks", Shard: "80-"},
+			},
+			ShardGtids: newVGtid("-40", "pos3", "40-", "pos4").ShardGtids,
+		},
+	}
+	store := &fakeStore{}
+	fvs := runStreamer(t, store, &fakeSink{}, &fakeStream{
+		batches: [][]*binlogdatapb.VEvent{
+			{fieldEvent("-80")},
+			transaction(newVGtid("-80", "pos1", "80-", "pos2"), "-80", "1|a"),
+			{journal},
+			{journal},
+		},
+		err: fmt.Errorf("unreachable"),
+	})
+
+	// The stream continues from the target shards once all the source
+	// shards sent the journal.
+	assert.Equal(t, []string{"ks/-80:current ks/80-:current ", "ks/-40:pos3 ks/40-:pos4 "}, vgtidStrings(fvs.vgtids))
+	assert.Equal(t, []string{"ks/-80:pos1 ks/80-:pos2 ", "ks/-40:pos3 ks/40-:pos4 "}, store.saved)
+}
+
+func vgtidStrings(vgtids []*binlogdatapb.VGtid) []string {
+	var s []string
+	for _, vgtid := range vgtids {
+		s = append(s, vgtidString(vgtid))
+	}
+	return s
+}
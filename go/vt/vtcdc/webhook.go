@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtcdc
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+var (
+	webhookURL       = flag.String("cdc_webhook_url", "", "URL the webhook sink posts the events to")
+	webhookFormat    = flag.String("cdc_webhook_format", "json", "format of the events posted by the webhook sink: json or debezium")
+	webhookTimeout   = flag.Duration("cdc_webhook_timeout", 30*time.Second, "timeout of the requests of the webhook sink")
+	webhookBatchSize = flag.Int("cdc_webhook_batch_size", 1000, "the webhook sink posts the buffered events once they reach this number, and on every checkpoint")
+)
+
+// webhookSink posts the events to an HTTP endpoint, as newline-delimited
+// JSON. A batch is acknowledged by any 2xx response, and failed requests
+// make the stream restart from its last checkpoint.
+type webhookSink struct {
+	url       string
+	encode    encoder
+	client    *http.Client
+	batchSize int
+
+	buf   bytes.Buffer
+	count int
+}
+
+func newWebhookSink() (Sink, error) {
+	if *webhookURL == "" {
+		return nil, fmt.Errorf("-cdc_webhook_url is required by the webhook sink")
+	}
+	encode, ok := encoders[*webhookFormat]
+	if !ok {
+		return nil, fmt.Errorf("unknown webhook format %s", *webhookFormat)
+	}
+	return &webhookSink{
+		url:       *webhookURL,
+		encode:    encode,
+		client:    &http.Client{Timeout: *webhookTimeout},
+		batchSize: *webhookBatchSize,
+	}, nil
+}
+
+// Write is part of the Sink interface.
+func (ws *webhookSink) Write(ctx context.Context, events []*ChangeEvent) error {
+	if err := writeLines(&ws.buf, events, ws.encode); err != nil {
+		return err
+	}
+	ws.count += len(events)
+	if ws.count < ws.batchSize {
+		return nil
+	}
+	return ws.Flush(ctx)
+}
+
+// Flush is part of the Sink interface.
+func (ws *webhookSink) Flush(ctx context.Context) error {
+	if ws.count == 0 {
+		return nil
+	}
+	// A failed batch is dropped: the stream restarts from its checkpoint
+	// and sends the events again.
+	defer func() {
+		ws.buf.Reset()
+		ws.count = 0
+	}()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ws.url, bytes.NewReader(ws.buf.Bytes()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := ws.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("webhook %s returned %s: %s", ws.url, resp.Status, body)
+	}
+	return nil
+}
+
+// Close is part of the Sink interface. Events that were not flushed are
+// dropped: they will be streamed again from the last checkpoint.
+func (ws *webhookSink) Close() error {
+	ws.client.CloseIdleConnections()
+	return nil
+}
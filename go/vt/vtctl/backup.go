@@ -22,8 +22,10 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
@@ -31,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -45,33 +48,35 @@ func init() {
 	addCommand("Shards", command{
 		name:   "BackupShard",
 		method: commandBackupShard,
-		params: "[-allow_primary=false] <keyspace/shard>",
+		params: "[-allow_primary=false] [-incremental] [-compression_engine=<engine>] <keyspace/shard>",
 		help:   "Chooses a tablet and creates a backup for a shard.",
 	})
 	addCommand("Shards", command{
 		name:   "RemoveBackup",
 		method: commandRemoveBackup,
 		params: "<keyspace/shard> <backup name>",
-		help:   "Removes a backup for the BackupStorage.",
+		help:   "Removes a backup for the BackupStorage. A backup can't be removed while incremental backups are taken on top of it.",
 	})
 
 	addCommand("Tablets", command{
 		name:   "Backup",
 		method: commandBackup,
-		params: "[-concurrency=4] [-allow_primary=false] <tablet alias>",
-		help:   "Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes.",
+		params: "[-concurrency=4] [-allow_primary=false] [-incremental] [-compression_engine=<engine>] <tablet alias>",
+		help:   "Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes. With -incremental, only the files that changed since the latest backup of the shard are stored.",
 	})
 	addCommand("Tablets", command{
 		name:   "RestoreFromBackup",
 		method: commandRestoreFromBackup,
-		params: "[-backup_timestamp=yyyy-MM-dd.HHmmss] <tablet alias>",
-		help:   "Stops mysqld and restores the data from the latest backup or if a timestamp is specified then the most recent backup at or before that time.",
+		params: "[-backup_timestamp=yyyy-MM-dd.HHmmss] [-restore_to_pos=<position> | -restore_to_timestamp=yyyy-MM-dd.HHmmss] <tablet alias>",
+		help:   "Stops mysqld and restores the data from the latest backup or if a timestamp is specified then the most recent backup at or before that time. If a restore position or timestamp is specified, the archived binary logs are then replayed up to that point, and the tablet is left DRAINED.",
 	})
 }
 
 func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowPrimary := subFlags.Bool("allow_primary", false, "Allows backups to be taken on primary. Warning!! If you are using the builtin backup engine, this will shutdown your primary mysql for as long as it takes to create a backup.")
+	incremental := subFlags.Bool("incremental", false, "Only store the files that changed since the latest backup of the shard, which becomes the parent of the new backup. Only supported by the builtin backup engine.")
+	compressionEngine := subFlags.String("compression_engine", "", "The compression engine for this backup: pgzip, zstd, lz4 or external. Defaults to the -compression_engine flag of the tablet.")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -89,12 +94,19 @@ func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fl
 		return err
 	}
 
-	return execBackup(ctx, wr, tabletInfo.Tablet, *concurrency, *allowPrimary)
+	return execBackup(ctx, wr, tabletInfo.Tablet, &tabletmanagerdatapb.BackupRequest{
+		Concurrency:       int64(*concurrency),
+		AllowPrimary:      *allowPrimary,
+		Incremental:       *incremental,
+		CompressionEngine: *compressionEngine,
+	})
 }
 
 func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowPrimary := subFlags.Bool("allow_primary", false, "Whether to use primary tablet for backup. Warning!! If you are using the builtin backup engine, this will shutdown your primary mysql for as long as it takes to create a backup.")
+	incremental := subFlags.Bool("incremental", false, "Only store the files that changed since the latest backup of the shard, which becomes the parent of the new backup. Only supported by the builtin backup engine.")
+	compressionEngine := subFlags.String("compression_engine", "", "The compression engine for this backup: pgzip, zstd, lz4 or external. Defaults to the -compression_engine flag of the tablet.")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -156,12 +168,17 @@ func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 		return errors.New("no tablet available for backup")
 	}
 
-	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowPrimary)
+	return execBackup(ctx, wr, tabletForBackup, &tabletmanagerdatapb.BackupRequest{
+		Concurrency:       int64(*concurrency),
+		AllowPrimary:      *allowPrimary,
+		Incremental:       *incremental,
+		CompressionEngine: *compressionEngine,
+	})
 }
 
 // execBackup is shared by Backup and BackupShard
-func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, concurrency int, allowPrimary bool) error {
-	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, concurrency, allowPrimary)
+func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.BackupRequest) error {
+	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, req)
 	if err != nil {
 		return err
 	}
@@ -227,11 +244,22 @@ func commandRemoveBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 		return err
 	}
 	defer bs.Close()
+
+	// Incremental backups refer to the files of their parent.
+	bhs, err := bs.ListBackups(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if children := mysqlctl.FindChildBackups(ctx, bhs, name); len(children) > 0 {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "backup %v is the parent of incremental backup(s) %v, which must be removed first", name, strings.Join(children, ", "))
+	}
 	return bs.RemoveBackup(ctx, bucket, name)
 }
 
 func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	backupTimestampStr := subFlags.String("backup_timestamp", "", "Use the backup taken at or before this timestamp rather than using the latest backup.")
+	restoreToPos := subFlags.String("restore_to_pos", "", "Replay the archived binary logs on top of the backup, up to this GTID position.")
+	restoreToTimestampStr := subFlags.String("restore_to_timestamp", "", "Replay the archived binary logs on top of the backup, up to this timestamp.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -251,6 +279,26 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 		}
 	}
 
+	req := &tabletmanagerdatapb.RestoreFromBackupRequest{
+		BackupTime: logutil.TimeToProto(backupTime),
+	}
+	if *restoreToPos != "" && *restoreToTimestampStr != "" {
+		return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "only one of -restore_to_pos and -restore_to_timestamp can be specified")
+	}
+	if *restoreToPos != "" {
+		if _, err := mysql.DecodePosition(*restoreToPos); err != nil {
+			return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, fmt.Sprintf("unable to parse the restore position value provided of '%s': %v", *restoreToPos, err))
+		}
+		req.RestoreToPos = *restoreToPos
+	}
+	if *restoreToTimestampStr != "" {
+		restoreToTimestamp, err := time.Parse(mysqlctl.BackupTimestampFormat, *restoreToTimestampStr)
+		if err != nil {
+			return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, fmt.Sprintf("unable to parse the restore timestamp value provided of '%s'", *restoreToTimestampStr))
+		}
+		req.RestoreToTimestamp = logutil.TimeToProto(restoreToTimestamp)
+	}
+
 	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
 	if err != nil {
 		return err
@@ -259,7 +307,7 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 	if err != nil {
 		return err
 	}
-	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet, backupTime)
+	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet, req)
 	if err != nil {
 		return err
 	}
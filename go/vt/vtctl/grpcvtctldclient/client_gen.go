@@ -26,6 +26,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
 )
 
 // AddCellInfo is part of the vtctlservicepb.VtctldClient interface.
@@ -64,6 +65,15 @@ func (client *gRPCVtctldClient) ApplyVSchema(ctx context.Context, in *vtctldatap
 	return client.c.ApplyVSchema(ctx, in, opts...)
 }
 
+// CancelWorkflow is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) CancelWorkflow(ctx context.Context, in *vtctldatapb.CancelWorkflowRequest, opts ...grpc.CallOption) (*vtctldatapb.CancelWorkflowResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.CancelWorkflow(ctx, in, opts...)
+}
+
 // ChangeTabletType is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) ChangeTabletType(ctx context.Context, in *vtctldatapb.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldatapb.ChangeTabletTypeResponse, error) {
 	if client.c == nil {
@@ -73,6 +83,15 @@ func (client *gRPCVtctldClient) ChangeTabletType(ctx context.Context, in *vtctld
 	return client.c.ChangeTabletType(ctx, in, opts...)
 }
 
+// CompleteWorkflow is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) CompleteWorkflow(ctx context.Context, in *vtctldatapb.CompleteWorkflowRequest, opts ...grpc.CallOption) (*vtctldatapb.CompleteWorkflowResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.CompleteWorkflow(ctx, in, opts...)
+}
+
 // CreateKeyspace is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) CreateKeyspace(ctx context.Context, in *vtctldatapb.CreateKeyspaceRequest, opts ...grpc.CallOption) (*vtctldatapb.CreateKeyspaceResponse, error) {
 	if client.c == nil {
@@ -82,6 +101,15 @@ func (client *gRPCVtctldClient) CreateKeyspace(ctx context.Context, in *vtctldat
 	return client.c.CreateKeyspace(ctx, in, opts...)
 }
 
+// CreateLookupVindex is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) CreateLookupVindex(ctx context.Context, in *vtctldatapb.CreateLookupVindexRequest, opts ...grpc.CallOption) (*vtctldatapb.CreateLookupVindexResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.CreateLookupVindex(ctx, in, opts...)
+}
+
 // CreateShard is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) CreateShard(ctx context.Context, in *vtctldatapb.CreateShardRequest, opts ...grpc.CallOption) (*vtctldatapb.CreateShardResponse, error) {
 	if client.c == nil {
@@ -334,6 +362,24 @@ func (client *gRPCVtctldClient) InitShardPrimary(ctx context.Context, in *vtctld
 	return client.c.InitShardPrimary(ctx, in, opts...)
 }
 
+// Materialize is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) Materialize(ctx context.Context, in *vtctldatapb.MaterializeRequest, opts ...grpc.CallOption) (*vtctldatapb.MaterializeResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.Materialize(ctx, in, opts...)
+}
+
+// MoveTables is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) MoveTables(ctx context.Context, in *vtctldatapb.MoveTablesRequest, opts ...grpc.CallOption) (*vtctldatapb.MoveTablesResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.MoveTables(ctx, in, opts...)
+}
+
 // PingTablet is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) PingTablet(ctx context.Context, in *vtctldatapb.PingTabletRequest, opts ...grpc.CallOption) (*vtctldatapb.PingTabletResponse, error) {
 	if client.c == nil {
@@ -442,6 +488,33 @@ func (client *gRPCVtctldClient) ReparentTablet(ctx context.Context, in *vtctldat
 	return client.c.ReparentTablet(ctx, in, opts...)
 }
 
+// Reshard is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) Reshard(ctx context.Context, in *vtctldatapb.ReshardRequest, opts ...grpc.CallOption) (*vtctldatapb.ReshardResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.Reshard(ctx, in, opts...)
+}
+
+// RestoreFromBackup is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) RestoreFromBackup(ctx context.Context, in *vtctldatapb.RestoreFromBackupRequest, opts ...grpc.CallOption) (vtctlservicepb.Vtctld_RestoreFromBackupClient, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.RestoreFromBackup(ctx, in, opts...)
+}
+
+// ReverseTraffic is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ReverseTraffic(ctx context.Context, in *vtctldatapb.ReverseTrafficRequest, opts ...grpc.CallOption) (*vtctldatapb.ReverseTrafficResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ReverseTraffic(ctx, in, opts...)
+}
+
 // RunHealthCheck is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) RunHealthCheck(ctx context.Context, in *vtctldatapb.RunHealthCheckRequest, opts ...grpc.CallOption) (*vtctldatapb.RunHealthCheckResponse, error) {
 	if client.c == nil {
@@ -469,6 +542,15 @@ func (client *gRPCVtctldClient) SetKeyspaceShardingInfo(ctx context.Context, in
 	return client.c.SetKeyspaceShardingInfo(ctx, in, opts...)
 }
 
+// SetKeyspaceThrottlerConfig is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) SetKeyspaceThrottlerConfig(ctx context.Context, in *vtctldatapb.SetKeyspaceThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldatapb.SetKeyspaceThrottlerConfigResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.SetKeyspaceThrottlerConfig(ctx, in, opts...)
+}
+
 // SetShardIsPrimaryServing is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) SetShardIsPrimaryServing(ctx context.Context, in *vtctldatapb.SetShardIsPrimaryServingRequest, opts ...grpc.CallOption) (*vtctldatapb.SetShardIsPrimaryServingResponse, error) {
 	if client.c == nil {
@@ -532,6 +614,15 @@ func (client *gRPCVtctldClient) StopReplication(ctx context.Context, in *vtctlda
 	return client.c.StopReplication(ctx, in, opts...)
 }
 
+// SwitchTraffic is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) SwitchTraffic(ctx context.Context, in *vtctldatapb.SwitchTrafficRequest, opts ...grpc.CallOption) (*vtctldatapb.SwitchTrafficResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.SwitchTraffic(ctx, in, opts...)
+}
+
 // TabletExternallyReparented is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) TabletExternallyReparented(ctx context.Context, in *vtctldatapb.TabletExternallyReparentedRequest, opts ...grpc.CallOption) (*vtctldatapb.TabletExternallyReparentedResponse, error) {
 	if client.c == nil {
@@ -559,6 +650,15 @@ func (client *gRPCVtctldClient) UpdateCellsAlias(ctx context.Context, in *vtctld
 	return client.c.UpdateCellsAlias(ctx, in, opts...)
 }
 
+// VDiff is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) VDiff(ctx context.Context, in *vtctldatapb.VDiffRequest, opts ...grpc.CallOption) (*vtctldatapb.VDiffResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.VDiff(ctx, in, opts...)
+}
+
 // Validate is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) Validate(ctx context.Context, in *vtctldatapb.ValidateRequest, opts ...grpc.CallOption) (*vtctldatapb.ValidateResponse, error) {
 	if client.c == nil {
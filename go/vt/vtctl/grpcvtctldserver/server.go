@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -30,9 +31,9 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"vitess.io/vitess/go/event"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/netutil"
 	"vitess.io/vitess/go/protoutil"
-	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -40,6 +41,7 @@ import (
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/mysqlctl/mysqlctlproto"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -51,6 +53,7 @@ import (
 	"vitess.io/vitess/go/vt/vtctl/schematools"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
+	throttlebase "vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
@@ -64,10 +67,6 @@ import (
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
-const (
-	initShardPrimaryOperation = "InitShardPrimary"
-)
-
 // VtctldServer implements the Vtctld RPC service protocol.
 type VtctldServer struct {
 	vtctlservicepb.UnimplementedVtctldServer
@@ -226,6 +225,14 @@ func (s *VtctldServer) ApplyVSchema(ctx context.Context, req *vtctldatapb.ApplyV
 	return &vtctldatapb.ApplyVSchemaResponse{VSchema: updatedVS}, nil
 }
 
+// CancelWorkflow is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) CancelWorkflow(ctx context.Context, req *vtctldatapb.CancelWorkflowRequest) (*vtctldatapb.CancelWorkflowResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.CancelWorkflow")
+	defer span.Finish()
+
+	return s.ws.CancelWorkflow(ctx, req)
+}
+
 // ChangeTabletType is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ChangeTabletType(ctx context.Context, req *vtctldatapb.ChangeTabletTypeRequest) (*vtctldatapb.ChangeTabletTypeResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ChangeTabletType")
@@ -281,6 +288,14 @@ func (s *VtctldServer) ChangeTabletType(ctx context.Context, req *vtctldatapb.Ch
 	}, nil
 }
 
+// CompleteWorkflow is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) CompleteWorkflow(ctx context.Context, req *vtctldatapb.CompleteWorkflowRequest) (*vtctldatapb.CompleteWorkflowResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.CompleteWorkflow")
+	defer span.Finish()
+
+	return s.ws.CompleteWorkflow(ctx, req)
+}
+
 // CreateKeyspace is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) CreateKeyspace(ctx context.Context, req *vtctldatapb.CreateKeyspaceRequest) (*vtctldatapb.CreateKeyspaceResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.CreateKeyspace")
@@ -382,6 +397,14 @@ func (s *VtctldServer) CreateKeyspace(ctx context.Context, req *vtctldatapb.Crea
 	}, nil
 }
 
+// CreateLookupVindex is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) CreateLookupVindex(ctx context.Context, req *vtctldatapb.CreateLookupVindexRequest) (*vtctldatapb.CreateLookupVindexResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.CreateLookupVindex")
+	defer span.Finish()
+
+	return s.ws.CreateLookupVindex(ctx, req)
+}
+
 // CreateShard is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) CreateShard(ctx context.Context, req *vtctldatapb.CreateShardRequest) (*vtctldatapb.CreateShardResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.CreateShard")
@@ -754,9 +777,18 @@ func (s *VtctldServer) GetBackups(ctx context.Context, req *vtctldatapb.GetBacku
 		bi.Shard = req.Shard
 
 		if req.Detailed {
-			if i >= backupsToSkipDetails { // nolint:staticcheck
+			if i >= backupsToSkipDetails {
+				// A backup is complete once its MANIFEST is written.
 				// (TODO:@ajm188) Update backupengine/backupstorage implementations
-				// to get Status info for backups.
+				// to tell apart VALID and INVALID backups.
+				bm, err := mysqlctl.GetBackupManifest(ctx, bh)
+				if err != nil {
+					bi.Status = mysqlctlpb.BackupInfo_INCOMPLETE
+				} else {
+					bi.Status = mysqlctlpb.BackupInfo_COMPLETE
+					bi.Engine = bm.BackupMethod
+					bi.ParentBackup = bm.ParentBackup
+				}
 			}
 		}
 
@@ -1366,196 +1398,23 @@ func (s *VtctldServer) InitShardPrimaryLocked(
 	tmc tmclient.TabletManagerClient,
 	logger logutil.Logger,
 ) error {
-	// (TODO:@amason) The code below this point is a verbatim copy of
-	// initShardMasterLocked in package wrangler, modulo the following:
-	// - s/keyspace/req.Keyspace
-	// - s/shard/req.Shard
-	// - s/masterElectTabletAlias/req.PrimaryElectTabletAlias
-	// - s/wr.logger/logger
-	// - s/wr.tmc/tmc
-	// - s/wr.ts/s.ts
-	//
-	// It is also sufficiently complex and critical code that I feel it's unwise
-	// to port and refactor in one change; so, this comment serves both as an
-	// acknowledgement of that, as well as a TODO marker for us to revisit this.
-	shardInfo, err := s.ts.GetShard(ctx, req.Keyspace, req.Shard)
-	if err != nil {
-		return err
-	}
-	ev.ShardInfo = *shardInfo
-
-	event.DispatchUpdate(ev, "reading tablet map")
-	tabletMap, err := s.ts.GetTabletMapForShard(ctx, req.Keyspace, req.Shard)
-	if err != nil {
-		return err
-	}
-
-	// Check the primary elect is in tabletMap.
-	primaryElectTabletAliasStr := topoproto.TabletAliasString(req.PrimaryElectTabletAlias)
-	primaryElectTabletInfo, ok := tabletMap[primaryElectTabletAliasStr]
-	if !ok {
-		return fmt.Errorf("primary-elect tablet %v is not in the shard", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
-	}
-	ev.NewPrimary = proto.Clone(primaryElectTabletInfo.Tablet).(*topodatapb.Tablet)
-
-	// Check the primary is the only primary is the shard, or -force was used.
-	_, primaryTabletMap := topotools.SortedTabletMap(tabletMap)
-	if !topoproto.TabletAliasEqual(shardInfo.PrimaryAlias, req.PrimaryElectTabletAlias) {
-		if !req.Force {
-			return fmt.Errorf("primary-elect tablet %v is not the shard primary, use -force to proceed anyway", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
-		}
-
-		logger.Warningf("primary-elect tablet %v is not the shard primary, proceeding anyway as -force was used", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
-	}
-	if _, ok := primaryTabletMap[primaryElectTabletAliasStr]; !ok {
-		if !req.Force {
-			return fmt.Errorf("primary-elect tablet %v is not a primary in the shard, use -force to proceed anyway", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
-		}
-		logger.Warningf("primary-elect tablet %v is not a primary in the shard, proceeding anyway as -force was used", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
-	}
-	haveOtherPrimary := false
-	for alias := range primaryTabletMap {
-		if primaryElectTabletAliasStr != alias {
-			haveOtherPrimary = true
-		}
-	}
-	if haveOtherPrimary {
-		if !req.Force {
-			return fmt.Errorf("primary-elect tablet %v is not the only primary in the shard, use -force to proceed anyway", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
-		}
-		logger.Warningf("primary-elect tablet %v is not the only primary in the shard, proceeding anyway as -force was used", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
-	}
-
-	// First phase: reset replication on all tablets. If anyone fails,
-	// we stop. It is probably because it is unreachable, and may leave
-	// an unstable database process in the mix, with a database daemon
-	// at a wrong replication spot.
-
-	// Create a context for the following RPCs that respects waitReplicasTimeout
-	resetCtx, resetCancel := context.WithTimeout(ctx, waitReplicasTimeout)
-	defer resetCancel()
-
-	event.DispatchUpdate(ev, "resetting replication on all tablets")
-	wg := sync.WaitGroup{}
-	rec := concurrency.AllErrorRecorder{}
-	for alias, tabletInfo := range tabletMap {
-		wg.Add(1)
-		go func(alias string, tabletInfo *topo.TabletInfo) {
-			defer wg.Done()
-			logger.Infof("resetting replication on tablet %v", alias)
-			if err := tmc.ResetReplication(resetCtx, tabletInfo.Tablet); err != nil {
-				rec.RecordError(fmt.Errorf("tablet %v ResetReplication failed (either fix it, or Scrap it): %v", alias, err))
-			}
-		}(alias, tabletInfo)
-	}
-	wg.Wait()
-	if err := rec.Error(); err != nil {
-		// if any of the replicas failed
-		return err
-	}
-
-	// Check we still have the topology lock.
-	if err := topo.CheckShardLocked(ctx, req.Keyspace, req.Shard); err != nil {
-		return fmt.Errorf("lost topology lock, aborting: %v", err)
-	}
-
-	// Tell the new primary to break its replicas, return its replication
-	// position
-	logger.Infof("initializing primary on %v", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
-	event.DispatchUpdate(ev, "initializing primary")
-	rp, err := tmc.InitPrimary(ctx, primaryElectTabletInfo.Tablet)
-	if err != nil {
-		return err
-	}
-
-	// Check we stil have the topology lock.
-	if err := topo.CheckShardLocked(ctx, req.Keyspace, req.Shard); err != nil {
-		return fmt.Errorf("lost topology lock, aborting: %v", err)
-	}
-
-	// Create a cancelable context for the following RPCs.
-	// If error conditions happen, we can cancel all outgoing RPCs.
-	replCtx, replCancel := context.WithTimeout(ctx, waitReplicasTimeout)
-	defer replCancel()
-
-	// Now tell the new primary to insert the reparent_journal row,
-	// and tell everybody else to become a replica of the new primary,
-	// and wait for the row in the reparent_journal table.
-	// We start all these in parallel, to handle the semi-sync
-	// case: for the primary to be able to commit its row in the
-	// reparent_journal table, it needs connected replicas.
-	event.DispatchUpdate(ev, "reparenting all tablets")
-	now := time.Now().UnixNano()
-	wgPrimary := sync.WaitGroup{}
-	wgReplicas := sync.WaitGroup{}
-	var primaryErr error
-	for alias, tabletInfo := range tabletMap {
-		if alias == primaryElectTabletAliasStr {
-			wgPrimary.Add(1)
-			go func(alias string, tabletInfo *topo.TabletInfo) {
-				defer wgPrimary.Done()
-				logger.Infof("populating reparent journal on new primary %v", alias)
-				primaryErr = tmc.PopulateReparentJournal(replCtx, tabletInfo.Tablet, now,
-					initShardPrimaryOperation,
-					req.PrimaryElectTabletAlias, rp)
-			}(alias, tabletInfo)
-		} else {
-			wgReplicas.Add(1)
-			go func(alias string, tabletInfo *topo.TabletInfo) {
-				defer wgReplicas.Done()
-				logger.Infof("initializing replica %v", alias)
-				if err := tmc.InitReplica(replCtx, tabletInfo.Tablet, req.PrimaryElectTabletAlias, rp, now); err != nil {
-					rec.RecordError(fmt.Errorf("tablet %v InitReplica failed: %v", alias, err))
-				}
-			}(alias, tabletInfo)
-		}
-	}
+	return reparentutil.InitShardPrimaryLocked(ctx, s.ts, tmc, logger, ev, req, waitReplicasTimeout)
+}
 
-	// After the primary is done, we can update the shard record
-	// (note with semi-sync, it also means at least one replica is done).
-	wgPrimary.Wait()
-	if primaryErr != nil {
-		// The primary failed, there is no way the
-		// replicas will work.  So we cancel them all.
-		logger.Warningf("primary failed to PopulateReparentJournal, canceling replicas")
-		replCancel()
-		wgReplicas.Wait()
-		return fmt.Errorf("failed to PopulateReparentJournal on primary: %v", primaryErr)
-	}
-	if !topoproto.TabletAliasEqual(shardInfo.PrimaryAlias, req.PrimaryElectTabletAlias) {
-		if _, err := s.ts.UpdateShardFields(ctx, req.Keyspace, req.Shard, func(si *topo.ShardInfo) error {
-			si.PrimaryAlias = req.PrimaryElectTabletAlias
-			return nil
-		}); err != nil {
-			wgReplicas.Wait()
-			return fmt.Errorf("failed to update shard primary record: %v", err)
-		}
-	}
+// Materialize is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) Materialize(ctx context.Context, req *vtctldatapb.MaterializeRequest) (*vtctldatapb.MaterializeResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.Materialize")
+	defer span.Finish()
 
-	// Wait for the replicas to complete. If some of them fail, we
-	// don't want to rebuild the shard serving graph (the failure
-	// will most likely be a timeout, and our context will be
-	// expired, so the rebuild will fail anyway)
-	wgReplicas.Wait()
-	if err := rec.Error(); err != nil {
-		return err
-	}
+	return s.ws.Materialize(ctx, req)
+}
 
-	// Create database if necessary on the primary. replicas will get it too through
-	// replication. Since the user called InitShardPrimary, they've told us to
-	// assume that whatever data is on all the replicas is what they intended.
-	// If the database doesn't exist, it means the user intends for these tablets
-	// to begin serving with no data (i.e. first time initialization).
-	createDB := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", sqlescape.EscapeID(topoproto.TabletDbName(primaryElectTabletInfo.Tablet)))
-	if _, err := tmc.ExecuteFetchAsDba(ctx, primaryElectTabletInfo.Tablet, false, []byte(createDB), 1, false, true); err != nil {
-		return fmt.Errorf("failed to create database: %v", err)
-	}
-	// Refresh the state to force the tabletserver to reconnect after db has been created.
-	if err := tmc.RefreshState(ctx, primaryElectTabletInfo.Tablet); err != nil {
-		log.Warningf("RefreshState failed: %v", err)
-	}
+// MoveTables is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) MoveTables(ctx context.Context, req *vtctldatapb.MoveTablesRequest) (*vtctldatapb.MoveTablesResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.MoveTables")
+	defer span.Finish()
 
-	return nil
+	return s.ws.MoveTables(ctx, req)
 }
 
 // PingTablet is part of the vtctlservicepb.VtctldServer interface.
@@ -1934,6 +1793,76 @@ func (s *VtctldServer) ReparentTablet(ctx context.Context, req *vtctldatapb.Repa
 	}, nil
 }
 
+// Reshard is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) Reshard(ctx context.Context, req *vtctldatapb.ReshardRequest) (*vtctldatapb.ReshardResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.Reshard")
+	defer span.Finish()
+
+	return s.ws.Reshard(ctx, req)
+}
+
+// RestoreFromBackup is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) RestoreFromBackup(req *vtctldatapb.RestoreFromBackupRequest, stream vtctlservicepb.Vtctld_RestoreFromBackupServer) (err error) {
+	span, ctx := trace.NewSpan(stream.Context(), "VtctldServer.RestoreFromBackup")
+	defer span.Finish()
+
+	if req.TabletAlias == nil {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "RestoreFromBackup requires a tablet alias")
+	}
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+	span.Annotate("restore_to_pos", req.RestoreToPos)
+
+	if req.RestoreToPos != "" && req.RestoreToTimestamp != nil {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "RestoreFromBackup accepts only one of restore_to_pos and restore_to_timestamp")
+	}
+	if _, err := mysql.DecodePosition(req.RestoreToPos); err != nil {
+		return vterrors.Wrapf(err, "invalid restore_to_pos %v", req.RestoreToPos)
+	}
+
+	ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return err
+	}
+
+	logStream, err := s.tmc.RestoreFromBackup(ctx, ti.Tablet, &tabletmanagerdatapb.RestoreFromBackupRequest{
+		BackupTime:         req.BackupTime,
+		RestoreToPos:       req.RestoreToPos,
+		RestoreToTimestamp: req.RestoreToTimestamp,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp := &vtctldatapb.RestoreFromBackupResponse{
+		TabletAlias: req.TabletAlias,
+		Keyspace:    ti.Keyspace,
+		Shard:       ti.Shard,
+	}
+	for {
+		event, err := logStream.Recv()
+		switch err {
+		case nil:
+			resp.Event = event
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
+// ReverseTraffic is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ReverseTraffic(ctx context.Context, req *vtctldatapb.ReverseTrafficRequest) (*vtctldatapb.ReverseTrafficResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ReverseTraffic")
+	defer span.Finish()
+
+	return s.ws.ReverseTraffic(ctx, req)
+}
+
 // RunHealthCheck is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) RunHealthCheck(ctx context.Context, req *vtctldatapb.RunHealthCheckRequest) (*vtctldatapb.RunHealthCheckResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.RunHealthCheck")
@@ -2051,6 +1980,47 @@ func (s *VtctldServer) SetKeyspaceShardingInfo(ctx context.Context, req *vtctlda
 	}, nil
 }
 
+// SetKeyspaceThrottlerConfig is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) SetKeyspaceThrottlerConfig(ctx context.Context, req *vtctldatapb.SetKeyspaceThrottlerConfigRequest) (*vtctldatapb.SetKeyspaceThrottlerConfigResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.SetKeyspaceThrottlerConfig")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	throttlerConfig := req.ThrottlerConfig
+	if proto.Equal(throttlerConfig, &topodatapb.ThrottlerConfig{}) {
+		throttlerConfig = nil
+	}
+	if throttlerConfig != nil {
+		if err := throttlebase.ValidateThrottlerConfig(throttlerConfig); err != nil {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid throttler config: %v", err)
+		}
+	}
+
+	ctx, unlock, lockErr := s.ts.LockKeyspace(ctx, req.Keyspace, "SetKeyspaceThrottlerConfig")
+	if lockErr != nil {
+		return nil, lockErr
+	}
+
+	var err error
+	defer unlock(&err)
+
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	ki.ThrottlerConfig = throttlerConfig
+	err = s.ts.UpdateKeyspace(ctx, ki)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.SetKeyspaceThrottlerConfigResponse{
+		Keyspace: ki.Keyspace,
+	}, nil
+}
+
 // SetShardIsPrimaryServing is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) SetShardIsPrimaryServing(ctx context.Context, req *vtctldatapb.SetShardIsPrimaryServingRequest) (*vtctldatapb.SetShardIsPrimaryServingResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.SetShardIsPrimaryServing")
@@ -2374,6 +2344,14 @@ func (s *VtctldServer) StopReplication(ctx context.Context, req *vtctldatapb.Sto
 	return &vtctldatapb.StopReplicationResponse{}, nil
 }
 
+// SwitchTraffic is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) SwitchTraffic(ctx context.Context, req *vtctldatapb.SwitchTrafficRequest) (*vtctldatapb.SwitchTrafficResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.SwitchTraffic")
+	defer span.Finish()
+
+	return s.ws.SwitchTraffic(ctx, req)
+}
+
 // TabletExternallyReparented is part of the vtctldservicepb.VtctldServer interface.
 func (s *VtctldServer) TabletExternallyReparented(ctx context.Context, req *vtctldatapb.TabletExternallyReparentedRequest) (*vtctldatapb.TabletExternallyReparentedResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.TabletExternallyReparented")
@@ -2517,6 +2495,14 @@ func (s *VtctldServer) UpdateCellsAlias(ctx context.Context, req *vtctldatapb.Up
 	}, nil
 }
 
+// VDiff is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VDiff(ctx context.Context, req *vtctldatapb.VDiffRequest) (*vtctldatapb.VDiffResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VDiff")
+	defer span.Finish()
+
+	return s.ws.VDiff(ctx, req)
+}
+
 // Validate is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) Validate(ctx context.Context, req *vtctldatapb.ValidateRequest) (*vtctldatapb.ValidateResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.Validate")
@@ -3124,6 +3124,57 @@ func TestGetBackups(t *testing.T) {
 		assert.Less(t, len(limited.Backups), len(unlimited.Backups), "expected limited backups to be less than unlimited")
 		utils.MustMatch(t, limited.Backups[0], unlimited.Backups[len(unlimited.Backups)-1], "expected limiting to keep N most recent")
 	})
+
+	t.Run("detailed", func(t *testing.T) {
+		testutil.BackupStorage.Backups["ks3/-"] = []string{"backup1", "backup2", "backup3", "backup4"}
+		testutil.BackupStorage.Manifests["ks3/-/backup1"] = `{"BackupMethod": "builtin"}`
+		testutil.BackupStorage.Manifests["ks3/-/backup2"] = `{"BackupMethod": "builtin", "ParentBackup": "backup1"}`
+		testutil.BackupStorage.Manifests["ks3/-/backup3"] = `{"BackupMethod": "builtin", "ParentBackup": "backup2"}`
+
+		resp, err := vtctld.GetBackups(ctx, &vtctldatapb.GetBackupsRequest{
+			Keyspace:      "ks3",
+			Shard:         "-",
+			Detailed:      true,
+			DetailedLimit: 3,
+		})
+		require.NoError(t, err)
+		expected := &vtctldatapb.GetBackupsResponse{
+			Backups: []*mysqlctlpb.BackupInfo{
+				{
+					Directory: "ks3/-",
+					Name:      "backup1",
+					Keyspace:  "ks3",
+					Shard:     "-",
+				},
+				{
+					Directory:    "ks3/-",
+					Name:         "backup2",
+					Keyspace:     "ks3",
+					Shard:        "-",
+					Engine:       "builtin",
+					Status:       mysqlctlpb.BackupInfo_COMPLETE,
+					ParentBackup: "backup1",
+				},
+				{
+					Directory:    "ks3/-",
+					Name:         "backup3",
+					Keyspace:     "ks3",
+					Shard:        "-",
+					Engine:       "builtin",
+					Status:       mysqlctlpb.BackupInfo_COMPLETE,
+					ParentBackup: "backup2",
+				},
+				{
+					Directory: "ks3/-",
+					Name:      "backup4",
+					Keyspace:  "ks3",
+					Shard:     "-",
+					Status:    mysqlctlpb.BackupInfo_INCOMPLETE,
+				},
+			},
+		}
+		utils.MustMatch(t, expected, resp)
+	})
 }
 
 func TestGetKeyspace(t *testing.T) {
@@ -6891,6 +6942,202 @@ func TestReparentTablet(t *testing.T) {
 	}
 }
 
+type restoreFromBackupStream struct {
+	vtctlservicepb.Vtctld_RestoreFromBackupServer
+	ctx       context.Context
+	responses []*vtctldatapb.RestoreFromBackupResponse
+}
+
+func (stream *restoreFromBackupStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *restoreFromBackupStream) Send(resp *vtctldatapb.RestoreFromBackupResponse) error {
+	stream.responses = append(stream.responses, proto.Clone(resp).(*vtctldatapb.RestoreFromBackupResponse))
+	return nil
+}
+
+func TestRestoreFromBackup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		tablets   []*topodatapb.Tablet
+		tmc       testutil.TabletManagerClient
+		req       *vtctldatapb.RestoreFromBackupRequest
+		expected  []*vtctldatapb.RestoreFromBackupResponse
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+				},
+			},
+			tmc: testutil.TabletManagerClient{
+				RestoreFromBackupResults: map[string]struct {
+					Events      []*logutilpb.Event
+					StreamError error
+					Error       error
+				}{
+					"zone1-0000000100": {
+						Events: []*logutilpb.Event{
+							{Value: "restoring"},
+							{Value: "replaying binary logs"},
+						},
+					},
+				},
+			},
+			req: &vtctldatapb.RestoreFromBackupRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+				RestoreToPos: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5",
+			},
+			expected: []*vtctldatapb.RestoreFromBackupResponse{
+				{
+					TabletAlias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+					Event:    &logutilpb.Event{Value: "restoring"},
+				},
+				{
+					TabletAlias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+					Event:    &logutilpb.Event{Value: "replaying binary logs"},
+				},
+			},
+		},
+		{
+			name: "invalid restore position",
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+				},
+			},
+			req: &vtctldatapb.RestoreFromBackupRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+				RestoreToPos: "MySQL56/not-a-gtid",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "both restore position and timestamp",
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+				},
+			},
+			req: &vtctldatapb.RestoreFromBackupRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+				RestoreToPos:       "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5",
+				RestoreToTimestamp: protoutil.TimeToProto(time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "no tablet",
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  404,
+					},
+				},
+			},
+			req: &vtctldatapb.RestoreFromBackupRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "restore failed",
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+				},
+			},
+			tmc: testutil.TabletManagerClient{
+				RestoreFromBackupResults: map[string]struct {
+					Events      []*logutilpb.Event
+					StreamError error
+					Error       error
+				}{
+					"zone1-0000000100": {
+						Events: []*logutilpb.Event{
+							{Value: "restoring"},
+						},
+						StreamError: assert.AnError,
+					},
+				},
+			},
+			req: &vtctldatapb.RestoreFromBackupRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablets(ctx, t, ts, nil, tt.tablets...)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &tt.tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			stream := &restoreFromBackupStream{ctx: ctx}
+			err := vtctld.RestoreFromBackup(tt.req, stream)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			utils.MustMatch(t, tt.expected, stream.responses)
+		})
+	}
+}
+
 func TestRunHealthCheck(t *testing.T) {
 	t.Parallel()
 
@@ -7229,6 +7476,112 @@ func TestSetKeyspaceShardingInfo(t *testing.T) {
 	}
 }
 
+func TestSetKeyspaceThrottlerConfig(t *testing.T) {
+	t.Parallel()
+
+	throttlerConfig := &topodatapb.ThrottlerConfig{
+		MetricThresholds: map[string]float64{"lag": 5, "threads_running": 100},
+		AppConfigs: map[string]*topodatapb.ThrottlerConfig_AppConfig{
+			"tablegc": {
+				Metrics:          []string{"lag", "loadavg"},
+				MetricThresholds: map[string]float64{"loadavg": 2},
+			},
+		},
+	}
+	tests := []struct {
+		name      string
+		keyspaces []*vtctldatapb.Keyspace
+		req       *vtctldatapb.SetKeyspaceThrottlerConfigRequest
+		expected  *vtctldatapb.SetKeyspaceThrottlerConfigResponse
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			keyspaces: []*vtctldatapb.Keyspace{
+				{
+					Name:     "ks1",
+					Keyspace: &topodatapb.Keyspace{},
+				},
+			},
+			req: &vtctldatapb.SetKeyspaceThrottlerConfigRequest{
+				Keyspace:        "ks1",
+				ThrottlerConfig: throttlerConfig,
+			},
+			expected: &vtctldatapb.SetKeyspaceThrottlerConfigResponse{
+				Keyspace: &topodatapb.Keyspace{
+					ThrottlerConfig: throttlerConfig,
+				},
+			},
+		},
+		{
+			name: "clear",
+			keyspaces: []*vtctldatapb.Keyspace{
+				{
+					Name: "ks1",
+					Keyspace: &topodatapb.Keyspace{
+						ThrottlerConfig: throttlerConfig,
+					},
+				},
+			},
+			req: &vtctldatapb.SetKeyspaceThrottlerConfigRequest{
+				Keyspace:        "ks1",
+				ThrottlerConfig: &topodatapb.ThrottlerConfig{},
+			},
+			expected: &vtctldatapb.SetKeyspaceThrottlerConfigResponse{
+				Keyspace: &topodatapb.Keyspace{},
+			},
+		},
+		{
+			name: "keyspace not found",
+			req: &vtctldatapb.SetKeyspaceThrottlerConfigRequest{
+				Keyspace:        "ks1",
+				ThrottlerConfig: throttlerConfig,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid config",
+			keyspaces: []*vtctldatapb.Keyspace{
+				{
+					Name:     "ks1",
+					Keyspace: &topodatapb.Keyspace{},
+				},
+			},
+			req: &vtctldatapb.SetKeyspaceThrottlerConfigRequest{
+				Keyspace: "ks1",
+				ThrottlerConfig: &topodatapb.ThrottlerConfig{
+					MetricThresholds: map[string]float64{"no_such_metric": 5},
+				},
+			},
+			shouldErr: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddKeyspaces(ctx, t, ts, tt.keyspaces...)
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.SetKeyspaceThrottlerConfig(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+}
+
 func TestSetShardIsPrimaryServing(t *testing.T) {
 	t.Parallel()
 
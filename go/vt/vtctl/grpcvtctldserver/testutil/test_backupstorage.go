@@ -18,7 +18,11 @@ package testutil
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"path"
 	"sort"
+	"strings"
 
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 )
@@ -29,6 +33,9 @@ type backupStorage struct {
 	// Backups is a mapping of directory to list of backup names stored in that
 	// directory.
 	Backups map[string][]string
+	// Manifests is a mapping of "<directory>/<name>" to the contents of the
+	// MANIFEST file of that backup. Backups missing from it have no MANIFEST.
+	Manifests map[string]string
 	// ListBackupsError is returned from ListBackups when it is non-nil.
 	ListBackupsError error
 }
@@ -44,7 +51,8 @@ func (bs *backupStorage) ListBackups(ctx context.Context, dir string) ([]backups
 	for k, v := range bs.Backups {
 		if k == dir {
 			for _, name := range v {
-				handles = append(handles, &backupHandle{directory: k, name: name})
+				manifest, ok := bs.Manifests[path.Join(k, name)]
+				handles = append(handles, &backupHandle{directory: k, name: name, manifest: manifest, hasManifest: ok})
 			}
 		}
 	}
@@ -61,13 +69,24 @@ func (bs *backupStorage) Close() error { return nil }
 type backupHandle struct {
 	backupstorage.BackupHandle
 
-	directory string
-	name      string
+	directory   string
+	name        string
+	manifest    string
+	hasManifest bool
 }
 
 func (bh *backupHandle) Directory() string { return bh.directory }
 func (bh *backupHandle) Name() string      { return bh.name }
 
+// ReadFile is part of the backupstorage.BackupHandle interface. Only the
+// MANIFEST file can be read.
+func (bh *backupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	if filename != "MANIFEST" || !bh.hasManifest {
+		return nil, fmt.Errorf("no file %v in backup %v/%v", filename, bh.directory, bh.name)
+	}
+	return io.NopCloser(strings.NewReader(bh.manifest)), nil
+}
+
 // handlesByName implements the sort interface for backup handles by Name().
 type handlesByName []backupstorage.BackupHandle
 
@@ -84,7 +103,8 @@ const BackupStorageImplementation = "grpcvtctldserver.testutil"
 // is public and singleton to allow tests to both mutate and assert against its
 // state.
 var BackupStorage = &backupStorage{
-	Backups: map[string][]string{},
+	Backups:   map[string][]string{},
+	Manifests: map[string]string{},
 }
 
 func init() {
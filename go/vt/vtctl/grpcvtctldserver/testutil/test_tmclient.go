@@ -19,6 +19,7 @@ package testutil
 import (
 	"context"
 	"fmt"
+	"io"
 	"path"
 	"sync"
 	"testing"
@@ -27,12 +28,14 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	hk "vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
@@ -193,6 +196,12 @@ type TabletManagerClient struct {
 		Position *replicationdatapb.Status
 		Error    error
 	}
+	// keyed by tablet alias.
+	RestoreFromBackupResults map[string]struct {
+		Events      []*logutilpb.Event
+		StreamError error
+		Error       error
+	}
 	// keyed by tablet alias
 	RunHealthCheckDelays map[string]time.Duration
 	// keyed by tablet alias
@@ -550,6 +559,46 @@ func (fake *TabletManagerClient) ReplicationStatus(ctx context.Context, tablet *
 	return nil, assert.AnError
 }
 
+// eventStream is a logutil.EventStream that returns a fixed set of events,
+// then an error (or io.EOF, if error is nil).
+type eventStream struct {
+	events []*logutilpb.Event
+	err    error
+}
+
+// Recv is part of the logutil.EventStream interface.
+func (stream *eventStream) Recv() (*logutilpb.Event, error) {
+	if len(stream.events) == 0 {
+		if stream.err != nil {
+			return nil, stream.err
+		}
+
+		return nil, io.EOF
+	}
+
+	e := stream.events[0]
+	stream.events = stream.events[1:]
+	return e, nil
+}
+
+// RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) (logutil.EventStream, error) {
+	if fake.RestoreFromBackupResults == nil {
+		return nil, fmt.Errorf("%w: no RestoreFromBackup results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.RestoreFromBackupResults[key]; ok {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		return &eventStream{events: result.Events, err: result.StreamError}, nil
+	}
+
+	return nil, fmt.Errorf("%w: no RestoreFromBackup result set for tablet %s", assert.AnError, key)
+}
+
 // RunHealthCheck is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error {
 	if fake.RunHealthCheckResults == nil {
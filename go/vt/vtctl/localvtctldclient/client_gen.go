@@ -24,6 +24,7 @@ import (
 	"google.golang.org/grpc"
 
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
 )
 
 // AddCellInfo is part of the vtctlservicepb.VtctldClient interface.
@@ -46,16 +47,31 @@ func (client *localVtctldClient) ApplyVSchema(ctx context.Context, in *vtctldata
 	return client.s.ApplyVSchema(ctx, in)
 }
 
+// CancelWorkflow is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) CancelWorkflow(ctx context.Context, in *vtctldatapb.CancelWorkflowRequest, opts ...grpc.CallOption) (*vtctldatapb.CancelWorkflowResponse, error) {
+	return client.s.CancelWorkflow(ctx, in)
+}
+
 // ChangeTabletType is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) ChangeTabletType(ctx context.Context, in *vtctldatapb.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldatapb.ChangeTabletTypeResponse, error) {
 	return client.s.ChangeTabletType(ctx, in)
 }
 
+// CompleteWorkflow is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) CompleteWorkflow(ctx context.Context, in *vtctldatapb.CompleteWorkflowRequest, opts ...grpc.CallOption) (*vtctldatapb.CompleteWorkflowResponse, error) {
+	return client.s.CompleteWorkflow(ctx, in)
+}
+
 // CreateKeyspace is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) CreateKeyspace(ctx context.Context, in *vtctldatapb.CreateKeyspaceRequest, opts ...grpc.CallOption) (*vtctldatapb.CreateKeyspaceResponse, error) {
 	return client.s.CreateKeyspace(ctx, in)
 }
 
+// CreateLookupVindex is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) CreateLookupVindex(ctx context.Context, in *vtctldatapb.CreateLookupVindexRequest, opts ...grpc.CallOption) (*vtctldatapb.CreateLookupVindexResponse, error) {
+	return client.s.CreateLookupVindex(ctx, in)
+}
+
 // CreateShard is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) CreateShard(ctx context.Context, in *vtctldatapb.CreateShardRequest, opts ...grpc.CallOption) (*vtctldatapb.CreateShardResponse, error) {
 	return client.s.CreateShard(ctx, in)
@@ -196,6 +212,16 @@ func (client *localVtctldClient) InitShardPrimary(ctx context.Context, in *vtctl
 	return client.s.InitShardPrimary(ctx, in)
 }
 
+// Materialize is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) Materialize(ctx context.Context, in *vtctldatapb.MaterializeRequest, opts ...grpc.CallOption) (*vtctldatapb.MaterializeResponse, error) {
+	return client.s.Materialize(ctx, in)
+}
+
+// MoveTables is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) MoveTables(ctx context.Context, in *vtctldatapb.MoveTablesRequest, opts ...grpc.CallOption) (*vtctldatapb.MoveTablesResponse, error) {
+	return client.s.MoveTables(ctx, in)
+}
+
 // PingTablet is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) PingTablet(ctx context.Context, in *vtctldatapb.PingTabletRequest, opts ...grpc.CallOption) (*vtctldatapb.PingTabletResponse, error) {
 	return client.s.PingTablet(ctx, in)
@@ -256,6 +282,62 @@ func (client *localVtctldClient) ReparentTablet(ctx context.Context, in *vtctlda
 	return client.s.ReparentTablet(ctx, in)
 }
 
+type restoreFromBackupStreamAdapter struct {
+	*bidiStream
+	ch chan *vtctldatapb.RestoreFromBackupResponse
+}
+
+func (stream *restoreFromBackupStreamAdapter) Recv() (*vtctldatapb.RestoreFromBackupResponse, error) {
+	select {
+	case <-stream.ctx.Done():
+		return nil, stream.ctx.Err()
+	case err := <-stream.errch:
+		return nil, err
+	case msg := <-stream.ch:
+		return msg, nil
+	}
+}
+
+func (stream *restoreFromBackupStreamAdapter) Send(msg *vtctldatapb.RestoreFromBackupResponse) error {
+	stream.m.RLock()
+	defer stream.m.RUnlock()
+
+	if stream.sendClosed {
+		return errStreamClosed
+	}
+
+	select {
+	case <-stream.ctx.Done():
+		return stream.ctx.Err()
+	case stream.ch <- msg:
+		return nil
+	}
+}
+
+// Reshard is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) Reshard(ctx context.Context, in *vtctldatapb.ReshardRequest, opts ...grpc.CallOption) (*vtctldatapb.ReshardResponse, error) {
+	return client.s.Reshard(ctx, in)
+}
+
+// RestoreFromBackup is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) RestoreFromBackup(ctx context.Context, in *vtctldatapb.RestoreFromBackupRequest, opts ...grpc.CallOption) (vtctlservicepb.Vtctld_RestoreFromBackupClient, error) {
+	stream := &restoreFromBackupStreamAdapter{
+		bidiStream: newBidiStream(ctx),
+		ch:         make(chan *vtctldatapb.RestoreFromBackupResponse, 1),
+	}
+	go func() {
+		err := client.s.RestoreFromBackup(in, stream)
+		stream.close(err)
+	}()
+
+	return stream, nil
+}
+
+// ReverseTraffic is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) ReverseTraffic(ctx context.Context, in *vtctldatapb.ReverseTrafficRequest, opts ...grpc.CallOption) (*vtctldatapb.ReverseTrafficResponse, error) {
+	return client.s.ReverseTraffic(ctx, in)
+}
+
 // RunHealthCheck is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) RunHealthCheck(ctx context.Context, in *vtctldatapb.RunHealthCheckRequest, opts ...grpc.CallOption) (*vtctldatapb.RunHealthCheckResponse, error) {
 	return client.s.RunHealthCheck(ctx, in)
@@ -271,6 +353,11 @@ func (client *localVtctldClient) SetKeyspaceShardingInfo(ctx context.Context, in
 	return client.s.SetKeyspaceShardingInfo(ctx, in)
 }
 
+// SetKeyspaceThrottlerConfig is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) SetKeyspaceThrottlerConfig(ctx context.Context, in *vtctldatapb.SetKeyspaceThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldatapb.SetKeyspaceThrottlerConfigResponse, error) {
+	return client.s.SetKeyspaceThrottlerConfig(ctx, in)
+}
+
 // SetShardIsPrimaryServing is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) SetShardIsPrimaryServing(ctx context.Context, in *vtctldatapb.SetShardIsPrimaryServingRequest, opts ...grpc.CallOption) (*vtctldatapb.SetShardIsPrimaryServingResponse, error) {
 	return client.s.SetShardIsPrimaryServing(ctx, in)
@@ -306,6 +393,11 @@ func (client *localVtctldClient) StopReplication(ctx context.Context, in *vtctld
 	return client.s.StopReplication(ctx, in)
 }
 
+// SwitchTraffic is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) SwitchTraffic(ctx context.Context, in *vtctldatapb.SwitchTrafficRequest, opts ...grpc.CallOption) (*vtctldatapb.SwitchTrafficResponse, error) {
+	return client.s.SwitchTraffic(ctx, in)
+}
+
 // TabletExternallyReparented is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) TabletExternallyReparented(ctx context.Context, in *vtctldatapb.TabletExternallyReparentedRequest, opts ...grpc.CallOption) (*vtctldatapb.TabletExternallyReparentedResponse, error) {
 	return client.s.TabletExternallyReparented(ctx, in)
@@ -321,6 +413,11 @@ func (client *localVtctldClient) UpdateCellsAlias(ctx context.Context, in *vtctl
 	return client.s.UpdateCellsAlias(ctx, in)
 }
 
+// VDiff is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) VDiff(ctx context.Context, in *vtctldatapb.VDiffRequest, opts ...grpc.CallOption) (*vtctldatapb.VDiffResponse, error) {
+	return client.s.VDiff(ctx, in)
+}
+
 // Validate is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) Validate(ctx context.Context, in *vtctldatapb.ValidateRequest, opts ...grpc.CallOption) (*vtctldatapb.ValidateResponse, error) {
 	return client.s.Validate(ctx, in)
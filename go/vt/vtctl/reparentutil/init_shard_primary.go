@@ -0,0 +1,236 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reparentutil
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/event"
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/topotools/events"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+const (
+	initShardPrimaryOperation = "InitShardPrimary"
+)
+
+// InitShardPrimaryLocked is the main work of doing an InitShardPrimary. It
+// should only be called by callers that have already locked the shard in the
+// topo.
+func InitShardPrimaryLocked(
+	ctx context.Context,
+	ts *topo.Server,
+	tmc tmclient.TabletManagerClient,
+	logger logutil.Logger,
+	ev *events.Reparent,
+	req *vtctldatapb.InitShardPrimaryRequest,
+	waitReplicasTimeout time.Duration,
+) error {
+	shardInfo, err := ts.GetShard(ctx, req.Keyspace, req.Shard)
+	if err != nil {
+		return err
+	}
+	ev.ShardInfo = *shardInfo
+
+	event.DispatchUpdate(ev, "reading tablet map")
+	tabletMap, err := ts.GetTabletMapForShard(ctx, req.Keyspace, req.Shard)
+	if err != nil {
+		return err
+	}
+
+	// Check the primary elect is in tabletMap.
+	primaryElectTabletAliasStr := topoproto.TabletAliasString(req.PrimaryElectTabletAlias)
+	primaryElectTabletInfo, ok := tabletMap[primaryElectTabletAliasStr]
+	if !ok {
+		return fmt.Errorf("primary-elect tablet %v is not in the shard", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
+	}
+	ev.NewPrimary = proto.Clone(primaryElectTabletInfo.Tablet).(*topodatapb.Tablet)
+
+	// Check the primary is the only primary is the shard, or -force was used.
+	_, primaryTabletMap := topotools.SortedTabletMap(tabletMap)
+	if !topoproto.TabletAliasEqual(shardInfo.PrimaryAlias, req.PrimaryElectTabletAlias) {
+		if !req.Force {
+			return fmt.Errorf("primary-elect tablet %v is not the shard primary, use -force to proceed anyway", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
+		}
+
+		logger.Warningf("primary-elect tablet %v is not the shard primary, proceeding anyway as -force was used", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
+	}
+	if _, ok := primaryTabletMap[primaryElectTabletAliasStr]; !ok {
+		if !req.Force {
+			return fmt.Errorf("primary-elect tablet %v is not a primary in the shard, use -force to proceed anyway", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
+		}
+		logger.Warningf("primary-elect tablet %v is not a primary in the shard, proceeding anyway as -force was used", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
+	}
+	haveOtherPrimary := false
+	for alias := range primaryTabletMap {
+		if primaryElectTabletAliasStr != alias {
+			haveOtherPrimary = true
+		}
+	}
+	if haveOtherPrimary {
+		if !req.Force {
+			return fmt.Errorf("primary-elect tablet %v is not the only primary in the shard, use -force to proceed anyway", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
+		}
+		logger.Warningf("primary-elect tablet %v is not the only primary in the shard, proceeding anyway as -force was used", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
+	}
+
+	// First phase: reset replication on all tablets. If anyone fails,
+	// we stop. It is probably because it is unreachable, and may leave
+	// an unstable database process in the mix, with a database daemon
+	// at a wrong replication spot.
+
+	// Create a context for the following RPCs that respects waitReplicasTimeout
+	resetCtx, resetCancel := context.WithTimeout(ctx, waitReplicasTimeout)
+	defer resetCancel()
+
+	event.DispatchUpdate(ev, "resetting replication on all tablets")
+	wg := sync.WaitGroup{}
+	rec := concurrency.AllErrorRecorder{}
+	for alias, tabletInfo := range tabletMap {
+		wg.Add(1)
+		go func(alias string, tabletInfo *topo.TabletInfo) {
+			defer wg.Done()
+			logger.Infof("resetting replication on tablet %v", alias)
+			if err := tmc.ResetReplication(resetCtx, tabletInfo.Tablet); err != nil {
+				rec.RecordError(fmt.Errorf("tablet %v ResetReplication failed (either fix it, or Scrap it): %v", alias, err))
+			}
+		}(alias, tabletInfo)
+	}
+	wg.Wait()
+	if err := rec.Error(); err != nil {
+		// if any of the replicas failed
+		return err
+	}
+
+	// Check we still have the topology lock.
+	if err := topo.CheckShardLocked(ctx, req.Keyspace, req.Shard); err != nil {
+		return fmt.Errorf("lost topology lock, aborting: %v", err)
+	}
+
+	// Tell the new primary to break its replicas, return its replication
+	// position
+	logger.Infof("initializing primary on %v", topoproto.TabletAliasString(req.PrimaryElectTabletAlias))
+	event.DispatchUpdate(ev, "initializing primary")
+	rp, err := tmc.InitPrimary(ctx, primaryElectTabletInfo.Tablet)
+	if err != nil {
+		return err
+	}
+
+	// Check we stil have the topology lock.
+	if err := topo.CheckShardLocked(ctx, req.Keyspace, req.Shard); err != nil {
+		return fmt.Errorf("lost topology lock, aborting: %v", err)
+	}
+
+	// Create a cancelable context for the following RPCs.
+	// If error conditions happen, we can cancel all outgoing RPCs.
+	replCtx, replCancel := context.WithTimeout(ctx, waitReplicasTimeout)
+	defer replCancel()
+
+	// Now tell the new primary to insert the reparent_journal row,
+	// and tell everybody else to become a replica of the new primary,
+	// and wait for the row in the reparent_journal table.
+	// We start all these in parallel, to handle the semi-sync
+	// case: for the primary to be able to commit its row in the
+	// reparent_journal table, it needs connected replicas.
+	event.DispatchUpdate(ev, "reparenting all tablets")
+	now := time.Now().UnixNano()
+	wgPrimary := sync.WaitGroup{}
+	wgReplicas := sync.WaitGroup{}
+	var primaryErr error
+	for alias, tabletInfo := range tabletMap {
+		if alias == primaryElectTabletAliasStr {
+			wgPrimary.Add(1)
+			go func(alias string, tabletInfo *topo.TabletInfo) {
+				defer wgPrimary.Done()
+				logger.Infof("populating reparent journal on new primary %v", alias)
+				primaryErr = tmc.PopulateReparentJournal(replCtx, tabletInfo.Tablet, now,
+					initShardPrimaryOperation,
+					req.PrimaryElectTabletAlias, rp)
+			}(alias, tabletInfo)
+		} else {
+			wgReplicas.Add(1)
+			go func(alias string, tabletInfo *topo.TabletInfo) {
+				defer wgReplicas.Done()
+				logger.Infof("initializing replica %v", alias)
+				if err := tmc.InitReplica(replCtx, tabletInfo.Tablet, req.PrimaryElectTabletAlias, rp, now); err != nil {
+					rec.RecordError(fmt.Errorf("tablet %v InitReplica failed: %v", alias, err))
+				}
+			}(alias, tabletInfo)
+		}
+	}
+
+	// After the primary is done, we can update the shard record
+	// (note with semi-sync, it also means at least one replica is done).
+	wgPrimary.Wait()
+	if primaryErr != nil {
+		// The primary failed, there is no way the
+		// replicas will work.  So we cancel them all.
+		logger.Warningf("primary failed to PopulateReparentJournal, canceling replicas")
+		replCancel()
+		wgReplicas.Wait()
+		return fmt.Errorf("failed to PopulateReparentJournal on primary: %v", primaryErr)
+	}
+	if !topoproto.TabletAliasEqual(shardInfo.PrimaryAlias, req.PrimaryElectTabletAlias) {
+		if _, err := ts.UpdateShardFields(ctx, req.Keyspace, req.Shard, func(si *topo.ShardInfo) error {
+			si.PrimaryAlias = req.PrimaryElectTabletAlias
+			return nil
+		}); err != nil {
+			wgReplicas.Wait()
+			return fmt.Errorf("failed to update shard primary record: %v", err)
+		}
+	}
+
+	// Wait for the replicas to complete. If some of them fail, we
+	// don't want to rebuild the shard serving graph (the failure
+	// will most likely be a timeout, and our context will be
+	// expired, so the rebuild will fail anyway)
+	wgReplicas.Wait()
+	if err := rec.Error(); err != nil {
+		return err
+	}
+
+	// Create database if necessary on the primary. replicas will get it too through
+	// replication. Since the user called InitShardPrimary, they've told us to
+	// assume that whatever data is on all the replicas is what they intended.
+	// If the database doesn't exist, it means the user intends for these tablets
+	// to begin serving with no data (i.e. first time initialization).
+	createDB := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", sqlescape.EscapeID(topoproto.TabletDbName(primaryElectTabletInfo.Tablet)))
+	if _, err := tmc.ExecuteFetchAsDba(ctx, primaryElectTabletInfo.Tablet, false, []byte(createDB), 1, false, true); err != nil {
+		return fmt.Errorf("failed to create database: %v", err)
+	}
+	// Refresh the state to force the tabletserver to reconnect after db has been created.
+	if err := tmc.RefreshState(ctx, primaryElectTabletInfo.Tablet); err != nil {
+		log.Warningf("RefreshState failed: %v", err)
+	}
+
+	return nil
+}
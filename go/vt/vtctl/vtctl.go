@@ -433,6 +433,12 @@ var commands = []commandGroup{
 				params: "[-source=<source keyspace name>] [-remove] [-cells=c1,c2,...] <keyspace name> <tablet type>",
 				help:   "Changes the ServedFromMap manually. This command is intended for emergency fixes. This field is automatically set when you call the *MigrateServedFrom* command. This command does not rebuild the serving graph.",
 			},
+			{
+				name:   "SetKeyspaceThrottlerConfig",
+				method: commandSetKeyspaceThrottlerConfig,
+				params: "[-clear] <keyspace name> [<throttler config json>]",
+				help:   "Updates the throttler config of a keyspace, which selects the metrics that its tablet throttlers check each app against (lag, threads_running, history_list_length, loadavg, datadir_used_ratio, semi_sync_ack_latency, custom), and their thresholds. Example: '{\"metric_thresholds\": {\"lag\": 5}, \"app_configs\": {\"tablegc\": {\"metrics\": [\"lag\", \"loadavg\"], \"metric_thresholds\": {\"loadavg\": 2}}}}'",
+			},
 			{
 				name:   "RebuildKeyspaceGraph",
 				method: commandRebuildKeyspaceGraph,
@@ -448,7 +454,7 @@ var commands = []commandGroup{
 			{
 				name:   "Reshard",
 				method: commandReshard,
-				params: "[-source_shards=<source_shards>] [-target_shards=<target_shards>] [-cells=<cells>] [-tablet_types=<source_tablet_types>]  [-skip_schema_copy] <action> 'action must be one of the following: Create, Complete, Cancel, SwitchTraffic, ReverseTrafffic, Show, or Progress' <keyspace.workflow>",
+				params: "[-source_shards=<source_shards>] [-target_shards=<target_shards>] [-cells=<cells>] [-tablet_types=<source_tablet_types>]  [-skip_schema_copy] [-seed_from_backup] <action> 'action must be one of the following: Create, Complete, Cancel, SwitchTraffic, ReverseTrafffic, Show, or Progress' <keyspace.workflow>",
 				help:   "Start a Resharding process. Example: Reshard -cells='zone1,alias1' -tablet_types='primary,replica,rdonly'  ks.workflow001 '0' '-80,80-'",
 			},
 			{
@@ -504,8 +510,8 @@ var commands = []commandGroup{
 			{
 				name:   "VDiff",
 				method: commandVDiff,
-				params: "[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=primary,replica,rdonly] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
-				help:   "Perform a diff of all tables in the workflow",
+				params: "[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=primary,replica,rdonly] [-filtered_replication_wait_time=30s] <keyspace.workflow> [create|show|stop|resume|delete [<uuid>|last|all]]",
+				help:   "Perform a diff of all tables in the workflow. Without an action, the diff runs in vtctl. With an action, the diff runs on the primaries of the target shards: create starts it, show reports its progress and differences, and stop, resume and delete manage it. show, stop, resume and delete apply to the vdiff with the given uuid, to the last one, or to all of them.",
 			},
 			{
 				name:   "MigrateServedTypes",
@@ -2116,6 +2122,30 @@ func commandSetKeyspaceShardingInfo(ctx context.Context, wr *wrangler.Wrangler,
 	return wr.SetKeyspaceShardingInfo(ctx, keyspace, columnName, kit, *force)
 }
 
+func commandSetKeyspaceThrottlerConfig(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	clearConfig := subFlags.Bool("clear", false, "Clears the throttler config of the keyspace, so that its tablets use their defaults")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	throttlerConfig := &topodatapb.ThrottlerConfig{}
+	switch {
+	case *clearConfig && subFlags.NArg() == 1:
+		// An empty config clears the throttler config.
+	case !*clearConfig && subFlags.NArg() == 2:
+		if err := json2.Unmarshal([]byte(subFlags.Arg(1)), throttlerConfig); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("the <keyspace name> argument, and exactly one of -clear and <throttler config json>, are required for the SetKeyspaceThrottlerConfig command")
+	}
+
+	_, err := wr.VtctldServer().SetKeyspaceThrottlerConfig(ctx, &vtctldatapb.SetKeyspaceThrottlerConfigRequest{
+		Keyspace:        subFlags.Arg(0),
+		ThrottlerConfig: throttlerConfig,
+	})
+	return err
+}
+
 func commandSetKeyspaceServedFrom(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	source := subFlags.String("source", "", "Specifies the source keyspace name")
 	remove := subFlags.Bool("remove", false, "Indicates whether to add (default) or remove the served from record")
@@ -2328,6 +2358,7 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 	sourceShards := subFlags.String("source_shards", "", "Reshard only. Source shards")
 	targetShards := subFlags.String("target_shards", "", "Reshard only. Target shards")
 	skipSchemaCopy := subFlags.Bool("skip_schema_copy", false, "Reshard only. Skip copying of schema to target shards")
+	seedFromBackup := subFlags.Bool("seed_from_backup", false, "Reshard only. Restore the target shards from the latest backup of their source shard instead of copying the rows. The target shards must not have a primary.")
 
 	_ = subFlags.Bool("v1", false, "Enables usage of v1 command structure. (default false). Must be added to run the command with -workflow")
 	_ = subFlags.Bool("v2", true, "")
@@ -2451,6 +2482,7 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 			vrwp.SourceShards = strings.Split(*sourceShards, ",")
 			vrwp.TargetShards = strings.Split(*targetShards, ",")
 			vrwp.SkipSchemaCopy = *skipSchemaCopy
+			vrwp.SeedFromBackup = *seedFromBackup
 			vrwp.SourceKeyspace = target
 		default:
 			return fmt.Errorf("unknown workflow type passed: %v", workflowType)
@@ -2726,8 +2758,8 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 		return err
 	}
 
-	if subFlags.NArg() != 1 {
-		return fmt.Errorf("<keyspace.workflow> is required")
+	if subFlags.NArg() < 1 || subFlags.NArg() > 3 {
+		return fmt.Errorf("<keyspace.workflow> is required, optionally followed by an action and its argument")
 	}
 	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
 	if err != nil {
@@ -2736,6 +2768,21 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	if *maxRows <= 0 {
 		return fmt.Errorf("maximum number of rows to compare needs to be greater than 0")
 	}
+	if subFlags.NArg() > 1 {
+		options := &tabletmanagerdatapb.VDiffOptions{
+			Tables:                             *tables,
+			SourceCell:                         *sourceCell,
+			TabletTypes:                        *tabletTypes,
+			FilteredReplicationWaitTimeSeconds: int64(filteredReplicationWaitTime.Seconds()),
+			OnlyPks:                            *onlyPks,
+			DebugQuery:                         *debugQuery,
+		}
+		if *maxRows != math.MaxInt64 {
+			options.MaxRows = *maxRows
+		}
+		_, err = wr.VDiff2(ctx, keyspace, workflow, subFlags.Arg(1), subFlags.Arg(2), *format, options)
+		return err
+	}
 	_, err = wr.
 		VDiff(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime, *format, *maxRows, *tables, *debugQuery, *onlyPks)
 	if err != nil {
@@ -0,0 +1,417 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/netutil"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// throttlerCheckTimeout is the timeout of the throttler checks of the
+// cutover preconditions.
+const throttlerCheckTimeout = 5 * time.Second
+
+// cutoverVDiffMaxAge is how long ago the last vdiff of a workflow can have
+// completed for a cutover that requires a vdiff.
+const cutoverVDiffMaxAge = 24 * time.Hour
+
+// maxCutoverAttempts is the number of attempts a cutover keeps in its audit
+// trail, and the maximum of its max_attempts. The older attempts are dropped
+// so that a cutover that keeps failing doesn't grow its topo record forever.
+const maxCutoverAttempts = 100
+
+// isScheduledCutover returns true if a SwitchTraffic request must be stored
+// as a cutover instead of switching the traffic right away.
+func isScheduledCutover(req *vtctldatapb.SwitchTrafficRequest) bool {
+	return req.Schedule != nil || req.Preconditions != nil
+}
+
+func validateCutover(req *vtctldatapb.SwitchTrafficRequest, now time.Time) error {
+	if req.DryRun {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "a scheduled cutover cannot be a dry run")
+	}
+
+	if schedule := req.Schedule; schedule != nil {
+		if schedule.NotAfter != nil {
+			notAfter := protoutil.TimeFromProto(schedule.NotAfter)
+			if !notAfter.After(now) {
+				return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the cutover window ended at %v", notAfter.UTC())
+			}
+			if schedule.NotBefore != nil && !notAfter.After(protoutil.TimeFromProto(schedule.NotBefore)) {
+				return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the cutover window ends before it starts")
+			}
+		}
+		if _, _, err := protoutil.DurationFromProto(schedule.RetryInterval); err != nil {
+			return vterrors.Wrapf(err, "invalid retry_interval")
+		}
+		if schedule.MaxAttempts < 0 {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "max_attempts cannot be negative")
+		}
+		if schedule.MaxAttempts > maxCutoverAttempts {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "max_attempts cannot be more than %d", maxCutoverAttempts)
+		}
+	}
+
+	if preconditions := req.Preconditions; preconditions != nil {
+		if _, _, err := protoutil.DurationFromProto(preconditions.MaxReplicationLag); err != nil {
+			return vterrors.Wrapf(err, "invalid max_replication_lag")
+		}
+	}
+
+	return nil
+}
+
+// scheduleCutover stores a SwitchTraffic request with a schedule or
+// preconditions in the topo, replacing the previous cutover of the workflow.
+func (s *Server) scheduleCutover(ctx context.Context, req *vtctldatapb.SwitchTrafficRequest) (*vtctldatapb.SwitchTrafficResponse, error) {
+	now := time.Now()
+	if err := validateCutover(req, now); err != nil {
+		return nil, err
+	}
+
+	cutover := &vtctldatapb.WorkflowCutover{
+		Request:   req,
+		State:     vtctldatapb.WorkflowCutover_PENDING,
+		CreatedAt: protoutil.TimeToProto(now),
+	}
+	if err := s.ts.SaveWorkflowCutover(ctx, req.Keyspace, req.Workflow, cutover); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Scheduled the cutover of workflow %s.%s", req.Keyspace, req.Workflow)
+	return &vtctldatapb.SwitchTrafficResponse{Cutover: cutover}, nil
+}
+
+// RunCutovers attempts the pending cutovers of all the keyspaces whose
+// window is open. Every attempt is recorded in the cutover, whether it
+// switched the traffic or not.
+func (s *Server) RunCutovers(ctx context.Context) error {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.RunCutovers")
+	defer span.Finish()
+
+	keyspaces, err := s.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, keyspace := range keyspaces {
+		workflows, err := s.ts.GetWorkflowCutoverNames(ctx, keyspace)
+		if err != nil {
+			log.Errorf("Failed to list the workflow cutovers of keyspace %s: %v", keyspace, err)
+			continue
+		}
+
+		for _, workflow := range workflows {
+			if err := s.runCutover(ctx, keyspace, workflow); err != nil {
+				log.Errorf("Failed to run the cutover of workflow %s.%s: %v", keyspace, workflow, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) runCutover(ctx context.Context, keyspace, workflow string) error {
+	wci, err := s.getPendingCutover(ctx, keyspace, workflow)
+	if wci == nil || err != nil {
+		return err
+	}
+
+	// The vdiff is checked before the cutover is locked, since the target
+	// primaries may take a while to answer.
+	var (
+		checkedVDiff bool
+		vdiffErr     error
+	)
+	if wci.Request.Preconditions.GetRequireVdiff() && isCutoverDue(wci, time.Now()) {
+		checkedVDiff = true
+		vdiffErr = s.checkCutoverVDiff(ctx, keyspace, workflow, time.Now())
+	}
+
+	lockDescriptor, err := s.ts.LockWorkflowCutover(ctx, keyspace, workflow, "vtctld.RunCutovers")
+	if err != nil {
+		return err
+	}
+	defer lockDescriptor.Unlock(ctx)
+
+	wci, err = s.getPendingCutover(ctx, keyspace, workflow)
+	if wci == nil || err != nil {
+		return err
+	}
+
+	now := time.Now()
+	schedule := wci.Request.Schedule
+	if schedule == nil {
+		schedule = &vtctldatapb.CutoverSchedule{}
+	}
+
+	if schedule.NotAfter != nil && !now.Before(protoutil.TimeFromProto(schedule.NotAfter)) {
+		wci.State = vtctldatapb.WorkflowCutover_EXPIRED
+		wci.Attempts = append(wci.Attempts, &vtctldatapb.WorkflowCutover_Attempt{
+			Time:    protoutil.TimeToProto(now),
+			Message: "the cutover window ended",
+		})
+		log.Warningf("The cutover window of workflow %s.%s ended", keyspace, workflow)
+		return s.ts.UpdateWorkflowCutover(ctx, wci)
+	}
+	if !isCutoverDue(wci, now) {
+		return nil
+	}
+	if wci.Request.Preconditions.GetRequireVdiff() && !checkedVDiff {
+		// The cutover became due or was replaced since its vdiff was
+		// checked, it is attempted on the next run.
+		return nil
+	}
+
+	attempt := &vtctldatapb.WorkflowCutover_Attempt{Time: protoutil.TimeToProto(now)}
+	resp, err := s.attemptCutover(ctx, wci.Request, vdiffErr)
+	switch {
+	case err != nil:
+		attempt.Message = err.Error()
+		log.Warningf("The cutover of workflow %s.%s was not done: %v", keyspace, workflow, err)
+	default:
+		attempt.Switched = true
+		attempt.Message = resp.CurrentState
+		wci.State = vtctldatapb.WorkflowCutover_COMPLETED
+		log.Infof("The cutover of workflow %s.%s is done: %s", keyspace, workflow, resp.CurrentState)
+	}
+
+	wci.Attempts = append(wci.Attempts, attempt)
+	if wci.State == vtctldatapb.WorkflowCutover_PENDING && schedule.MaxAttempts > 0 && len(wci.Attempts) >= int(schedule.MaxAttempts) {
+		wci.State = vtctldatapb.WorkflowCutover_EXPIRED
+		log.Warningf("The cutover of workflow %s.%s reached its maximum number of attempts", keyspace, workflow)
+	}
+	if n := len(wci.Attempts); n > maxCutoverAttempts {
+		wci.Attempts = wci.Attempts[n-maxCutoverAttempts:]
+	}
+
+	return s.ts.UpdateWorkflowCutover(ctx, wci)
+}
+
+// getPendingCutover returns the cutover of a workflow if it is pending, and
+// nil otherwise.
+func (s *Server) getPendingCutover(ctx context.Context, keyspace, workflow string) (*topo.WorkflowCutoverInfo, error) {
+	wci, err := s.ts.GetWorkflowCutover(ctx, keyspace, workflow)
+	if err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			// Just some other entry in this path, or a cutover that was
+			// deleted meanwhile.
+			return nil, nil
+		}
+		return nil, err
+	}
+	if wci.State != vtctldatapb.WorkflowCutover_PENDING {
+		return nil, nil
+	}
+	return wci, nil
+}
+
+// isCutoverDue returns true if the window of a cutover is open, and its retry
+// interval elapsed since its last attempt.
+func isCutoverDue(wci *topo.WorkflowCutoverInfo, now time.Time) bool {
+	schedule := wci.Request.Schedule
+	if schedule == nil {
+		return true
+	}
+	if schedule.NotBefore != nil && now.Before(protoutil.TimeFromProto(schedule.NotBefore)) {
+		return false
+	}
+	if schedule.NotAfter != nil && !now.Before(protoutil.TimeFromProto(schedule.NotAfter)) {
+		return false
+	}
+	if n := len(wci.Attempts); n > 0 {
+		retryInterval, _, _ := protoutil.DurationFromProto(schedule.RetryInterval)
+		if now.Before(protoutil.TimeFromProto(wci.Attempts[n-1].Time).Add(retryInterval)) {
+			return false
+		}
+	}
+	return true
+}
+
+// attemptCutover checks the preconditions of a cutover, and switches the
+// traffic if they all hold. vdiffErr is the result of the vdiff check, which
+// is done beforehand.
+func (s *Server) attemptCutover(ctx context.Context, req *vtctldatapb.SwitchTrafficRequest, vdiffErr error) (*vtctldatapb.SwitchTrafficResponse, error) {
+	if err := s.checkCutoverPreconditions(ctx, req, vdiffErr); err != nil {
+		return nil, err
+	}
+
+	switchReq := proto.Clone(req).(*vtctldatapb.SwitchTrafficRequest)
+	switchReq.Schedule = nil
+	switchReq.Preconditions = nil
+	return s.switchTraffic(ctx, switchReq, DirectionForward)
+}
+
+func (s *Server) checkCutoverPreconditions(ctx context.Context, req *vtctldatapb.SwitchTrafficRequest, vdiffErr error) error {
+	preconditions := req.Preconditions
+	if preconditions == nil {
+		return nil
+	}
+
+	maxLag, checkLag, err := protoutil.DurationFromProto(preconditions.MaxReplicationLag)
+	if err != nil {
+		return err
+	}
+
+	if checkLag || preconditions.CheckThrottler {
+		resp, err := s.GetWorkflows(ctx, &vtctldatapb.GetWorkflowsRequest{Keyspace: req.Keyspace})
+		if err != nil {
+			return err
+		}
+
+		var workflow *vtctldatapb.Workflow
+		for _, wf := range resp.Workflows {
+			if wf.Name == req.Workflow {
+				workflow = wf
+				break
+			}
+		}
+		if workflow == nil {
+			return fmt.Errorf("workflow %s.%s does not exist", req.Keyspace, req.Workflow)
+		}
+
+		if lag := time.Duration(workflow.MaxVReplicationLag) * time.Second; checkLag && lag > maxLag {
+			return fmt.Errorf("replication lag %v exceeds the maximum of %v", lag, maxLag)
+		}
+
+		if preconditions.CheckThrottler {
+			if err := s.checkTargetThrottlers(ctx, workflow.Target); err != nil {
+				return err
+			}
+		}
+	}
+
+	if preconditions.RequireVdiff && vdiffErr != nil {
+		return vdiffErr
+	}
+
+	return nil
+}
+
+// checkCutoverVDiff checks that the last vdiff of a workflow completed on all
+// the target shards less than cutoverVDiffMaxAge ago, without finding any
+// difference. It doesn't start a vdiff: the vdiff has to be run beforehand.
+func (s *Server) checkCutoverVDiff(ctx context.Context, keyspace, workflow string, now time.Time) error {
+	summary, err := s.showVDiff(ctx, keyspace, workflow, tabletvdiff.LastActionArg)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot get the last vdiff")
+	}
+	if summary == nil {
+		return fmt.Errorf("no vdiff found for workflow %s.%s", keyspace, workflow)
+	}
+	if summary.state != tabletvdiff.CompletedState {
+		return fmt.Errorf("vdiff %s is %s, not %s", summary.uuid, summary.state, tabletvdiff.CompletedState)
+	}
+
+	tables := make([]string, 0, len(summary.reports))
+	for table := range summary.reports {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		if dr := summary.reports[table]; dr.HasDifferences() {
+			return fmt.Errorf("vdiff %s found differences in table %s: %d mismatched rows, %d extra rows on the source, %d extra rows on the target",
+				summary.uuid, table, dr.MismatchedRows, dr.ExtraRowsSource, dr.ExtraRowsTarget)
+		}
+	}
+
+	if age := now.Sub(summary.completedAt); age > cutoverVDiffMaxAge {
+		return fmt.Errorf("vdiff %s completed %v ago, more than the maximum of %v", summary.uuid, age.Truncate(time.Second), cutoverVDiffMaxAge)
+	}
+
+	return nil
+}
+
+// checkTargetThrottlers checks the tablet throttler of the primaries of the
+// target shards of a workflow.
+func (s *Server) checkTargetThrottlers(ctx context.Context, target *vtctldatapb.Workflow_ReplicationLocation) error {
+	for _, shard := range target.Shards {
+		si, err := s.ts.GetShard(ctx, target.Keyspace, shard)
+		if err != nil {
+			return err
+		}
+		if si.PrimaryAlias == nil {
+			return fmt.Errorf("shard %s/%s has no primary", target.Keyspace, shard)
+		}
+
+		tablet, err := s.ts.GetTablet(ctx, si.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		if err := checkTabletThrottler(ctx, tablet.Tablet); err != nil {
+			return fmt.Errorf("throttler of tablet %s is not healthy: %w", topoproto.TabletAliasString(tablet.Alias), err)
+		}
+	}
+
+	return nil
+}
+
+func checkTabletThrottler(ctx context.Context, tablet *topodatapb.Tablet) error {
+	ctx, cancel := context.WithTimeout(ctx, throttlerCheckTimeout)
+	defer cancel()
+
+	url := fmt.Sprintf("http://%s/throttler/check?app=vtctld", netutil.JoinHostPort(tablet.Hostname, tablet.PortMap["vt"]))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var check struct {
+		StatusCode int
+		Value      float64
+		Threshold  float64
+		Message    string
+		MetricName string
+	}
+	if err := json.Unmarshal(body, &check); err != nil {
+		return err
+	}
+	if check.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: metric %s, value %v, threshold %v, %s", http.StatusText(check.StatusCode), check.MetricName, check.Value, check.Threshold, check.Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/topo"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestScheduleCutover(t *testing.T) {
+	ctx := context.Background()
+	s, tmc := newTestWorkflowServer(t, []string{"0"}, true)
+
+	now := time.Now()
+	tests := []struct {
+		name     string
+		schedule *vtctldatapb.CutoverSchedule
+		dryRun   bool
+		err      string
+	}{
+		{
+			name:   "dry run",
+			dryRun: true,
+			err:    "a scheduled cutover cannot be a dry run",
+		},
+		{
+			name:     "window in the past",
+			schedule: &vtctldatapb.CutoverSchedule{NotAfter: protoutil.TimeToProto(now.Add(-time.Hour))},
+			err:      "the cutover window ended at",
+		},
+		{
+			name: "window ends before it starts",
+			schedule: &vtctldatapb.CutoverSchedule{
+				NotBefore: protoutil.TimeToProto(now.Add(2 * time.Hour)),
+				NotAfter:  protoutil.TimeToProto(now.Add(time.Hour)),
+			},
+			err: "the cutover window ends before it starts",
+		},
+		{
+			name:     "negative max attempts",
+			schedule: &vtctldatapb.CutoverSchedule{MaxAttempts: -1},
+			err:      "max_attempts cannot be negative",
+		},
+		{
+			name:     "too many max attempts",
+			schedule: &vtctldatapb.CutoverSchedule{MaxAttempts: maxCutoverAttempts + 1},
+			err:      "max_attempts cannot be more than 100",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.SwitchTraffic(ctx, &vtctldatapb.SwitchTrafficRequest{
+				Keyspace:      "ks",
+				Workflow:      "wf",
+				DryRun:        tt.dryRun,
+				Schedule:      tt.schedule,
+				Preconditions: &vtctldatapb.CutoverPreconditions{},
+			})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+
+	resp, err := s.SwitchTraffic(ctx, &vtctldatapb.SwitchTrafficRequest{
+		Keyspace: "ks",
+		Workflow: "wf",
+		Schedule: &vtctldatapb.CutoverSchedule{NotBefore: protoutil.TimeToProto(now.Add(time.Hour))},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, vtctldatapb.WorkflowCutover_PENDING, resp.Cutover.State)
+
+	wci, err := s.ts.GetWorkflowCutover(ctx, "ks", "wf")
+	require.NoError(t, err)
+	assert.Equal(t, "wf", wci.Request.Workflow)
+	assert.Empty(t, tmc.vdiffs["zone1-0000000100"].actions, "a scheduled cutover must not be attempted right away")
+}
+
+func TestRunCutovers(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	// setup schedules a cutover that requires a vdiff. The last vdiff of the
+	// workflow just completed, and its tables have the given reports.
+	setup := func(t *testing.T, reports map[string]*tabletvdiff.DiffReport, schedule *vtctldatapb.CutoverSchedule) (*Server, *fakeVDiff) {
+		s, tmc := newTestWorkflowServer(t, []string{"0"}, true)
+		vdiff := tmc.vdiffs["zone1-0000000100"]
+		vdiff.uuid = "a"
+		vdiff.state = tabletvdiff.CompletedState
+		vdiff.completedAt = now
+		vdiff.reports = reports
+		_, err := s.SwitchTraffic(ctx, &vtctldatapb.SwitchTrafficRequest{
+			Keyspace:      "ks",
+			Workflow:      "wf",
+			Schedule:      schedule,
+			Preconditions: &vtctldatapb.CutoverPreconditions{RequireVdiff: true},
+		})
+		require.NoError(t, err)
+		return s, vdiff
+	}
+	getCutover := func(t *testing.T, s *Server) *topo.WorkflowCutoverInfo {
+		wci, err := s.ts.GetWorkflowCutover(ctx, "ks", "wf")
+		require.NoError(t, err)
+		return wci
+	}
+	mismatch := map[string]*tabletvdiff.DiffReport{
+		"t1": {TableName: "t1", ProcessedRows: 2, MatchingRows: 1, MismatchedRows: 1},
+	}
+
+	t.Run("before the window", func(t *testing.T) {
+		s, vdiff := setup(t, mismatch, &vtctldatapb.CutoverSchedule{NotBefore: protoutil.TimeToProto(now.Add(time.Hour))})
+
+		require.NoError(t, s.RunCutovers(ctx))
+		wci := getCutover(t, s)
+		assert.Equal(t, vtctldatapb.WorkflowCutover_PENDING, wci.State)
+		assert.Empty(t, wci.Attempts)
+		assert.Empty(t, vdiff.actions)
+	})
+
+	t.Run("preconditions", func(t *testing.T) {
+		s, vdiff := setup(t, mismatch, &vtctldatapb.CutoverSchedule{NotBefore: protoutil.TimeToProto(now.Add(-time.Minute))})
+
+		require.NoError(t, s.RunCutovers(ctx))
+		wci := getCutover(t, s)
+		assert.Equal(t, vtctldatapb.WorkflowCutover_PENDING, wci.State)
+		require.Len(t, wci.Attempts, 1)
+		assert.False(t, wci.Attempts[0].Switched)
+		assert.Equal(t, "vdiff a found differences in table t1: 1 mismatched rows, 0 extra rows on the source, 0 extra rows on the target", wci.Attempts[0].Message)
+
+		vdiff.uuid = "b"
+		vdiff.state = tabletvdiff.StartedState
+		require.NoError(t, s.RunCutovers(ctx))
+		wci = getCutover(t, s)
+		require.Len(t, wci.Attempts, 2)
+		assert.Equal(t, "vdiff b is started, not completed", wci.Attempts[1].Message)
+
+		vdiff.state = tabletvdiff.CompletedState
+		vdiff.completedAt = now.Add(-25 * time.Hour)
+		vdiff.reports = map[string]*tabletvdiff.DiffReport{"t1": {TableName: "t1", ProcessedRows: 2, MatchingRows: 2}}
+		require.NoError(t, s.RunCutovers(ctx))
+		wci = getCutover(t, s)
+		require.Len(t, wci.Attempts, 3)
+		assert.Contains(t, wci.Attempts[2].Message, "vdiff b completed 25h0m")
+
+		// Once a recent vdiff matches, the cutover goes on to switch the
+		// traffic, which fails here because the source keyspace doesn't
+		// exist.
+		vdiff.completedAt = now
+		require.NoError(t, s.RunCutovers(ctx))
+		wci = getCutover(t, s)
+		assert.Equal(t, vtctldatapb.WorkflowCutover_PENDING, wci.State)
+		require.Len(t, wci.Attempts, 4)
+		assert.False(t, wci.Attempts[3].Switched)
+		assert.NotContains(t, wci.Attempts[3].Message, "vdiff")
+
+		// The cutover only reads the state of the vdiffs, it never starts one.
+		assert.NotContains(t, vdiff.actions, tabletvdiff.CreateAction)
+
+		// A completed cutover is not attempted again.
+		wci.State = vtctldatapb.WorkflowCutover_COMPLETED
+		require.NoError(t, s.ts.UpdateWorkflowCutover(ctx, wci))
+		require.NoError(t, s.RunCutovers(ctx))
+		assert.Len(t, getCutover(t, s).Attempts, 4)
+	})
+
+	t.Run("no vdiff", func(t *testing.T) {
+		s, vdiff := setup(t, nil, nil)
+		vdiff.uuid = ""
+
+		require.NoError(t, s.RunCutovers(ctx))
+		wci := getCutover(t, s)
+		require.Len(t, wci.Attempts, 1)
+		assert.Equal(t, "no vdiff found for workflow ks.wf", wci.Attempts[0].Message)
+	})
+
+	t.Run("retry interval", func(t *testing.T) {
+		s, vdiff := setup(t, mismatch, &vtctldatapb.CutoverSchedule{RetryInterval: protoutil.DurationToProto(time.Hour)})
+
+		require.NoError(t, s.RunCutovers(ctx))
+		require.NoError(t, s.RunCutovers(ctx))
+		assert.Len(t, getCutover(t, s).Attempts, 1)
+		// The vdiff isn't checked again before the retry interval elapses.
+		assert.Equal(t, []string{tabletvdiff.ShowAction}, vdiff.actions)
+	})
+
+	t.Run("max attempts", func(t *testing.T) {
+		s, _ := setup(t, mismatch, &vtctldatapb.CutoverSchedule{MaxAttempts: 2})
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, s.RunCutovers(ctx))
+		}
+		wci := getCutover(t, s)
+		assert.Equal(t, vtctldatapb.WorkflowCutover_EXPIRED, wci.State)
+		assert.Len(t, wci.Attempts, 2)
+	})
+
+	t.Run("audit trail", func(t *testing.T) {
+		s, _ := setup(t, mismatch, nil)
+		wci := getCutover(t, s)
+		for i := 0; i < maxCutoverAttempts; i++ {
+			wci.Attempts = append(wci.Attempts, &vtctldatapb.WorkflowCutover_Attempt{
+				Time:    protoutil.TimeToProto(now.Add(-time.Hour)),
+				Message: fmt.Sprintf("attempt %d", i),
+			})
+		}
+		require.NoError(t, s.ts.UpdateWorkflowCutover(ctx, wci))
+
+		// Only the last attempts are kept.
+		require.NoError(t, s.RunCutovers(ctx))
+		wci = getCutover(t, s)
+		assert.Equal(t, vtctldatapb.WorkflowCutover_PENDING, wci.State)
+		require.Len(t, wci.Attempts, maxCutoverAttempts)
+		assert.Equal(t, "attempt 1", wci.Attempts[0].Message)
+		assert.Contains(t, wci.Attempts[maxCutoverAttempts-1].Message, "vdiff a found differences")
+	})
+
+	t.Run("end of the window", func(t *testing.T) {
+		s, vdiff := setup(t, nil, &vtctldatapb.CutoverSchedule{NotAfter: protoutil.TimeToProto(time.Now().Add(50 * time.Millisecond))})
+
+		time.Sleep(100 * time.Millisecond)
+		require.NoError(t, s.RunCutovers(ctx))
+		wci := getCutover(t, s)
+		assert.Equal(t, vtctldatapb.WorkflowCutover_EXPIRED, wci.State)
+		require.Len(t, wci.Attempts, 1)
+		assert.Equal(t, "the cutover window ended", wci.Attempts[0].Message)
+		assert.Empty(t, vdiff.actions)
+	})
+}
@@ -0,0 +1,544 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// defaultWorkflowTimeout is the timeout of the traffic switches, and the
+// time vdiffs wait for the streams to catch up, if the requests don't have
+// one.
+const defaultWorkflowTimeout = 30 * time.Second
+
+// The errors of CompleteWorkflow and CancelWorkflow when the traffic of the
+// workflow is not switched accordingly.
+const (
+	errWorkflowNotFullySwitched  = "cannot complete workflow because you have not yet switched all read and write traffic"
+	errWorkflowPartiallySwitched = "cannot cancel workflow because you have already switched some or all read and write traffic"
+)
+
+// switchableTabletTypes are the tablet types whose traffic SwitchTraffic
+// switches, and the default if the request doesn't have any.
+var switchableTabletTypes = []topodatapb.TabletType{
+	topodatapb.TabletType_PRIMARY,
+	topodatapb.TabletType_REPLICA,
+	topodatapb.TabletType_RDONLY,
+}
+
+func validateKeyspaceWorkflow(keyspace, workflow string) error {
+	if keyspace == "" {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+	if workflow == "" {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "workflow field is required")
+	}
+	return nil
+}
+
+// MoveTables creates a workflow that moves tables from a source keyspace to
+// a target keyspace.
+func (s *Server) MoveTables(ctx context.Context, req *vtctldatapb.MoveTablesRequest) (*vtctldatapb.MoveTablesResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.MoveTables")
+	defer span.Finish()
+
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("source_keyspace", req.SourceKeyspace)
+	span.Annotate("target_keyspace", req.TargetKeyspace)
+
+	if err := validateKeyspaceWorkflow(req.TargetKeyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+	if req.SourceKeyspace == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "source_keyspace field is required")
+	}
+	if !req.AllTables && len(req.Tables) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "no tables specified to move")
+	}
+
+	logger := logutil.NewMemoryLogger()
+	err := s.checkNewWorkflow(ctx, logger, req.TargetKeyspace, req.Workflow)
+	if err == nil {
+		err = s.CreateMoveTablesWorkflow(ctx, logger, req.Workflow, req.SourceKeyspace, req.TargetKeyspace, strings.Join(req.Tables, ","),
+			strings.Join(req.Cells, ","), tabletTypesString(req.TabletTypes), req.AllTables, strings.Join(req.ExcludeTables, ","),
+			req.AutoStart, req.StopAfterCopy, req.ExternalCluster)
+	}
+	return &vtctldatapb.MoveTablesResponse{Events: logger.Events}, err
+}
+
+// Reshard creates a workflow that copies the data of the source shards of a
+// keyspace to its target shards.
+func (s *Server) Reshard(ctx context.Context, req *vtctldatapb.ReshardRequest) (*vtctldatapb.ReshardResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.Reshard")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if err := validateKeyspaceWorkflow(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+	if len(req.SourceShards) == 0 || len(req.TargetShards) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "source and target shards are not specified")
+	}
+
+	logger := logutil.NewMemoryLogger()
+	err := s.checkNewWorkflow(ctx, logger, req.Keyspace, req.Workflow)
+	if err == nil {
+		if err = s.validateVSchema(ctx, req.Keyspace, req.SourceShards, nil, true /* includeViews */); err != nil {
+			err = vterrors.Wrap(err, "Create ReshardWorkflow failed")
+		}
+	}
+	if err == nil {
+		// The schema of the target shards that are seeded from a backup is
+		// restored along with their rows.
+		err = s.CreateReshardWorkflow(ctx, logger, req.Keyspace, req.Workflow, req.SourceShards, req.TargetShards,
+			req.SkipSchemaCopy || req.SeedFromBackup, strings.Join(req.Cells, ","), tabletTypesString(req.TabletTypes),
+			req.AutoStart, req.StopAfterCopy, req.SeedFromBackup)
+	}
+	return &vtctldatapb.ReshardResponse{Events: logger.Events}, err
+}
+
+// Materialize creates a workflow that materializes the results of queries on
+// the tables of a source keyspace into tables of a target keyspace.
+func (s *Server) Materialize(ctx context.Context, req *vtctldatapb.MaterializeRequest) (*vtctldatapb.MaterializeResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.Materialize")
+	defer span.Finish()
+
+	ms := req.Settings
+	if ms == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "settings field is required")
+	}
+
+	span.Annotate("workflow", ms.Workflow)
+	span.Annotate("source_keyspace", ms.SourceKeyspace)
+	span.Annotate("target_keyspace", ms.TargetKeyspace)
+
+	if err := validateKeyspaceWorkflow(ms.TargetKeyspace, ms.Workflow); err != nil {
+		return nil, err
+	}
+	if ms.SourceKeyspace == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "source_keyspace field is required")
+	}
+	if len(ms.TableSettings) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "no tables specified to materialize")
+	}
+
+	if err := s.CreateMaterializeWorkflow(ctx, ms); err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.MaterializeResponse{}, nil
+}
+
+// CreateLookupVindex creates a lookup vindex, its lookup table and the
+// workflow that backfills it.
+func (s *Server) CreateLookupVindex(ctx context.Context, req *vtctldatapb.CreateLookupVindexRequest) (*vtctldatapb.CreateLookupVindexResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.CreateLookupVindex")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+	if req.Vindex == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vindex field is required")
+	}
+
+	if err := s.CreateLookupVindexWorkflow(ctx, req.Keyspace, req.Vindex, strings.Join(req.Cells, ","), tabletTypesString(req.TabletTypes), req.ContinueAfterCopyWithOwner); err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.CreateLookupVindexResponse{}, nil
+}
+
+// VDiff compares the source and target tables of a workflow. It creates a
+// vdiff on the primaries of the target shards, and waits for it to complete.
+func (s *Server) VDiff(ctx context.Context, req *vtctldatapb.VDiffRequest) (*vtctldatapb.VDiffResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.VDiff")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if err := validateKeyspaceWorkflow(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+	if req.MaxRows < 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "max_rows cannot be negative")
+	}
+	// The vdiffs compare the rows of the target primaries.
+	if req.TargetCell != "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "target_cell is not supported, the vdiffs run on the target primaries")
+	}
+
+	reports, err := s.vdiff(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.VDiffResponse{TableReports: reports}, nil
+}
+
+// SwitchTraffic switches the traffic of a workflow from its source to its
+// target. If the request has a schedule or preconditions, the cutover is
+// stored in the topo instead, and RunCutovers switches the traffic later.
+func (s *Server) SwitchTraffic(ctx context.Context, req *vtctldatapb.SwitchTrafficRequest) (*vtctldatapb.SwitchTrafficResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.SwitchTraffic")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("dry_run", req.DryRun)
+	span.Annotate("scheduled", isScheduledCutover(req))
+
+	if isScheduledCutover(req) {
+		if err := validateKeyspaceWorkflow(req.Keyspace, req.Workflow); err != nil {
+			return nil, err
+		}
+		return s.scheduleCutover(ctx, req)
+	}
+
+	return s.switchTraffic(ctx, req, DirectionForward)
+}
+
+// ReverseTraffic switches the traffic of a workflow back to its source.
+func (s *Server) ReverseTraffic(ctx context.Context, req *vtctldatapb.ReverseTrafficRequest) (*vtctldatapb.ReverseTrafficResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.ReverseTraffic")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("dry_run", req.DryRun)
+
+	resp, err := s.switchTraffic(ctx, &vtctldatapb.SwitchTrafficRequest{
+		Keyspace:                 req.Keyspace,
+		Workflow:                 req.Workflow,
+		Cells:                    req.Cells,
+		TabletTypes:              req.TabletTypes,
+		Timeout:                  req.Timeout,
+		EnableReverseReplication: req.EnableReverseReplication,
+		DryRun:                   req.DryRun,
+	}, DirectionBackward)
+	if resp == nil {
+		return nil, err
+	}
+	return &vtctldatapb.ReverseTrafficResponse{
+		StartState:    resp.StartState,
+		CurrentState:  resp.CurrentState,
+		DryRunResults: resp.DryRunResults,
+		Events:        resp.Events,
+	}, err
+}
+
+func (s *Server) switchTraffic(ctx context.Context, req *vtctldatapb.SwitchTrafficRequest, direction TrafficSwitchDirection) (*vtctldatapb.SwitchTrafficResponse, error) {
+	if err := validateKeyspaceWorkflow(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+	timeout, ok, err := protoutil.DurationFromProto(req.Timeout)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid timeout: %v", err)
+	} else if !ok {
+		timeout = defaultWorkflowTimeout
+	}
+	tabletTypes := req.TabletTypes
+	if len(tabletTypes) == 0 {
+		tabletTypes = switchableTabletTypes
+	}
+	var readTypes []topodatapb.TabletType
+	switchWrites := false
+	for _, tabletType := range tabletTypes {
+		switch tabletType {
+		case topodatapb.TabletType_PRIMARY:
+			switchWrites = true
+		case topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY:
+			readTypes = append(readTypes, tabletType)
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid tablet type %v, the traffic of primary, replica and rdonly tablets can be switched", topoproto.TabletTypeLString(tabletType))
+		}
+	}
+
+	logger := logutil.NewMemoryLogger()
+	ts, state, err := s.existingWorkflow(ctx, logger, req.Keyspace, req.Workflow)
+	if err != nil {
+		return &vtctldatapb.SwitchTrafficResponse{Events: logger.Events}, err
+	}
+	resp := &vtctldatapb.SwitchTrafficResponse{StartState: workflowStateString(state)}
+	if err := s.switchWorkflowTraffic(ctx, logger, ts, req, direction, timeout, readTypes, switchWrites, resp); err != nil {
+		resp.Events = logger.Events
+		return resp, err
+	}
+	if req.DryRun {
+		resp.CurrentState = resp.StartState
+	} else {
+		resp.CurrentState = s.currentWorkflowState(ctx, logger, req.Keyspace, req.Workflow)
+	}
+	resp.Events = logger.Events
+	return resp, nil
+}
+
+// switchWorkflowTraffic switches the reads of readTypes, then the writes if
+// switchWrites is set. The results of a dry run are added to the response.
+func (s *Server) switchWorkflowTraffic(ctx context.Context, logger logutil.Logger, ts *trafficSwitcher, req *vtctldatapb.SwitchTrafficRequest,
+	direction TrafficSwitchDirection, timeout time.Duration, readTypes []topodatapb.TabletType, switchWrites bool, resp *vtctldatapb.SwitchTrafficResponse) error {
+	if ts.externalCluster != "" {
+		action := "SwitchTraffic"
+		if direction == DirectionBackward {
+			action = "ReverseTraffic"
+		}
+		return fmt.Errorf("invalid action for Migrate workflow: %s", action)
+	}
+	copying, err := s.isCopyInProgress(ctx, ts)
+	if err != nil {
+		return err
+	}
+	if copying {
+		return fmt.Errorf("cannot switch traffic at this time, copy is still in progress for this workflow")
+	}
+
+	if len(readTypes) > 0 {
+		dryRunResults, err := s.SwitchReads(ctx, logger, req.Keyspace, req.Workflow, readTypes, req.Cells, direction, req.DryRun)
+		if err != nil {
+			return err
+		}
+		if dryRunResults != nil {
+			resp.DryRunResults = append(resp.DryRunResults, *dryRunResults...)
+		}
+	}
+	if switchWrites {
+		// The writes are switched back by switching the writes of the
+		// reverse workflow.
+		keyspace, workflow := req.Keyspace, req.Workflow
+		if direction == DirectionBackward {
+			keyspace, workflow = ts.sourceKeyspace, ReverseWorkflowName(req.Workflow)
+		}
+		_, dryRunResults, err := s.SwitchWrites(ctx, logger, keyspace, workflow, timeout, false /* cancel */, direction == DirectionBackward,
+			req.EnableReverseReplication, req.DryRun)
+		if err != nil {
+			return err
+		}
+		if dryRunResults != nil {
+			resp.DryRunResults = append(resp.DryRunResults, *dryRunResults...)
+		}
+	}
+	return nil
+}
+
+// CompleteWorkflow cleans up a workflow that switched all its traffic,
+// dropping or renaming its source tables or shards. The cutover of the
+// workflow is deleted too.
+func (s *Server) CompleteWorkflow(ctx context.Context, req *vtctldatapb.CompleteWorkflowRequest) (*vtctldatapb.CompleteWorkflowResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.CompleteWorkflow")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("dry_run", req.DryRun)
+
+	if err := validateKeyspaceWorkflow(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+
+	logger := logutil.NewMemoryLogger()
+	dryRunResults, err := s.completeWorkflow(ctx, logger, req)
+	if err == nil && !req.DryRun {
+		err = s.ts.DeleteWorkflowCutover(ctx, req.Keyspace, req.Workflow)
+	}
+	return &vtctldatapb.CompleteWorkflowResponse{DryRunResults: dryRunResults, Events: logger.Events}, err
+}
+
+// completeWorkflow drops or renames the source tables or shards of a
+// workflow, and returns the results of a dry run. A Migrate workflow has no
+// traffic to switch, so it is finalized right away.
+func (s *Server) completeWorkflow(ctx context.Context, logger logutil.Logger, req *vtctldatapb.CompleteWorkflowRequest) ([]string, error) {
+	ts, state, err := s.existingWorkflow(ctx, logger, req.Keyspace, req.Workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	var dryRunResults *[]string
+	if ts.externalCluster != "" {
+		dryRunResults, err = s.FinalizeMigrateWorkflow(ctx, logger, req.Keyspace, req.Workflow, "" /* tableSpecs */, false /* cancel */, req.KeepData, req.DryRun)
+	} else {
+		if !state.WritesSwitched || len(state.ReplicaCellsNotSwitched) > 0 || len(state.RdonlyCellsNotSwitched) > 0 {
+			return nil, fmt.Errorf(errWorkflowNotFullySwitched)
+		}
+		removalType := DropTable
+		if req.RenameTables {
+			removalType = RenameTable
+		}
+		dryRunResults, err = s.DropSources(ctx, logger, req.Keyspace, req.Workflow, removalType, req.KeepData, false /* force */, req.DryRun)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !req.DryRun || dryRunResults == nil {
+		return nil, nil
+	}
+	return *dryRunResults, nil
+}
+
+// CancelWorkflow deletes a workflow that didn't switch any traffic, along
+// with its target tables or shards and its pending cutover.
+func (s *Server) CancelWorkflow(ctx context.Context, req *vtctldatapb.CancelWorkflowRequest) (*vtctldatapb.CancelWorkflowResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.CancelWorkflow")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if err := validateKeyspaceWorkflow(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+
+	logger := logutil.NewMemoryLogger()
+	err := s.cancelWorkflow(ctx, logger, req)
+	if err == nil {
+		err = s.ts.DeleteWorkflowCutover(ctx, req.Keyspace, req.Workflow)
+	}
+	return &vtctldatapb.CancelWorkflowResponse{Events: logger.Events}, err
+}
+
+// cancelWorkflow drops the target tables or shards and the streams of a
+// workflow.
+func (s *Server) cancelWorkflow(ctx context.Context, logger logutil.Logger, req *vtctldatapb.CancelWorkflowRequest) error {
+	ts, state, err := s.existingWorkflow(ctx, logger, req.Keyspace, req.Workflow)
+	if err != nil {
+		return err
+	}
+	if ts.externalCluster != "" {
+		_, err := s.FinalizeMigrateWorkflow(ctx, logger, req.Keyspace, req.Workflow, "" /* tableSpecs */, true /* cancel */, req.KeepData, false /* dryRun */)
+		return err
+	}
+	if state.WritesSwitched || len(state.ReplicaCellsSwitched) > 0 || len(state.RdonlyCellsSwitched) > 0 {
+		return fmt.Errorf(errWorkflowPartiallySwitched)
+	}
+	_, err = s.DropTargets(ctx, logger, req.Keyspace, req.Workflow, req.KeepData, false /* dryRun */)
+	return err
+}
+
+// checkNewWorkflow returns an error if the workflow was already created.
+func (s *Server) checkNewWorkflow(ctx context.Context, logger logutil.Logger, keyspace, workflow string) error {
+	_, state, err := s.getWorkflowState(ctx, logger, keyspace, workflow)
+	if err != nil {
+		return err
+	}
+	if state != nil {
+		return vterrors.Errorf(vtrpcpb.Code_ALREADY_EXISTS, "workflow %s.%s already exists", keyspace, workflow)
+	}
+	return nil
+}
+
+// existingWorkflow returns the traffic switcher and the state of a workflow
+// that was already created.
+func (s *Server) existingWorkflow(ctx context.Context, logger logutil.Logger, keyspace, workflow string) (*trafficSwitcher, *State, error) {
+	ts, state, err := s.getWorkflowState(ctx, logger, keyspace, workflow)
+	if err != nil {
+		return nil, nil, err
+	}
+	if state == nil {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "workflow %s.%s does not exist", keyspace, workflow)
+	}
+	return ts, state, nil
+}
+
+// currentWorkflowState reloads the state of a workflow, as a human readable
+// string.
+func (s *Server) currentWorkflowState(ctx context.Context, logger logutil.Logger, keyspace, workflow string) string {
+	_, state, err := s.getWorkflowState(ctx, logger, keyspace, workflow)
+	if err != nil {
+		return err.Error()
+	}
+	if state == nil {
+		return "Workflow Not Found"
+	}
+	return workflowStateString(state)
+}
+
+// workflowStateString returns a human readable state of a workflow, which
+// tells which of its traffic is switched.
+func workflowStateString(state *State) string {
+	var stateInfo []string
+	s := ""
+	if len(state.RdonlyCellsNotSwitched) == 0 && len(state.ReplicaCellsNotSwitched) == 0 && len(state.ReplicaCellsSwitched) > 0 {
+		s = "All Reads Switched"
+	} else if len(state.RdonlyCellsSwitched) == 0 && len(state.ReplicaCellsSwitched) == 0 {
+		s = "Reads Not Switched"
+	} else {
+		stateInfo = append(stateInfo, "Reads partially switched")
+		if len(state.ReplicaCellsNotSwitched) == 0 {
+			s += "All Replica Reads Switched"
+		} else if len(state.ReplicaCellsSwitched) == 0 {
+			s += "Replica not switched"
+		} else {
+			s += "Replica switched in cells: " + strings.Join(state.ReplicaCellsSwitched, ",")
+		}
+		stateInfo = append(stateInfo, s)
+		s = ""
+		if len(state.RdonlyCellsNotSwitched) == 0 {
+			s += "All Rdonly Reads Switched"
+		} else if len(state.RdonlyCellsSwitched) == 0 {
+			s += "Rdonly not switched"
+		} else {
+			s += "Rdonly switched in cells: " + strings.Join(state.RdonlyCellsSwitched, ",")
+		}
+	}
+	stateInfo = append(stateInfo, s)
+	if state.WritesSwitched {
+		stateInfo = append(stateInfo, "Writes Switched")
+	} else {
+		stateInfo = append(stateInfo, "Writes Not Switched")
+	}
+	return strings.Join(stateInfo, ". ")
+}
+
+// isCopyInProgress returns true if any stream of the workflow still has
+// tables to copy.
+func (s *Server) isCopyInProgress(ctx context.Context, ts *trafficSwitcher) (bool, error) {
+	for _, target := range ts.targets {
+		for id := range target.Sources {
+			query := fmt.Sprintf("select 1 from _vt.copy_state cs, _vt.vreplication vr where vr.id = cs.vrepl_id and vr.id = %d", id)
+			p3qr, err := s.tmc.ExecuteFetchAsDba(ctx, target.GetPrimary().Tablet, true, []byte(query), 1, false, false)
+			if err != nil {
+				return false, err
+			}
+			if len(p3qr.Rows) > 0 {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func tabletTypesString(tabletTypes []topodatapb.TabletType) string {
+	names := make([]string, len(tabletTypes))
+	for i, tabletType := range tabletTypes {
+		names[i] = topoproto.TabletTypeLString(tabletType)
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// newTestWorkflowServer returns a server whose keyspace "ks" has a primary
+// in each of the given shards. The primaries have a stream of workflow "wf"
+// if withStreams is set, and no stream otherwise.
+func newTestWorkflowServer(t *testing.T, shards []string, withStreams bool) (*Server, *fakeTMC) {
+	t.Helper()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	tmc := &fakeTMC{
+		vrepQueriesByTablet: make(map[string]map[string]*querypb.QueryResult),
+		vdiffs:              make(map[string]*fakeVDiff),
+	}
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+
+	streams := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"id|source|message|cell|tablet_types",
+		"int64|varchar|varchar|varchar|varchar"),
+	)
+	if withStreams {
+		streams = sqltypes.MakeTestResult(streams.Fields, `1|keyspace:"src" shard:"0" filter:{rules:{match:"t1"}}||zone1|primary`)
+	}
+	for i, shard := range shards {
+		require.NoError(t, ts.CreateShard(ctx, "ks", shard))
+		tablet := &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: uint32(100 * (i + 1))},
+			Keyspace: "ks",
+			Shard:    shard,
+			Type:     topodatapb.TabletType_PRIMARY,
+		}
+		require.NoError(t, ts.CreateTablet(ctx, tablet))
+		_, err := ts.UpdateShardFields(ctx, "ks", shard, func(si *topo.ShardInfo) error {
+			si.PrimaryAlias = tablet.Alias
+			return nil
+		})
+		require.NoError(t, err)
+
+		alias := topoproto.TabletAliasString(tablet.Alias)
+		tmc.vrepQueriesByTablet[alias] = map[string]*querypb.QueryResult{
+			"select id, source, message, cell, tablet_types from _vt.vreplication where workflow='wf' and db_name='vt_ks'": sqltypes.ResultToProto3(streams),
+		}
+		tmc.vdiffs[alias] = &fakeVDiff{}
+	}
+	return NewServer(ts, tmc), tmc
+}
+
+func TestServerLifecycleValidation(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestWorkflowServer(t, []string{"0"}, false)
+
+	_, err := s.MoveTables(ctx, &vtctldatapb.MoveTablesRequest{Workflow: "wf", TargetKeyspace: "dst", AllTables: true})
+	assert.EqualError(t, err, "source_keyspace field is required")
+	_, err = s.MoveTables(ctx, &vtctldatapb.MoveTablesRequest{Workflow: "wf", SourceKeyspace: "src", TargetKeyspace: "dst"})
+	assert.EqualError(t, err, "no tables specified to move")
+	_, err = s.Reshard(ctx, &vtctldatapb.ReshardRequest{Keyspace: "ks", Workflow: "wf", SourceShards: []string{"0"}})
+	assert.EqualError(t, err, "source and target shards are not specified")
+	_, err = s.Materialize(ctx, &vtctldatapb.MaterializeRequest{})
+	assert.EqualError(t, err, "settings field is required")
+	_, err = s.CreateLookupVindex(ctx, &vtctldatapb.CreateLookupVindexRequest{Keyspace: "ks"})
+	assert.EqualError(t, err, "vindex field is required")
+	_, err = s.VDiff(ctx, &vtctldatapb.VDiffRequest{Keyspace: "ks", Workflow: "wf", MaxRows: -1})
+	assert.EqualError(t, err, "max_rows cannot be negative")
+	_, err = s.VDiff(ctx, &vtctldatapb.VDiffRequest{Keyspace: "ks", Workflow: "wf", TargetCell: "zone1"})
+	assert.EqualError(t, err, "target_cell is not supported, the vdiffs run on the target primaries")
+	_, err = s.SwitchTraffic(ctx, &vtctldatapb.SwitchTrafficRequest{Workflow: "wf"})
+	assert.EqualError(t, err, "keyspace field is required")
+	_, err = s.ReverseTraffic(ctx, &vtctldatapb.ReverseTrafficRequest{Keyspace: "ks"})
+	assert.EqualError(t, err, "workflow field is required")
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	_, err = s.SwitchTraffic(ctx, &vtctldatapb.SwitchTrafficRequest{
+		Keyspace:    "ks",
+		Workflow:    "wf",
+		TabletTypes: []topodatapb.TabletType{topodatapb.TabletType_BACKUP},
+	})
+	assert.EqualError(t, err, "invalid tablet type backup, the traffic of primary, replica and rdonly tablets can be switched")
+}
+
+func TestServerLifecycleWorkflowNotFound(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestWorkflowServer(t, []string{"-80", "80-"}, false)
+
+	_, err := s.SwitchTraffic(ctx, &vtctldatapb.SwitchTrafficRequest{Keyspace: "ks", Workflow: "wf"})
+	assert.EqualError(t, err, "workflow ks.wf does not exist")
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+	_, err = s.ReverseTraffic(ctx, &vtctldatapb.ReverseTrafficRequest{Keyspace: "ks", Workflow: "wf"})
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+	_, err = s.CompleteWorkflow(ctx, &vtctldatapb.CompleteWorkflowRequest{Keyspace: "ks", Workflow: "wf"})
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+	_, err = s.CancelWorkflow(ctx, &vtctldatapb.CancelWorkflowRequest{Keyspace: "ks", Workflow: "wf"})
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+}
+
+func TestWorkflowStateString(t *testing.T) {
+	tests := []struct {
+		state *State
+		want  string
+	}{
+		{
+			state: &State{},
+			want:  "Reads Not Switched. Writes Not Switched",
+		},
+		{
+			state: &State{ReplicaCellsSwitched: []string{"zone1"}, RdonlyCellsSwitched: []string{"zone1"}, WritesSwitched: true},
+			want:  "All Reads Switched. Writes Switched",
+		},
+		{
+			state: &State{ReplicaCellsSwitched: []string{"zone1"}, ReplicaCellsNotSwitched: []string{"zone2"}, RdonlyCellsNotSwitched: []string{"zone1", "zone2"}},
+			want:  "Reads partially switched. Replica switched in cells: zone1. Rdonly not switched. Writes Not Switched",
+		},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, workflowStateString(tt.state))
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/event"
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/topotools/events"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// reshardSeed is the backup of a source shard that target shards are
+// restored from.
+type reshardSeed struct {
+	sourceShard string
+	backupTime  time.Time
+	// position is the encoded replication position of the backup.
+	position string
+}
+
+// seedReshardTargets restores all the tablets of the target shards from the
+// latest backup of the source shard that they split, then elects a primary
+// in each target shard. Only splits are supported, because the rows of a
+// merged shard come from several backups. It returns the seeds of the target
+// shards, by shard name.
+func (s *Server) seedReshardTargets(ctx context.Context, logger logutil.Logger, keyspace string, sources, targets []string) (map[string]*reshardSeed, error) {
+	var sourceShards, targetShards []*topo.ShardInfo
+	for _, shard := range sources {
+		si, err := s.ts.GetShard(ctx, keyspace, shard)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "GetShard(%s) failed", shard)
+		}
+		sourceShards = append(sourceShards, si)
+	}
+	for _, shard := range targets {
+		si, err := s.ts.GetShard(ctx, keyspace, shard)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "GetShard(%s) failed", shard)
+		}
+		if si.HasPrimary() {
+			return nil, fmt.Errorf("target shard %v has a primary, only shards without a primary can be seeded from a backup", shard)
+		}
+		targetShards = append(targetShards, si)
+	}
+	if err := topotools.ValidateForReshard(sourceShards, targetShards); err != nil {
+		return nil, vterrors.Wrap(err, "ValidateForReshard")
+	}
+
+	sourceSeeds := make(map[string]*reshardSeed)
+	seeds := make(map[string]*reshardSeed)
+	for _, target := range targetShards {
+		var overlapping []*topo.ShardInfo
+		for _, source := range sourceShards {
+			if key.KeyRangesIntersect(target.KeyRange, source.KeyRange) {
+				overlapping = append(overlapping, source)
+			}
+		}
+		if len(overlapping) != 1 {
+			return nil, fmt.Errorf("target shard %v overlaps %d source shards, only the target shards of a split can be seeded from a backup", target.ShardName(), len(overlapping))
+		}
+		sourceShard := overlapping[0].ShardName()
+		seed, ok := sourceSeeds[sourceShard]
+		if !ok {
+			var err error
+			if seed, err = s.findReshardSeed(ctx, logger, keyspace, sourceShard); err != nil {
+				return nil, err
+			}
+			sourceSeeds[sourceShard] = seed
+		}
+		seeds[target.ShardName()] = seed
+	}
+
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, target := range targetShards {
+		wg.Add(1)
+		go func(target *topo.ShardInfo) {
+			defer wg.Done()
+			if err := s.seedShard(ctx, logger, keyspace, target.ShardName(), seeds[target.ShardName()]); err != nil {
+				allErrors.RecordError(vterrors.Wrapf(err, "seedShard(%s)", target.ShardName()))
+			}
+		}(target)
+	}
+	wg.Wait()
+	if allErrors.HasErrors() {
+		return nil, allErrors.AggrError(vterrors.Aggregate)
+	}
+	return seeds, nil
+}
+
+// findReshardSeed returns the latest complete backup of a shard.
+func (s *Server) findReshardSeed(ctx context.Context, logger logutil.Logger, keyspace, shard string) (*reshardSeed, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+
+	backupDir := mysqlctl.GetBackupDir(keyspace, shard)
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "ListBackups(%s) failed", backupDir)
+	}
+	if len(bhs) == 0 {
+		return nil, fmt.Errorf("no backup found for source shard %v/%v", keyspace, shard)
+	}
+	bh, err := mysqlctl.FindBackupToRestore(ctx, mysqlctl.RestoreParams{Logger: logger, Keyspace: keyspace, Shard: shard}, bhs)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "no complete backup found for source shard %v/%v", keyspace, shard)
+	}
+	bm, err := mysqlctl.GetBackupManifest(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if bm.Position.IsZero() {
+		return nil, fmt.Errorf("backup %v/%v has no replication position", backupDir, bh.Name())
+	}
+	backupTime, err := time.Parse(time.RFC3339, bm.BackupTime)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "backup %v/%v has an invalid time %v", backupDir, bh.Name(), bm.BackupTime)
+	}
+
+	logger.Infof("Seeding the target shards of %v/%v from backup %v at position %v", keyspace, shard, bh.Name(), mysql.EncodePosition(bm.Position))
+	return &reshardSeed{
+		sourceShard: shard,
+		backupTime:  backupTime,
+		position:    mysql.EncodePosition(bm.Position),
+	}, nil
+}
+
+// seedShard restores all the tablets of a target shard from the backup of
+// the seed, deletes the streams that the backup contains, and elects the
+// first replica as the primary.
+func (s *Server) seedShard(ctx context.Context, logger logutil.Logger, keyspace, shard string, seed *reshardSeed) error {
+	tabletMap, err := s.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	aliases := make([]string, 0, len(tabletMap))
+	for alias := range tabletMap {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	var primaryElect *topodatapb.TabletAlias
+	for _, alias := range aliases {
+		if tabletMap[alias].Type == topodatapb.TabletType_REPLICA {
+			primaryElect = tabletMap[alias].Alias
+			break
+		}
+	}
+	if primaryElect == nil {
+		return fmt.Errorf("target shard %v/%v has no replica tablet to elect as primary", keyspace, shard)
+	}
+
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, ti := range tabletMap {
+		wg.Add(1)
+		go func(ti *topo.TabletInfo) {
+			defer wg.Done()
+			if err := s.restoreSeed(ctx, logger, ti, seed); err != nil {
+				allErrors.RecordError(vterrors.Wrapf(err, "tablet %v", topoproto.TabletAliasString(ti.Alias)))
+			}
+		}(ti)
+	}
+	wg.Wait()
+	if allErrors.HasErrors() {
+		return allErrors.AggrError(vterrors.Aggregate)
+	}
+
+	return s.initShardPrimary(ctx, logger, keyspace, shard, primaryElect)
+}
+
+// initShardPrimary forces the election of the primary of a shard that
+// doesn't have one, with the shard locked.
+func (s *Server) initShardPrimary(ctx context.Context, logger logutil.Logger, keyspace, shard string, primaryElect *topodatapb.TabletAlias) (err error) {
+	ctx, unlock, lockErr := s.ts.LockShard(ctx, keyspace, shard, fmt.Sprintf("InitShardPrimary(%v)", topoproto.TabletAliasString(primaryElect)))
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	ev := &events.Reparent{}
+	err = reparentutil.InitShardPrimaryLocked(ctx, s.ts, s.tmc, logger, ev, &vtctldatapb.InitShardPrimaryRequest{
+		Keyspace:                keyspace,
+		Shard:                   shard,
+		PrimaryElectTabletAlias: primaryElect,
+		Force:                   true,
+	}, *topo.RemoteOperationTimeout)
+	if err != nil {
+		event.DispatchUpdate(ev, "failed InitShardPrimary: "+err.Error())
+	} else {
+		event.DispatchUpdate(ev, "finished InitShardPrimary")
+	}
+	return err
+}
+
+// restoreSeed restores a tablet from the backup of the seed. The streams of
+// the source shard are restored along with its rows, so they are deleted
+// before the tablet can become the primary and start them.
+func (s *Server) restoreSeed(ctx context.Context, logger logutil.Logger, ti *topo.TabletInfo, seed *reshardSeed) error {
+	stream, err := s.tmc.RestoreFromBackup(ctx, ti.Tablet, &tabletmanagerdatapb.RestoreFromBackupRequest{
+		BackupTime:  logutil.TimeToProto(seed.backupTime),
+		BackupShard: seed.sourceShard,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		e, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		logutil.LogEvent(logger, e)
+	}
+
+	for _, query := range []string{"delete from _vt.vreplication", "delete from _vt.copy_state"} {
+		if _, err := s.tmc.ExecuteFetchAsDba(ctx, ti.Tablet, false, []byte(query), 0, true /* disableBinlogs */, false); err != nil {
+			return vterrors.Wrapf(err, "ExecuteFetchAsDba(%s)", query)
+		}
+	}
+	return nil
+}
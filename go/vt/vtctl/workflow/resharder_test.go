@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// recordingTMC records the VReplicationExec queries, by tablet alias.
+type recordingTMC struct {
+	tmclient.TabletManagerClient
+
+	mu      sync.Mutex
+	queries map[string][]string
+}
+
+func (tmc *recordingTMC) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	tmc.queries[alias] = append(tmc.queries[alias], query)
+	return &querypb.QueryResult{}, nil
+}
+
+func TestResharderSeededStreams(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	tmc := &recordingTMC{queries: make(map[string][]string)}
+
+	getShards := func(shards ...string) []*topo.ShardInfo {
+		var sis []*topo.ShardInfo
+		for _, shard := range shards {
+			require.NoError(t, ts.CreateShard(ctx, "ks", shard))
+			si, err := ts.GetShard(ctx, "ks", shard)
+			require.NoError(t, err)
+			sis = append(sis, si)
+		}
+		return sis
+	}
+	rs := &resharder{
+		s:            NewServer(ts, tmc),
+		logger:       logutil.NewMemoryLogger(),
+		keyspace:     "ks",
+		workflow:     "wf",
+		sourceShards: getShards("0"),
+		targetShards: getShards("-80", "80-"),
+		targetPrimaries: map[string]*topo.TabletInfo{
+			"-80": topo.NewTabletInfo(&topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 200}, Keyspace: "ks", Shard: "-80"}, nil),
+			"80-": topo.NewTabletInfo(&topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 210}, Keyspace: "ks", Shard: "80-"}, nil),
+		},
+		vschema: &vschemapb.Keyspace{},
+	}
+
+	// Both target shards start from the position of the backup of the source shard.
+	seed := &reshardSeed{sourceShard: "0", position: "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-615"}
+	rs.seeds = map[string]*reshardSeed{"-80": seed, "80-": seed}
+	require.NoError(t, rs.createStreams(ctx))
+
+	for alias, keyRange := range map[string]string{"zone1-0000000200": "-80", "zone1-0000000210": "80-"} {
+		require.Len(t, tmc.queries[alias], 1, alias)
+		assert.Regexp(t,
+			`^insert into _vt.vreplication\(workflow, source, pos, max_tps, max_replication_lag, cell, tablet_types, time_updated, transaction_timestamp, state, db_name\) values `+
+				`\('wf', 'keyspace:\\"ks\\" shard:\\"0\\" filter:{rules:{match:\\"/.*\\" filter:\\"`+keyRange+`\\"}} seeded_from_backup:true', 'MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-615', [0-9]*, [0-9]*, '', '', [0-9]*, 0, 'Stopped', 'vt_ks'\)$`,
+			tmc.queries[alias][0])
+	}
+}
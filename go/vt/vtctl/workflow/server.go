@@ -62,9 +62,7 @@ var (
 // workflows (MoveTables, Reshard, etc) and schema migration workflows.
 //
 // NB: This is in alpha, and you probably don't want to depend on it (yet!).
-// Currently, it provides only a read-only API to vreplication workflows. Write
-// actions on vreplication workflows, and schema migration workflows entirely,
-// are not yet supported, but planned.
+// Schema migration workflows are not yet supported, but planned.
 type Server struct {
 	ts  *topo.Server
 	tmc tmclient.TabletManagerClient
@@ -687,6 +685,14 @@ ORDER BY
 
 		workflow.MaxVReplicationLag = int64(maxVReplicationLag)
 
+		cutover, err := s.ts.GetWorkflowCutover(ctx, targetKeyspace, name)
+		switch {
+		case err == nil:
+			workflow.Cutover = cutover.WorkflowCutover
+		case !topo.IsErrType(err, topo.NoNode):
+			return nil, err
+		}
+
 		// Sort shard streams by stream_id ASC, to support an optimization
 		// in fetchStreamLogs below.
 		for _, shardStreams := range workflow.ShardStreams {
@@ -38,6 +38,8 @@ import (
 type fakeTMC struct {
 	tmclient.TabletManagerClient
 	vrepQueriesByTablet map[string]map[string]*querypb.QueryResult
+	// vdiffs are the vdiffs of the primaries, by tablet alias.
+	vdiffs map[string]*fakeVDiff
 }
 
 func (fake *fakeTMC) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
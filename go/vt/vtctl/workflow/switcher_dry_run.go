@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package wrangler
+package workflow
 
 import (
 	"context"
@@ -24,7 +24,6 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/mysql"
-	"vitess.io/vitess/go/vt/vtctl/workflow"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -47,7 +46,7 @@ func (dr *switcherDryRun) deleteRoutingRules(ctx context.Context) error {
 	return nil
 }
 
-func (dr *switcherDryRun) switchShardReads(ctx context.Context, cells []string, servedTypes []topodatapb.TabletType, direction workflow.TrafficSwitchDirection) error {
+func (dr *switcherDryRun) switchShardReads(ctx context.Context, cells []string, servedTypes []topodatapb.TabletType, direction TrafficSwitchDirection) error {
 	sourceShards := make([]string, 0)
 	targetShards := make([]string, 0)
 	for _, source := range dr.ts.Sources() {
@@ -58,7 +57,7 @@ func (dr *switcherDryRun) switchShardReads(ctx context.Context, cells []string,
 	}
 	sort.Strings(sourceShards)
 	sort.Strings(targetShards)
-	if direction == workflow.DirectionForward {
+	if direction == DirectionForward {
 		dr.drLog.Log(fmt.Sprintf("Switch reads from keyspace %s to keyspace %s for shards %s to shards %s",
 			dr.ts.SourceKeyspaceName(), dr.ts.TargetKeyspaceName(), strings.Join(sourceShards, ","), strings.Join(targetShards, ",")))
 	} else {
@@ -68,9 +67,9 @@ func (dr *switcherDryRun) switchShardReads(ctx context.Context, cells []string,
 	return nil
 }
 
-func (dr *switcherDryRun) switchTableReads(ctx context.Context, cells []string, servedTypes []topodatapb.TabletType, direction workflow.TrafficSwitchDirection) error {
+func (dr *switcherDryRun) switchTableReads(ctx context.Context, cells []string, servedTypes []topodatapb.TabletType, direction TrafficSwitchDirection) error {
 	ks := dr.ts.TargetKeyspaceName()
-	if direction == workflow.DirectionBackward {
+	if direction == DirectionBackward {
 		ks = dr.ts.SourceKeyspaceName()
 	}
 	var tabletTypes []string
@@ -148,7 +147,7 @@ func (dr *switcherDryRun) createReverseVReplication(ctx context.Context) error {
 	return nil
 }
 
-func (dr *switcherDryRun) migrateStreams(ctx context.Context, sm *workflow.StreamMigrator) error {
+func (dr *switcherDryRun) migrateStreams(ctx context.Context, sm *StreamMigrator) error {
 	templates := sm.Templates()
 
 	if len(templates) == 0 {
@@ -199,7 +198,7 @@ func (dr *switcherDryRun) stopSourceWrites(ctx context.Context) error {
 	return nil
 }
 
-func (dr *switcherDryRun) stopStreams(ctx context.Context, sm *workflow.StreamMigrator) ([]string, error) {
+func (dr *switcherDryRun) stopStreams(ctx context.Context, sm *StreamMigrator) ([]string, error) {
 	logs := make([]string, 0)
 	for _, streams := range sm.Streams() {
 		for _, stream := range streams {
@@ -214,7 +213,7 @@ func (dr *switcherDryRun) stopStreams(ctx context.Context, sm *workflow.StreamMi
 	return nil, nil
 }
 
-func (dr *switcherDryRun) cancelMigration(ctx context.Context, sm *workflow.StreamMigrator) {
+func (dr *switcherDryRun) cancelMigration(ctx context.Context, sm *StreamMigrator) {
 	dr.drLog.Log("Cancel stream migrations as requested")
 }
 
@@ -225,7 +224,7 @@ func (dr *switcherDryRun) lockKeyspace(ctx context.Context, keyspace, _ string)
 	}, nil
 }
 
-func (dr *switcherDryRun) removeSourceTables(ctx context.Context, removalType workflow.TableRemovalType) error {
+func (dr *switcherDryRun) removeSourceTables(ctx context.Context, removalType TableRemovalType) error {
 	logs := make([]string, 0)
 	for _, source := range dr.ts.Sources() {
 		for _, tableName := range dr.ts.Tables() {
@@ -234,7 +233,7 @@ func (dr *switcherDryRun) removeSourceTables(ctx context.Context, removalType wo
 		}
 	}
 	action := "Dropping"
-	if removalType == workflow.RenameTable {
+	if removalType == RenameTable {
 		action = "Renaming"
 	}
 	if len(logs) > 0 {
@@ -289,7 +288,7 @@ func (dr *switcherDryRun) dropSourceReverseVReplicationStreams(ctx context.Conte
 	logs := make([]string, 0)
 	for _, t := range dr.ts.Sources() {
 		logs = append(logs, fmt.Sprintf("\tKeyspace %s Shard %s Workflow %s DbName %s Tablet %d",
-			t.GetShard().Keyspace(), t.GetShard().ShardName(), workflow.ReverseWorkflowName(dr.ts.WorkflowName()), t.GetPrimary().DbName(), t.GetPrimary().Alias.Uid))
+			t.GetShard().Keyspace(), t.GetShard().ShardName(), ReverseWorkflowName(dr.ts.WorkflowName()), t.GetPrimary().DbName(), t.GetPrimary().Alias.Uid))
 	}
 	dr.drLog.LogSlice(logs)
 	return nil
@@ -17,9 +17,11 @@ limitations under the License.
 package workflow
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
@@ -56,3 +58,48 @@ func TestReverseWorkflowName(t *testing.T) {
 		assert.Equal(t, test.out, got)
 	}
 }
+
+func TestReverseVReplicationUpdateQuery(t *testing.T) {
+	ts := &trafficSwitcher{
+		reverseWorkflow: "wf",
+	}
+	dbname := "db"
+	type tCase struct {
+		optCells       string
+		optTabletTypes string
+		targetCell     string
+		sourceCell     string
+		want           string
+	}
+	updateQuery := "update _vt.vreplication set cell = '%s', tablet_types = '%s' where workflow = 'wf' and db_name = 'db'"
+	tCases := []tCase{
+		{
+			targetCell: "cell1", sourceCell: "cell1", optCells: "cell1", optTabletTypes: "",
+			want: fmt.Sprintf(updateQuery, "cell1", ""),
+		},
+		{
+			targetCell: "cell1", sourceCell: "cell2", optCells: "cell1", optTabletTypes: "",
+			want: fmt.Sprintf(updateQuery, "cell2", ""),
+		},
+		{
+			targetCell: "cell1", sourceCell: "cell2", optCells: "cell2", optTabletTypes: "",
+			want: fmt.Sprintf(updateQuery, "cell2", ""),
+		},
+		{
+			targetCell: "cell1", sourceCell: "cell1", optCells: "cell1,cell2", optTabletTypes: "replica,primary",
+			want: fmt.Sprintf(updateQuery, "cell1,cell2", "replica,primary"),
+		},
+		{
+			targetCell: "cell1", sourceCell: "cell1", optCells: "", optTabletTypes: "replica,primary",
+			want: fmt.Sprintf(updateQuery, "", "replica,primary"),
+		},
+	}
+	for _, tc := range tCases {
+		t.Run("", func(t *testing.T) {
+			ts.optCells = tc.optCells
+			ts.optTabletTypes = tc.optTabletTypes
+			got := ts.getReverseVReplicationUpdateQuery(tc.targetCell, tc.sourceCell, dbname)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
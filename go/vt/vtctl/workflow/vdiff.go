@@ -0,0 +1,305 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// vdiffPollInterval is how often VDiff checks the progress of the vdiff it
+// created on the target primaries. It can be changed to a smaller value for
+// tests.
+var vdiffPollInterval = time.Second
+
+// vdiffStateOrder orders the states of the shards of a vdiff: the state
+// across the shards is the first one any shard is in.
+var vdiffStateOrder = []string{tabletvdiff.ErrorState, tabletvdiff.StartedState, tabletvdiff.PendingState, tabletvdiff.StoppedState, tabletvdiff.CompletedState}
+
+// vdiffSummary is the state of one vdiff across the target shards of a
+// workflow, as the target primaries show it.
+type vdiffSummary struct {
+	uuid  string
+	state string
+	// completedAt is when the last shard completed the vdiff. It is only set
+	// if all the shards did.
+	completedAt time.Time
+	// errors are the last errors of the shards, by shard.
+	errors map[string]string
+	// reports are the reports of the tables, summed across the shards.
+	reports map[string]*tabletvdiff.DiffReport
+}
+
+// hasDifferences returns true if any table of the vdiff has differences.
+func (summary *vdiffSummary) hasDifferences() bool {
+	for _, dr := range summary.reports {
+		if dr.HasDifferences() {
+			return true
+		}
+	}
+	return false
+}
+
+// errorString returns the errors of the shards, in shard order.
+func (summary *vdiffSummary) errorString() string {
+	shards := make([]string, 0, len(summary.errors))
+	for shard := range summary.errors {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	errs := make([]string, len(shards))
+	for i, shard := range shards {
+		errs[i] = fmt.Sprintf("%v: %v", shard, summary.errors[shard])
+	}
+	return strings.Join(errs, "; ")
+}
+
+// vdiffOptions returns the options of the tablet vdiffs for a VDiff request.
+func vdiffOptions(req *vtctldatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffOptions, error) {
+	waitTime, ok, err := protoutil.DurationFromProto(req.FilteredReplicationWaitTime)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		waitTime = defaultWorkflowTimeout
+	}
+	return &tabletmanagerdatapb.VDiffOptions{
+		Tables:                             strings.Join(req.Tables, ","),
+		SourceCell:                         req.SourceCell,
+		TabletTypes:                        tabletTypesString(req.TabletTypes),
+		MaxRows:                            req.MaxRows,
+		FilteredReplicationWaitTimeSeconds: int64(waitTime.Seconds()),
+		OnlyPks:                            req.OnlyPks,
+		DebugQuery:                         req.DebugQuery,
+	}, nil
+}
+
+// vdiff creates a vdiff on the primaries of the target shards of a workflow,
+// and waits for it to complete on all of them.
+func (s *Server) vdiff(ctx context.Context, req *vtctldatapb.VDiffRequest) (map[string]*vtctldatapb.VDiffResponse_TableReport, error) {
+	options, err := vdiffOptions(req)
+	if err != nil {
+		return nil, err
+	}
+	// All the shards share the same uuid, so the vdiff can be managed as a
+	// whole.
+	vdiffUUID := uuid.New().String()
+	if _, err := s.performVDiffAction(ctx, req.Keyspace, req.Workflow, &tabletmanagerdatapb.VDiffRequest{
+		Keyspace:  req.Keyspace,
+		Workflow:  req.Workflow,
+		Action:    tabletvdiff.CreateAction,
+		VdiffUuid: vdiffUUID,
+		Options:   options,
+	}); err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(vdiffPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, vterrors.Wrapf(ctx.Err(), "vdiff %v did not complete", vdiffUUID)
+		case <-ticker.C:
+		}
+
+		summary, err := s.showVDiff(ctx, req.Keyspace, req.Workflow, vdiffUUID)
+		if err != nil {
+			return nil, err
+		}
+		if summary == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "vdiff %v not found on the target shards", vdiffUUID)
+		}
+		switch summary.state {
+		case tabletvdiff.CompletedState:
+			reports := make(map[string]*vtctldatapb.VDiffResponse_TableReport, len(summary.reports))
+			for table, dr := range summary.reports {
+				reports[table] = &vtctldatapb.VDiffResponse_TableReport{
+					ProcessedRows:   dr.ProcessedRows,
+					MatchingRows:    dr.MatchingRows,
+					MismatchedRows:  dr.MismatchedRows,
+					ExtraRowsSource: dr.ExtraRowsSource,
+					ExtraRowsTarget: dr.ExtraRowsTarget,
+				}
+			}
+			return reports, nil
+		case tabletvdiff.ErrorState:
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "vdiff %v failed: %v", vdiffUUID, summary.errorString())
+		case tabletvdiff.StoppedState:
+			return nil, vterrors.Errorf(vtrpcpb.Code_ABORTED, "vdiff %v was stopped", vdiffUUID)
+		}
+	}
+}
+
+// showVDiff returns the state of a vdiff across the target shards of a
+// workflow. actionArg is the uuid of the vdiff, or "last" for the most
+// recent one. It returns nil if no shard has the vdiff.
+func (s *Server) showVDiff(ctx context.Context, keyspace, workflow, actionArg string) (*vdiffSummary, error) {
+	responses, err := s.performVDiffAction(ctx, keyspace, workflow, &tabletmanagerdatapb.VDiffRequest{
+		Keyspace:  keyspace,
+		Workflow:  workflow,
+		Action:    tabletvdiff.ShowAction,
+		ActionArg: actionArg,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summarizeVDiff(responses)
+}
+
+// performVDiffAction performs a vdiff action on the primaries of the target
+// shards of a workflow, and returns their responses by shard.
+func (s *Server) performVDiffAction(ctx context.Context, keyspace, workflow string, req *tabletmanagerdatapb.VDiffRequest) (map[string]*tabletmanagerdatapb.VDiffResponse, error) {
+	targets, err := BuildTargets(ctx, s.ts, s.tmc, keyspace, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		m         sync.Mutex
+		wg        sync.WaitGroup
+		rec       concurrency.AllErrorRecorder
+		responses = make(map[string]*tabletmanagerdatapb.VDiffResponse, len(targets.Targets))
+	)
+	for shard, target := range targets.Targets {
+		wg.Add(1)
+		go func(shard string, target *MigrationTarget) {
+			defer wg.Done()
+
+			resp, err := s.tmc.VDiff(ctx, target.GetPrimary().Tablet, req)
+			if err != nil {
+				rec.RecordError(vterrors.Wrapf(err, "vdiff %v on %v", req.Action, topoproto.TabletAliasString(target.GetPrimary().Alias)))
+				return
+			}
+
+			m.Lock()
+			defer m.Unlock()
+			responses[shard] = resp
+		}(shard, target)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+	return responses, nil
+}
+
+// summarizeVDiff summarizes the "show" responses of the target shards to a
+// vdiff. It returns nil if no shard has the vdiff, and an error if only some
+// of them have it.
+func summarizeVDiff(responses map[string]*tabletmanagerdatapb.VDiffResponse) (*vdiffSummary, error) {
+	var summary *vdiffSummary
+	var missing []string
+	states := make(map[string]bool)
+	var completedAt string
+	for shard, resp := range responses {
+		if resp.Output == nil || len(resp.Output.Rows) == 0 {
+			missing = append(missing, shard)
+			continue
+		}
+		for i, row := range sqltypes.ToNamedResult(sqltypes.Proto3ToResult(resp.Output)).Rows {
+			if summary == nil {
+				summary = &vdiffSummary{
+					uuid:    row.AsString("vdiff_uuid", ""),
+					errors:  make(map[string]string),
+					reports: make(map[string]*tabletvdiff.DiffReport),
+				}
+			}
+			if i == 0 {
+				if vdiffUUID := row.AsString("vdiff_uuid", ""); vdiffUUID != summary.uuid {
+					return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the target shards have different vdiffs: %v and %v", summary.uuid, vdiffUUID)
+				}
+				states[row.AsString("state", "")] = true
+				if shardCompletedAt := row.AsString("completed_at", ""); shardCompletedAt > completedAt {
+					completedAt = shardCompletedAt
+				}
+				if lastError := row.AsString("last_error", ""); lastError != "" {
+					summary.errors[shard] = lastError
+				}
+			}
+
+			table := row.AsString("table_name", "")
+			report := row.AsString("report", "")
+			if table == "" || report == "" {
+				// The vdiff of this shard has not compared this table yet.
+				continue
+			}
+			dr := &tabletvdiff.DiffReport{}
+			if err := json.Unmarshal([]byte(report), dr); err != nil {
+				return nil, vterrors.Wrapf(err, "invalid report of table %v on shard %v", table, shard)
+			}
+			total := summary.reports[table]
+			if total == nil {
+				total = &tabletvdiff.DiffReport{TableName: table}
+				summary.reports[table] = total
+			}
+			total.ProcessedRows += dr.ProcessedRows
+			total.MatchingRows += dr.MatchingRows
+			total.MismatchedRows += dr.MismatchedRows
+			total.ExtraRowsSource += dr.ExtraRowsSource
+			total.ExtraRowsTarget += dr.ExtraRowsTarget
+		}
+	}
+	if summary == nil {
+		return nil, nil
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %v not found on target shards %v", summary.uuid, strings.Join(missing, ","))
+	}
+	summary.state = aggregateVDiffState(states)
+	if summary.state == tabletvdiff.CompletedState && completedAt != "" {
+		t, err := time.Parse("2006-01-02 15:04:05", completedAt)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "invalid completion time of vdiff %v", summary.uuid)
+		}
+		summary.completedAt = t
+	}
+	return summary, nil
+}
+
+// aggregateVDiffState returns the state across shards, given the states of
+// the shards.
+func aggregateVDiffState(states map[string]bool) string {
+	for _, state := range vdiffStateOrder {
+		if states[state] {
+			return state
+		}
+	}
+	// Unknown states are reported as is.
+	for state := range states {
+		return state
+	}
+	return ""
+}
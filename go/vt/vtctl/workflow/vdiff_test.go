@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// fakeVDiff is the vdiff of a fake primary. Creating a vdiff sets its uuid,
+// and moves it to the state of the fake right away.
+type fakeVDiff struct {
+	uuid        string
+	state       string
+	lastError   string
+	completedAt time.Time
+	reports     map[string]*tabletvdiff.DiffReport
+	actions     []string
+}
+
+func (fake *fakeTMC) VDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	vdiff, ok := fake.vdiffs[alias]
+	if !ok {
+		return nil, fmt.Errorf("no vdiff registered on fake for %s", alias)
+	}
+	vdiff.actions = append(vdiff.actions, req.Action)
+
+	switch req.Action {
+	case tabletvdiff.CreateAction:
+		vdiff.uuid = req.VdiffUuid
+		return &tabletmanagerdatapb.VDiffResponse{VdiffUuid: req.VdiffUuid}, nil
+	case tabletvdiff.ShowAction:
+		result := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+			"vdiff_uuid|state|completed_at|last_error|table_name|report",
+			"varchar|varchar|timestamp|varchar|varchar|json"),
+		)
+		if vdiff.uuid == "" || (req.ActionArg != tabletvdiff.LastActionArg && req.ActionArg != vdiff.uuid) {
+			return &tabletmanagerdatapb.VDiffResponse{Output: sqltypes.ResultToProto3(result)}, nil
+		}
+		completedAt := "null"
+		if !vdiff.completedAt.IsZero() {
+			completedAt = vdiff.completedAt.UTC().Format("2006-01-02 15:04:05")
+		}
+		tables := make([]string, 0, len(vdiff.reports))
+		for table := range vdiff.reports {
+			tables = append(tables, table)
+		}
+		sort.Strings(tables)
+		var rows []string
+		for _, table := range tables {
+			report, err := json.Marshal(vdiff.reports[table])
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, fmt.Sprintf("%s|%s|%s|%s|%s|%s", vdiff.uuid, vdiff.state, completedAt, vdiff.lastError, table, report))
+		}
+		result = sqltypes.MakeTestResult(result.Fields, rows...)
+		return &tabletmanagerdatapb.VDiffResponse{Output: sqltypes.ResultToProto3(result)}, nil
+	}
+	return nil, fmt.Errorf("unsupported vdiff action %s on fake", req.Action)
+}
+
+func TestVDiff(t *testing.T) {
+	defer func(interval time.Duration) { vdiffPollInterval = interval }(vdiffPollInterval)
+	vdiffPollInterval = time.Millisecond
+
+	ctx := context.Background()
+	completedAt := time.Now().UTC().Truncate(time.Second)
+
+	t.Run("completed", func(t *testing.T) {
+		s, tmc := newTestWorkflowServer(t, []string{"-80", "80-"}, true)
+		tmc.vdiffs["zone1-0000000100"].state = tabletvdiff.CompletedState
+		tmc.vdiffs["zone1-0000000100"].completedAt = completedAt
+		tmc.vdiffs["zone1-0000000100"].reports = map[string]*tabletvdiff.DiffReport{
+			"t1": {TableName: "t1", ProcessedRows: 2, MatchingRows: 2},
+		}
+		tmc.vdiffs["zone1-0000000200"].state = tabletvdiff.CompletedState
+		tmc.vdiffs["zone1-0000000200"].completedAt = completedAt.Add(-time.Minute)
+		tmc.vdiffs["zone1-0000000200"].reports = map[string]*tabletvdiff.DiffReport{
+			"t1": {TableName: "t1", ProcessedRows: 3, MatchingRows: 2, MismatchedRows: 1},
+		}
+
+		resp, err := s.VDiff(ctx, &vtctldatapb.VDiffRequest{Keyspace: "ks", Workflow: "wf"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]*vtctldatapb.VDiffResponse_TableReport{
+			"t1": {ProcessedRows: 5, MatchingRows: 4, MismatchedRows: 1},
+		}, resp.TableReports)
+		// Both shards run the same vdiff.
+		assert.Equal(t, tmc.vdiffs["zone1-0000000100"].uuid, tmc.vdiffs["zone1-0000000200"].uuid)
+		assert.Equal(t, []string{tabletvdiff.CreateAction, tabletvdiff.ShowAction}, tmc.vdiffs["zone1-0000000100"].actions)
+
+		summary, err := s.showVDiff(ctx, "ks", "wf", tabletvdiff.LastActionArg)
+		require.NoError(t, err)
+		assert.Equal(t, completedAt, summary.completedAt)
+		assert.True(t, summary.hasDifferences())
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		s, tmc := newTestWorkflowServer(t, []string{"-80", "80-"}, true)
+		tmc.vdiffs["zone1-0000000100"].state = tabletvdiff.CompletedState
+		tmc.vdiffs["zone1-0000000100"].reports = map[string]*tabletvdiff.DiffReport{"t1": {}}
+		tmc.vdiffs["zone1-0000000200"].state = tabletvdiff.ErrorState
+		tmc.vdiffs["zone1-0000000200"].lastError = "table t1 not found"
+		tmc.vdiffs["zone1-0000000200"].reports = map[string]*tabletvdiff.DiffReport{"t1": {}}
+
+		_, err := s.VDiff(ctx, &vtctldatapb.VDiffRequest{Keyspace: "ks", Workflow: "wf"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed: 80-: table t1 not found")
+		assert.Equal(t, vtrpcpb.Code_UNKNOWN, vterrors.Code(err))
+	})
+
+	t.Run("no streams", func(t *testing.T) {
+		s, _ := newTestWorkflowServer(t, []string{"0"}, false)
+
+		_, err := s.VDiff(ctx, &vtctldatapb.VDiffRequest{Keyspace: "ks", Workflow: "wf"})
+		assert.ErrorIs(t, err, ErrNoStreams)
+	})
+}
+
+func TestShowVDiff(t *testing.T) {
+	ctx := context.Background()
+	s, tmc := newTestWorkflowServer(t, []string{"-80", "80-"}, true)
+
+	summary, err := s.showVDiff(ctx, "ks", "wf", tabletvdiff.LastActionArg)
+	require.NoError(t, err)
+	assert.Nil(t, summary)
+
+	tmc.vdiffs["zone1-0000000100"].uuid = "a"
+	tmc.vdiffs["zone1-0000000100"].state = tabletvdiff.StartedState
+	tmc.vdiffs["zone1-0000000100"].reports = map[string]*tabletvdiff.DiffReport{"t1": {}}
+	_, err = s.showVDiff(ctx, "ks", "wf", tabletvdiff.LastActionArg)
+	assert.EqualError(t, err, "vdiff a not found on target shards 80-")
+
+	tmc.vdiffs["zone1-0000000200"].uuid = "b"
+	tmc.vdiffs["zone1-0000000200"].state = tabletvdiff.CompletedState
+	tmc.vdiffs["zone1-0000000200"].reports = map[string]*tabletvdiff.DiffReport{"t1": {}}
+	_, err = s.showVDiff(ctx, "ks", "wf", tabletvdiff.LastActionArg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the target shards have different vdiffs")
+
+	tmc.vdiffs["zone1-0000000200"].uuid = "a"
+	summary, err = s.showVDiff(ctx, "ks", "wf", "a")
+	require.NoError(t, err)
+	assert.Equal(t, tabletvdiff.StartedState, summary.state)
+	assert.True(t, summary.completedAt.IsZero())
+}
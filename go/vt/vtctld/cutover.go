@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+)
+
+var (
+	cutoverCheckTicks *timer.Timer
+)
+
+var (
+	cutoverCheckInterval = flag.Duration("workflow_cutover_check_interval", time.Minute, "interval polling for the scheduled workflow cutovers to run")
+)
+
+// initCutoverManager starts carrying out the scheduled cutovers of the
+// vreplication workflows, which SwitchTraffic stores in the topo.
+func initCutoverManager(ts *topo.Server) {
+	ws := workflow.NewServer(ts, tmclient.NewTabletManagerClient())
+	cutoverCheckTicks = timer.NewTimer(*cutoverCheckInterval)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cutoverCheckTicks.Start(func() {
+		if err := ws.RunCutovers(ctx); err != nil {
+			log.Errorf("vtctld.onCutoverCheckTick error: %s", err.Error())
+		}
+	})
+
+	go func() {
+		<-ctx.Done()
+		cutoverCheckTicks.Stop()
+	}()
+
+	// Running cancel on OnTermSync will cancel the context of a cutover
+	// that is switching the traffic.
+	servenv.OnTermSync(cancel)
+}
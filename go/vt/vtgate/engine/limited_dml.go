@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+var _ Primitive = (*LimitedDML)(nil)
+
+// DMLLimitVar is the bind variable holding the number of rows
+// a LimitedDML changes on a shard.
+const DMLLimitVar = "__dml_limit"
+
+// LimitedDML executes a multi-shard UPDATE or DELETE that has a LIMIT clause.
+// The Input selects the values of the primary vindex column of the rows the
+// statement has to change, in the order of the statement and up to its limit.
+// The DML is then sent to every shard these rows live on, with its limit
+// set to the number of rows that were found on that shard. The Input and the
+// DML are both ordered by the primary vindex column last, so that every shard
+// changes the rows that the Input selected from it.
+type LimitedDML struct {
+	// Input returns the primary vindex column of the rows to change as its first column.
+	Input Primitive
+
+	// DML is the Update or Delete to execute. Its query uses DMLLimitVar as limit.
+	DML Primitive
+
+	// Keyspace and Vindex are used to find the shards of the rows returned by the Input.
+	Keyspace *vindexes.Keyspace
+	Vindex   vindexes.SingleColumn
+
+	txNeeded
+}
+
+// RouteType implements the Primitive interface
+func (l *LimitedDML) RouteType() string {
+	return "LimitedDML"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (l *LimitedDML) GetKeyspaceName() string {
+	return l.Keyspace.Name
+}
+
+// GetTableName implements the Primitive interface
+func (l *LimitedDML) GetTableName() string {
+	return l.DML.GetTableName()
+}
+
+// TryExecute implements the Primitive interface
+func (l *LimitedDML) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, _ bool) (*sqltypes.Result, error) {
+	qr, err := vcursor.ExecutePrimitive(l.Input, bindVars, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+
+	keys := make([]sqltypes.Value, 0, len(qr.Rows))
+	ids := make([]*querypb.Value, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		if len(row) == 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] no primary vindex column returned for %s", l.GetTableName())
+		}
+		keys = append(keys, row[0])
+		ids = append(ids, sqltypes.ValueToProto(row[0]))
+	}
+	destinations, err := l.Vindex.Map(vcursor, keys)
+	if err != nil {
+		return nil, err
+	}
+	rss, values, err := vcursor.ResolveDestinations(l.Keyspace.Name, ids, destinations)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{}
+	for i, rs := range rss {
+		shardVars := make(map[string]*querypb.BindVariable, len(bindVars)+1)
+		for k, v := range bindVars {
+			shardVars[k] = v
+		}
+		shardVars[DMLLimitVar] = sqltypes.Int64BindVariable(int64(len(values[i])))
+		qr, err := l.execShard(vcursor, shardVars, key.DestinationShard(rs.Target.Shard))
+		if err != nil {
+			return nil, err
+		}
+		result.RowsAffected += qr.RowsAffected
+	}
+	return result, nil
+}
+
+func (l *LimitedDML) execShard(vcursor VCursor, bindVars map[string]*querypb.BindVariable, dest key.Destination) (*sqltypes.Result, error) {
+	switch dml := l.DML.(type) {
+	case *Delete:
+		if dml.QueryTimeout != 0 {
+			cancel := vcursor.SetContextTimeout(time.Duration(dml.QueryTimeout) * time.Millisecond)
+			defer cancel()
+		}
+		return dml.execDeleteByDestination(vcursor, bindVars, dest)
+	case *Update:
+		if dml.QueryTimeout != 0 {
+			cancel := vcursor.SetContextTimeout(time.Duration(dml.QueryTimeout) * time.Millisecond)
+			defer cancel()
+		}
+		return dml.execUpdateByDestination(vcursor, bindVars, dest)
+	default:
+		// Unreachable.
+		return nil, fmt.Errorf("unsupported DML for LimitedDML: %T", l.DML)
+	}
+}
+
+// TryStreamExecute implements the Primitive interface
+func (l *LimitedDML) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	res, err := l.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(res)
+}
+
+// GetFields implements the Primitive interface
+func (l *LimitedDML) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, fmt.Errorf("BUG: unreachable code for LimitedDML on %q", l.GetTableName())
+}
+
+// Inputs implements the Primitive interface
+func (l *LimitedDML) Inputs() []Primitive {
+	return []Primitive{l.Input, l.DML}
+}
+
+func (l *LimitedDML) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "LimitedDML",
+		Keyspace:     l.Keyspace,
+		Other: map[string]interface{}{
+			"Vindex":   l.Vindex.String(),
+			"LimitVar": DMLLimitVar,
+		},
+	}
+}
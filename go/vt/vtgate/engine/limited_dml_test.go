@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+func TestLimitedDMLDelete(t *testing.T) {
+	vindex, _ := vindexes.NewHash("", nil)
+	ks := &vindexes.Keyspace{Name: "ks", Sharded: true}
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "4", "2"),
+		},
+	}
+	ldml := &LimitedDML{
+		Input: input,
+		DML: &Delete{
+			DML: DML{
+				Opcode:   Scatter,
+				Keyspace: ks,
+				Table:    &vindexes.Table{Name: sqlparser.NewTableIdent("t")},
+				Query:    "delete from t limit :__dml_limit",
+			},
+		},
+		Keyspace: ks,
+		Vindex:   vindex.(vindexes.SingleColumn),
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-", "-20"}
+	vc.results = []*sqltypes.Result{{RowsAffected: 2}, {RowsAffected: 1}}
+	qr, err := ldml.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, qr.RowsAffected)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1" type:INT64 value:"4" type:INT64 value:"2"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(d2fd8867d50d2dfe),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ResolveDestinations ks [] Destinations:DestinationShard(-20)`,
+		`ExecuteMultiShard ks.DestinationShard(-20): delete from t limit :__dml_limit {__dml_limit: type:INT64 value:"2"} true true`,
+		`ResolveDestinations ks [] Destinations:DestinationShard(20-)`,
+		`ExecuteMultiShard ks.DestinationShard(20-): delete from t limit :__dml_limit {__dml_limit: type:INT64 value:"1"} true true`,
+	})
+
+	// No rows selected: nothing gets deleted.
+	input.rewind()
+	input.results = []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"))}
+	vc.Rewind()
+	qr, err = ldml.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, qr.RowsAffected)
+	vc.ExpectLog(t, nil)
+}
+
+func TestLimitedDMLUpdate(t *testing.T) {
+	vindex, _ := vindexes.NewHash("", nil)
+	ks := &vindexes.Keyspace{Name: "ks", Sharded: true}
+	ldml := &LimitedDML{
+		Input: &fakePrimitive{
+			results: []*sqltypes.Result{
+				sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|col", "int64|int64"), "4|1", "4|2"),
+			},
+		},
+		DML: &Update{
+			DML: DML{
+				Opcode:   Scatter,
+				Keyspace: ks,
+				Table:    &vindexes.Table{Name: sqlparser.NewTableIdent("t")},
+				Query:    "update t set a = 1 order by col asc limit :__dml_limit",
+			},
+		},
+		Keyspace: ks,
+		Vindex:   vindex.(vindexes.SingleColumn),
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "20-"}
+	_, err := ldml.TryExecute(vc, map[string]*querypb.BindVariable{"x": sqltypes.Int64BindVariable(1)}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"4" type:INT64 value:"4"] Destinations:DestinationKeyspaceID(d2fd8867d50d2dfe),DestinationKeyspaceID(d2fd8867d50d2dfe)`,
+		`ResolveDestinations ks [] Destinations:DestinationShard(20-)`,
+		`ExecuteMultiShard ks.DestinationShard(20-): update t set a = 1 order by col asc limit :__dml_limit {__dml_limit: type:INT64 value:"2" x: type:INT64 value:"1"} true true`,
+	})
+}
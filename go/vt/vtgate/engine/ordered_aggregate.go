@@ -17,8 +17,14 @@ limitations under the License.
 package engine
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
+	"sort"
 	"strconv"
+	"strings"
 
 	"vitess.io/vitess/go/mysql/collations"
 
@@ -90,6 +96,16 @@ type AggregateParams struct {
 	WCol      int
 	WAssigned bool
 
+	// These are used only by the opcodes that are computed from other
+	// aggregates pushed down to the input. CountCol holds count(expr) for
+	// avg and the variance functions, and AvgCol holds avg(expr) for the
+	// variance functions.
+	CountCol int
+	AvgCol   int
+
+	// Separator is used only by group_concat.
+	Separator string `json:",omitempty"`
+
 	Alias string `json:",omitempty"`
 	Expr  sqlparser.Expr
 }
@@ -99,7 +115,7 @@ func (ap *AggregateParams) isDistinct() bool {
 }
 
 func (ap *AggregateParams) preProcess() bool {
-	return ap.Opcode == AggregateCountDistinct || ap.Opcode == AggregateSumDistinct || ap.Opcode == AggregateGtid
+	return ap.Opcode == AggregateCountDistinct || ap.Opcode == AggregateSumDistinct || ap.Opcode == AggregateGtid || ap.Opcode.NeedsRewrite()
 }
 
 func (ap *AggregateParams) String() string {
@@ -125,6 +141,17 @@ const (
 	AggregateCountDistinct
 	AggregateSumDistinct
 	AggregateGtid
+	AggregateAvg
+	AggregateBitAnd
+	AggregateBitOr
+	AggregateBitXor
+	AggregateGroupConcat
+	AggregateVarPop
+	AggregateVarSamp
+	AggregateStdDevPop
+	AggregateStdDevSamp
+	AggregateJSONArrayAgg
+	AggregateJSONObjectAgg
 )
 
 var (
@@ -135,6 +162,16 @@ var (
 		AggregateSumDistinct:   sqltypes.Decimal,
 		AggregateSum:           sqltypes.Decimal,
 		AggregateGtid:          sqltypes.VarChar,
+		AggregateAvg:           sqltypes.Decimal,
+		AggregateBitAnd:        sqltypes.Uint64,
+		AggregateBitOr:         sqltypes.Uint64,
+		AggregateBitXor:        sqltypes.Uint64,
+		AggregateVarPop:        sqltypes.Float64,
+		AggregateVarSamp:       sqltypes.Float64,
+		AggregateStdDevPop:     sqltypes.Float64,
+		AggregateStdDevSamp:    sqltypes.Float64,
+		AggregateJSONArrayAgg:  sqltypes.TypeJSON,
+		AggregateJSONObjectAgg: sqltypes.TypeJSON,
 	}
 	// Some predefined values
 	countZero = sqltypes.MakeTrusted(sqltypes.Int64, []byte("0"))
@@ -145,10 +182,25 @@ var (
 // SupportedAggregates maps the list of supported aggregate
 // functions to their opcodes.
 var SupportedAggregates = map[string]AggregateOpcode{
-	"count": AggregateCount,
-	"sum":   AggregateSum,
-	"min":   AggregateMin,
-	"max":   AggregateMax,
+	"count":          AggregateCount,
+	"sum":            AggregateSum,
+	"min":            AggregateMin,
+	"max":            AggregateMax,
+	"avg":            AggregateAvg,
+	"bit_and":        AggregateBitAnd,
+	"bit_or":         AggregateBitOr,
+	"bit_xor":        AggregateBitXor,
+	"group_concat":   AggregateGroupConcat,
+	"var_pop":        AggregateVarPop,
+	"var_samp":       AggregateVarSamp,
+	"stddev_pop":     AggregateStdDevPop,
+	"stddev_samp":    AggregateStdDevSamp,
+	"json_arrayagg":  AggregateJSONArrayAgg,
+	"json_objectagg": AggregateJSONObjectAgg,
+	// These are synonyms of the functions above.
+	"variance": AggregateVarPop,
+	"std":      AggregateStdDevPop,
+	"stddev":   AggregateStdDevPop,
 	// These functions don't exist in mysql, but are used
 	// to display the plan.
 	"count_distinct": AggregateCountDistinct,
@@ -156,15 +208,35 @@ var SupportedAggregates = map[string]AggregateOpcode{
 	"vgtid":          AggregateGtid,
 }
 
+// aggregateSynonyms lists the names in SupportedAggregates
+// that are never used to display the plan.
+var aggregateSynonyms = map[string]bool{
+	"variance": true,
+	"std":      true,
+	"stddev":   true,
+}
+
 func (code AggregateOpcode) String() string {
 	for k, v := range SupportedAggregates {
-		if v == code {
+		if v == code && !aggregateSynonyms[k] {
 			return k
 		}
 	}
 	panic("unreachable")
 }
 
+// NeedsRewrite returns true if the aggregate cannot be computed by merging
+// the results of the same function from every shard. Instead, the input has
+// to return the aggregates described in AggregateParams, and the final value
+// is computed once all the rows of a group have been merged.
+func (code AggregateOpcode) NeedsRewrite() bool {
+	switch code {
+	case AggregateAvg, AggregateVarPop, AggregateVarSamp, AggregateStdDevPop, AggregateStdDevSamp:
+		return true
+	}
+	return false
+}
+
 // MarshalJSON serializes the AggregateOpcode as a JSON string.
 // It's used for testing and diagnostics.
 func (code AggregateOpcode) MarshalJSON() ([]byte, error) {
@@ -241,7 +313,11 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 			}
 			continue
 		}
-		out.Rows = append(out.Rows, current)
+		final, err := oa.convertFinal(current)
+		if err != nil {
+			return nil, err
+		}
+		out.Rows = append(out.Rows, final)
 		current, curDistincts = oa.convertRow(row)
 	}
 
@@ -302,7 +378,11 @@ func (oa *OrderedAggregate) TryStreamExecute(vcursor VCursor, bindVars map[strin
 				}
 				continue
 			}
-			if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+			final, err := oa.convertFinal(current)
+			if err != nil {
+				return err
+			}
+			if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{final}}); err != nil {
 				return err
 			}
 			current, curDistincts = oa.convertRow(row)
@@ -314,7 +394,11 @@ func (oa *OrderedAggregate) TryStreamExecute(vcursor VCursor, bindVars map[strin
 	}
 
 	if current != nil {
-		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+		final, err := oa.convertFinal(current)
+		if err != nil {
+			return err
+		}
+		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{final}}); err != nil {
 			return err
 		}
 	}
@@ -329,9 +413,14 @@ func (oa *OrderedAggregate) convertFields(fields []*querypb.Field) []*querypb.Fi
 		if !aggr.preProcess() {
 			continue
 		}
+		typ := OpcodeType[aggr.Opcode]
+		if aggr.Opcode == AggregateAvg && sqltypes.IsFloat(fields[aggr.Col].Type) {
+			// like in MySQL, the average of approximate values is a DOUBLE
+			typ = sqltypes.Float64
+		}
 		fields[aggr.Col] = &querypb.Field{
 			Name: aggr.Alias,
-			Type: OpcodeType[aggr.Opcode],
+			Type: typ,
 		}
 		if aggr.isDistinct() {
 			aggr.KeyCol = aggr.Col
@@ -459,6 +548,21 @@ func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes
 			result[aggr.Col], err = evalengine.NullSafeAdd(row1[aggr.Col], countOne, OpcodeType[aggr.Opcode])
 		case AggregateSumDistinct:
 			result[aggr.Col], err = evalengine.NullSafeAdd(row1[aggr.Col], row2[aggr.Col], OpcodeType[aggr.Opcode])
+		case AggregateAvg:
+			result[aggr.Col], err = evalengine.NullSafeAdd(row1[aggr.Col], row2[aggr.Col], fields[aggr.Col].Type)
+			if err == nil {
+				result[aggr.CountCol], err = evalengine.NullSafeAdd(row1[aggr.CountCol], row2[aggr.CountCol], OpcodeType[AggregateCount])
+			}
+		case AggregateBitAnd, AggregateBitOr, AggregateBitXor:
+			result[aggr.Col], err = mergeBits(aggr.Opcode, row1[aggr.Col], row2[aggr.Col])
+		case AggregateGroupConcat:
+			result[aggr.Col] = mergeGroupConcat(row1[aggr.Col], row2[aggr.Col], aggr.Separator)
+		case AggregateVarPop, AggregateVarSamp, AggregateStdDevPop, AggregateStdDevSamp:
+			err = mergeVariance(aggr, result, row1, row2)
+		case AggregateJSONArrayAgg:
+			result[aggr.Col], err = mergeJSONArrays(row1[aggr.Col], row2[aggr.Col])
+		case AggregateJSONObjectAgg:
+			result[aggr.Col], err = mergeJSONObjects(row1[aggr.Col], row2[aggr.Col])
 		case AggregateGtid:
 			vgtid := &binlogdatapb.VGtid{}
 			rowBytes, err := row1[aggr.Col].ToBytes()
@@ -510,9 +614,22 @@ func createEmptyValueFor(opcode AggregateOpcode) (sqltypes.Value, error) {
 		AggregateSumDistinct,
 		AggregateSum,
 		AggregateMin,
-		AggregateMax:
+		AggregateMax,
+		AggregateAvg,
+		AggregateGroupConcat,
+		AggregateVarPop,
+		AggregateVarSamp,
+		AggregateStdDevPop,
+		AggregateStdDevSamp,
+		AggregateJSONArrayAgg,
+		AggregateJSONObjectAgg:
 		return sqltypes.NULL, nil
-
+	case AggregateBitAnd:
+		return sqltypes.NewUint64(math.MaxUint64), nil
+	case
+		AggregateBitOr,
+		AggregateBitXor:
+		return sqltypes.NewUint64(0), nil
 	}
 	return sqltypes.NULL, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown aggregation %v", opcode)
 }
@@ -557,7 +674,236 @@ func (oa *OrderedAggregate) convertFinal(current []sqltypes.Value) ([]sqltypes.V
 				return nil, err
 			}
 			result[aggr.Col] = sqltypes.NewVarChar(vgtid.String())
+		case AggregateAvg:
+			avg, err := finalAverage(current[aggr.Col], current[aggr.CountCol])
+			if err != nil {
+				return nil, err
+			}
+			result[aggr.Col] = avg
+		case AggregateVarSamp, AggregateStdDevPop, AggregateStdDevSamp:
+			variance, err := finalVariance(aggr.Opcode, current[aggr.Col], current[aggr.CountCol])
+			if err != nil {
+				return nil, err
+			}
+			result[aggr.Col] = variance
 		}
 	}
 	return result, nil
 }
+
+func mergeBits(opcode AggregateOpcode, v1, v2 sqltypes.Value) (sqltypes.Value, error) {
+	if v1.IsNull() {
+		return v2, nil
+	}
+	if v2.IsNull() {
+		return v1, nil
+	}
+	b1, err := evalengine.ToUint64(v1)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	b2, err := evalengine.ToUint64(v2)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch opcode {
+	case AggregateBitAnd:
+		return sqltypes.NewUint64(b1 & b2), nil
+	case AggregateBitOr:
+		return sqltypes.NewUint64(b1 | b2), nil
+	default:
+		return sqltypes.NewUint64(b1 ^ b2), nil
+	}
+}
+
+// mergeGroupConcat joins the values concatenated by two shards. Every shard applies the
+// ORDER BY of the GROUP_CONCAT to its own values, so the values of a shard stay together.
+func mergeGroupConcat(v1, v2 sqltypes.Value, separator string) sqltypes.Value {
+	if v1.IsNull() {
+		return v2
+	}
+	if v2.IsNull() {
+		return v1
+	}
+	buf := make([]byte, 0, len(v1.Raw())+len(separator)+len(v2.Raw()))
+	buf = append(buf, v1.Raw()...)
+	buf = append(buf, separator...)
+	buf = append(buf, v2.Raw()...)
+	return sqltypes.MakeTrusted(v1.Type(), buf)
+}
+
+// mergeJSONArrays appends the elements of the JSON array v2 to the JSON array v1.
+func mergeJSONArrays(v1, v2 sqltypes.Value) (sqltypes.Value, error) {
+	if v1.IsNull() {
+		return v2, nil
+	}
+	if v2.IsNull() {
+		return v1, nil
+	}
+	a1, err := jsonContainer(v1, '[', ']')
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	a2, err := jsonContainer(v2, '[', ']')
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch {
+	case len(bytes.TrimSpace(a1[1:len(a1)-1])) == 0:
+		return v2, nil
+	case len(bytes.TrimSpace(a2[1:len(a2)-1])) == 0:
+		return v1, nil
+	}
+	buf := make([]byte, 0, len(a1)+len(a2)+1)
+	buf = append(buf, a1[:len(a1)-1]...)
+	buf = append(buf, ", "...)
+	buf = append(buf, a2[1:]...)
+	return sqltypes.MakeTrusted(v1.Type(), buf), nil
+}
+
+// mergeJSONObjects merges the members of two JSON objects. Like in MySQL, the last
+// value of a duplicated key wins, and the keys are sorted by length and then bytewise.
+func mergeJSONObjects(v1, v2 sqltypes.Value) (sqltypes.Value, error) {
+	if v1.IsNull() {
+		return v2, nil
+	}
+	if v2.IsNull() {
+		return v1, nil
+	}
+	members := map[string]json.RawMessage{}
+	for _, v := range []sqltypes.Value{v1, v2} {
+		obj, err := jsonContainer(v, '{', '}')
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if err := json.Unmarshal(obj, &members); err != nil {
+			return sqltypes.NULL, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid JSON object returned by json_objectagg: %v", err)
+		}
+	}
+	keys := make([]string, 0, len(members))
+	for key := range members {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		buf.Write(encodedKey)
+		buf.WriteString(": ")
+		buf.Write(members[key])
+	}
+	buf.WriteByte('}')
+	return sqltypes.MakeTrusted(v1.Type(), buf.Bytes()), nil
+}
+
+func jsonContainer(v sqltypes.Value, open, close byte) ([]byte, error) {
+	raw := bytes.TrimSpace(v.Raw())
+	if len(raw) < 2 || raw[0] != open || raw[len(raw)-1] != close {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "unexpected JSON value in aggregation: %s", v.String())
+	}
+	return raw, nil
+}
+
+// mergeVariance combines the population variance, the average and the number of values
+// of two groups into the ones of their union, using the parallel algorithm of Chan et al.
+func mergeVariance(aggr *AggregateParams, result, row1, row2 []sqltypes.Value) error {
+	n2, err := evalengine.ToInt64(row2[aggr.CountCol])
+	if err != nil || n2 == 0 {
+		return err
+	}
+	n1, err := evalengine.ToInt64(row1[aggr.CountCol])
+	if err != nil {
+		return err
+	}
+	if n1 == 0 {
+		result[aggr.Col] = row2[aggr.Col]
+		result[aggr.AvgCol] = row2[aggr.AvgCol]
+		result[aggr.CountCol] = row2[aggr.CountCol]
+		return nil
+	}
+
+	var values [4]float64
+	for i, v := range []sqltypes.Value{row1[aggr.Col], row1[aggr.AvgCol], row2[aggr.Col], row2[aggr.AvgCol]} {
+		values[i], err = evalengine.ToFloat64(v)
+		if err != nil {
+			return err
+		}
+	}
+	var1, avg1, var2, avg2 := values[0], values[1], values[2], values[3]
+
+	count1, count2 := float64(n1), float64(n2)
+	count := count1 + count2
+	delta := avg2 - avg1
+	m2 := var1*count1 + var2*count2 + delta*delta*count1*count2/count
+
+	result[aggr.Col] = sqltypes.NewFloat64(m2 / count)
+	result[aggr.AvgCol] = sqltypes.NewFloat64(avg1 + delta*count2/count)
+	result[aggr.CountCol] = sqltypes.NewInt64(n1 + n2)
+	return nil
+}
+
+// finalAverage divides the sum of a group by its number of values. Like in MySQL,
+// the average of exact values is a DECIMAL with 4 more digits than the sum.
+func finalAverage(sum, count sqltypes.Value) (sqltypes.Value, error) {
+	n, err := evalengine.ToInt64(count)
+	if err != nil || n == 0 || sum.IsNull() {
+		return sqltypes.NULL, err
+	}
+	if sum.IsFloat() {
+		total, err := evalengine.ToFloat64(sum)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		return sqltypes.NewFloat64(total / float64(n)), nil
+	}
+
+	str := sum.ToString()
+	total, ok := new(big.Rat).SetString(str)
+	if !ok {
+		return sqltypes.NULL, vterrors.Errorf(vtrpc.Code_INTERNAL, "cannot compute the average of: %s", sum.String())
+	}
+	scale := 4
+	if dot := strings.IndexByte(str, '.'); dot >= 0 {
+		scale += len(str) - dot - 1
+	}
+	avg := total.Quo(total, new(big.Rat).SetInt64(n))
+	return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(avg.FloatString(scale))), nil
+}
+
+// finalVariance turns the population variance of a group into the requested function.
+func finalVariance(opcode AggregateOpcode, variance, count sqltypes.Value) (sqltypes.Value, error) {
+	if variance.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	n, err := evalengine.ToInt64(count)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	result, err := evalengine.ToFloat64(variance)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if opcode == AggregateVarSamp || opcode == AggregateStdDevSamp {
+		if n <= 1 {
+			return sqltypes.NULL, nil
+		}
+		result = result * float64(n) / float64(n-1)
+	}
+	if opcode == AggregateStdDevPop || opcode == AggregateStdDevSamp {
+		result = math.Sqrt(result)
+	}
+	return sqltypes.NewFloat64(result), nil
+}
@@ -703,6 +703,16 @@ func TestNoInputAndNoGroupingKeys(outer *testing.T) {
 		AggregateMin,
 		"null",
 		"int64",
+	}, {
+		"avg(col1)",
+		AggregateAvg,
+		"null",
+		"decimal",
+	}, {
+		"col1",
+		AggregateGroupConcat,
+		"null",
+		"int64",
 	}}
 
 	for _, test := range testCases {
@@ -1187,3 +1197,279 @@ func TestOrderedAggregateCollateKS(t *testing.T) {
 	)
 	assert.Equal(wantResult, result)
 }
+
+func TestOrderedAggregateAvg(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|avg(val)|count(val)",
+		"varbinary|decimal|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|3|2",
+			"a|4|1",
+			"b|5|2",
+			"c|1.5|1",
+			"c|2.25|2",
+			"d|null|0",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		PreProcess: true,
+		Aggregates: []*AggregateParams{{
+			Opcode:   AggregateAvg,
+			Col:      1,
+			CountCol: 2,
+			Alias:    "avg(val)",
+		}},
+		GroupByKeys:         []*GroupByParams{{KeyCol: 0}},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	result, err := oa.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|avg(val)",
+			"varbinary|decimal",
+		),
+		"a|2.3333",
+		"b|2.5000",
+		"c|1.250000",
+		"d|null",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestOrderedAggregateStreamAvgOnFloats(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|avg(val)|count(val)",
+		"varbinary|float64|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1.5|1",
+			"a|2.5|3",
+			"b|0.5|1",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		PreProcess: true,
+		Aggregates: []*AggregateParams{{
+			Opcode:   AggregateAvg,
+			Col:      1,
+			CountCol: 2,
+			Alias:    "avg(val)",
+		}},
+		GroupByKeys:         []*GroupByParams{{KeyCol: 0}},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	var results []*sqltypes.Result
+	err := oa.TryStreamExecute(&noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	wantFields := sqltypes.MakeTestFields(
+		"col|avg(val)",
+		"varbinary|float64",
+	)
+	wantResults := sqltypes.MakeTestStreamingResults(
+		wantFields,
+		"a|1",
+		"---",
+		"b|0.5",
+	)
+	utils.MustMatch(t, wantResults, results)
+}
+
+func TestOrderedAggregateVariance(t *testing.T) {
+	testCases := []struct {
+		opcode AggregateOpcode
+		want   string
+	}{
+		{AggregateVarPop, "2"},
+		{AggregateVarSamp, "2.5"},
+		{AggregateStdDevPop, "1.4142135623730951"},
+		{AggregateStdDevSamp, "1.5811388300841898"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.opcode.String(), func(t *testing.T) {
+			// the values 1, 2, 3 are on the first shard and 4, 5 on the second one
+			fp := &fakePrimitive{
+				results: []*sqltypes.Result{sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields(
+						"col|var_pop(val)|count(val)|avg(val)",
+						"varbinary|float64|int64|decimal",
+					),
+					"a|0.6666666666666666|3|2.0000",
+					"a|0.25|2|4.5000",
+					"b|0|1|7.0000",
+					"b|null|0|null",
+				)},
+			}
+
+			oa := &OrderedAggregate{
+				PreProcess: true,
+				Aggregates: []*AggregateParams{{
+					Opcode:   tc.opcode,
+					Col:      1,
+					CountCol: 2,
+					AvgCol:   3,
+					Alias:    "v",
+				}},
+				GroupByKeys:         []*GroupByParams{{KeyCol: 0}},
+				TruncateColumnCount: 2,
+				Input:               fp,
+			}
+
+			result, err := oa.TryExecute(&noopVCursor{}, nil, false)
+			require.NoError(t, err)
+
+			wantB := "0"
+			if tc.opcode == AggregateVarSamp || tc.opcode == AggregateStdDevSamp {
+				// the sample variance is not defined for a single value
+				wantB = "null"
+			}
+			wantResult := sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col|v",
+					"varbinary|float64",
+				),
+				"a|"+tc.want,
+				"b|"+wantB,
+			)
+			assert.Equal(t, wantResult, result)
+		})
+	}
+}
+
+func TestOrderedAggregateBitFunctions(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|bit_and(val)|bit_or(val)|bit_xor(val)",
+		"varbinary|uint64|uint64|uint64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|12|12|12",
+			"a|10|3|6",
+			"b|18446744073709551615|0|0",
+			"b|5|4|4",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateBitAnd,
+			Col:    1,
+		}, {
+			Opcode: AggregateBitOr,
+			Col:    2,
+		}, {
+			Opcode: AggregateBitXor,
+			Col:    3,
+		}},
+		GroupByKeys: []*GroupByParams{{KeyCol: 0}},
+		Input:       fp,
+	}
+
+	result, err := oa.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"a|8|15|10",
+		"b|5|4|4",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestOrderedAggregateGroupConcat(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|group_concat(val)",
+		"varbinary|varchar",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|x; y",
+			"a|null",
+			"a|z",
+			"b|null",
+			"c|w",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []*AggregateParams{{
+			Opcode:    AggregateGroupConcat,
+			Col:       1,
+			Separator: "; ",
+		}},
+		GroupByKeys: []*GroupByParams{{KeyCol: 0}},
+		Input:       fp,
+	}
+
+	result, err := oa.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"a|x; y; z",
+		"b|null",
+		"c|w",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestOrderedAggregateJSONFunctions(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|json_arrayagg(val)|json_objectagg(k, val)",
+		"varbinary|json|json",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			`a|[1, "x"]|{"bb": 1, "c": [1, 2]}`,
+			`a|[{"k": 2}]|{"a": 2, "bb": 3}`,
+			`b|null|null`,
+			`b|[null]|{"b": null}`,
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []*AggregateParams{{
+			Opcode: AggregateJSONArrayAgg,
+			Col:    1,
+		}, {
+			Opcode: AggregateJSONObjectAgg,
+			Col:    2,
+		}},
+		GroupByKeys: []*GroupByParams{{KeyCol: 0}},
+		Input:       fp,
+	}
+
+	result, err := oa.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		`a|[1, "x", {"k": 2}]|{"a": 2, "c": [1, 2], "bb": 3}`,
+		`b|[null]|{"b": null}`,
+	)
+	assert.Equal(t, wantResult, result)
+
+	fp.rewind()
+	fp.results[0].Rows[1][2] = sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte("[1]"))
+	_, err = oa.TryExecute(&noopVCursor{}, nil, false)
+	require.EqualError(t, err, `unexpected JSON value in aggregation: JSON("[1]")`)
+}
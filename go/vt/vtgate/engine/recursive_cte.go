@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var _ Primitive = (*RecursiveCTE)(nil)
+
+// DefaultMaxRecursionDepth is the default value of MySQL's cte_max_recursion_depth.
+const DefaultMaxRecursionDepth = 1000
+
+// RecursiveCTE evaluates a recursive common table expression at the vtgate level.
+// The Anchor produces the first working set. The Recursive primitive is then
+// executed once for every row of the working set, with the columns listed
+// in Vars bound as bind variables, and the rows it produces become the next
+// working set. The iteration stops when an iteration produces no new rows.
+type RecursiveCTE struct {
+	// Anchor is the non-recursive part of the CTE.
+	Anchor Primitive
+	// Recursive is the recursive part of the CTE, with the
+	// references to the CTE replaced by bind variables.
+	Recursive Primitive
+
+	// Vars maps the bind variables used by Recursive
+	// to the column offsets of the working row.
+	Vars map[string]int `json:",omitempty"`
+
+	// Distinct is set for UNION DISTINCT. Rows that were already
+	// produced are then discarded and do not take part in the
+	// next iteration. Rows are compared using their binary representation.
+	Distinct bool `json:",omitempty"`
+
+	// MaxRecursionDepth is the maximum number of iterations
+	// before the query is aborted.
+	MaxRecursionDepth int
+}
+
+// RouteType implements the Primitive interface
+func (rc *RecursiveCTE) RouteType() string {
+	return "RecursiveCTE"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (rc *RecursiveCTE) GetKeyspaceName() string {
+	if rc.Anchor.GetKeyspaceName() == rc.Recursive.GetKeyspaceName() {
+		return rc.Anchor.GetKeyspaceName()
+	}
+	return rc.Anchor.GetKeyspaceName() + "_" + rc.Recursive.GetKeyspaceName()
+}
+
+// GetTableName implements the Primitive interface
+func (rc *RecursiveCTE) GetTableName() string {
+	return rc.Anchor.GetTableName() + "_" + rc.Recursive.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface
+func (rc *RecursiveCTE) NeedsTransaction() bool {
+	return rc.Anchor.NeedsTransaction() || rc.Recursive.NeedsTransaction()
+}
+
+// TryExecute implements the Primitive interface
+func (rc *RecursiveCTE) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	anchor, err := vcursor.ExecutePrimitive(rc.Anchor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{Fields: anchor.Fields}
+	seen := map[string]struct{}{}
+	working := rc.appendRows(result, anchor.Rows, seen)
+
+	for depth := 1; len(working) > 0; depth++ {
+		if depth > rc.maxDepth() {
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "Recursive query aborted after %d iterations. Try increasing @@cte_max_recursion_depth to a larger value.", depth)
+		}
+		var next [][]sqltypes.Value
+		joinVars := make(map[string]*querypb.BindVariable, len(rc.Vars))
+		for _, row := range working {
+			for k, col := range rc.Vars {
+				joinVars[k] = sqltypes.ValueBindVariable(row[col])
+			}
+			rresult, err := vcursor.ExecutePrimitive(rc.Recursive, combineVars(bindVars, joinVars), false)
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, rresult.Rows...)
+		}
+		working = rc.appendRows(result, next, seen)
+	}
+	return result, nil
+}
+
+// TryStreamExecute implements the Primitive interface
+func (rc *RecursiveCTE) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	// Every iteration depends on the complete result of the previous one,
+	// so there is nothing to gain from streaming the intermediate results.
+	result, err := rc.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (rc *RecursiveCTE) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return rc.Anchor.GetFields(vcursor, bindVars)
+}
+
+// Inputs implements the Primitive interface
+func (rc *RecursiveCTE) Inputs() []Primitive {
+	return []Primitive{rc.Anchor, rc.Recursive}
+}
+
+func (rc *RecursiveCTE) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"MaxRecursionDepth": rc.maxDepth(),
+	}
+	if len(rc.Vars) > 0 {
+		other["JoinVars"] = orderedStringIntMap(rc.Vars)
+	}
+	variant := "UnionAll"
+	if rc.Distinct {
+		variant = "Union"
+	}
+	return PrimitiveDescription{
+		OperatorType: "RecursiveCTE",
+		Variant:      variant,
+		Other:        other,
+	}
+}
+
+func (rc *RecursiveCTE) maxDepth() int {
+	if rc.MaxRecursionDepth <= 0 {
+		return DefaultMaxRecursionDepth
+	}
+	return rc.MaxRecursionDepth
+}
+
+// appendRows adds the rows to the result and returns the rows
+// that make up the working set of the next iteration.
+func (rc *RecursiveCTE) appendRows(result *sqltypes.Result, rows [][]sqltypes.Value, seen map[string]struct{}) [][]sqltypes.Value {
+	if !rc.Distinct {
+		result.Rows = append(result.Rows, rows...)
+		return rows
+	}
+	var added [][]sqltypes.Value
+	for _, row := range rows {
+		key := rowKey(row)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		added = append(added, row)
+	}
+	result.Rows = append(result.Rows, added...)
+	return added
+}
+
+func rowKey(row []sqltypes.Value) string {
+	var key strings.Builder
+	for _, value := range row {
+		if value.IsNull() {
+			key.WriteString("N;")
+			continue
+		}
+		raw := value.Raw()
+		key.WriteString(strconv.Itoa(len(raw)))
+		key.WriteByte(':')
+		key.Write(raw)
+	}
+	return key.String()
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestRecursiveCTEExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"id|parent",
+		"int64|int64",
+	)
+	anchor := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "1|0"),
+		},
+	}
+	recursive := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "2|1", "3|1"),
+			sqltypes.MakeTestResult(fields, "4|2"),
+			sqltypes.MakeTestResult(fields),
+			sqltypes.MakeTestResult(fields),
+		},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	rc := &RecursiveCTE{
+		Anchor:    anchor,
+		Recursive: recursive,
+		Vars: map[string]int{
+			"cte_id": 0,
+		},
+	}
+	r, err := rc.TryExecute(&noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	anchor.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" true`,
+	})
+	recursive.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" cte_id: type:INT64 value:"1" false`,
+		`Execute a: type:INT64 value:"10" cte_id: type:INT64 value:"2" false`,
+		`Execute a: type:INT64 value:"10" cte_id: type:INT64 value:"3" false`,
+		`Execute a: type:INT64 value:"10" cte_id: type:INT64 value:"4" false`,
+	})
+	expectResult(t, "rc.Execute", r, sqltypes.MakeTestResult(
+		fields,
+		"1|0",
+		"2|1",
+		"3|1",
+		"4|2",
+	))
+
+	// Streaming returns the same rows
+	anchor.rewind()
+	recursive.rewind()
+	r, err = wrapStreamExecute(rc, &noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	expectResult(t, "rc.StreamExecute", r, sqltypes.MakeTestResult(
+		fields,
+		"1|0",
+		"2|1",
+		"3|1",
+		"4|2",
+	))
+}
+
+func TestRecursiveCTEDistinct(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"id",
+		"int64",
+	)
+	anchor := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "1", "1"),
+		},
+	}
+	recursive := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "1", "2"),
+			sqltypes.MakeTestResult(fields, "1"),
+		},
+	}
+
+	rc := &RecursiveCTE{
+		Anchor:    anchor,
+		Recursive: recursive,
+		Vars: map[string]int{
+			"cte_id": 0,
+		},
+		Distinct: true,
+	}
+	r, err := rc.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	recursive.ExpectLog(t, []string{
+		`Execute cte_id: type:INT64 value:"1" false`,
+		`Execute cte_id: type:INT64 value:"2" false`,
+	})
+	expectResult(t, "rc.Execute", r, sqltypes.MakeTestResult(
+		fields,
+		"1",
+		"2",
+	))
+}
+
+func TestRecursiveCTEMaxRecursionDepth(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"id",
+		"int64",
+	)
+	anchor := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "1"),
+		},
+	}
+	recursive := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "2"),
+			sqltypes.MakeTestResult(fields, "3"),
+			sqltypes.MakeTestResult(fields, "4"),
+		},
+	}
+
+	rc := &RecursiveCTE{
+		Anchor:            anchor,
+		Recursive:         recursive,
+		MaxRecursionDepth: 2,
+	}
+	_, err := rc.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.EqualError(t, err, "Recursive query aborted after 3 iterations. Try increasing @@cte_max_recursion_depth to a larger value.")
+}
+
+func TestRecursiveCTEError(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"id",
+		"int64",
+	)
+	anchor := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "1"),
+		},
+	}
+	recursive := &fakePrimitive{
+		sendErr: errors.New("recursive err"),
+	}
+
+	rc := &RecursiveCTE{
+		Anchor:    anchor,
+		Recursive: recursive,
+	}
+	_, err := rc.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.EqualError(t, err, "recursive err")
+
+	anchor.sendErr = errors.New("anchor err")
+	anchor.results = nil
+	_, err = rc.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.EqualError(t, err, "anchor err")
+}
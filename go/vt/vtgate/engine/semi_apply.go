@@ -0,0 +1,434 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*SemiApply)(nil)
+
+// DefaultSemiApplyBatchSize is the number of outer rows
+// for which a batched SemiApply executes its subquery at once.
+const DefaultSemiApplyBatchSize = 100
+
+// SemiApply evaluates a correlated subquery for the rows of an outer query
+// that cannot be sent to a single route, because the tables of the subquery
+// live on different shards than the tables of the outer query.
+//
+// Like the SemiJoin, the subquery is executed once for every outer row, with the
+// outer columns listed in Vars supplied as bind variables. When BatchVar is set,
+// the subquery is instead executed once for every batch of outer rows: the distinct
+// values of the outer column BatchCol are sent as a list bind variable, and the subquery
+// returns the value it was correlated with in its first column. The results are then
+// matched back to the outer rows using a hash table, like in the HashJoin.
+//
+// The result of the subquery is used the same way as in a PulloutSubquery:
+// with PulloutExists, outer rows are kept if the subquery returned rows (or if it
+// did not, when Negated is set). With PulloutValue, the subquery must return at most
+// one row and one column, and its value is made available as an extra column.
+type SemiApply struct {
+	Opcode  PulloutOpcode
+	Negated bool `json:",omitempty"`
+
+	// Outer and Subquery are the primitives of the outer query and of
+	// the correlated subquery. They can be any primitive.
+	Outer, Subquery Primitive `json:",omitempty"`
+
+	// Vars defines the bind variables built from the outer row
+	// before the subquery is executed.
+	Vars map[string]int `json:",omitempty"`
+
+	// Cols defines which columns should be returned. Columns of the
+	// outer query are numbered -1, -2, etc. The value of the subquery is 1.
+	Cols []int `json:",omitempty"`
+
+	// ValueName is the name of the column holding the value of the subquery.
+	ValueName string `json:",omitempty"`
+
+	// Predicate, if set, is evaluated on the outer row, with the value of the subquery
+	// bound to SubqueryResult. Rows for which the predicate is not true are discarded.
+	Predicate      evalengine.Expr `json:",omitempty"`
+	ASTPredicate   sqlparser.Expr  `json:",omitempty"`
+	SubqueryResult string          `json:",omitempty"`
+
+	// BatchVar is the list bind variable used to execute
+	// the subquery for a batch of outer rows.
+	BatchVar  string `json:",omitempty"`
+	BatchCol  int    `json:",omitempty"`
+	BatchSize int    `json:",omitempty"`
+
+	// Collation and ComparisonType are used to match
+	// the batched results with the outer rows.
+	Collation      collations.ID `json:",omitempty"`
+	ComparisonType querypb.Type  `json:",omitempty"`
+}
+
+// TryExecute performs a non-streaming exec.
+func (sa *SemiApply) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	oresult, err := vcursor.ExecutePrimitive(sa.Outer, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	if wantfields {
+		result.Fields, err = sa.fields(vcursor, bindVars, oresult.Fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+	result.Rows, err = sa.apply(vcursor, bindVars, oresult.Rows)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TryStreamExecute performs a streaming exec.
+func (sa *SemiApply) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return vcursor.StreamExecutePrimitive(sa.Outer, bindVars, wantfields, func(oresult *sqltypes.Result) error {
+		result := &sqltypes.Result{}
+		if oresult.Fields != nil {
+			fields, err := sa.fields(vcursor, bindVars, oresult.Fields)
+			if err != nil {
+				return err
+			}
+			result.Fields = fields
+		}
+		rows, err := sa.apply(vcursor, bindVars, oresult.Rows)
+		if err != nil {
+			return err
+		}
+		result.Rows = rows
+		return callback(result)
+	})
+}
+
+// GetFields fetches the field info.
+func (sa *SemiApply) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	oresult, err := sa.Outer.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := sa.fields(vcursor, bindVars, oresult.Fields)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: fields}, nil
+}
+
+// Inputs returns the input primitives for this SemiApply
+func (sa *SemiApply) Inputs() []Primitive {
+	return []Primitive{sa.Outer, sa.Subquery}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (sa *SemiApply) RouteType() string {
+	return "SemiApply"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (sa *SemiApply) GetKeyspaceName() string {
+	if sa.Outer.GetKeyspaceName() == sa.Subquery.GetKeyspaceName() {
+		return sa.Outer.GetKeyspaceName()
+	}
+	return sa.Outer.GetKeyspaceName() + "_" + sa.Subquery.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (sa *SemiApply) GetTableName() string {
+	return sa.Outer.GetTableName() + "_" + sa.Subquery.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface
+func (sa *SemiApply) NeedsTransaction() bool {
+	return sa.Subquery.NeedsTransaction() || sa.Outer.NeedsTransaction()
+}
+
+func (sa *SemiApply) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName":        sa.GetTableName(),
+		"ProjectedIndexes": strings.Trim(strings.Join(strings.Fields(fmt.Sprint(sa.Cols)), ","), "[]"),
+	}
+	if len(sa.Vars) > 0 {
+		other["JoinVars"] = orderedStringIntMap(sa.Vars)
+	}
+	if sa.ASTPredicate != nil {
+		other["Predicate"] = sqlparser.String(sa.ASTPredicate)
+		other["SubqueryResult"] = sa.SubqueryResult
+	}
+	if sa.BatchVar != "" {
+		other["BatchVar"] = sa.BatchVar
+		other["BatchColumn"] = sa.BatchCol
+		other["BatchSize"] = sa.batchSize()
+	}
+	return PrimitiveDescription{
+		OperatorType: "SemiApply",
+		Variant:      sa.variant(),
+		Other:        other,
+	}
+}
+
+func (sa *SemiApply) variant() string {
+	switch {
+	case sa.Opcode == PulloutValue:
+		return "Value"
+	case sa.Negated:
+		return "NotExists"
+	default:
+		return "Exists"
+	}
+}
+
+func (sa *SemiApply) batchSize() int {
+	if sa.BatchSize <= 0 {
+		return DefaultSemiApplyBatchSize
+	}
+	return sa.BatchSize
+}
+
+// valueCol is the column of the subquery result that holds the value of the subquery.
+func (sa *SemiApply) valueCol() int {
+	if sa.BatchVar != "" {
+		return 1
+	}
+	return 0
+}
+
+func (sa *SemiApply) fields(vcursor VCursor, bindVars map[string]*querypb.BindVariable, ofields []*querypb.Field) ([]*querypb.Field, error) {
+	if ofields == nil {
+		return nil, nil
+	}
+	var valueField *querypb.Field
+	fields := make([]*querypb.Field, len(sa.Cols))
+	for i, index := range sa.Cols {
+		if index < 0 {
+			fields[i] = ofields[-index-1]
+			continue
+		}
+		if valueField == nil {
+			var err error
+			valueField, err = sa.valueField(vcursor, bindVars)
+			if err != nil {
+				return nil, err
+			}
+		}
+		fields[i] = valueField
+	}
+	return fields, nil
+}
+
+func (sa *SemiApply) valueField(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*querypb.Field, error) {
+	nullVars := make(map[string]*querypb.BindVariable, len(sa.Vars)+1)
+	for k := range sa.Vars {
+		nullVars[k] = sqltypes.NullBindVariable
+	}
+	if sa.BatchVar != "" {
+		nullVars[sa.BatchVar] = &querypb.BindVariable{
+			Type:   querypb.Type_TUPLE,
+			Values: []*querypb.Value{sqltypes.ValueToProto(sqltypes.NULL)},
+		}
+	}
+	sresult, err := sa.Subquery.GetFields(vcursor, combineVars(bindVars, nullVars))
+	if err != nil {
+		return nil, err
+	}
+	if len(sresult.Fields) != sa.valueCol()+1 {
+		return nil, errSqColumn
+	}
+	field := sresult.Fields[sa.valueCol()]
+	return &querypb.Field{Name: sa.ValueName, Type: field.Type}, nil
+}
+
+// apply evaluates the subquery for the given outer rows and returns the projected rows.
+func (sa *SemiApply) apply(vcursor VCursor, bindVars map[string]*querypb.BindVariable, orows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	if sa.BatchVar != "" {
+		return sa.applyBatched(vcursor, bindVars, orows)
+	}
+
+	var rows [][]sqltypes.Value
+	joinVars := make(map[string]*querypb.BindVariable, len(sa.Vars))
+	for _, orow := range orows {
+		for k, col := range sa.Vars {
+			joinVars[k] = sqltypes.ValueBindVariable(orow[col])
+		}
+		sresult, err := vcursor.ExecutePrimitive(sa.Subquery, combineVars(bindVars, joinVars), false)
+		if err != nil {
+			return nil, err
+		}
+		rows, err = sa.addRow(rows, bindVars, orow, sresult.Rows)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+func (sa *SemiApply) applyBatched(vcursor VCursor, bindVars map[string]*querypb.BindVariable, orows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	var rows [][]sqltypes.Value
+	for len(orows) > 0 {
+		batch := orows
+		if len(batch) > sa.batchSize() {
+			batch = batch[:sa.batchSize()]
+		}
+		orows = orows[len(batch):]
+
+		probeTable, err := sa.executeBatch(vcursor, bindVars, batch)
+		if err != nil {
+			return nil, err
+		}
+		for _, orow := range batch {
+			var matches [][]sqltypes.Value
+			key := orow[sa.BatchCol]
+			if !key.IsNull() {
+				hashcode, err := evalengine.NullsafeHashcode(key, sa.Collation, sa.ComparisonType)
+				if err != nil {
+					return nil, err
+				}
+				for _, srow := range probeTable[hashcode] {
+					// hash codes can give false positives, so we need to check with a real comparison as well
+					cmp, err := evalengine.NullsafeCompare(key, srow[0], sa.Collation)
+					if err != nil {
+						return nil, err
+					}
+					if cmp == 0 {
+						matches = append(matches, srow)
+					}
+				}
+			}
+			rows, err = sa.addRow(rows, bindVars, orow, matches)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rows, nil
+}
+
+// executeBatch executes the subquery for the distinct keys of the batch
+// and returns its rows indexed by the hash code of their first column.
+func (sa *SemiApply) executeBatch(vcursor VCursor, bindVars map[string]*querypb.BindVariable, batch [][]sqltypes.Value) (map[evalengine.HashCode][][]sqltypes.Value, error) {
+	keys := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	seen := map[evalengine.HashCode][]sqltypes.Value{}
+	for _, orow := range batch {
+		key := orow[sa.BatchCol]
+		if key.IsNull() {
+			continue
+		}
+		hashcode, err := evalengine.NullsafeHashcode(key, sa.Collation, sa.ComparisonType)
+		if err != nil {
+			return nil, err
+		}
+		duplicate := false
+		for _, other := range seen[hashcode] {
+			cmp, err := evalengine.NullsafeCompare(key, other, sa.Collation)
+			if err != nil {
+				return nil, err
+			}
+			if cmp == 0 {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
+		seen[hashcode] = append(seen[hashcode], key)
+		keys.Values = append(keys.Values, sqltypes.ValueToProto(key))
+	}
+
+	probeTable := map[evalengine.HashCode][][]sqltypes.Value{}
+	if len(keys.Values) == 0 {
+		return probeTable, nil
+	}
+	sresult, err := vcursor.ExecutePrimitive(sa.Subquery, combineVars(bindVars, map[string]*querypb.BindVariable{sa.BatchVar: keys}), false)
+	if err != nil {
+		return nil, err
+	}
+	for _, srow := range sresult.Rows {
+		if srow[0].IsNull() {
+			continue
+		}
+		hashcode, err := evalengine.NullsafeHashcode(srow[0], sa.Collation, sa.ComparisonType)
+		if err != nil {
+			return nil, err
+		}
+		probeTable[hashcode] = append(probeTable[hashcode], srow)
+	}
+	return probeTable, nil
+}
+
+// addRow appends the outer row to rows if it is kept, given the rows returned by the subquery for it.
+func (sa *SemiApply) addRow(rows [][]sqltypes.Value, bindVars map[string]*querypb.BindVariable, orow []sqltypes.Value, srows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	if sa.Opcode == PulloutExists {
+		if (len(srows) > 0) == sa.Negated {
+			return rows, nil
+		}
+		return append(rows, projectRows(orow, sa.Cols)), nil
+	}
+
+	var value sqltypes.Value
+	switch len(srows) {
+	case 0:
+		value = sqltypes.NULL
+	case 1:
+		if len(srows[0]) != sa.valueCol()+1 {
+			return nil, errSqColumn
+		}
+		value = srows[0][sa.valueCol()]
+	default:
+		return nil, errSqRow
+	}
+
+	if sa.Predicate != nil {
+		env := &evalengine.ExpressionEnv{
+			BindVars: combineVars(bindVars, map[string]*querypb.BindVariable{sa.SubqueryResult: sqltypes.ValueBindVariable(value)}),
+			Row:      orow,
+		}
+		evalResult, err := sa.Predicate.Evaluate(env)
+		if err != nil {
+			return nil, err
+		}
+		if evalResult.Value().IsNull() {
+			return rows, nil
+		}
+		intEvalResult, err := evalResult.Value().ToInt64()
+		if err != nil {
+			return nil, err
+		}
+		if intEvalResult != 1 {
+			return rows, nil
+		}
+	}
+
+	row := make([]sqltypes.Value, len(sa.Cols))
+	for i, index := range sa.Cols {
+		if index < 0 {
+			row[i] = orow[-index-1]
+		} else {
+			row[i] = value
+		}
+	}
+	return append(rows, row), nil
+}
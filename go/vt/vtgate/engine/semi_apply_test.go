@@ -0,0 +1,312 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+func TestSemiApplyNotExists(t *testing.T) {
+	outerPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|c",
+			),
+		},
+	}
+	subFields := sqltypes.MakeTestFields(
+		"1",
+		"int64",
+	)
+	subPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(subFields, "1"),
+			sqltypes.MakeTestResult(subFields),
+			sqltypes.MakeTestResult(subFields, "1", "1"),
+		},
+	}
+
+	sa := &SemiApply{
+		Opcode:   PulloutExists,
+		Negated:  true,
+		Outer:    outerPrim,
+		Subquery: subPrim,
+		Vars: map[string]int{
+			"bv": 1,
+		},
+		Cols: []int{-1, -2},
+	}
+	r, err := sa.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	subPrim.ExpectLog(t, []string{
+		`Execute bv: type:VARCHAR value:"a" false`,
+		`Execute bv: type:VARCHAR value:"b" false`,
+		`Execute bv: type:VARCHAR value:"c" false`,
+	})
+	expectResult(t, "sa.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|varchar",
+		),
+		"2|b",
+	))
+}
+
+func TestSemiApplyValue(t *testing.T) {
+	outerPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|c",
+			),
+		},
+	}
+	subFields := sqltypes.MakeTestFields(
+		"max(x)",
+		"int64",
+	)
+	subPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			// GetFields
+			sqltypes.MakeTestResult(subFields),
+			sqltypes.MakeTestResult(subFields, "0"),
+			sqltypes.MakeTestResult(subFields),
+			sqltypes.MakeTestResult(subFields, "5"),
+		},
+	}
+
+	// select col1, (select max(x) ...) from ... where col1 < (select max(x) ...)
+	sa := &SemiApply{
+		Opcode:   PulloutValue,
+		Outer:    outerPrim,
+		Subquery: subPrim,
+		Vars: map[string]int{
+			"bv": 1,
+		},
+		Cols:      []int{-1, 1},
+		ValueName: "sq",
+		Predicate: &evalengine.ComparisonExpr{
+			Op:    &evalengine.EqualOp{Operator: "<", Compare: func(cmp int) bool { return cmp < 0 }},
+			Left:  evalengine.NewColumn(0, collations.TypedCollation{}),
+			Right: evalengine.NewBindVar("__sq1", collations.TypedCollation{}),
+		},
+		SubqueryResult: "__sq1",
+	}
+	r, err := sa.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	subPrim.ExpectLog(t, []string{
+		`GetFields bv: `,
+		`Execute bv:  true`,
+		`Execute bv: type:VARCHAR value:"a" false`,
+		`Execute bv: type:VARCHAR value:"b" false`,
+		`Execute bv: type:VARCHAR value:"c" false`,
+	})
+	expectResult(t, "sa.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|sq",
+			"int64|int64",
+		),
+		"3|5",
+	))
+}
+
+func TestSemiApplyValueErrors(t *testing.T) {
+	outerPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1",
+					"int64",
+				),
+				"1",
+			),
+		},
+	}
+	subPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1",
+					"int64",
+				),
+				"1",
+				"2",
+			),
+		},
+	}
+
+	sa := &SemiApply{
+		Opcode:   PulloutValue,
+		Outer:    outerPrim,
+		Subquery: subPrim,
+		Vars: map[string]int{
+			"bv": 0,
+		},
+		Cols: []int{-1, 1},
+	}
+	_, err := sa.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "subquery returned more than one row")
+
+	outerPrim.rewind()
+	subPrim.rewind()
+	subPrim.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2",
+				"int64|int64",
+			),
+			"1|2",
+		),
+	}
+	_, err = sa.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "subquery returned more than one column")
+}
+
+func TestSemiApplyBatched(t *testing.T) {
+	outerPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|int64",
+				),
+				"1|10",
+				"2|20",
+				"3|10",
+				"4|null",
+				"5|30",
+			),
+		},
+	}
+	subFields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"int64|int64",
+	)
+	subPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(subFields, "10|4"),
+			sqltypes.MakeTestResult(subFields, "30|1"),
+		},
+	}
+
+	sa := &SemiApply{
+		Opcode:         PulloutValue,
+		Outer:          outerPrim,
+		Subquery:       subPrim,
+		Cols:           []int{-1, 1},
+		BatchVar:       "list",
+		BatchCol:       1,
+		BatchSize:      3,
+		ComparisonType: querypb.Type_INT64,
+	}
+	r, err := sa.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	subPrim.ExpectLog(t, []string{
+		`Execute list: type:TUPLE values:{type:INT64 value:"10"} values:{type:INT64 value:"20"} false`,
+		`Execute list: type:TUPLE values:{type:INT64 value:"30"} false`,
+	})
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("col1|col", "int64|int64"),
+		"1|4",
+		"2|null",
+		"3|4",
+		"4|null",
+		"5|1",
+	)
+	want.Fields = nil
+	expectResult(t, "sa.Execute", r, want)
+
+	// Exists only keeps the rows that have a match
+	outerPrim.rewind()
+	subPrim.rewind()
+	sa.Opcode = PulloutExists
+	sa.Cols = []int{-1}
+	r, err = sa.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	want = sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("col1", "int64"),
+		"1",
+		"3",
+		"5",
+	)
+	want.Fields = nil
+	expectResult(t, "sa.Execute", r, want)
+}
+
+func TestSemiApplyStreamExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"int64|varchar",
+	)
+	outerPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "1|a", "2|b"),
+			sqltypes.MakeTestResult(fields, "3|c", "4|d"),
+		},
+		allResultsInOneCall: true,
+	}
+	subFields := sqltypes.MakeTestFields(
+		"1",
+		"int64",
+	)
+	subPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(subFields),
+			sqltypes.MakeTestResult(subFields, "1"),
+			sqltypes.MakeTestResult(subFields),
+			sqltypes.MakeTestResult(subFields, "1"),
+		},
+	}
+
+	sa := &SemiApply{
+		Opcode:   PulloutExists,
+		Outer:    outerPrim,
+		Subquery: subPrim,
+		Vars: map[string]int{
+			"bv": 1,
+		},
+		Cols: []int{-1, -2},
+	}
+	r, err := wrapStreamExecute(sa, &noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	outerPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	expectResult(t, "sa.StreamExecute", r, sqltypes.MakeTestResult(
+		fields,
+		"2|b",
+		"4|d",
+	))
+}
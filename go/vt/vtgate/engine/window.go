@@ -0,0 +1,492 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*Window)(nil)
+
+// Window is a primitive that evaluates window functions over the
+// complete result of its input. The input rows are returned in the
+// order they were received, with the value of each window function
+// written into its placeholder column. Since every function may use
+// a different partitioning and ordering, all rows are buffered in memory.
+type Window struct {
+	// Functions specifies the window functions to evaluate.
+	Functions []*WindowFuncParams
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// WindowFuncParams specify the parameters for each window function.
+type WindowFuncParams struct {
+	Opcode WindowOpcode
+	// Col is the column the result of the function is written to.
+	Col int
+	// Args are the input columns holding the arguments of the function.
+	Args []int
+	// FromLast is only used by nth_value.
+	FromLast bool `json:",omitempty"`
+	// CollationID is used to compare the argument of min and max.
+	CollationID collations.ID `json:",omitempty"`
+
+	PartitionBy []OrderByParams
+	OrderBy     []OrderByParams
+
+	Alias string `json:",omitempty"`
+	Expr  sqlparser.Expr
+}
+
+func (wp *WindowFuncParams) String() string {
+	cols := make([]string, 0, len(wp.Args))
+	for _, arg := range wp.Args {
+		cols = append(cols, fmt.Sprintf("%d", arg))
+	}
+	var over []string
+	if len(wp.PartitionBy) > 0 {
+		over = append(over, "partition by "+GenericJoin(wp.PartitionBy, orderByParamsToString))
+	}
+	if len(wp.OrderBy) > 0 {
+		over = append(over, "order by "+GenericJoin(wp.OrderBy, orderByParamsToString))
+	}
+	res := fmt.Sprintf("%s(%s) over (%s)", wp.Opcode.String(), strings.Join(cols, ", "), strings.Join(over, " "))
+	if wp.Alias != "" {
+		res += " AS " + wp.Alias
+	}
+	return fmt.Sprintf("%d:%s", wp.Col, res)
+}
+
+// WindowOpcode is the window function Opcode.
+type WindowOpcode int
+
+// These constants list the possible window function opcodes.
+const (
+	WindowRowNumber = WindowOpcode(iota)
+	WindowRank
+	WindowDenseRank
+	WindowPercentRank
+	WindowCumeDist
+	WindowNtile
+	WindowLag
+	WindowLead
+	WindowFirstValue
+	WindowLastValue
+	WindowNthValue
+	WindowCount
+	WindowSum
+	WindowMin
+	WindowMax
+	WindowAvg
+)
+
+var (
+	// WindowOpcodeType keeps track of the known output types for different window functions.
+	// Functions that return one of their arguments use the type of that argument.
+	WindowOpcodeType = map[WindowOpcode]querypb.Type{
+		WindowRowNumber:   sqltypes.Uint64,
+		WindowRank:        sqltypes.Uint64,
+		WindowDenseRank:   sqltypes.Uint64,
+		WindowPercentRank: sqltypes.Float64,
+		WindowCumeDist:    sqltypes.Float64,
+		WindowNtile:       sqltypes.Uint64,
+		WindowCount:       sqltypes.Int64,
+		WindowSum:         sqltypes.Decimal,
+		WindowAvg:         sqltypes.Decimal,
+	}
+)
+
+// SupportedWindowFunctions maps the list of window functions
+// that can be evaluated on vtgate to their opcodes.
+var SupportedWindowFunctions = map[string]WindowOpcode{
+	"row_number":   WindowRowNumber,
+	"rank":         WindowRank,
+	"dense_rank":   WindowDenseRank,
+	"percent_rank": WindowPercentRank,
+	"cume_dist":    WindowCumeDist,
+	"ntile":        WindowNtile,
+	"lag":          WindowLag,
+	"lead":         WindowLead,
+	"first_value":  WindowFirstValue,
+	"last_value":   WindowLastValue,
+	"nth_value":    WindowNthValue,
+	"count":        WindowCount,
+	"sum":          WindowSum,
+	"min":          WindowMin,
+	"max":          WindowMax,
+	"avg":          WindowAvg,
+}
+
+func (code WindowOpcode) String() string {
+	for k, v := range SupportedWindowFunctions {
+		if v == code {
+			return k
+		}
+	}
+	panic("unreachable")
+}
+
+// MarshalJSON serializes the WindowOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code WindowOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (w *Window) RouteType() string {
+	return w.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (w *Window) GetKeyspaceName() string {
+	return w.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (w *Window) GetTableName() string {
+	return w.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (w *Window) SetTruncateColumnCount(count int) {
+	w.TruncateColumnCount = count
+}
+
+// TryExecute satisfies the Primitive interface.
+func (w *Window) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := vcursor.ExecutePrimitive(w.Input, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := w.evaluate(result.Rows)
+	if err != nil {
+		return nil, err
+	}
+	out := &sqltypes.Result{
+		Fields: w.convertFields(result.Fields),
+		Rows:   rows,
+	}
+	return out.Truncate(w.TruncateColumnCount), nil
+}
+
+// TryStreamExecute satisfies the Primitive interface.
+func (w *Window) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	cb := func(qr *sqltypes.Result) error {
+		return callback(qr.Truncate(w.TruncateColumnCount))
+	}
+
+	var rows [][]sqltypes.Value
+	err := vcursor.StreamExecutePrimitive(w.Input, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			if err := cb(&sqltypes.Result{Fields: w.convertFields(qr.Fields)}); err != nil {
+				return err
+			}
+		}
+		rows = append(rows, qr.Rows...)
+		if vcursor.ExceedsMaxMemoryRows(len(rows)) {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	rows, err = w.evaluate(rows)
+	if err != nil {
+		return err
+	}
+	return cb(&sqltypes.Result{Rows: rows})
+}
+
+// GetFields satisfies the Primitive interface.
+func (w *Window) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := w.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	qr = &sqltypes.Result{Fields: w.convertFields(qr.Fields)}
+	return qr.Truncate(w.TruncateColumnCount), nil
+}
+
+// Inputs returns the Primitive input for this window
+func (w *Window) Inputs() []Primitive {
+	return []Primitive{w.Input}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (w *Window) NeedsTransaction() bool {
+	return w.Input.NeedsTransaction()
+}
+
+func (w *Window) convertFields(fields []*querypb.Field) []*querypb.Field {
+	if fields == nil {
+		return nil
+	}
+	fields = append([]*querypb.Field(nil), fields...)
+	for _, fn := range w.Functions {
+		typ, ok := WindowOpcodeType[fn.Opcode]
+		if !ok && len(fn.Args) > 0 {
+			typ = fields[fn.Args[0]].Type
+		}
+		fields[fn.Col] = &querypb.Field{
+			Name: fields[fn.Col].Name,
+			Type: typ,
+		}
+	}
+	return fields
+}
+
+// evaluate computes every window function and returns copies of the
+// input rows with the results written into them.
+func (w *Window) evaluate(input [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	rows := make([][]sqltypes.Value, 0, len(input))
+	for _, row := range input {
+		rows = append(rows, append([]sqltypes.Value(nil), row...))
+	}
+	for _, fn := range w.Functions {
+		var err error
+		partitionBy := extractSlices(fn.PartitionBy)
+		orderBy := extractSlices(fn.OrderBy)
+		cmp := func(r1, r2 []sqltypes.Value, comparers []*comparer) int {
+			for _, c := range comparers {
+				if err != nil {
+					return 0
+				}
+				var res int
+				res, err = c.compare(r1, r2)
+				if res != 0 {
+					return res
+				}
+			}
+			return 0
+		}
+
+		idx := make([]int, len(rows))
+		for i := range idx {
+			idx[i] = i
+		}
+		sort.SliceStable(idx, func(i, j int) bool {
+			r1, r2 := rows[idx[i]], rows[idx[j]]
+			if res := cmp(r1, r2, partitionBy); res != 0 {
+				return res < 0
+			}
+			return cmp(r1, r2, orderBy) < 0
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for start := 0; start < len(idx); {
+			end := start + 1
+			for end < len(idx) && cmp(rows[idx[start]], rows[idx[end]], partitionBy) == 0 {
+				end++
+			}
+			partition := idx[start:end]
+			// peerEnd holds, for each row, the position after its last peer in the ordering
+			peerEnd := make([]int, len(partition))
+			for i := 0; i < len(partition); {
+				j := i + 1
+				for j < len(partition) && cmp(rows[partition[i]], rows[partition[j]], orderBy) == 0 {
+					j++
+				}
+				for k := i; k < j; k++ {
+					peerEnd[k] = j
+				}
+				i = j
+			}
+			if err != nil {
+				return nil, err
+			}
+			if err := fn.evaluatePartition(rows, partition, peerEnd); err != nil {
+				return nil, err
+			}
+			start = end
+		}
+	}
+	return rows, nil
+}
+
+// evaluatePartition computes the function for the rows of one partition.
+// Without an explicit frame, the frame of a row is the whole partition when the
+// window is not ordered, and ends with the last peer of the row otherwise.
+func (wp *WindowFuncParams) evaluatePartition(rows [][]sqltypes.Value, partition []int, peerEnd []int) error {
+	n := len(partition)
+	frameEnd := func(i int) int {
+		if len(wp.OrderBy) == 0 {
+			return n
+		}
+		return peerEnd[i]
+	}
+	arg := func(row, i int) sqltypes.Value {
+		if i >= len(wp.Args) {
+			return sqltypes.NULL
+		}
+		return rows[row][wp.Args[i]]
+	}
+
+	// state used by the ranking and aggregate functions
+	var rank, denseRank, added, count int
+	acc := sqltypes.NULL
+
+	for i, row := range partition {
+		peerStart := i == 0 || peerEnd[i-1] != peerEnd[i]
+		if peerStart {
+			rank = i + 1
+			denseRank++
+		}
+
+		var res sqltypes.Value
+		switch wp.Opcode {
+		case WindowRowNumber:
+			res = sqltypes.NewUint64(uint64(i + 1))
+		case WindowRank:
+			res = sqltypes.NewUint64(uint64(rank))
+		case WindowDenseRank:
+			res = sqltypes.NewUint64(uint64(denseRank))
+		case WindowPercentRank:
+			var pr float64
+			if n > 1 {
+				pr = float64(rank-1) / float64(n-1)
+			}
+			res = sqltypes.NewFloat64(pr)
+		case WindowCumeDist:
+			res = sqltypes.NewFloat64(float64(peerEnd[i]) / float64(n))
+		case WindowNtile:
+			buckets, err := evalengine.ToInt64(arg(row, 0))
+			if err != nil || buckets <= 0 {
+				return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect arguments to ntile")
+			}
+			size, rem := n/int(buckets), n%int(buckets)
+			var bucket int
+			if i < rem*(size+1) {
+				bucket = i/(size+1) + 1
+			} else {
+				bucket = (i-rem*(size+1))/size + rem + 1
+			}
+			res = sqltypes.NewUint64(uint64(bucket))
+		case WindowLag, WindowLead:
+			offset := int64(1)
+			if len(wp.Args) > 1 {
+				var err error
+				offset, err = evalengine.ToInt64(arg(row, 1))
+				if err != nil || offset < 0 {
+					return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect arguments to %s", wp.Opcode.String())
+				}
+			}
+			target := i - int(offset)
+			if wp.Opcode == WindowLead {
+				target = i + int(offset)
+			}
+			if target >= 0 && target < n {
+				res = arg(partition[target], 0)
+			} else {
+				res = arg(row, 2)
+			}
+		case WindowFirstValue:
+			res = arg(partition[0], 0)
+		case WindowLastValue:
+			res = arg(partition[frameEnd(i)-1], 0)
+		case WindowNthValue:
+			nth, err := evalengine.ToInt64(arg(row, 1))
+			if err != nil || nth <= 0 {
+				return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect arguments to nth_value")
+			}
+			end := frameEnd(i)
+			pos := int(nth) - 1
+			if wp.FromLast {
+				pos = end - int(nth)
+			}
+			res = sqltypes.NULL
+			if pos >= 0 && pos < end {
+				res = arg(partition[pos], 0)
+			}
+		case WindowCount, WindowSum, WindowMin, WindowMax, WindowAvg:
+			// the frame only grows, so the rows entering it are added to the running value
+			for ; added < frameEnd(i); added++ {
+				if len(wp.Args) == 0 {
+					count++
+					continue
+				}
+				v := arg(partition[added], 0)
+				if v.IsNull() {
+					continue
+				}
+				count++
+				var err error
+				switch wp.Opcode {
+				case WindowSum, WindowAvg:
+					acc, err = evalengine.NullSafeAdd(acc, v, sqltypes.Decimal)
+				case WindowMin:
+					acc, err = evalengine.Min(acc, v, wp.CollationID)
+				case WindowMax:
+					acc, err = evalengine.Max(acc, v, wp.CollationID)
+				}
+				if err != nil {
+					return err
+				}
+			}
+			switch wp.Opcode {
+			case WindowCount:
+				res = sqltypes.NewInt64(int64(count))
+			case WindowAvg:
+				var err error
+				res, err = evalengine.Divide(acc, sqltypes.NewInt64(int64(count)))
+				if err != nil {
+					return err
+				}
+			default:
+				res = acc
+			}
+		}
+		rows[row][wp.Col] = res
+	}
+	return nil
+}
+
+func windowFuncParamsToString(in interface{}) string {
+	return in.(*WindowFuncParams).String()
+}
+
+func (w *Window) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Functions": GenericJoin(w.Functions, windowFuncParamsToString),
+	}
+	if w.TruncateColumnCount > 0 {
+		other["ResultColumns"] = w.TruncateColumnCount
+	}
+	return PrimitiveDescription{
+		OperatorType: "Window",
+		Other:        other,
+	}
+}
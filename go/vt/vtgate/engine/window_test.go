@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+)
+
+func TestWindowRanking(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"grp|val|rn|rnk|drnk|cd",
+				"varbinary|int64|null_type|null_type|null_type|null_type",
+			),
+			"b|3|null|null|null|null",
+			"a|2|null|null|null|null",
+			"a|1|null|null|null|null",
+			"b|1|null|null|null|null",
+			"a|2|null|null|null|null",
+		)},
+	}
+
+	partitionBy := []OrderByParams{{Col: 0, WeightStringCol: -1}}
+	orderBy := []OrderByParams{{Col: 1, WeightStringCol: -1}}
+	w := &Window{
+		Functions: []*WindowFuncParams{
+			{Opcode: WindowRowNumber, Col: 2, PartitionBy: partitionBy, OrderBy: orderBy},
+			{Opcode: WindowRank, Col: 3, PartitionBy: partitionBy, OrderBy: orderBy},
+			{Opcode: WindowDenseRank, Col: 4, OrderBy: orderBy},
+			{Opcode: WindowCumeDist, Col: 5, PartitionBy: partitionBy, OrderBy: orderBy},
+		},
+		Input: fp,
+	}
+
+	result, err := w.TryExecute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"grp|val|rn|rnk|drnk|cd",
+			"varbinary|int64|uint64|uint64|uint64|float64",
+		),
+		"b|3|2|2|3|1",
+		"a|2|2|2|2|1",
+		"a|1|1|1|1|0.3333333333333333",
+		"b|1|1|1|1|0.5",
+		"a|2|3|2|2|1",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestWindowValueAndAggregate(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|val|lag|lead|running|total",
+				"int64|int64|null_type|null_type|null_type|null_type",
+			),
+			"3|30|null|null|null|null",
+			"1|10|null|null|null|null",
+			"4|null|null|null|null|null",
+			"2|20|null|null|null|null",
+		)},
+	}
+
+	orderBy := []OrderByParams{{Col: 0, WeightStringCol: -1}}
+	w := &Window{
+		Functions: []*WindowFuncParams{
+			{Opcode: WindowLag, Col: 2, Args: []int{1}, OrderBy: orderBy},
+			{Opcode: WindowLead, Col: 3, Args: []int{1}, OrderBy: orderBy},
+			{Opcode: WindowCount, Col: 4, Args: []int{1}, OrderBy: orderBy},
+			{Opcode: WindowMax, Col: 5, Args: []int{1}},
+		},
+		Input: fp,
+	}
+
+	result, err := w.TryExecute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|val|lag|lead|running|total",
+			"int64|int64|int64|int64|int64|int64",
+		),
+		"3|30|20|null|3|30",
+		"1|10|null|20|1|30",
+		"4|null|30|null|3|30",
+		"2|20|10|30|2|30",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestWindowNtile(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|buckets|tile",
+				"int64|int64|null_type",
+			),
+			"1|2|null",
+			"2|2|null",
+			"3|2|null",
+		)},
+	}
+
+	w := &Window{
+		Functions: []*WindowFuncParams{
+			{Opcode: WindowNtile, Col: 2, Args: []int{1}, OrderBy: []OrderByParams{{Col: 0, WeightStringCol: -1}}},
+		},
+		Input: fp,
+	}
+
+	result, err := w.TryExecute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|buckets|tile",
+			"int64|int64|uint64",
+		),
+		"1|2|1",
+		"2|2|1",
+		"3|2|2",
+	)
+	utils.MustMatch(t, wantResult, result)
+
+	w.Functions[0].Args = []int{2}
+	fp.rewind()
+	_, err = w.TryExecute(&noopVCursor{}, nil, true)
+	require.EqualError(t, err, "Incorrect arguments to ntile")
+}
+
+func TestWindowStreamExecuteTruncate(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"val|sum|weight",
+				"int64|null_type|int64",
+			),
+			"3|null|2",
+			"1|null|1",
+			"2|null|1",
+		)},
+	}
+
+	w := &Window{
+		Functions: []*WindowFuncParams{
+			{Opcode: WindowSum, Col: 1, Args: []int{0}, OrderBy: []OrderByParams{{Col: 2, WeightStringCol: -1}}},
+		},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	var results []*sqltypes.Result
+	err := w.TryStreamExecute(&noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	wantResults := sqltypes.MakeTestStreamingResults(
+		sqltypes.MakeTestFields(
+			"val|sum",
+			"int64|decimal",
+		),
+		"3|6",
+		"1|3",
+		"2|3",
+	)
+	utils.MustMatch(t, wantResults, results)
+}
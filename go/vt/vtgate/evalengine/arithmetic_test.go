@@ -885,6 +885,9 @@ func TestToFloat64(t *testing.T) {
 	}, {
 		v:   NewFloat64(1.2),
 		out: 1.2,
+	}, {
+		v:   TestValue(querypb.Type_DECIMAL, "1.25"),
+		out: 1.25,
 	}, {
 		v:   TestValue(querypb.Type_INT64, "1.2"),
 		err: vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "strconv.ParseInt: parsing \"1.2\": invalid syntax"),
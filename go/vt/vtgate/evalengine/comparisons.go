@@ -81,15 +81,19 @@ func (c *ComparisonExpr) evaluateComparisonExprs(env *ExpressionEnv) (EvalResult
 	if lVal, err = c.Left.Evaluate(env); err != nil {
 		return EvalResult{}, EvalResult{}, err
 	}
-	if sqltypes.IsText(lVal.typ) && c.CoerceLeft != nil {
-		lVal.bytes, _ = c.CoerceLeft(nil, lVal.bytes)
+	if (sqltypes.IsText(lVal.typ) || sqltypes.IsBinary(lVal.typ)) && c.TypedCollation.Valid() {
+		if c.CoerceLeft != nil {
+			lVal.bytes, _ = c.CoerceLeft(nil, lVal.bytes)
+		}
 		lVal.collation = c.TypedCollation
 	}
 	if rVal, err = c.Right.Evaluate(env); err != nil {
 		return EvalResult{}, EvalResult{}, err
 	}
-	if sqltypes.IsText(rVal.typ) && c.CoerceRight != nil {
-		rVal.bytes, _ = c.CoerceRight(nil, rVal.bytes)
+	if (sqltypes.IsText(rVal.typ) || sqltypes.IsBinary(rVal.typ)) && c.TypedCollation.Valid() {
+		if c.CoerceRight != nil {
+			rVal.bytes, _ = c.CoerceRight(nil, rVal.bytes)
+		}
 		rVal.collation = c.TypedCollation
 	}
 	return lVal, rVal, nil
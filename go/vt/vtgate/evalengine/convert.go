@@ -17,6 +17,8 @@ limitations under the License.
 package evalengine
 
 import (
+	"strings"
+
 	"vitess.io/vitess/go/mysql/collations"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -121,15 +123,19 @@ func simplifyExpr(e Expr) (Expr, error) {
 			return &Literal{Val: res}, nil
 		}
 
-		if lit1 != nil && node.CoerceLeft != nil {
-			lit1.Val.bytes, _ = node.CoerceLeft(nil, lit1.Val.bytes)
+		if lit1 != nil && node.TypedCollation.Valid() {
+			if node.CoerceLeft != nil {
+				lit1.Val.bytes, _ = node.CoerceLeft(nil, lit1.Val.bytes)
+				node.CoerceLeft = nil
+			}
 			lit1.Val.collation = node.TypedCollation
-			node.CoerceLeft = nil
 		}
-		if lit2 != nil && node.CoerceRight != nil {
-			lit2.Val.bytes, _ = node.CoerceRight(nil, lit2.Val.bytes)
+		if lit2 != nil && node.TypedCollation.Valid() {
+			if node.CoerceRight != nil {
+				lit2.Val.bytes, _ = node.CoerceRight(nil, lit2.Val.bytes)
+				node.CoerceRight = nil
+			}
 			lit2.Val.collation = node.TypedCollation
-			node.CoerceRight = nil
 		}
 
 		switch op := node.Op.(type) {
@@ -236,10 +242,104 @@ func simplifyExpr(e Expr) (Expr, error) {
 			}
 			node[i] = expr
 		}
+
+	case *CallExpr:
+		for i, arg := range node.Arguments {
+			node.Arguments[i], err = simplifyExpr(arg)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !node.F.volatile && allLiterals(node.Arguments...) {
+			return evaluateToLiteral(node)
+		}
+
+	case *IntervalExpr:
+		node.Expr, err = simplifyExpr(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+
+	case *LogicalExpr:
+		node.Left, err = simplifyExpr(node.Left)
+		if err != nil {
+			return nil, err
+		}
+		node.Right, err = simplifyExpr(node.Right)
+		if err != nil {
+			return nil, err
+		}
+		if allLiterals(node.Left, node.Right) {
+			return evaluateToLiteral(node)
+		}
+
+	case *NotExpr:
+		node.Inner, err = simplifyExpr(node.Inner)
+		if err != nil {
+			return nil, err
+		}
+		if allLiterals(node.Inner) {
+			return evaluateToLiteral(node)
+		}
+
+	case *IsExpr:
+		node.Inner, err = simplifyExpr(node.Inner)
+		if err != nil {
+			return nil, err
+		}
+		if allLiterals(node.Inner) {
+			return evaluateToLiteral(node)
+		}
+
+	case *CaseExpr:
+		if node.Base != nil {
+			node.Base, err = simplifyExpr(node.Base)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for i := range node.Cases {
+			node.Cases[i].When, err = simplifyExpr(node.Cases[i].When)
+			if err != nil {
+				return nil, err
+			}
+			node.Cases[i].Then, err = simplifyExpr(node.Cases[i].Then)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if node.Else != nil {
+			node.Else, err = simplifyExpr(node.Else)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 	return e, nil
 }
 
+// allLiterals returns true if all the given expressions are literals, or intervals of a literal,
+// meaning that an expression using them as arguments can be evaluated while planning
+func allLiterals(exprs ...Expr) bool {
+	for _, expr := range exprs {
+		if interval, ok := expr.(*IntervalExpr); ok {
+			expr = interval.Expr
+		}
+		if _, ok := expr.(*Literal); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func evaluateToLiteral(e Expr) (Expr, error) {
+	res, err := e.Evaluate(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Literal{Val: res}, nil
+}
+
 func convertExpr(e sqlparser.Expr, lookup ConverterLookup) (Expr, error) {
 	switch node := e.(type) {
 	case *sqlparser.ColName:
@@ -251,6 +351,7 @@ func convertExpr(e sqlparser.Expr, lookup ConverterLookup) (Expr, error) {
 			return nil, err
 		}
 		collation := getCollation(node, lookup)
+		collation.Coercibility = collations.CoerceImplicit
 		return NewColumn(idx, collation), nil
 	case *sqlparser.ComparisonExpr:
 		left, err := convertExpr(node.Left, lookup)
@@ -361,6 +462,137 @@ func convertExpr(e sqlparser.Expr, lookup ConverterLookup) (Expr, error) {
 				Repertoire:   collations.RepertoireUnicode,
 			},
 		}, nil
+	case *sqlparser.AndExpr:
+		return convertLogicalExpr(LogicalAnd, node.Left, node.Right, lookup)
+	case *sqlparser.OrExpr:
+		return convertLogicalExpr(LogicalOr, node.Left, node.Right, lookup)
+	case *sqlparser.XorExpr:
+		return convertLogicalExpr(LogicalXor, node.Left, node.Right, lookup)
+	case *sqlparser.NotExpr:
+		inner, err := convertExpr(node.Expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{Inner: inner}, nil
+	case *sqlparser.IsExpr:
+		inner, err := convertExpr(node.Left, lookup)
+		if err != nil {
+			return nil, err
+		}
+		return &IsExpr{Op: node.Right, Inner: inner}, nil
+	case *sqlparser.CaseExpr:
+		return convertCaseExpr(node, lookup)
+	case *sqlparser.FuncExpr:
+		if node.Qualifier.IsEmpty() && !node.Distinct && node.Over == nil {
+			if args, ok := funcArguments(node.Exprs); ok {
+				return convertCallExpr(node.Name.Lowered(), args, lookup)
+			}
+		}
+	case *sqlparser.SubstrExpr:
+		args := []sqlparser.Expr{node.Name, node.From}
+		if node.To != nil {
+			args = append(args, node.To)
+		}
+		return convertCallExpr("substring", args, lookup)
+	case *sqlparser.CurTimeFuncExpr:
+		var args []sqlparser.Expr
+		if node.Fsp != nil {
+			args = append(args, node.Fsp)
+		}
+		return convertCallExpr(node.Name.Lowered(), args, lookup)
+	case *sqlparser.IntervalExpr:
+		unit := strings.ToLower(node.Unit)
+		if !intervalUnits[unit] {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: interval unit %s", ErrConvertExprNotSupported, node.Unit)
+		}
+		expr, err := convertExpr(node.Expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		return &IntervalExpr{Expr: expr, Unit: unit}, nil
 	}
 	return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: %T", ErrConvertExprNotSupported, e)
 }
+
+func convertLogicalExpr(op LogicalOp, left, right sqlparser.Expr, lookup ConverterLookup) (Expr, error) {
+	l, err := convertExpr(left, lookup)
+	if err != nil {
+		return nil, err
+	}
+	r, err := convertExpr(right, lookup)
+	if err != nil {
+		return nil, err
+	}
+	return &LogicalExpr{
+		Op:    op,
+		Left:  l,
+		Right: r,
+	}, nil
+}
+
+func convertCaseExpr(node *sqlparser.CaseExpr, lookup ConverterLookup) (Expr, error) {
+	var err error
+	result := &CaseExpr{}
+	if node.Expr != nil {
+		result.Base, err = convertExpr(node.Expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+	}
+	results := make([]Expr, 0, len(node.Whens)+1)
+	for _, when := range node.Whens {
+		var branch WhenThen
+		branch.When, err = convertExpr(when.Cond, lookup)
+		if err != nil {
+			return nil, err
+		}
+		branch.Then, err = convertExpr(when.Val, lookup)
+		if err != nil {
+			return nil, err
+		}
+		result.Cases = append(result.Cases, branch)
+		results = append(results, branch.Then)
+	}
+	if node.Else != nil {
+		result.Else, err = convertExpr(node.Else, lookup)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result.Else)
+	}
+	result.collation, err = mergeArgCollations(results...)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// funcArguments returns the expressions used as arguments of a function call,
+// or false if any of them is not a plain expression (e.g. the star in COUNT(*))
+func funcArguments(exprs sqlparser.SelectExprs) ([]sqlparser.Expr, bool) {
+	args := make([]sqlparser.Expr, 0, len(exprs))
+	for _, expr := range exprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, false
+		}
+		args = append(args, aliased.Expr)
+	}
+	return args, true
+}
+
+func convertCallExpr(name string, args []sqlparser.Expr, lookup ConverterLookup) (Expr, error) {
+	f, err := lookupBuiltin(name, len(args))
+	if err != nil {
+		return nil, err
+	}
+	converted := make(TupleExpr, 0, len(args))
+	for _, arg := range args {
+		expr, err := convertExpr(arg, lookup)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, expr)
+	}
+	return newCallExpr(name, f, converted)
+}
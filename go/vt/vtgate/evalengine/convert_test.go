@@ -17,6 +17,7 @@ limitations under the License.
 package evalengine
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -87,6 +88,21 @@ func TestConvertSimplification(t *testing.T) {
 			ok(`VARBINARY("pokemon") in (VARBINARY("bulbasaur"), VARBINARY("venusaur"), NULL)`),
 			ok(`NULL`),
 		},
+		{"concat('poke', 'mon')", ok(`concat(VARBINARY("poke"), VARBINARY("mon"))`), ok(`VARBINARY("pokemon")`)},
+		{"upper(concat('poke', 'mon')) = 'POKEMON'",
+			ok(`upper(concat(VARBINARY("poke"), VARBINARY("mon"))) = VARBINARY("POKEMON")`),
+			ok(`INT32(1)`),
+		},
+		{"date_add('2021-01-01', interval (1 + 1) day)",
+			ok(`date_add(VARBINARY("2021-01-01"), interval (INT64(1) + INT64(1)) day)`),
+			ok(`DATE("2021-01-03")`),
+		},
+		{"now() and 1 is not null", ok(`now() and (INT64(1) is not null)`), ok(`now() and INT32(1)`)},
+		{"case when 1 = 1 then 'a' else 'b' end",
+			ok(`case when (INT64(1) = INT64(1)) then VARBINARY("a") else VARBINARY("b") end`),
+			ok(`case when INT32(1) then VARBINARY("a") else VARBINARY("b") end`),
+		},
+		{"abs(1, 2)", err("Incorrect parameter count in the call to native function 'abs'"), err("Incorrect parameter count in the call to native function 'abs'")},
 	}
 
 	for _, tc := range testCases {
@@ -272,3 +288,90 @@ func TestEvaluateTuple(t *testing.T) {
 		})
 	}
 }
+
+// columnCollations resolves the columns of a row by name, and gives each
+// column its own collation and every literal the default one.
+type columnCollations struct {
+	names      []string
+	collations []collations.ID
+}
+
+func (c columnCollations) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	for i, name := range c.names {
+		if col.Name.EqualString(name) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown column: %s", sqlparser.String(col))
+}
+
+func (c columnCollations) CollationIDLookup(expr sqlparser.Expr) collations.ID {
+	if col, ok := expr.(*sqlparser.ColName); ok {
+		if idx, err := c.ColumnLookup(col); err == nil {
+			return c.collations[idx]
+		}
+	}
+	return collations.Local().LookupByName("utf8mb4_0900_ai_ci").ID()
+}
+
+func TestEvaluateColumnCollations(t *testing.T) {
+	lookup := columnCollations{
+		names: []string{"ci", "cs", "bin"},
+		collations: []collations.ID{
+			collations.Local().LookupByName("utf8mb4_general_ci").ID(),
+			collations.Local().LookupByName("utf8mb4_bin").ID(),
+			collations.CollationBinaryID,
+		},
+	}
+	row := []sqltypes.Value{sqltypes.NewVarChar("Alice"), sqltypes.NewVarChar("Alice"), sqltypes.NewVarBinary("Alice")}
+
+	// The columns are implicitly coercible, so a comparison with a literal
+	// uses the collation of the column, and binary columns compare as bytes.
+	tests := []struct {
+		expression string
+		expected   sqltypes.Value
+	}{{
+		expression: "ci = 'ALICE'",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "'ALICE' = ci",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "cs = 'ALICE'",
+		expected:   sqltypes.NewInt32(0),
+	}, {
+		expression: "cs = 'Alice'",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "bin = 'ALICE'",
+		expected:   sqltypes.NewInt32(0),
+	}, {
+		expression: "bin = 'Alice'",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "ci in ('bob', 'alice')",
+		expected:   sqltypes.NewInt32(1),
+	}, {
+		expression: "bin in ('bob', 'alice')",
+		expected:   sqltypes.NewInt32(0),
+	}, {
+		expression: "ci like 'AL%'",
+		expected:   sqltypes.NewInt32(1),
+	}}
+
+	for _, test := range tests {
+		for _, simplify := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s (simplify=%v)", test.expression, simplify), func(t *testing.T) {
+				stmt, err := sqlparser.Parse("select " + test.expression)
+				require.NoError(t, err)
+				astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+				expr, err := ConvertEx(astExpr, lookup, simplify)
+				require.NoError(t, err)
+
+				r, err := expr.Evaluate(&ExpressionEnv{Row: row})
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, r.Value())
+			})
+		}
+	}
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// IntervalExpr is the INTERVAL argument of the DATE_ADD and DATE_SUB functions.
+// It cannot be evaluated on its own.
+type IntervalExpr struct {
+	Expr Expr
+	Unit string
+}
+
+var _ Expr = (*IntervalExpr)(nil)
+
+// intervalUnits are the units that can be used in an IntervalExpr. Compound units
+// such as DAY_HOUR are not supported yet.
+var intervalUnits = map[string]bool{
+	"microsecond": true,
+	"second":      true,
+	"minute":      true,
+	"hour":        true,
+	"day":         true,
+	"week":        true,
+	"month":       true,
+	"quarter":     true,
+	"year":        true,
+}
+
+const (
+	layoutDate     = "2006-01-02"
+	layoutDatetime = "2006-01-02 15:04:05"
+	layoutFraction = ".000000"
+)
+
+// Evaluate implements the Expr interface
+func (i *IntervalExpr) Evaluate(*ExpressionEnv) (EvalResult, error) {
+	return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "INTERVAL can only be used as an argument of a date function")
+}
+
+// Type implements the Expr interface
+func (i *IntervalExpr) Type(env *ExpressionEnv) (querypb.Type, error) {
+	return i.Expr.Type(env)
+}
+
+// Collation implements the Expr interface
+func (i *IntervalExpr) Collation() collations.TypedCollation {
+	return collationNumeric
+}
+
+func (i *IntervalExpr) format(w *strings.Builder, _ bool) {
+	w.WriteString("interval ")
+	i.Expr.format(w, true)
+	w.WriteByte(' ')
+	w.WriteString(i.Unit)
+}
+
+func builtinNow(args []EvalResult, _ collations.TypedCollation) (EvalResult, error) {
+	var fsp int64
+	if len(args) > 0 {
+		fsp = evalToInt64(args[0])
+		if fsp < 0 || fsp > 6 {
+			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Too-big precision %d specified for 'now'. Maximum is 6.", fsp)
+		}
+	}
+	now := time.Now()
+	layout := layoutDatetime
+	if fsp > 0 {
+		layout += layoutFraction[:fsp+1]
+	}
+	return EvalResult{typ: sqltypes.Datetime, bytes: []byte(now.Format(layout))}, nil
+}
+
+// parsedDate is a date parsed from an EvalResult, with the information
+// required to format it back with the same precision
+type parsedDate struct {
+	t        time.Time
+	hasFrac  bool
+	dateOnly bool
+}
+
+// parseDateArgument parses the given date, datetime, timestamp or string value.
+// Values that are not valid dates are reported with ok=false, and turn into NULL.
+func parseDateArgument(e EvalResult) (date parsedDate, ok bool) {
+	str := strings.TrimSpace(string(e.Value().Raw()))
+	if t, err := time.Parse(layoutDate, str); err == nil {
+		return parsedDate{t: t, dateOnly: e.typ != sqltypes.Datetime && e.typ != sqltypes.Timestamp}, true
+	}
+	if t, err := time.Parse(layoutDatetime, str); err == nil {
+		return parsedDate{t: t}, true
+	}
+	if t, err := time.Parse(layoutDatetime+".999999999", str); err == nil {
+		return parsedDate{t: t, hasFrac: true}, true
+	}
+	return parsedDate{}, false
+}
+
+func builtinDateAdd(env *ExpressionEnv, args TupleExpr, _ collations.TypedCollation) (EvalResult, error) {
+	return dateAddInterval(env, args, false)
+}
+
+func builtinDateSub(env *ExpressionEnv, args TupleExpr, _ collations.TypedCollation) (EvalResult, error) {
+	return dateAddInterval(env, args, true)
+}
+
+func dateAddInterval(env *ExpressionEnv, args TupleExpr, negate bool) (EvalResult, error) {
+	interval, ok := args[1].(*IntervalExpr)
+	if !ok {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: date arithmetic without an INTERVAL argument")
+	}
+	dateVal, err := args[0].Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	amountVal, err := interval.Expr.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if dateVal.typ == sqltypes.Null || amountVal.typ == sqltypes.Null {
+		return resultNull, nil
+	}
+	date, ok := parseDateArgument(dateVal)
+	if !ok {
+		return resultNull, nil
+	}
+
+	var t time.Time
+	var fractional bool
+	switch interval.Unit {
+	case "microsecond", "second":
+		amount := makeFloat(amountVal)
+		seconds := math.Float64frombits(amount.numval)
+		if interval.Unit == "microsecond" {
+			seconds = math.Round(seconds) / 1e6
+		}
+		if negate {
+			seconds = -seconds
+		}
+		d := time.Duration(math.Round(seconds*1e6)) * time.Microsecond
+		fractional = d%time.Second != 0 || interval.Unit == "microsecond"
+		t = date.t.Add(d)
+	default:
+		amount := evalToInt64(amountVal)
+		if negate {
+			amount = -amount
+		}
+		switch interval.Unit {
+		case "minute":
+			t = date.t.Add(time.Duration(amount) * time.Minute)
+		case "hour":
+			t = date.t.Add(time.Duration(amount) * time.Hour)
+		case "day":
+			t = date.t.AddDate(0, 0, int(amount))
+		case "week":
+			t = date.t.AddDate(0, 0, 7*int(amount))
+		case "month":
+			t = addMonths(date.t, amount)
+		case "quarter":
+			t = addMonths(date.t, 3*amount)
+		case "year":
+			t = addMonths(date.t, 12*amount)
+		}
+	}
+	if t.Year() < 1 || t.Year() > 9999 {
+		return resultNull, nil
+	}
+
+	switch interval.Unit {
+	case "day", "week", "month", "quarter", "year":
+		if date.dateOnly {
+			return EvalResult{typ: sqltypes.Date, bytes: []byte(t.Format(layoutDate))}, nil
+		}
+	}
+	layout := layoutDatetime
+	if date.hasFrac || fractional {
+		layout += layoutFraction
+	}
+	return EvalResult{typ: sqltypes.Datetime, bytes: []byte(t.Format(layout))}, nil
+}
+
+// addMonths adds the given number of months to the date. Like in MySQL, if the
+// day does not exist in the resulting month, the last day of that month is used.
+func addMonths(t time.Time, months int64) time.Time {
+	total := int64(t.Year())*12 + int64(t.Month()-1) + months
+	year, month := int(total/12), time.Month(total%12+1)
+	day := t.Day()
+	if last := daysInMonth(year, month); day > last {
+		day = last
+	}
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func daysInYear(year int) int {
+	if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+		return 366
+	}
+	return 365
+}
+
+func builtinDateFormat(args []EvalResult, _ collations.TypedCollation) (EvalResult, error) {
+	date, ok := parseDateArgument(args[0])
+	if !ok {
+		return resultNull, nil
+	}
+	format := args[1].Value().Raw()
+	t := date.t
+
+	var buf []byte
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i == len(format)-1 {
+			buf = append(buf, format[i])
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'a':
+			buf = append(buf, t.Weekday().String()[:3]...)
+		case 'b':
+			buf = append(buf, t.Month().String()[:3]...)
+		case 'c':
+			buf = strconv.AppendInt(buf, int64(t.Month()), 10)
+		case 'D':
+			buf = strconv.AppendInt(buf, int64(t.Day()), 10)
+			buf = append(buf, ordinalSuffix(t.Day())...)
+		case 'd':
+			buf = append(buf, fmt.Sprintf("%02d", t.Day())...)
+		case 'e':
+			buf = strconv.AppendInt(buf, int64(t.Day()), 10)
+		case 'f':
+			buf = append(buf, fmt.Sprintf("%06d", t.Nanosecond()/1000)...)
+		case 'H':
+			buf = append(buf, fmt.Sprintf("%02d", t.Hour())...)
+		case 'h', 'I':
+			buf = append(buf, fmt.Sprintf("%02d", hour12(t))...)
+		case 'i':
+			buf = append(buf, fmt.Sprintf("%02d", t.Minute())...)
+		case 'j':
+			buf = append(buf, fmt.Sprintf("%03d", t.YearDay())...)
+		case 'k':
+			buf = strconv.AppendInt(buf, int64(t.Hour()), 10)
+		case 'l':
+			buf = strconv.AppendInt(buf, int64(hour12(t)), 10)
+		case 'M':
+			buf = append(buf, t.Month().String()...)
+		case 'm':
+			buf = append(buf, fmt.Sprintf("%02d", t.Month())...)
+		case 'p':
+			buf = append(buf, t.Format("PM")...)
+		case 'r':
+			buf = append(buf, t.Format("03:04:05 PM")...)
+		case 'S', 's':
+			buf = append(buf, fmt.Sprintf("%02d", t.Second())...)
+		case 'T':
+			buf = append(buf, t.Format("15:04:05")...)
+		case 'U':
+			week, _ := calcWeek(t, weekFirstWeekday)
+			buf = append(buf, fmt.Sprintf("%02d", week)...)
+		case 'u':
+			week, _ := calcWeek(t, weekMondayFirst)
+			buf = append(buf, fmt.Sprintf("%02d", week)...)
+		case 'V':
+			week, _ := calcWeek(t, weekYear|weekFirstWeekday)
+			buf = append(buf, fmt.Sprintf("%02d", week)...)
+		case 'v':
+			week, _ := calcWeek(t, weekMondayFirst|weekYear)
+			buf = append(buf, fmt.Sprintf("%02d", week)...)
+		case 'W':
+			buf = append(buf, t.Weekday().String()...)
+		case 'w':
+			buf = strconv.AppendInt(buf, int64(t.Weekday()), 10)
+		case 'X':
+			_, year := calcWeek(t, weekYear|weekFirstWeekday)
+			buf = append(buf, fmt.Sprintf("%04d", year)...)
+		case 'x':
+			_, year := calcWeek(t, weekMondayFirst|weekYear)
+			buf = append(buf, fmt.Sprintf("%04d", year)...)
+		case 'Y':
+			buf = append(buf, fmt.Sprintf("%04d", t.Year())...)
+		case 'y':
+			buf = append(buf, fmt.Sprintf("%02d", t.Year()%100)...)
+		default:
+			// '%%' and any unknown specifier output the character itself
+			buf = append(buf, format[i])
+		}
+	}
+	return EvalResult{typ: sqltypes.VarBinary, bytes: buf}, nil
+}
+
+func hour12(t time.Time) int {
+	h := t.Hour() % 12
+	if h == 0 {
+		return 12
+	}
+	return h
+}
+
+func ordinalSuffix(day int) string {
+	if day >= 11 && day <= 13 {
+		return "th"
+	}
+	switch day % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+// week calculation flags, as used by MySQL's WEEK() modes
+const (
+	weekMondayFirst = 1 << iota
+	weekYear
+	weekFirstWeekday
+)
+
+// calcWeek returns the week number of the given date and the year that week belongs to.
+// It is a port of MySQL's calc_week, with the same behaviour for every combination of flags.
+func calcWeek(t time.Time, flags int) (week int, year int) {
+	mondayFirst := flags&weekMondayFirst != 0
+	weekYearFlag := flags&weekYear != 0
+	firstWeekday := flags&weekFirstWeekday != 0
+
+	year = t.Year()
+	// days is the number of days between the date and the first day of the year
+	days := t.YearDay() - 1
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	weekday := int(jan1.Weekday())
+	if mondayFirst {
+		weekday = (weekday + 6) % 7
+	}
+
+	if t.Month() == time.January && t.Day() <= 7-weekday {
+		if !weekYearFlag && ((firstWeekday && weekday != 0) || (!firstWeekday && weekday >= 4)) {
+			return 0, year
+		}
+		weekYearFlag = true
+		year--
+		prevDays := daysInYear(year)
+		days += prevDays
+		weekday = (weekday + 53*7 - prevDays) % 7
+	}
+
+	if (firstWeekday && weekday != 0) || (!firstWeekday && weekday >= 4) {
+		days -= 7 - weekday
+	} else {
+		days += weekday
+	}
+
+	if weekYearFlag && days >= 52*7 {
+		weekday = (weekday + daysInYear(year)) % 7
+		if (!firstWeekday && weekday < 4) || (firstWeekday && weekday == 0) {
+			return 1, year + 1
+		}
+	}
+	return days/7 + 1, year
+}
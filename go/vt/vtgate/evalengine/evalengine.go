@@ -110,7 +110,7 @@ func ToFloat64(v sqltypes.Value) (float64, error) {
 		return float64(int64(num.numval)), nil
 	case sqltypes.Uint64:
 		return float64(num.numval), nil
-	case sqltypes.Float64:
+	case sqltypes.Float64, sqltypes.Decimal:
 		return math.Float64frombits(num.numval), nil
 	}
 
@@ -412,7 +412,7 @@ func compareDateAndString(l, r EvalResult) (int, error) {
 }
 
 func mergeCollations(left, right EvalResult) (EvalResult, EvalResult, error) {
-	if !sqltypes.IsText(left.typ) || !sqltypes.IsText(right.typ) {
+	if !isTextOrBinary(left.typ) || !isTextOrBinary(right.typ) {
 		return left, right, nil
 	}
 	env := collations.Local()
@@ -434,6 +434,10 @@ func mergeCollations(left, right EvalResult) (EvalResult, EvalResult, error) {
 	return left, right, nil
 }
 
+func isTextOrBinary(typ querypb.Type) bool {
+	return sqltypes.IsText(typ) || sqltypes.IsBinary(typ)
+}
+
 func compareTuples(lVal EvalResult, rVal EvalResult) (int, bool, error) {
 	if len(*lVal.tuple) != len(*rVal.tuple) {
 		return 0, false, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.OperandColumns, "Operand should contain %d column(s)", len(*lVal.tuple))
@@ -0,0 +1,527 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// CallExpr is a call to one of the builtin scalar functions
+	CallExpr struct {
+		Arguments TupleExpr
+		Method    string
+		F         *builtin
+		collation collations.TypedCollation
+	}
+
+	// builtin is the implementation of a MySQL scalar function that can be evaluated at the vtgate level
+	builtin struct {
+		// minArgs and maxArgs are the bounds for the number of arguments of the function.
+		// A negative maxArgs means that the function is variadic.
+		minArgs, maxArgs int
+		// volatile functions return a different value every time they are evaluated,
+		// so they must never be folded into a literal while planning
+		volatile bool
+		// collation returns the collation of the result of the function for the given arguments
+		collation func(args TupleExpr) (collations.TypedCollation, error)
+		// call evaluates the function. The arguments are passed unevaluated,
+		// so control flow functions only evaluate the branch they return.
+		call func(env *ExpressionEnv, args TupleExpr, collation collations.TypedCollation) (EvalResult, error)
+	}
+)
+
+var _ Expr = (*CallExpr)(nil)
+
+// builtinFunctions is the registry of all the scalar functions that can be converted
+// from their AST representation. The keys are the lowercase names of the functions.
+var builtinFunctions = map[string]*builtin{
+	// string functions
+	"concat":    {minArgs: 1, maxArgs: -1, collation: mergedCollation, call: strict(builtinConcat)},
+	"substring": {minArgs: 2, maxArgs: 3, collation: firstArgCollation, call: strict(builtinSubstring)},
+	"substr":    {minArgs: 2, maxArgs: 3, collation: firstArgCollation, call: strict(builtinSubstring)},
+	"lower":     {minArgs: 1, maxArgs: 1, collation: firstArgCollation, call: strict(builtinLower)},
+	"lcase":     {minArgs: 1, maxArgs: 1, collation: firstArgCollation, call: strict(builtinLower)},
+	"upper":     {minArgs: 1, maxArgs: 1, collation: firstArgCollation, call: strict(builtinUpper)},
+	"ucase":     {minArgs: 1, maxArgs: 1, collation: firstArgCollation, call: strict(builtinUpper)},
+
+	// numeric functions
+	"abs":     {minArgs: 1, maxArgs: 1, collation: numericCollation, call: strict(builtinAbs)},
+	"round":   {minArgs: 1, maxArgs: 2, collation: numericCollation, call: strict(builtinRound)},
+	"floor":   {minArgs: 1, maxArgs: 1, collation: numericCollation, call: strict(builtinFloor)},
+	"ceil":    {minArgs: 1, maxArgs: 1, collation: numericCollation, call: strict(builtinCeil)},
+	"ceiling": {minArgs: 1, maxArgs: 1, collation: numericCollation, call: strict(builtinCeil)},
+
+	// date and time functions
+	"now":               {minArgs: 0, maxArgs: 1, volatile: true, collation: numericCollation, call: strict(builtinNow)},
+	"current_timestamp": {minArgs: 0, maxArgs: 1, volatile: true, collation: numericCollation, call: strict(builtinNow)},
+	"localtime":         {minArgs: 0, maxArgs: 1, volatile: true, collation: numericCollation, call: strict(builtinNow)},
+	"localtimestamp":    {minArgs: 0, maxArgs: 1, volatile: true, collation: numericCollation, call: strict(builtinNow)},
+	"date_add":          {minArgs: 2, maxArgs: 2, collation: numericCollation, call: builtinDateAdd},
+	"date_sub":          {minArgs: 2, maxArgs: 2, collation: numericCollation, call: builtinDateSub},
+	"date_format":       {minArgs: 2, maxArgs: 2, collation: defaultStringCollation, call: strict(builtinDateFormat)},
+
+	// control flow functions
+	"if":       {minArgs: 3, maxArgs: 3, collation: ifCollation, call: builtinIf},
+	"ifnull":   {minArgs: 2, maxArgs: 2, collation: mergedCollation, call: builtinCoalesce},
+	"coalesce": {minArgs: 1, maxArgs: -1, collation: mergedCollation, call: builtinCoalesce},
+}
+
+// lookupBuiltin returns the builtin function with the given name, checking that
+// it can be called with the given number of arguments
+func lookupBuiltin(name string, argc int) (*builtin, error) {
+	f, ok := builtinFunctions[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%s: function '%s'", ErrConvertExprNotSupported, name)
+	}
+	if argc < f.minArgs || (f.maxArgs >= 0 && argc > f.maxArgs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect parameter count in the call to native function '%s'", name)
+	}
+	return f, nil
+}
+
+// newCallExpr returns a call to the given builtin function with the given arguments
+func newCallExpr(name string, f *builtin, args TupleExpr) (*CallExpr, error) {
+	collation, err := f.collation(args)
+	if err != nil {
+		return nil, err
+	}
+	return &CallExpr{
+		Arguments: args,
+		Method:    name,
+		F:         f,
+		collation: collation,
+	}, nil
+}
+
+// Evaluate implements the Expr interface
+func (c *CallExpr) Evaluate(env *ExpressionEnv) (EvalResult, error) {
+	res, err := c.F.call(env, c.Arguments, c.collation)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if res.typ != sqltypes.Null {
+		res.collation = c.collation
+	}
+	return res, nil
+}
+
+// Type implements the Expr interface
+func (c *CallExpr) Type(env *ExpressionEnv) (querypb.Type, error) {
+	res, err := c.Evaluate(env)
+	if err != nil {
+		return querypb.Type_NULL_TYPE, err
+	}
+	return res.typ, nil
+}
+
+// Collation implements the Expr interface
+func (c *CallExpr) Collation() collations.TypedCollation {
+	return c.collation
+}
+
+func (c *CallExpr) format(w *strings.Builder, _ bool) {
+	w.WriteString(c.Method)
+	w.WriteByte('(')
+	for i, arg := range c.Arguments {
+		if i > 0 {
+			w.WriteString(", ")
+		}
+		arg.format(w, false)
+	}
+	w.WriteByte(')')
+}
+
+// strict wraps the implementation of a function that needs all of its arguments
+// evaluated beforehand, and that returns NULL if any of them is NULL
+func strict(fn func(args []EvalResult, collation collations.TypedCollation) (EvalResult, error)) func(*ExpressionEnv, TupleExpr, collations.TypedCollation) (EvalResult, error) {
+	return func(env *ExpressionEnv, args TupleExpr, collation collations.TypedCollation) (EvalResult, error) {
+		values := make([]EvalResult, 0, len(args))
+		for _, arg := range args {
+			val, err := arg.Evaluate(env)
+			if err != nil {
+				return EvalResult{}, err
+			}
+			if val.typ == sqltypes.Null {
+				return resultNull, nil
+			}
+			values = append(values, val)
+		}
+		return fn(values, collation)
+	}
+}
+
+func numericCollation(TupleExpr) (collations.TypedCollation, error) {
+	return collationNumeric, nil
+}
+
+func firstArgCollation(args TupleExpr) (collations.TypedCollation, error) {
+	return args[0].Collation(), nil
+}
+
+func mergedCollation(args TupleExpr) (collations.TypedCollation, error) {
+	return mergeArgCollations(args...)
+}
+
+func ifCollation(args TupleExpr) (collations.TypedCollation, error) {
+	return mergeArgCollations(args[1:]...)
+}
+
+func defaultStringCollation(TupleExpr) (collations.TypedCollation, error) {
+	return getCollation(nil, nil), nil
+}
+
+// mergeArgCollations returns the collation that the results of all the given
+// expressions can be coerced to, following MySQL's collation coercibility rules
+func mergeArgCollations(args ...Expr) (collations.TypedCollation, error) {
+	var merged collations.TypedCollation
+	for _, arg := range args {
+		coll := arg.Collation()
+		if !coll.Valid() {
+			continue
+		}
+		if !merged.Valid() {
+			merged = coll
+			continue
+		}
+		var err error
+		merged, _, _, err = collations.Local().MergeCollations(merged, coll, collations.CoercionOptions{
+			ConvertToSuperset:   true,
+			ConvertWithCoercion: true,
+		})
+		if err != nil {
+			return collations.TypedCollation{}, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error())
+		}
+	}
+	if !merged.Valid() {
+		return collationNumeric, nil
+	}
+	return merged, nil
+}
+
+// coerceTo returns the textual representation of the given value, transcoded to the given collation
+func (e EvalResult) coerceTo(collation collations.TypedCollation) ([]byte, error) {
+	if !sqltypes.IsText(e.typ) && !sqltypes.IsBinary(e.typ) {
+		return e.Value().Raw(), nil
+	}
+	if !e.collation.Valid() || !collation.Valid() || e.collation.Collation == collation.Collation {
+		return e.bytes, nil
+	}
+	_, coerce, _, err := collations.Local().MergeCollations(e.collation, collation, collations.CoercionOptions{
+		ConvertToSuperset:   true,
+		ConvertWithCoercion: true,
+	})
+	if err != nil {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error())
+	}
+	if coerce == nil {
+		return e.bytes, nil
+	}
+	return coerce(nil, e.bytes)
+}
+
+// runeCodec encodes and decodes the characters of a string in a given charset
+type runeCodec interface {
+	EncodeRune([]byte, rune) int
+	DecodeRune([]byte) (rune, int)
+}
+
+type utf8Codec struct{}
+
+func (utf8Codec) EncodeRune(dst []byte, r rune) int {
+	return utf8.EncodeRune(dst, r)
+}
+
+func (utf8Codec) DecodeRune(src []byte) (rune, int) {
+	return utf8.DecodeRune(src)
+}
+
+// codecFor returns the codec for the charset of the given collation; binary strings
+// are handled byte by byte, and strings without a known collation are treated as UTF-8
+func codecFor(collation collations.TypedCollation) runeCodec {
+	if coll := collations.Local().LookupByID(collation.Collation); coll != nil {
+		return coll.Charset()
+	}
+	return utf8Codec{}
+}
+
+func isBinaryCollation(collation collations.TypedCollation) bool {
+	return collation.Collation == collations.CollationBinaryID
+}
+
+func builtinConcat(args []EvalResult, collation collations.TypedCollation) (EvalResult, error) {
+	var buf []byte
+	for _, arg := range args {
+		raw, err := arg.coerceTo(collation)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		buf = append(buf, raw...)
+	}
+	return EvalResult{typ: sqltypes.VarBinary, bytes: buf}, nil
+}
+
+func builtinSubstring(args []EvalResult, collation collations.TypedCollation) (EvalResult, error) {
+	str, err := args[0].coerceTo(collation)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	codec := codecFor(collation)
+
+	var offsets []int
+	for pos := 0; pos < len(str); {
+		_, width := codec.DecodeRune(str[pos:])
+		if width <= 0 {
+			width = 1
+		}
+		offsets = append(offsets, pos)
+		pos += width
+	}
+	offsets = append(offsets, len(str))
+	length := int64(len(offsets) - 1)
+
+	// MySQL positions are 1-based; negative positions count from the end of the string,
+	// and position 0 always returns the empty string
+	start := evalToInt64(args[1])
+	switch {
+	case start > 0:
+		start--
+	case start < 0:
+		start += length
+	default:
+		start = length
+	}
+	if start < 0 || start > length {
+		start = length
+	}
+	end := length
+	if len(args) > 2 {
+		count := evalToInt64(args[2])
+		if count < 0 {
+			count = 0
+		}
+		if count < end-start {
+			end = start + count
+		}
+	}
+	return EvalResult{typ: sqltypes.VarBinary, bytes: str[offsets[start]:offsets[end]]}, nil
+}
+
+func builtinLower(args []EvalResult, collation collations.TypedCollation) (EvalResult, error) {
+	return changeCase(args[0], collation, unicode.ToLower)
+}
+
+func builtinUpper(args []EvalResult, collation collations.TypedCollation) (EvalResult, error) {
+	return changeCase(args[0], collation, unicode.ToUpper)
+}
+
+// changeCase maps all the characters in the given string, decoding and encoding them
+// with the charset of the collation. Like in MySQL, binary strings are returned unchanged.
+func changeCase(arg EvalResult, collation collations.TypedCollation, mapping func(rune) rune) (EvalResult, error) {
+	str, err := arg.coerceTo(collation)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if isBinaryCollation(collation) {
+		return EvalResult{typ: sqltypes.VarBinary, bytes: str}, nil
+	}
+
+	codec := codecFor(collation)
+	var tmp [utf8.UTFMax]byte
+	buf := make([]byte, 0, len(str))
+	for len(str) > 0 {
+		r, width := codec.DecodeRune(str)
+		if width <= 0 {
+			width = 1
+		}
+		if r != utf8.RuneError {
+			if n := codec.EncodeRune(tmp[:], mapping(r)); n > 0 {
+				buf = append(buf, tmp[:n]...)
+				str = str[width:]
+				continue
+			}
+		}
+		buf = append(buf, str[:width]...)
+		str = str[width:]
+	}
+	return EvalResult{typ: sqltypes.VarBinary, bytes: buf}, nil
+}
+
+// evalToInt64 returns the integer value of the given result, rounding
+// floating point values like MySQL does for integer arguments
+func evalToInt64(e EvalResult) int64 {
+	e = makeNumeric(e)
+	switch e.typ {
+	case sqltypes.Int64:
+		return int64(e.numval)
+	case sqltypes.Uint64:
+		if e.numval > math.MaxInt64 {
+			return math.MaxInt64
+		}
+		return int64(e.numval)
+	default:
+		f := math.Round(math.Float64frombits(e.numval))
+		switch {
+		case f >= math.MaxInt64:
+			return math.MaxInt64
+		case f <= math.MinInt64:
+			return math.MinInt64
+		}
+		return int64(f)
+	}
+}
+
+func newFloatResult(typ querypb.Type, f float64) EvalResult {
+	return EvalResult{typ: typ, numval: math.Float64bits(f)}
+}
+
+func builtinAbs(args []EvalResult, _ collations.TypedCollation) (EvalResult, error) {
+	arg := makeNumeric(args[0])
+	switch arg.typ {
+	case sqltypes.Int64:
+		i := int64(arg.numval)
+		if i == math.MinInt64 {
+			return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT value is out of range in 'abs(%d)'", i)
+		}
+		if i < 0 {
+			i = -i
+		}
+		return EvalResult{typ: sqltypes.Int64, numval: uint64(i)}, nil
+	case sqltypes.Uint64:
+		return arg, nil
+	default:
+		return newFloatResult(arg.typ, math.Abs(math.Float64frombits(arg.numval))), nil
+	}
+}
+
+func builtinRound(args []EvalResult, _ collations.TypedCollation) (EvalResult, error) {
+	arg := makeNumeric(args[0])
+	var decimals int64
+	if len(args) > 1 {
+		decimals = evalToInt64(args[1])
+	}
+
+	switch arg.typ {
+	case sqltypes.Int64, sqltypes.Uint64:
+		if decimals >= 0 {
+			return arg, nil
+		}
+		if decimals < -19 {
+			return EvalResult{typ: arg.typ}, nil
+		}
+		pow := uint64(1)
+		for i := int64(0); i < -decimals; i++ {
+			pow *= 10
+		}
+		if arg.typ == sqltypes.Uint64 {
+			rounded := (arg.numval + pow/2) / pow * pow
+			if rounded < arg.numval {
+				return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT UNSIGNED value is out of range in 'round(%d,%d)'", arg.numval, decimals)
+			}
+			return EvalResult{typ: sqltypes.Uint64, numval: rounded}, nil
+		}
+		i := int64(arg.numval)
+		half := int64(pow / 2)
+		if i < 0 {
+			half = -half
+		}
+		rounded := (i + half) / int64(pow) * int64(pow)
+		if (i < 0) != (rounded < 0) && rounded != 0 {
+			return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT value is out of range in 'round(%d,%d)'", i, decimals)
+		}
+		return EvalResult{typ: sqltypes.Int64, numval: uint64(rounded)}, nil
+	default:
+		f := math.Float64frombits(arg.numval)
+		if decimals > 30 {
+			return arg, nil
+		}
+		if decimals < -308 {
+			return newFloatResult(arg.typ, 0), nil
+		}
+		// exact values (DECIMAL) are rounded half away from zero, while
+		// approximate values (DOUBLE) are rounded to the nearest even value
+		round := math.RoundToEven
+		if arg.typ == sqltypes.Decimal {
+			round = math.Round
+		}
+		if decimals < 0 {
+			pow := math.Pow10(int(-decimals))
+			return newFloatResult(arg.typ, round(f/pow)*pow), nil
+		}
+		pow := math.Pow10(int(decimals))
+		return newFloatResult(arg.typ, round(f*pow)/pow), nil
+	}
+}
+
+func builtinFloor(args []EvalResult, _ collations.TypedCollation) (EvalResult, error) {
+	return roundToInteger(args[0], math.Floor)
+}
+
+func builtinCeil(args []EvalResult, _ collations.TypedCollation) (EvalResult, error) {
+	return roundToInteger(args[0], math.Ceil)
+}
+
+// roundToInteger implements FLOOR and CEIL: integers are returned unchanged, DOUBLE values
+// are rounded as DOUBLE, and DECIMAL values are rounded to a BIGINT
+func roundToInteger(arg EvalResult, round func(float64) float64) (EvalResult, error) {
+	arg = makeNumeric(arg)
+	switch arg.typ {
+	case sqltypes.Int64, sqltypes.Uint64:
+		return arg, nil
+	case sqltypes.Decimal:
+		f := round(math.Float64frombits(arg.numval))
+		if f >= math.MinInt64 && f < math.MaxInt64 {
+			return EvalResult{typ: sqltypes.Int64, numval: uint64(int64(f))}, nil
+		}
+		return newFloatResult(sqltypes.Decimal, f), nil
+	default:
+		return newFloatResult(arg.typ, round(math.Float64frombits(arg.numval))), nil
+	}
+}
+
+func builtinIf(env *ExpressionEnv, args TupleExpr, _ collations.TypedCollation) (EvalResult, error) {
+	cond, err := args[0].Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if isTrue, _ := cond.truthValue(); isTrue {
+		return args[1].Evaluate(env)
+	}
+	return args[2].Evaluate(env)
+}
+
+func builtinCoalesce(env *ExpressionEnv, args TupleExpr, _ collations.TypedCollation) (EvalResult, error) {
+	for _, arg := range args {
+		val, err := arg.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if val.typ != sqltypes.Null {
+			return val, nil
+		}
+	}
+	return resultNull, nil
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func evaluateSQLExpr(t *testing.T, expression string, env *ExpressionEnv) (EvalResult, error) {
+	t.Helper()
+	stmt, err := sqlparser.Parse("select " + expression)
+	require.NoError(t, err)
+	astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+	expr, err := ConvertEx(astExpr, dummyCollation(45), false)
+	require.NoError(t, err)
+	return expr.Evaluate(env)
+}
+
+func TestBuiltinFunctions(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   sqltypes.Value
+	}{
+		// string functions
+		{"concat('foo', 'bar')", sqltypes.NewVarBinary("foobar")},
+		{"concat('foo', 42, 1.5)", sqltypes.NewVarBinary("foo421.5")},
+		{"concat('foo', null)", NULL},
+		{"substring('vitess', 3)", sqltypes.NewVarBinary("tess")},
+		{"substring('vitess', 2, 3)", sqltypes.NewVarBinary("ite")},
+		{"substring('vitess', -4, 2)", sqltypes.NewVarBinary("te")},
+		{"substring('vitess', 0)", sqltypes.NewVarBinary("")},
+		{"substring('vitess' from 5)", sqltypes.NewVarBinary("ss")},
+		{"substr('añoñí', 2, 3)", sqltypes.NewVarBinary("ñoñ")},
+		{"substring('vitess', 10)", sqltypes.NewVarBinary("")},
+		{"lower('ViTeSS')", sqltypes.NewVarBinary("vitess")},
+		{"upper('Ñandú')", sqltypes.NewVarBinary("ÑANDÚ")},
+		{"lower('ViTeSS' collate utf8mb4_bin)", sqltypes.NewVarBinary("vitess")},
+		{"lower(concat('ViTeSS', 42))", sqltypes.NewVarBinary("vitess42")},
+		{"lower(null)", NULL},
+
+		// numeric functions
+		{"abs(-42)", sqltypes.NewInt64(42)},
+		{"abs(42)", sqltypes.NewInt64(42)},
+		{"abs(-4.5)", sqltypes.NewFloat64(4.5)},
+		{"abs('-3')", sqltypes.NewInt64(3)},
+		{"round(4.4)", sqltypes.NewFloat64(4)},
+		{"round(-4.6)", sqltypes.NewFloat64(-5)},
+		{"round(3.14159, 2)", sqltypes.NewFloat64(3.14)},
+		{"round(1234, -2)", sqltypes.NewInt64(1200)},
+		{"round(-1250, -2)", sqltypes.NewInt64(-1300)},
+		{"round(1234.5, -2)", sqltypes.NewFloat64(1200)},
+		{"floor(1.7)", sqltypes.NewFloat64(1)},
+		{"floor(-1.2)", sqltypes.NewFloat64(-2)},
+		{"ceil(1.2)", sqltypes.NewFloat64(2)},
+		{"ceiling(-1.7)", sqltypes.NewFloat64(-1)},
+		{"ceil(7)", sqltypes.NewInt64(7)},
+		{"floor(null)", NULL},
+
+		// date and time functions
+		{"date_add('2021-01-31', interval 1 month)", sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-02-28"))},
+		{"date_add('2020-02-29', interval 1 year)", sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-02-28"))},
+		{"date_add('2021-12-31', interval 1 day)", sqltypes.MakeTrusted(sqltypes.Date, []byte("2022-01-01"))},
+		{"date_add('2021-12-31', interval 2 quarter)", sqltypes.MakeTrusted(sqltypes.Date, []byte("2022-06-30"))},
+		{"date_add('2021-12-31', interval 1 hour)", sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-12-31 01:00:00"))},
+		{"date_add('2021-12-31 23:59:59', interval 1 second)", sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2022-01-01 00:00:00"))},
+		{"date_add('2021-12-31 23:59:59', interval 1 microsecond)", sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-12-31 23:59:59.000001"))},
+		{"date_add('2021-12-31 23:59:59', interval 2 week)", sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2022-01-14 23:59:59"))},
+		{"date_sub('2021-03-31', interval 1 month)", sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-02-28"))},
+		{"date_sub('2021-01-01 00:00:00', interval 90 minute)", sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2020-12-31 22:30:00"))},
+		{"date_add('not a date', interval 1 day)", NULL},
+		{"date_add('2021-01-01', interval null day)", NULL},
+		{"date_format('2021-07-04 15:04:05', '%Y-%m-%d %H:%i:%s')", sqltypes.NewVarBinary("2021-07-04 15:04:05")},
+		{"date_format('2021-07-04 15:04:05', '%W %M %D %y, %l:%i %p')", sqltypes.NewVarBinary("Sunday July 4th 21, 3:04 PM")},
+		{"date_format('2021-07-04', '%a %b %e %c %j %w %T %f %%')", sqltypes.NewVarBinary("Sun Jul 4 7 185 0 00:00:00 000000 %")},
+		{"date_format('2021-01-01', '%U %u %V %v %X %x')", sqltypes.NewVarBinary("00 00 52 53 2020 2020")},
+		{"date_format('2021-12-31', '%U %u %V %v %X %x')", sqltypes.NewVarBinary("52 52 52 52 2021 2021")},
+		{"date_format('2008-12-29', '%U %u %V %v %X %x')", sqltypes.NewVarBinary("52 53 52 01 2008 2009")},
+		{"date_format(null, '%Y')", NULL},
+
+		// control flow functions
+		{"if(1 = 1, 'yes', 'no')", sqltypes.NewVarBinary("yes")},
+		{"if(1 = 2, 'yes', 'no')", sqltypes.NewVarBinary("no")},
+		{"if(null, 'yes', 'no')", sqltypes.NewVarBinary("no")},
+		{"if('0.0', 1, 2)", sqltypes.NewInt64(2)},
+		{"ifnull(null, 42)", sqltypes.NewInt64(42)},
+		{"ifnull(1, 42)", sqltypes.NewInt64(1)},
+		{"coalesce(null, null, 'x', 'y')", sqltypes.NewVarBinary("x")},
+		{"coalesce(null, null)", NULL},
+		{"case when 1 = 2 then 'a' when 2 = 2 then 'b' else 'c' end", sqltypes.NewVarBinary("b")},
+		{"case when 1 = 2 then 'a' end", NULL},
+		{"case 3 when 1 then 'a' when 3 then 'c' else 'z' end", sqltypes.NewVarBinary("c")},
+		{"case null when null then 'a' else 'z' end", sqltypes.NewVarBinary("z")},
+
+		// logical operators
+		{"1 and 1", sqltypes.NewInt32(1)},
+		{"1 and 0", sqltypes.NewInt32(0)},
+		{"null and 0", sqltypes.NewInt32(0)},
+		{"null and 1", NULL},
+		{"0 or 1", sqltypes.NewInt32(1)},
+		{"null or 1", sqltypes.NewInt32(1)},
+		{"null or 0", NULL},
+		{"1 xor 1", sqltypes.NewInt32(0)},
+		{"1 xor 0", sqltypes.NewInt32(1)},
+		{"1 xor null", NULL},
+		{"not 1", sqltypes.NewInt32(0)},
+		{"not 0", sqltypes.NewInt32(1)},
+		{"not null", NULL},
+		{"null is null", sqltypes.NewInt32(1)},
+		{"1 is null", sqltypes.NewInt32(0)},
+		{"1 is not null", sqltypes.NewInt32(1)},
+		{"'foo' is true", sqltypes.NewInt32(0)},
+		{"'1foo' is true", sqltypes.NewInt32(1)},
+		{"null is not true", sqltypes.NewInt32(1)},
+		{"0 is false", sqltypes.NewInt32(1)},
+		{"null is not false", sqltypes.NewInt32(1)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			res, err := evaluateSQLExpr(t, test.expression, nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, res.Value())
+		})
+	}
+}
+
+func TestBuiltinFunctionsOnColumns(t *testing.T) {
+	env := &ExpressionEnv{
+		Row: []sqltypes.Value{sqltypes.NewVarChar("Vitess"), sqltypes.NewInt64(-7), sqltypes.NULL},
+	}
+	lookup := columnLookup{"name": 0, "num": 1, "empty": 2}
+
+	tests := []struct {
+		expression string
+		expected   sqltypes.Value
+	}{
+		{"concat(lower(name), ':', abs(num))", sqltypes.NewVarBinary("vitess:7")},
+		{"if(num < 0 and name is not null, 'negative', 'positive')", sqltypes.NewVarBinary("negative")},
+		{"coalesce(empty, num)", sqltypes.NewInt64(-7)},
+		{"case when empty is null then upper(substring(name, 1, 3)) end", sqltypes.NewVarBinary("VIT")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + test.expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			expr, err := Convert(astExpr, lookup)
+			require.NoError(t, err)
+			res, err := expr.Evaluate(env)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, res.Value())
+		})
+	}
+}
+
+func TestBuiltinNow(t *testing.T) {
+	before := time.Now().Truncate(time.Second)
+	res, err := evaluateSQLExpr(t, "now()", nil)
+	require.NoError(t, err)
+	require.Equal(t, sqltypes.Datetime, res.typ)
+	now, err := time.ParseInLocation(layoutDatetime, string(res.bytes), time.Local)
+	require.NoError(t, err)
+	assert.False(t, now.Before(before), "now() returned %s, before %s", now, before)
+
+	res, err = evaluateSQLExpr(t, "current_timestamp(3)", nil)
+	require.NoError(t, err)
+	assert.Len(t, res.bytes, len(layoutDatetime)+4)
+
+	_, err = evaluateSQLExpr(t, "now(7)", nil)
+	require.EqualError(t, err, "Too-big precision 7 specified for 'now'. Maximum is 6.")
+}
+
+func TestBuiltinFunctionConvertErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		err        string
+	}{
+		{"unknown_function(1)", "expr cannot be converted, not supported: function 'unknown_function'"},
+		{"concat()", "Incorrect parameter count in the call to native function 'concat'"},
+		{"abs(1, 2)", "Incorrect parameter count in the call to native function 'abs'"},
+		{"date_add('2021-01-01', interval '1 2' day_hour)", "expr cannot be converted, not supported: interval unit day_hour"},
+		{"count(*)", "expr cannot be converted, not supported: *sqlparser.FuncExpr"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + test.expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			_, err = Convert(astExpr, dummyCollation(45))
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestCalcWeek(t *testing.T) {
+	// expected values for every week mode, as returned by MySQL's WEEK(date, mode)
+	modes := []int{
+		weekFirstWeekday,
+		weekMondayFirst,
+		weekYear | weekFirstWeekday,
+		weekMondayFirst | weekYear,
+	}
+	tests := []struct {
+		date     string
+		expected []int
+	}{
+		{"2000-01-01", []int{0, 0, 52, 52}},
+		{"2000-01-02", []int{1, 0, 1, 52}},
+		{"2000-12-31", []int{53, 52, 53, 52}},
+		{"2019-12-30", []int{52, 53, 52, 1}},
+		{"2021-06-15", []int{24, 24, 24, 24}},
+	}
+
+	for _, test := range tests {
+		date, err := time.Parse(layoutDate, test.date)
+		require.NoError(t, err)
+		for i, mode := range modes {
+			week, _ := calcWeek(date, mode)
+			assert.Equal(t, test.expected[i], week, "week of %s with flags %d", test.date, mode)
+		}
+	}
+}
+
+type columnLookup map[string]int
+
+func (c columnLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	return c[col.Name.Lowered()], nil
+}
+
+func (c columnLookup) CollationIDLookup(sqlparser.Expr) collations.ID {
+	return collations.ID(45)
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+type (
+	// LogicalOp is the operator of a LogicalExpr
+	LogicalOp int8
+
+	// LogicalExpr represents the AND, OR and XOR boolean operators
+	LogicalExpr struct {
+		Op          LogicalOp
+		Left, Right Expr
+	}
+
+	// NotExpr represents the NOT boolean operator
+	NotExpr struct {
+		Inner Expr
+	}
+
+	// IsExpr represents the IS [NOT] NULL, IS [NOT] TRUE and IS [NOT] FALSE tests
+	IsExpr struct {
+		Op    sqlparser.IsExprOperator
+		Inner Expr
+	}
+
+	// WhenThen is a single WHEN ... THEN ... branch of a CaseExpr
+	WhenThen struct {
+		When, Then Expr
+	}
+
+	// CaseExpr represents both the simple and the searched forms of CASE.
+	// When Base is not nil, each branch is chosen by comparing Base with its When expression.
+	CaseExpr struct {
+		Base      Expr
+		Cases     []WhenThen
+		Else      Expr
+		collation collations.TypedCollation
+	}
+)
+
+const (
+	LogicalAnd LogicalOp = iota
+	LogicalOr
+	LogicalXor
+)
+
+var _ Expr = (*LogicalExpr)(nil)
+var _ Expr = (*NotExpr)(nil)
+var _ Expr = (*IsExpr)(nil)
+var _ Expr = (*CaseExpr)(nil)
+
+// truthValue returns the boolean value of the result when it is used as a condition.
+// Like in MySQL, numbers are true when they are not zero, and strings are converted
+// to numbers first. The second return value is true when the result is NULL.
+func (e EvalResult) truthValue() (bool, bool) {
+	switch {
+	case e.typ == sqltypes.Null:
+		return false, true
+	case sqltypes.IsIntegral(e.typ):
+		return e.numval != 0, false
+	case sqltypes.IsFloat(e.typ) || e.typ == sqltypes.Decimal:
+		return math.Float64frombits(e.numval) != 0, false
+	case sqltypes.IsText(e.typ) || sqltypes.IsBinary(e.typ):
+		return parseStringToFloat(string(e.bytes)) != 0, false
+	case e.typ == querypb.Type_TUPLE:
+		return false, false
+	default:
+		return strings.Trim(string(e.bytes), "0-: .") != "", false
+	}
+}
+
+// IsTrue returns true if the result is true when it is used as a condition.
+func (e EvalResult) IsTrue() bool {
+	val, isNull := e.truthValue()
+	return val && !isNull
+}
+
+// nullableBool returns the result of a boolean expression, which can be NULL
+func nullableBool(val, isNull bool) EvalResult {
+	if isNull {
+		return resultNull
+	}
+	return boolResult(val, false)
+}
+
+// String returns the SQL representation of the operator
+func (op LogicalOp) String() string {
+	switch op {
+	case LogicalAnd:
+		return "and"
+	case LogicalOr:
+		return "or"
+	default:
+		return "xor"
+	}
+}
+
+// Evaluate implements the Expr interface
+func (l *LogicalExpr) Evaluate(env *ExpressionEnv) (EvalResult, error) {
+	left, err := l.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	lval, lnull := left.truthValue()
+
+	// AND and OR can short-circuit when the left side decides the result on its own
+	switch {
+	case l.Op == LogicalAnd && !lval && !lnull:
+		return resultFalse, nil
+	case l.Op == LogicalOr && lval:
+		return resultTrue, nil
+	}
+
+	right, err := l.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	rval, rnull := right.truthValue()
+
+	switch l.Op {
+	case LogicalAnd:
+		if !rval && !rnull {
+			return resultFalse, nil
+		}
+		return nullableBool(true, lnull || rnull), nil
+	case LogicalOr:
+		if rval {
+			return resultTrue, nil
+		}
+		return nullableBool(false, lnull || rnull), nil
+	default:
+		return nullableBool(lval != rval, lnull || rnull), nil
+	}
+}
+
+// Type implements the Expr interface
+func (l *LogicalExpr) Type(*ExpressionEnv) (querypb.Type, error) {
+	return querypb.Type_INT32, nil
+}
+
+// Collation implements the Expr interface
+func (l *LogicalExpr) Collation() collations.TypedCollation {
+	return collationNumeric
+}
+
+func (l *LogicalExpr) format(w *strings.Builder, wrap bool) {
+	if wrap {
+		w.WriteByte('(')
+	}
+	l.Left.format(w, true)
+	w.WriteString(" ")
+	w.WriteString(l.Op.String())
+	w.WriteString(" ")
+	l.Right.format(w, true)
+	if wrap {
+		w.WriteByte(')')
+	}
+}
+
+// Evaluate implements the Expr interface
+func (n *NotExpr) Evaluate(env *ExpressionEnv) (EvalResult, error) {
+	inner, err := n.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	val, isNull := inner.truthValue()
+	return nullableBool(!val, isNull), nil
+}
+
+// Type implements the Expr interface
+func (n *NotExpr) Type(*ExpressionEnv) (querypb.Type, error) {
+	return querypb.Type_INT32, nil
+}
+
+// Collation implements the Expr interface
+func (n *NotExpr) Collation() collations.TypedCollation {
+	return collationNumeric
+}
+
+func (n *NotExpr) format(w *strings.Builder, _ bool) {
+	w.WriteString("not ")
+	n.Inner.format(w, true)
+}
+
+// Evaluate implements the Expr interface
+func (i *IsExpr) Evaluate(env *ExpressionEnv) (EvalResult, error) {
+	inner, err := i.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	val, isNull := inner.truthValue()
+
+	// the IS tests never return NULL
+	switch i.Op {
+	case sqlparser.IsNullOp:
+		return boolResult(isNull, false), nil
+	case sqlparser.IsNotNullOp:
+		return boolResult(isNull, true), nil
+	case sqlparser.IsTrueOp:
+		return boolResult(val && !isNull, false), nil
+	case sqlparser.IsNotTrueOp:
+		return boolResult(val && !isNull, true), nil
+	case sqlparser.IsFalseOp:
+		return boolResult(!val && !isNull, false), nil
+	default:
+		return boolResult(!val && !isNull, true), nil
+	}
+}
+
+// Type implements the Expr interface
+func (i *IsExpr) Type(*ExpressionEnv) (querypb.Type, error) {
+	return querypb.Type_INT32, nil
+}
+
+// Collation implements the Expr interface
+func (i *IsExpr) Collation() collations.TypedCollation {
+	return collationNumeric
+}
+
+func (i *IsExpr) format(w *strings.Builder, wrap bool) {
+	if wrap {
+		w.WriteByte('(')
+	}
+	i.Inner.format(w, true)
+	w.WriteString(" ")
+	w.WriteString(i.Op.ToString())
+	if wrap {
+		w.WriteByte(')')
+	}
+}
+
+// Evaluate implements the Expr interface
+func (c *CaseExpr) Evaluate(env *ExpressionEnv) (EvalResult, error) {
+	var base EvalResult
+	if c.Base != nil {
+		var err error
+		base, err = c.Base.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+	}
+
+	for _, branch := range c.Cases {
+		when, err := branch.When.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+
+		var matched bool
+		if c.Base != nil {
+			cmp, isNull, err := nullSafeCoerceAndCompare(base, when)
+			if err != nil {
+				return EvalResult{}, err
+			}
+			matched = !isNull && cmp == 0
+		} else {
+			matched, _ = when.truthValue()
+		}
+
+		if matched {
+			return c.result(env, branch.Then)
+		}
+	}
+
+	if c.Else == nil {
+		return resultNull, nil
+	}
+	return c.result(env, c.Else)
+}
+
+func (c *CaseExpr) result(env *ExpressionEnv, expr Expr) (EvalResult, error) {
+	res, err := expr.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if res.typ != sqltypes.Null {
+		res.collation = c.collation
+	}
+	return res, nil
+}
+
+// Type implements the Expr interface
+func (c *CaseExpr) Type(env *ExpressionEnv) (querypb.Type, error) {
+	res, err := c.Evaluate(env)
+	if err != nil {
+		return querypb.Type_NULL_TYPE, err
+	}
+	return res.typ, nil
+}
+
+// Collation implements the Expr interface
+func (c *CaseExpr) Collation() collations.TypedCollation {
+	return c.collation
+}
+
+func (c *CaseExpr) format(w *strings.Builder, _ bool) {
+	w.WriteString("case")
+	if c.Base != nil {
+		w.WriteByte(' ')
+		c.Base.format(w, true)
+	}
+	for _, branch := range c.Cases {
+		w.WriteString(" when ")
+		branch.When.format(w, true)
+		w.WriteString(" then ")
+		branch.Then.format(w, true)
+	}
+	if c.Else != nil {
+		w.WriteString(" else ")
+		c.Else.format(w, true)
+	}
+	w.WriteString(" end")
+}
@@ -29,8 +29,9 @@ import (
 type (
 	// SelectExpr provides whether the columns is aggregation expression or not.
 	SelectExpr struct {
-		Col  sqlparser.SelectExpr
-		Aggr bool
+		Col    sqlparser.SelectExpr
+		Aggr   bool
+		Window bool
 	}
 
 	// QueryProjection contains the information about the projections, group by and order by expressions used to do horizon planning.
@@ -43,6 +44,7 @@ type (
 		OrderExprs         []OrderBy
 		CanPushDownSorting bool
 		HasStar            bool
+		HasWindowFunc      bool
 		ProjectionError    error
 	}
 
@@ -148,6 +150,10 @@ func (qp *QueryProjection) addSelectExpressions(sel *sqlparser.Select) error {
 				col.Aggr = true
 				qp.HasAggr = true
 			}
+			if sqlparser.ContainsWindowFunc(selExp.Expr) {
+				col.Window = true
+				qp.HasWindowFunc = true
+			}
 
 			qp.SelectExprs = append(qp.SelectExprs, col)
 		case *sqlparser.StarExpr:
@@ -205,7 +211,11 @@ func checkForInvalidAggregations(exp *sqlparser.AliasedExpr) error {
 	return sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
 		fExpr, ok := node.(*sqlparser.FuncExpr)
 		if ok && fExpr.IsAggregate() {
-			if len(fExpr.Exprs) != 1 {
+			if fExpr.Name.Lowered() == "json_objectagg" {
+				if len(fExpr.Exprs) != 2 {
+					return false, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.SyntaxError, "json_objectagg takes a key and a value '%s'", sqlparser.String(fExpr))
+				}
+			} else if len(fExpr.Exprs) != 1 {
 				return false, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.SyntaxError, "aggregate functions take a single argument '%s'", sqlparser.String(fExpr))
 			}
 		}
@@ -321,6 +331,9 @@ func (qp *QueryProjection) toString() string {
 		if expr.Aggr {
 			e = "aggr: " + e
 		}
+		if expr.Window {
+			e = "window: " + e
+		}
 		out.Select = append(out.Select, e)
 	}
 
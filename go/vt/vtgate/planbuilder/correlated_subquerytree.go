@@ -17,7 +17,10 @@ limitations under the License.
 package planbuilder
 
 import (
+	"vitess.io/vitess/go/mysql/collations"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 )
 
@@ -27,6 +30,21 @@ type correlatedSubqueryTree struct {
 	extracted *sqlparser.ExtractedSubquery
 	// arguments that need to be copied from the uter to inner
 	vars map[string]int
+
+	// negated is set for NOT EXISTS subqueries
+	negated bool
+	// predicate is the outer predicate using the value of the subquery.
+	// It is evaluated at the vtgate level once the subquery has been executed.
+	predicate sqlparser.Expr
+
+	// when the subquery is correlated on a single column, it can be executed
+	// for a batch of outer rows at once. batchVar is then the list argument holding
+	// the values of the outer column at offset batchCol, and batchKey the inner column.
+	batchVar       string
+	batchCol       int
+	batchKey       *sqlparser.ColName
+	comparisonType querypb.Type
+	collation      collations.ID
 }
 
 var _ queryTree = (*correlatedSubqueryTree)(nil)
@@ -41,13 +59,27 @@ func (s *correlatedSubqueryTree) cost() int {
 
 func (s *correlatedSubqueryTree) clone() queryTree {
 	result := &correlatedSubqueryTree{
-		outer:     s.outer.clone(),
-		inner:     s.inner.clone(),
-		extracted: s.extracted,
+		outer:          s.outer.clone(),
+		inner:          s.inner.clone(),
+		extracted:      s.extracted,
+		vars:           s.vars,
+		negated:        s.negated,
+		predicate:      s.predicate,
+		batchVar:       s.batchVar,
+		batchCol:       s.batchCol,
+		batchKey:       s.batchKey,
+		comparisonType: s.comparisonType,
+		collation:      s.collation,
 	}
 	return result
 }
 
+// isSemiJoin returns true if the subquery can be planned as a semi join:
+// an EXISTS subquery that is executed once for every outer row.
+func (s *correlatedSubqueryTree) isSemiJoin() bool {
+	return engine.PulloutOpcode(s.extracted.OpCode) == engine.PulloutExists && !s.negated && s.batchVar == ""
+}
+
 func (s *correlatedSubqueryTree) pushOutputColumns(colnames []*sqlparser.ColName, semTable *semantics.SemTable) ([]int, error) {
 	return s.outer.pushOutputColumns(colnames, semTable)
 }
@@ -0,0 +1,680 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// cteScope holds the common table expressions visible at a point of the query.
+// The bodies of the CTEs have already been inlined using their own scope.
+type cteScope struct {
+	parent *cteScope
+	ctes   map[string]*sqlparser.CommonTableExpr
+}
+
+// inlineCTEs replaces every reference to a common table expression in the statement
+// with a derived table built from the body of the CTE, so that the planner can plan
+// and merge them like any other derived table. The WITH clauses are removed from the AST.
+// Recursive CTEs cannot be inlined and are rejected.
+func inlineCTEs(stmt sqlparser.Statement) error {
+	var scope *cteScope
+	if with := takeWith(stmt); with != nil {
+		if recursiveCTE(with) != nil {
+			return vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression in this statement")
+		}
+		var err error
+		scope, err = newCTEScope(nil, with.Ctes)
+		if err != nil {
+			return err
+		}
+		if err := scope.checkNotTarget(stmt); err != nil {
+			return err
+		}
+	}
+	return scope.inlineIn(stmt)
+}
+
+// checkNotTarget returns an error when the DML statement modifies one of the CTEs of the scope.
+func (s *cteScope) checkNotTarget(stmt sqlparser.Statement) error {
+	var kind string
+	var targets sqlparser.TableNames
+	switch node := stmt.(type) {
+	case *sqlparser.Update:
+		kind, targets = "UPDATE", singleTableTarget(node.TableExprs)
+	case *sqlparser.Delete:
+		kind, targets = "DELETE", node.Targets
+		if len(targets) == 0 {
+			targets = singleTableTarget(node.TableExprs)
+		}
+	}
+	for _, target := range targets {
+		if s.find(target) != nil {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUpdateableTable, "The target table %s of the %s is not updatable", target.Name.String(), kind)
+		}
+	}
+	return nil
+}
+
+func singleTableTarget(tableExprs sqlparser.TableExprs) sqlparser.TableNames {
+	if len(tableExprs) != 1 {
+		return nil
+	}
+	aliasedTable, ok := tableExprs[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil
+	}
+	tableName, ok := aliasedTable.Expr.(sqlparser.TableName)
+	if !ok {
+		return nil
+	}
+	return sqlparser.TableNames{tableName}
+}
+
+// takeWith removes the WITH clause from the statement and returns it.
+func takeWith(stmt sqlparser.Statement) *sqlparser.With {
+	var with *sqlparser.With
+	switch node := stmt.(type) {
+	case *sqlparser.Select:
+		with, node.With = node.With, nil
+	case *sqlparser.Union:
+		with, node.With = node.With, nil
+	case *sqlparser.Update:
+		with, node.With = node.With, nil
+	case *sqlparser.Delete:
+		with, node.With = node.With, nil
+	}
+	return with
+}
+
+// recursiveCTE returns the first CTE of a WITH RECURSIVE clause that references itself.
+func recursiveCTE(with *sqlparser.With) *sqlparser.CommonTableExpr {
+	if !with.Recursive {
+		return nil
+	}
+	for _, cte := range with.Ctes {
+		if referencesTable(cte.Subquery.Select, cte.TableID) {
+			return cte
+		}
+	}
+	return nil
+}
+
+// referencesTable returns true if the node uses the unqualified table name anywhere.
+func referencesTable(node sqlparser.SQLNode, name sqlparser.TableIdent) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			// the qualifier of a column is not a table reference
+			return false, nil
+		case sqlparser.TableName:
+			if node.Qualifier.IsEmpty() && node.Name.String() == name.String() {
+				found = true
+			}
+		}
+		return !found, nil
+	}, node)
+	return found
+}
+
+func newCTEScope(parent *cteScope, ctes []*sqlparser.CommonTableExpr) (*cteScope, error) {
+	scope := &cteScope{
+		parent: parent,
+		ctes:   map[string]*sqlparser.CommonTableExpr{},
+	}
+	for _, cte := range ctes {
+		name := cte.TableID.String()
+		if _, exists := scope.ctes[name]; exists {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUniqTable, "Not unique table/alias: '%s'", name)
+		}
+		// a CTE can only use the CTEs that are defined before it
+		body := sqlparser.CloneSelectStatement(cte.Subquery.Select)
+		if err := scope.inlineIn(body); err != nil {
+			return nil, err
+		}
+		scope.ctes[name] = &sqlparser.CommonTableExpr{
+			TableID:  cte.TableID,
+			Columns:  cte.Columns,
+			Subquery: &sqlparser.Subquery{Select: body},
+		}
+	}
+	return scope, nil
+}
+
+func (s *cteScope) find(name sqlparser.TableName) *sqlparser.CommonTableExpr {
+	if !name.Qualifier.IsEmpty() {
+		return nil
+	}
+	for scope := s; scope != nil; scope = scope.parent {
+		if cte, ok := scope.ctes[name.Name.String()]; ok {
+			return cte
+		}
+	}
+	return nil
+}
+
+// inlineIn replaces the references to the CTEs of the scope found in node. The WITH clauses
+// of the statements nested in node are inlined as well, using a scope nested in this one.
+func (s *cteScope) inlineIn(node sqlparser.SQLNode) error {
+	var err error
+	inlineNested := func(stmt sqlparser.SelectStatement, with *sqlparser.With) {
+		if recursiveCTE(with) != nil {
+			err = vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression in subquery")
+			return
+		}
+		var nested *cteScope
+		nested, err = newCTEScope(s, with.Ctes)
+		if err != nil {
+			return
+		}
+		err = nested.inlineIn(stmt)
+	}
+
+	_ = sqlparser.Rewrite(node, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Select:
+			if node.With != nil {
+				inlineNested(node, takeWith(node))
+				return false
+			}
+		case *sqlparser.Union:
+			if node.With != nil {
+				inlineNested(node, takeWith(node))
+				return false
+			}
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := node.Expr.(sqlparser.TableName)
+			if !ok {
+				return true
+			}
+			cte := s.find(tableName)
+			if cte == nil {
+				return true
+			}
+			alias := node.As
+			if alias.IsEmpty() {
+				alias = tableName.Name
+			}
+			cursor.Replace(&sqlparser.AliasedTableExpr{
+				Expr:    &sqlparser.DerivedTable{Select: sqlparser.CloneSelectStatement(cte.Subquery.Select)},
+				As:      alias,
+				Columns: sqlparser.CloneColumns(cte.Columns),
+			})
+			return false
+		}
+		return true
+	}, nil)
+	return err
+}
+
+// planWithClause handles the WITH clause of a SELECT or UNION statement that
+// is planned by gen4. Non-recursive CTEs are inlined in the statement, which can
+// then be planned as usual, in which case a nil primitive is returned.
+// Recursive CTEs are sent as-is to a single shard when all the tables
+// used by the query live in the same unsharded keyspace. Otherwise the recursion
+// is evaluated at the vtgate level by an engine.RecursiveCTE.
+func planWithClause(stmt sqlparser.SelectStatement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
+	var with *sqlparser.With
+	switch node := stmt.(type) {
+	case *sqlparser.Select:
+		with = node.With
+	case *sqlparser.Union:
+		with = node.With
+	}
+	if with == nil {
+		return nil, inlineCTEs(stmt)
+	}
+
+	recursive := recursiveCTE(with)
+	if recursive == nil {
+		return nil, inlineCTEs(stmt)
+	}
+
+	route, err := unshardedRecursiveCTERoute(stmt, vschema)
+	if err != nil || route != nil {
+		return route, err
+	}
+
+	takeWith(stmt)
+	var others []*sqlparser.CommonTableExpr
+	for _, cte := range with.Ctes {
+		if cte == recursive {
+			continue
+		}
+		if referencesTable(cte.Subquery.Select, recursive.TableID) {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multiple recursive common table expressions")
+		}
+		others = append(others, cte)
+	}
+	scope, err := newCTEScope(nil, others)
+	if err != nil {
+		return nil, err
+	}
+	body := sqlparser.CloneSelectStatement(recursive.Subquery.Select)
+	if err := scope.inlineIn(body); err != nil {
+		return nil, err
+	}
+	if err := scope.inlineIn(stmt); err != nil {
+		return nil, err
+	}
+
+	ecte, columns, err := buildRecursiveCTE(recursive, body, reservedVars, vschema)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression in union statement")
+	}
+	return planRecursiveCTEQuery(sel, recursive.TableID, columns, ecte)
+}
+
+// unshardedRecursiveCTERoute returns a route that sends the whole query to a single shard
+// when every table it uses belongs to the same unsharded keyspace. It returns nil otherwise.
+func unshardedRecursiveCTERoute(stmt sqlparser.SelectStatement, vschema ContextVSchema) (engine.Primitive, error) {
+	stmt = sqlparser.CloneSelectStatement(stmt)
+
+	cteNames := map[string]bool{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if cte, ok := node.(*sqlparser.CommonTableExpr); ok {
+			cteNames[cte.TableID.String()] = true
+		}
+		return true, nil
+	}, stmt)
+
+	var keyspace *vindexes.Keyspace
+	var tableNames []string
+	singleShard := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			node.Qualifier.Qualifier = sqlparser.NewTableIdent("")
+			return false, nil
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := node.Expr.(sqlparser.TableName)
+			if !ok {
+				return true, nil
+			}
+			if tableName.Qualifier.IsEmpty() && (cteNames[tableName.Name.String()] || tableName.Name.String() == "dual") {
+				return true, nil
+			}
+			vschemaTable, _, _, _, err := vschema.FindTable(tableName)
+			if err != nil || vschemaTable == nil || vschemaTable.Keyspace.Sharded {
+				singleShard = false
+				return false, nil
+			}
+			if keyspace != nil && keyspace.Name != vschemaTable.Keyspace.Name {
+				singleShard = false
+				return false, nil
+			}
+			keyspace = vschemaTable.Keyspace
+			tableNames = append(tableNames, vschemaTable.Name.String())
+			node.Expr = sqlparser.TableName{Name: tableName.Name}
+		}
+		return singleShard, nil
+	}, stmt)
+	if !singleShard || keyspace == nil {
+		return nil, nil
+	}
+
+	query := sqlparser.String(stmt)
+	// the impossible query of a statement does not include its WITH clause
+	buffer := sqlparser.NewTrackedBuffer(sqlparser.FormatImpossibleQuery)
+	buffer.Myprintf("%v%v", takeWith(stmt), stmt)
+	route := engine.NewRoute(engine.SelectUnsharded, keyspace, query, buffer.ParsedQuery().Query)
+	route.TableName = strings.Join(tableNames, ", ")
+	return route, nil
+}
+
+// buildRecursiveCTE plans the anchor and the recursive member of the CTE. The references to
+// the CTE in the recursive member are replaced by bind variables, which are filled in by
+// the engine.RecursiveCTE with the rows produced by the previous iteration.
+func buildRecursiveCTE(cte *sqlparser.CommonTableExpr, body sqlparser.SelectStatement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (*engine.RecursiveCTE, []sqlparser.ColIdent, error) {
+	union, ok := body.(*sqlparser.Union)
+	if !ok {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Recursive Common Table Expression '%s' should contain a UNION", cte.TableID.String())
+	}
+	member, ok := union.Right.(*sqlparser.Select)
+	if !ok || referencesTable(union.Left, cte.TableID) {
+		return nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression with more than one recursive member")
+	}
+	if len(union.OrderBy) > 0 || union.Limit != nil {
+		return nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: order by or limit in recursive common table expression")
+	}
+
+	columns, err := recursiveCTEColumns(cte, union.Left)
+	if err != nil {
+		return nil, nil, err
+	}
+	vars, err := rewriteRecursiveMember(member, cte.TableID, columns, reservedVars)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	anchor, err := newBuildSelectPlan(union.Left, reservedVars, vschema)
+	if err != nil {
+		return nil, nil, err
+	}
+	recursive, err := newBuildSelectPlan(member, reservedVars, vschema)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &engine.RecursiveCTE{
+		Anchor:            anchor.Primitive(),
+		Recursive:         recursive.Primitive(),
+		Vars:              vars,
+		Distinct:          union.Distinct,
+		MaxRecursionDepth: engine.DefaultMaxRecursionDepth,
+	}, columns, nil
+}
+
+// recursiveCTEColumns returns the column names of the CTE, which are either listed
+// explicitly or taken from the select expressions of the anchor.
+func recursiveCTEColumns(cte *sqlparser.CommonTableExpr, anchor sqlparser.SelectStatement) ([]sqlparser.ColIdent, error) {
+	if len(cte.Columns) > 0 {
+		return cte.Columns, nil
+	}
+	var columns []sqlparser.ColIdent
+	for _, expr := range sqlparser.GetFirstSelect(anchor).SelectExprs {
+		aliasedExpr, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: '%s' in recursive common table expression", sqlparser.String(expr))
+		}
+		columns = append(columns, columnNameOf(aliasedExpr))
+	}
+	return columns, nil
+}
+
+// columnNameOf returns the name of the column produced by the select expression.
+func columnNameOf(expr *sqlparser.AliasedExpr) sqlparser.ColIdent {
+	if !expr.As.IsEmpty() {
+		return expr.As
+	}
+	if col, ok := expr.Expr.(*sqlparser.ColName); ok {
+		return col.Name
+	}
+	return sqlparser.NewColIdent(sqlparser.String(expr.Expr))
+}
+
+// rewriteRecursiveMember removes the self reference from the FROM clause of the recursive member,
+// and replaces the columns of the CTE with arguments. It returns the column offsets of these arguments.
+func rewriteRecursiveMember(sel *sqlparser.Select, name sqlparser.TableIdent, columns []sqlparser.ColIdent, reservedVars *sqlparser.ReservedVars) (map[string]int, error) {
+	alias, err := removeSelfReference(sel, name)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := map[string]int{}
+	args := map[int]string{}
+	var rewriteErr error
+	sqlparser.Rewrite(sel, func(cursor *sqlparser.Cursor) bool {
+		col, ok := cursor.Node().(*sqlparser.ColName)
+		if !ok {
+			return rewriteErr == nil
+		}
+		if !col.Qualifier.IsEmpty() && (!col.Qualifier.Qualifier.IsEmpty() || col.Qualifier.Name.String() != alias.String()) {
+			return false
+		}
+		for offset, column := range columns {
+			if !col.Name.Equal(column) {
+				continue
+			}
+			arg, ok := args[offset]
+			if !ok {
+				arg = reservedVars.ReserveColName(&sqlparser.ColName{Name: column, Qualifier: sqlparser.TableName{Name: alias}})
+				args[offset] = arg
+				vars[arg] = offset
+			}
+			cursor.Replace(sqlparser.NewArgument(arg))
+			return false
+		}
+		if !col.Qualifier.IsEmpty() {
+			rewriteErr = vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in 'field list'", sqlparser.String(col))
+		}
+		return false
+	}, nil)
+	if rewriteErr != nil {
+		return nil, rewriteErr
+	}
+	return vars, nil
+}
+
+// removeSelfReference removes the CTE from the FROM clause of the recursive member and returns the name
+// it is known by. The CTE can either be one of the tables of the FROM clause or a side of an inner join,
+// in which case the join condition is moved to the WHERE clause.
+func removeSelfReference(sel *sqlparser.Select, name sqlparser.TableIdent) (sqlparser.TableIdent, error) {
+	isSelfReference := func(expr sqlparser.TableExpr) (sqlparser.TableIdent, bool) {
+		aliasedTable, ok := expr.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return sqlparser.TableIdent{}, false
+		}
+		tableName, ok := aliasedTable.Expr.(sqlparser.TableName)
+		if !ok || !tableName.Qualifier.IsEmpty() || tableName.Name.String() != name.String() {
+			return sqlparser.TableIdent{}, false
+		}
+		if aliasedTable.As.IsEmpty() {
+			return tableName.Name, true
+		}
+		return aliasedTable.As, true
+	}
+
+	for i, expr := range sel.From {
+		if alias, ok := isSelfReference(expr); ok {
+			sel.From = append(sel.From[:i:i], sel.From[i+1:]...)
+			if len(sel.From) == 0 {
+				sel.From = sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: sqlparser.TableName{Name: sqlparser.NewTableIdent("dual")}}}
+			}
+			return alias, nil
+		}
+		join, ok := expr.(*sqlparser.JoinTableExpr)
+		if !ok || join.Join != sqlparser.NormalJoinType || len(join.Condition.Using) > 0 {
+			continue
+		}
+		alias, isLeft := isSelfReference(join.LeftExpr)
+		other := join.RightExpr
+		if !isLeft {
+			if alias, ok = isSelfReference(join.RightExpr); !ok {
+				continue
+			}
+			other = join.LeftExpr
+		}
+		sel.From[i] = other
+		if join.Condition.On != nil {
+			sel.AddWhere(join.Condition.On)
+		}
+		return alias, nil
+	}
+	return sqlparser.TableIdent{}, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: reference to recursive common table expression '%s' in this position", name.String())
+}
+
+// planRecursiveCTEQuery builds the primitives of the query that reads from the recursive CTE.
+// The rows are filtered, projected, sorted and limited at the vtgate level.
+func planRecursiveCTEQuery(sel *sqlparser.Select, name sqlparser.TableIdent, columns []sqlparser.ColIdent, input engine.Primitive) (engine.Primitive, error) {
+	alias, isSelfReference := sqlparser.TableIdent{}, false
+	if len(sel.From) == 1 {
+		if aliasedTable, ok := sel.From[0].(*sqlparser.AliasedTableExpr); ok {
+			tableName, ok := aliasedTable.Expr.(sqlparser.TableName)
+			isSelfReference = ok && tableName.Qualifier.IsEmpty() && tableName.Name.String() == name.String()
+			alias = aliasedTable.As
+			if alias.IsEmpty() {
+				alias = name
+			}
+		}
+	}
+	if !isSelfReference {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression joined with other tables")
+	}
+	if sel.Distinct || len(sel.GroupBy) > 0 || sel.Having != nil || sqlparser.ContainsAggregation(sel.SelectExprs) {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: aggregation on recursive common table expression")
+	}
+
+	lookup := &cteColumnLookup{alias: alias, columns: columns}
+	plan := input
+	if sel.Where != nil {
+		predicate, err := evalengine.Convert(sel.Where.Expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		plan = &engine.Filter{
+			Predicate:    predicate,
+			ASTPredicate: sel.Where.Expr,
+			Input:        plan,
+		}
+	}
+
+	// the columns of the CTE are followed by the expressions evaluated by the projection
+	projection := &engine.Projection{}
+	var outputCols []int
+	aliases := map[string]int{}
+	for _, expr := range sel.SelectExprs {
+		switch expr := expr.(type) {
+		case *sqlparser.StarExpr:
+			if !expr.TableName.IsEmpty() && (!expr.TableName.Qualifier.IsEmpty() || expr.TableName.Name.String() != alias.String()) {
+				return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown table '%s'", sqlparser.String(expr.TableName))
+			}
+			for i := range columns {
+				outputCols = append(outputCols, i)
+			}
+		case *sqlparser.AliasedExpr:
+			if col, ok := expr.Expr.(*sqlparser.ColName); ok && expr.As.IsEmpty() {
+				offset, err := lookup.ColumnLookup(col)
+				if err != nil {
+					return nil, err
+				}
+				outputCols = append(outputCols, offset)
+				continue
+			}
+			evalExpr, err := evalengine.Convert(expr.Expr, lookup)
+			if err != nil {
+				return nil, err
+			}
+			offset := len(columns) + len(projection.Exprs)
+			projection.Exprs = append(projection.Exprs, evalExpr)
+			projection.Cols = append(projection.Cols, columnNameOf(expr).String())
+			outputCols = append(outputCols, offset)
+			if !expr.As.IsEmpty() {
+				aliases[expr.As.Lowered()] = offset
+			}
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: '%s' on recursive common table expression", sqlparser.String(expr))
+		}
+	}
+	if len(projection.Exprs) > 0 {
+		projection.Input = plan
+		plan = projection
+	}
+
+	if len(sel.OrderBy) > 0 {
+		memorySort := &engine.MemorySort{Input: plan}
+		for _, order := range sel.OrderBy {
+			offset, err := recursiveCTEOrderOffset(order.Expr, outputCols, aliases, lookup)
+			if err != nil {
+				return nil, err
+			}
+			memorySort.OrderBy = append(memorySort.OrderBy, engine.OrderByParams{
+				Col:             offset,
+				WeightStringCol: -1,
+				Desc:            order.Direction == sqlparser.DescOrder,
+			})
+		}
+		plan = memorySort
+	}
+
+	if sel.Limit != nil {
+		limit := &engine.Limit{Input: plan}
+		pv, err := sqlparser.NewPlanValue(sel.Limit.Rowcount)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "unexpected expression in LIMIT")
+		}
+		limit.Count = pv
+		if sel.Limit.Offset != nil {
+			pv, err = sqlparser.NewPlanValue(sel.Limit.Offset)
+			if err != nil {
+				return nil, vterrors.Wrap(err, "unexpected expression in OFFSET")
+			}
+			limit.Offset = pv
+		}
+		plan = limit
+	}
+
+	identity := len(outputCols) == len(columns)+len(projection.Exprs)
+	for i, offset := range outputCols {
+		identity = identity && i == offset
+	}
+	if !identity {
+		plan = &engine.SimpleProjection{Cols: outputCols, Input: plan}
+	}
+	return plan, nil
+}
+
+// recursiveCTEOrderOffset returns the column offset used to sort on the given ORDER BY expression.
+func recursiveCTEOrderOffset(expr sqlparser.Expr, outputCols []int, aliases map[string]int, lookup *cteColumnLookup) (int, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.Literal:
+		if expr.Type == sqlparser.IntVal {
+			num, err := strconv.Atoi(expr.Val)
+			if err != nil || num < 1 || num > len(outputCols) {
+				return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in 'order clause'", expr.Val)
+			}
+			return outputCols[num-1], nil
+		}
+	case *sqlparser.ColName:
+		if offset, ok := aliases[expr.Name.Lowered()]; ok && expr.Qualifier.IsEmpty() {
+			return offset, nil
+		}
+		return lookup.ColumnLookup(expr)
+	}
+	return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: order by '%s' on recursive common table expression", sqlparser.String(expr))
+}
+
+// cteColumnLookup resolves the columns of a recursive CTE to their offsets in its rows.
+type cteColumnLookup struct {
+	alias   sqlparser.TableIdent
+	columns []sqlparser.ColIdent
+}
+
+var _ evalengine.ConverterLookup = (*cteColumnLookup)(nil)
+
+// ColumnLookup implements the evalengine.ConverterLookup interface
+func (c *cteColumnLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	if col.Qualifier.IsEmpty() || (col.Qualifier.Qualifier.IsEmpty() && col.Qualifier.Name.String() == c.alias.String()) {
+		for offset, column := range c.columns {
+			if col.Name.Equal(column) {
+				return offset, nil
+			}
+		}
+	}
+	return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in 'field list'", sqlparser.String(col))
+}
+
+// CollationIDLookup implements the evalengine.ConverterLookup interface
+func (c *cteColumnLookup) CollationIDLookup(sqlparser.Expr) collations.ID {
+	return collations.Unknown
+}
@@ -26,17 +26,25 @@ import (
 // buildDeletePlan builds the instructions for a DELETE statement.
 func buildDeletePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
 	del := stmt.(*sqlparser.Delete)
-	if del.With != nil {
-		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in delete statement")
+	if err := inlineCTEs(del); err != nil {
+		return nil, err
+	}
+	rewrite, err := needsMultiTableRewrite(vschema, reservedVars, del.TableExprs)
+	if err != nil {
+		return nil, err
+	}
+	if rewrite {
+		if err := rewriteMultiTableDelete(del); err != nil {
+			return nil, err
+		}
 	}
-	var err error
 	if len(del.TableExprs) == 1 && len(del.Targets) == 1 {
 		del, err = rewriteSingleTbl(del)
 		if err != nil {
 			return nil, err
 		}
 	}
-	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "delete", del, reservedVars, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
+	dml, ksidVindex, ksidCol, inputs, err := buildDMLPlan(vschema, "delete", del, reservedVars, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +74,7 @@ func buildDeletePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 		edel.KsidVindex = ksidVindex
 	}
 
-	return edel, nil
+	return inputs.wrap(edel, dml, ksidVindex), nil
 }
 
 func rewriteSingleTbl(del *sqlparser.Delete) (*sqlparser.Delete, error) {
@@ -95,7 +103,8 @@ func rewriteSingleTbl(del *sqlparser.Delete) (*sqlparser.Delete, error) {
 		_ = sqlparser.Rewrite(del.Where, func(cursor *sqlparser.Cursor) bool {
 			switch node := cursor.Node().(type) {
 			case *sqlparser.ColName:
-				if !node.Qualifier.IsEmpty() {
+				// columns of the tables of subqueries keep their qualifier
+				if isQualifiedBy(node, atExpr.As, tbl) {
 					node.Qualifier = tbl
 				}
 			}
@@ -104,3 +113,18 @@ func rewriteSingleTbl(del *sqlparser.Delete) (*sqlparser.Delete, error) {
 	}
 	return del, nil
 }
+
+// isQualifiedBy returns true if the column is qualified by the given alias,
+// or by the table name if there is no alias.
+func isQualifiedBy(col *sqlparser.ColName, as sqlparser.TableIdent, tbl sqlparser.TableName) bool {
+	if col.Qualifier.IsEmpty() {
+		return false
+	}
+	if !as.IsEmpty() {
+		return col.Qualifier.Qualifier.IsEmpty() && sqlparser.EqualsTableIdent(col.Qualifier.Name, as)
+	}
+	if !col.Qualifier.Qualifier.IsEmpty() && !tbl.Qualifier.IsEmpty() && !sqlparser.EqualsTableIdent(col.Qualifier.Qualifier, tbl.Qualifier) {
+		return false
+	}
+	return sqlparser.EqualsTableIdent(col.Qualifier.Name, tbl.Name)
+}
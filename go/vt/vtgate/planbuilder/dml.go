@@ -84,7 +84,7 @@ func getMatch(node sqlparser.Expr, col sqlparser.ColIdent) (pv sqltypes.PlanValu
 				continue
 			}
 		case sqlparser.InOp:
-			if !sqlparser.IsSimpleTuple(comparison.Right) {
+			if _, isListArg := comparison.Right.(sqlparser.ListArg); !isListArg && !sqlparser.IsSimpleTuple(comparison.Right) {
 				continue
 			}
 		default:
@@ -104,12 +104,45 @@ func nameMatch(node sqlparser.Expr, col sqlparser.ColIdent) bool {
 	return ok && colname.Name.Equal(col)
 }
 
-func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.SingleColumn, string, error) {
+// dmlInputs contains the primitives that have to be executed before a sharded DML.
+type dmlInputs struct {
+	// pullouts are the subqueries of the DML. They are evaluated first,
+	// and their results are sent to the shards as bind variables.
+	pullouts []*pulloutSubquery
+
+	// limitInput selects the rows changed by a multi-shard DML with a limit.
+	limitInput engine.Primitive
+}
+
+// wrap returns the primitive that executes the inputs and then the dml.
+func (in *dmlInputs) wrap(dml engine.Primitive, edml *engine.DML, ksidVindex vindexes.SingleColumn) engine.Primitive {
+	if in == nil {
+		return dml
+	}
+	if in.limitInput != nil {
+		dml = &engine.LimitedDML{
+			Input:    in.limitInput,
+			DML:      dml,
+			Keyspace: edml.Keyspace,
+			Vindex:   ksidVindex,
+		}
+	}
+	// the first subquery is the outermost one, so it gets evaluated first
+	for i := len(in.pullouts) - 1; i >= 0; i-- {
+		pullout := in.pullouts[i]
+		pullout.eSubquery.Subquery = pullout.subquery.Primitive()
+		pullout.eSubquery.Underlying = dml
+		dml = pullout.eSubquery
+	}
+	return dml
+}
+
+func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.SingleColumn, string, *dmlInputs, error) {
 	edml := &engine.DML{}
 	pb := newPrimitiveBuilder(vschema, newJointab(reservedVars))
 	rb, err := pb.processDMLTable(tableExprs, reservedVars, nil)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, "", nil, err
 	}
 	edml.Keyspace = rb.eroute.Keyspace
 	if !edml.Keyspace.Sharded {
@@ -120,17 +153,24 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 		if pb.finalizeUnshardedDMLSubqueries(reservedVars, subqueryArgs...) {
 			vschema.WarnUnshardedOnly("subqueries can't be sharded in DML")
 		} else {
-			return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
+			return nil, nil, "", nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
 		}
 		edml.Opcode = engine.Unsharded
 		// Generate query after all the analysis. Otherwise table name substitutions for
 		// routed tables won't happen.
 		edml.Query = generateQuery(stmt)
-		return edml, nil, "", nil
+		return edml, nil, "", nil, nil
 	}
 
+	inputs := &dmlInputs{}
 	if hasSubquery(stmt) {
-		return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+		inputs.pullouts, err = pb.pulloutDMLSubqueries(reservedVars, where, nodes...)
+		if err != nil {
+			return nil, nil, "", nil, err
+		}
+		if hasSubquery(tableExprs) || hasSubquery(orderBy) || hasSubquery(limit) {
+			return nil, nil, "", nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+		}
 	}
 
 	// Generate query after all the analysis. Otherwise table name substitutions for
@@ -145,7 +185,7 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 	edml.QueryTimeout = queryTimeout(directives)
 
 	if len(pb.st.tables) != 1 {
-		return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi-table %s statement is not supported in sharded database", dmlType)
+		return nil, nil, "", nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi-table %s statement is not supported in sharded database", dmlType)
 	}
 	for _, tval := range pb.st.tables {
 		// There is only one table.
@@ -154,29 +194,144 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 
 	routingType, ksidVindex, ksidCol, vindex, values, err := getDMLRouting(where, edml.Table)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, "", nil, err
 	}
 
 	if rb.eroute.TargetDestination != nil {
 		if rb.eroute.TargetTabletType != topodatapb.TabletType_PRIMARY {
-			return nil, nil, "", vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.InnodbReadOnly, "unsupported: %s statement with a replica target", dmlType)
+			return nil, nil, "", nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.InnodbReadOnly, "unsupported: %s statement with a replica target", dmlType)
 		}
 		edml.Opcode = engine.ByDestination
 		edml.TargetDestination = rb.eroute.TargetDestination
-		return edml, ksidVindex, ksidCol, nil
+		return edml, ksidVindex, ksidCol, inputs, nil
 	}
 
 	edml.Opcode = routingType
-	if routingType == engine.Scatter {
-		if limit != nil {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi shard %s with limit is not supported", dmlType)
-		}
-	} else {
+	if routingType != engine.Scatter {
 		edml.Vindex = vindex
 		edml.Values = values
 	}
+	if limit != nil && (routingType == engine.Scatter || routingType == engine.In) {
+		// the rows are ordered by the primary vindex column too, so that the rows
+		// selected by the input are spread over the shards the same way every time,
+		// and each shard changes the rows that were selected from it
+		orderBy = orderByVindexColumn(orderBy, ksidCol)
+		switch stmt := stmt.(type) {
+		case *sqlparser.Update:
+			stmt.OrderBy = orderBy
+		case *sqlparser.Delete:
+			stmt.OrderBy = orderBy
+		}
+		inputs.limitInput, err = buildDMLLimitInput(vschema, reservedVars, tableExprs, where, orderBy, limit, ksidCol)
+		if err != nil {
+			return nil, nil, "", nil, err
+		}
+		// every shard changes as many rows as were selected from it
+		limit.Rowcount = sqlparser.NewArgument(engine.DMLLimitVar)
+		edml.Query = generateQuery(stmt)
+	}
+
+	return edml, ksidVindex, ksidCol, inputs, nil
+}
+
+// pulloutDMLSubqueries pulls the subqueries out of the WHERE clause and the SET expressions of a sharded DML,
+// so that they can be evaluated before the DML is sent to the shards. Subqueries that can be evaluated on the
+// shards of the DML are merged into its query instead.
+func (pb *primitiveBuilder) pulloutDMLSubqueries(reservedVars *sqlparser.ReservedVars, where *sqlparser.Where, nodes ...sqlparser.SQLNode) ([]*pulloutSubquery, error) {
+	var pullouts []*pulloutSubquery
+	pullout := func(expr sqlparser.Expr) (sqlparser.Expr, error) {
+		if !hasSubquery(expr) {
+			return expr, nil
+		}
+		subqueries, _, newExpr, err := pb.findOrigin(expr, reservedVars)
+		if err != nil {
+			return nil, err
+		}
+		for _, subquery := range subqueries {
+			if err := subquery.subquery.Wireup(subquery.subquery, pb.jt); err != nil {
+				return nil, err
+			}
+		}
+		pullouts = append(pullouts, subqueries...)
+		return newExpr, nil
+	}
+
+	for _, node := range nodes {
+		exprs, ok := node.(sqlparser.UpdateExprs)
+		if !ok {
+			continue
+		}
+		for _, updExpr := range exprs {
+			newExpr, err := pullout(updExpr.Expr)
+			if err != nil {
+				return nil, err
+			}
+			updExpr.Expr = newExpr
+		}
+	}
+	if where != nil {
+		newExpr, err := pullout(where.Expr)
+		if err != nil {
+			return nil, err
+		}
+		where.Expr = newExpr
+	}
+	return pullouts, nil
+}
+
+// buildDMLLimitInput builds the primitive selecting the primary vindex column
+// of the rows changed by a multi-shard DML with a limit.
+func buildDMLLimitInput(vschema ContextVSchema, reservedVars *sqlparser.ReservedVars, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, ksidCol string) (engine.Primitive, error) {
+	if limit.Offset != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "offset is not allowed in the limit of a DML")
+	}
+	sel := &sqlparser.Select{
+		SelectExprs: sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: sqlparser.NewColName(ksidCol)}},
+		From:        sqlparser.CloneTableExprs(tableExprs),
+		Where:       sqlparser.CloneRefOfWhere(where),
+		OrderBy:     sqlparser.CloneOrderBy(orderBy),
+		Limit:       sqlparser.CloneRefOfLimit(limit),
+		Lock:        sqlparser.ForUpdateLock,
+	}
+	// the ordering columns have to be returned for the rows to be merged in order
+	for _, order := range sel.OrderBy {
+		if isColumn(order.Expr, ksidCol) {
+			// already returned as the first column
+			continue
+		}
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: order.Expr})
+	}
+	clearColumnMetadata(sel)
+	return buildSelectPlan(sqlparser.String(sel))(sel, reservedVars, vschema)
+}
 
-	return edml, ksidVindex, ksidCol, nil
+// orderByVindexColumn returns the ordering of a DML, ending with the primary
+// vindex column unless the DML is already ordered by it.
+func orderByVindexColumn(orderBy sqlparser.OrderBy, ksidCol string) sqlparser.OrderBy {
+	for _, order := range orderBy {
+		if isColumn(order.Expr, ksidCol) {
+			return orderBy
+		}
+	}
+	return append(orderBy, &sqlparser.Order{Expr: sqlparser.NewColName(ksidCol), Direction: sqlparser.AscOrder})
+}
+
+// isColumn returns true if the expression is the named column.
+func isColumn(expr sqlparser.Expr, name string) bool {
+	col, ok := expr.(*sqlparser.ColName)
+	return ok && col.Name.EqualString(name)
+}
+
+// clearColumnMetadata removes the symbols that were resolved for the columns of the node.
+// Cloning an AST does not clone its columns, so this has to be done before the columns
+// are planned again as part of another statement.
+func clearColumnMetadata(node sqlparser.SQLNode) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			col.Metadata = nil
+		}
+		return true, nil
+	}, node)
 }
 
 func generateDMLSubquery(tblExpr sqlparser.TableExpr, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCol string) string {
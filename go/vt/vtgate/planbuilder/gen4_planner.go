@@ -33,15 +33,9 @@ func gen4Planner(query string) func(sqlparser.Statement, *sqlparser.ReservedVars
 		if !ok {
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%T not yet supported", stmt)
 		}
-		switch node := selStatement.(type) {
-		case *sqlparser.Select:
-			if node.With != nil {
-				return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in select statement")
-			}
-		case *sqlparser.Union:
-			if node.With != nil {
-				return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in union statement")
-			}
+		p, err := planWithClause(selStatement, reservedVars, vschema)
+		if err != nil || p != nil {
+			return p, err
 		}
 
 		sel, isSel := selStatement.(*sqlparser.Select)
@@ -129,6 +123,10 @@ func newBuildSelectPlan(selStmt sqlparser.SelectStatement, reservedVars *sqlpars
 	if ks, _ := vschema.DefaultKeyspace(); ks != nil {
 		ksName = ks.Name
 	}
+	err := expandNamedWindows(selStmt)
+	if err != nil {
+		return nil, err
+	}
 	semTable, err := semantics.Analyze(selStmt, ksName, vschema)
 	if err != nil {
 		return nil, err
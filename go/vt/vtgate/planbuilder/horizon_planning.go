@@ -17,6 +17,8 @@ limitations under the License.
 package planbuilder
 
 import (
+	"strings"
+
 	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
@@ -59,6 +61,14 @@ func (hp *horizonPlanning) planHorizon(ctx *planningContext, plan logicalPlan) (
 	hp.qp = qp
 
 	needAggrOrHaving := hp.qp.NeedsAggregation() || hp.sel.Having != nil
+	if hp.qp.HasWindowFunc {
+		if needAggrOrHaving {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions and aggregation in cross-shard query")
+		}
+		if !isRoute || !windowFuncsCanBePushedDown(ctx, hp.qp) {
+			return hp.planWindowFuncs(ctx, plan)
+		}
+	}
 	canShortcut := isRoute && !needAggrOrHaving && len(hp.qp.OrderExprs) == 0
 
 	if needAggrOrHaving {
@@ -140,12 +150,14 @@ func (hp *horizonPlanning) truncateColumnsIfNeeded(plan logicalPlan) error {
 	switch p := plan.(type) {
 	case *routeGen4:
 		p.eroute.SetTruncateColumnCount(hp.sel.GetColumnCount())
-	case *joinGen4, *semiJoin, *hashJoin:
+	case *joinGen4, *semiJoin, *semiApply, *hashJoin:
 		// since this is a join, we can safely add extra columns and not need to truncate them
 	case *orderedAggregate:
 		p.eaggr.SetTruncateColumnCount(hp.sel.GetColumnCount())
 	case *memorySort:
 		p.truncater.SetTruncateColumnCount(hp.sel.GetColumnCount())
+	case *window:
+		p.eWindow.SetTruncateColumnCount(hp.sel.GetColumnCount())
 	case *pulloutSubquery:
 		return hp.truncateColumnsIfNeeded(p.underlying)
 	case *filter:
@@ -339,6 +351,21 @@ func pushProjection(expr *sqlparser.AliasedExpr, plan logicalPlan, semTable *sem
 			return 0, false, err
 		}
 		return i /* col added */, len(node.eVindexFunc.Cols) > colsBefore, nil
+	case *window:
+		colName, isColName := expr.Expr.(*sqlparser.ColName)
+		for _, fn := range node.eWindow.Functions {
+			if sqlparser.EqualsExpr(fn.Expr, expr.Expr) {
+				return fn.Col, false, nil
+			}
+			if isColName && colName.Name.EqualString(fn.Alias) {
+				return fn.Col, false, nil
+			}
+		}
+		if sqlparser.ContainsWindowFunc(expr.Expr) {
+			return 0, false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex window function expression: %s", sqlparser.String(expr.Expr))
+		}
+		// the window primitive returns the columns of its input unchanged
+		return pushProjection(expr, node.input, semTable, inner, reuseCol, hasAggregation)
 	case *limit:
 		return pushProjection(expr, node.input, semTable, inner, reuseCol, hasAggregation)
 	case *distinct:
@@ -362,6 +389,31 @@ func pushProjection(expr *sqlparser.AliasedExpr, plan logicalPlan, semTable *sem
 		}
 		node.cols = append(node.cols, column)
 		return len(node.cols) - 1, true, nil
+	case *semiApply:
+		if expr.Expr == sqlparser.Expr(node.extracted) {
+			return node.pushValue(expr, reuseCol)
+		}
+		if containsSubquery(expr.Expr, node.extracted) {
+			return 0, false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery in a complex expression: %s", sqlparser.String(expr.Expr))
+		}
+		passDownReuseCol := reuseCol
+		if !reuseCol {
+			passDownReuseCol = expr.As.IsEmpty()
+		}
+		offset, added, err := pushProjection(expr, node.outer, semTable, inner, passDownReuseCol, hasAggregation)
+		if err != nil {
+			return 0, false, err
+		}
+		column := -(offset + 1)
+		if reuseCol && !added {
+			for idx, col := range node.esemiApply.Cols {
+				if column == col {
+					return idx, false, nil
+				}
+			}
+		}
+		node.esemiApply.Cols = append(node.esemiApply.Cols, column)
+		return len(node.esemiApply.Cols) - 1, true, nil
 	case *concatenateGen4:
 		if hasAggregation {
 			return 0, false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: aggregation on unions")
@@ -445,7 +497,8 @@ func (hp *horizonPlanning) planAggregations(ctx *planningContext, plan logicalPl
 	var oa *orderedAggregate
 	uniqVindex := hasUniqueVindex(ctx.vschema, ctx.semTable, hp.qp.GroupByExprs)
 	joinPlan := isJoin(plan)
-	if !uniqVindex || joinPlan {
+	semiJoinPlan := isSemiJoin(plan)
+	if !uniqVindex || joinPlan || semiJoinPlan {
 		if hp.qp.ProjectionError != nil {
 			return nil, hp.qp.ProjectionError
 		}
@@ -481,6 +534,14 @@ func (hp *horizonPlanning) planAggregations(ctx *planningContext, plan logicalPl
 			continue
 		}
 
+		if gcExpr, isGroupConcat := aliasExpr.Expr.(*sqlparser.GroupConcatExpr); isGroupConcat {
+			err := hp.planGroupConcat(ctx, e, gcExpr, plan, oa)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		fExpr, isFunc := aliasExpr.Expr.(*sqlparser.FuncExpr)
 		if !isFunc {
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex aggregate expression")
@@ -496,6 +557,20 @@ func (hp *horizonPlanning) planAggregations(ctx *planningContext, plan logicalPl
 		}
 
 		pushExpr, alias, opcode := hp.createPushExprAndAlias(e, handleDistinct, innerAliased, opcode, oa)
+		if semiJoinPlan && !handleDistinct {
+			// the rows are filtered by the correlated subquery at the vtgate level, so they
+			// cannot be aggregated by MySQL. Instead, the values are fetched for every row.
+			pushExpr, err = aggregateArgument(fExpr, opcode, alias)
+			if err != nil {
+				return nil, err
+			}
+		} else if opcode.NeedsRewrite() {
+			// the function is computed from other aggregates. The first one takes the place of the
+			// function in the projection, and the other ones are pushed once all the select expressions
+			// have been planned.
+			pushExpr = &sqlparser.AliasedExpr{Expr: rewriteAggregate(fExpr, partialAggregateName(opcode)), As: pushExpr.As}
+			oa.eaggr.PreProcess = true
+		}
 		offset, _, err := pushProjection(pushExpr, plan, ctx.semTable, true, false, true)
 		if err != nil {
 			return nil, err
@@ -508,6 +583,13 @@ func (hp *horizonPlanning) planAggregations(ctx *planningContext, plan logicalPl
 		})
 	}
 
+	if oa != nil {
+		err := hp.pushPartialAggregates(ctx, oa, plan)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	for _, groupExpr := range hp.qp.GroupByExprs {
 		added, err := planGroupByGen4(groupExpr, newPlan, ctx.semTable, false)
 		if err != nil {
@@ -559,6 +641,102 @@ func (hp *horizonPlanning) planAggregations(ctx *planningContext, plan logicalPl
 	return plan, nil
 }
 
+// planGroupConcat pushes a GROUP_CONCAT down to the leaves. The values concatenated by
+// every shard are then joined by the orderedAggregate, using the same separator.
+func (hp *horizonPlanning) planGroupConcat(ctx *planningContext, expr abstract.SelectExpr, gcExpr *sqlparser.GroupConcatExpr, plan logicalPlan, oa *orderedAggregate) error {
+	if gcExpr.Distinct {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: group_concat with distinct")
+	}
+	if gcExpr.Limit != nil {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: group_concat with limit")
+	}
+	if isJoin(plan) || isSemiJoin(plan) {
+		// every row of one side is repeated for each matching row of the other side,
+		// so the values cannot be concatenated before the join
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard query with aggregates")
+	}
+	pushExpr, alias, _ := hp.createPushExprAndAlias(expr, false, nil, engine.AggregateGroupConcat, oa)
+	offset, _, err := pushProjection(pushExpr, plan, ctx.semTable, true, false, true)
+	if err != nil {
+		return err
+	}
+	oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, &engine.AggregateParams{
+		Opcode:    engine.AggregateGroupConcat,
+		Col:       offset,
+		Separator: gcExpr.SeparatorString(),
+		Alias:     alias,
+		Expr:      gcExpr,
+	})
+	return nil
+}
+
+// pushPartialAggregates pushes the extra aggregates needed by the functions that the
+// orderedAggregate computes from other aggregates, like AVG which is computed from SUM and COUNT.
+// They are added after all the select expressions, so they have to be truncated from the result.
+func (hp *horizonPlanning) pushPartialAggregates(ctx *planningContext, oa *orderedAggregate, plan logicalPlan) error {
+	for _, aggr := range oa.eaggr.Aggregates {
+		if !aggr.Opcode.NeedsRewrite() {
+			continue
+		}
+		fExpr := aggr.Expr.(*sqlparser.FuncExpr)
+		offset, _, err := pushProjection(&sqlparser.AliasedExpr{Expr: rewriteAggregate(fExpr, "count")}, plan, ctx.semTable, true, false, true)
+		if err != nil {
+			return err
+		}
+		aggr.CountCol = offset
+		if aggr.Opcode != engine.AggregateAvg {
+			offset, _, err = pushProjection(&sqlparser.AliasedExpr{Expr: rewriteAggregate(fExpr, "avg")}, plan, ctx.semTable, true, false, true)
+			if err != nil {
+				return err
+			}
+			aggr.AvgCol = offset
+		}
+		hp.haveToTruncate(true)
+	}
+	return nil
+}
+
+// aggregateArgument returns the expression that is fetched for every row in place of an
+// aggregate function, when the aggregation can only be done by the orderedAggregate.
+func aggregateArgument(fExpr *sqlparser.FuncExpr, opcode engine.AggregateOpcode, alias string) (*sqlparser.AliasedExpr, error) {
+	var arg sqlparser.Expr
+	switch opcode {
+	case engine.AggregateCount:
+		// the orderedAggregate sums the counts of its input rows
+		arg = sqlparser.NewIntLiteral("1")
+		if aliasedExpr, isAliased := fExpr.Exprs[0].(*sqlparser.AliasedExpr); isAliased {
+			arg = &sqlparser.IsExpr{Left: aliasedExpr.Expr, Right: sqlparser.IsNotNullOp}
+		}
+	case engine.AggregateSum, engine.AggregateMin, engine.AggregateMax, engine.AggregateBitAnd, engine.AggregateBitOr, engine.AggregateBitXor:
+		aliasedExpr, isAliased := fExpr.Exprs[0].(*sqlparser.AliasedExpr)
+		if !isAliased {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error: %s", sqlparser.String(fExpr))
+		}
+		arg = aliasedExpr.Expr
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: aggregation function '%s' in a query having a correlated subquery", fExpr.Name.Lowered())
+	}
+	return &sqlparser.AliasedExpr{Expr: arg, As: sqlparser.NewColIdent(alias)}, nil
+}
+
+// partialAggregateName returns the aggregate function that is pushed down
+// in place of a function computed by the orderedAggregate.
+func partialAggregateName(opcode engine.AggregateOpcode) string {
+	if opcode == engine.AggregateAvg {
+		return "sum"
+	}
+	return "var_pop"
+}
+
+// rewriteAggregate returns a call to the aggregate function name with the arguments of fExpr.
+func rewriteAggregate(fExpr *sqlparser.FuncExpr, name string) *sqlparser.FuncExpr {
+	return &sqlparser.FuncExpr{
+		Name:     sqlparser.NewColIdent(name),
+		Distinct: fExpr.Distinct,
+		Exprs:    sqlparser.CloneSelectExprs(fExpr.Exprs),
+	}
+}
+
 // createPushExprAndAlias creates the expression that should be pushed down to the leaves,
 // and changes the opcode so it is a distinct one if needed
 func (hp *horizonPlanning) createPushExprAndAlias(
@@ -621,7 +799,7 @@ func planGroupByGen4(groupExpr abstract.GroupBy, plan logicalPlan, semTable *sem
 			sel.GroupBy = append(sel.GroupBy, weightStringFor(groupExpr.WeightStrExpr))
 		}
 		return false, nil
-	case *joinGen4, *hashJoin:
+	case *joinGen4, *hashJoin, *semiJoin, *semiApply:
 		_, _, added, err := wrapAndPushExpr(groupExpr.Inner, groupExpr.WeightStrExpr, node, semTable)
 		return added, err
 	case *orderedAggregate:
@@ -644,8 +822,6 @@ func planGroupByGen4(groupExpr abstract.GroupBy, plan logicalPlan, semTable *sem
 		return colAdded || colAddedRecursively, nil
 	case *pulloutSubquery:
 		return planGroupByGen4(groupExpr, node.underlying, semTable, wsAdded)
-	case *semiJoin:
-		return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: group by in a query having a correlated subquery")
 	default:
 		return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: group by on: %T", plan)
 	}
@@ -725,11 +901,38 @@ func (hp *horizonPlanning) planOrderBy(ctx *planningContext, orderExprs []abstra
 		return plan, nil
 	case *memorySort:
 		return plan, nil
+	case *window:
+		for _, order := range orderExprs {
+			if sqlparser.ContainsWindowFunc(order.WeightStrExpr) {
+				// window function values are computed on vtgate, so weight_string cannot be used.
+				return hp.createMemorySortPlan(ctx, plan, orderExprs /* useWeightStr */, false)
+			}
+		}
+		// the window primitive keeps the order of its input, so the ordering can be done below it
+		newInput, err := hp.planOrderBy(ctx, orderExprs, plan.input)
+		if err != nil {
+			return nil, err
+		}
+		plan.input = newInput
+		return plan, nil
 	case *simpleProjection:
 		return hp.createMemorySortPlan(ctx, plan, orderExprs, true)
 	case *vindexFunc:
 		// This is evaluated at VTGate only, so weight_string function cannot be used.
 		return hp.createMemorySortPlan(ctx, plan, orderExprs /* useWeightStr */, false)
+	case *semiApply:
+		for _, order := range orderExprs {
+			if containsSubquery(order.WeightStrExpr, plan.extracted) {
+				// the value of the subquery is computed on vtgate, so weight_string cannot be used.
+				return hp.createMemorySortPlan(ctx, plan, subqueryValueOrdering(orderExprs, plan.extracted) /* useWeightStr */, false)
+			}
+		}
+		newOuter, err := hp.planOrderBy(ctx, orderExprs, plan.outer)
+		if err != nil {
+			return nil, err
+		}
+		plan.outer = newOuter
+		return plan, nil
 	case *limit, *semiJoin, *filter, *pulloutSubquery:
 		inputs := plan.Inputs()
 		if len(inputs) == 0 {
@@ -981,6 +1184,22 @@ func (hp *horizonPlanning) createMemorySortPlan(ctx *planningContext, plan logic
 	return ms, nil
 }
 
+// subqueryValueOrdering replaces the aliases of the subquery value in the ordering
+// with the subquery itself, so the ordering uses the value computed by the semiApply
+func subqueryValueOrdering(orderExprs []abstract.OrderBy, extracted *sqlparser.ExtractedSubquery) []abstract.OrderBy {
+	result := make([]abstract.OrderBy, 0, len(orderExprs))
+	for _, order := range orderExprs {
+		if order.WeightStrExpr == sqlparser.Expr(extracted) {
+			order.Inner = &sqlparser.Order{
+				Expr:      extracted,
+				Direction: order.Inner.Direction,
+			}
+		}
+		result = append(result, order)
+	}
+	return result
+}
+
 func orderExprsDependsOnTableSet(orderExprs []abstract.OrderBy, semTable *semantics.SemTable, ts semantics.TableSet) bool {
 	for _, expr := range orderExprs {
 		exprDependencies := semTable.RecursiveDeps(expr.Inner.Expr)
@@ -1005,7 +1224,7 @@ func (hp *horizonPlanning) planDistinct(ctx *planningContext, plan logicalPlan)
 		}
 
 		return hp.addDistinct(ctx, plan)
-	case *joinGen4, *pulloutSubquery:
+	case *joinGen4, *pulloutSubquery, *semiJoin, *semiApply:
 		return hp.addDistinct(ctx, plan)
 	case *orderedAggregate:
 		return hp.planDistinctOA(ctx.semTable, p)
@@ -1151,13 +1370,22 @@ func (hp *horizonPlanning) needDistinctHandling(ctx *planningContext, funcExpr *
 	if !funcExpr.Distinct {
 		return false, nil, nil
 	}
-	if opcode != engine.AggregateCount && opcode != engine.AggregateSum {
-		return false, nil, nil
-	}
 	innerAliased, ok := funcExpr.Exprs[0].(*sqlparser.AliasedExpr)
 	if !ok {
 		return false, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error: %s", sqlparser.String(funcExpr))
 	}
+	switch opcode {
+	case engine.AggregateCount, engine.AggregateSum:
+	case engine.AggregateMin, engine.AggregateMax, engine.AggregateBitAnd, engine.AggregateBitOr:
+		// duplicated values do not change the result of these functions
+		return false, nil, nil
+	default:
+		if exprHasUniqueVindex(ctx.vschema, ctx.semTable, innerAliased.Expr) {
+			// the values are unique across all the shards, so each shard can apply the distinct on its own
+			return false, nil, nil
+		}
+		return false, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: aggregation function '%s' with distinct", funcExpr.Name.Lowered())
+	}
 	_, ok = input.(*routeGen4)
 	if !ok {
 		// Unreachable
@@ -1202,3 +1430,210 @@ func isJoin(plan logicalPlan) bool {
 		return false
 	}
 }
+
+// isSemiJoin returns true if the rows of the plan depend on a correlated
+// subquery that is evaluated at the vtgate level.
+func isSemiJoin(plan logicalPlan) bool {
+	switch plan := plan.(type) {
+	case *semiJoin, *semiApply:
+		return true
+	case *pulloutSubquery:
+		return isSemiJoin(plan.underlying)
+	default:
+		return false
+	}
+}
+
+// windowFuncsCanBePushedDown returns true if every window function is partitioned
+// by a column with a unique vindex. In that case each partition lives on a single
+// shard, and the window functions can be evaluated by the underlying MySQL.
+func windowFuncsCanBePushedDown(ctx *planningContext, qp *abstract.QueryProjection) bool {
+	canPushDown := true
+	for _, e := range qp.SelectExprs {
+		if !e.Window {
+			continue
+		}
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+			switch node := node.(type) {
+			case *sqlparser.Subquery:
+				return false, nil
+			case *sqlparser.OverClause:
+				if node.WindowSpec == nil || !partitionHasUniqueVindex(ctx, node.WindowSpec.PartitionClause) {
+					canPushDown = false
+				}
+			}
+			return canPushDown, nil
+		}, e.Col)
+	}
+	return canPushDown
+}
+
+func partitionHasUniqueVindex(ctx *planningContext, partition sqlparser.Exprs) bool {
+	for _, expr := range partition {
+		if exprHasUniqueVindex(ctx.vschema, ctx.semTable, expr) {
+			return true
+		}
+	}
+	return false
+}
+
+// planWindowFuncs plans the projections and ordering of a query where the window
+// functions have to be evaluated at the vtgate level.
+// A placeholder column is projected for each window function, and the columns
+// needed to compute the functions are added after the select expressions.
+func (hp *horizonPlanning) planWindowFuncs(ctx *planningContext, plan logicalPlan) (logicalPlan, error) {
+	if hp.qp.NeedsDistinct() {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions and distinct in cross-shard query")
+	}
+
+	type windowExpr struct {
+		expr   *sqlparser.AliasedExpr
+		offset int
+	}
+	var windowExprs []windowExpr
+	for _, e := range hp.qp.SelectExprs {
+		aliasExpr, err := e.GetAliasedExpr()
+		if err != nil {
+			return nil, err
+		}
+		if !e.Window {
+			if _, _, err := pushProjection(aliasExpr, plan, ctx.semTable, true, false, false); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		alias := aliasExpr.As
+		if alias.IsEmpty() {
+			alias = sqlparser.NewColIdent(sqlparser.String(aliasExpr.Expr))
+		}
+		placeholder := &sqlparser.AliasedExpr{Expr: &sqlparser.NullVal{}, As: alias}
+		offset, _, err := pushProjection(placeholder, plan, ctx.semTable, true, false, false)
+		if err != nil {
+			return nil, err
+		}
+		windowExprs = append(windowExprs, windowExpr{expr: aliasExpr, offset: offset})
+	}
+
+	w := &window{
+		input:   plan,
+		eWindow: &engine.Window{},
+	}
+	for _, we := range windowExprs {
+		params, err := hp.createWindowFuncParams(ctx, we.expr, we.offset, plan)
+		if err != nil {
+			return nil, err
+		}
+		w.eWindow.Functions = append(w.eWindow.Functions, params)
+	}
+
+	var newPlan logicalPlan = w
+	if len(hp.qp.OrderExprs) > 0 {
+		var err error
+		newPlan, err = hp.planOrderBy(ctx, hp.qp.OrderExprs, newPlan)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := hp.truncateColumnsIfNeeded(newPlan)
+	if err != nil {
+		return nil, err
+	}
+	return newPlan, nil
+}
+
+// createWindowFuncParams pushes the arguments, the partitioning and the ordering of
+// a window function to the plan, and returns the parameters of the function.
+func (hp *horizonPlanning) createWindowFuncParams(ctx *planningContext, aliasExpr *sqlparser.AliasedExpr, col int, plan logicalPlan) (*engine.WindowFuncParams, error) {
+	var name string
+	var args []sqlparser.Expr
+	var over *sqlparser.OverClause
+	params := &engine.WindowFuncParams{
+		Col:  col,
+		Expr: aliasExpr.Expr,
+	}
+	switch fn := aliasExpr.Expr.(type) {
+	case *sqlparser.FuncExpr:
+		if fn.Distinct {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: distinct in window function: %s", sqlparser.String(fn))
+		}
+		name = fn.Name.Lowered()
+		over = fn.Over
+		for _, arg := range fn.Exprs {
+			switch arg := arg.(type) {
+			case *sqlparser.AliasedExpr:
+				args = append(args, arg.Expr)
+			case *sqlparser.StarExpr:
+				// count(*) counts all the rows of the frame
+			default:
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: window function argument: %s", sqlparser.String(arg))
+			}
+		}
+	case *sqlparser.WindowFuncExpr:
+		if fn.IgnoreNulls {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: ignore nulls in window function: %s", sqlparser.String(fn))
+		}
+		name = strings.ToLower(fn.Name)
+		over = fn.Over
+		args = fn.Exprs
+		params.FromLast = fn.FromLast
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex window function expression: %s", sqlparser.String(aliasExpr.Expr))
+	}
+
+	opcode, supported := engine.SupportedWindowFunctions[name]
+	if !supported {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: window function '%s'", name)
+	}
+	params.Opcode = opcode
+	if !aliasExpr.As.IsEmpty() {
+		params.Alias = aliasExpr.As.String()
+	}
+
+	spec := over.WindowSpec
+	if spec == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] named window was not expanded: %s", sqlparser.String(over))
+	}
+	if spec.FrameClause != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: window frame clause: %s", sqlparser.String(spec.FrameClause))
+	}
+
+	for _, arg := range args {
+		offset, added, err := pushProjection(&sqlparser.AliasedExpr{Expr: arg}, plan, ctx.semTable, true, true, false)
+		if err != nil {
+			return nil, err
+		}
+		hp.haveToTruncate(added)
+		params.Args = append(params.Args, offset)
+	}
+	if len(args) > 0 {
+		params.CollationID = ctx.semTable.CollationFor(args[0])
+	}
+
+	for _, expr := range spec.PartitionClause {
+		offset, weightStringOffset, added, err := wrapAndPushExpr(expr, expr, plan, ctx.semTable)
+		if err != nil {
+			return nil, err
+		}
+		hp.haveToTruncate(added)
+		params.PartitionBy = append(params.PartitionBy, engine.OrderByParams{
+			Col:             offset,
+			WeightStringCol: weightStringOffset,
+			CollationID:     ctx.semTable.CollationFor(expr),
+		})
+	}
+	for _, order := range spec.OrderClause {
+		offset, weightStringOffset, added, err := wrapAndPushExpr(order.Expr, order.Expr, plan, ctx.semTable)
+		if err != nil {
+			return nil, err
+		}
+		hp.haveToTruncate(added)
+		params.OrderBy = append(params.OrderBy, engine.OrderByParams{
+			Col:             offset,
+			WeightStringCol: weightStringOffset,
+			Desc:            order.Direction == sqlparser.DescOrder,
+			CollationID:     ctx.semTable.CollationFor(order.Expr),
+		})
+	}
+	return params, nil
+}
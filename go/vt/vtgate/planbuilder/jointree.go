@@ -144,6 +144,12 @@ func (jp *joinTree) removePredicate(ctx *planningContext, expr sqlparser.Expr) e
 	for idx, predicate := range jp.predicates {
 		if sqlparser.EqualsExpr(predicate, expr) {
 			jp.predicates = append(jp.predicates[0:idx], jp.predicates[idx+1:]...)
+			if !isRemoved {
+				// the predicate was split when it was pushed, and the rhs received a rewritten version of it
+				if err := jp.removeRewrittenPredicate(ctx, expr); err != nil {
+					return err
+				}
+			}
 			isRemoved = true
 			break
 		}
@@ -153,3 +159,39 @@ func (jp *joinTree) removePredicate(ctx *planningContext, expr sqlparser.Expr) e
 	}
 	return vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "remove '%s' predicate not supported on cross-shard join query", sqlparser.String(expr))
 }
+
+// removeRewrittenPredicate removes the predicate that was pushed to the rhs
+// in place of a predicate depending on both sides of the join.
+func (jp *joinTree) removeRewrittenPredicate(ctx *planningContext, expr sqlparser.Expr) error {
+	bvNames, _, rewritten, err := breakExpressionInLHSandRHS(expr, ctx.semTable, jp.lhs.tableID())
+	if err != nil {
+		return err
+	}
+	// the join variables are only needed if another predicate is still using them
+	usedVars := map[string]bool{}
+	for _, predicate := range jp.predicates {
+		deps := ctx.semTable.RecursiveDeps(predicate)
+		if deps.IsSolvedBy(jp.lhs.tableID()) || deps.IsSolvedBy(jp.rhs.tableID()) {
+			continue
+		}
+		names, _, _, err := breakExpressionInLHSandRHS(predicate, ctx.semTable, jp.lhs.tableID())
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			usedVars[name] = true
+		}
+	}
+	for _, name := range bvNames {
+		if !usedVars[name] {
+			delete(jp.vars, name)
+		}
+	}
+	for idx, predicate := range jp.predicatesToRemoveFromHashJoin {
+		if sqlparser.EqualsExpr(predicate, rewritten) {
+			jp.predicatesToRemoveFromHashJoin = append(jp.predicatesToRemoveFromHashJoin[0:idx], jp.predicatesToRemoveFromHashJoin[idx+1:]...)
+			break
+		}
+	}
+	return jp.rhs.removePredicate(ctx, rewritten)
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// multiTableDML is a multi-table UPDATE or DELETE that is rewritten into a single-table
+// statement on its target table. The other tables are moved into a subquery on the column
+// the target table is joined on:
+//
+//	delete o from orders o join customer c on o.customer_id = c.id where c.region = 'x'
+//
+// becomes
+//
+//	delete o from orders as o where o.customer_id in (select c.id from customer as c where c.region = 'x')
+//
+// The subquery then gets pulled out and evaluated before the DML is sent to the shards.
+type multiTableDML struct {
+	dmlType string
+	target  *sqlparser.AliasedTableExpr
+	others  sqlparser.TableExprs
+	preds   []sqlparser.Expr
+}
+
+// isMultiTableDML returns true if the DML has more than one table in its table expressions.
+func isMultiTableDML(tableExprs sqlparser.TableExprs) bool {
+	if len(tableExprs) != 1 {
+		return true
+	}
+	_, isAliased := tableExprs[0].(*sqlparser.AliasedTableExpr)
+	return !isAliased
+}
+
+// needsMultiTableRewrite returns true if the tables of the multi-table DML
+// cannot be sent as a whole to a single unsharded keyspace.
+func needsMultiTableRewrite(vschema ContextVSchema, reservedVars *sqlparser.ReservedVars, tableExprs sqlparser.TableExprs) (bool, error) {
+	if !isMultiTableDML(tableExprs) {
+		return false, nil
+	}
+	pb := newPrimitiveBuilder(vschema, newJointab(reservedVars))
+	err := pb.processTableExprs(sqlparser.CloneTableExprs(tableExprs), reservedVars, nil)
+	clearColumnMetadata(tableExprs)
+	if err != nil {
+		return false, err
+	}
+	rb, isRoute := pb.plan.(*route)
+	return !isRoute || rb.eroute.Keyspace.Sharded, nil
+}
+
+// rewriteMultiTableDelete rewrites a multi-table DELETE into a DELETE of its single target table.
+func rewriteMultiTableDelete(del *sqlparser.Delete) error {
+	if len(del.Targets) != 1 {
+		return vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table delete statement with more than one target table in sharded database")
+	}
+	mt, err := newMultiTableDML("delete", del.TableExprs, del.Where, del.Targets[0].Name)
+	if err != nil {
+		return err
+	}
+	if len(del.OrderBy) > 0 || del.Limit != nil {
+		return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect usage of DELETE and ORDER BY or LIMIT")
+	}
+	del.TableExprs = sqlparser.TableExprs{mt.target}
+	del.Where, err = mt.where()
+	return err
+}
+
+// rewriteMultiTableUpdate rewrites a multi-table UPDATE into an UPDATE of its single target table.
+// The target table is the table of the updated columns.
+func rewriteMultiTableUpdate(upd *sqlparser.Update) error {
+	var target sqlparser.TableIdent
+	for _, updExpr := range upd.Exprs {
+		if updExpr.Name.Qualifier.IsEmpty() {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: unqualified column '%s' in multi-table update", sqlparser.String(updExpr.Name))
+		}
+		if !target.IsEmpty() && !sqlparser.EqualsTableIdent(target, updExpr.Name.Qualifier.Name) {
+			return vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table update statement with more than one target table in sharded database")
+		}
+		target = updExpr.Name.Qualifier.Name
+	}
+	mt, err := newMultiTableDML("update", upd.TableExprs, upd.Where, target)
+	if err != nil {
+		return err
+	}
+	if len(upd.OrderBy) > 0 || upd.Limit != nil {
+		return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect usage of UPDATE and ORDER BY or LIMIT")
+	}
+	for _, updExpr := range upd.Exprs {
+		if err := mt.checkOnlyTarget(updExpr.Expr); err != nil {
+			return err
+		}
+	}
+	upd.TableExprs = sqlparser.TableExprs{mt.target}
+	upd.Where, err = mt.where()
+	return err
+}
+
+func newMultiTableDML(dmlType string, tableExprs sqlparser.TableExprs, where *sqlparser.Where, target sqlparser.TableIdent) (*multiTableDML, error) {
+	mt := &multiTableDML{dmlType: dmlType}
+	for _, tableExpr := range tableExprs {
+		if err := mt.addTableExpr(tableExpr, target); err != nil {
+			return nil, err
+		}
+	}
+	if mt.target == nil {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.UnknownTable, "Unknown table '%s' in MULTI %s", target.String(), dmlType)
+	}
+	if where != nil {
+		mt.preds = append(mt.preds, sqlparser.SplitAndExpression(nil, where.Expr)...)
+	}
+	return mt, nil
+}
+
+func (mt *multiTableDML) addTableExpr(tableExpr sqlparser.TableExpr, target sqlparser.TableIdent) error {
+	switch tableExpr := tableExpr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+		if !ok {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: derived table in multi-table %s", mt.dmlType)
+		}
+		if sqlparser.EqualsTableIdent(tableExpr.As, target) || (tableExpr.As.IsEmpty() && sqlparser.EqualsTableIdent(tableName.Name, target)) {
+			if mt.target != nil {
+				return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUniqTable, "Not unique table/alias: '%s'", target.String())
+			}
+			mt.target = tableExpr
+			return nil
+		}
+		mt.others = append(mt.others, tableExpr)
+	case *sqlparser.ParenTableExpr:
+		for _, expr := range tableExpr.Exprs {
+			if err := mt.addTableExpr(expr, target); err != nil {
+				return err
+			}
+		}
+	case *sqlparser.JoinTableExpr:
+		if tableExpr.Join != sqlparser.NormalJoinType && tableExpr.Join != sqlparser.StraightJoinType {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s in multi-table %s", tableExpr.Join.ToString(), mt.dmlType)
+		}
+		if tableExpr.Condition != nil && len(tableExpr.Condition.Using) > 0 {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: join with using in multi-table %s", mt.dmlType)
+		}
+		if err := mt.addTableExpr(tableExpr.LeftExpr, target); err != nil {
+			return err
+		}
+		if err := mt.addTableExpr(tableExpr.RightExpr, target); err != nil {
+			return err
+		}
+		if tableExpr.Condition != nil && tableExpr.Condition.On != nil {
+			mt.preds = append(mt.preds, sqlparser.SplitAndExpression(nil, tableExpr.Condition.On)...)
+		}
+	default:
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %T in multi-table %s", tableExpr, mt.dmlType)
+	}
+	return nil
+}
+
+// where returns the WHERE clause of the single-table statement.
+func (mt *multiTableDML) where() (*sqlparser.Where, error) {
+	var targetPreds, otherPreds []sqlparser.Expr
+	var targetCol *sqlparser.ColName
+	var otherExpr sqlparser.Expr
+	for _, pred := range mt.preds {
+		onTarget, onOthers, err := mt.dependencies(pred)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case !onOthers:
+			targetPreds = append(targetPreds, pred)
+			continue
+		case !onTarget:
+			otherPreds = append(otherPreds, pred)
+			continue
+		}
+		col, other, err := mt.joinColumn(pred)
+		if err != nil {
+			return nil, err
+		}
+		if targetCol == nil {
+			targetCol, otherExpr = col, other
+			continue
+		}
+		if !sqlparser.EqualsRefOfColName(targetCol, col) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s joining the target table on more than one column", mt.dmlType)
+		}
+		// t.a = o.x and t.a = o.y implies o.x = o.y
+		otherPreds = append(otherPreds, &sqlparser.ComparisonExpr{Operator: sqlparser.EqualOp, Left: otherExpr, Right: other})
+	}
+
+	subquery := &sqlparser.Select{
+		From: mt.others,
+	}
+	if len(otherPreds) > 0 {
+		subquery.Where = sqlparser.NewWhere(sqlparser.WhereClause, sqlparser.AndExpressions(otherPreds...))
+	}
+	if targetCol == nil {
+		// the tables are not joined: the target rows are changed if the other tables have any matching row
+		subquery.SelectExprs = sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: sqlparser.NewIntLiteral("1")}}
+		targetPreds = append(targetPreds, &sqlparser.ExistsExpr{Subquery: &sqlparser.Subquery{Select: subquery}})
+	} else {
+		subquery.SelectExprs = sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: otherExpr}}
+		targetPreds = append(targetPreds, &sqlparser.ComparisonExpr{
+			Operator: sqlparser.InOp,
+			Left:     targetCol,
+			Right:    &sqlparser.Subquery{Select: subquery},
+		})
+	}
+	return sqlparser.NewWhere(sqlparser.WhereClause, sqlparser.AndExpressions(targetPreds...)), nil
+}
+
+// joinColumn returns the columns of a predicate between the target and the other tables.
+// Only equalities between a column of the target table and a column of the other tables are supported.
+func (mt *multiTableDML) joinColumn(pred sqlparser.Expr) (*sqlparser.ColName, *sqlparser.ColName, error) {
+	cmp, ok := pred.(*sqlparser.ComparisonExpr)
+	if ok && cmp.Operator == sqlparser.EqualOp {
+		left, leftOk := cmp.Left.(*sqlparser.ColName)
+		right, rightOk := cmp.Right.(*sqlparser.ColName)
+		if leftOk && rightOk {
+			switch {
+			case mt.isTarget(left) && !mt.isTarget(right):
+				return left, right, nil
+			case mt.isTarget(right) && !mt.isTarget(left):
+				return right, left, nil
+			}
+		}
+	}
+	return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: '%s' in multi-table %s: only equality between columns of the target and the other tables is supported", sqlparser.String(pred), mt.dmlType)
+}
+
+// dependencies returns whether the expression uses columns of the target table and of the other tables.
+// Subqueries are only evaluated in the context of the statement they end up in.
+func (mt *multiTableDML) dependencies(expr sqlparser.Expr) (onTarget, onOthers bool, err error) {
+	err = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery:
+			return false, nil
+		case *sqlparser.ColName:
+			if node.Qualifier.IsEmpty() {
+				return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: unqualified column '%s' in multi-table %s", sqlparser.String(node), mt.dmlType)
+			}
+			if mt.isTarget(node) {
+				onTarget = true
+			} else {
+				onOthers = true
+			}
+		}
+		return true, nil
+	}, expr)
+	return
+}
+
+// checkOnlyTarget returns an error if the expression uses columns of the other tables.
+func (mt *multiTableDML) checkOnlyTarget(expr sqlparser.Expr) error {
+	_, onOthers, err := mt.dependencies(expr)
+	if err != nil {
+		return err
+	}
+	if onOthers {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s setting a value from another table: %s", mt.dmlType, sqlparser.String(expr))
+	}
+	return nil
+}
+
+func (mt *multiTableDML) isTarget(col *sqlparser.ColName) bool {
+	return isQualifiedBy(col, mt.target.As, mt.target.Expr.(sqlparser.TableName))
+}
@@ -34,6 +34,9 @@ import (
 func planProjection(pb *primitiveBuilder, in logicalPlan, expr *sqlparser.AliasedExpr, origin logicalPlan) (logicalPlan, *resultColumn, int, error) {
 	switch node := in.(type) {
 	case *join:
+		if sqlparser.ContainsWindowFunc(expr.Expr) {
+			return nil, nil, 0, errors.New("unsupported: window functions in cross-shard query")
+		}
 		var rc *resultColumn
 		if node.isOnLeft(origin.Order()) {
 			newLeft, col, colNumber, err := planProjection(pb, node.Left, expr, origin)
@@ -73,7 +76,7 @@ func planProjection(pb *primitiveBuilder, in logicalPlan, expr *sqlparser.Aliase
 		// the rows be correctly ordered.
 	case *orderedAggregate:
 		if inner, ok := expr.Expr.(*sqlparser.FuncExpr); ok {
-			if _, ok := engine.SupportedAggregates[inner.Name.Lowered()]; ok {
+			if opcode, ok := engine.SupportedAggregates[inner.Name.Lowered()]; ok && !opcode.NeedsRewrite() {
 				rc, colNumber, err := node.pushAggr(pb, expr, origin)
 				if err != nil {
 					return nil, nil, 0, err
@@ -95,6 +98,9 @@ func planProjection(pb *primitiveBuilder, in logicalPlan, expr *sqlparser.Aliase
 		node.resultColumns = append(node.resultColumns, innerRC)
 		return node, innerRC, len(node.resultColumns) - 1, nil
 	case *route:
+		if !node.isSingleShard() && sqlparser.ContainsWindowFunc(expr.Expr) {
+			return nil, nil, 0, errors.New("unsupported: window functions in cross-shard query")
+		}
 		sel := node.Select.(*sqlparser.Select)
 		sel.SelectExprs = append(sel.SelectExprs, expr)
 
@@ -62,6 +62,9 @@ func transformCorrelatedSubquery(ctx *planningContext, tree *correlatedSubqueryT
 	if err != nil {
 		return nil, err
 	}
+	if !tree.isSemiJoin() {
+		return transformSemiApply(ctx, tree, outer, inner)
+	}
 	return newSemiJoin(outer, inner, tree.vars), nil
 }
 
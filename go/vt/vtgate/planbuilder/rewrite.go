@@ -183,7 +183,7 @@ func rewriteHavingClause(node *sqlparser.Select) {
 				}
 				originalExpr, isInMap := selectExprMap[x.Name.Lowered()]
 				if isInMap {
-					if sqlparser.ContainsAggregation(originalExpr) {
+					if sqlparser.ContainsAggregation(originalExpr) || sqlparser.ContainsWindowFunc(originalExpr) {
 						hasAggr = true
 					} else {
 						cursor.Replace(originalExpr)
@@ -203,3 +203,94 @@ func rewriteHavingClause(node *sqlparser.Select) {
 		}
 	}
 }
+
+// expandNamedWindows replaces the references to windows defined in the WINDOW clause
+// with the window specifications they name, and removes the WINDOW clause.
+// This way the rest of the planner only has to deal with inline window specifications.
+func expandNamedWindows(statement sqlparser.SelectStatement) error {
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if sel, isSel := node.(*sqlparser.Select); isSel {
+			return true, expandNamedWindowsInSelect(sel)
+		}
+		return true, nil
+	}, statement)
+}
+
+func expandNamedWindowsInSelect(sel *sqlparser.Select) error {
+	definitions := map[string]*sqlparser.WindowDefinition{}
+	for _, def := range sel.Windows {
+		name := def.Name.Lowered()
+		if _, exists := definitions[name]; exists {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window '%s' is defined twice.", def.Name.String())
+		}
+		definitions[name] = def
+	}
+
+	var resolve func(spec *sqlparser.WindowSpecification, specName string, seen map[string]bool) (*sqlparser.WindowSpecification, error)
+	resolve = func(spec *sqlparser.WindowSpecification, specName string, seen map[string]bool) (*sqlparser.WindowSpecification, error) {
+		if spec.Name.IsEmpty() {
+			return spec, nil
+		}
+		name := spec.Name.Lowered()
+		def, exists := definitions[name]
+		if !exists {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window name '%s' is not defined.", spec.Name.String())
+		}
+		if seen[name] {
+			return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "There is a circularity in the window dependency graph.")
+		}
+		seen[name] = true
+		base, err := resolve(def.WindowSpec, def.Name.String(), seen)
+		if err != nil {
+			return nil, err
+		}
+
+		// a window can only add an ORDER BY and a frame to the window it refers to
+		merged := sqlparser.CloneRefOfWindowSpecification(base)
+		if len(spec.PartitionClause) > 0 {
+			return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "A window which depends on another cannot define partitioning.")
+		}
+		if len(spec.OrderClause) > 0 {
+			if len(merged.OrderClause) > 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window '%s' cannot inherit '%s' since both contain an ORDER BY clause.", specName, def.Name.String())
+			}
+			merged.OrderClause = spec.OrderClause
+		}
+		if merged.FrameClause != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window '%s' has a frame definition, so cannot be referenced by another window.", def.Name.String())
+		}
+		merged.FrameClause = spec.FrameClause
+		return merged, nil
+	}
+
+	var err error
+	expand := func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Subquery:
+			// subqueries have their own WINDOW clause
+			return false
+		case *sqlparser.OverClause:
+			spec := node.WindowSpec
+			if !node.WindowName.IsEmpty() {
+				spec = &sqlparser.WindowSpecification{Name: node.WindowName}
+			}
+			spec, err = resolve(spec, "<unnamed window>", map[string]bool{})
+			if err != nil {
+				return false
+			}
+			node.WindowName = sqlparser.ColIdent{}
+			node.WindowSpec = spec
+		}
+		return err == nil
+	}
+	sqlparser.Rewrite(sel.SelectExprs, expand, nil)
+	if err != nil {
+		return err
+	}
+	sqlparser.Rewrite(sel.OrderBy, expand, nil)
+	if err != nil {
+		return err
+	}
+	sel.Windows = nil
+	return nil
+}
@@ -168,16 +168,11 @@ func optimizeSubQuery(ctx *planningContext, op *abstract.SubQuery) (queryTree, e
 			continue
 		}
 
-		if inner.ExtractedSubquery.OpCode == int(engine.PulloutExists) {
-			correlatedTree, err := createCorrelatedSubqueryTree(ctx, treeInner, outerTree, preds, inner.ExtractedSubquery)
-			if err != nil {
-				return nil, err
-			}
-			outerTree = correlatedTree
-			continue
+		correlatedTree, err := createCorrelatedSubqueryTree(ctx, treeInner, outerTree, preds, inner.ExtractedSubquery)
+		if err != nil {
+			return nil, err
 		}
-
-		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+		outerTree = correlatedTree
 	}
 
 	/*
@@ -197,9 +192,34 @@ func optimizeSubQuery(ctx *planningContext, op *abstract.SubQuery) (queryTree, e
 }
 
 func createCorrelatedSubqueryTree(ctx *planningContext, innerTree, outerTree queryTree, preds []sqlparser.Expr, extractedSubquery *sqlparser.ExtractedSubquery) (*correlatedSubqueryTree, error) {
-	err := outerTree.removePredicate(ctx, extractedSubquery)
-	if err != nil {
-		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "exists sub-queries are only supported with AND clause")
+	tree := &correlatedSubqueryTree{
+		outer:     outerTree,
+		inner:     innerTree,
+		extracted: extractedSubquery,
+	}
+	switch engine.PulloutOpcode(extractedSubquery.OpCode) {
+	case engine.PulloutExists:
+		err := outerTree.removePredicate(ctx, extractedSubquery)
+		if err != nil {
+			// NOT EXISTS (...)
+			err = outerTree.removePredicate(ctx, &sqlparser.NotExpr{Expr: extractedSubquery})
+			if err != nil {
+				return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "exists sub-queries are only supported with AND clause")
+			}
+			tree.negated = true
+		}
+	case engine.PulloutValue:
+		err := tree.extractValuePredicate(ctx)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+	}
+
+	batched, err := tree.tryBatching(ctx, preds)
+	if err != nil || batched {
+		return tree, err
 	}
 
 	vars := map[string]int{}
@@ -242,17 +262,182 @@ func createCorrelatedSubqueryTree(ctx *planningContext, innerTree, outerTree que
 		if rewriteError != nil {
 			return nil, rewriteError
 		}
+		// the outer columns have been replaced by arguments, so the predicate only depends on the inner tables
+		tableSet := ctx.semTable.DirectDeps(pred)
+		tableSet.RemoveInPlace(outerTree.tableID())
+		ctx.semTable.Direct[pred] = tableSet
+		tableSet = ctx.semTable.RecursiveDeps(pred)
+		tableSet.RemoveInPlace(outerTree.tableID())
+		ctx.semTable.Recursive[pred] = tableSet
+
 		err := innerTree.pushPredicate(ctx, pred)
 		if err != nil {
 			return nil, err
 		}
 	}
-	return &correlatedSubqueryTree{
-		outer:     outerTree,
-		inner:     innerTree,
-		extracted: extractedSubquery,
-		vars:      vars,
-	}, nil
+	tree.vars = vars
+	return tree, nil
+}
+
+// extractValuePredicate removes the outer predicate using the value of a scalar subquery,
+// so it can be evaluated once the subquery has been executed for the outer row.
+// Scalar subqueries that are not used in a predicate must be part of the projection.
+func (s *correlatedSubqueryTree) extractValuePredicate(ctx *planningContext) error {
+	sel := ctx.semTable.SelectFor(s.extracted)
+	if sel == nil {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+	}
+	var predicate sqlparser.Expr
+	if sel.Where != nil {
+		for _, expr := range sqlparser.SplitAndExpression(nil, sel.Where.Expr) {
+			if containsSubquery(expr, s.extracted) {
+				predicate = expr
+				break
+			}
+		}
+	}
+	if predicate == nil {
+		for _, expr := range sel.SelectExprs {
+			if aliasedExpr, ok := expr.(*sqlparser.AliasedExpr); ok && aliasedExpr.Expr == sqlparser.Expr(s.extracted) {
+				return nil
+			}
+		}
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+	}
+
+	err := s.outer.removePredicate(ctx, predicate)
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+	}
+
+	// the subquery is replaced by the argument that will hold its value
+	var value sqlparser.Expr = sqlparser.NewArgument(s.extracted.GetArgName())
+	if cmp, isCmp := s.extracted.Original.(*sqlparser.ComparisonExpr); isCmp {
+		value = &sqlparser.ComparisonExpr{
+			Left:     s.extracted.OtherSide,
+			Operator: cmp.Operator,
+			Right:    value,
+		}
+	}
+	predicate = sqlparser.Rewrite(predicate, func(cursor *sqlparser.Cursor) bool {
+		if cursor.Node() == sqlparser.SQLNode(s.extracted) {
+			cursor.Replace(value)
+			return false
+		}
+		return true
+	}, nil).(sqlparser.Expr)
+
+	// the columns used by the predicate have to be returned by the outer query
+	var columns []*sqlparser.ColName
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, isCol := node.(*sqlparser.ColName); isCol {
+			columns = append(columns, col)
+		}
+		return true, nil
+	}, predicate)
+	if len(columns) > 0 {
+		if _, err := s.outer.pushOutputColumns(columns, ctx.semTable); err != nil {
+			return err
+		}
+	}
+	s.predicate = predicate
+	return nil
+}
+
+// tryBatching checks if the subquery is correlated on a single equality between an inner
+// and an outer column. If it is, the subquery can be executed for a batch of outer rows,
+// using the list of values of the outer column. The predicate is then rewritten as an IN
+// predicate on the inner column, and the value of the inner column is returned first.
+func (s *correlatedSubqueryTree) tryBatching(ctx *planningContext, preds []sqlparser.Expr) (bool, error) {
+	if len(preds) != 1 {
+		return false, nil
+	}
+	innerRoute, isRoute := s.inner.(*routeTree)
+	if !isRoute || len(innerRoute.columns) > 0 {
+		return false, nil
+	}
+	cmp, isCmp := preds[0].(*sqlparser.ComparisonExpr)
+	if !isCmp || cmp.Operator != sqlparser.EqualOp {
+		return false, nil
+	}
+	lhs, lIsCol := cmp.Left.(*sqlparser.ColName)
+	rhs, rIsCol := cmp.Right.(*sqlparser.ColName)
+	if !lIsCol || !rIsCol {
+		return false, nil
+	}
+	outerCol, innerCol := lhs, rhs
+	if !ctx.semTable.RecursiveDeps(outerCol).IsSolvedBy(s.outer.tableID()) {
+		outerCol, innerCol = rhs, lhs
+	}
+	if !ctx.semTable.RecursiveDeps(outerCol).IsSolvedBy(s.outer.tableID()) ||
+		!ctx.semTable.RecursiveDeps(innerCol).IsSolvedBy(s.inner.tableID()) {
+		return false, nil
+	}
+
+	outerType, found := ctx.semTable.ExprTypes[outerCol]
+	if !found {
+		return false, nil
+	}
+	innerType, found := ctx.semTable.ExprTypes[innerCol]
+	if !found || innerType.Collation != outerType.Collation {
+		return false, nil
+	}
+	comparisonType, err := evalengine.CoerceTo(outerType.Type, innerType.Type)
+	if err != nil {
+		return false, nil
+	}
+
+	sel, isSel := s.extracted.Subquery.Select.(*sqlparser.Select)
+	if !isSel {
+		return false, nil
+	}
+	if engine.PulloutOpcode(s.extracted.OpCode) == engine.PulloutValue {
+		if sel.Limit != nil {
+			return false, nil
+		}
+		// the inner column is returned first, so the rows can be matched with the outer rows,
+		// and the values are computed separately for each of its values.
+		sel.SelectExprs = append(sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: innerCol}}, sel.SelectExprs...)
+		if len(sel.GroupBy) > 0 || sqlparser.ContainsAggregation(sel.SelectExprs) {
+			sel.GroupBy = append(sqlparser.GroupBy{innerCol}, sel.GroupBy...)
+		}
+	} else {
+		if _, err := s.inner.pushOutputColumns([]*sqlparser.ColName{innerCol}, ctx.semTable); err != nil {
+			return false, err
+		}
+	}
+
+	offsets, err := s.outer.pushOutputColumns([]*sqlparser.ColName{outerCol}, ctx.semTable)
+	if err != nil {
+		return false, err
+	}
+	s.batchVar = ctx.reservedVars.ReserveColName(outerCol)
+	s.batchCol = offsets[0]
+	s.batchKey = innerCol
+	s.comparisonType = comparisonType
+	s.collation = innerType.Collation
+
+	err = s.inner.pushPredicate(ctx, &sqlparser.ComparisonExpr{
+		Left:     innerCol,
+		Operator: sqlparser.InOp,
+		Right:    sqlparser.ListArg(s.batchVar),
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// containsSubquery returns true if the expression uses the given subquery.
+func containsSubquery(expr sqlparser.Expr, extracted *sqlparser.ExtractedSubquery) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if node == sqlparser.SQLNode(extracted) {
+			found = true
+		}
+		return !found, nil
+	}, expr)
+	return found
 }
 
 func tryMergeSubQuery(ctx *planningContext, outer, subq queryTree, subQueryInner *abstract.SubQueryInner, joinPredicates []sqlparser.Expr, merger mergeFunc) (queryTree, error) {
@@ -174,12 +174,33 @@ func (rp *routeTree) removePredicate(ctx *planningContext, expr sqlparser.Expr)
 	for i, predicate := range rp.predicates {
 		if sqlparser.EqualsExpr(predicate, expr) {
 			rp.predicates = append(rp.predicates[0:i], rp.predicates[i+1:]...)
+			rp.removeTablePredicate(expr)
 			return rp.resetRoutingSelections(ctx)
 		}
 	}
 	return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "%s not found in predicates", sqlparser.String(expr))
 }
 
+// removeTablePredicate removes the predicate from the tables of the route,
+// since the predicates of the tables are also added to the query sent to the tablets
+func (rp *routeTree) removeTablePredicate(expr sqlparser.Expr) {
+	_ = visitRelations(rp.tables, func(tbl relation) (bool, error) {
+		switch tbl := tbl.(type) {
+		case *routeTable:
+			var predicates []sqlparser.Expr
+			for _, predicate := range tbl.qtable.Predicates {
+				if !sqlparser.EqualsExpr(predicate, expr) {
+					predicates = append(predicates, predicate)
+				}
+			}
+			tbl.qtable.Predicates = predicates
+		case *derivedTable:
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
 // addPredicate adds these predicates added to it. if the predicates can help,
 // they will improve the routeOpCode
 func (rp *routeTree) addPredicate(ctx *planningContext, predicates ...sqlparser.Expr) error {
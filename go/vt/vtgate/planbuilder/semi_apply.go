@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+var _ logicalPlan = (*semiApply)(nil)
+
+// semiApply is the logicalPlan for engine.SemiApply.
+// This gets built for correlated subqueries that cannot be merged
+// with the outer query, and that cannot be planned as a semiJoin.
+type semiApply struct {
+	outer, inner logicalPlan
+	extracted    *sqlparser.ExtractedSubquery
+	esemiApply   *engine.SemiApply
+}
+
+// transformSemiApply builds a semiApply from the logical plans of a correlatedSubqueryTree.
+func transformSemiApply(ctx *planningContext, tree *correlatedSubqueryTree, outer, inner logicalPlan) (logicalPlan, error) {
+	opcode := engine.PulloutOpcode(tree.extracted.OpCode)
+	esemiApply := &engine.SemiApply{
+		Opcode:         opcode,
+		Negated:        tree.negated,
+		Vars:           tree.vars,
+		BatchVar:       tree.batchVar,
+		BatchCol:       tree.batchCol,
+		Collation:      tree.collation,
+		ComparisonType: tree.comparisonType,
+	}
+	if opcode == engine.PulloutValue {
+		var err error
+		inner, err = planHorizon(ctx, inner, tree.extracted.Subquery.Select)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if tree.predicate != nil {
+		predicate, err := evalengine.Convert(tree.predicate, &simpleConverterLookup{semTable: ctx.semTable, plan: outer})
+		if err != nil {
+			return nil, err
+		}
+		esemiApply.Predicate = predicate
+		esemiApply.ASTPredicate = tree.predicate
+		esemiApply.SubqueryResult = tree.extracted.GetArgName()
+	}
+	return &semiApply{
+		outer:      outer,
+		inner:      inner,
+		extracted:  tree.extracted,
+		esemiApply: esemiApply,
+	}, nil
+}
+
+// pushValue adds the value of the subquery to the columns returned by the semiApply.
+func (sa *semiApply) pushValue(expr *sqlparser.AliasedExpr, reuseCol bool) (int, bool, error) {
+	if sa.esemiApply.Opcode != engine.PulloutValue {
+		return 0, false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery in the projection")
+	}
+	if reuseCol {
+		for idx, col := range sa.esemiApply.Cols {
+			if col > 0 {
+				return idx, false, nil
+			}
+		}
+	}
+	if sa.esemiApply.ValueName == "" {
+		if expr.As.IsEmpty() {
+			sa.esemiApply.ValueName = sqlparser.String(expr.Expr)
+		} else {
+			sa.esemiApply.ValueName = expr.As.String()
+		}
+	}
+	sa.esemiApply.Cols = append(sa.esemiApply.Cols, 1)
+	return len(sa.esemiApply.Cols) - 1, true, nil
+}
+
+// Order implements the logicalPlan interface
+func (sa *semiApply) Order() int {
+	panic("[BUG]: should not be called. This is a Gen4 primitive")
+}
+
+// Reorder implements the logicalPlan interface
+func (sa *semiApply) Reorder(order int) {
+	panic("[BUG]: should not be called. This is a Gen4 primitive")
+}
+
+// Primitive implements the logicalPlan interface
+func (sa *semiApply) Primitive() engine.Primitive {
+	sa.esemiApply.Outer = sa.outer.Primitive()
+	sa.esemiApply.Subquery = sa.inner.Primitive()
+	return sa.esemiApply
+}
+
+// ResultColumns implements the logicalPlan interface
+func (sa *semiApply) ResultColumns() []*resultColumn {
+	panic("[BUG]: should not be called. This is a Gen4 primitive")
+}
+
+// Wireup implements the logicalPlan interface
+func (sa *semiApply) Wireup(plan logicalPlan, jt *jointab) error {
+	panic("[BUG]: should not be called. This is a Gen4 primitive")
+}
+
+// WireupGen4 implements the logicalPlan interface
+func (sa *semiApply) WireupGen4(semTable *semantics.SemTable) error {
+	if err := sa.outer.WireupGen4(semTable); err != nil {
+		return err
+	}
+	return sa.inner.WireupGen4(semTable)
+}
+
+// SupplyVar implements the logicalPlan interface
+func (sa *semiApply) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	panic("[BUG]: should not be called. This is a Gen4 primitive")
+}
+
+// SupplyCol implements the logicalPlan interface
+func (sa *semiApply) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	panic("[BUG]: should not be called. This is a Gen4 primitive")
+}
+
+// SupplyWeightString implements the logicalPlan interface
+func (sa *semiApply) SupplyWeightString(colNumber int, alsoAddToGroupBy bool) (weightcolNumber int, err error) {
+	panic("[BUG]: should not be called. This is a Gen4 primitive")
+}
+
+// Rewrite implements the logicalPlan interface
+func (sa *semiApply) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 2 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "semiApply: wrong number of inputs")
+	}
+	sa.outer = inputs[0]
+	sa.inner = inputs[1]
+	return nil
+}
+
+// ContainsTables implements the logicalPlan interface
+func (sa *semiApply) ContainsTables() semantics.TableSet {
+	return sa.outer.ContainsTables().Merge(sa.inner.ContainsTables())
+}
+
+// Inputs implements the logicalPlan interface
+func (sa *semiApply) Inputs() []logicalPlan {
+	return []logicalPlan{sa.outer, sa.inner}
+}
@@ -29,10 +29,19 @@ import (
 // buildUpdatePlan builds the instructions for an UPDATE statement.
 func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
 	upd := stmt.(*sqlparser.Update)
-	if upd.With != nil {
-		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in update statement")
+	if err := inlineCTEs(upd); err != nil {
+		return nil, err
+	}
+	rewrite, err := needsMultiTableRewrite(vschema, reservedVars, upd.TableExprs)
+	if err != nil {
+		return nil, err
+	}
+	if rewrite {
+		if err := rewriteMultiTableUpdate(upd); err != nil {
+			return nil, err
+		}
 	}
-	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "update", stmt, reservedVars, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
+	dml, ksidVindex, ksidCol, inputs, err := buildDMLPlan(vschema, "update", stmt, reservedVars, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +62,7 @@ func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 	if len(eupd.ChangedVindexValues) != 0 {
 		eupd.KsidVindex = ksidVindex
 	}
-	return eupd, nil
+	return inputs.wrap(eupd, dml, ksidVindex), nil
 }
 
 // buildChangedVindexesValues adds to the plan all the lookup vindexes that are changing.
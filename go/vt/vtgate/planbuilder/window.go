@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+var _ logicalPlan = (*window)(nil)
+
+// window is the logicalPlan for engine.Window.
+// It is used when window functions cannot be pushed down to a
+// single route, and have to be evaluated at the vtgate level.
+// Its output has the same columns as its input, with the value
+// of each window function written into its placeholder column.
+type window struct {
+	gen4Plan
+	input   logicalPlan
+	eWindow *engine.Window
+}
+
+// WireupGen4 implements the logicalPlan interface
+func (w *window) WireupGen4(semTable *semantics.SemTable) error {
+	return w.input.WireupGen4(semTable)
+}
+
+// Primitive implements the logicalPlan interface
+func (w *window) Primitive() engine.Primitive {
+	w.eWindow.Input = w.input.Primitive()
+	return w.eWindow
+}
+
+// Inputs implements the logicalPlan interface
+func (w *window) Inputs() []logicalPlan {
+	return []logicalPlan{w.input}
+}
+
+// Rewrite implements the logicalPlan interface
+func (w *window) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 1 {
+		return vterrors.New(vtrpcpb.Code_INTERNAL, "[BUG]: expected only 1 input")
+	}
+	w.input = inputs[0]
+	return nil
+}
+
+// ContainsTables implements the logicalPlan interface
+func (w *window) ContainsTables() semantics.TableSet {
+	return w.input.ContainsTables()
+}
@@ -215,6 +215,16 @@ func isParentSelect(cursor *sqlparser.Cursor) bool {
 	return isSelect
 }
 
+// isOrderByOfExpression returns true if the ORDER BY belongs to an expression,
+// like a window specification or a GROUP_CONCAT, instead of a query.
+func isOrderByOfExpression(cursor *sqlparser.Cursor) bool {
+	switch cursor.Parent().(type) {
+	case *sqlparser.WindowSpecification, *sqlparser.GroupConcatExpr:
+		return true
+	}
+	return false
+}
+
 type originable interface {
 	tableSetFor(t *sqlparser.AliasedTableExpr) TableSet
 	depsForExpr(expr sqlparser.Expr) (direct, recursive TableSet, typ *Type)
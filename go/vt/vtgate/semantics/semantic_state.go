@@ -299,6 +299,18 @@ func (st *SemTable) FindSubqueryReference(subquery *sqlparser.Subquery) *sqlpars
 	return nil
 }
 
+// SelectFor returns the SELECT statement that the given subquery was extracted from
+func (st *SemTable) SelectFor(subquery *sqlparser.ExtractedSubquery) *sqlparser.Select {
+	for sel, subqueries := range st.SubqueryMap {
+		for _, extractedSubquery := range subqueries {
+			if extractedSubquery == subquery {
+				return sel
+			}
+		}
+	}
+	return nil
+}
+
 // GetSubqueryNeedingRewrite returns a list of sub-queries that need to be rewritten
 func (st *SemTable) GetSubqueryNeedingRewrite() []*sqlparser.ExtractedSubquery {
 	var res []*sqlparser.ExtractedSubquery
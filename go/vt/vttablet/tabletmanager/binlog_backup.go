@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file handles the archiving of the binary logs of a primary into the
+// BackupStorage, so they can be replayed by point-in-time restores.
+
+var (
+	binlogBackupInterval = flag.Duration("binlog_backup_interval", 0, "if this is greater than 0, a primary tablet archives its binary logs to the BackupStorage at this interval, to allow point-in-time restores")
+)
+
+// binlogBackupLoop archives the binary logs at every binlog_backup_interval,
+// while the tablet is a primary.
+func (tm *TabletManager) binlogBackupLoop(ctx context.Context, doneChan chan<- struct{}) {
+	defer close(doneChan)
+
+	ticker := time.NewTicker(*binlogBackupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if tm.Tablet().Type != topodatapb.TabletType_PRIMARY {
+			continue
+		}
+		if err := tm.backupBinlogs(ctx); err != nil {
+			log.Errorf("Failed to archive binary logs: %v", err)
+		}
+	}
+}
+
+// backupBinlogs archives the binary logs that were closed since the last run.
+func (tm *TabletManager) backupBinlogs(ctx context.Context) error {
+	tablet := tm.Tablet()
+	count, err := mysqlctl.BackupBinlogs(ctx, mysqlctl.BinlogBackupParams{
+		Cnf:          tm.Cnf,
+		Mysqld:       tm.MysqlDaemon,
+		Logger:       logutil.NewConsoleLogger(),
+		HookExtraEnv: tm.hookExtraEnv(),
+		Keyspace:     tablet.Keyspace,
+		Shard:        tablet.Shard,
+		TabletAlias:  topoproto.TabletAliasString(tablet.Alias),
+	})
+	if count > 0 {
+		log.Infof("Archived %v binary log files", count)
+	}
+	return err
+}
+
+func (tm *TabletManager) startBinlogBackup() {
+	if *binlogBackupInterval <= 0 || tm.Cnf == nil {
+		return
+	}
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+	tm._binlogBackupDone = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	tm._binlogBackupCancel = cancel
+
+	go tm.binlogBackupLoop(ctx, tm._binlogBackupDone)
+}
+
+func (tm *TabletManager) stopBinlogBackup() {
+	var doneChan <-chan struct{}
+
+	tm.mutex.Lock()
+	if tm._binlogBackupCancel != nil {
+		tm._binlogBackupCancel()
+	}
+	doneChan = tm._binlogBackupDone
+	tm.mutex.Unlock()
+
+	// If the binlog backup loop was running, wait for it to fully stop.
+	if doneChan != nil {
+		<-doneChan
+	}
+}
@@ -49,6 +49,8 @@ var (
 	restoreFromBackupTsStr = flag.String("restore_from_backup_ts", "", "(init restore parameter) if set, restore the latest backup taken at or before this timestamp. Example: '2021-04-29.133050'")
 	restoreConcurrency     = flag.Int("restore_concurrency", 4, "(init restore parameter) how many concurrent files to restore at once")
 	waitForBackupInterval  = flag.Duration("wait_for_backup_interval", 0, "(init restore parameter) if this is greater than 0, instead of starting up empty when no backups are found, keep checking at this interval for a backup to appear")
+	restoreToPosStr        = flag.String("restore_to_pos", "", "(init restore parameter) if set, restore the latest backup at or before this GTID position, then replay the archived binary logs up to it. The tablet is left DRAINED and not replicating")
+	restoreToTimestampStr  = flag.String("restore_to_timestamp", "", "(init restore parameter) if set, restore the latest backup taken at or before this time, then replay the archived binary logs up to it. The tablet is left DRAINED and not replicating. Example: '2021-04-29.133050'")
 
 	// Flags for PITR
 	binlogHost           = flag.String("binlog_host", "", "PITR restore parameter: hostname/IP of binlog server.")
@@ -66,7 +68,9 @@ var (
 // It will either work, fail gracefully, or return
 // an error in case of a non-recoverable error.
 // It takes the action lock so no RPC interferes.
-func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, backupTime time.Time) error {
+// If restoreToPos or restoreToTimestamp is set, the archived binary logs are
+// replayed on top of the backup, and the tablet is left DRAINED.
+func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, backupTime time.Time, restoreToPos mysql.Position, restoreToTimestamp time.Time) error {
 	if err := tm.lock(ctx); err != nil {
 		return err
 	}
@@ -120,7 +124,7 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 
 	startTime = time.Now()
 
-	err = tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore, backupTime)
+	err = tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore, backupTime, restoreToPos, restoreToTimestamp, "" /* backupShard */)
 	if err != nil {
 		return err
 	}
@@ -138,7 +142,9 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 	return nil
 }
 
-func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, backupTime time.Time) error {
+// restoreDataLocked restores the latest backup of the shard of the tablet, or
+// of backupShard if it is set.
+func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, backupTime time.Time, restoreToPos mysql.Position, restoreToTimestamp time.Time, backupShard string) error {
 
 	tablet := tm.Tablet()
 	originalType := tablet.Type
@@ -163,6 +169,11 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 		keyspace = keyspaceInfo.BaseKeyspace
 		log.Infof("Using base_keyspace %v to restore keyspace %v using a backup time of %v", keyspace, tablet.Keyspace, backupTime)
 	}
+	shard := tablet.Shard
+	if backupShard != "" {
+		shard = backupShard
+		logger.Infof("Restoring a backup of shard %v/%v into shard %v/%v", keyspace, shard, tablet.Keyspace, tablet.Shard)
+	}
 
 	params := mysqlctl.RestoreParams{
 		Cnf:                 tm.Cnf,
@@ -174,8 +185,10 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 		DeleteBeforeRestore: deleteBeforeRestore,
 		DbName:              topoproto.TabletDbName(tablet),
 		Keyspace:            keyspace,
-		Shard:               tablet.Shard,
+		Shard:               shard,
 		StartTime:           backupTime,
+		RestoreToPos:        restoreToPos,
+		RestoreToTimestamp:  restoreToTimestamp,
 	}
 
 	// Check whether we're going to restore before changing to RESTORE type,
@@ -234,6 +247,11 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 	}
 	switch err {
 	case nil:
+		// A tablet restored to a point in time must neither serve nor replicate
+		// past that point, so it is left DRAINED for the operator to inspect it.
+		if params.IsPointInTimeRecovery() {
+			return tm.tmState.ChangeTabletType(ctx, topodatapb.TabletType_DRAINED, DBActionNone)
+		}
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. Thus we use the background context to get through to the finish.
 		if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
@@ -22,11 +22,13 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -36,7 +38,7 @@ const (
 )
 
 // Backup takes a db backup and sends it to the BackupStorage
-func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowPrimary bool) error {
+func (tm *TabletManager) Backup(ctx context.Context, logger logutil.Logger, req *tabletmanagerdatapb.BackupRequest) error {
 	if tm.Cnf == nil {
 		return fmt.Errorf("cannot perform backup without my.cnf, please restart vttablet with a my.cnf file specified")
 	}
@@ -46,7 +48,7 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 	// but the process didn't find out about this.
 	// It is not safe to take backups from tablet in this state
 	currentTablet := tm.Tablet()
-	if !allowPrimary && currentTablet.Type == topodatapb.TabletType_PRIMARY {
+	if !req.AllowPrimary && currentTablet.Type == topodatapb.TabletType_PRIMARY {
 		return fmt.Errorf("type PRIMARY cannot take backup. if you really need to do this, rerun the backup command with -allow_primary")
 	}
 	engine, err := mysqlctl.GetBackupEngine()
@@ -58,7 +60,7 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 	if err != nil {
 		return err
 	}
-	if !allowPrimary && tablet.Type == topodatapb.TabletType_PRIMARY {
+	if !req.AllowPrimary && tablet.Type == topodatapb.TabletType_PRIMARY {
 		return fmt.Errorf("type PRIMARY cannot take backup. if you really need to do this, rerun the backup command with -allow_primary")
 	}
 
@@ -104,16 +106,18 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 
 	// now we can run the backup
 	backupParams := mysqlctl.BackupParams{
-		Cnf:          tm.Cnf,
-		Mysqld:       tm.MysqlDaemon,
-		Logger:       l,
-		Concurrency:  concurrency,
-		HookExtraEnv: tm.hookExtraEnv(),
-		TopoServer:   tm.TopoServer,
-		Keyspace:     tablet.Keyspace,
-		Shard:        tablet.Shard,
-		TabletAlias:  topoproto.TabletAliasString(tablet.Alias),
-		BackupTime:   time.Now(),
+		Cnf:               tm.Cnf,
+		Mysqld:            tm.MysqlDaemon,
+		Logger:            l,
+		Concurrency:       int(req.Concurrency),
+		HookExtraEnv:      tm.hookExtraEnv(),
+		TopoServer:        tm.TopoServer,
+		Keyspace:          tablet.Keyspace,
+		Shard:             tablet.Shard,
+		TabletAlias:       topoproto.TabletAliasString(tablet.Alias),
+		BackupTime:        time.Now(),
+		Incremental:       req.Incremental,
+		CompressionEngine: req.CompressionEngine,
 	}
 
 	returnErr := mysqlctl.Backup(ctx, backupParams)
@@ -151,8 +155,10 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 }
 
 // RestoreFromBackup deletes all local data and then restores the data from the latest backup [at
-// or before the backupTime value if specified]
-func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger, backupTime time.Time) error {
+// or before the backupTime value if specified]. If a restore position or timestamp is specified,
+// the archived binary logs are replayed up to it. If a backup shard is specified, the backups of
+// that shard of the keyspace are restored instead of those of the shard of the tablet.
+func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger, request *tabletmanagerdatapb.RestoreFromBackupRequest) error {
 	if err := tm.lock(ctx); err != nil {
 		return err
 	}
@@ -166,11 +172,16 @@ func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.L
 		return fmt.Errorf("type PRIMARY cannot restore from backup, if you really need to do this, restart vttablet in replica mode")
 	}
 
+	restoreToPos, err := mysql.DecodePosition(request.RestoreToPos)
+	if err != nil {
+		return vterrors.Wrapf(err, "unable to parse the restore position value provided of '%s'", request.RestoreToPos)
+	}
+
 	// create the loggers: tee to console and source
 	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
 
 	// now we can run restore
-	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */, backupTime)
+	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */, logutil.ProtoToTime(request.BackupTime), restoreToPos, logutil.ProtoToTime(request.RestoreToTimestamp), request.BackupShard)
 
 	// re-run health check to be sure to capture any replication delay
 	tm.QueryServiceControl.BroadcastHealth()
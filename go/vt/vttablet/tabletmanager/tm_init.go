@@ -47,6 +47,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/netutil"
 	"vitess.io/vitess/go/stats"
@@ -63,6 +64,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 
@@ -131,6 +133,7 @@ type TabletManager struct {
 	QueryServiceControl tabletserver.Controller
 	UpdateStream        binlog.UpdateStreamControl
 	VREngine            *vreplication.Engine
+	VDiffEngine         *vdiff.Engine
 
 	// MetadataManager manages the local metadata tables for a tablet. It
 	// exists, and is exported, to support swapping a nil pointer in test code,
@@ -179,6 +182,13 @@ type TabletManager struct {
 	// _shardSyncCancel is the function to stop the background shard sync goroutine.
 	_shardSyncCancel context.CancelFunc
 
+	// _binlogBackupDone is a channel for waiting until the binlog backup
+	// goroutine has really finished after _binlogBackupCancel was called.
+	_binlogBackupDone chan struct{}
+
+	// _binlogBackupCancel is the function to stop the background binlog backup goroutine.
+	_binlogBackupCancel context.CancelFunc
+
 	// _rebuildKeyspaceDone is a channel for waiting until the current keyspace
 	// has been rebuilt
 	_rebuildKeyspaceDone chan struct{}
@@ -376,9 +386,15 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval ti
 		servenv.OnTerm(tm.VREngine.Close)
 	}
 
+	if tm.VDiffEngine != nil {
+		tm.VDiffEngine.InitDBConfig(tm.DBConfigs)
+		servenv.OnTerm(tm.VDiffEngine.Close)
+	}
+
 	// The following initializations don't need to be done
 	// in any specific order.
 	tm.startShardSync()
+	tm.startBinlogBackup()
 	tm.exportStats()
 	orc, err := newOrcClient()
 	if err != nil {
@@ -412,6 +428,7 @@ func (tm *TabletManager) Close() {
 	// rather than registering it as an OnTerm hook so the shard sync loop keeps
 	// running during lame duck.
 	tm.stopShardSync()
+	tm.stopBinlogBackup()
 	tm.stopRebuildKeyspace()
 
 	// cleanup initialized fields in the tablet entry
@@ -443,12 +460,17 @@ func (tm *TabletManager) Stop() {
 	// Stop the shard sync loop and wait for it to exit. This needs to be done
 	// here in addition to in Close() because tests do not call Close().
 	tm.stopShardSync()
+	tm.stopBinlogBackup()
 	tm.stopRebuildKeyspace()
 
 	if tm.UpdateStream != nil {
 		tm.UpdateStream.Disable()
 	}
 
+	if tm.VDiffEngine != nil {
+		tm.VDiffEngine.Close()
+	}
+
 	if tm.VREngine != nil {
 		tm.VREngine.Close()
 	}
@@ -736,9 +758,28 @@ func (tm *TabletManager) handleRestore(ctx context.Context) (bool, error) {
 				}
 			}
 
+			// If a restore target was specified, then we replay the archived
+			// binary logs on top of the backup
+			var restoreToPos mysql.Position
+			if *restoreToPosStr != "" {
+				var err error
+				restoreToPos, err = mysql.DecodePosition(*restoreToPosStr)
+				if err != nil {
+					log.Exitf("RestoreFromBackup failed: unable to parse the restore position value provided of '%s': %v", *restoreToPosStr, err)
+				}
+			}
+			restoreToTimestamp := time.Time{}
+			if *restoreToTimestampStr != "" {
+				var err error
+				restoreToTimestamp, err = time.Parse(mysqlctl.BackupTimestampFormat, *restoreToTimestampStr)
+				if err != nil {
+					log.Exitf("RestoreFromBackup failed: unable to parse the restore timestamp value provided of '%s'", *restoreToTimestampStr)
+				}
+			}
+
 			// restoreFromBackup will just be a regular action
 			// (same as if it was triggered remotely)
-			if err := tm.RestoreData(ctx, logutil.NewConsoleLogger(), *waitForBackupInterval, false /* deleteBeforeRestore */, backupTime); err != nil {
+			if err := tm.RestoreData(ctx, logutil.NewConsoleLogger(), *waitForBackupInterval, false /* deleteBeforeRestore */, backupTime, restoreToPos, restoreToTimestamp); err != nil {
 				log.Exitf("RestoreFromBackup failed: %v", err)
 			}
 		}()
@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The actions of PerformVDiffAction.
+const (
+	CreateAction = "create"
+	ShowAction   = "show"
+	StopAction   = "stop"
+	ResumeAction = "resume"
+	DeleteAction = "delete"
+)
+
+// The action arguments that select vdiffs other than by uuid.
+const (
+	LastActionArg = "last"
+	AllActionArg  = "all"
+)
+
+// PerformVDiffAction performs an action on the vdiffs of a workflow.
+// A create action starts a new vdiff, with the uuid of the request if it
+// has one, so that the vdiffs of all the shards of the target share it.
+// The other actions apply to the vdiffs that the action arg selects: a
+// uuid, "last" for the most recent vdiff, or "all".
+func (vde *Engine) PerformVDiffAction(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if err := vde.checkOpen(); err != nil {
+		return nil, err
+	}
+
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+
+	switch req.Action {
+	case CreateAction:
+		return vde.createVDiff(ctx, dbClient, req)
+	case ShowAction:
+		return vde.showVDiff(ctx, dbClient, req)
+	case StopAction, ResumeAction, DeleteAction:
+		rows, err := vde.getVDiffs(ctx, dbClient, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			if err := vde.performRowAction(ctx, dbClient, req.Action, row); err != nil {
+				return nil, err
+			}
+		}
+		return &tabletmanagerdatapb.VDiffResponse{}, nil
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vdiff action %v not supported", req.Action)
+	}
+}
+
+func (vde *Engine) createVDiff(ctx context.Context, dbClient binlogplayer.DBClient, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	vdiffUUID := req.VdiffUuid
+	if vdiffUUID == "" {
+		vdiffUUID = uuid.New().String()
+	}
+	options := req.Options
+	if options == nil {
+		options = &tabletmanagerdatapb.VDiffOptions{}
+	}
+	optionsJSON, err := protojson.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlGetWorkflowStreams, encodeString(vde.dbName), encodeString(req.Workflow)), 10000)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "workflow %v not found in database %v", req.Workflow, vde.dbName)
+	}
+
+	query := fmt.Sprintf(sqlNewVDiff, encodeString(vdiffUUID), encodeString(req.Workflow), encodeString(vde.thisTablet.Keyspace),
+		encodeString(vde.thisTablet.Shard), encodeString(vde.dbName), encodeString(PendingState), encodeString(string(optionsJSON)))
+	qr, err = execWithDDL(ctx, dbClient, query)
+	if err != nil {
+		return nil, err
+	}
+	id := int64(qr.InsertID)
+	qr, err = dbClient.ExecuteFetch(fmt.Sprintf(sqlGetVDiffByID, id), 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vdiff %v not found after creating it", vdiffUUID)
+	}
+	if err := vde.startControllerLocked(sqltypes.ToNamedResult(qr).Row()); err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.VDiffResponse{Id: id, VdiffUuid: vdiffUUID}, nil
+}
+
+// showVDiff returns the list of the vdiffs of the workflow for "all", and
+// the per table report of the selected vdiff otherwise.
+func (vde *Engine) showVDiff(ctx context.Context, dbClient binlogplayer.DBClient, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	if req.ActionArg == AllActionArg {
+		qr, err := withDDL.ExecIgnore(ctx, fmt.Sprintf(sqlGetAllVDiffs, encodeString(vde.dbName), encodeString(req.Keyspace), encodeString(req.Workflow)), dbClient.ExecuteFetch)
+		if err != nil {
+			return nil, err
+		}
+		return &tabletmanagerdatapb.VDiffResponse{Output: sqltypes.ResultToProto3(qr)}, nil
+	}
+	rows, err := vde.getVDiffs(ctx, dbClient, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		// This shard has no such vdiff. The caller decides whether the other
+		// shards have it.
+		return &tabletmanagerdatapb.VDiffResponse{Output: sqltypes.ResultToProto3(&sqltypes.Result{})}, nil
+	}
+	id, err := rows[0].ToInt64("id")
+	if err != nil {
+		return nil, err
+	}
+	qr, err := withDDL.ExecIgnore(ctx, fmt.Sprintf(sqlGetVDiffReport, id), dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.VDiffResponse{Id: id, VdiffUuid: rows[0].AsString("vdiff_uuid", ""), Output: sqltypes.ResultToProto3(qr)}, nil
+}
+
+// getVDiffs returns the vdiffs of the workflow that the action arg selects.
+func (vde *Engine) getVDiffs(ctx context.Context, dbClient binlogplayer.DBClient, req *tabletmanagerdatapb.VDiffRequest) ([]sqltypes.RowNamedValues, error) {
+	var query string
+	switch req.ActionArg {
+	case "":
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vdiff action %v needs a vdiff uuid, %q or %q", req.Action, LastActionArg, AllActionArg)
+	case LastActionArg:
+		query = fmt.Sprintf(sqlGetLastVDiff, encodeString(vde.dbName), encodeString(req.Keyspace), encodeString(req.Workflow))
+	case AllActionArg:
+		query = fmt.Sprintf(sqlGetAllVDiffs, encodeString(vde.dbName), encodeString(req.Keyspace), encodeString(req.Workflow))
+	default:
+		query = fmt.Sprintf(sqlGetVDiffByUUID, encodeString(req.ActionArg), encodeString(vde.dbName))
+	}
+	qr, err := withDDL.ExecIgnore(ctx, query, dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.ToNamedResult(qr).Rows, nil
+}
+
+// performRowAction stops, resumes or deletes one vdiff.
+func (vde *Engine) performRowAction(ctx context.Context, dbClient binlogplayer.DBClient, action string, row sqltypes.RowNamedValues) error {
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return err
+	}
+	switch action {
+	case StopAction:
+		vde.stopControllerLocked(id)
+		_, err := execWithDDL(ctx, dbClient, fmt.Sprintf(sqlStopVDiff, id))
+		return err
+	case ResumeAction:
+		if state := row.AsString("state", ""); state == CompletedState {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %v is already completed", row.AsString("vdiff_uuid", ""))
+		}
+		vde.stopControllerLocked(id)
+		if _, err := execWithDDL(ctx, dbClient, fmt.Sprintf(sqlResumeVDiff, id)); err != nil {
+			return err
+		}
+		qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlGetVDiffByID, id), 1)
+		if err != nil {
+			return err
+		}
+		if len(qr.Rows) == 0 {
+			return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "vdiff %v not found", row.AsString("vdiff_uuid", ""))
+		}
+		return vde.startControllerLocked(sqltypes.ToNamedResult(qr).Row())
+	case DeleteAction:
+		vde.stopControllerLocked(id)
+		if _, err := execWithDDL(ctx, dbClient, fmt.Sprintf(sqlDeleteVDiffTables, id)); err != nil {
+			return err
+		}
+		_, err := execWithDDL(ctx, dbClient, fmt.Sprintf(sqlDeleteVDiff, id))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const defaultTabletTypes = "primary,replica,rdonly"
+
+// controller runs one vdiff, table by table, until it completes, fails or
+// is stopped.
+type controller struct {
+	id       int64
+	uuid     string
+	workflow string
+	keyspace string
+	options  *tabletmanagerdatapb.VDiffOptions
+	vde      *Engine
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// workflowStream is one of the vreplication streams of the workflow.
+type workflowStream struct {
+	id  int
+	bls *binlogdatapb.BinlogSource
+	pos mysql.Position
+}
+
+func newController(ctx context.Context, row sqltypes.RowNamedValues, vde *Engine) (*controller, error) {
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return nil, err
+	}
+	ct := &controller{
+		id:       id,
+		uuid:     row.AsString("vdiff_uuid", ""),
+		workflow: row.AsString("workflow", ""),
+		keyspace: row.AsString("keyspace", ""),
+		options:  &tabletmanagerdatapb.VDiffOptions{},
+		vde:      vde,
+		done:     make(chan struct{}),
+	}
+	if options := row.AsString("options", ""); options != "" {
+		if err := protojson.Unmarshal([]byte(options), ct.options); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid options of vdiff %v", ct.uuid)
+		}
+	}
+	ctx, ct.cancel = context.WithCancel(ctx)
+	go ct.run(ctx)
+	return ct, nil
+}
+
+// Stop stops the controller, and waits for it to exit.
+func (ct *controller) Stop() {
+	ct.cancel()
+	<-ct.done
+}
+
+func (ct *controller) run(ctx context.Context) {
+	defer close(ct.done)
+
+	dbClient := ct.vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		log.Errorf("vdiff %v: can't connect to the database: %v", ct.uuid, err)
+		return
+	}
+	defer dbClient.Close()
+
+	err := ct.diff(ctx, dbClient)
+	switch {
+	case err == nil:
+		_, err = execWithDDL(ctx, dbClient, fmt.Sprintf(sqlCompleteVDiff, ct.id))
+		if err != nil {
+			log.Errorf("vdiff %v: can't mark it completed: %v", ct.uuid, err)
+		}
+		log.Infof("vdiff %v completed", ct.uuid)
+	case ctx.Err() != nil:
+		// The vdiff was stopped, or the engine is closing. Its state is
+		// left as is, to be resumed later.
+		log.Infof("vdiff %v interrupted: %v", ct.uuid, err)
+	default:
+		log.Errorf("vdiff %v failed: %v", ct.uuid, err)
+		if _, err := execWithDDL(ctx, dbClient, fmt.Sprintf(sqlFailVDiff, encodeString(truncateError(err)), ct.id)); err != nil {
+			log.Errorf("vdiff %v: can't record its error: %v", ct.uuid, err)
+		}
+	}
+}
+
+// diff diffs the tables of the vdiff that aren't completed yet.
+func (ct *controller) diff(ctx context.Context, dbClient binlogplayer.DBClient) error {
+	if _, err := execWithDDL(ctx, dbClient, fmt.Sprintf(sqlStartVDiff, ct.id)); err != nil {
+		return err
+	}
+	streams, err := ct.readStreams(dbClient)
+	if err != nil {
+		return err
+	}
+	plans, err := ct.buildPlans(ctx, streams[0].bls.Filter)
+	if err != nil {
+		return err
+	}
+	tables := make([]string, 0, len(plans))
+	for table := range plans {
+		tables = append(tables, table)
+		if _, err := execWithDDL(ctx, dbClient, fmt.Sprintf(sqlNewVDiffTable, ct.id, encodeString(table))); err != nil {
+			return err
+		}
+	}
+	sort.Strings(tables)
+
+	qr, err := execWithDDL(ctx, dbClient, fmt.Sprintf(sqlGetVDiffTables, ct.id))
+	if err != nil {
+		return err
+	}
+	tableRows := make(map[string]sqltypes.RowNamedValues)
+	for _, row := range sqltypes.ToNamedResult(qr).Rows {
+		tableRows[row.AsString("table_name", "")] = row
+	}
+
+	for _, table := range tables {
+		row := tableRows[table]
+		if row.AsString("state", "") == CompletedState {
+			continue
+		}
+		td, err := newTableDiffer(ct, plans[table], row)
+		if err != nil {
+			return err
+		}
+		if err := td.diff(ctx, dbClient); err != nil {
+			return vterrors.Wrapf(err, "table %v", table)
+		}
+	}
+	return nil
+}
+
+// readStreams reads the vreplication streams of the workflow, with the
+// position they replicated their source up to.
+func (ct *controller) readStreams(dbClient binlogplayer.DBClient) ([]*workflowStream, error) {
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlGetWorkflowStreams, encodeString(ct.vde.dbName), encodeString(ct.workflow)), 10000)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "workflow %v not found in database %v", ct.workflow, ct.vde.dbName)
+	}
+	var streams []*workflowStream
+	for _, row := range qr.Rows {
+		id, err := evalRowInt(row[0])
+		if err != nil {
+			return nil, err
+		}
+		blsBytes, err := row[1].ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		var bls binlogdatapb.BinlogSource
+		if err := prototext.Unmarshal(blsBytes, &bls); err != nil {
+			return nil, err
+		}
+		if bls.ExternalCluster != "" {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "vdiff does not support workflows from an external cluster: %v", bls.ExternalCluster)
+		}
+		pos, err := binlogplayer.DecodePosition(row[2].ToString())
+		if err != nil {
+			return nil, err
+		}
+		streams = append(streams, &workflowStream{id: id, bls: &bls, pos: pos})
+	}
+	return streams, nil
+}
+
+// buildPlans builds the plans of the tables to diff, from the filter of
+// the workflow and the schema of the target.
+func (ct *controller) buildPlans(ctx context.Context, filter *binlogdatapb.Filter) (map[string]*tablePlan, error) {
+	var tables []string
+	if t := strings.TrimSpace(ct.options.Tables); t != "" {
+		tables = strings.Split(t, ",")
+	}
+	schm, err := ct.vde.mysqld.GetSchema(ctx, ct.vde.dbName, nil, nil, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "GetSchema")
+	}
+	return buildTablePlans(filter, schm, tables)
+}
+
+// newSourceTabletPicker returns the picker of the tablets to stream a
+// source shard from.
+func (ct *controller) newSourceTabletPicker(ctx context.Context, keyspace, shard string) (*discovery.TabletPicker, error) {
+	var cells []string
+	if ct.options.SourceCell != "" {
+		cells = strings.Split(ct.options.SourceCell, ",")
+	} else {
+		var err error
+		cells, err = ct.vde.ts.GetCellInfoNames(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tabletTypes := ct.options.TabletTypes
+	if tabletTypes == "" {
+		tabletTypes = defaultTabletTypes
+	}
+	return discovery.NewTabletPicker(ct.vde.ts, cells, keyspace, shard, tabletTypes)
+}
+
+func evalRowInt(v sqltypes.Value) (int, error) {
+	i, err := v.ToInt64()
+	return int(i), err
+}
+
+// truncateError returns the message of an error, truncated to fit in
+// _vt.vdiff.
+func truncateError(err error) string {
+	msg := err.Error()
+	if len(msg) > 1000 {
+		msg = msg[:1000]
+	}
+	return msg
+}
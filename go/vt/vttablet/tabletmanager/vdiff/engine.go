@@ -0,0 +1,249 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// vdiff states, in _vt.vdiff and _vt.vdiff_table.
+const (
+	PendingState   = "pending"
+	StartedState   = "started"
+	StoppedState   = "stopped"
+	CompletedState = "completed"
+	ErrorState     = "error"
+)
+
+// openRetryInterval is how often the Engine retries to load the vdiffs to
+// resume. It can be changed to a smaller value for tests.
+var openRetryInterval = 5 * time.Second
+
+// vreplicationEngine is the part of the vreplication engine that the
+// vdiffs use to synchronize the streams of their workflow.
+type vreplicationEngine interface {
+	ExecWithDBA(query string) (*sqltypes.Result, error)
+	WaitForPos(ctx context.Context, id int, pos string) error
+}
+
+// Engine runs the vdiffs of the workflows that replicate into this tablet,
+// while it is a primary. A vdiff is created, stopped, resumed and deleted
+// through PerformVDiffAction. Its progress is checkpointed in the _vt
+// database, so the Engine resumes the vdiffs that were running when it
+// opens.
+type Engine struct {
+	// mu synchronizes isOpen, controllers, ctx and vre.
+	mu          sync.Mutex
+	isOpen      bool
+	controllers map[int64]*controller
+
+	// ctx is the root context for all controllers.
+	ctx context.Context
+	// cancel will cancel the root context, thereby all controllers.
+	cancel context.CancelFunc
+
+	ts              *topo.Server
+	thisTablet      *topodatapb.Tablet
+	mysqld          mysqlctl.MysqlDaemon
+	tmc             tmclient.TabletManagerClient
+	dbClientFactory func() binlogplayer.DBClient
+	dbName          string
+	vre             vreplicationEngine
+}
+
+// NewEngine creates a new Engine.
+// A nil ts means that the Engine is disabled.
+func NewEngine(ts *topo.Server, tablet *topodatapb.Tablet, mysqld mysqlctl.MysqlDaemon) *Engine {
+	return &Engine{
+		controllers: make(map[int64]*controller),
+		ts:          ts,
+		thisTablet:  tablet,
+		mysqld:      mysqld,
+		tmc:         tmclient.NewTabletManagerClient(),
+	}
+}
+
+// NewTestEngine creates a new Engine for testing.
+func NewTestEngine(ts *topo.Server, tablet *topodatapb.Tablet, mysqld mysqlctl.MysqlDaemon, tmc tmclient.TabletManagerClient, dbClientFactory func() binlogplayer.DBClient, dbName string) *Engine {
+	return &Engine{
+		controllers:     make(map[int64]*controller),
+		ts:              ts,
+		thisTablet:      tablet,
+		mysqld:          mysqld,
+		tmc:             tmc,
+		dbClientFactory: dbClientFactory,
+		dbName:          dbName,
+	}
+}
+
+// InitDBConfig should be invoked after the db name is computed.
+func (vde *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
+	// If we're already initilized, it's a test engine. Ignore the call.
+	if vde.dbClientFactory != nil {
+		return
+	}
+	vde.dbClientFactory = func() binlogplayer.DBClient {
+		return binlogplayer.NewDBClient(dbcfgs.DbaWithDB())
+	}
+	vde.dbName = dbcfgs.DBName
+}
+
+// Open starts the Engine, and resumes the vdiffs that were running. vre is
+// the vreplication engine of the tablet, which runs the streams of the
+// workflows.
+func (vde *Engine) Open(ctx context.Context, vre vreplicationEngine) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+
+	if vde.ts == nil || vde.isOpen {
+		return
+	}
+	log.Infof("VDiff Engine: opening")
+
+	vde.ctx, vde.cancel = context.WithCancel(ctx)
+	vde.vre = vre
+	vde.isOpen = true
+	go vde.resumeVDiffs(vde.ctx)
+}
+
+// resumeVDiffs starts the controllers of the vdiffs that were running,
+// retrying until it succeeds or the Engine is closed.
+func (vde *Engine) resumeVDiffs(ctx context.Context) {
+	for {
+		err := vde.initControllers(ctx)
+		if err == nil {
+			return
+		}
+		log.Errorf("Error resuming vdiffs: %v, will keep retrying.", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(openRetryInterval):
+		}
+	}
+}
+
+func (vde *Engine) initControllers(ctx context.Context) error {
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return err
+	}
+	defer dbClient.Close()
+
+	qr, err := withDDL.ExecIgnore(ctx, fmt.Sprintf(sqlGetVDiffsToRun, encodeString(vde.dbName)), dbClient.ExecuteFetch)
+	if err != nil {
+		return err
+	}
+
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if ctx.Err() != nil {
+		return nil
+	}
+	for _, row := range sqltypes.ToNamedResult(qr).Rows {
+		if err := vde.startControllerLocked(row); err != nil {
+			log.Errorf("Controller could not be initialized for vdiff: %v: %v", row, err)
+		}
+	}
+	return nil
+}
+
+// startControllerLocked starts the controller of a vdiff, replacing any
+// that was already running. It must be called with the lock held.
+func (vde *Engine) startControllerLocked(row sqltypes.RowNamedValues) error {
+	ct, err := newController(vde.ctx, row, vde)
+	if err != nil {
+		return err
+	}
+	if existing := vde.controllers[ct.id]; existing != nil {
+		existing.Stop()
+	}
+	vde.controllers[ct.id] = ct
+	return nil
+}
+
+// stopControllerLocked stops the controller of a vdiff, if it's running.
+// It must be called with the lock held.
+func (vde *Engine) stopControllerLocked(id int64) {
+	if ct := vde.controllers[id]; ct != nil {
+		ct.Stop()
+		delete(vde.controllers, id)
+	}
+}
+
+// IsOpen returns true if Engine is open.
+func (vde *Engine) IsOpen() bool {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	return vde.isOpen
+}
+
+// Close stops the Engine. The vdiffs that were running are left as is, to
+// be resumed when the Engine opens again, here or on the next primary.
+func (vde *Engine) Close() {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+
+	if !vde.isOpen {
+		return
+	}
+
+	vde.cancel()
+	// We still have to wait for all controllers to stop.
+	for _, ct := range vde.controllers {
+		ct.Stop()
+	}
+	vde.controllers = make(map[int64]*controller)
+	vde.vre = nil
+	vde.isOpen = false
+	log.Infof("VDiff Engine: closed")
+}
+
+// execWithDDL executes a query on the _vt tables of the vdiffs, creating
+// them if needed.
+func execWithDDL(ctx context.Context, dbClient binlogplayer.DBClient, query string) (*sqltypes.Result, error) {
+	return withDDL.Exec(ctx, query, dbClient.ExecuteFetch, dbClient.ExecuteFetch)
+}
+
+func (vde *Engine) checkOpen() error {
+	if !vde.isOpen {
+		return vterrors.New(vtrpcpb.Code_UNAVAILABLE, "vdiff engine is closed: vdiffs only run on primary tablets")
+	}
+	return nil
+}
+
+func encodeString(in string) string {
+	var buf strings.Builder
+	sqltypes.NewVarChar(in).EncodeSQL(&buf)
+	return buf.String()
+}
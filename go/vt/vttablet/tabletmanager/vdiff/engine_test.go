@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// newTestOpenEngine returns an Engine that behaves as open, without
+// resuming any vdiff.
+func newTestOpenEngine(dbClient binlogplayer.DBClient) *Engine {
+	tablet := &topodatapb.Tablet{Keyspace: "ks", Shard: "0"}
+	vde := NewTestEngine(nil, tablet, nil, nil, func() binlogplayer.DBClient { return dbClient }, "vt_ks")
+	vde.isOpen = true
+	return vde
+}
+
+var vdiffRow = sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+	"id|vdiff_uuid|workflow|keyspace|shard|state",
+	"int64|varchar|varbinary|varbinary|varchar|varbinary"),
+	"1|uuid1|wf|ks|0|stopped",
+)
+
+func TestPerformVDiffActionClosed(t *testing.T) {
+	vde := NewTestEngine(nil, &topodatapb.Tablet{}, nil, nil, nil, "vt_ks")
+	_, err := vde.PerformVDiffAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Action: ShowAction, ActionArg: LastActionArg})
+	require.EqualError(t, err, "vdiff engine is closed: vdiffs only run on primary tablets")
+}
+
+func TestPerformVDiffAction(t *testing.T) {
+	reportResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"vdiff_uuid|state|table_name|table_state|rows_compared|mismatch",
+		"varchar|varbinary|varbinary|varbinary|int64|int8"),
+		"uuid1|stopped|t1|completed|10|0",
+	)
+	testcases := []struct {
+		req     *tabletmanagerdatapb.VDiffRequest
+		queries []string
+		results []*sqltypes.Result
+		want    *tabletmanagerdatapb.VDiffResponse
+		err     string
+	}{{
+		req: &tabletmanagerdatapb.VDiffRequest{Keyspace: "ks", Workflow: "wf", Action: ShowAction, ActionArg: LastActionArg},
+		queries: []string{
+			"select * from _vt.vdiff where db_name = 'vt_ks' and keyspace = 'ks' and workflow = 'wf' order by id desc limit 1",
+			"select v.vdiff_uuid, v.workflow, v.keyspace, v.shard, v.state, v.created_at, v.started_at, v.completed_at, v.last_error, " +
+				"vt.table_name, vt.state as table_state, vt.rows_compared, vt.mismatch, vt.report " +
+				"from _vt.vdiff as v left join _vt.vdiff_table as vt on v.id = vt.vdiff_id where v.id = 1 order by vt.table_name",
+		},
+		results: []*sqltypes.Result{vdiffRow, reportResult},
+		want:    &tabletmanagerdatapb.VDiffResponse{Id: 1, VdiffUuid: "uuid1", Output: sqltypes.ResultToProto3(reportResult)},
+	}, {
+		req: &tabletmanagerdatapb.VDiffRequest{Keyspace: "ks", Workflow: "wf", Action: ShowAction, ActionArg: "uuid2"},
+		queries: []string{
+			"select * from _vt.vdiff where vdiff_uuid = 'uuid2' and db_name = 'vt_ks'",
+		},
+		results: []*sqltypes.Result{{}},
+		want:    &tabletmanagerdatapb.VDiffResponse{Output: sqltypes.ResultToProto3(&sqltypes.Result{})},
+	}, {
+		req: &tabletmanagerdatapb.VDiffRequest{Keyspace: "ks", Workflow: "wf", Action: StopAction, ActionArg: "uuid1"},
+		queries: []string{
+			"select * from _vt.vdiff where vdiff_uuid = 'uuid1' and db_name = 'vt_ks'",
+			"update _vt.vdiff set state = 'stopped' where id = 1 and state in ('pending', 'started')",
+		},
+		results: []*sqltypes.Result{vdiffRow, {}},
+		want:    &tabletmanagerdatapb.VDiffResponse{},
+	}, {
+		req: &tabletmanagerdatapb.VDiffRequest{Keyspace: "ks", Workflow: "wf", Action: DeleteAction, ActionArg: AllActionArg},
+		queries: []string{
+			"select * from _vt.vdiff where db_name = 'vt_ks' and keyspace = 'ks' and workflow = 'wf' order by id desc",
+			"delete from _vt.vdiff_table where vdiff_id = 1",
+			"delete from _vt.vdiff where id = 1",
+		},
+		results: []*sqltypes.Result{vdiffRow, {}, {}},
+		want:    &tabletmanagerdatapb.VDiffResponse{},
+	}, {
+		req:     &tabletmanagerdatapb.VDiffRequest{Keyspace: "ks", Workflow: "wf", Action: ResumeAction},
+		queries: []string{},
+		err:     "vdiff action resume needs a vdiff uuid, \"last\" or \"all\"",
+	}, {
+		req: &tabletmanagerdatapb.VDiffRequest{Keyspace: "ks", Workflow: "wf", Action: CreateAction},
+		queries: []string{
+			"select id, source, pos from _vt.vreplication where db_name = 'vt_ks' and workflow = 'wf'",
+		},
+		results: []*sqltypes.Result{{}},
+		err:     "workflow wf not found in database vt_ks",
+	}, {
+		req: &tabletmanagerdatapb.VDiffRequest{Keyspace: "ks", Workflow: "wf", Action: "diff"},
+		err: "vdiff action diff not supported",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.req.Action+" "+tcase.req.ActionArg, func(t *testing.T) {
+			dbClient := binlogplayer.NewMockDbaClient(t)
+			for i, query := range tcase.queries {
+				dbClient.ExpectRequest(query, tcase.results[i], nil)
+			}
+			vde := newTestOpenEngine(dbClient)
+			resp, err := vde.PerformVDiffAction(context.Background(), tcase.req)
+			if tcase.err != "" {
+				require.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want.Id, resp.Id)
+			assert.Equal(t, tcase.want.VdiffUuid, resp.VdiffUuid)
+			assert.Equal(t, sqltypes.Proto3ToResult(tcase.want.Output), sqltypes.Proto3ToResult(resp.Output))
+			if len(tcase.queries) > 0 {
+				dbClient.Wait()
+			}
+		})
+	}
+}
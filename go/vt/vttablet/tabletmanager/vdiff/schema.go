@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import "vitess.io/vitess/go/vt/withddl"
+
+// A vdiff is recorded in _vt.vdiff, and the progress of each of its tables
+// in _vt.vdiff_table. Both tables are replicated, so a vdiff resumes on the
+// new primary after a reparent.
+
+const (
+	sqlCreateVDiffTable = `create table if not exists _vt.vdiff (
+  id bigint(20) not null auto_increment,
+  vdiff_uuid varchar(64) not null,
+  workflow varbinary(1024) not null,
+  keyspace varbinary(256) not null,
+  shard varchar(255) not null,
+  db_name varbinary(1024) not null,
+  state varbinary(64) not null,
+  options json,
+  created_at timestamp not null default current_timestamp,
+  started_at timestamp null default null,
+  completed_at timestamp null default null,
+  last_error varbinary(1024) not null default '',
+  primary key (id),
+  unique key uuid_idx (vdiff_uuid),
+  key state_idx (state),
+  key ks_wf_idx (keyspace(64), workflow(64)))`
+
+	sqlCreateVDiffTableTable = `create table if not exists _vt.vdiff_table (
+  vdiff_id bigint(20) not null,
+  table_name varbinary(128) not null,
+  state varbinary(64) not null,
+  lastpk varbinary(2000),
+  rows_compared bigint(20) not null default 0,
+  mismatch bool not null default false,
+  report json,
+  created_at timestamp not null default current_timestamp,
+  updated_at timestamp not null default current_timestamp on update current_timestamp,
+  primary key (vdiff_id, table_name))`
+)
+
+const (
+	sqlNewVDiff               = "insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values(%s, %s, %s, %s, %s, %s, %s)"
+	sqlGetVDiffByUUID         = "select * from _vt.vdiff where vdiff_uuid = %s and db_name = %s"
+	sqlGetVDiffByID           = "select * from _vt.vdiff where id = %d"
+	sqlGetLastVDiff           = "select * from _vt.vdiff where db_name = %s and keyspace = %s and workflow = %s order by id desc limit 1"
+	sqlGetAllVDiffs           = "select * from _vt.vdiff where db_name = %s and keyspace = %s and workflow = %s order by id desc"
+	sqlGetVDiffsToRun         = "select * from _vt.vdiff where db_name = %s and state in ('pending', 'started')"
+	sqlStartVDiff             = "update _vt.vdiff set state = 'started', started_at = ifnull(started_at, utc_timestamp()), last_error = '' where id = %d"
+	sqlResumeVDiff            = "update _vt.vdiff set state = 'pending', completed_at = null, last_error = '' where id = %d"
+	sqlStopVDiff              = "update _vt.vdiff set state = 'stopped' where id = %d and state in ('pending', 'started')"
+	sqlCompleteVDiff          = "update _vt.vdiff set state = 'completed', completed_at = utc_timestamp() where id = %d"
+	sqlFailVDiff              = "update _vt.vdiff set state = 'error', last_error = %s where id = %d"
+	sqlDeleteVDiff            = "delete from _vt.vdiff where id = %d"
+	sqlGetVDiffTables         = "select * from _vt.vdiff_table where vdiff_id = %d order by table_name"
+	sqlNewVDiffTable          = "insert ignore into _vt.vdiff_table(vdiff_id, table_name, state) values(%d, %s, 'pending')"
+	sqlUpdateVDiffTableState  = "update _vt.vdiff_table set state = %s where vdiff_id = %d and table_name = %s"
+	sqlUpdateVDiffTableReport = "update _vt.vdiff_table set lastpk = %s, rows_compared = %d, mismatch = %t, report = %s where vdiff_id = %d and table_name = %s"
+	sqlDeleteVDiffTables      = "delete from _vt.vdiff_table where vdiff_id = %d"
+	sqlGetVDiffReport         = "select v.vdiff_uuid, v.workflow, v.keyspace, v.shard, v.state, v.created_at, v.started_at, v.completed_at, v.last_error, " +
+		"vt.table_name, vt.state as table_state, vt.rows_compared, vt.mismatch, vt.report " +
+		"from _vt.vdiff as v left join _vt.vdiff_table as vt on v.id = vt.vdiff_id where v.id = %d order by vt.table_name"
+
+	sqlGetWorkflowStreams    = "select id, source, pos from _vt.vreplication where db_name = %s and workflow = %s"
+	sqlStopWorkflowStreams   = "update _vt.vreplication set state = 'Stopped', message = 'for vdiff' where db_name = %s and workflow = %s"
+	sqlSyncWorkflowStream    = "update _vt.vreplication set state = 'Running', stop_pos = %s, message = 'synchronizing for vdiff' where id = %d"
+	sqlRestartWorkflowStream = "update _vt.vreplication set state = 'Running', stop_pos = '', message = '' where db_name = %s and workflow = %s"
+)
+
+var withDDL = withddl.New([]string{
+	sqlCreateVDiffTable,
+	sqlCreateVDiffTableTable,
+})
@@ -0,0 +1,691 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// maxSampleRows is the number of rows a report keeps as samples of each
+// kind of difference.
+const maxSampleRows = 10
+
+// defaultWaitTime is how long the streams of the workflow have to catch
+// up with the sources, if the vdiff doesn't say.
+const defaultWaitTime = 30 * time.Second
+
+// checkpointInterval is how often the progress of a table is saved. It can
+// be changed to a smaller value for tests.
+var checkpointInterval = 10 * time.Second
+
+// DiffReport is the summary of differences for one table. It is saved in
+// _vt.vdiff_table as the table is compared, so it accumulates the
+// differences found across the runs of a vdiff.
+type DiffReport struct {
+	TableName             string
+	ProcessedRows         int64
+	MatchingRows          int64
+	MismatchedRows        int64
+	ExtraRowsSource       int64
+	ExtraRowsSourceSample []*RowDiff `json:",omitempty"`
+	ExtraRowsTarget       int64
+	ExtraRowsTargetSample []*RowDiff      `json:",omitempty"`
+	MismatchedRowsSample  []*DiffMismatch `json:",omitempty"`
+}
+
+// HasDifferences returns true if the report found any difference.
+func (dr *DiffReport) HasDifferences() bool {
+	return dr.MismatchedRows != 0 || dr.ExtraRowsSource != 0 || dr.ExtraRowsTarget != 0
+}
+
+// DiffMismatch is a sample of row diffs between source and target.
+type DiffMismatch struct {
+	Source *RowDiff
+	Target *RowDiff
+}
+
+// RowDiff is a row that didn't match as part of the comparison.
+type RowDiff struct {
+	Row   map[string]string
+	Query string `json:",omitempty"`
+}
+
+// tableDiffer diffs one table of a vdiff, from where its previous runs
+// left off.
+type tableDiffer struct {
+	ct   *controller
+	plan *tablePlan
+
+	// lastPK is the primary key up to which the rows were compared, when
+	// the progress was last saved. The streams resume after it.
+	lastPK *querypb.QueryResult
+	// lastPKRow is the primary key of the last compared row.
+	lastPKRow []sqltypes.Value
+	report    *DiffReport
+
+	// The key for sources is the shard name.
+	sources map[string]*shardStreamer
+	target  *shardStreamer
+	// collations are the collations the columns are compared with.
+	collations []collations.ID
+}
+
+// shardStreamer streams rows from one shard. This works for
+// the source as well as the target.
+// shardStreamer satisfies engine.StreamExecutor, and can be
+// added to Primitives of engine.MergeSort.
+type shardStreamer struct {
+	keyspace         string
+	shard            string
+	tablet           *topodatapb.Tablet
+	position         mysql.Position
+	snapshotPosition string
+	fields           []*querypb.Field
+	result           chan *sqltypes.Result
+	err              error
+}
+
+func newTableDiffer(ct *controller, plan *tablePlan, row sqltypes.RowNamedValues) (*tableDiffer, error) {
+	td := &tableDiffer{
+		ct:     ct,
+		plan:   plan,
+		report: &DiffReport{TableName: plan.table},
+	}
+	if lastpk := row.AsString("lastpk", ""); lastpk != "" {
+		td.lastPK = &querypb.QueryResult{}
+		if err := prototext.Unmarshal([]byte(lastpk), td.lastPK); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid lastpk of table %v", plan.table)
+		}
+	}
+	if report := row.AsString("report", ""); report != "" {
+		if err := json.Unmarshal([]byte(report), td.report); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid report of table %v", plan.table)
+		}
+	}
+	return td, nil
+}
+
+// diff compares the rows of the table that weren't compared yet. Its
+// progress is saved regularly, and when it stops.
+func (td *tableDiffer) diff(ctx context.Context, dbClient binlogplayer.DBClient) error {
+	log.Infof("Starting vdiff %v for table %v", td.ct.uuid, td.plan.table)
+	if err := td.updateState(ctx, dbClient, StartedState); err != nil {
+		return err
+	}
+
+	// We need a cancelable context to abort all running streams
+	// if one stream returns an error.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if err := td.startStreams(ctx, dbClient); err != nil {
+		return err
+	}
+	err := td.compareRows(ctx, func() error {
+		return td.saveProgress(ctx, dbClient)
+	})
+	// The progress is saved even if the diff failed, so it resumes from
+	// there.
+	if serr := td.saveProgress(ctx, dbClient); err == nil {
+		err = serr
+	}
+	if err != nil {
+		return err
+	}
+	return td.updateState(ctx, dbClient, CompletedState)
+}
+
+func (td *tableDiffer) updateState(ctx context.Context, dbClient binlogplayer.DBClient, state string) error {
+	_, err := execWithDDL(ctx, dbClient, fmt.Sprintf(sqlUpdateVDiffTableState, encodeString(state), td.ct.id, encodeString(td.plan.table)))
+	return err
+}
+
+// saveProgress saves the primary key up to which the rows are compared,
+// and the report of the differences found.
+func (td *tableDiffer) saveProgress(ctx context.Context, dbClient binlogplayer.DBClient) error {
+	var lastpk []byte
+	if td.lastPKRow != nil {
+		pkFields := make([]*querypb.Field, len(td.plan.pkCols))
+		for i, col := range td.plan.pkCols {
+			pkFields[i] = &querypb.Field{Name: td.target.fields[col].Name, Type: td.target.fields[col].Type}
+		}
+		td.lastPK = sqltypes.ResultToProto3(&sqltypes.Result{
+			Fields: pkFields,
+			Rows:   [][]sqltypes.Value{td.lastPKRow},
+		})
+	}
+	if td.lastPK != nil {
+		var err error
+		if lastpk, err = prototext.Marshal(td.lastPK); err != nil {
+			return err
+		}
+	}
+	report, err := json.Marshal(td.report)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(sqlUpdateVDiffTableReport, encodeString(string(lastpk)), td.report.ProcessedRows, td.report.HasDifferences(),
+		encodeString(string(report)), td.ct.id, encodeString(td.plan.table))
+	_, err = execWithDDL(ctx, dbClient, query)
+	return err
+}
+
+// startStreams starts streaming the rows of the table from the sources and
+// the target, as of the same point of the workflow. The streams of the
+// workflow are stopped, the sources are streamed from a snapshot past the
+// positions the workflow stopped at, and the workflow then catches up with
+// those snapshots before the target is streamed. The streams of the
+// workflow are restarted once all the streams started.
+func (td *tableDiffer) startStreams(ctx context.Context, dbClient binlogplayer.DBClient) (err error) {
+	ct := td.ct
+	vre := ct.vde.vre
+	defer func() {
+		query := fmt.Sprintf(sqlRestartWorkflowStream, encodeString(ct.vde.dbName), encodeString(ct.workflow))
+		if _, rerr := vre.ExecWithDBA(query); rerr != nil {
+			log.Errorf("vdiff %v: could not restart workflow %v: %v, please restart it manually", ct.uuid, ct.workflow, rerr)
+			if err == nil {
+				err = rerr
+			}
+		}
+	}()
+
+	// Stop the workflow and record the source positions it stopped at.
+	if _, err := vre.ExecWithDBA(fmt.Sprintf(sqlStopWorkflowStreams, encodeString(ct.vde.dbName), encodeString(ct.workflow))); err != nil {
+		return vterrors.Wrap(err, "stopping the workflow")
+	}
+	streams, err := ct.readStreams(dbClient)
+	if err != nil {
+		return err
+	}
+	td.sources = make(map[string]*shardStreamer)
+	for _, stream := range streams {
+		source := td.sources[stream.bls.Shard]
+		if source == nil {
+			source = &shardStreamer{keyspace: stream.bls.Keyspace, shard: stream.bls.Shard}
+			td.sources[stream.bls.Shard] = source
+		}
+		if source.position.IsZero() || !source.position.AtLeast(stream.pos) {
+			source.position = stream.pos
+		}
+	}
+
+	waitTime := defaultWaitTime
+	if ct.options.FilteredReplicationWaitTimeSeconds > 0 {
+		waitTime = time.Duration(ct.options.FilteredReplicationWaitTimeSeconds) * time.Second
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, waitTime)
+	defer cancel()
+
+	// Make sure all sources are past the workflow's positions and start
+	// streams that record the current source positions.
+	err = forAll(td.sources, func(source *shardStreamer) error {
+		if source.position.IsZero() {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "workflow %v: stream from %v/%v has not started", ct.workflow, source.keyspace, source.shard)
+		}
+		tp, err := ct.newSourceTabletPicker(waitCtx, source.keyspace, source.shard)
+		if err != nil {
+			return err
+		}
+		if source.tablet, err = tp.PickForStreaming(waitCtx); err != nil {
+			return err
+		}
+		pos := mysql.EncodePosition(source.position)
+		if err := ct.vde.tmc.WaitForPosition(waitCtx, source.tablet, pos); err != nil {
+			return vterrors.Wrapf(err, "WaitForPosition for tablet %v", topoproto.TabletAliasString(source.tablet.Alias))
+		}
+		return td.startStream(ctx, source, td.plan.sourceQuery, td.plan.sourcePKColumns)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Fast forward the workflow to the source snapshots.
+	for _, stream := range streams {
+		pos := td.sources[stream.bls.Shard].snapshotPosition
+		if _, err := vre.ExecWithDBA(fmt.Sprintf(sqlSyncWorkflowStream, encodeString(pos), stream.id)); err != nil {
+			return err
+		}
+		if err := vre.WaitForPos(waitCtx, stream.id, pos); err != nil {
+			return vterrors.Wrapf(err, "waiting for stream %v to reach %v", stream.id, pos)
+		}
+	}
+
+	// Sources and target are in sync. Stream the target from this tablet.
+	target := proto.Clone(ct.vde.thisTablet).(*topodatapb.Tablet)
+	target.Type = topodatapb.TabletType_PRIMARY
+	td.target = &shardStreamer{keyspace: target.Keyspace, shard: target.Shard, tablet: target}
+	return td.startStream(ctx, td.target, td.plan.targetQuery, td.plan.targetPKColumns)
+}
+
+// startStream starts streaming the rows of a participant, and records the
+// position of the snapshot they're streamed from.
+func (td *tableDiffer) startStream(ctx context.Context, participant *shardStreamer, query string, pkColumns []string) error {
+	participant.result = make(chan *sqltypes.Result, 1)
+	gtidch := make(chan string, 1)
+
+	// Start the stream in a separate goroutine.
+	go td.streamOne(ctx, participant, query, pkColumns, gtidch)
+
+	// Wait for the gtid to be sent. If it's not received, there was an error
+	// which would be stored in participant.err.
+	gtid, ok := <-gtidch
+	if !ok {
+		return participant.err
+	}
+	participant.snapshotPosition = gtid
+	return nil
+}
+
+// streamOne is called as a goroutine, and communicates its results through channels.
+// It first sends the snapshot gtid to gtidch.
+// Then it streams results to participant.result.
+// Before returning, it sets participant.err, and closes all channels.
+// If any channel is closed, then participant.err can be checked if there was an error.
+// The shardStreamer's StreamExecute consumes the result channel.
+func (td *tableDiffer) streamOne(ctx context.Context, participant *shardStreamer, query string, pkColumns []string, gtidch chan string) {
+	defer close(participant.result)
+	defer close(gtidch)
+
+	// Wrap the streaming in a separate function so we can capture the error.
+	// This shows that the error will be set before the channels are closed.
+	participant.err = func() error {
+		conn, err := tabletconn.GetDialer()(participant.tablet, grpcclient.FailFast(false))
+		if err != nil {
+			return err
+		}
+		defer conn.Close(ctx)
+
+		target := &querypb.Target{
+			Keyspace:   participant.keyspace,
+			Shard:      participant.shard,
+			TabletType: participant.tablet.Type,
+		}
+		return conn.VStreamRows(ctx, target, query, td.lastPK, func(vsr *binlogdatapb.VStreamRowsResponse) error {
+			var result *sqltypes.Result
+			if vsr.Fields != nil {
+				if err := checkPKColumns(td.plan.table, vsr.Pkfields, pkColumns); err != nil {
+					return err
+				}
+				participant.fields = vsr.Fields
+				gtidch <- vsr.Gtid
+				// Fields should be received only once, and sent only once.
+				result = sqltypes.Proto3ToResult(&querypb.QueryResult{Fields: vsr.Fields, Rows: vsr.Rows})
+			} else {
+				result = sqltypes.Proto3ToResult(&querypb.QueryResult{Fields: participant.fields, Rows: vsr.Rows})
+				result.Fields = nil
+			}
+			select {
+			case participant.result <- result:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "VStreamRows")
+			}
+			return nil
+		})
+	}()
+}
+
+// checkPKColumns checks that a stream is ordered by the expected primary
+// key, so its rows can be merged and compared with the other streams.
+func checkPKColumns(table string, pkFields []*querypb.Field, pkColumns []string) error {
+	var got []string
+	for _, field := range pkFields {
+		got = append(got, field.Name)
+	}
+	if !strings.EqualFold(strings.Join(got, ","), strings.Join(pkColumns, ",")) {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the rows of table %v are streamed by primary key (%v), expected (%v): the source and target tables need the same primary key",
+			table, strings.Join(got, ","), strings.Join(pkColumns, ","))
+	}
+	return nil
+}
+
+// compareRows compares the streamed rows of the sources and the target,
+// until both are exhausted or the maximum number of rows is compared.
+// checkpoint is called regularly to save the progress.
+func (td *tableDiffer) compareRows(ctx context.Context, checkpoint func() error) error {
+	td.collations = columnCollations(td.target.fields)
+	sourceExecutor := newPrimitiveExecutor(ctx, td.newMergeSorter(td.sources))
+	targetExecutor := newPrimitiveExecutor(ctx, td.newMergeSorter(map[string]*shardStreamer{td.target.shard: td.target}))
+	dr := td.report
+	maxRows := td.ct.options.MaxRows
+	lastCheckpoint := time.Now()
+
+	var sourceRow, targetRow []sqltypes.Value
+	var err error
+	advanceSource := true
+	advanceTarget := true
+	for {
+		if maxRows > 0 && dr.ProcessedRows >= maxRows {
+			log.Infof("Stopping vdiff %v of table %v, specified limit reached", td.ct.uuid, td.plan.table)
+			return nil
+		}
+		if time.Since(lastCheckpoint) >= checkpointInterval {
+			if err := checkpoint(); err != nil {
+				return err
+			}
+			lastCheckpoint = time.Now()
+		}
+		if advanceSource {
+			if sourceRow, err = sourceExecutor.next(); err != nil {
+				return err
+			}
+		}
+		if advanceTarget {
+			if targetRow, err = targetExecutor.next(); err != nil {
+				return err
+			}
+		}
+		if sourceRow == nil && targetRow == nil {
+			return nil
+		}
+
+		advanceSource = true
+		advanceTarget = true
+		dr.ProcessedRows++
+
+		// Compare pk values. A missing row sorts after all the others.
+		var c int
+		switch {
+		case sourceRow == nil:
+			c = 1
+		case targetRow == nil:
+			c = -1
+		default:
+			if c, err = td.compare(sourceRow, targetRow, td.plan.pkCols); err != nil {
+				return err
+			}
+		}
+		switch {
+		case c < 0:
+			if len(dr.ExtraRowsSourceSample) < maxSampleRows {
+				dr.ExtraRowsSourceSample = append(dr.ExtraRowsSourceSample, td.genRowDiff(sourceRow))
+			}
+			dr.ExtraRowsSource++
+			td.setLastPK(sourceRow)
+			advanceTarget = false
+			continue
+		case c > 0:
+			if len(dr.ExtraRowsTargetSample) < maxSampleRows {
+				dr.ExtraRowsTargetSample = append(dr.ExtraRowsTargetSample, td.genRowDiff(targetRow))
+			}
+			dr.ExtraRowsTarget++
+			td.setLastPK(targetRow)
+			advanceSource = false
+			continue
+		}
+
+		// c == 0
+		// Compare all values.
+		td.setLastPK(sourceRow)
+		c, err = td.compare(sourceRow, targetRow, nil)
+		switch {
+		case err != nil:
+			return err
+		case c != 0:
+			if len(dr.MismatchedRowsSample) < maxSampleRows {
+				dr.MismatchedRowsSample = append(dr.MismatchedRowsSample, &DiffMismatch{
+					Source: td.genRowDiff(sourceRow),
+					Target: td.genRowDiff(targetRow),
+				})
+			}
+			dr.MismatchedRows++
+		default:
+			dr.MatchingRows++
+		}
+	}
+}
+
+// compare compares the given columns of two rows, or all their columns if
+// cols is nil.
+func (td *tableDiffer) compare(sourceRow, targetRow []sqltypes.Value, cols []int) (int, error) {
+	if cols == nil {
+		cols = make([]int, len(td.plan.columns))
+		for i := range cols {
+			cols[i] = i
+		}
+	}
+	for _, col := range cols {
+		c, err := evalengine.NullsafeCompare(sourceRow[col], targetRow[col], td.collations[col])
+		if err != nil {
+			return 0, vterrors.Wrapf(err, "comparing column %v", td.plan.columns[col])
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+// setLastPK records the primary key of the last compared row.
+func (td *tableDiffer) setLastPK(row []sqltypes.Value) {
+	if td.lastPKRow == nil {
+		td.lastPKRow = make([]sqltypes.Value, len(td.plan.pkCols))
+	}
+	for i, col := range td.plan.pkCols {
+		td.lastPKRow[i] = row[col]
+	}
+}
+
+func (td *tableDiffer) genRowDiff(row []sqltypes.Value) *RowDiff {
+	rd := &RowDiff{Row: make(map[string]string)}
+	if td.ct.options.OnlyPks {
+		for _, col := range td.plan.pkCols {
+			rd.Row[td.plan.columns[col]] = formatValue(row[col])
+		}
+	} else {
+		for i, col := range td.plan.columns {
+			rd.Row[col] = formatValue(row[i])
+		}
+	}
+	if td.ct.options.DebugQuery {
+		rd.Query = td.genDebugQuery(row)
+	}
+	return rd
+}
+
+// genDebugQuery returns the query that reads a row from the target.
+func (td *tableDiffer) genDebugQuery(row []sqltypes.Value) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	cols := td.plan.pkCols
+	if !td.ct.options.OnlyPks {
+		cols = make([]int, len(td.plan.columns))
+		for i := range cols {
+			cols[i] = i
+		}
+	}
+	for i, col := range cols {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(td.plan.columns[col]))
+	}
+	buf.Myprintf(" from %v where ", sqlparser.NewTableIdent(td.plan.table))
+	for i, col := range td.plan.pkCols {
+		if i != 0 {
+			buf.Myprintf(" and ")
+		}
+		buf.Myprintf("%v=", sqlparser.NewColIdent(td.plan.columns[col]))
+		row[col].EncodeSQL(buf)
+	}
+	return buf.String()
+}
+
+// newMergeSorter creates an engine.MergeSort based on the shard streamers
+// and pk columns.
+func (td *tableDiffer) newMergeSorter(participants map[string]*shardStreamer) *engine.MergeSort {
+	prims := make([]engine.StreamExecutor, 0, len(participants))
+	for _, participant := range participants {
+		prims = append(prims, participant)
+	}
+	ob := make([]engine.OrderByParams, 0, len(td.plan.pkCols))
+	for _, col := range td.plan.pkCols {
+		ob = append(ob, engine.OrderByParams{Col: col, WeightStringCol: -1, CollationID: td.collations[col]})
+	}
+	return &engine.MergeSort{
+		Primitives: prims,
+		OrderBy:    ob,
+	}
+}
+
+// columnCollations returns the collations the columns are compared with.
+// VStreamRows reads the rows with a binary connection, so text values are
+// raw bytes in the collation of their column.
+func columnCollations(fields []*querypb.Field) []collations.ID {
+	colls := make([]collations.ID, len(fields))
+	for i, field := range fields {
+		colls[i] = collations.ID(field.Charset)
+		if colls[i] == collations.Unknown || collations.Local().LookupByID(colls[i]) == nil {
+			colls[i] = collations.CollationBinaryID
+		}
+	}
+	return colls
+}
+
+func forAll(participants map[string]*shardStreamer, f func(*shardStreamer) error) error {
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, participant := range participants {
+		wg.Add(1)
+		go func(participant *shardStreamer) {
+			defer wg.Done()
+
+			if err := f(participant); err != nil {
+				allErrors.RecordError(err)
+			}
+		}(participant)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
+
+func formatValue(val sqltypes.Value) string {
+	if val.IsNull() {
+		return "NULL"
+	}
+	return val.ToString()
+}
+
+//-----------------------------------------------------------------
+// primitiveExecutor
+
+// primitiveExecutor starts execution on the top level primitive
+// and provides convenience functions for row-by-row iteration.
+type primitiveExecutor struct {
+	prim     engine.Primitive
+	rows     [][]sqltypes.Value
+	resultch chan *sqltypes.Result
+	err      error
+}
+
+func newPrimitiveExecutor(ctx context.Context, prim engine.Primitive) *primitiveExecutor {
+	pe := &primitiveExecutor{
+		prim:     prim,
+		resultch: make(chan *sqltypes.Result, 1),
+	}
+	vcursor := &contextVCursor{ctx: ctx}
+	go func() {
+		defer close(pe.resultch)
+		pe.err = vcursor.StreamExecutePrimitive(pe.prim, make(map[string]*querypb.BindVariable), true, func(qr *sqltypes.Result) error {
+			select {
+			case pe.resultch <- qr:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "Outer Stream")
+			}
+			return nil
+		})
+	}()
+	return pe
+}
+
+func (pe *primitiveExecutor) next() ([]sqltypes.Value, error) {
+	for len(pe.rows) == 0 {
+		qr, ok := <-pe.resultch
+		if !ok {
+			return nil, pe.err
+		}
+		pe.rows = qr.Rows
+	}
+
+	row := pe.rows[0]
+	pe.rows = pe.rows[1:]
+	return row, nil
+}
+
+//-----------------------------------------------------------------
+// shardStreamer
+
+func (sm *shardStreamer) StreamExecute(vcursor engine.VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	for result := range sm.result {
+		if err := callback(result); err != nil {
+			return err
+		}
+	}
+	return sm.err
+}
+
+//-----------------------------------------------------------------
+// contextVCursor
+
+// contextVCursor satisfies VCursor, but only implements Context().
+// MergeSort only requires Context to be implemented.
+type contextVCursor struct {
+	engine.VCursor
+	ctx context.Context
+}
+
+func (vc *contextVCursor) ConnCollation() collations.ID {
+	return collations.CollationBinaryID
+}
+
+func (vc *contextVCursor) ExecutePrimitive(primitive engine.Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	return primitive.TryExecute(vc, bindVars, wantfields)
+}
+
+func (vc *contextVCursor) StreamExecutePrimitive(primitive engine.Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return primitive.TryStreamExecute(vc, bindVars, wantfields, callback)
+}
+
+func (vc *contextVCursor) Context() context.Context {
+	return vc.ctx
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+var testFields = sqltypes.MakeTestFields("c1|c2", "int64|varbinary")
+
+// newTestStreamer returns a shardStreamer that streams the given rows, in
+// the format "c1|c2".
+func newTestStreamer(shard string, rows ...string) *shardStreamer {
+	qr := sqltypes.MakeTestResult(testFields, rows...)
+	ss := &shardStreamer{
+		shard:  shard,
+		fields: testFields,
+		result: make(chan *sqltypes.Result, 2),
+	}
+	ss.result <- &sqltypes.Result{Fields: testFields}
+	ss.result <- &sqltypes.Result{Rows: qr.Rows}
+	close(ss.result)
+	return ss
+}
+
+func newTestTableDiffer(options *tabletmanagerdatapb.VDiffOptions, sources map[string]*shardStreamer, target *shardStreamer) *tableDiffer {
+	return &tableDiffer{
+		ct: &controller{id: 1, uuid: "uuid", options: options},
+		plan: &tablePlan{
+			table:   "t1",
+			columns: []string{"c1", "c2"},
+			pkCols:  []int{0},
+		},
+		report:  &DiffReport{TableName: "t1"},
+		sources: sources,
+		target:  target,
+	}
+}
+
+func TestCompareRows(t *testing.T) {
+	testcases := []struct {
+		name      string
+		options   *tabletmanagerdatapb.VDiffOptions
+		sources   map[string]*shardStreamer
+		target    *shardStreamer
+		report    *DiffReport
+		lastPKRow []sqltypes.Value
+	}{{
+		name: "match",
+		sources: map[string]*shardStreamer{
+			"-80": newTestStreamer("-80", "1|a", "3|c"),
+			"80-": newTestStreamer("80-", "2|b"),
+		},
+		target: newTestStreamer("0", "1|a", "2|b", "3|c"),
+		report: &DiffReport{
+			TableName:     "t1",
+			ProcessedRows: 3,
+			MatchingRows:  3,
+		},
+		lastPKRow: []sqltypes.Value{sqltypes.NewInt64(3)},
+	}, {
+		name: "differences",
+		sources: map[string]*shardStreamer{
+			"0": newTestStreamer("0", "1|a", "2|b", "3|c"),
+		},
+		target: newTestStreamer("0", "1|a", "3|d", "4|e"),
+		report: &DiffReport{
+			TableName:             "t1",
+			ProcessedRows:         4,
+			MatchingRows:          1,
+			MismatchedRows:        1,
+			ExtraRowsSource:       1,
+			ExtraRowsSourceSample: []*RowDiff{{Row: map[string]string{"c1": "2", "c2": "b"}}},
+			ExtraRowsTarget:       1,
+			ExtraRowsTargetSample: []*RowDiff{{Row: map[string]string{"c1": "4", "c2": "e"}}},
+			MismatchedRowsSample: []*DiffMismatch{{
+				Source: &RowDiff{Row: map[string]string{"c1": "3", "c2": "c"}},
+				Target: &RowDiff{Row: map[string]string{"c1": "3", "c2": "d"}},
+			}},
+		},
+		lastPKRow: []sqltypes.Value{sqltypes.NewInt64(4)},
+	}, {
+		name:    "only pks and debug query",
+		options: &tabletmanagerdatapb.VDiffOptions{OnlyPks: true, DebugQuery: true},
+		sources: map[string]*shardStreamer{
+			"0": newTestStreamer("0", "1|a"),
+		},
+		target: newTestStreamer("0", "1|b"),
+		report: &DiffReport{
+			TableName:      "t1",
+			ProcessedRows:  1,
+			MismatchedRows: 1,
+			MismatchedRowsSample: []*DiffMismatch{{
+				Source: &RowDiff{Row: map[string]string{"c1": "1"}, Query: "select c1 from t1 where c1=1"},
+				Target: &RowDiff{Row: map[string]string{"c1": "1"}, Query: "select c1 from t1 where c1=1"},
+			}},
+		},
+		lastPKRow: []sqltypes.Value{sqltypes.NewInt64(1)},
+	}, {
+		name:    "max rows",
+		options: &tabletmanagerdatapb.VDiffOptions{MaxRows: 2},
+		sources: map[string]*shardStreamer{
+			"0": newTestStreamer("0", "1|a", "2|b", "3|c"),
+		},
+		target: newTestStreamer("0", "1|a", "2|b", "3|c"),
+		report: &DiffReport{
+			TableName:     "t1",
+			ProcessedRows: 2,
+			MatchingRows:  2,
+		},
+		lastPKRow: []sqltypes.Value{sqltypes.NewInt64(2)},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			options := tcase.options
+			if options == nil {
+				options = &tabletmanagerdatapb.VDiffOptions{}
+			}
+			td := newTestTableDiffer(options, tcase.sources, tcase.target)
+			err := td.compareRows(context.Background(), func() error { return nil })
+			require.NoError(t, err)
+			assert.Equal(t, tcase.report, td.report)
+			assert.Equal(t, tcase.lastPKRow, td.lastPKRow)
+		})
+	}
+}
+
+func TestCompareRowsCheckpoint(t *testing.T) {
+	defer func(saved time.Duration) { checkpointInterval = saved }(checkpointInterval)
+	checkpointInterval = 0
+
+	td := newTestTableDiffer(&tabletmanagerdatapb.VDiffOptions{},
+		map[string]*shardStreamer{"0": newTestStreamer("0", "1|a", "2|b")},
+		newTestStreamer("0", "1|a", "2|b"))
+	var checkpoints []int64
+	err := td.compareRows(context.Background(), func() error {
+		checkpoints = append(checkpoints, td.report.ProcessedRows)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{0, 1, 2}, checkpoints)
+}
+
+func TestSaveProgress(t *testing.T) {
+	td := newTestTableDiffer(&tabletmanagerdatapb.VDiffOptions{}, nil, &shardStreamer{fields: testFields})
+	td.setLastPK([]sqltypes.Value{sqltypes.NewInt64(10), sqltypes.NewVarBinary("j")})
+	td.report.ProcessedRows = 10
+	td.report.ExtraRowsTarget = 1
+
+	dbClient := binlogplayer.NewMockDBClient(t)
+	dbClient.ExpectRequestRE("update _vt.vdiff_table set lastpk = .*, rows_compared = 10, mismatch = true, report = .* where vdiff_id = 1 and table_name = 't1'", &sqltypes.Result{}, nil)
+	require.NoError(t, td.saveProgress(context.Background(), dbClient))
+	dbClient.Wait()
+
+	// The saved progress is what a new tableDiffer resumes from.
+	lastpk, err := prototext.Marshal(td.lastPK)
+	require.NoError(t, err)
+	report, err := json.Marshal(td.report)
+	require.NoError(t, err)
+	row := sqltypes.RowNamedValues{
+		"lastpk": sqltypes.NewVarBinary(string(lastpk)),
+		"report": sqltypes.NewVarBinary(string(report)),
+	}
+	resumed, err := newTableDiffer(td.ct, td.plan, row)
+	require.NoError(t, err)
+	assert.Equal(t, td.report, resumed.report)
+	utils.MustMatch(t, &querypb.QueryResult{
+		Fields: []*querypb.Field{{Name: "c1", Type: querypb.Type_INT64}},
+		Rows:   []*querypb.Row{{Lengths: []int64{2}, Values: []byte("10")}},
+	}, resumed.lastPK)
+}
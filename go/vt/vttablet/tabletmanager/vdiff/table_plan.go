@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// tablePlan is the plan to diff one table. The rows of the sources and
+// the target are both read with VStreamRows, which streams them in the
+// order of their primary key, and can resume after a given primary key.
+type tablePlan struct {
+	table string
+
+	// sourceQuery streams the rows from the sources. It's the filter of
+	// the workflow, with its column list expanded.
+	sourceQuery string
+	// targetQuery streams the same columns from the target.
+	targetQuery string
+
+	// columns are the names of the compared columns, on the target.
+	columns []string
+	// pkCols are the indexes of the primary key columns in columns.
+	pkCols []int
+	// sourcePKColumns and targetPKColumns are the names of the primary key
+	// columns, as VStreamRows reports them for the sources and the target.
+	sourcePKColumns []string
+	targetPKColumns []string
+}
+
+// buildTablePlans builds the plans of the tables of the target that the
+// filter of the workflow matches. If tables is not empty, only the plans
+// of those tables are built.
+func buildTablePlans(filter *binlogdatapb.Filter, schm *tabletmanagerdatapb.SchemaDefinition, tables []string) (map[string]*tablePlan, error) {
+	include := make(map[string]bool)
+	for _, table := range tables {
+		include[strings.TrimSpace(table)] = true
+	}
+	plans := make(map[string]*tablePlan)
+	for _, table := range schm.TableDefinitions {
+		// Skip internal operation tables for vdiff
+		if schema.IsInternalOperationTableName(table.Name) {
+			continue
+		}
+		if len(include) > 0 && !include[table.Name] {
+			continue
+		}
+		rule, err := vreplication.MatchTable(table.Name, filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil || rule.Filter == vreplication.ExcludeStr {
+			continue
+		}
+		query := rule.Filter
+		switch {
+		case rule.Filter == "":
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table.Name))
+			query = buf.String()
+		case key.IsKeyRange(rule.Filter):
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v where in_keyrange(%v)", sqlparser.NewTableIdent(table.Name), sqlparser.NewStrLiteral(rule.Filter))
+			query = buf.String()
+		}
+		plans[table.Name], err = buildTablePlan(table, query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for table := range include {
+		if plans[table] == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "table %v is not part of the workflow", table)
+		}
+	}
+	if len(plans) == 0 {
+		return nil, vterrors.New(vtrpcpb.Code_NOT_FOUND, "no table to diff in the workflow")
+	}
+	return plans, nil
+}
+
+// buildTablePlan builds the plan of one table, from the query of its
+// filter.
+func buildTablePlan(table *tabletmanagerdatapb.TableDefinition, query string) (*tablePlan, error) {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected: %v", sqlparser.String(statement))
+	}
+	if len(sel.GroupBy) != 0 || sel.Having != nil || sel.Distinct {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "vdiff does not support aggregations in the filter of table %v: %v", table.Name, query)
+	}
+
+	fields := make(map[string]bool)
+	for _, field := range table.Fields {
+		fields[strings.ToLower(field.Name)] = true
+	}
+
+	tp := &tablePlan{table: table.Name}
+	sourceSelect := &sqlparser.Select{From: sel.From, Where: sel.Where}
+	targetSelect := &sqlparser.Select{
+		From: sqlparser.TableExprs{
+			&sqlparser.AliasedTableExpr{
+				Expr: &sqlparser.TableName{Name: sqlparser.NewTableIdent(table.Name)},
+			},
+		},
+	}
+	var sourceColumns []string
+	addColumn := func(sourceCol sqlparser.SelectExpr, source, target string) error {
+		if !fields[strings.ToLower(target)] {
+			return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "column %v not found in table %v", target, table.Name)
+		}
+		sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, sourceCol)
+		targetSelect.SelectExprs = append(targetSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(target)}})
+		sourceColumns = append(sourceColumns, source)
+		tp.columns = append(tp.columns, target)
+		return nil
+	}
+	for _, selExpr := range sel.SelectExprs {
+		switch selExpr := selExpr.(type) {
+		case *sqlparser.StarExpr:
+			// If it's a '*' expression, expand column list from the schema.
+			for _, fld := range table.Fields {
+				col := &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(fld.Name)}}
+				if err := addColumn(col, fld.Name, fld.Name); err != nil {
+					return nil, err
+				}
+			}
+		case *sqlparser.AliasedExpr:
+			// VStreamRows only streams plain columns, so the rows of the
+			// sources and the target can be compared one column at a time.
+			colName, ok := selExpr.Expr.(*sqlparser.ColName)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "vdiff only supports columns in the filter of table %v, not %v", table.Name, sqlparser.String(selExpr))
+			}
+			// If the input was "select a as b", then source will use "a" and target will use "b".
+			target := colName.Name.String()
+			if !selExpr.As.IsEmpty() {
+				target = selExpr.As.String()
+			}
+			if err := addColumn(selExpr, colName.Name.String(), target); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected: %v", sqlparser.String(statement))
+		}
+	}
+
+	if len(table.PrimaryKeyColumns) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "table %v has no primary key", table.Name)
+	}
+	for _, pk := range table.PrimaryKeyColumns {
+		found := false
+		for i, col := range tp.columns {
+			if strings.EqualFold(pk, col) {
+				tp.pkCols = append(tp.pkCols, i)
+				tp.sourcePKColumns = append(tp.sourcePKColumns, sourceColumns[i])
+				tp.targetPKColumns = append(tp.targetPKColumns, col)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "primary key column %v of table %v is not in the filter of the workflow", pk, table.Name)
+		}
+	}
+
+	tp.sourceQuery = sqlparser.String(sourceSelect)
+	tp.targetQuery = sqlparser.String(targetSelect)
+	return tp, nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestBuildTablePlans(t *testing.T) {
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}, {
+			Name:              "t2",
+			Columns:           []string{"c1", "c2", "c3"},
+			PrimaryKeyColumns: []string{"c2", "c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2|c3", "int64|varchar|int64"),
+		}, {
+			Name:              "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+			Columns:           []string{"c1"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1", "int64"),
+		}},
+	}
+
+	testcases := []struct {
+		input  *binlogdatapb.Rule
+		tables []string
+		plans  map[string]*tablePlan
+		err    string
+	}{{
+		input: &binlogdatapb.Rule{Match: "t1"},
+		plans: map[string]*tablePlan{
+			"t1": {
+				table:           "t1",
+				sourceQuery:     "select c1, c2 from t1",
+				targetQuery:     "select c1, c2 from t1",
+				columns:         []string{"c1", "c2"},
+				pkCols:          []int{0},
+				sourcePKColumns: []string{"c1"},
+				targetPKColumns: []string{"c1"},
+			},
+		},
+	}, {
+		input: &binlogdatapb.Rule{Match: "t1", Filter: "-80"},
+		plans: map[string]*tablePlan{
+			"t1": {
+				table:           "t1",
+				sourceQuery:     "select c1, c2 from t1 where in_keyrange('-80')",
+				targetQuery:     "select c1, c2 from t1",
+				columns:         []string{"c1", "c2"},
+				pkCols:          []int{0},
+				sourcePKColumns: []string{"c1"},
+				targetPKColumns: []string{"c1"},
+			},
+		},
+	}, {
+		input: &binlogdatapb.Rule{Match: "t1", Filter: "select a as c1, c2 from src where in_keyrange('-80')"},
+		plans: map[string]*tablePlan{
+			"t1": {
+				table:           "t1",
+				sourceQuery:     "select a as c1, c2 from src where in_keyrange('-80')",
+				targetQuery:     "select c1, c2 from t1",
+				columns:         []string{"c1", "c2"},
+				pkCols:          []int{0},
+				sourcePKColumns: []string{"a"},
+				targetPKColumns: []string{"c1"},
+			},
+		},
+	}, {
+		// Multi-column primary keys follow the order of the primary key,
+		// and internal tables are skipped.
+		input:  &binlogdatapb.Rule{Match: "/.*"},
+		tables: []string{"t2"},
+		plans: map[string]*tablePlan{
+			"t2": {
+				table:           "t2",
+				sourceQuery:     "select c1, c2, c3 from t2",
+				targetQuery:     "select c1, c2, c3 from t2",
+				columns:         []string{"c1", "c2", "c3"},
+				pkCols:          []int{1, 0},
+				sourcePKColumns: []string{"c2", "c1"},
+				targetPKColumns: []string{"c2", "c1"},
+			},
+		},
+	}, {
+		input:  &binlogdatapb.Rule{Match: "t1"},
+		tables: []string{"t2"},
+		err:    "table t2 is not part of the workflow",
+	}, {
+		input: &binlogdatapb.Rule{Match: "t1", Filter: "select c2 from t1"},
+		err:   "primary key column c1 of table t1 is not in the filter of the workflow",
+	}, {
+		input: &binlogdatapb.Rule{Match: "t1", Filter: "select c1, c2 + 1 as c2 from t1"},
+		err:   "vdiff only supports columns in the filter of table t1, not c2 + 1 as c2",
+	}, {
+		input: &binlogdatapb.Rule{Match: "t1", Filter: "select c1, count(*) as c2 from t1 group by c1"},
+		err:   "vdiff does not support aggregations in the filter of table t1",
+	}, {
+		input: &binlogdatapb.Rule{Match: "t1", Filter: "select c1, c3 from t1"},
+		err:   "column c3 not found in table t1",
+	}, {
+		input: &binlogdatapb.Rule{Match: "none"},
+		err:   "no table to diff in the workflow",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.input.Filter, func(t *testing.T) {
+			filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{tcase.input}}
+			plans, err := buildTablePlans(filter, schm, tcase.tables)
+			if tcase.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.plans, plans)
+		})
+	}
+}
@@ -0,0 +1,420 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// The EXEC_ADDITIVE mode of on_ddl applies the DDLs of the source tables
+// that only extend them to the target tables: ADD COLUMN, ADD INDEX and
+// MODIFY COLUMN to a wider type of the same family. The DDLs are mapped
+// through the filter rules, to every target table that selects from the
+// source table:
+//  * The columns are added only if the rule is a "select *", because the
+//    other rules don't select them.
+//  * The columns of the indexes and of MODIFY COLUMN are renamed to the
+//    target columns that the rule selects them as. They are skipped if
+//    the rule doesn't select them as is.
+// The DDLs of the tables that no rule selects from are skipped, and so are
+// the DDLs of the online DDL and table GC tables. The ALTERs of an online
+// DDL shadow table are applied when it's cut over, by being renamed to a
+// replicated table. The other DDLs stop the workflow, like the STOP mode
+// does.
+
+// shadowTables maps the online DDL shadow tables of the source, which are
+// created like a replicated table, to the ALTERs applied to them until
+// they are cut over.
+type shadowTables map[string][]string
+
+// targetMapping is the target of a source table.
+type targetMapping struct {
+	table string
+	// columns maps the source columns to the target columns. It's nil
+	// if the rule is a "select *", which keeps the names.
+	columns map[string]string
+}
+
+func (tm *targetMapping) column(name string) (string, bool) {
+	if tm.columns == nil {
+		return name, true
+	}
+	target, ok := tm.columns[strings.ToLower(name)]
+	return target, ok
+}
+
+// buildAdditiveDDLs returns the DDLs that apply an additive DDL of the
+// source to the target tables. It returns no DDLs if the DDL doesn't
+// change the targets, and an error if the DDL is not additive.
+func buildAdditiveDDLs(statement string, filter *binlogdatapb.Filter, colInfoMap map[string][]*ColumnInfo, shadows shadowTables) ([]string, error) {
+	stmt, err := sqlparser.Parse(statement)
+	if err != nil {
+		return nil, err
+	}
+	notAdditive := fmt.Errorf("not an additive DDL: %s", statement)
+	switch stmt := stmt.(type) {
+	case *sqlparser.AlterTable:
+		name := stmt.Table.Name.String()
+		if schema.IsInternalOperationTableName(name) {
+			if _, ok := shadows[name]; ok {
+				if stmt.PartitionSpec != nil {
+					// The cut-over of the table can't be applied.
+					delete(shadows, name)
+				} else {
+					shadows[name] = append(shadows[name], statement)
+				}
+			}
+			return nil, nil
+		}
+		if stmt.PartitionSpec != nil {
+			targets, err := findTargetMappings(name, filter, colInfoMap)
+			if err != nil || len(targets) == 0 {
+				return nil, err
+			}
+			return nil, notAdditive
+		}
+		return buildAlters(name, []string{statement}, filter, colInfoMap, notAdditive)
+	case *sqlparser.CreateTable:
+		name := stmt.Table.Name.String()
+		if schema.IsInternalOperationTableName(name) {
+			if stmt.OptLike != nil {
+				shadows[name] = nil
+			}
+			return nil, nil
+		}
+	case *sqlparser.RenameTable:
+		var ddls []string
+		for _, pair := range stmt.TablePairs {
+			from, to := pair.FromTable.Name.String(), pair.ToTable.Name.String()
+			statements, isShadow := shadows[from]
+			delete(shadows, from)
+			if schema.IsInternalOperationTableName(to) {
+				// The table is moved aside, by an online DDL cut-over
+				// or before it's dropped.
+				continue
+			}
+			targets, err := findTargetMappings(to, filter, colInfoMap)
+			if err != nil {
+				return nil, err
+			}
+			if len(targets) == 0 {
+				replicated, err := isReplicated(from, filter, colInfoMap)
+				if err != nil {
+					return nil, err
+				}
+				if replicated {
+					return nil, notAdditive
+				}
+				continue
+			}
+			if !isShadow {
+				if schema.IsInternalOperationTableName(from) {
+					return nil, fmt.Errorf("cannot apply the online DDL cut-over of %s from %s: %s", to, from, statement)
+				}
+				return nil, notAdditive
+			}
+			alters, err := buildAlters(to, statements, filter, colInfoMap, notAdditive)
+			if err != nil {
+				return nil, err
+			}
+			ddls = append(ddls, alters...)
+		}
+		return ddls, nil
+	}
+	ddl, ok := stmt.(sqlparser.DDLStatement)
+	if !ok {
+		return nil, notAdditive
+	}
+	for _, table := range ddl.AffectedTables() {
+		name := table.Name.String()
+		if schema.IsInternalOperationTableName(name) {
+			continue
+		}
+		replicated, err := isReplicated(name, filter, colInfoMap)
+		if err != nil {
+			return nil, err
+		}
+		if replicated {
+			return nil, notAdditive
+		}
+	}
+	return nil, nil
+}
+
+// buildAlters returns the ALTERs that apply the options of ALTERs of a
+// source table to its target tables.
+func buildAlters(sourceTable string, statements []string, filter *binlogdatapb.Filter, colInfoMap map[string][]*ColumnInfo, notAdditive error) ([]string, error) {
+	targets, err := findTargetMappings(sourceTable, filter, colInfoMap)
+	if err != nil {
+		return nil, err
+	}
+	var ddls []string
+	for _, target := range targets {
+		var options []sqlparser.AlterOption
+		for _, statement := range statements {
+			// The options are mapped in place, so each target parses
+			// its own.
+			stmt, err := sqlparser.Parse(statement)
+			if err != nil {
+				return nil, err
+			}
+			alterOptions, err := buildAlterOptions(stmt.(*sqlparser.AlterTable).AlterOptions, target, colInfoMap, notAdditive)
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, alterOptions...)
+		}
+		if len(options) == 0 {
+			continue
+		}
+		ddls = append(ddls, sqlparser.String(&sqlparser.AlterTable{
+			Table:        sqlparser.TableName{Name: sqlparser.NewTableIdent(target.table)},
+			AlterOptions: options,
+		}))
+	}
+	return ddls, nil
+}
+
+// buildAlterOptions maps the options of an ALTER of a source table to a
+// target table.
+func buildAlterOptions(alterOptions []sqlparser.AlterOption, target *targetMapping, colInfoMap map[string][]*ColumnInfo, notAdditive error) ([]sqlparser.AlterOption, error) {
+	var options []sqlparser.AlterOption
+	for _, option := range alterOptions {
+		switch option := option.(type) {
+		case *sqlparser.AddColumns:
+			if target.columns != nil {
+				continue
+			}
+			options = append(options, option)
+		case *sqlparser.AddIndexDefinition:
+			if option.IndexDefinition.Info.Primary {
+				return nil, notAdditive
+			}
+			if mapIndexColumns(option.IndexDefinition, target) {
+				options = append(options, option)
+			}
+		case *sqlparser.ModifyColumn:
+			col := option.NewColDefinition
+			name, ok := target.column(col.Name.String())
+			if !ok {
+				continue
+			}
+			colInfo := findColInfo(colInfoMap[target.table], name)
+			if colInfo == nil {
+				continue
+			}
+			widening, err := isWidening(colInfo, col.Type)
+			if err != nil {
+				return nil, err
+			}
+			if !widening {
+				return nil, notAdditive
+			}
+			col.Name = sqlparser.NewColIdent(name)
+			if target.columns != nil {
+				// The column of After may not be in the target.
+				option.First = false
+				option.After = nil
+			}
+			options = append(options, option)
+		default:
+			return nil, notAdditive
+		}
+	}
+	return options, nil
+}
+
+// isReplicated returns true if a rule of the filter selects from a source
+// table.
+func isReplicated(sourceTable string, filter *binlogdatapb.Filter, colInfoMap map[string][]*ColumnInfo) (bool, error) {
+	targets, err := findTargetMappings(sourceTable, filter, colInfoMap)
+	return len(targets) > 0, err
+}
+
+// findTargetMappings returns the target tables of a source table, which
+// are the tables whose rules select from it.
+func findTargetMappings(sourceTable string, filter *binlogdatapb.Filter, colInfoMap map[string][]*ColumnInfo) ([]*targetMapping, error) {
+	var tableNames []string
+	for tableName := range colInfoMap {
+		tableNames = append(tableNames, tableName)
+	}
+	sort.Strings(tableNames)
+	var targets []*targetMapping
+	for _, tableName := range tableNames {
+		rule, err := MatchTable(tableName, filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil {
+			continue
+		}
+		query := ruleQuery(tableName, rule.Filter)
+		if query == "" {
+			continue
+		}
+		sel, fromTable, err := analyzeSelectFrom(query)
+		if err != nil {
+			return nil, err
+		}
+		if fromTable != sourceTable {
+			continue
+		}
+		target := &targetMapping{table: tableName}
+		targets = append(targets, target)
+		if _, ok := sel.SelectExprs[0].(*sqlparser.StarExpr); ok {
+			continue
+		}
+		target.columns = make(map[string]string)
+		for _, selExpr := range sel.SelectExprs {
+			aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+			if !ok {
+				continue
+			}
+			col, ok := aliased.Expr.(*sqlparser.ColName)
+			if !ok {
+				continue
+			}
+			as := aliased.As
+			if as.IsEmpty() {
+				as = col.Name
+			}
+			target.columns[col.Name.Lowered()] = as.String()
+		}
+	}
+	return targets, nil
+}
+
+// mapIndexColumns renames the columns of an index to the target columns.
+// It returns false if one of them is not in the target.
+func mapIndexColumns(index *sqlparser.IndexDefinition, target *targetMapping) bool {
+	for _, col := range index.Columns {
+		name, ok := target.column(col.Column.String())
+		if !ok {
+			return false
+		}
+		col.Column = sqlparser.NewColIdent(name)
+	}
+	return true
+}
+
+func findColInfo(colInfos []*ColumnInfo, name string) *ColumnInfo {
+	for _, colInfo := range colInfos {
+		if strings.EqualFold(colInfo.Name, name) {
+			return colInfo
+		}
+	}
+	return nil
+}
+
+// The types of a family, from the narrowest to the widest.
+var typeFamilies = [][]string{
+	{"tinyint", "smallint", "mediumint", "int", "bigint"},
+	{"float", "double"},
+	{"char", "varchar"},
+	{"binary", "varbinary"},
+	{"tinytext", "text", "mediumtext", "longtext"},
+	{"tinyblob", "blob", "mediumblob", "longblob"},
+}
+
+// isWidening returns true if a column of the target can be changed to the
+// new type without losing values.
+func isWidening(colInfo *ColumnInfo, newType sqlparser.ColumnType) (bool, error) {
+	stmt, err := sqlparser.Parse(fmt.Sprintf("create table t (c %s)", colInfo.ColumnType))
+	if err != nil {
+		return false, err
+	}
+	oldType := stmt.(*sqlparser.CreateTable).TableSpec.Columns[0].Type
+	if newType.Charset != "" && !strings.EqualFold(newType.Charset, colInfo.CharSet) {
+		return false, nil
+	}
+	oldName, newName := typeName(oldType), typeName(newType)
+	switch {
+	case oldName == "decimal" && newName == "decimal":
+		oldLength, oldScale := typeLength(oldType.Length, 10), typeLength(oldType.Scale, 0)
+		newLength, newScale := typeLength(newType.Length, 10), typeLength(newType.Scale, 0)
+		return newScale >= oldScale && newLength-newScale >= oldLength-oldScale, nil
+	case oldName == "enum" && newName == "enum", oldName == "set" && newName == "set":
+		// The values can only be appended.
+		if len(newType.EnumValues) < len(oldType.EnumValues) {
+			return false, nil
+		}
+		for i, value := range oldType.EnumValues {
+			if newType.EnumValues[i] != value {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+	if oldType.Unsigned != newType.Unsigned {
+		return false, nil
+	}
+	oldFamily, oldRank := typeRank(oldName)
+	newFamily, newRank := typeRank(newName)
+	if oldName != newName && (oldFamily == -1 || oldFamily != newFamily || newRank < oldRank) {
+		return false, nil
+	}
+	if lengthTypes[oldName] {
+		return typeLength(newType.Length, 1) >= typeLength(oldType.Length, 1), nil
+	}
+	return true, nil
+}
+
+// lengthTypes are the types whose length limits their values.
+var lengthTypes = map[string]bool{
+	"char":      true,
+	"varchar":   true,
+	"binary":    true,
+	"varbinary": true,
+}
+
+func typeName(typ sqlparser.ColumnType) string {
+	name := strings.ToLower(typ.Type)
+	if name == "integer" {
+		return "int"
+	}
+	return name
+}
+
+func typeRank(name string) (family, rank int) {
+	for i, types := range typeFamilies {
+		for j, typ := range types {
+			if typ == name {
+				return i, j
+			}
+		}
+	}
+	return -1, -1
+}
+
+func typeLength(length *sqlparser.Literal, def int) int {
+	if length == nil {
+		return def
+	}
+	val, err := strconv.Atoi(length.Val)
+	if err != nil {
+		return def
+	}
+	return val
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func TestBuildAdditiveDDL(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "t1",
+		}, {
+			Match:  "t2",
+			Filter: "select id, val as v2, name from src2",
+		}, {
+			Match:  "t3",
+			Filter: "-80",
+		}, {
+			Match:  "t4",
+			Filter: "select id, val from t1",
+		}},
+	}
+	colInfoMap := map[string][]*ColumnInfo{
+		"t1": {
+			{Name: "id", ColumnType: "int(11)", IsPK: true},
+			{Name: "val", ColumnType: "varchar(10)", CharSet: "utf8mb4"},
+		},
+		"t2": {
+			{Name: "id", ColumnType: "int(11)", IsPK: true},
+			{Name: "v2", ColumnType: "decimal(10,2)"},
+			{Name: "name", ColumnType: "enum('a','b')"},
+		},
+		"t3": {
+			{Name: "id", ColumnType: "bigint(20) unsigned", IsPK: true},
+		},
+		"t4": {
+			{Name: "id", ColumnType: "int(11)", IsPK: true},
+			{Name: "val", ColumnType: "varchar(10)", CharSet: "utf8mb4"},
+		},
+	}
+	testcases := []struct {
+		ddl  string
+		want []string
+		err  string
+	}{{
+		// The columns are only added to the targets that select all the
+		// columns, and the indexes to the targets that select theirs.
+		ddl:  "alter table t1 add column c int after val, add index c_idx (c, val)",
+		want: []string{"alter table t1 add column c int after val, add index c_idx (c, val)"},
+	}, {
+		ddl:  "alter table t1 add index v_idx (val), add column c int",
+		want: []string{"alter table t1 add index v_idx (val), add column c int", "alter table t4 add index v_idx (val)"},
+	}, {
+		ddl:  "create index v_idx on t1(val)",
+		want: []string{"alter table t1 add index v_idx (val)", "alter table t4 add index v_idx (val)"},
+	}, {
+		ddl:  "alter table t1 modify column val varchar(20) not null",
+		want: []string{"alter table t1 modify column val varchar(20) not null", "alter table t4 modify column val varchar(20) not null"},
+	}, {
+		// The keyrange rules select all the columns.
+		ddl:  "alter table t3 add column c int, modify id bigint unsigned",
+		want: []string{"alter table t3 add column c int, modify column id bigint unsigned"},
+	}, {
+		// The columns are renamed, and the ones that are not selected
+		// are skipped.
+		ddl:  "alter table src2 add column c int, add index (val, name), modify val decimal(12,3) after id",
+		want: []string{"alter table t2 add index (v2, `name`), modify column v2 decimal(12,3)"},
+	}, {
+		ddl:  "alter table src2 add index (c)",
+		want: nil,
+	}, {
+		ddl:  "alter table src2 modify name enum('a','b','c')",
+		want: []string{"alter table t2 modify column `name` enum('a', 'b', 'c')"},
+	}, {
+		ddl:  "alter table other add column c int",
+		want: nil,
+	}, {
+		ddl: "alter table t1 modify column val varchar(5)",
+		err: "not an additive DDL: alter table t1 modify column val varchar(5)",
+	}, {
+		ddl: "alter table t1 modify column val int",
+		err: "not an additive DDL: alter table t1 modify column val int",
+	}, {
+		ddl: "alter table src2 modify column val decimal(10,3)",
+		err: "not an additive DDL: alter table src2 modify column val decimal(10,3)",
+	}, {
+		ddl: "alter table src2 modify name enum('b','a','c')",
+		err: "not an additive DDL: alter table src2 modify name enum('b','a','c')",
+	}, {
+		ddl: "alter table t1 drop column val",
+		err: "not an additive DDL: alter table t1 drop column val",
+	}, {
+		ddl: "alter table t1 add primary key (id, val)",
+		err: "not an additive DDL: alter table t1 add primary key (id, val)",
+	}, {
+		ddl: "alter table t1 add column c int, drop index v_idx",
+		err: "not an additive DDL: alter table t1 add column c int, drop index v_idx",
+	}, {
+		ddl: "drop table t1",
+		err: "not an additive DDL: drop table t1",
+	}, {
+		ddl: "rename table t1 to t5",
+		err: "not an additive DDL: rename table t1 to t5",
+	}, {
+		ddl: "create table t3 (id int primary key)",
+		err: "not an additive DDL: create table t3 (id int primary key)",
+	}, {
+		ddl: "alter table t1 truncate partition p0",
+		err: "not an additive DDL: alter table t1 truncate partition p0",
+	}, {
+		// The DDLs of the tables that are not replicated are skipped.
+		ddl:  "create table other (id int primary key)",
+		want: nil,
+	}, {
+		ddl:  "rename table other to other2",
+		want: nil,
+	}, {
+		ddl:  "drop table other, other2",
+		want: nil,
+	}, {
+		ddl:  "alter table other truncate partition p0",
+		want: nil,
+	}, {
+		ddl:  "truncate table other",
+		want: nil,
+	}, {
+		ddl:  "drop table _vt_DROP_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+		want: nil,
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.ddl, func(t *testing.T) {
+			got, err := buildAdditiveDDLs(tcase.ddl, filter, colInfoMap, make(shadowTables))
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, got)
+		})
+	}
+}
+
+func TestBuildAdditiveDDLsOnlineDDL(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "t1",
+		}, {
+			Match:  "t2",
+			Filter: "select id, val as v2 from t1",
+		}},
+	}
+	colInfoMap := map[string][]*ColumnInfo{
+		"t1": {
+			{Name: "id", ColumnType: "int(11)", IsPK: true},
+			{Name: "val", ColumnType: "varchar(10)", CharSet: "utf8mb4"},
+		},
+		"t2": {
+			{Name: "id", ColumnType: "int(11)", IsPK: true},
+			{Name: "v2", ColumnType: "varchar(10)", CharSet: "utf8mb4"},
+		},
+	}
+	const (
+		shadow = "_6ace8bce_f732_11ea_87e9_f875a4d24e90_20200915120410_vrepl"
+		hold   = "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410"
+	)
+	shadows := make(shadowTables)
+	build := func(ddl string) ([]string, error) {
+		return buildAdditiveDDLs(ddl, filter, colInfoMap, shadows)
+	}
+
+	// The DDLs of the shadow table are skipped until it's cut over, and
+	// then they are applied to the targets of the table it replaces.
+	for _, ddl := range []string{
+		"create table " + shadow + " like t1",
+		"alter table " + shadow + " add column c int",
+		"alter table " + shadow + " modify val varchar(20)",
+		"create table _6ace8bce_f732_11ea_87e9_f875a4d24e90_20200915120410_ghc (id int primary key)",
+	} {
+		got, err := build(ddl)
+		require.NoError(t, err, ddl)
+		assert.Empty(t, got, ddl)
+	}
+	got, err := build("rename table t1 to " + hold + ", " + shadow + " to t1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"alter table t1 add column c int, modify column val varchar(20)",
+		"alter table t2 modify column v2 varchar(20)",
+	}, got)
+	assert.Empty(t, shadows)
+
+	// The cut-over of a shadow table whose DDLs are not all known stops
+	// the workflow.
+	got, err = build("rename table " + shadow + " to t1")
+	assert.EqualError(t, err, "cannot apply the online DDL cut-over of t1 from "+shadow+": rename table "+shadow+" to t1")
+	assert.Empty(t, got)
+
+	// The ALTERs of the shadow table must be additive.
+	for _, ddl := range []string{
+		"create table " + shadow + " like t1",
+		"alter table " + shadow + " drop column val",
+	} {
+		_, err := build(ddl)
+		require.NoError(t, err, ddl)
+	}
+	_, err = build("rename table " + shadow + " to t1")
+	assert.EqualError(t, err, "not an additive DDL: rename table "+shadow+" to t1")
+}
@@ -63,6 +63,20 @@ const (
   primary key (vrepl_id, table_name))`
 )
 
+// alterCopyState adds the columns of the tables that are copied
+// concurrently: the rows after prev_lastpk were copied as of snapshot_pos,
+// and completed is set once the whole table was copied. Large tables are
+// copied as several ranges of their primary key: each range has its own row,
+// for the rows after range_start up to range_end.
+var alterCopyState = []string{
+	"alter table _vt.copy_state add column snapshot_pos varbinary(10000) default null",
+	"alter table _vt.copy_state add column prev_lastpk varbinary(2000) default null",
+	"alter table _vt.copy_state add column completed tinyint not null default 0",
+	"alter table _vt.copy_state add column range_id int not null default 0, drop primary key, add primary key (vrepl_id, table_name, range_id)",
+	"alter table _vt.copy_state add column range_start varbinary(2000) default null",
+	"alter table _vt.copy_state add column range_end varbinary(2000) default null",
+}
+
 var withDDL *withddl.WithDDL
 var withDDLInitialQueries []string
 
@@ -74,6 +88,7 @@ func init() {
 	allddls := append([]string{}, binlogplayer.CreateVReplicationTable()...)
 	allddls = append(allddls, binlogplayer.AlterVReplicationTable...)
 	allddls = append(allddls, createReshardingJournalTable, createCopyState)
+	allddls = append(allddls, alterCopyState...)
 	allddls = append(allddls, createVReplicationLogTable)
 	withDDL = withddl.New(allddls)
 
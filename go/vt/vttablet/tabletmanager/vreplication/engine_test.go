@@ -502,6 +502,12 @@ func TestCreateDBAndTable(t *testing.T) {
 		dbClient.ExpectRequestRE("ALTER TABLE _vt.vreplication ADD COLUMN tags.*", &sqltypes.Result{}, nil)
 		dbClient.ExpectRequestRE("create table if not exists _vt.resharding_journal.*", &sqltypes.Result{}, nil)
 		dbClient.ExpectRequestRE("create table if not exists _vt.copy_state.*", &sqltypes.Result{}, nil)
+		dbClient.ExpectRequestRE("alter table _vt.copy_state add column snapshot_pos.*", &sqltypes.Result{}, nil)
+		dbClient.ExpectRequestRE("alter table _vt.copy_state add column prev_lastpk.*", &sqltypes.Result{}, nil)
+		dbClient.ExpectRequestRE("alter table _vt.copy_state add column completed.*", &sqltypes.Result{}, nil)
+		dbClient.ExpectRequestRE("alter table _vt.copy_state add column range_id.*", &sqltypes.Result{}, nil)
+		dbClient.ExpectRequestRE("alter table _vt.copy_state add column range_start.*", &sqltypes.Result{}, nil)
+		dbClient.ExpectRequestRE("alter table _vt.copy_state add column range_end.*", &sqltypes.Result{}, nil)
 	}
 	expectDDLs()
 	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
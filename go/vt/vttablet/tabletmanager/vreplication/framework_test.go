@@ -283,6 +283,11 @@ func (ftc *fakeTabletConn) VStreamRows(ctx context.Context, target *querypb.Targ
 	})
 }
 
+// VStreamResults directly calls into the pre-initialized engine.
+func (ftc *fakeTabletConn) VStreamResults(ctx context.Context, target *querypb.Target, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+	return streamerEngine.StreamResults(ctx, query, send)
+}
+
 //--------------------------------------
 // Binlog Client to TabletManager
 
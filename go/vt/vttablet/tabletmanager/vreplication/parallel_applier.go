@@ -0,0 +1,569 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/log"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	// maxTrackedWrites is the number of rows whose last writer the
+	// parallelApplier remembers before it forgets the committed ones.
+	maxTrackedWrites = 10000
+
+	// parallelApplyLockWaitTimeout is the innodb_lock_wait_timeout of the
+	// applier connections, in seconds. Rows that the write sets don't
+	// capture, like unique secondary keys, can make a transaction wait for
+	// a later one, which itself waits for its turn to commit. The short
+	// timeout breaks such waits, and the transactions are then applied
+	// again one at a time.
+	parallelApplyLockWaitTimeout = 5
+)
+
+// parallelApplier applies the replicated transactions of a vplayer on a
+// pool of target connections. Each transaction has a write set: the
+// primary keys of the rows it writes, or the table if the table plan has
+// no primary key. A transaction starts once the earlier transactions that
+// share any of its write set committed, so transactions on different rows
+// run concurrently, and transactions on the same rows run in source order.
+// All transactions commit in source order, along with the update of the
+// position in _vt.vreplication, so the saved position is always one that
+// all the transactions up to it were applied at.
+//
+// Statements from statement based replication have no known write set:
+// their transactions are applied alone, after all the earlier ones
+// committed and before any later one starts.
+//
+// If a transaction fails, the transactions that didn't commit yet are
+// aborted, and the vplayer applies them again, one at a time, on the
+// connection of the stream.
+type parallelApplier struct {
+	vp  *vplayer
+	ctx context.Context
+	// idle holds the workers that are not applying a transaction.
+	idle chan *applierWorker
+	// pkIndexes caches the indexes of the primary key references in the
+	// fields of each table plan.
+	pkIndexes map[*TablePlan][]int
+
+	// mu protects the fields below, and cond signals their changes.
+	mu   sync.Mutex
+	cond *sync.Cond
+	// lastSeq is the sequence of the last scheduled transaction.
+	lastSeq int64
+	// committedSeq is the sequence of the last committed transaction.
+	committedSeq int64
+	// running is the number of transactions that workers are applying.
+	running int
+	// lastWriters is the sequence of the last transaction that wrote each
+	// row or table.
+	lastWriters map[string]int64
+	// lastBarrier is the sequence of the last transaction that had to be
+	// applied alone.
+	lastBarrier int64
+	// pending are the scheduled transactions that didn't commit yet.
+	pending map[int64]*applierTxn
+	// err is the error of the first transaction that failed. Transactions
+	// that didn't commit are aborted until the vplayer applies them again.
+	err error
+}
+
+// applierTxn is a replicated transaction.
+type applierTxn struct {
+	seq int64
+	// dependsOn is the sequence of the transaction that has to commit
+	// before this one starts.
+	dependsOn int64
+	// writeset are the keys of the rows and tables the transaction writes.
+	writeset []string
+	// barrier is set if the transaction has to be applied alone.
+	barrier bool
+	events  []*applierEvent
+	// pos and timestamp are the position and time saved with the commit.
+	pos       mysql.Position
+	timestamp int64
+}
+
+// applierEvent is a row event or a statement of a transaction.
+type applierEvent struct {
+	tplan     *TablePlan
+	rowEvent  *binlogdatapb.RowEvent
+	statement string
+}
+
+// applierWorker is a target connection of the parallelApplier.
+type applierWorker struct {
+	dbClient  *vdbClient
+	connected bool
+}
+
+func newParallelApplier(ctx context.Context, vp *vplayer, workers int) *parallelApplier {
+	pa := &parallelApplier{
+		vp:          vp,
+		ctx:         ctx,
+		idle:        make(chan *applierWorker, workers),
+		pkIndexes:   make(map[*TablePlan][]int),
+		lastWriters: make(map[string]int64),
+		pending:     make(map[int64]*applierTxn),
+	}
+	pa.cond = sync.NewCond(&pa.mu)
+	for i := 0; i < workers; i++ {
+		pa.idle <- pa.newWorker()
+	}
+	// Wake up the waiting workers when the context is done.
+	go func() {
+		<-ctx.Done()
+		pa.mu.Lock()
+		defer pa.mu.Unlock()
+		pa.cond.Broadcast()
+	}()
+	return pa
+}
+
+func (pa *parallelApplier) newWorker() *applierWorker {
+	return &applierWorker{dbClient: newVDBClient(pa.vp.vr.vre.dbClientFactoryFiltered(), pa.vp.vr.stats)}
+}
+
+func (w *applierWorker) connect() error {
+	if w.connected {
+		return nil
+	}
+	if err := w.dbClient.connectFiltered(); err != nil {
+		return err
+	}
+	// Gap locks would add conflicts between transactions that write
+	// different rows.
+	if _, err := w.dbClient.Execute("set session transaction isolation level read committed"); err != nil {
+		w.dbClient.Close()
+		return err
+	}
+	if _, err := w.dbClient.Execute(fmt.Sprintf("set session innodb_lock_wait_timeout = %d", parallelApplyLockWaitTimeout)); err != nil {
+		w.dbClient.Close()
+		return err
+	}
+	w.connected = true
+	return nil
+}
+
+// addRowEvent adds a row event to the transaction, and the rows it writes
+// to its write set.
+func (pa *parallelApplier) addRowEvent(txn *applierTxn, tplan *TablePlan, rowEvent *binlogdatapb.RowEvent) {
+	txn.events = append(txn.events, &applierEvent{tplan: tplan, rowEvent: rowEvent})
+	pkIndexes, ok := pa.pkIndexes[tplan]
+	if !ok {
+		pkIndexes = buildPKIndexes(tplan)
+		pa.pkIndexes[tplan] = pkIndexes
+	}
+	if pkIndexes == nil {
+		txn.writeset = append(txn.writeset, tplan.TargetName)
+		return
+	}
+	for _, change := range rowEvent.RowChanges {
+		for _, row := range []*querypb.Row{change.Before, change.After} {
+			if row == nil {
+				continue
+			}
+			vals := sqltypes.MakeRowTrusted(tplan.Fields, row)
+			var buf strings.Builder
+			buf.WriteString(tplan.TargetName)
+			for _, i := range pkIndexes {
+				buf.WriteByte(',')
+				vals[i].EncodeSQL(&buf)
+			}
+			txn.writeset = append(txn.writeset, buf.String())
+		}
+	}
+}
+
+// buildPKIndexes returns the indexes of the primary key references of a
+// table plan in its fields, or nil if the rows of the table can't be told
+// apart.
+func buildPKIndexes(tplan *TablePlan) []int {
+	if len(tplan.PKReferences) == 0 {
+		return nil
+	}
+	var pkIndexes []int
+	for _, pkref := range tplan.PKReferences {
+		found := false
+		for i, field := range tplan.Fields {
+			if field.Name == pkref {
+				pkIndexes = append(pkIndexes, i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil
+		}
+	}
+	return pkIndexes
+}
+
+// addStatement adds a statement to the transaction. Only savepoints can be
+// applied in parallel with other transactions.
+func (pa *parallelApplier) addStatement(txn *applierTxn, event *binlogdatapb.VEvent) {
+	sql := event.Statement
+	if sql == "" {
+		sql = event.Dml
+	}
+	txn.events = append(txn.events, &applierEvent{statement: sql})
+	if event.Type != binlogdatapb.VEventType_SAVEPOINT {
+		txn.barrier = true
+	}
+}
+
+// schedule starts applying a transaction on an idle worker. If a
+// transaction failed, the ones that didn't commit are applied again first.
+func (pa *parallelApplier) schedule(txn *applierTxn) error {
+	pa.mu.Lock()
+	failed := pa.err != nil
+	pa.mu.Unlock()
+	if failed {
+		if err := pa.drain(); err != nil {
+			return err
+		}
+	}
+
+	var w *applierWorker
+	select {
+	case w = <-pa.idle:
+	case <-pa.ctx.Done():
+		return pa.ctx.Err()
+	}
+
+	pa.mu.Lock()
+	pa.sequence(txn)
+	pa.pending[txn.seq] = txn
+	pa.running++
+	pa.mu.Unlock()
+
+	go pa.run(w, txn)
+	return nil
+}
+
+// sequence assigns the next sequence to a transaction, and finds the
+// transaction it depends on. It must be called with the lock held.
+func (pa *parallelApplier) sequence(txn *applierTxn) {
+	pa.lastSeq++
+	txn.seq = pa.lastSeq
+	txn.dependsOn = pa.lastBarrier
+	if txn.barrier {
+		txn.dependsOn = txn.seq - 1
+		pa.lastBarrier = txn.seq
+	}
+	if len(pa.lastWriters) > maxTrackedWrites {
+		for key, seq := range pa.lastWriters {
+			if seq <= pa.committedSeq {
+				delete(pa.lastWriters, key)
+			}
+		}
+	}
+	for _, key := range txn.writeset {
+		if seq := pa.lastWriters[key]; seq > txn.dependsOn {
+			txn.dependsOn = seq
+		}
+		pa.lastWriters[key] = txn.seq
+	}
+}
+
+func (pa *parallelApplier) run(w *applierWorker, txn *applierTxn) {
+	err := pa.apply(w, txn)
+
+	pa.mu.Lock()
+	if err != nil {
+		if pa.err == nil {
+			log.Infof("Parallel apply of the transaction at %v failed, the transactions that didn't commit will be applied again one at a time: %v", txn.pos, err)
+			pa.err = err
+		}
+		if rerr := w.dbClient.Rollback(); rerr != nil {
+			// The connection is unusable: replace it.
+			w.dbClient.Close()
+			w = pa.newWorker()
+		}
+		// The transaction is not retried on this connection.
+		w.dbClient.queries = nil
+	} else {
+		pa.committedSeq = txn.seq
+		delete(pa.pending, txn.seq)
+	}
+	// The worker goes back to idle before it stops counting as running, so
+	// that close finds it there once no worker runs. The send doesn't block:
+	// idle has room for all the workers.
+	pa.idle <- w
+	pa.running--
+	pa.cond.Broadcast()
+	pa.mu.Unlock()
+}
+
+// apply applies a transaction once the transaction it depends on
+// committed, and commits it in its turn.
+func (pa *parallelApplier) apply(w *applierWorker, txn *applierTxn) error {
+	if err := pa.waitCommitted(txn.dependsOn); err != nil {
+		return err
+	}
+	if err := w.connect(); err != nil {
+		return err
+	}
+	if err := w.dbClient.Begin(); err != nil {
+		return err
+	}
+	if err := pa.vp.applyTxnEvents(txn, w.dbClient.Execute); err != nil {
+		return err
+	}
+	if err := pa.waitCommitted(txn.seq - 1); err != nil {
+		return err
+	}
+	return pa.vp.commitTxn(txn, w.dbClient)
+}
+
+// waitCommitted waits for the transaction of the given sequence to commit.
+func (pa *parallelApplier) waitCommitted(seq int64) error {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+	for pa.committedSeq < seq {
+		if pa.err != nil {
+			return pa.err
+		}
+		if err := pa.ctx.Err(); err != nil {
+			return err
+		}
+		pa.cond.Wait()
+	}
+	return nil
+}
+
+// drain waits for all the scheduled transactions to commit. If one of them
+// failed, the ones that didn't commit are applied again, one at a time, on
+// the connection of the stream.
+func (pa *parallelApplier) drain() error {
+	pa.mu.Lock()
+	for pa.running > 0 || (pa.committedSeq < pa.lastSeq && pa.err == nil) {
+		if err := pa.ctx.Err(); err != nil {
+			pa.mu.Unlock()
+			return err
+		}
+		pa.cond.Wait()
+	}
+	var txns []*applierTxn
+	if pa.err != nil {
+		for _, txn := range pa.pending {
+			txns = append(txns, txn)
+		}
+		sort.Slice(txns, func(i, j int) bool { return txns[i].seq < txns[j].seq })
+	}
+	pa.mu.Unlock()
+
+	// No worker is running: the transactions can be applied without the
+	// lock.
+	for _, txn := range txns {
+		if err := pa.vp.applyTxnSerially(txn); err != nil {
+			return err
+		}
+		pa.mu.Lock()
+		pa.committedSeq = txn.seq
+		delete(pa.pending, txn.seq)
+		pa.mu.Unlock()
+	}
+	pa.mu.Lock()
+	pa.err = nil
+	pa.mu.Unlock()
+	return nil
+}
+
+// close aborts the transactions that didn't commit, waits for the workers
+// to exit and closes their connections.
+func (pa *parallelApplier) close() {
+	pa.mu.Lock()
+	if pa.err == nil {
+		pa.err = fmt.Errorf("vplayer is closing")
+	}
+	pa.cond.Broadcast()
+	for pa.running > 0 {
+		pa.cond.Wait()
+	}
+	pa.mu.Unlock()
+
+	for len(pa.idle) > 0 {
+		w := <-pa.idle
+		if w.connected {
+			w.dbClient.Close()
+		}
+	}
+}
+
+// applyParallelEvent accumulates the row events and statements of a
+// transaction, and schedules the transaction on the parallelApplier when
+// it commits. It returns false for the events that have to go through
+// applyEvent, after the scheduled transactions committed if they save the
+// position.
+func (vp *vplayer) applyParallelEvent(event *binlogdatapb.VEvent, mustSave bool) (bool, error) {
+	pa := vp.parallel
+	stats := NewVrLogStats(event.Type.String())
+	switch event.Type {
+	case binlogdatapb.VEventType_BEGIN:
+		// No-op: the transaction is created by its first event.
+		return true, nil
+	case binlogdatapb.VEventType_FIELD:
+		tplan, err := vp.replicatorPlan.buildExecutionPlan(event.FieldEvent)
+		if err != nil {
+			return true, err
+		}
+		if old := vp.tablePlans[event.FieldEvent.TableName]; old != nil {
+			delete(pa.pkIndexes, old)
+		}
+		vp.tablePlans[event.FieldEvent.TableName] = tplan
+		stats.Send(fmt.Sprintf("%v", event.FieldEvent))
+		return true, nil
+	case binlogdatapb.VEventType_INSERT, binlogdatapb.VEventType_DELETE, binlogdatapb.VEventType_UPDATE,
+		binlogdatapb.VEventType_REPLACE, binlogdatapb.VEventType_SAVEPOINT:
+		sql := event.Statement
+		if sql == "" {
+			sql = event.Dml
+		}
+		// If the event is for one of the AWS RDS "special" or pt-table-checksum tables, we skip
+		if strings.Contains(sql, " mysql.rds_") || strings.Contains(sql, " percona.checksums") {
+			return true, nil
+		}
+		if event.Type != binlogdatapb.VEventType_SAVEPOINT && !vp.canAcceptStmtEvents {
+			return true, fmt.Errorf("filter rules are not supported for SBR replication: %v", vp.vr.source.Filter.GetRules())
+		}
+		if vp.txn == nil {
+			vp.txn = &applierTxn{}
+		}
+		pa.addStatement(vp.txn, event)
+		stats.Send(sql)
+		return true, nil
+	case binlogdatapb.VEventType_ROW:
+		tplan := vp.tablePlans[event.RowEvent.TableName]
+		if tplan == nil {
+			return true, fmt.Errorf("unexpected event on table %s", event.RowEvent.TableName)
+		}
+		if vp.txn == nil {
+			vp.txn = &applierTxn{}
+		}
+		pa.addRowEvent(vp.txn, tplan, event.RowEvent)
+		return true, nil
+	case binlogdatapb.VEventType_COMMIT:
+		if vp.txn == nil {
+			// Empty transactions go through applyEvent. If the stop position
+			// is reached, it saves the position.
+			if mustSave {
+				return false, pa.drain()
+			}
+			return false, nil
+		}
+		txn := vp.txn
+		vp.txn = nil
+		txn.pos = vp.pos
+		txn.timestamp = event.Timestamp
+		if err := pa.schedule(txn); err != nil {
+			return true, err
+		}
+		vp.unsavedEvent = nil
+		vp.timeLastSaved = time.Now()
+		vp.numAccumulatedHeartbeats = 0
+		if !mustSave {
+			return true, nil
+		}
+		if err := pa.drain(); err != nil {
+			return true, err
+		}
+		log.Infof("Stopped at position: %v", vp.stopPos)
+		if vp.saveStop {
+			if err := vp.vr.setState(binlogplayer.BlpStopped, fmt.Sprintf("Stopped at position %v", vp.stopPos)); err != nil {
+				return true, err
+			}
+		}
+		return true, io.EOF
+	case binlogdatapb.VEventType_OTHER, binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_JOURNAL:
+		return false, pa.drain()
+	}
+	return false, nil
+}
+
+// applyTxnEvents executes the row events and statements of a transaction.
+func (vp *vplayer) applyTxnEvents(txn *applierTxn, exec func(string) (*sqltypes.Result, error)) error {
+	for _, event := range txn.events {
+		if event.rowEvent == nil {
+			start := time.Now()
+			_, err := exec(event.statement)
+			vp.vr.stats.QueryTimings.Record(vp.phase, start)
+			vp.vr.stats.QueryCount.Add(vp.phase, 1)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		for _, change := range event.rowEvent.RowChanges {
+			_, err := event.tplan.applyChange(change, func(sql string) (*sqltypes.Result, error) {
+				stats := NewVrLogStats("ROWCHANGE")
+				start := time.Now()
+				qr, err := exec(sql)
+				vp.vr.stats.QueryCount.Add(vp.phase, 1)
+				vp.vr.stats.QueryTimings.Record(vp.phase, start)
+				stats.Send(sql)
+				return qr, err
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// commitTxn saves the position of a transaction and commits it.
+func (vp *vplayer) commitTxn(txn *applierTxn, dbClient *vdbClient) error {
+	update := binlogplayer.GenerateUpdatePos(vp.vr.id, txn.pos, time.Now().Unix(), txn.timestamp, vp.vr.stats.CopyRowCount.Get(), *vreplicationStoreCompressedGTID)
+	if _, err := dbClient.Execute(update); err != nil {
+		return fmt.Errorf("error %v updating position", err)
+	}
+	if err := dbClient.Commit(); err != nil {
+		return err
+	}
+	vp.vr.stats.SetLastPosition(txn.pos)
+	return nil
+}
+
+// applyTxnSerially applies a transaction on the connection of the stream.
+func (vp *vplayer) applyTxnSerially(txn *applierTxn) error {
+	dbClient := vp.vr.dbClient
+	if err := dbClient.Begin(); err != nil {
+		return err
+	}
+	err := vp.applyTxnEvents(txn, func(sql string) (*sqltypes.Result, error) {
+		return dbClient.ExecuteWithRetry(vp.parallel.ctx, sql)
+	})
+	if err != nil {
+		return err
+	}
+	return vp.commitTxn(txn, dbClient)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func newTestParallelApplier() *parallelApplier {
+	pa := &parallelApplier{
+		pkIndexes:   make(map[*TablePlan][]int),
+		lastWriters: make(map[string]int64),
+		pending:     make(map[int64]*applierTxn),
+	}
+	pa.cond = sync.NewCond(&pa.mu)
+	return pa
+}
+
+func TestParallelApplierWriteset(t *testing.T) {
+	pa := newTestParallelApplier()
+	t1 := &TablePlan{
+		TargetName:   "t1",
+		Fields:       sqltypes.MakeTestFields("c1|id|c2", "varchar|int64|varchar"),
+		PKReferences: []string{"id", "c1"},
+	}
+	nopk := &TablePlan{
+		TargetName: "nopk",
+		Fields:     sqltypes.MakeTestFields("c1", "varchar"),
+	}
+	rows := func(values ...string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(t1.Fields, values...)
+	}
+
+	txn := &applierTxn{}
+	pa.addRowEvent(txn, t1, &binlogdatapb.RowEvent{
+		TableName: "t1",
+		RowChanges: []*binlogdatapb.RowChange{{
+			After: sqltypes.RowToProto3(rows("a|1|x").Rows[0]),
+		}, {
+			Before: sqltypes.RowToProto3(rows("a|1|x").Rows[0]),
+			After:  sqltypes.RowToProto3(rows("b|2|x").Rows[0]),
+		}},
+	})
+	pa.addRowEvent(txn, nopk, &binlogdatapb.RowEvent{
+		TableName:  "nopk",
+		RowChanges: []*binlogdatapb.RowChange{{After: sqltypes.RowToProto3(sqltypes.MakeTestResult(nopk.Fields, "a").Rows[0])}},
+	})
+	assert.Equal(t, []string{"t1,1,'a'", "t1,1,'a'", "t1,2,'b'", "nopk"}, txn.writeset)
+	assert.False(t, txn.barrier)
+
+	pa.addStatement(txn, &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_SAVEPOINT, Statement: "savepoint a"})
+	assert.False(t, txn.barrier)
+	pa.addStatement(txn, &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_INSERT, Dml: "insert into t1 values (3, 'c')"})
+	assert.True(t, txn.barrier)
+	assert.Equal(t, "insert into t1 values (3, 'c')", txn.events[len(txn.events)-1].statement)
+}
+
+func TestParallelApplierSequence(t *testing.T) {
+	pa := newTestParallelApplier()
+	testcases := []struct {
+		writeset  []string
+		barrier   bool
+		dependsOn int64
+	}{{
+		writeset:  []string{"t1,1"},
+		dependsOn: 0,
+	}, {
+		writeset:  []string{"t1,2"},
+		dependsOn: 0,
+	}, {
+		// Writes a row of the first transaction.
+		writeset:  []string{"t1,3", "t1,1"},
+		dependsOn: 1,
+	}, {
+		writeset:  []string{"t1,2", "t1,3"},
+		dependsOn: 3,
+	}, {
+		// Barriers wait for all the earlier transactions.
+		barrier:   true,
+		dependsOn: 4,
+	}, {
+		// And the later transactions wait for them.
+		writeset:  []string{"t1,4"},
+		dependsOn: 5,
+	}, {
+		writeset:  []string{"t1,5"},
+		dependsOn: 5,
+	}}
+	for i, tcase := range testcases {
+		txn := &applierTxn{writeset: tcase.writeset, barrier: tcase.barrier}
+		pa.sequence(txn)
+		assert.Equal(t, int64(i+1), txn.seq)
+		assert.Equal(t, tcase.dependsOn, txn.dependsOn, "transaction %d", txn.seq)
+	}
+}
+
+func TestParallelApplierForgetsCommittedWrites(t *testing.T) {
+	defer func(saved int) { maxTrackedWrites = saved }(maxTrackedWrites)
+	maxTrackedWrites = 2
+
+	pa := newTestParallelApplier()
+	for _, key := range []string{"t1,1", "t1,2", "t1,3"} {
+		pa.sequence(&applierTxn{writeset: []string{key}})
+	}
+	pa.committedSeq = 2
+	txn := &applierTxn{writeset: []string{"t1,3"}}
+	pa.sequence(txn)
+	assert.Equal(t, int64(3), txn.dependsOn)
+	assert.Equal(t, map[string]int64{"t1,3": 4}, pa.lastWriters)
+}
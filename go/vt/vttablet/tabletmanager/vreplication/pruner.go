@@ -0,0 +1,308 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// The streams of a Reshard that are seeded from a backup start on a target
+// that was restored from a backup of their source shard, and that contains
+// all the rows of the source shard at the position of the stream. Before
+// replicating, the pruner deletes the rows of the tables that are outside of
+// the key range of their rule, in batches that wait for the throttler. The
+// rows are scanned in primary key order, and their keyspace ids are computed
+// with the vindex of the table in the source keyspace. If the stream is
+// restarted while pruning, the pruning starts over, which only rescans the
+// rows that were kept.
+
+var pruneBatchSize = flag.Int("vreplication_prune_batch_size", 1000, "Number of rows that the streams seeded from a backup scan at a time to delete the rows outside of their key range, between throttler checks.")
+
+// prunePlan is the plan to prune a table.
+type prunePlan struct {
+	table    string
+	keyRange *topodatapb.KeyRange
+	vindex   vindexes.Vindex
+	// columns are the primary key columns, followed by the vindex columns
+	// that are not part of the primary key.
+	columns []string
+	// numPKs is the number of primary key columns.
+	numPKs int
+	// vindexColumns are the indexes of the vindex columns in columns.
+	vindexColumns []int
+}
+
+// buildPrunePlans returns the plans to prune the tables of the target that
+// match a key range rule of the filter, sorted by table name.
+func buildPrunePlans(filter *binlogdatapb.Filter, colInfoMap map[string][]*ColumnInfo, kschema *vindexes.KeyspaceSchema) ([]*prunePlan, error) {
+	tables := make([]string, 0, len(colInfoMap))
+	for table := range colInfoMap {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	var plans []*prunePlan
+	for _, table := range tables {
+		rule, err := MatchTable(table, filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil || rule.Filter == ExcludeStr {
+			continue
+		}
+		if !key.IsKeyRange(rule.Filter) {
+			return nil, fmt.Errorf("table %s: streams seeded from a backup only support key range filters, got: %s", table, rule.Filter)
+		}
+		keyRanges, err := key.ParseShardingSpec(rule.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if len(keyRanges) != 1 {
+			return nil, fmt.Errorf("error parsing keyrange: %v", rule.Filter)
+		}
+
+		vtable := kschema.Tables[table]
+		if vtable == nil {
+			return nil, fmt.Errorf("table %s not found in vschema", table)
+		}
+		cv, err := vindexes.FindBestColVindex(vtable)
+		if err != nil {
+			return nil, err
+		}
+
+		plan := &prunePlan{
+			table:    table,
+			keyRange: keyRanges[0],
+			vindex:   cv.Vindex,
+		}
+		for _, colInfo := range colInfoMap[table] {
+			if colInfo.IsPK {
+				plan.columns = append(plan.columns, colInfo.Name)
+			}
+		}
+		plan.numPKs = len(plan.columns)
+	nextVindexColumn:
+		for _, col := range cv.Columns {
+			for i, name := range plan.columns {
+				if col.EqualString(name) {
+					plan.vindexColumns = append(plan.vindexColumns, i)
+					continue nextVindexColumn
+				}
+			}
+			plan.vindexColumns = append(plan.vindexColumns, len(plan.columns))
+			plan.columns = append(plan.columns, col.String())
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// selectQuery returns the query that reads the next batch of rows, after
+// the primary key of the last row of the previous batch, if any.
+func (plan *prunePlan) selectQuery(lastpk []sqltypes.Value, batchSize int) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.WriteString("select ")
+	plan.writeColumns(buf, plan.columns)
+	buf.Myprintf(" from %v", sqlparser.NewTableIdent(plan.table))
+	if lastpk != nil {
+		buf.WriteString(" where ")
+		plan.writePKTuple(buf)
+		buf.WriteString(" > ")
+		plan.writeValuesTuple(buf, lastpk)
+	}
+	buf.WriteString(" order by ")
+	plan.writeColumns(buf, plan.columns[:plan.numPKs])
+	fmt.Fprintf(buf, " limit %d", batchSize)
+	return buf.String()
+}
+
+// deleteQuery returns the query that deletes the rows of primary keys pks.
+func (plan *prunePlan) deleteQuery(pks [][]sqltypes.Value) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("delete from %v where ", sqlparser.NewTableIdent(plan.table))
+	plan.writePKTuple(buf)
+	buf.WriteString(" in (")
+	for i, pk := range pks {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		plan.writeValuesTuple(buf, pk)
+	}
+	buf.WriteString(")")
+	return buf.String()
+}
+
+func (plan *prunePlan) writeColumns(buf *sqlparser.TrackedBuffer, columns []string) {
+	for i, col := range columns {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(col))
+	}
+}
+
+func (plan *prunePlan) writePKTuple(buf *sqlparser.TrackedBuffer) {
+	if plan.numPKs == 1 {
+		plan.writeColumns(buf, plan.columns[:1])
+		return
+	}
+	buf.WriteString("(")
+	plan.writeColumns(buf, plan.columns[:plan.numPKs])
+	buf.WriteString(")")
+}
+
+func (plan *prunePlan) writeValuesTuple(buf *sqlparser.TrackedBuffer, values []sqltypes.Value) {
+	if len(values) != 1 {
+		buf.WriteString("(")
+	}
+	for i, val := range values {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		val.EncodeSQL(buf)
+	}
+	if len(values) != 1 {
+		buf.WriteString(")")
+	}
+}
+
+// rowsToDelete returns the primary keys of the rows that are outside of the
+// key range.
+func (plan *prunePlan) rowsToDelete(rows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	vindexValues := make([][]sqltypes.Value, 0, len(rows))
+	for _, row := range rows {
+		values := make([]sqltypes.Value, 0, len(plan.vindexColumns))
+		for _, col := range plan.vindexColumns {
+			values = append(values, row[col])
+		}
+		vindexValues = append(vindexValues, values)
+	}
+	destinations, err := vindexes.Map(plan.vindex, nil, vindexValues)
+	if err != nil {
+		return nil, err
+	}
+	if len(destinations) != len(rows) {
+		return nil, fmt.Errorf("mapping %d rows to keyspace ids returned %d destinations", len(rows), len(destinations))
+	}
+
+	var pks [][]sqltypes.Value
+	for i, dest := range destinations {
+		ksid, ok := dest.(key.DestinationKeyspaceID)
+		if !ok || len(ksid) == 0 {
+			return nil, fmt.Errorf("could not map %v to a keyspace id, got destination %v", vindexValues[i], dest)
+		}
+		if !key.KeyRangeContains(plan.keyRange, ksid) {
+			pks = append(pks, rows[i][:plan.numPKs])
+		}
+	}
+	return pks, nil
+}
+
+// pruner deletes the rows that are outside of the key range of a stream
+// seeded from a backup.
+type pruner struct {
+	vr *vreplicator
+}
+
+func newPruner(vr *vreplicator) *pruner {
+	return &pruner{vr: vr}
+}
+
+// prune prunes all the tables, then clears SeededFromBackup from the source
+// of the stream.
+func (p *pruner) prune(ctx context.Context) error {
+	vschema, err := p.vr.vre.ts.GetVSchema(ctx, p.vr.source.Keyspace)
+	if err != nil {
+		return err
+	}
+	kschema, err := vindexes.BuildKeyspaceSchema(vschema, p.vr.source.Keyspace)
+	if err != nil {
+		return err
+	}
+	plans, err := buildPrunePlans(p.vr.source.Filter, p.vr.colInfoMap, kschema)
+	if err != nil {
+		return err
+	}
+
+	if err := p.vr.insertLog(LogPruneStart, fmt.Sprintf("Pruning %d tables seeded from a backup", len(plans))); err != nil {
+		return err
+	}
+	for _, plan := range plans {
+		if err := p.pruneTable(ctx, plan); err != nil {
+			return err
+		}
+	}
+
+	source := proto.Clone(p.vr.source).(*binlogdatapb.BinlogSource)
+	source.SeededFromBackup = false
+	query := fmt.Sprintf("update _vt.vreplication set source=%s where id=%d", encodeString(source.String()), p.vr.id)
+	if _, err := p.vr.dbClient.ExecuteFetch(query, 1); err != nil {
+		return fmt.Errorf("could not clear seeded_from_backup: %v: %v", query, err)
+	}
+	p.vr.source.SeededFromBackup = false
+	return p.vr.insertLog(LogPruneEnd, "Prune phase completed")
+}
+
+func (p *pruner) pruneTable(ctx context.Context, plan *prunePlan) error {
+	var lastpk []sqltypes.Value
+	var scanned, deleted int
+	for {
+		p.vr.vre.throttlerClient.Throttle(ctx)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		qr, err := p.vr.dbClient.ExecuteFetch(plan.selectQuery(lastpk, *pruneBatchSize), *pruneBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(qr.Rows) == 0 {
+			break
+		}
+		pks, err := plan.rowsToDelete(qr.Rows)
+		if err != nil {
+			return vterrors.Wrapf(err, "table %s", plan.table)
+		}
+		if len(pks) != 0 {
+			if _, err := p.vr.dbClient.ExecuteFetch(plan.deleteQuery(pks), 0); err != nil {
+				return err
+			}
+		}
+		scanned += len(qr.Rows)
+		deleted += len(pks)
+		if len(qr.Rows) < *pruneBatchSize {
+			break
+		}
+		lastpk = qr.Rows[len(qr.Rows)-1][:plan.numPKs]
+	}
+	return p.vr.setMessage(fmt.Sprintf("Pruned table %s: deleted %d of %d rows outside of key range %s",
+		plan.table, deleted, scanned, key.KeyRangeString(plan.keyRange)))
+}
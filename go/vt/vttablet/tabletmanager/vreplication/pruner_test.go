@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestPrunePlans(t *testing.T) {
+	kschema, err := vindexes.BuildKeyspaceSchema(&vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}}},
+			"t2": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "cid", Name: "hash"}}},
+		},
+	}, "ks")
+	require.NoError(t, err)
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{
+			{Match: "ref", Filter: ExcludeStr},
+			{Match: "/.*", Filter: "-80"},
+		},
+	}
+	colInfoMap := map[string][]*ColumnInfo{
+		"t2":  {{Name: "cid", IsPK: false}, {Name: "id", IsPK: true}, {Name: "seq", IsPK: true}},
+		"t1":  {{Name: "id", IsPK: true}, {Name: "val"}},
+		"ref": {{Name: "id", IsPK: true}},
+	}
+	plans, err := buildPrunePlans(filter, colInfoMap, kschema)
+	require.NoError(t, err)
+	require.Len(t, plans, 2)
+
+	// The vindex column of t1 is its primary key.
+	t1 := plans[0]
+	assert.Equal(t, "t1", t1.table)
+	assert.Equal(t, []string{"id"}, t1.columns)
+	assert.Equal(t, []int{0}, t1.vindexColumns)
+	assert.Equal(t, "select id from t1 order by id limit 10", t1.selectQuery(nil, 10))
+	assert.Equal(t, "select id from t1 where id > 4 order by id limit 10", t1.selectQuery([]sqltypes.Value{sqltypes.NewInt64(4)}, 10))
+
+	// 1 maps to 166b40b44aba4bd6 and 2 to 06e7ea22ce92708f, which are in
+	// -80, and 4 maps to d2fd8867d50d2dfe, which is not.
+	pks, err := t1.rowsToDelete([][]sqltypes.Value{
+		{sqltypes.NewInt64(1)},
+		{sqltypes.NewInt64(2)},
+		{sqltypes.NewInt64(4)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewInt64(4)}}, pks)
+	assert.Equal(t, "delete from t1 where id in (4)", t1.deleteQuery(pks))
+
+	// The vindex column of t2 is not part of its primary key.
+	t2 := plans[1]
+	assert.Equal(t, "t2", t2.table)
+	assert.Equal(t, []string{"id", "seq", "cid"}, t2.columns)
+	assert.Equal(t, 2, t2.numPKs)
+	assert.Equal(t, []int{2}, t2.vindexColumns)
+	assert.Equal(t, "select id, seq, cid from t2 where (id, seq) > (1, 'a') order by id, seq limit 10",
+		t2.selectQuery([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")}, 10))
+
+	pks, err = t2.rowsToDelete([][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NewInt64(2)},
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("b"), sqltypes.NewInt64(4)},
+		{sqltypes.NewInt64(2), sqltypes.NewVarChar("a"), sqltypes.NewInt64(4)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "delete from t2 where (id, seq) in ((1, 'b'), (2, 'a'))", t2.deleteQuery(pks))
+}
+
+func TestPrunePlanErrors(t *testing.T) {
+	kschema, err := vindexes.BuildKeyspaceSchema(&vschemapb.Keyspace{Sharded: true}, "ks")
+	require.NoError(t, err)
+	colInfoMap := map[string][]*ColumnInfo{
+		"t1": {{Name: "id", IsPK: true}},
+	}
+
+	filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select * from t1"}}}
+	_, err = buildPrunePlans(filter, colInfoMap, kschema)
+	assert.EqualError(t, err, "table t1: streams seeded from a backup only support key range filters, got: select * from t1")
+
+	filter = &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "80-"}}}
+	_, err = buildPrunePlans(filter, colInfoMap, kschema)
+	assert.EqualError(t, err, "table t1 not found in vschema")
+}
@@ -82,7 +82,7 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 		return &tplanv, nil
 	}
 	// select * construct was used. We need to use the field names.
-	tplan, err := rp.buildFromFields(prelim.TargetName, prelim.Lastpk, fieldEvent.Fields)
+	tplan, err := rp.buildFromFields(prelim.TargetName, prelim.Lastpk, prelim.PKRanges, fieldEvent.Fields)
 	if err != nil {
 		return nil, err
 	}
@@ -93,10 +93,11 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 // buildFromFields builds a full TablePlan, but uses the field info as the
 // full column list. This happens when the query used was a 'select *', which
 // requires us to wait for the field info sent by the source.
-func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Result, fields []*querypb.Field) (*TablePlan, error) {
+func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Result, ranges []*pkRange, fields []*querypb.Field) (*TablePlan, error) {
 	tpb := &tablePlanBuilder{
 		name:     sqlparser.NewTableIdent(tableName),
 		lastpk:   lastpk,
+		ranges:   ranges,
 		colInfos: rp.ColInfoMap[tableName],
 		stats:    rp.stats,
 	}
@@ -177,6 +178,10 @@ type TablePlan struct {
 	// will be used for building the final plan after field info
 	// is received.
 	Lastpk *sqltypes.Result
+	// PKRanges are the copied ranges of the primary key if the table is
+	// copied as several ranges. Like Lastpk, any events that fall outside
+	// of them must be excluded.
+	PKRanges []*pkRange
 	// BulkInsertFront, BulkInsertValues and BulkInsertOnDup are used
 	// by vcopier. These three parts are combined to build bulk insert
 	// statements. This is functionally equivalent to generating
@@ -201,6 +206,9 @@ type TablePlan struct {
 	FieldsToSkip            map[string]bool
 	ConvertCharset          map[string](*binlogdatapb.CharsetConversion)
 	HasExtraSourcePkColumns bool
+	// Transforms are the transforms of the fields, by lowered name. They
+	// are applied by both vcopier and vplayer.
+	Transforms map[string]Transform
 }
 
 // MarshalJSON performs a custom JSON Marshalling.
@@ -234,11 +242,21 @@ func (tp *TablePlan) applyBulkInsert(sqlbuffer *bytes2.Buffer, rows *binlogdatap
 	sqlbuffer.WriteString(tp.BulkInsertFront.Query)
 	sqlbuffer.WriteString(" values ")
 
+	fields := tp.Fields
+	if len(tp.Transforms) > 0 {
+		fields = tp.transformedFields()
+	}
 	for i, row := range rows.Rows {
 		if i > 0 {
 			sqlbuffer.WriteString(", ")
 		}
-		if err := tp.BulkInsertValues.AppendFromRow(sqlbuffer, tp.Fields, row, tp.FieldsToSkip); err != nil {
+		if len(tp.Transforms) > 0 {
+			var err error
+			if row, err = tp.transformRow(row); err != nil {
+				return nil, err
+			}
+		}
+		if err := tp.BulkInsertValues.AppendFromRow(sqlbuffer, fields, row, tp.FieldsToSkip); err != nil {
 			return nil, err
 		}
 	}
@@ -293,12 +311,52 @@ func (tp *TablePlan) isOutsidePKRange(bindvars map[string]*querypb.BindVariable,
 	return false
 }
 
+// transformedFields returns the fields of the rows that transformRow returns:
+// the transformed fields are VARCHAR.
+func (tp *TablePlan) transformedFields() []*querypb.Field {
+	fields := make([]*querypb.Field, len(tp.Fields))
+	for i, field := range tp.Fields {
+		fields[i] = field
+		if _, ok := tp.Transforms[strings.ToLower(field.Name)]; ok {
+			fields[i] = proto.Clone(field).(*querypb.Field)
+			fields[i].Type = querypb.Type_VARCHAR
+		}
+	}
+	return fields
+}
+
+// transformRow applies the transforms to a row streamed by vcopier.
+func (tp *TablePlan) transformRow(row *querypb.Row) (*querypb.Row, error) {
+	vals := sqltypes.MakeRowTrusted(tp.Fields, row)
+	for i, field := range tp.Fields {
+		if vals[i].IsNull() {
+			continue
+		}
+		if transform, ok := tp.Transforms[strings.ToLower(field.Name)]; ok {
+			val, err := transform(vals[i])
+			if err != nil {
+				return nil, err
+			}
+			vals[i] = val
+		}
+	}
+	return sqltypes.RowToProto3(vals), nil
+}
+
 // bindFieldVal returns a bind variable based on given field and value.
 // Most values will just bind directly. But some values may need manipulation:
 // - text values with charset conversion
 // - enum values converted to text via Online DDL
+// - values of transformed fields
 // - ...any other future possible values
 func (tp *TablePlan) bindFieldVal(field *querypb.Field, val *sqltypes.Value) (*querypb.BindVariable, error) {
+	if transform, ok := tp.Transforms[strings.ToLower(field.Name)]; ok && !val.IsNull() {
+		transformed, err := transform(*val)
+		if err != nil {
+			return nil, err
+		}
+		return sqltypes.ValueBindVariable(transformed), nil
+	}
 	if conversion, ok := tp.ConvertCharset[field.Name]; ok && !val.IsNull() {
 		// Non-null string value, for which we have a charset conversion instruction
 		valString := val.ToString()
@@ -684,7 +684,7 @@ func TestBuildPlayerPlan(t *testing.T) {
 	}
 
 	for _, tcase := range testcases {
-		plan, err := buildReplicatorPlan(tcase.input, PrimaryKeyInfos, nil, binlogplayer.NewStats())
+		plan, err := buildReplicatorPlan(tcase.input, PrimaryKeyInfos, nil, nil, binlogplayer.NewStats())
 		gotPlan, _ := json.Marshal(plan)
 		wantPlan, _ := json.Marshal(tcase.plan)
 		if string(gotPlan) != string(wantPlan) {
@@ -698,7 +698,7 @@ func TestBuildPlayerPlan(t *testing.T) {
 			t.Errorf("Filter err(%v): %s, want %v", tcase.input, gotErr, tcase.err)
 		}
 
-		plan, err = buildReplicatorPlan(tcase.input, PrimaryKeyInfos, copyState, binlogplayer.NewStats())
+		plan, err = buildReplicatorPlan(tcase.input, PrimaryKeyInfos, copyState, nil, binlogplayer.NewStats())
 		if err != nil {
 			continue
 		}
@@ -724,7 +724,7 @@ func TestBuildPlayerPlanNoDup(t *testing.T) {
 			Filter: "select * from t",
 		}},
 	}
-	_, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil, binlogplayer.NewStats())
+	_, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil, nil, binlogplayer.NewStats())
 	want := "more than one target for source table t"
 	if err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("buildReplicatorPlan err: %v, must contain: %v", err, want)
@@ -745,7 +745,7 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 			Filter: "",
 		}},
 	}
-	plan, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil, binlogplayer.NewStats())
+	plan, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil, nil, binlogplayer.NewStats())
 	assert.NoError(t, err)
 
 	want := &TestReplicatorPlan{
@@ -53,8 +53,18 @@ type tablePlanBuilder struct {
 	pkCols            []*colExpr
 	extraSourcePkCols []*colExpr
 	lastpk            *sqltypes.Result
+	ranges            []*pkRange
 	colInfos          []*ColumnInfo
 	stats             *binlogplayer.Stats
+	// transforms are the transforms of the source columns, by lowered name.
+	transforms map[string]Transform
+}
+
+// pkRange is a range of the primary key of a source table: the rows after
+// start, up to and including end. A nil start or end leaves that side of
+// the range unbounded.
+type pkRange struct {
+	start, end *sqltypes.Result
 }
 
 // colExpr describes the processing to be performed to
@@ -71,6 +81,9 @@ type colExpr struct {
 	expr sqlparser.Expr
 	// references contains all the column names referenced in the expression.
 	references map[string]bool
+	// transform is set if the expression is a transform of a column. expr
+	// is then the column.
+	transform Transform
 
 	isGrouped  bool
 	isPK       bool
@@ -121,10 +134,14 @@ const (
 // that was copied.  If so, only replication events < lastpk are applied.
 // If the entry is nil, then copying of the table has not started yet. If so,
 // no events are applied.
+// copiedRanges is a map of the tables that are copied as several ranges of
+// their primary key, to the ranges that were copied so far. Only replication
+// events within them are applied. If no range was copied yet, no events are
+// applied.
 // The TablePlan built is a partial plan. The full plan for a table is built
 // when we receive field information from events or rows sent by the source.
 // buildExecutionPlan is the function that builds the full plan.
-func buildReplicatorPlan(filter *binlogdatapb.Filter, colInfoMap map[string][]*ColumnInfo, copyState map[string]*sqltypes.Result, stats *binlogplayer.Stats) (*ReplicatorPlan, error) {
+func buildReplicatorPlan(filter *binlogdatapb.Filter, colInfoMap map[string][]*ColumnInfo, copyState map[string]*sqltypes.Result, copiedRanges map[string][]*pkRange, stats *binlogplayer.Stats) (*ReplicatorPlan, error) {
 	plan := &ReplicatorPlan{
 		VStreamFilter: &binlogdatapb.Filter{FieldEventMode: filter.FieldEventMode},
 		TargetTables:  make(map[string]*TablePlan),
@@ -138,6 +155,13 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, colInfoMap map[string][]*C
 			// Don't replicate uncopied tables.
 			continue
 		}
+		ranges, ok := copiedRanges[tableName]
+		if ok && len(ranges) == 0 {
+			continue
+		}
+		if coversTable(ranges) {
+			ranges = nil
+		}
 		rule, err := MatchTable(tableName, filter)
 		if err != nil {
 			return nil, err
@@ -149,7 +173,7 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, colInfoMap map[string][]*C
 		if !ok {
 			return nil, fmt.Errorf("table %s not found in schema", tableName)
 		}
-		tablePlan, err := buildTablePlan(tableName, rule, colInfos, lastpk, stats)
+		tablePlan, err := buildTablePlan(tableName, rule, colInfos, lastpk, ranges, stats)
 		if err != nil {
 			return nil, err
 		}
@@ -167,6 +191,16 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, colInfoMap map[string][]*C
 	return plan, nil
 }
 
+// coversTable returns true if one of the ranges is the whole table.
+func coversTable(ranges []*pkRange) bool {
+	for _, r := range ranges {
+		if r.start == nil && r.end == nil {
+			return true
+		}
+	}
+	return false
+}
+
 // MatchTable is similar to tableMatches and buildPlan defined in vstreamer/planbuilder.go.
 func MatchTable(tableName string, filter *binlogdatapb.Filter) (*binlogdatapb.Rule, error) {
 	for _, rule := range filter.Rules {
@@ -188,20 +222,9 @@ func MatchTable(tableName string, filter *binlogdatapb.Filter) (*binlogdatapb.Ru
 	return nil, nil
 }
 
-func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*ColumnInfo, lastpk *sqltypes.Result, stats *binlogplayer.Stats) (*TablePlan, error) {
-	filter := rule.Filter
-	query := filter
-	// generate equivalent select statement if filter is empty or a keyrange.
-	switch {
-	case filter == "":
-		buf := sqlparser.NewTrackedBuffer(nil)
-		buf.Myprintf("select * from %v", sqlparser.NewTableIdent(tableName))
-		query = buf.String()
-	case key.IsKeyRange(filter):
-		buf := sqlparser.NewTrackedBuffer(nil)
-		buf.Myprintf("select * from %v where in_keyrange(%v)", sqlparser.NewTableIdent(tableName), sqlparser.NewStrLiteral(filter))
-		query = buf.String()
-	case filter == ExcludeStr:
+func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*ColumnInfo, lastpk *sqltypes.Result, ranges []*pkRange, stats *binlogplayer.Stats) (*TablePlan, error) {
+	query := ruleQuery(tableName, rule.Filter)
+	if query == "" {
 		return nil, nil
 	}
 	sel, fromTable, err := analyzeSelectFrom(query)
@@ -232,6 +255,7 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 			TargetName:     tableName,
 			SendRule:       sendRule,
 			Lastpk:         lastpk,
+			PKRanges:       ranges,
 			Stats:          stats,
 			EnumValuesMap:  enumValuesMap,
 			ConvertCharset: rule.ConvertCharset,
@@ -248,8 +272,10 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 		},
 		selColumns: make(map[string]bool),
 		lastpk:     lastpk,
+		ranges:     ranges,
 		colInfos:   colInfos,
 		stats:      stats,
+		transforms: make(map[string]Transform),
 	}
 
 	if err := tpb.analyzeExprs(sel.SelectExprs); err != nil {
@@ -258,12 +284,15 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 	// It's possible that the target table does not materialize all
 	// the primary keys of the source table. In such situations,
 	// we still have to be able to validate the incoming event
-	// against the current lastpk. For this, we have to request
+	// against the current lastpk or ranges. For this, we have to request
 	// the missing columns so we can compare against those values.
 	// If there is no lastpk to validate against, then we don't
 	// care.
-	if tpb.lastpk != nil {
-		for _, f := range tpb.lastpk.Fields {
+	if pkFields := tpb.pkFields(); pkFields != nil {
+		for _, f := range pkFields {
+			if tpb.transforms[strings.ToLower(f.Name)] != nil {
+				return nil, fmt.Errorf("column %s of the primary key of the source table cannot be transformed", f.Name)
+			}
 			tpb.addCol(sqlparser.NewColIdent(f.Name))
 		}
 	}
@@ -312,9 +341,31 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 	tablePlan.SendRule = sendRule
 	tablePlan.EnumValuesMap = enumValuesMap
 	tablePlan.ConvertCharset = rule.ConvertCharset
+	if len(tpb.transforms) > 0 {
+		tablePlan.Transforms = tpb.transforms
+	}
 	return tablePlan, nil
 }
 
+// ruleQuery returns the select statement of the filter of a rule, or
+// an empty string if the table is excluded.
+func ruleQuery(tableName, filter string) string {
+	// generate equivalent select statement if filter is empty or a keyrange.
+	switch {
+	case filter == "":
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select * from %v", sqlparser.NewTableIdent(tableName))
+		return buf.String()
+	case key.IsKeyRange(filter):
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select * from %v where in_keyrange(%v)", sqlparser.NewTableIdent(tableName), sqlparser.NewStrLiteral(filter))
+		return buf.String()
+	case filter == ExcludeStr:
+		return ""
+	}
+	return filter
+}
+
 func (tpb *tablePlanBuilder) generate() *TablePlan {
 	refmap := make(map[string]bool)
 	for _, cexpr := range tpb.pkCols {
@@ -322,10 +373,8 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 			refmap[k] = true
 		}
 	}
-	if tpb.lastpk != nil {
-		for _, f := range tpb.lastpk.Fields {
-			refmap[f.Name] = true
-		}
+	for _, f := range tpb.pkFields() {
+		refmap[f.Name] = true
 	}
 	pkrefs := make([]string, 0, len(refmap))
 	for k := range refmap {
@@ -345,6 +394,7 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 	return &TablePlan{
 		TargetName:              tpb.name.String(),
 		Lastpk:                  tpb.lastpk,
+		PKRanges:                tpb.ranges,
 		BulkInsertFront:         tpb.generateInsertPart(sqlparser.NewTrackedBuffer(bvf.formatter)),
 		BulkInsertValues:        tpb.generateValuesPart(sqlparser.NewTrackedBuffer(bvf.formatter), bvf),
 		BulkInsertOnDup:         tpb.generateOnDupPart(sqlparser.NewTrackedBuffer(bvf.formatter)),
@@ -392,6 +442,18 @@ func (tpb *tablePlanBuilder) analyzeExprs(selExprs sqlparser.SelectExprs) error
 		}
 		tpb.colExprs = append(tpb.colExprs, cexpr)
 	}
+	// The transformed columns are sent with their transformed values, so
+	// they can't be used as is by the other expressions.
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.transform != nil {
+			continue
+		}
+		for ref := range cexpr.references {
+			if tpb.transforms[ref] != nil {
+				return fmt.Errorf("column %s is transformed and cannot be referenced by other expressions: %v", ref, sqlparser.String(cexpr.colName))
+			}
+		}
+	}
 	return nil
 }
 
@@ -463,6 +525,26 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 			// The vstreamer responds with "keyspace_id" as the field name for this request.
 			cexpr.expr = &sqlparser.ColName{Name: sqlparser.NewColIdent("keyspace_id")}
 			return cexpr, nil
+		default:
+			transform, col, err := buildTransform(expr)
+			if err != nil {
+				return nil, err
+			}
+			if transform == nil {
+				break
+			}
+			if tpb.transforms[col.Name.Lowered()] != nil {
+				return nil, fmt.Errorf("column %s cannot be transformed more than once: %v", col.Name.String(), sqlparser.String(expr))
+			}
+			if tpb.transforms == nil {
+				tpb.transforms = make(map[string]Transform)
+			}
+			tpb.transforms[col.Name.Lowered()] = transform
+			tpb.addCol(col.Name)
+			cexpr.expr = col
+			cexpr.transform = transform
+			cexpr.references[col.Name.Lowered()] = true
+			return cexpr, nil
 		}
 	}
 	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
@@ -632,7 +714,7 @@ func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
 	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
 
 	tpb.generateInsertPart(buf)
-	if tpb.lastpk == nil {
+	if tpb.pkFields() == nil {
 		// If there's no lastpk, generate straight values.
 		buf.Myprintf(" values ", tpb.name)
 		tpb.generateValuesPart(buf, bvf)
@@ -843,7 +925,7 @@ func (tpb *tablePlanBuilder) generateWhere(buf *sqlparser.TrackedBuffer, bvf *bi
 	}
 	addWhereColumns(tpb.pkCols)
 	addWhereColumns(tpb.extraSourcePkCols)
-	if tpb.lastpk != nil {
+	if tpb.pkFields() != nil {
 		buf.WriteString(" and ")
 		tpb.generatePKConstraint(buf, bvf)
 	}
@@ -864,20 +946,48 @@ func (tpb *tablePlanBuilder) getCharsetAndCollation(pkname string) (charSet stri
 }
 
 func (tpb *tablePlanBuilder) generatePKConstraint(buf *sqlparser.TrackedBuffer, bvf *bindvarFormatter) {
+	if tpb.lastpk != nil {
+		tpb.generatePKComparison(buf, tpb.lastpk.Fields, "<=", tpb.lastpk.Rows[0])
+		return
+	}
+	// The events are applied to the rows of any of the copied ranges.
+	buf.WriteString("(")
+	for i, r := range tpb.ranges {
+		if i > 0 {
+			buf.WriteString(" or ")
+		}
+		buf.WriteString("(")
+		if r.start != nil {
+			tpb.generatePKComparison(buf, r.start.Fields, ">", r.start.Rows[0])
+			if r.end != nil {
+				buf.WriteString(" and ")
+			}
+		}
+		if r.end != nil {
+			tpb.generatePKComparison(buf, r.end.Fields, "<=", r.end.Rows[0])
+		}
+		buf.WriteString(")")
+	}
+	buf.WriteString(")")
+}
+
+// generatePKComparison compares the pk columns of the source table with
+// values. For example: (id1,id2) <= (1,2).
+func (tpb *tablePlanBuilder) generatePKComparison(buf *sqlparser.TrackedBuffer, pkfields []*querypb.Field, op string, values []sqltypes.Value) {
 	type charSetCollation struct {
 		charSet   string
 		collation string
 	}
 	var charSetCollations []*charSetCollation
 	separator := "("
-	for _, pkname := range tpb.lastpk.Fields {
+	for _, pkname := range pkfields {
 		charSet, collation := tpb.getCharsetAndCollation(pkname.Name)
 		charSetCollations = append(charSetCollations, &charSetCollation{charSet: charSet, collation: collation})
 		buf.Myprintf("%s%s%v%s", separator, charSet, &sqlparser.ColName{Name: sqlparser.NewColIdent(pkname.Name)}, collation)
 		separator = ","
 	}
-	separator = ") <= ("
-	for i, val := range tpb.lastpk.Rows[0] {
+	separator = ") " + op + " ("
+	for i, val := range values {
 		buf.WriteString(separator)
 		buf.WriteString(charSetCollations[i].charSet)
 		separator = ","
@@ -887,6 +997,23 @@ func (tpb *tablePlanBuilder) generatePKConstraint(buf *sqlparser.TrackedBuffer,
 	buf.WriteString(")")
 }
 
+// pkFields returns the pk fields of the source table that the lastpk or
+// the ranges compare, or nil if there are none.
+func (tpb *tablePlanBuilder) pkFields() []*querypb.Field {
+	if tpb.lastpk != nil {
+		return tpb.lastpk.Fields
+	}
+	for _, r := range tpb.ranges {
+		if r.start != nil {
+			return r.start.Fields
+		}
+		if r.end != nil {
+			return r.end.Fields
+		}
+	}
+	return nil
+}
+
 func (tpb *tablePlanBuilder) isColumnGenerated(col sqlparser.ColIdent) bool {
 	for _, colInfo := range tpb.colInfos {
 		if col.EqualString(colInfo.Name) && colInfo.IsGenerated {
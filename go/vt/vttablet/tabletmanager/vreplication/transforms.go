@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Transforms are named functions that change the values of a column while
+// they are replicated, for example to pseudonymize PII that is materialized
+// into another keyspace. They are used in the filters of the rules like
+// functions whose first argument is a column of the source table, and whose
+// other arguments are literals:
+//   select id, vt_hash(email, 'salt') as email, vt_truncate_ip(ip) as ip from t
+// The transforms run on the target, in both the vcopier and the vplayer, so
+// the source doesn't need to know about them. They are never passed NULL
+// values, which stay NULL, and they must be deterministic: the same value
+// must always be transformed the same way, or the updates and deletes of
+// the vplayer would not find the rows that the vcopier inserted.
+// A transformed column can't be referenced by the other expressions of the
+// rule, nor be part of the primary key of the source table.
+
+// Transform transforms a non-NULL value of a column. It returns a VARCHAR
+// value, or NULL.
+type Transform func(val sqltypes.Value) (sqltypes.Value, error)
+
+// TransformFactory builds a Transform from the literal arguments that follow
+// the column in the filter, like the salt of vt_hash(email, 'salt').
+type TransformFactory func(args []sqltypes.Value) (Transform, error)
+
+var (
+	transformsMu sync.Mutex
+	transforms   = make(map[string]TransformFactory)
+)
+
+// RegisterTransform registers a transform under a name, to make it usable in
+// the filters of the rules. The names are case-insensitive, and should not
+// shadow MySQL functions. It panics if the name is already registered.
+func RegisterTransform(name string, factory TransformFactory) {
+	transformsMu.Lock()
+	defer transformsMu.Unlock()
+
+	name = strings.ToLower(name)
+	if _, ok := transforms[name]; ok {
+		panic(fmt.Sprintf("transform %s is already registered", name))
+	}
+	transforms[name] = factory
+}
+
+func transformFactory(name string) TransformFactory {
+	transformsMu.Lock()
+	defer transformsMu.Unlock()
+
+	return transforms[strings.ToLower(name)]
+}
+
+// buildTransform builds the Transform of a function of a filter, and returns
+// the column that it transforms. It returns a nil Transform if the function
+// is not a registered transform.
+func buildTransform(funcExpr *sqlparser.FuncExpr) (Transform, *sqlparser.ColName, error) {
+	if !funcExpr.Qualifier.IsEmpty() {
+		return nil, nil, nil
+	}
+	factory := transformFactory(funcExpr.Name.Lowered())
+	if factory == nil {
+		return nil, nil, nil
+	}
+
+	if len(funcExpr.Exprs) == 0 {
+		return nil, nil, fmt.Errorf("transform %s needs a column: %v", funcExpr.Name.Lowered(), sqlparser.String(funcExpr))
+	}
+	var col *sqlparser.ColName
+	if aliased, ok := funcExpr.Exprs[0].(*sqlparser.AliasedExpr); ok {
+		col, _ = aliased.Expr.(*sqlparser.ColName)
+	}
+	if col == nil {
+		return nil, nil, fmt.Errorf("the first argument of transform %s must be a column: %v", funcExpr.Name.Lowered(), sqlparser.String(funcExpr))
+	}
+	if !col.Qualifier.IsEmpty() {
+		return nil, nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(col))
+	}
+
+	args := make([]sqltypes.Value, 0, len(funcExpr.Exprs)-1)
+	for _, expr := range funcExpr.Exprs[1:] {
+		var arg sqltypes.Value
+		if aliased, ok := expr.(*sqlparser.AliasedExpr); ok {
+			if lit, ok := aliased.Expr.(*sqlparser.Literal); ok {
+				switch lit.Type {
+				case sqlparser.StrVal:
+					arg = sqltypes.NewVarChar(lit.Val)
+				case sqlparser.IntVal:
+					arg = sqltypes.MakeTrusted(querypb.Type_INT64, lit.Bytes())
+				}
+			}
+		}
+		if arg.IsNull() {
+			return nil, nil, fmt.Errorf("the arguments of transform %s must be string or integer literals: %v", funcExpr.Name.Lowered(), sqlparser.String(funcExpr))
+		}
+		args = append(args, arg)
+	}
+
+	transform, err := factory(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("transform %s: %v", funcExpr.Name.Lowered(), err)
+	}
+	return transform, col, nil
+}
+
+func init() {
+	RegisterTransform("vt_hash", newHashTransform)
+	RegisterTransform("vt_mask", newMaskTransform)
+	RegisterTransform("vt_mask_email", newMaskEmailTransform)
+	RegisterTransform("vt_truncate_ip", newTruncateIPTransform)
+}
+
+// newHashTransform builds vt_hash(col[, salt]), which replaces the values by
+// the hex SHA-256 of the salt followed by the value.
+func newHashTransform(args []sqltypes.Value) (Transform, error) {
+	if len(args) > 1 {
+		return nil, fmt.Errorf("expected at most a salt, got %d arguments", len(args))
+	}
+	var salt []byte
+	if len(args) == 1 {
+		salt = args[0].Raw()
+	}
+	return func(val sqltypes.Value) (sqltypes.Value, error) {
+		h := sha256.New()
+		h.Write(salt)
+		h.Write(val.Raw())
+		return sqltypes.NewVarChar(hex.EncodeToString(h.Sum(nil))), nil
+	}, nil
+}
+
+// newMaskTransform builds vt_mask(col[, keep_prefix[, keep_suffix]]), which
+// replaces the characters of the values by '*', except for the first
+// keep_prefix and the last keep_suffix ones.
+func newMaskTransform(args []sqltypes.Value) (Transform, error) {
+	if len(args) > 2 {
+		return nil, fmt.Errorf("expected at most keep_prefix and keep_suffix, got %d arguments", len(args))
+	}
+	keep := make([]int, 2)
+	for i, arg := range args {
+		n, err := arg.ToInt64()
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid number of characters to keep: %v", arg.ToString())
+		}
+		keep[i] = int(n)
+	}
+	return func(val sqltypes.Value) (sqltypes.Value, error) {
+		return sqltypes.NewVarChar(mask(val.ToString(), keep[0], keep[1])), nil
+	}, nil
+}
+
+func mask(s string, keepPrefix, keepSuffix int) string {
+	runes := []rune(s)
+	for i := keepPrefix; i < len(runes)-keepSuffix; i++ {
+		runes[i] = '*'
+	}
+	return string(runes)
+}
+
+// newMaskEmailTransform builds vt_mask_email(col), which masks the local part
+// of email addresses, except for its first character, and keeps their
+// domain. The values that are not email addresses are masked entirely.
+func newMaskEmailTransform(args []sqltypes.Value) (Transform, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf("expected no arguments, got %d", len(args))
+	}
+	return func(val sqltypes.Value) (sqltypes.Value, error) {
+		s := val.ToString()
+		at := strings.LastIndexByte(s, '@')
+		if at <= 0 {
+			return sqltypes.NewVarChar(mask(s, 0, 0)), nil
+		}
+		return sqltypes.NewVarChar(mask(s[:at], 1, 0) + s[at:]), nil
+	}, nil
+}
+
+// newTruncateIPTransform builds vt_truncate_ip(col[, ipv4_bits[, ipv6_bits]]),
+// which zeroes the host bits of IP addresses, keeping the first 24 bits of
+// the IPv4 addresses and the first 48 bits of the IPv6 addresses by default.
+// The values that are not IP addresses are replaced by NULL.
+func newTruncateIPTransform(args []sqltypes.Value) (Transform, error) {
+	if len(args) > 2 {
+		return nil, fmt.Errorf("expected at most ipv4_bits and ipv6_bits, got %d arguments", len(args))
+	}
+	bits := []int64{24, 48}
+	maxBits := []int64{32, 128}
+	for i, arg := range args {
+		n, err := arg.ToInt64()
+		if err != nil || n < 0 || n > maxBits[i] {
+			return nil, fmt.Errorf("invalid number of bits to keep: %v", arg.ToString())
+		}
+		bits[i] = n
+	}
+	ipv4Mask := net.CIDRMask(int(bits[0]), 32)
+	ipv6Mask := net.CIDRMask(int(bits[1]), 128)
+	return func(val sqltypes.Value) (sqltypes.Value, error) {
+		ip := net.ParseIP(strings.TrimSpace(val.ToString()))
+		if ip == nil {
+			return sqltypes.NULL, nil
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			return sqltypes.NewVarChar(ip4.Mask(ipv4Mask).String()), nil
+		}
+		return sqltypes.NewVarChar(ip.Mask(ipv6Mask).String()), nil
+	}, nil
+}
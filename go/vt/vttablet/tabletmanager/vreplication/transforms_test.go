@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/bytes2"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func parseFuncExpr(t *testing.T, expr string) *sqlparser.FuncExpr {
+	t.Helper()
+	stmt, err := sqlparser.Parse("select " + expr + " from t1")
+	require.NoError(t, err)
+	return stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr.(*sqlparser.FuncExpr)
+}
+
+func TestTransforms(t *testing.T) {
+	testcases := []struct {
+		expr string
+		in   sqltypes.Value
+		out  sqltypes.Value
+		err  string
+	}{{
+		expr: "vt_hash(email)",
+		in:   sqltypes.NewVarChar("abc"),
+		out:  sqltypes.NewVarChar("ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"),
+	}, {
+		expr: "VT_HASH(email, 'a')",
+		in:   sqltypes.NewVarChar("bc"),
+		out:  sqltypes.NewVarChar("ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"),
+	}, {
+		expr: "vt_hash(email, 'a', 'b')",
+		err:  "transform vt_hash: expected at most a salt, got 2 arguments",
+	}, {
+		expr: "vt_mask(card)",
+		in:   sqltypes.NewVarChar("1234"),
+		out:  sqltypes.NewVarChar("****"),
+	}, {
+		expr: "vt_mask(card, 1, 2)",
+		in:   sqltypes.NewVarChar("123456"),
+		out:  sqltypes.NewVarChar("1***56"),
+	}, {
+		expr: "vt_mask(card, 4, 4)",
+		in:   sqltypes.NewVarChar("12345"),
+		out:  sqltypes.NewVarChar("12345"),
+	}, {
+		expr: "vt_mask(card, 'a')",
+		err:  "transform vt_mask: invalid number of characters to keep: a",
+	}, {
+		expr: "vt_mask_email(email)",
+		in:   sqltypes.NewVarChar("jane.doe@example.com"),
+		out:  sqltypes.NewVarChar("j*******@example.com"),
+	}, {
+		expr: "vt_mask_email(email)",
+		in:   sqltypes.NewVarChar("jane"),
+		out:  sqltypes.NewVarChar("****"),
+	}, {
+		expr: "vt_truncate_ip(ip)",
+		in:   sqltypes.NewVarChar("192.168.12.34"),
+		out:  sqltypes.NewVarChar("192.168.12.0"),
+	}, {
+		expr: "vt_truncate_ip(ip, 16)",
+		in:   sqltypes.NewVarChar("192.168.12.34"),
+		out:  sqltypes.NewVarChar("192.168.0.0"),
+	}, {
+		expr: "vt_truncate_ip(ip)",
+		in:   sqltypes.NewVarChar("2001:db8:85a3::8a2e:370:7334"),
+		out:  sqltypes.NewVarChar("2001:db8:85a3::"),
+	}, {
+		expr: "vt_truncate_ip(ip)",
+		in:   sqltypes.NewVarChar("localhost"),
+		out:  sqltypes.NULL,
+	}, {
+		expr: "vt_truncate_ip(ip, 33)",
+		err:  "transform vt_truncate_ip: invalid number of bits to keep: 33",
+	}, {
+		expr: "vt_hash(lower(email))",
+		err:  "the first argument of transform vt_hash must be a column: vt_hash(lower(email))",
+	}, {
+		expr: "vt_hash(email, salt)",
+		err:  "the arguments of transform vt_hash must be string or integer literals: vt_hash(email, salt)",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.expr, func(t *testing.T) {
+			transform, col, err := buildTransform(parseFuncExpr(t, tcase.expr))
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, transform)
+			assert.False(t, col.Name.IsEmpty())
+
+			out, err := transform(tcase.in)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, out)
+		})
+	}
+
+	transform, _, err := buildTransform(parseFuncExpr(t, "md5(email)"))
+	require.NoError(t, err)
+	assert.Nil(t, transform, "md5 is not a transform")
+}
+
+func TestBuildPlayerPlanTransforms(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select id, vt_mask_email(email) as email, vt_truncate_ip(ip) as ip_prefix from t1",
+		}},
+	}
+	colInfos := map[string][]*ColumnInfo{
+		"t1": {{Name: "id", IsPK: true}, {Name: "email"}, {Name: "ip_prefix"}},
+	}
+	plan, err := buildReplicatorPlan(filter, colInfos, nil, nil, binlogplayer.NewStats())
+	require.NoError(t, err)
+	assert.Equal(t, "select id, email, ip from t1", plan.VStreamFilter.Rules[0].Filter)
+
+	tp, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{
+		TableName: "t1",
+		Fields: []*querypb.Field{
+			{Name: "id", Type: querypb.Type_INT64},
+			{Name: "email", Type: querypb.Type_VARCHAR},
+			{Name: "ip", Type: querypb.Type_VARBINARY},
+		},
+	})
+	require.NoError(t, err)
+
+	var queries []string
+	executor := func(sql string) (*sqltypes.Result, error) {
+		queries = append(queries, sql)
+		return &sqltypes.Result{}, nil
+	}
+
+	// vcopier
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("jane@example.com"), sqltypes.NewVarBinary("10.1.2.3")},
+		{sqltypes.NewInt64(2), sqltypes.NULL, sqltypes.NewVarBinary("10.1.3.4")},
+	}
+	_, err = tp.applyBulkInsert(&bytes2.Buffer{}, &binlogdatapb.VStreamRowsResponse{
+		Rows: []*querypb.Row{sqltypes.RowToProto3(rows[0]), sqltypes.RowToProto3(rows[1])},
+	}, executor)
+	require.NoError(t, err)
+
+	// vplayer
+	_, err = tp.applyChange(&binlogdatapb.RowChange{
+		Before: sqltypes.RowToProto3(rows[0]),
+		After:  sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("john@example.com"), sqltypes.NewVarBinary("10.1.5.6")}),
+	}, executor)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"insert into t1(id,email,ip_prefix) values (1,'j***@example.com','10.1.2.0'), (2,null,'10.1.3.0')",
+		"update t1 set email='j***@example.com', ip_prefix='10.1.5.0' where id=1",
+	}, queries)
+}
+
+func TestBuildPlayerPlanTransformErrors(t *testing.T) {
+	testcases := []struct {
+		filter string
+		lastpk *sqltypes.Result
+		err    string
+	}{{
+		filter: "select id, email, vt_hash(email) as email_hash from t1",
+		err:    "column email is transformed and cannot be referenced by other expressions: email",
+	}, {
+		filter: "select id, vt_hash(email) as a, vt_mask(email) as b from t1",
+		err:    "column email cannot be transformed more than once: vt_mask(email)",
+	}, {
+		filter: "select vt_hash(id) as id, email from t1",
+		lastpk: sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1"),
+		err:    "column id of the primary key of the source table cannot be transformed",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.filter, func(t *testing.T) {
+			rule := &binlogdatapb.Rule{Match: "t1", Filter: tcase.filter}
+			_, err := buildTablePlan("t1", rule, nil, tcase.lastpk, nil, binlogplayer.NewStats())
+			assert.EqualError(t, err, tcase.err)
+		})
+	}
+}
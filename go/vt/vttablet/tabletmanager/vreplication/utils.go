@@ -57,6 +57,10 @@ const (
 	LogCopyStart = "Started Copy Phase"
 	// LogCopyEnd is used when the copy phase is done
 	LogCopyEnd = "Ended Copy Phase"
+	// LogPruneStart is used when a stream seeded from a backup starts deleting the rows outside of its key range
+	LogPruneStart = "Started Prune Phase"
+	// LogPruneEnd is used when a stream seeded from a backup is done deleting the rows outside of its key range
+	LogPruneEnd = "Ended Prune Phase"
 	// LogStateChange is used when the state of the stream changes
 	LogStateChange = "State Changed"
 
@@ -57,7 +57,7 @@ func newVCopier(vr *vreplicator) *vcopier {
 func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 	defer vc.vr.dbClient.Rollback()
 
-	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.colInfoMap, nil, vc.vr.stats)
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.colInfoMap, nil, nil, vc.vr.stats)
 	if err != nil {
 		return err
 	}
@@ -108,41 +108,122 @@ func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 // copyNext also builds the copyState metadata that contains the tables and their last
 // primary key that was copied. A nil Result means that nothing has been copied.
 // A table that was fully copied is removed from copyState.
+// If vreplication_copy_phase_concurrency is more than 1, or tables were copied
+// concurrently before, steps 2 to 4 copy several tables, or ranges of tables, at
+// the same time instead: see copyTables.
 func (vc *vcopier) copyNext(ctx context.Context, settings binlogplayer.VRSettings) error {
-	qr, err := vc.vr.dbClient.Execute(fmt.Sprintf("select table_name, lastpk from _vt.copy_state where vrepl_id=%d", vc.vr.id))
+	tablesToCopy, copyState, copyRanges, err := vc.readCopyState(ctx)
 	if err != nil {
 		return err
 	}
-	var tableToCopy string
-	copyState := make(map[string]*sqltypes.Result)
+	if len(copyState) == 0 && len(copyRanges) == 0 {
+		return fmt.Errorf("unexpected: there are no tables to copy")
+	}
+	if err := vc.catchup(ctx, copyState, copyRanges); err != nil {
+		return err
+	}
+	if hasPendingCopies(copyRanges) {
+		return vc.resolvePendingCopies(ctx, copyState, copyRanges)
+	}
+	if *copyPhaseConcurrency > 1 || len(copyRanges) != 0 {
+		return vc.copyTables(ctx, tablesToCopy, copyState, copyRanges)
+	}
+	return vc.copyTable(ctx, tablesToCopy[0], copyState)
+}
+
+// readCopyState reads the tables to copy from copy_state, along with their
+// last primary key that was copied. The tables that were copied by
+// copyTables are returned as their ranges instead, if they were split in
+// several ranges, or copied as of a later position than the one of the
+// stream.
+func (vc *vcopier) readCopyState(ctx context.Context) (tablesToCopy []string, copyState map[string]*sqltypes.Result, copyRanges map[string][]*copyRange, err error) {
+	query := fmt.Sprintf("select table_name, lastpk, snapshot_pos, prev_lastpk, completed, range_id, range_start, range_end from _vt.copy_state where vrepl_id=%d order by table_name, range_id", vc.vr.id)
+	qr, err := withDDL.Exec(ctx, query, vc.vr.dbClient.ExecuteFetch, vc.vr.dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var tableNames []string
+	copyRanges = make(map[string][]*copyRange)
 	for _, row := range qr.Rows {
 		tableName := row[0].ToString()
-		lastpk := row[1].ToString()
-		if tableToCopy == "" {
-			tableToCopy = tableName
+		r, err := readCopyRange(row[1:])
+		if err != nil {
+			return nil, nil, nil, err
 		}
-		copyState[tableName] = nil
-		if lastpk != "" {
-			var r querypb.QueryResult
-			if err := prototext.Unmarshal([]byte(lastpk), &r); err != nil {
-				return err
+		if _, ok := copyRanges[tableName]; !ok {
+			tableNames = append(tableNames, tableName)
+		}
+		copyRanges[tableName] = append(copyRanges[tableName], r)
+	}
+	copyState = make(map[string]*sqltypes.Result)
+	for _, tableName := range tableNames {
+		ranges := copyRanges[tableName]
+		// A table copied as a whole that isn't pending is in copyState,
+		// like the tables copied by copyTable.
+		if r := ranges[0]; len(ranges) == 1 && r.start == nil && r.end == nil && r.pending == nil && !r.completed {
+			delete(copyRanges, tableName)
+			tablesToCopy = append(tablesToCopy, tableName)
+			copyState[tableName] = r.lastpk
+			continue
+		}
+		for _, r := range ranges {
+			if !r.completed {
+				tablesToCopy = append(tablesToCopy, tableName)
+				break
 			}
-			copyState[tableName] = sqltypes.Proto3ToResult(&r)
 		}
 	}
-	if len(copyState) == 0 {
-		return fmt.Errorf("unexpected: there are no tables to copy")
+	return tablesToCopy, copyState, copyRanges, nil
+}
+
+// readCopyRange reads the range of a row of copy_state, from its lastpk
+// column on.
+func readCopyRange(row []sqltypes.Value) (*copyRange, error) {
+	var err error
+	r := &copyRange{completed: row[3].ToString() == "1"}
+	if r.lastpk, err = decodeLastPK(row[0].ToString()); err != nil {
+		return nil, err
 	}
-	if err := vc.catchup(ctx, copyState); err != nil {
-		return err
+	if snapshotPos := row[1].ToString(); snapshotPos != "" {
+		pos, err := mysql.DecodePosition(snapshotPos)
+		if err != nil {
+			return nil, err
+		}
+		prevLastpk, err := decodeLastPK(row[2].ToString())
+		if err != nil {
+			return nil, err
+		}
+		r.pending = &pendingCopy{pos: pos, prevLastpk: prevLastpk}
+	}
+	if r.id, err = row[4].ToInt64(); err != nil {
+		return nil, err
+	}
+	if r.start, err = decodeLastPK(row[5].ToString()); err != nil {
+		return nil, err
+	}
+	if r.end, err = decodeLastPK(row[6].ToString()); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// decodeLastPK decodes a lastpk of copy_state. It returns nil if the
+// lastpk is empty.
+func decodeLastPK(lastpk string) (*sqltypes.Result, error) {
+	if lastpk == "" {
+		return nil, nil
+	}
+	var r querypb.QueryResult
+	if err := prototext.Unmarshal([]byte(lastpk), &r); err != nil {
+		return nil, err
 	}
-	return vc.copyTable(ctx, tableToCopy, copyState)
+	return sqltypes.Proto3ToResult(&r), nil
 }
 
 // catchup replays events to the subset of the tables that have been copied
 // until replication is caught up. In order to stop, the seconds behind primary has
 // to fall below replicationLagTolerance.
-func (vc *vcopier) catchup(ctx context.Context, copyState map[string]*sqltypes.Result) error {
+func (vc *vcopier) catchup(ctx context.Context, copyState map[string]*sqltypes.Result, copyRanges map[string][]*copyRange) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	defer vc.vr.stats.PhaseTimings.Record("catchup", time.Now())
@@ -160,7 +241,9 @@ func (vc *vcopier) catchup(ctx context.Context, copyState map[string]*sqltypes.R
 	// Start vreplication.
 	errch := make(chan error, 1)
 	go func() {
-		errch <- newVPlayer(vc.vr, settings, copyState, mysql.Position{}, "catchup").play(ctx)
+		vp := newVPlayer(vc.vr, settings, copyState, mysql.Position{}, "catchup")
+		vp.copyRanges = copyRanges
+		errch <- vp.play(ctx)
 	}()
 
 	// Wait for catchup.
@@ -200,7 +283,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 
 	log.Infof("Copying table %s, lastpk: %v", tableName, copyState[tableName])
 
-	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.colInfoMap, nil, vc.vr.stats)
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.colInfoMap, nil, nil, vc.vr.stats)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,644 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/bytes2"
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// pendingCopy is a range whose last rows were copied as of a later position
+// than the position of the stream. Ranges that are copied concurrently each
+// have their own row stream, and so their own snapshot position, which can't
+// all be the position of the stream like copyTable does with fastForward.
+// Instead, until the stream gets to pos, the events are applied as if only
+// the rows up to prevLastpk were copied: the rows after it already have
+// them.
+type pendingCopy struct {
+	pos mysql.Position
+	// prevLastpk is the lastpk before the rows copied as of pos. It's nil
+	// if no rows of the range were copied before.
+	prevLastpk *sqltypes.Result
+}
+
+// copyRange is a range of the primary key of a table that copyTables copies
+// with its own row stream, and its row of copy_state. Large tables are split
+// in several ranges by splitTable. The other tables are copied as a single
+// range, whose id is 0 and which has no start and end.
+type copyRange struct {
+	id         int64
+	start, end *sqltypes.Result
+	// lastpk is the last pk copied in the range. It's nil if no rows of the
+	// range were copied yet.
+	lastpk    *sqltypes.Result
+	completed bool
+	// pending is set if the last rows of the range were copied as of a
+	// later position than the position of the stream.
+	pending *pendingCopy
+}
+
+// copied returns the range of the rows that were copied, or nil if no rows
+// were. Unless resolved is set, the rows copied as of the pending position
+// are left out.
+func (r *copyRange) copied(resolved bool) *pkRange {
+	lastpk, completed := r.lastpk, r.completed
+	if r.pending != nil && !resolved {
+		lastpk, completed = r.pending.prevLastpk, false
+	}
+	if completed {
+		return &pkRange{start: r.start, end: r.end}
+	}
+	if lastpk == nil {
+		return nil
+	}
+	return &pkRange{start: r.start, end: lastpk}
+}
+
+// hasPendingCopies returns true if one of the ranges is pending.
+func hasPendingCopies(copyRanges map[string][]*copyRange) bool {
+	for _, ranges := range copyRanges {
+		for _, r := range ranges {
+			if r.pending != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// copySnapshot is the position of the row stream of a tableCopier.
+type copySnapshot struct {
+	tc  *tableCopier
+	pos mysql.Position
+}
+
+// tableCopier copies the rows of a range of a table with its own row
+// stream and target connection.
+type tableCopier struct {
+	vc          *vcopier
+	tableName   string
+	rng         *copyRange
+	plan        *ReplicatorPlan
+	initialPlan *TablePlan
+}
+
+// copyTables copies up to vreplication_copy_phase_concurrency tables, or
+// ranges of tables, at the same time. If vreplication_copy_phase_split_rows
+// is set, the tables are split in ranges by splitTable before their copy
+// starts. The row streams of the ranges start first, and their snapshot
+// positions are saved in copy_state as the positions of the rows copied
+// after the current lastpk. If the stream has no position yet, it starts at
+// the earliest of them. Then the rows get copied until all ranges are
+// copied or the copy phase duration is over. The tables whose ranges are
+// all copied are only removed from copy_state by resolvePendingCopies, once
+// the stream is past the positions they were copied at.
+func (vc *vcopier) copyTables(ctx context.Context, tablesToCopy []string, copyState map[string]*sqltypes.Result, copyRanges map[string][]*copyRange) error {
+	defer vc.vr.dbClient.Rollback()
+	defer vc.vr.stats.PhaseTimings.Record("copy", time.Now())
+	defer vc.vr.stats.CopyLoopCount.Add(1)
+
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.colInfoMap, nil, nil, vc.vr.stats)
+	if err != nil {
+		return err
+	}
+	var copiers []*tableCopier
+	for _, tableName := range tablesToCopy {
+		if len(copiers) >= *copyPhaseConcurrency {
+			break
+		}
+		initialPlan, ok := plan.TargetTables[tableName]
+		if !ok {
+			return fmt.Errorf("plan not found for table: %s, current plans are: %#v", tableName, plan.TargetTables)
+		}
+		ranges, ok := copyRanges[tableName]
+		if !ok {
+			ranges = []*copyRange{{lastpk: copyState[tableName]}}
+			if *copyPhaseSplitRows > 0 && *copyPhaseConcurrency > 1 && copyState[tableName] == nil {
+				if ranges, err = vc.splitTable(ctx, tableName, initialPlan); err != nil {
+					return err
+				}
+			}
+		}
+		for _, r := range ranges {
+			if r.completed {
+				continue
+			}
+			if len(copiers) >= *copyPhaseConcurrency {
+				break
+			}
+			copiers = append(copiers, &tableCopier{
+				vc:          vc,
+				tableName:   tableName,
+				rng:         r,
+				plan:        plan,
+				initialPlan: initialPlan,
+			})
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *copyPhaseDuration)
+	defer cancel()
+
+	started := make(chan *copySnapshot, len(copiers))
+	proceed := make(chan struct{})
+	errs := make(chan error, len(copiers))
+	for _, tc := range copiers {
+		go func(tc *tableCopier) {
+			errs <- tc.copy(ctx, started, proceed)
+		}(tc)
+	}
+	running := len(copiers)
+	// wait cancels the copy and waits for the copiers that are still
+	// running. It returns the first error.
+	wait := func(err error) error {
+		cancel()
+		for ; running > 0; running-- {
+			if cerr := <-errs; err == nil {
+				err = cerr
+			}
+		}
+		return err
+	}
+
+	var snapshots []*copySnapshot
+	for len(snapshots) < len(copiers) {
+		select {
+		case snapshot := <-started:
+			snapshots = append(snapshots, snapshot)
+		case err := <-errs:
+			// A copier ended before its stream started: the copy phase
+			// duration is over, or there was an error.
+			running--
+			return wait(err)
+		}
+	}
+	if err := vc.saveSnapshots(snapshots); err != nil {
+		return wait(err)
+	}
+	close(proceed)
+
+	rowsCopiedTicker := time.NewTicker(rowsCopiedUpdateInterval)
+	defer rowsCopiedTicker.Stop()
+	for running > 0 {
+		select {
+		case err := <-errs:
+			running--
+			if err != nil {
+				return wait(err)
+			}
+		case <-rowsCopiedTicker.C:
+			update := binlogplayer.GenerateUpdateRowsCopied(vc.vr.id, vc.vr.stats.CopyRowCount.Get())
+			_, _ = vc.vr.dbClient.Execute(update)
+		}
+	}
+	return nil
+}
+
+// splitTable splits a table that wasn't copied yet in ranges of
+// vreplication_copy_phase_split_rows rows of its primary key, and saves
+// them in copy_state. The bounds of the ranges are the primary keys of every
+// vreplication_copy_phase_split_rows-th row of the source table. A table
+// that doesn't have more rows is copied as a single range.
+func (vc *vcopier) splitTable(ctx context.Context, tableName string, initialPlan *TablePlan) ([]*copyRange, error) {
+	pkfields, err := vc.sourcePKFields(ctx, initialPlan)
+	if err != nil {
+		return nil, err
+	}
+	var bounds []*sqltypes.Result
+	for {
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select ")
+		writePKColumns(buf, pkfields)
+		buf.Myprintf(" from %v", sqlparser.NewTableIdent(initialPlan.SendRule.Match))
+		if len(bounds) > 0 {
+			buf.WriteString(" where ")
+			writePKAfter(buf, pkfields, bounds[len(bounds)-1].Rows[0])
+		}
+		buf.WriteString(" order by ")
+		writePKColumns(buf, pkfields)
+		buf.Myprintf(" limit 1 offset %s", strconv.FormatInt(*copyPhaseSplitRows-1, 10))
+
+		var fields []*querypb.Field
+		var bound []sqltypes.Value
+		err := vc.vr.sourceVStreamer.VStreamResults(ctx, buf.String(), func(qr *binlogdatapb.VStreamResultsResponse) error {
+			if fields == nil {
+				fields = qr.Fields
+			}
+			if len(qr.Rows) > 0 {
+				bound = sqltypes.MakeRowTrusted(fields, qr.Rows[0])
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if bound == nil {
+			break
+		}
+		bounds = append(bounds, &sqltypes.Result{Fields: pkfields, Rows: [][]sqltypes.Value{bound}})
+	}
+	if len(bounds) == 0 {
+		return []*copyRange{{}}, nil
+	}
+	log.Infof("Splitting table %s in %d ranges", tableName, len(bounds)+1)
+
+	ranges := make([]*copyRange, len(bounds)+1)
+	for i := range ranges {
+		ranges[i] = &copyRange{id: int64(i)}
+		if i > 0 {
+			ranges[i].start = bounds[i-1]
+		}
+		if i < len(bounds) {
+			ranges[i].end = bounds[i]
+		}
+	}
+	if err := vc.saveRanges(tableName, ranges); err != nil {
+		return nil, err
+	}
+	return ranges, nil
+}
+
+// saveRanges replaces the row of a table in copy_state with the rows of
+// its ranges.
+func (vc *vcopier) saveRanges(tableName string, ranges []*copyRange) error {
+	if err := vc.vr.dbClient.Begin(); err != nil {
+		return err
+	}
+	query := fmt.Sprintf("delete from _vt.copy_state where vrepl_id=%d and table_name=%s", vc.vr.id, encodeString(tableName))
+	if _, err := vc.vr.dbClient.Execute(query); err != nil {
+		return err
+	}
+	var buf strings.Builder
+	buf.WriteString("insert into _vt.copy_state(vrepl_id, table_name, range_id, range_start, range_end) values ")
+	for i, r := range ranges {
+		start, err := encodeLastPK(r.start)
+		if err != nil {
+			return err
+		}
+		end, err := encodeLastPK(r.end)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "(%d, %s, %d, %s, %s)", vc.vr.id, encodeString(tableName), r.id, start, end)
+	}
+	if _, err := vc.vr.dbClient.Execute(buf.String()); err != nil {
+		return err
+	}
+	return vc.vr.dbClient.Commit()
+}
+
+// sourcePKFields returns the pk fields of the source table of a plan. They
+// are the first thing its row stream sends, which then gets canceled.
+func (vc *vcopier) sourcePKFields(ctx context.Context, initialPlan *TablePlan) ([]*querypb.Field, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var pkfields []*querypb.Field
+	err := vc.vr.sourceVStreamer.VStreamRows(ctx, initialPlan.SendRule.Filter, nil, func(rows *binlogdatapb.VStreamRowsResponse) error {
+		pkfields = rows.Pkfields
+		cancel()
+		return io.EOF
+	})
+	if pkfields != nil {
+		return pkfields, nil
+	}
+	if err == nil {
+		err = fmt.Errorf("row stream of table %s ended before it started", initialPlan.SendRule.Match)
+	}
+	return nil, err
+}
+
+// writePKColumns writes the pk columns of the source table, separated by
+// commas.
+func writePKColumns(buf *sqlparser.TrackedBuffer, pkfields []*querypb.Field) {
+	for i, pkfield := range pkfields {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(pkfield.Name))
+	}
+}
+
+// writePKAfter writes the condition of the rows after a pk of the source
+// table. Like for the row streams, it's not a tuple inequality, which is a
+// full table scan for mysql. For example, if the pk is (1,2):
+// (col1 = 1 and col2 > 2) or (col1 > 1).
+func writePKAfter(buf *sqlparser.TrackedBuffer, pkfields []*querypb.Field, pk []sqltypes.Value) {
+	for lastcol := len(pkfields) - 1; lastcol >= 0; lastcol-- {
+		if lastcol < len(pkfields)-1 {
+			buf.WriteString(" or ")
+		}
+		buf.WriteString("(")
+		for i, pkfield := range pkfields[:lastcol] {
+			buf.Myprintf("%v = ", sqlparser.NewColIdent(pkfield.Name))
+			pk[i].EncodeSQL(buf)
+			buf.WriteString(" and ")
+		}
+		buf.Myprintf("%v > ", sqlparser.NewColIdent(pkfields[lastcol].Name))
+		pk[lastcol].EncodeSQL(buf)
+		buf.WriteString(")")
+	}
+}
+
+// withEndPK adds the endpk directive to the query of a row stream, so that
+// it doesn't stream the rows after the end of a range.
+func withEndPK(query string, end *sqltypes.Result) (string, error) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return "", err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return "", fmt.Errorf("unexpected query: %v", query)
+	}
+	values := make([]string, len(end.Rows[0]))
+	for i, val := range end.Rows[0] {
+		values[i] = hex.EncodeToString(val.Raw())
+	}
+	sel.Comments = append(sel.Comments, fmt.Sprintf(`/*vt+ endpk="%s" */`, strings.Join(values, ",")))
+	return sqlparser.String(sel), nil
+}
+
+// encodeLastPK encodes a lastpk for copy_state, as a string literal. It
+// returns null if lastpk is nil.
+func encodeLastPK(lastpk *sqltypes.Result) (string, error) {
+	if lastpk == nil {
+		return "null", nil
+	}
+	buf, err := prototext.Marshal(sqltypes.ResultToProto3(lastpk))
+	if err != nil {
+		return "", err
+	}
+	return encodeString(string(buf)), nil
+}
+
+// saveSnapshots saves the snapshot positions of the ranges being copied in
+// copy_state. If the stream has no position yet, it's set to the earliest
+// snapshot position.
+func (vc *vcopier) saveSnapshots(snapshots []*copySnapshot) error {
+	settings, err := binlogplayer.ReadVRSettings(vc.vr.dbClient, vc.vr.id)
+	if err != nil {
+		return err
+	}
+	if err := vc.vr.dbClient.Begin(); err != nil {
+		return err
+	}
+	startPos := settings.StartPos
+	if startPos.IsZero() {
+		startPos = snapshots[0].pos
+		for _, snapshot := range snapshots[1:] {
+			if startPos.AtLeast(snapshot.pos) {
+				startPos = snapshot.pos
+			}
+		}
+		update := binlogplayer.GenerateUpdatePos(vc.vr.id, startPos, time.Now().Unix(), 0, vc.vr.stats.CopyRowCount.Get(), *vreplicationStoreCompressedGTID)
+		if _, err := vc.vr.dbClient.Execute(update); err != nil {
+			return err
+		}
+	}
+	for _, snapshot := range snapshots {
+		// The rows copied from the snapshot would miss the events between
+		// it and the position of the stream.
+		if !snapshot.pos.AtLeast(startPos) {
+			return fmt.Errorf("the snapshot position %v of table %s is before the position of the stream %v", snapshot.pos, snapshot.tc.tableName, startPos)
+		}
+		query := fmt.Sprintf("update _vt.copy_state set snapshot_pos=%s, prev_lastpk=lastpk where vrepl_id=%d and table_name=%s and range_id=%d",
+			encodeString(mysql.EncodePosition(snapshot.pos)), vc.vr.id, encodeString(snapshot.tc.tableName), snapshot.tc.rng.id)
+		if _, err := vc.vr.dbClient.Execute(query); err != nil {
+			return err
+		}
+	}
+	return vc.vr.dbClient.Commit()
+}
+
+// resolvePendingCopies fast-forwards the stream past the positions the
+// pending copies were copied at. Then the tables whose ranges were all
+// copied are removed from copy_state, and the others get the events of all
+// the rows up to the lastpk of their ranges.
+func (vc *vcopier) resolvePendingCopies(ctx context.Context, copyState map[string]*sqltypes.Result, copyRanges map[string][]*copyRange) error {
+	defer vc.vr.dbClient.Rollback()
+	for {
+		settings, err := binlogplayer.ReadVRSettings(vc.vr.dbClient, vc.vr.id)
+		if err != nil {
+			return err
+		}
+		var stopPos mysql.Position
+		for _, ranges := range copyRanges {
+			for _, r := range ranges {
+				if r.pending != nil && !settings.StartPos.AtLeast(r.pending.pos) {
+					stopPos = r.pending.pos
+					break
+				}
+			}
+		}
+		if stopPos.IsZero() {
+			break
+		}
+		log.Infof("Fast-forwarding to %v, the position of the tables copied concurrently", stopPos)
+		vp := newVPlayer(vc.vr, settings, copyState, stopPos, "fastforward")
+		vp.copyRanges = copyRanges
+		if err := vp.play(ctx); err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+
+	if err := vc.vr.dbClient.Begin(); err != nil {
+		return err
+	}
+	for tableName, ranges := range copyRanges {
+		completed := true
+		for _, r := range ranges {
+			completed = completed && r.completed
+		}
+		if !completed {
+			continue
+		}
+		query := fmt.Sprintf("delete from _vt.copy_state where vrepl_id=%d and table_name=%s", vc.vr.id, encodeString(tableName))
+		if _, err := vc.vr.dbClient.Execute(query); err != nil {
+			return err
+		}
+	}
+	if _, err := vc.vr.dbClient.Execute(fmt.Sprintf("update _vt.copy_state set snapshot_pos=null, prev_lastpk=null where vrepl_id=%d", vc.vr.id)); err != nil {
+		return err
+	}
+	return vc.vr.dbClient.Commit()
+}
+
+// copy copies the rows of the range. Once its row stream started, it sends
+// the snapshot position to started, and waits for proceed before copying
+// the rows. Each packet received is transactionally committed with the
+// lastpk.
+func (tc *tableCopier) copy(ctx context.Context, started chan<- *copySnapshot, proceed <-chan struct{}) error {
+	vr := tc.vc.vr
+	dbClient := newVDBClient(vr.vre.dbClientFactoryFiltered(), vr.stats)
+	if err := dbClient.connectFiltered(); err != nil {
+		return err
+	}
+	defer dbClient.Close()
+	defer dbClient.Rollback()
+	if _, err := dbClient.Execute("set foreign_key_checks=0;"); err != nil {
+		return err
+	}
+
+	log.Infof("Copying table %s, range %d, lastpk: %v", tc.tableName, tc.rng.id, tc.rng.lastpk)
+	var lastpkpb *querypb.QueryResult
+	if lastpk := tc.rng.lastpk; lastpk != nil {
+		lastpkpb = sqltypes.ResultToProto3(lastpk)
+	} else if start := tc.rng.start; start != nil {
+		lastpkpb = sqltypes.ResultToProto3(start)
+	}
+	query := tc.initialPlan.SendRule.Filter
+	if tc.rng.end != nil {
+		var err error
+		if query, err = withEndPK(query, tc.rng.end); err != nil {
+			return err
+		}
+	}
+
+	var tablePlan *TablePlan
+	var pkfields []*querypb.Field
+	var updateCopyState *sqlparser.ParsedQuery
+	var bv map[string]*querypb.BindVariable
+	var sqlbuffer bytes2.Buffer
+	err := vr.sourceVStreamer.VStreamRows(ctx, query, lastpkpb, func(rows *binlogdatapb.VStreamRowsResponse) error {
+		for {
+			select {
+			case <-ctx.Done():
+				return io.EOF
+			default:
+			}
+			// verify throttler is happy, otherwise keep looping
+			if vr.vre.throttlerClient.ThrottleCheckOKOrWait(ctx) {
+				break
+			}
+		}
+		if tablePlan == nil {
+			if len(rows.Fields) == 0 {
+				return fmt.Errorf("expecting field event first, got: %v", rows)
+			}
+			pos, err := mysql.DecodePosition(rows.Gtid)
+			if err != nil {
+				return err
+			}
+			select {
+			case started <- &copySnapshot{tc: tc, pos: pos}:
+			case <-ctx.Done():
+				return io.EOF
+			}
+			select {
+			case <-proceed:
+			case <-ctx.Done():
+				return io.EOF
+			}
+			fieldEvent := &binlogdatapb.FieldEvent{
+				TableName: tc.initialPlan.SendRule.Match,
+			}
+			fieldEvent.Fields = append(fieldEvent.Fields, rows.Fields...)
+			tablePlan, err = tc.plan.buildExecutionPlan(fieldEvent)
+			if err != nil {
+				return err
+			}
+			pkfields = append(pkfields, rows.Pkfields...)
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("update _vt.copy_state set lastpk=%a where vrepl_id=%s and table_name=%s and range_id=%s", ":lastpk", strconv.Itoa(int(vr.id)), encodeString(tc.tableName), strconv.FormatInt(tc.rng.id, 10))
+			updateCopyState = buf.ParsedQuery()
+		}
+		if len(rows.Rows) == 0 {
+			return nil
+		}
+
+		if err := dbClient.Begin(); err != nil {
+			return err
+		}
+		_, err := tablePlan.applyBulkInsert(&sqlbuffer, rows, func(sql string) (*sqltypes.Result, error) {
+			start := time.Now()
+			qr, err := dbClient.ExecuteWithRetry(ctx, sql)
+			if err != nil {
+				return nil, err
+			}
+			vr.stats.QueryTimings.Record("copy", start)
+			vr.stats.CopyRowCount.Add(int64(qr.RowsAffected))
+			vr.stats.QueryCount.Add("copy", 1)
+			return qr, err
+		})
+		if err != nil {
+			return err
+		}
+		buf, err := prototext.Marshal(&querypb.QueryResult{
+			Fields: pkfields,
+			Rows:   []*querypb.Row{rows.Lastpk},
+		})
+		if err != nil {
+			return err
+		}
+		bv = map[string]*querypb.BindVariable{
+			"lastpk": {
+				Type:  sqltypes.VarBinary,
+				Value: buf,
+			},
+		}
+		updateState, err := updateCopyState.GenerateQuery(bv, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := dbClient.Execute(updateState); err != nil {
+			return err
+		}
+		return dbClient.Commit()
+	})
+	// If there was a timeout, return without an error.
+	select {
+	case <-ctx.Done():
+		log.Infof("Copy of %v, range %d stopped at lastpk: %v", tc.tableName, tc.rng.id, bv)
+		return nil
+	default:
+	}
+	if err != nil {
+		return err
+	}
+	if tablePlan == nil {
+		return fmt.Errorf("row stream of table %s ended before it started", tc.tableName)
+	}
+	log.Infof("Copy of %v, range %d finished at lastpk: %v", tc.tableName, tc.rng.id, bv)
+	query = fmt.Sprintf("update _vt.copy_state set completed=1 where vrepl_id=%d and table_name=%s and range_id=%d", vr.id, encodeString(tc.tableName), tc.rng.id)
+	if _, err := dbClient.Execute(query); err != nil {
+		return err
+	}
+	return nil
+}
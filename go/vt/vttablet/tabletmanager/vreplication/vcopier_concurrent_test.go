@@ -0,0 +1,284 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+const testCopyGTID = "MySQL56/00000000-0000-0000-0000-000000000001"
+
+func newTestCopyVReplicator(dbClient binlogplayer.DBClient) *vreplicator {
+	stats := binlogplayer.NewStats()
+	return &vreplicator{
+		id:       1,
+		dbClient: newVDBClient(dbClient, stats),
+		stats:    stats,
+	}
+}
+
+func mustDecodePosition(t *testing.T, s string) mysql.Position {
+	t.Helper()
+	pos, err := mysql.DecodePosition(s)
+	require.NoError(t, err)
+	return pos
+}
+
+func TestReadCopyState(t *testing.T) {
+	lastpk := `fields:{name:"id" type:INT64} rows:{lengths:1 values:"5"}`
+	prevLastpk := `fields:{name:"id" type:INT64} rows:{lengths:1 values:"2"}`
+	bound := `fields:{name:"id" type:INT64} rows:{lengths:1 values:"4"}`
+	dbClient := binlogplayer.NewMockDBClient(t)
+	dbClient.ExpectRequest("select table_name, lastpk, snapshot_pos, prev_lastpk, completed, range_id, range_start, range_end from _vt.copy_state where vrepl_id=1 order by table_name, range_id", sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"table_name|lastpk|snapshot_pos|prev_lastpk|completed|range_id|range_start|range_end",
+		"varbinary|varbinary|varbinary|varbinary|int8|int32|varbinary|varbinary"),
+		"t1|"+lastpk+"|null|null|0|0|null|null",
+		"t2|"+lastpk+"|"+testCopyGTID+":1-10|"+prevLastpk+"|0|0|null|null",
+		"t3|"+lastpk+"|"+testCopyGTID+":1-12|null|1|0|null|null",
+		"t4|null|null|null|0|0|null|null",
+		"t5|"+bound+"|null|null|1|0|null|"+bound,
+		"t5|null|null|null|0|1|"+bound+"|null",
+	), nil)
+
+	vc := newVCopier(newTestCopyVReplicator(dbClient))
+	tablesToCopy, copyState, copyRanges, err := vc.readCopyState(context.Background())
+	require.NoError(t, err)
+	dbClient.Wait()
+
+	wantLastpk := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "5")
+	wantPrevLastpk := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "2")
+	wantBound := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "4")
+	assert.Equal(t, []string{"t1", "t2", "t4", "t5"}, tablesToCopy)
+	utils.MustMatch(t, map[string]*sqltypes.Result{
+		"t1": wantLastpk,
+		"t4": nil,
+	}, copyState)
+	utils.MustMatch(t, map[string][]*copyRange{
+		"t2": {{lastpk: wantLastpk, pending: &pendingCopy{pos: mustDecodePosition(t, testCopyGTID+":1-10"), prevLastpk: wantPrevLastpk}}},
+		"t3": {{lastpk: wantLastpk, completed: true, pending: &pendingCopy{pos: mustDecodePosition(t, testCopyGTID+":1-12")}}},
+		"t5": {{end: wantBound, lastpk: wantBound, completed: true}, {id: 1, start: wantBound}},
+	}, copyRanges)
+}
+
+func TestCopyRangeCopied(t *testing.T) {
+	pk := func(id string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), id)
+	}
+	pending := &pendingCopy{pos: mustDecodePosition(t, testCopyGTID+":1-10"), prevLastpk: pk("2")}
+	testcases := []struct {
+		name           string
+		r              *copyRange
+		want, resolved *pkRange
+	}{{
+		name: "not started",
+		r:    &copyRange{start: pk("4")},
+	}, {
+		name:     "in progress",
+		r:        &copyRange{start: pk("4"), lastpk: pk("6")},
+		want:     &pkRange{start: pk("4"), end: pk("6")},
+		resolved: &pkRange{start: pk("4"), end: pk("6")},
+	}, {
+		name:     "completed",
+		r:        &copyRange{start: pk("4"), end: pk("8"), lastpk: pk("8"), completed: true},
+		want:     &pkRange{start: pk("4"), end: pk("8")},
+		resolved: &pkRange{start: pk("4"), end: pk("8")},
+	}, {
+		name:     "pending",
+		r:        &copyRange{lastpk: pk("3"), pending: pending},
+		want:     &pkRange{end: pk("2")},
+		resolved: &pkRange{end: pk("3")},
+	}, {
+		name:     "pending completed",
+		r:        &copyRange{end: pk("4"), lastpk: pk("4"), completed: true, pending: pending},
+		want:     &pkRange{end: pk("2")},
+		resolved: &pkRange{end: pk("4")},
+	}, {
+		name:     "pending first rows",
+		r:        &copyRange{start: pk("4"), lastpk: pk("6"), pending: &pendingCopy{pos: pending.pos}},
+		resolved: &pkRange{start: pk("4"), end: pk("6")},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			utils.MustMatch(t, tcase.want, tcase.r.copied(false))
+			utils.MustMatch(t, tcase.resolved, tcase.r.copied(true))
+		})
+	}
+}
+
+func TestSaveSnapshots(t *testing.T) {
+	settingsFields := sqltypes.MakeTestFields("pos|stop_pos|max_tps|max_replication_lag|state", "varbinary|varbinary|int64|int64|varbinary")
+	snapshots := func(vc *vcopier) []*copySnapshot {
+		return []*copySnapshot{{
+			tc:  &tableCopier{vc: vc, tableName: "t1", rng: &copyRange{}},
+			pos: mustDecodePosition(t, testCopyGTID+":1-12"),
+		}, {
+			tc:  &tableCopier{vc: vc, tableName: "t2", rng: &copyRange{id: 1}},
+			pos: mustDecodePosition(t, testCopyGTID+":1-10"),
+		}}
+	}
+
+	t.Run("first copy", func(t *testing.T) {
+		dbClient := binlogplayer.NewMockDBClient(t)
+		dbClient.ExpectRequest("select pos, stop_pos, max_tps, max_replication_lag, state from _vt.vreplication where id=1",
+			sqltypes.MakeTestResult(settingsFields, "||0|0|Copying"), nil)
+		dbClient.ExpectRequest("begin", &sqltypes.Result{}, nil)
+		// The stream starts at the earliest snapshot.
+		dbClient.ExpectRequestRE("update _vt.vreplication set pos='"+testCopyGTID+":1-10', .* where id=1", &sqltypes.Result{}, nil)
+		dbClient.ExpectRequest("update _vt.copy_state set snapshot_pos='"+testCopyGTID+":1-12', prev_lastpk=lastpk where vrepl_id=1 and table_name='t1' and range_id=0", &sqltypes.Result{}, nil)
+		dbClient.ExpectRequest("update _vt.copy_state set snapshot_pos='"+testCopyGTID+":1-10', prev_lastpk=lastpk where vrepl_id=1 and table_name='t2' and range_id=1", &sqltypes.Result{}, nil)
+		dbClient.ExpectRequest("commit", &sqltypes.Result{}, nil)
+
+		vc := newVCopier(newTestCopyVReplicator(dbClient))
+		require.NoError(t, vc.saveSnapshots(snapshots(vc)))
+		dbClient.Wait()
+	})
+
+	t.Run("snapshot before the stream", func(t *testing.T) {
+		dbClient := binlogplayer.NewMockDBClient(t)
+		dbClient.ExpectRequest("select pos, stop_pos, max_tps, max_replication_lag, state from _vt.vreplication where id=1",
+			sqltypes.MakeTestResult(settingsFields, testCopyGTID+":1-11||0|0|Copying"), nil)
+		dbClient.ExpectRequest("begin", &sqltypes.Result{}, nil)
+		dbClient.ExpectRequest("update _vt.copy_state set snapshot_pos='"+testCopyGTID+":1-12', prev_lastpk=lastpk where vrepl_id=1 and table_name='t1' and range_id=0", &sqltypes.Result{}, nil)
+
+		vc := newVCopier(newTestCopyVReplicator(dbClient))
+		err := vc.saveSnapshots(snapshots(vc))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the snapshot position "+testCopyGTID[len("MySQL56/"):]+":1-10 of table t2 is before the position of the stream")
+		dbClient.Wait()
+	})
+}
+
+func TestSaveRanges(t *testing.T) {
+	bound := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "4")
+	dbClient := binlogplayer.NewMockDBClient(t)
+	dbClient.ExpectRequest("begin", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("delete from _vt.copy_state where vrepl_id=1 and table_name='t1'", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("insert into _vt.copy_state(vrepl_id, table_name, range_id, range_start, range_end) values "+
+		"(1, 't1', 0, null, 'fields:{name:\\\"id\\\" type:INT64} rows:{lengths:1 values:\\\"4\\\"}'), "+
+		"(1, 't1', 1, 'fields:{name:\\\"id\\\" type:INT64} rows:{lengths:1 values:\\\"4\\\"}', null)", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("commit", &sqltypes.Result{}, nil)
+
+	vc := newVCopier(newTestCopyVReplicator(dbClient))
+	require.NoError(t, vc.saveRanges("t1", []*copyRange{{end: bound}, {id: 1, start: bound}}))
+	dbClient.Wait()
+}
+
+func TestWritePKAfter(t *testing.T) {
+	pkfields := sqltypes.MakeTestFields("id1|id2", "int64|varbinary")
+	buf := sqlparser.NewTrackedBuffer(nil)
+	writePKAfter(buf, pkfields, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarBinary("a")})
+	assert.Equal(t, "(id1 = 1 and id2 > 'a') or (id1 > 1)", buf.String())
+}
+
+func TestWithEndPK(t *testing.T) {
+	end := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id1|id2", "int64|varbinary"), "1|a")
+	got, err := withEndPK("select /*vt+ ukColumns=\"id1\" */ id1, id2 from t1", end)
+	require.NoError(t, err)
+	assert.Equal(t, "select /*vt+ ukColumns=\"id1\" */ /*vt+ endpk=\"31,61\" */ id1, id2 from t1", got)
+}
+
+func TestRowEventPlan(t *testing.T) {
+	pk := func(id string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), id)
+	}
+	stats := binlogplayer.NewStats()
+	vp := &vplayer{
+		vr: &vreplicator{
+			source: &binlogdatapb.BinlogSource{
+				Filter: &binlogdatapb.Filter{
+					Rules: []*binlogdatapb.Rule{{Match: "/.*"}},
+				},
+			},
+			colInfoMap: map[string][]*ColumnInfo{
+				"t1": {{Name: "id", IsPK: true}, {Name: "val"}},
+				"t2": {{Name: "id", IsPK: true}, {Name: "val"}},
+				"t3": {{Name: "id", IsPK: true}, {Name: "val"}},
+			},
+			stats: stats,
+		},
+		copyRanges: map[string][]*copyRange{
+			"t1": {{
+				end:       pk("5"),
+				lastpk:    pk("5"),
+				completed: true,
+				pending:   &pendingCopy{pos: mustDecodePosition(t, testCopyGTID+":1-10"), prevLastpk: pk("2")},
+			}, {
+				id:     1,
+				start:  pk("5"),
+				lastpk: pk("8"),
+			}},
+			"t2": {{
+				lastpk:  pk("3"),
+				pending: &pendingCopy{pos: mustDecodePosition(t, testCopyGTID+":1-10")},
+			}},
+		},
+		tablePlans: make(map[string]*TablePlan),
+	}
+	plan, err := buildReplicatorPlan(vp.vr.source.Filter, vp.vr.colInfoMap, nil, vp.streamedRanges(), stats)
+	require.NoError(t, err)
+	vp.replicatorPlan = plan
+	vp.buildRangedTables()
+	fields := sqltypes.MakeTestFields("id|val", "int64|varbinary")
+	for _, tableName := range []string{"t1", "t2", "t3"} {
+		fieldEvent := &binlogdatapb.FieldEvent{TableName: tableName, Fields: fields}
+		tplan, err := plan.buildExecutionPlan(fieldEvent)
+		require.NoError(t, err)
+		vp.tablePlans[tableName] = tplan
+		if rt := vp.rangedTables[tableName]; rt != nil {
+			rt.fieldEvent = fieldEvent
+		}
+	}
+
+	// Until the snapshot, the events are applied to the rows copied
+	// before it, and the events of tables without such rows are skipped.
+	vp.pos = mustDecodePosition(t, testCopyGTID+":1-9")
+	got, err := vp.rowEventPlan("t1")
+	require.NoError(t, err)
+	assert.Equal(t, "delete from t1 where id=:b_id and (((:b_id) <= (2)) or ((:b_id) > (5) and (:b_id) <= (8)))", got.Delete.Query)
+	got, err = vp.rowEventPlan("t2")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	// From it on, they are applied to all the copied rows.
+	vp.pos = mustDecodePosition(t, testCopyGTID+":1-10")
+	got, err = vp.rowEventPlan("t1")
+	require.NoError(t, err)
+	assert.Equal(t, "delete from t1 where id=:b_id and (((:b_id) <= (5)) or ((:b_id) > (5) and (:b_id) <= (8)))", got.Delete.Query)
+	got, err = vp.rowEventPlan("t2")
+	require.NoError(t, err)
+	assert.Equal(t, "delete from t2 where id=:b_id and (((:b_id) <= (3)))", got.Delete.Query)
+
+	// The other tables are fully copied.
+	got, err = vp.rowEventPlan("t3")
+	require.NoError(t, err)
+	assert.True(t, got == vp.tablePlans["t3"])
+
+	_, err = vp.rowEventPlan("t4")
+	assert.EqualError(t, err, "unexpected event on table t4")
+}
@@ -45,6 +45,27 @@ func newVDBClient(dbclient binlogplayer.DBClient, stats *binlogplayer.Stats) *vd
 	}
 }
 
+// connectFiltered connects the client with the session settings that
+// controller.runBlp sets on the connection of a filtered replication stream.
+// It's used by the additional connections that apply events or copy rows
+// for the stream.
+func (vc *vdbClient) connectFiltered() error {
+	if err := vc.Connect(); err != nil {
+		return err
+	}
+	for _, query := range []string{
+		"set @@session.time_zone = '+00:00'",
+		"set names binary",
+		"set @@session.sql_mode = CONCAT(@@session.sql_mode, ',NO_AUTO_VALUE_ON_ZERO')",
+	} {
+		if _, err := vc.ExecuteFetch(query, 10000); err != nil {
+			vc.Close()
+			return err
+		}
+	}
+	return nil
+}
+
 func (vc *vdbClient) Begin() error {
 	if vc.InTransaction {
 		return nil
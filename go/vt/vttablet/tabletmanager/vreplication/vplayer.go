@@ -66,6 +66,23 @@ type vplayer struct {
 	canAcceptStmtEvents bool
 
 	phase string
+
+	// copyRanges are the tables that are copied concurrently, as one or
+	// more ranges of their primary key, keyed by target table. They are set
+	// by the vcopier.
+	copyRanges map[string][]*copyRange
+	// rangedTables are the source tables of copyRanges. Their plans change
+	// as the vplayer goes past the positions the ranges were copied at.
+	rangedTables map[string]*rangedTable
+
+	// shadowTables are the online DDL shadow tables of the source that
+	// were created since the vplayer started, for the EXEC_ADDITIVE mode.
+	shadowTables shadowTables
+
+	// parallel is set if the replicated transactions are applied by a
+	// parallelApplier, and txn is the transaction being received.
+	parallel *parallelApplier
+	txn      *applierTxn
 }
 
 // newVPlayer creates a new vplayer. Parameters:
@@ -93,6 +110,7 @@ func newVPlayer(vr *vreplicator, settings binlogplayer.VRSettings, copyState map
 		timeLastSaved: time.Now(),
 		tablePlans:    make(map[string]*TablePlan),
 		phase:         phase,
+		shadowTables:  make(shadowTables),
 	}
 }
 
@@ -106,12 +124,13 @@ func (vp *vplayer) play(ctx context.Context) error {
 		return nil
 	}
 
-	plan, err := buildReplicatorPlan(vp.vr.source.Filter, vp.vr.colInfoMap, vp.copyState, vp.vr.stats)
+	plan, err := buildReplicatorPlan(vp.vr.source.Filter, vp.vr.colInfoMap, vp.copyState, vp.streamedRanges(), vp.vr.stats)
 	if err != nil {
 		vp.vr.stats.ErrorCounts.Add([]string{"Plan"}, 1)
 		return err
 	}
 	vp.replicatorPlan = plan
+	vp.buildRangedTables()
 
 	// We can't run in statement mode if there are filters defined.
 	vp.canAcceptStmtEvents = true
@@ -208,10 +227,158 @@ func (vp *vplayer) applyStmtEvent(ctx context.Context, event *binlogdatapb.VEven
 	return fmt.Errorf("filter rules are not supported for SBR replication: %v", vp.vr.source.Filter.GetRules())
 }
 
+// rangedTable is a source table of copyRanges.
+type rangedTable struct {
+	targetName string
+	ranges     []*copyRange
+	fieldEvent *binlogdatapb.FieldEvent
+	// resolved is the number of pending ranges that the vplayer was past
+	// when tablePlan was built, or -1 if tablePlan must be built.
+	resolved  int
+	tablePlan *TablePlan
+}
+
+// streamedRanges returns the ranges of copyRanges whose events are
+// streamed: the ones that were copied, as of the position of the vplayer or
+// of the pending copies. This makes the stream send the events of a table as
+// soon as one of its ranges is copied. The plans that apply them are built
+// by rangedTablePlan.
+func (vp *vplayer) streamedRanges() map[string][]*pkRange {
+	if len(vp.copyRanges) == 0 {
+		return nil
+	}
+	streamed := make(map[string][]*pkRange, len(vp.copyRanges))
+	for tableName, ranges := range vp.copyRanges {
+		copied := []*pkRange{}
+		whole := false
+		for _, r := range ranges {
+			for _, resolved := range []bool{false, true} {
+				pr := r.copied(resolved)
+				switch {
+				case pr == nil:
+				case pr.start == nil && pr.end == nil:
+					// The other ranges make the stream send the pk
+					// columns that their plans compare.
+					whole = true
+				default:
+					copied = append(copied, pr)
+				}
+			}
+		}
+		if len(copied) == 0 && whole {
+			copied = append(copied, &pkRange{})
+		}
+		streamed[tableName] = copied
+	}
+	return streamed
+}
+
+// buildRangedTables maps the source tables of copyRanges to their ranges.
+func (vp *vplayer) buildRangedTables() {
+	vp.rangedTables = make(map[string]*rangedTable)
+	for tableName, ranges := range vp.copyRanges {
+		if tplan := vp.replicatorPlan.TargetTables[tableName]; tplan != nil {
+			vp.rangedTables[tplan.SendRule.Match] = &rangedTable{
+				targetName: tableName,
+				ranges:     ranges,
+				resolved:   -1,
+			}
+		}
+	}
+}
+
+// rangedTablePlan returns the plan of a source table of copyRanges as of
+// the position of the vplayer. It only applies the events to the rows that
+// were copied before that position: the rows of the ranges copied as of a
+// later position already have them. It returns nil if no rows were.
+func (vp *vplayer) rangedTablePlan(rt *rangedTable) (*TablePlan, error) {
+	resolved := 0
+	for _, r := range rt.ranges {
+		if r.pending != nil && vp.pos.AtLeast(r.pending.pos) {
+			resolved++
+		}
+	}
+	if resolved == rt.resolved {
+		return rt.tablePlan, nil
+	}
+	copied := []*pkRange{}
+	for _, r := range rt.ranges {
+		if pr := r.copied(r.pending == nil || vp.pos.AtLeast(r.pending.pos)); pr != nil {
+			copied = append(copied, pr)
+		}
+	}
+	plan, err := buildReplicatorPlan(vp.vr.source.Filter, vp.vr.colInfoMap, vp.copyState, map[string][]*pkRange{rt.targetName: copied}, vp.vr.stats)
+	if err != nil {
+		return nil, err
+	}
+	rt.tablePlan = nil
+	if plan.TablePlans[rt.fieldEvent.TableName] != nil {
+		if rt.tablePlan, err = plan.buildExecutionPlan(rt.fieldEvent); err != nil {
+			return nil, err
+		}
+	}
+	rt.resolved = resolved
+	return rt.tablePlan, nil
+}
+
+// rowEventPlan returns the plan to apply the row events of a table with. It
+// returns nil if the events must be skipped, because the rows they change
+// were copied as of a position that already has them.
+func (vp *vplayer) rowEventPlan(tableName string) (*TablePlan, error) {
+	if rt := vp.rangedTables[tableName]; rt != nil && rt.fieldEvent != nil {
+		return vp.rangedTablePlan(rt)
+	}
+	tplan := vp.tablePlans[tableName]
+	if tplan == nil {
+		return nil, fmt.Errorf("unexpected event on table %s", tableName)
+	}
+	return tplan, nil
+}
+
+// stopAtDDL saves the position of a DDL and stops the workflow.
+func (vp *vplayer) stopAtDDL(event *binlogdatapb.VEvent, message string) error {
+	if err := vp.vr.dbClient.Begin(); err != nil {
+		return err
+	}
+	if _, err := vp.updatePos(event.Timestamp); err != nil {
+		return err
+	}
+	if err := vp.vr.setState(binlogplayer.BlpStopped, message); err != nil {
+		return err
+	}
+	if err := vp.vr.dbClient.Commit(); err != nil {
+		return err
+	}
+	return io.EOF
+}
+
+// refreshPlans rebuilds the replicator plan from the schema of the target
+// tables after a DDL changed them. The table plans are rebuilt from the
+// field events that the source sends after the DDL, so the stream goes
+// on without a restart.
+func (vp *vplayer) refreshPlans(ctx context.Context) error {
+	colInfoMap, err := vp.vr.buildColInfoMap(ctx)
+	if err != nil {
+		return err
+	}
+	vp.vr.colInfoMap = colInfoMap
+	plan, err := buildReplicatorPlan(vp.vr.source.Filter, colInfoMap, vp.copyState, vp.streamedRanges(), vp.vr.stats)
+	if err != nil {
+		vp.vr.stats.ErrorCounts.Add([]string{"Plan"}, 1)
+		return err
+	}
+	vp.replicatorPlan = plan
+	vp.buildRangedTables()
+	return nil
+}
+
 func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.RowEvent) error {
-	tplan := vp.tablePlans[rowEvent.TableName]
+	tplan, err := vp.rowEventPlan(rowEvent.TableName)
+	if err != nil {
+		return err
+	}
 	if tplan == nil {
-		return fmt.Errorf("unexpected event on table %s", rowEvent.TableName)
+		return nil
 	}
 	for _, change := range rowEvent.RowChanges {
 		_, err := tplan.applyChange(change, func(sql string) (*sqltypes.Result, error) {
@@ -328,6 +495,17 @@ func (vp *vplayer) recordHeartbeat() error {
 func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 	defer vp.vr.dbClient.Rollback()
 
+	// Transactions are applied in parallel only once the copy phase is
+	// done: before that, the table plans change as the tables get copied.
+	if *parallelApplyWorkers > 1 && len(vp.copyState) == 0 && len(vp.copyRanges) == 0 {
+		vp.parallel = newParallelApplier(ctx, vp, *parallelApplyWorkers)
+		defer func() {
+			vp.parallel.close()
+			vp.parallel = nil
+			vp.txn = nil
+		}()
+	}
+
 	// If we're not running, set ReplicationLagSeconds to be very high.
 	// TODO(sougou): if we also stored the time of the last event, we
 	// can estimate this value more accurately.
@@ -358,6 +536,13 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 		// In both cases, now > timeLastSaved. If so, the GTID of the last unsavedEvent
 		// must be saved.
 		if time.Since(vp.timeLastSaved) >= idleTimeout && vp.unsavedEvent != nil {
+			// The position of the unsavedEvent includes the transactions
+			// that are being applied in parallel.
+			if vp.parallel != nil {
+				if err := vp.parallel.drain(); err != nil {
+					return err
+				}
+			}
 			posReached, err := vp.updatePos(vp.unsavedEvent.Timestamp)
 			if err != nil {
 				return err
@@ -389,7 +574,8 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 					// applying the next set of events as part of the current transaction. This approach
 					// also handles the case where the last transaction is partial. In that case,
 					// we only group the transactions with commits we've seen so far.
-					if hasAnotherCommit(items, i, j+1) {
+					// Transactions that are applied in parallel are not grouped.
+					if vp.parallel == nil && hasAnotherCommit(items, i, j+1) {
 						continue
 					}
 				}
@@ -429,6 +615,11 @@ func hasAnotherCommit(items [][]*binlogdatapb.VEvent, i, j int) bool {
 }
 
 func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, mustSave bool) error {
+	if vp.parallel != nil {
+		if handled, err := vp.applyParallelEvent(event, mustSave); handled || err != nil {
+			return err
+		}
+	}
 	stats := NewVrLogStats(event.Type.String())
 	switch event.Type {
 	case binlogdatapb.VEventType_GTID:
@@ -475,6 +666,10 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			return err
 		}
 		vp.tablePlans[event.FieldEvent.TableName] = tplan
+		if rt := vp.rangedTables[event.FieldEvent.TableName]; rt != nil {
+			rt.fieldEvent = event.FieldEvent
+			rt.resolved = -1
+		}
 		stats.Send(fmt.Sprintf("%v", event.FieldEvent))
 
 	case binlogdatapb.VEventType_INSERT, binlogdatapb.VEventType_DELETE, binlogdatapb.VEventType_UPDATE,
@@ -536,19 +731,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 				return io.EOF
 			}
 		case binlogdatapb.OnDDLAction_STOP:
-			if err := vp.vr.dbClient.Begin(); err != nil {
-				return err
-			}
-			if _, err := vp.updatePos(event.Timestamp); err != nil {
-				return err
-			}
-			if err := vp.vr.setState(binlogplayer.BlpStopped, fmt.Sprintf("Stopped at DDL %s", event.Statement)); err != nil {
-				return err
-			}
-			if err := vp.vr.dbClient.Commit(); err != nil {
-				return err
-			}
-			return io.EOF
+			return vp.stopAtDDL(event, fmt.Sprintf("Stopped at DDL %s", event.Statement))
 		case binlogdatapb.OnDDLAction_EXEC:
 			// It's impossible to save the position transactionally with the statement.
 			// So, we apply the DDL first, and then save the position.
@@ -577,6 +760,30 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			if posReached {
 				return io.EOF
 			}
+		case binlogdatapb.OnDDLAction_EXEC_ADDITIVE:
+			ddls, err := buildAdditiveDDLs(event.Statement, vp.vr.source.Filter, vp.vr.colInfoMap, vp.shadowTables)
+			if err != nil {
+				return vp.stopAtDDL(event, fmt.Sprintf("Stopped at DDL %s: %v", event.Statement, err))
+			}
+			// Like for EXEC, the DDLs are applied before the position is saved.
+			for _, ddl := range ddls {
+				if _, err := vp.vr.dbClient.ExecuteWithRetry(ctx, ddl); err != nil {
+					return err
+				}
+				stats.Send(ddl)
+			}
+			if len(ddls) != 0 {
+				if err := vp.refreshPlans(ctx); err != nil {
+					return err
+				}
+			}
+			posReached, err := vp.updatePos(event.Timestamp)
+			if err != nil {
+				return err
+			}
+			if posReached {
+				return io.EOF
+			}
 		}
 	case binlogdatapb.VEventType_JOURNAL:
 		if vp.vr.dbClient.InTransaction {
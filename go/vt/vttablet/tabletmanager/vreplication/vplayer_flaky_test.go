@@ -1601,6 +1601,66 @@ func TestPlayerDDL(t *testing.T) {
 	cancel()
 }
 
+func TestPlayerAdditiveDDL(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+	execStatements(t, []string{
+		"create table t1(id int, primary key(id))",
+		fmt.Sprintf("create table %s.t1(id int, primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+		fmt.Sprintf("drop table %s.t1", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+	}
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_EXEC_ADDITIVE,
+	}
+	cancel, _ := startVReplication(t, bls, "")
+	defer cancel()
+	execStatements(t, []string{"insert into t1 values(1)"})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"insert into t1(id) values (1)",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+
+	// The column is added to the target, and replicated without a restart.
+	execStatements(t, []string{"alter table t1 add column val varchar(128)"})
+	expectDBClientQueries(t, []string{
+		"alter table t1 add column val varchar(128)",
+		"/update _vt.vreplication set pos=",
+		// The apply of the DDL on target generates an "other" event.
+		"/update _vt.vreplication set pos=",
+	})
+	execStatements(t, []string{"insert into t1 values(2, 'a')"})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"insert into t1(id,val) values (2,'a')",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+	expectData(t, "t1", [][]string{{"1", ""}, {"2", "a"}})
+
+	// The workflow stops at the DDLs that are not additive.
+	execStatements(t, []string{"alter table t1 drop column val"})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"/update _vt.vreplication set pos=",
+		"/update _vt.vreplication set state='Stopped', message='Stopped at DDL alter table t1 drop column val: not an additive DDL",
+		"commit",
+	})
+}
+
 func TestGTIDCompress(t *testing.T) {
 	ctx := context.Background()
 	defer deleteTablet(addTablet(100))
@@ -55,6 +55,11 @@ var (
 	copyPhaseDuration   = flag.Duration("vreplication_copy_phase_duration", 1*time.Hour, "Duration for each copy phase loop (before running the next catchup: default 1h)")
 	replicaLagTolerance = flag.Duration("vreplication_replica_lag_tolerance", 1*time.Minute, "Replica lag threshold duration: once lag is below this we switch from copy phase to the replication (streaming) phase")
 
+	// copyPhaseConcurrency is the number of tables, or ranges of tables, that a copy phase loop copies at the same time.
+	copyPhaseConcurrency = flag.Int("vreplication_copy_phase_concurrency", 1, "Number of tables, or ranges of tables, that each copy phase loop copies at the same time, each with its own row stream and target connection. 1 copies the tables one at a time.")
+	// copyPhaseSplitRows is the number of rows of the ranges that the tables copied concurrently are split in.
+	copyPhaseSplitRows = flag.Int64("vreplication_copy_phase_split_rows", 0, "If more than 0 and vreplication_copy_phase_concurrency is more than 1, the tables are split in ranges of their primary key of this many rows, which are copied at the same time. 0 copies each table as a whole.")
+
 	// vreplicationHeartbeatUpdateInterval determines how often the time_updated column is updated if there are no real events on the source and the source
 	// vstream is only sending heartbeats for this long. Keep this low if you expect high QPS and are monitoring this column to alert about potential
 	// outages. Keep this high if
@@ -70,6 +75,10 @@ var (
 	vreplicationExperimentalFlagOptimizeInserts int64 = 1
 
 	vreplicationStoreCompressedGTID = flag.Bool("vreplication_store_compressed_gtid", false, "Store compressed gtids in the pos column of _vt.vreplication")
+
+	// parallelApplyWorkers is the number of target connections that apply replicated transactions
+	// in the replication phase. When it's more than 1, the vplayer schedules transactions on a parallelApplier.
+	parallelApplyWorkers = flag.Int("vreplication_parallel_apply_workers", 1, "Number of target connections that apply replicated transactions in parallel once the copy phase is done. Transactions that write the same rows are applied in order, and all transactions commit in source order. 1 applies transactions one at a time.")
 )
 
 // vreplicator provides the core logic to start vreplication streams
@@ -90,7 +99,7 @@ type vreplicator struct {
 }
 
 // newVReplicator creates a new vreplicator. The valid fields from the source are:
-// Keyspce, Shard, Filter, OnDdl, ExternalMySql and StopAfterCopy.
+// Keyspce, Shard, Filter, OnDdl, ExternalMySql, StopAfterCopy and SeededFromBackup.
 // The Filter consists of Rules. Each Rule has a Match and an (inner) Filter field.
 // The Match can be a table name or, if it begins with a "/", a wildcard.
 // The Filter can be empty: get all rows and columns.
@@ -211,6 +220,11 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 				vr.stats.ErrorCounts.Add([]string{"Copy"}, 1)
 				return err
 			}
+		case vr.source.SeededFromBackup:
+			if err := newPruner(vr).prune(ctx); err != nil {
+				vr.stats.ErrorCounts.Add([]string{"Prune"}, 1)
+				return err
+			}
 		default:
 			if err := vr.resetFKCheckAfterCopy(); err != nil {
 				log.Warningf("Unable to reset FK check %v", err)
@@ -34,24 +34,36 @@ type VStreamer interface {
 	Stream(ctx context.Context, startPos string, tablePKs []*binlogdatapb.TableLastPK, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error
 }
 
+// ResultCache defines the functions of the result cache
+// that the BinlogWatcher needs.
+type ResultCache interface {
+	Enabled() bool
+	Invalidate(table string)
+	InvalidateAll()
+	Resume()
+	Suspend()
+}
+
 // BinlogWatcher is a tabletserver service that watches the
 // replication stream.  It will trigger schema reloads if a DDL
-// is encountered.
+// is encountered, and invalidates the result cache as rows change.
 type BinlogWatcher struct {
 	env              tabletenv.Env
 	watchReplication bool
 	vs               VStreamer
+	resultCache      ResultCache
 
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
 }
 
 // NewBinlogWatcher creates a new BinlogWatcher.
-func NewBinlogWatcher(env tabletenv.Env, vs VStreamer, config *tabletenv.TabletConfig) *BinlogWatcher {
+func NewBinlogWatcher(env tabletenv.Env, vs VStreamer, config *tabletenv.TabletConfig, resultCache ResultCache) *BinlogWatcher {
 	return &BinlogWatcher{
 		env:              env,
 		vs:               vs,
-		watchReplication: config.WatchReplication || config.TrackSchemaVersions,
+		resultCache:      resultCache,
+		watchReplication: config.WatchReplication || config.TrackSchemaVersions || resultCache.Enabled(),
 	}
 }
 
@@ -92,8 +104,20 @@ func (blw *BinlogWatcher) process(ctx context.Context) {
 	for {
 		// VStreamer will reload the schema when it encounters a DDL.
 		err := blw.vs.Stream(ctx, "current", nil, filter, func(events []*binlogdatapb.VEvent) error {
+			// The result cache can only serve results while it sees
+			// the changes of the replication stream.
+			blw.resultCache.Resume()
+			for _, ev := range events {
+				switch ev.Type {
+				case binlogdatapb.VEventType_ROW:
+					blw.resultCache.Invalidate(ev.RowEvent.TableName)
+				case binlogdatapb.VEventType_DDL:
+					blw.resultCache.InvalidateAll()
+				}
+			}
 			return nil
 		})
+		blw.resultCache.Suspend()
 		log.Infof("ReplicationWatcher VStream ended: %v, retrying in 5 seconds", err)
 		select {
 		case <-ctx.Done():
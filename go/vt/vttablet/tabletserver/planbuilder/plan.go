@@ -169,6 +169,14 @@ type Plan struct {
 	// to serialize e.g. UPDATEs going to the same row.
 	WhereClause *sqlparser.ParsedQuery
 
+	// WorkloadClass is the workload class named in the WORKLOAD_CLASS
+	// comment directive of the query, if any.
+	WorkloadClass string
+
+	// CacheResult is set if the query asked replicas to cache its result
+	// with the CACHE_RESULT comment directive.
+	CacheResult bool
+
 	// FullStmt can be used when the query does not operate on tables
 	FullStmt sqlparser.Statement
 }
@@ -249,6 +257,8 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 		return nil, err
 	}
 	plan.Permissions = BuildPermissions(statement)
+	plan.WorkloadClass = sqlparser.WorkloadClassDirective(statement)
+	plan.CacheResult = sqlparser.CacheResultDirective(statement)
 	return plan, nil
 }
 
@@ -267,9 +277,10 @@ func BuildStreaming(sql string, tables map[string]*schema.Table, isReservedConn
 	}
 
 	plan := &Plan{
-		PlanID:      PlanSelectStream,
-		FullQuery:   GenerateFullQuery(statement),
-		Permissions: BuildPermissions(statement),
+		PlanID:        PlanSelectStream,
+		FullQuery:     GenerateFullQuery(statement),
+		Permissions:   BuildPermissions(statement),
+		WorkloadClass: sqlparser.WorkloadClassDirective(statement),
 	}
 
 	switch stmt := statement.(type) {
@@ -42,10 +42,12 @@ import (
 	tacl "vitess.io/vitess/go/vt/tableacl/acl"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/resultcache"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/workload"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
@@ -98,6 +100,15 @@ func (ep *TabletPlan) buildAuthorized() {
 	}
 }
 
+// TableNames returns the names of the tables that the plan accesses.
+func (ep *TabletPlan) TableNames() []string {
+	names := make([]string, 0, len(ep.Permissions))
+	for _, perm := range ep.Permissions {
+		names = append(names, perm.TableName)
+	}
+	return names
+}
+
 //_______________________________________________
 
 // QueryEngine implements the core functionality of tabletserver.
@@ -131,6 +142,12 @@ type QueryEngine struct {
 	// that we start more than one transaction per hot row (range).
 	// For implementation details, please see BeginExecute() in tabletserver.go.
 	txSerializer *txserializer.TxSerializer
+	// workload queues the queries of the workload classes in front of
+	// the query pools, so that a heavy workload does not starve the others.
+	workload *workload.Manager
+	// resultCache caches the results of read-only queries on replicas.
+	// The BinlogWatcher invalidates it.
+	resultCache *resultcache.Cache
 
 	// Vars
 	maxResultSize    sync2.AtomicInt64
@@ -184,6 +201,8 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 		qe.streamConsolidator = NewStreamConsolidator(config.ConsolidatorStreamTotalSize, config.ConsolidatorStreamQuerySize, returnStreamResult)
 	}
 	qe.txSerializer = txserializer.New(env)
+	qe.workload = workload.NewManager(env)
+	qe.resultCache = resultcache.New(env)
 
 	qe.strictTableACL = config.StrictTableACL
 	qe.enableTableACLDryRun = config.EnableTableACLDryRun
@@ -233,6 +252,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	env.Exporter().HandleFunc("/debug/query_rules", qe.handleHTTPQueryRules)
 	env.Exporter().HandleFunc("/debug/consolidations", qe.handleHTTPConsolidations)
 	env.Exporter().HandleFunc("/debug/acl", qe.handleHTTPAclJSON)
+	env.Exporter().HandleFunc("/debug/workload_classes", qe.workload.ServeHTTP)
 
 	return qe
 }
@@ -123,9 +123,20 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		qre.tsv.Stats().ResultHistogram.Add(int64(len(reply.Rows)))
 	}(time.Now())
 
-	if err := qre.checkPermissions(); err != nil {
+	release, err := qre.checkPermissions()
+	if err != nil {
 		return nil, err
 	}
+	defer release()
+
+	// The queries of transactions are not queued, see workload.Manager.
+	if qre.connID == 0 {
+		done, err := qre.waitForWorkloadClass()
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+	}
 
 	switch qre.plan.PlanID {
 	case p.PlanNextval:
@@ -275,9 +286,20 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 		qre.recordUserQuery("Stream", int64(time.Since(start)))
 	}(time.Now())
 
-	if err := qre.checkPermissions(); err != nil {
+	release, err := qre.checkPermissions()
+	if err != nil {
 		return err
 	}
+	defer release()
+
+	// The queries of transactions are not queued, see workload.Manager.
+	if qre.connID == 0 {
+		done, err := qre.waitForWorkloadClass()
+		if err != nil {
+			return err
+		}
+		defer done()
+	}
 
 	sql, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
 	if err != nil {
@@ -352,9 +374,11 @@ func (qre *QueryExecutor) MessageStream(callback StreamCallback) error {
 		qre.recordUserQuery("MessageStream", int64(time.Since(start)))
 	}(time.Now())
 
-	if err := qre.checkPermissions(); err != nil {
+	release, err := qre.checkPermissions()
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	done, err := qre.tsv.messager.Subscribe(qre.ctx, qre.plan.TableName().String(), func(r *sqltypes.Result) error {
 		select {
@@ -372,11 +396,13 @@ func (qre *QueryExecutor) MessageStream(callback StreamCallback) error {
 }
 
 // checkPermissions returns an error if the query does not pass all checks
-// (denied query, table ACL).
-func (qre *QueryExecutor) checkPermissions() error {
+// (denied query, table ACL). If a query rule throttles the query, it applies
+// the throttling last, and returns a function that must be called once the
+// query is done.
+func (qre *QueryExecutor) checkPermissions() (release func(), err error) {
 	// Skip permissions check if the context is local.
 	if tabletenv.IsLocalContext(qre.ctx) {
-		return nil
+		return func() {}, nil
 	}
 
 	// Check if the query relates to a table that is in the denylist.
@@ -387,14 +413,28 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars, qre.marginComments)
+	action, ruleCause, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars, qre.marginComments)
 	switch action {
 	case rules.QRFail:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
 	case rules.QRFailRetry:
-		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+	}
+
+	if err := qre.checkACL(username); err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case rules.QRDelay, rules.QRConcurrencyLimit, rules.QRRateLimit:
+		return ruleCause.Throttle(qre.ctx)
 	}
+	return func() {}, nil
+}
 
+// checkACL returns an error if the caller is not allowed to access
+// the tables of the query.
+func (qre *QueryExecutor) checkACL(username string) error {
 	// Skip ACL check for queries against the dummy dual table
 	if qre.plan.TableName().String() == "dual" {
 		return nil
@@ -598,6 +638,53 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 // execSelect sends a query to mysql only if another identical query is not running. Otherwise, it waits and
 // reuses the result. If the plan is missing field info, it sends the query to mysql requesting full info.
 func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
+	if qre.shouldCacheResult() {
+		return qre.execSelectCached()
+	}
+	return qre.execSelectUncached()
+}
+
+// shouldCacheResult returns true if the result of a select may come from,
+// and go to, the result cache of replicas.
+func (qre *QueryExecutor) shouldCacheResult() bool {
+	rc := qre.tsv.qe.resultCache
+	if !rc.Enabled() || qre.tabletType == topodatapb.TabletType_PRIMARY || qre.plan.PlanID != p.PlanSelect {
+		return false
+	}
+	// Nothing invalidates the results of queries against the dummy dual table.
+	if qre.plan.TableName().String() == "dual" {
+		return false
+	}
+	return rc.Cacheable(qre.plan.TableNames(), qre.plan.CacheResult)
+}
+
+// execSelectCached returns the result of a select from the result cache,
+// or runs the select and caches its result.
+func (qre *QueryExecutor) execSelectCached() (*sqltypes.Result, error) {
+	_, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rc := qre.tsv.qe.resultCache
+	tableName := qre.plan.TableName().String()
+	if tableName == "" {
+		tableName = "Join"
+	}
+	result, token := rc.Get(sqlWithoutComments, tableName)
+	if result != nil {
+		qre.logStats.QuerySources |= tabletenv.QuerySourceResultCache
+		return result, nil
+	}
+	result, err = qre.execSelectUncached()
+	if err != nil {
+		return nil, err
+	}
+	rc.Set(sqlWithoutComments, qre.plan.TableNames(), token, result)
+	return result, nil
+}
+
+// execSelectUncached runs a select without the result cache.
+func (qre *QueryExecutor) execSelectUncached() (*sqltypes.Result, error) {
 	if qre.tsv.qe.enableQueryPlanFieldCaching && qre.plan.Fields != nil {
 		result, err := qre.qFetch(qre.logStats, qre.plan.FullQuery, qre.bindVars)
 		if err != nil {
@@ -659,6 +746,22 @@ func (qre *QueryExecutor) execOther() (*sqltypes.Result, error) {
 	return qre.execDBConn(conn, qre.query, true)
 }
 
+// waitForWorkloadClass blocks until the workload class of the query lets
+// it run. The caller must call done once the query is done.
+func (qre *QueryExecutor) waitForWorkloadClass() (done func(), err error) {
+	name := qre.tsv.qe.workload.Classify(qre.ctx, qre.plan.WorkloadClass, qre.plan.TableName().String())
+	if name == "" {
+		return func() {}, nil
+	}
+	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.waitForWorkloadClass")
+	defer span.Finish()
+
+	start := time.Now()
+	done, err = qre.tsv.qe.workload.Wait(ctx, name)
+	qre.logStats.WaitingForConnection += time.Since(start)
+	return done, err
+}
+
 func (qre *QueryExecutor) getConn() (*connpool.DBConn, error) {
 	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.getConn")
 	defer span.Finish()
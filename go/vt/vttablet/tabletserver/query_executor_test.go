@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -45,6 +46,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -1221,6 +1223,246 @@ func TestQueryExecutorDenyListQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorResultCache(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(2), sqltypes.NewInt32(3)},
+		},
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableResultCache, db)
+	defer tsv.StopService()
+
+	// The cache only serves results while the binlog watcher
+	// streams the changes of the replica.
+	vs := newFakeWatcherVStreamer()
+	blw := NewBinlogWatcher(tsv, vs, tsv.config, tsv.qe.resultCache)
+	blw.Open()
+	defer blw.Close()
+	vs.send(&binlogdatapb.VEvent{Type: binlogdatapb.VEventType_HEARTBEAT})
+
+	execute := func() *QueryExecutor {
+		qre := newTestQueryExecutor(ctx, tsv, query, 0)
+		qre.tabletType = topodatapb.TabletType_REPLICA
+		got, err := qre.Execute()
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+		return qre
+	}
+
+	qre := execute()
+	assert.Zero(t, qre.logStats.QuerySources&tabletenv.QuerySourceResultCache)
+	assert.Equal(t, 1, db.GetQueryCalledNum(query))
+
+	qre = execute()
+	assert.NotZero(t, qre.logStats.QuerySources&tabletenv.QuerySourceResultCache)
+	assert.Equal(t, 1, db.GetQueryCalledNum(query))
+
+	// A row event for test_table invalidates the cached result.
+	vs.send(&binlogdatapb.VEvent{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "test_table"},
+	})
+	qre = execute()
+	assert.Zero(t, qre.logStats.QuerySources&tabletenv.QuerySourceResultCache)
+	assert.Equal(t, 2, db.GetQueryCalledNum(query))
+
+	// The primary never serves results from the cache.
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.tabletType = topodatapb.TabletType_PRIMARY
+	_, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Zero(t, qre.logStats.QuerySources&tabletenv.QuerySourceResultCache)
+	assert.Equal(t, 3, db.GetQueryCalledNum(query))
+}
+
+// newThrottlingRuleTabletServer returns a TabletServer whose query rules
+// contain only the rule, along with the result of the query it serves.
+func newThrottlingRuleTabletServer(t *testing.T, db *fakesqldb.DB, query string, qr *rules.Rule) (*TabletServer, *sqltypes.Result) {
+	t.Helper()
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(2), sqltypes.NewInt32(3)},
+		},
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	tsv := newTestTabletServer(context.Background(), noFlags, db)
+	rulesName := "throttlingRules"
+	qrs := rules.New()
+	qrs.Add(qr)
+	tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, qrs))
+	return tsv, want
+}
+
+func TestQueryExecutorThrottlingRuleDelay(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	qr := rules.NewQueryRule("delay test_table", "delay", rules.QRDelay)
+	qr.AddTableCond("test_table")
+	qr.SetDelay(20 * time.Millisecond)
+	tsv, want := newThrottlingRuleTabletServer(t, db, query, qr)
+	defer tsv.StopService()
+
+	start := time.Now()
+	got, err := newTestQueryExecutor(context.Background(), tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(20*time.Millisecond))
+
+	// A query that is canceled while it is delayed doesn't run.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "query delayed due to rule: delay test_table")
+	assert.Equal(t, 1, db.GetQueryCalledNum(query))
+}
+
+func TestQueryExecutorThrottlingRuleConcurrencyLimit(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	qr := rules.NewQueryRule("limit test_table", "limit", rules.QRConcurrencyLimit)
+	qr.AddTableCond("test_table")
+	qr.SetMaxConcurrency(1)
+	tsv, want := newThrottlingRuleTabletServer(t, db, query, qr)
+	defer tsv.StopService()
+
+	// Another query that the rule matched is running.
+	release, err := qr.Throttle(context.Background())
+	require.NoError(t, err)
+
+	_, err = newTestQueryExecutor(context.Background(), tsv, query, 0).Execute()
+	assert.EqualError(t, err, "concurrency limit of 1 queries exceeded due to rule: limit test_table")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Equal(t, 0, db.GetQueryCalledNum(query))
+
+	release()
+	got, err := newTestQueryExecutor(context.Background(), tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	// The query gave its slot back once it was done.
+	release, err = qr.Throttle(context.Background())
+	require.NoError(t, err)
+	release()
+}
+
+func TestQueryExecutorThrottlingRuleRateLimit(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	qr := rules.NewQueryRule("rate test_table", "rate", rules.QRRateLimit)
+	qr.AddTableCond("test_table")
+	qr.SetMaxQueriesPerSecond(1)
+	tsv, want := newThrottlingRuleTabletServer(t, db, query, qr)
+	defer tsv.StopService()
+
+	got, err := newTestQueryExecutor(context.Background(), tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	_, err = newTestQueryExecutor(context.Background(), tsv, query, 0).Execute()
+	assert.EqualError(t, err, "rate limit of 1 queries per second exceeded due to rule: rate test_table")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Equal(t, 1, db.GetQueryCalledNum(query))
+}
+
+func TestQueryExecutorWorkloadClass(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(2), sqltypes.NewInt32(3)},
+		},
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	err := tsv.qe.workload.SetClasses([]*tabletenv.WorkloadClassConfig{
+		{Name: "reporting", Tables: []string{"test_table"}, MaxConcurrency: 1},
+	})
+	require.NoError(t, err)
+
+	// Another query of the class holds its only slot, and its queue
+	// has no room.
+	done, err := tsv.qe.workload.Wait(ctx, "reporting")
+	require.NoError(t, err)
+
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	assert.EqualError(t, err, "workload class reporting: too many queued queries (max 0)")
+
+	// The queries of transactions are not queued.
+	target := tsv.sm.Target()
+	txid := newTransaction(tsv, nil)
+	defer tsv.Commit(ctx, target, txid)
+	got, err := newTestQueryExecutor(ctx, tsv, query, txid).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	done()
+	got, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+// fakeWatcherVStreamer streams the events passed to send,
+// and returns once they were processed.
+type fakeWatcherVStreamer struct {
+	events chan []*binlogdatapb.VEvent
+	sent   chan struct{}
+}
+
+func newFakeWatcherVStreamer() *fakeWatcherVStreamer {
+	return &fakeWatcherVStreamer{
+		events: make(chan []*binlogdatapb.VEvent),
+		sent:   make(chan struct{}),
+	}
+}
+
+func (vs *fakeWatcherVStreamer) Stream(ctx context.Context, startPos string, tablePKs []*binlogdatapb.TableLastPK, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
+	for {
+		select {
+		case events := <-vs.events:
+			if err := send(events); err != nil {
+				return err
+			}
+			vs.sent <- struct{}{}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (vs *fakeWatcherVStreamer) send(events ...*binlogdatapb.VEvent) {
+	vs.events <- events
+	<-vs.sent
+}
+
 type executorFlags int64
 
 const (
@@ -1231,6 +1473,7 @@ const (
 	shortTwopcAge
 	smallResultSize
 	disableOnlineDDL
+	enableResultCache
 )
 
 // newTestQueryExecutor uses a package level variable testTabletServer defined in tabletserver_test.go
@@ -1266,6 +1509,10 @@ func newTestTabletServer(ctx context.Context, flags executorFlags, db *fakesqldb
 	if flags&smallResultSize > 0 {
 		config.Oltp.MaxRows = 2
 	}
+	if flags&enableResultCache > 0 {
+		config.ResultCache.Enable = true
+		config.ResultCache.Tables = []string{"test_table"}
+	}
 	dbconfigs := newDBConfigs(db)
 	config.DB = dbconfigs
 	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), &topodatapb.TabletAlias{})
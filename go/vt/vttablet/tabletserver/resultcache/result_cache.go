@@ -0,0 +1,292 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resultcache provides the vttablet query result cache.
+// See the Cache struct for details.
+package resultcache
+
+import (
+	"container/list"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// Cache caches the results of read-only queries on replicas, keyed by the
+// query with its bind variables. Unlike the consolidator, which only shares
+// the result of a query with identical queries that run at the same time,
+// the cache keeps the result until one of the tables of the query changes.
+//
+// The cache learns that a table changed from the row events of the
+// replication stream, see Invalidate. It only serves results while it is
+// told that the replication stream is watched, see Resume and Suspend.
+// Because the row events arrive shortly after the replica applied them,
+// the cache can serve a result that is stale by that much.
+//
+// The cache holds at most MaxSizeBytes of results, and evicts the least
+// recently used ones when it is full.
+type Cache struct {
+	enabled bool
+	tables  map[string]bool
+	maxSize int64
+
+	// mu protects the following fields.
+	mu sync.Mutex
+	// watching is true while the replication stream is watched.
+	watching bool
+	// list and entries contain the *entry objects, byTable indexes them
+	// by the tables of their query.
+	list    *list.List
+	entries map[string]*list.Element
+	byTable map[string]map[string]*list.Element
+	size    int64
+	// generation is incremented on every invalidation. invalidated records
+	// for each table the generation at which it was last invalidated.
+	generation      int64
+	invalidated     map[string]int64
+	allInvalidated  int64
+	evictions       int64
+	hits, misses    *stats.CountersWithSingleLabel
+	invalidations   *stats.CountersWithSingleLabel
+	skippedSetStale *stats.Counter
+}
+
+type entry struct {
+	key    string
+	tables []string
+	result *sqltypes.Result
+	size   int64
+}
+
+// New returns a Cache for the config.
+func New(env tabletenv.Env) *Cache {
+	config := env.Config().ResultCache
+	rc := &Cache{
+		enabled:     config.Enable,
+		tables:      make(map[string]bool, len(config.Tables)),
+		maxSize:     config.MaxSizeBytes,
+		list:        list.New(),
+		entries:     make(map[string]*list.Element),
+		byTable:     make(map[string]map[string]*list.Element),
+		invalidated: make(map[string]int64),
+		hits: env.Exporter().NewCountersWithSingleLabel(
+			"ResultCacheHits",
+			"Number of queries whose result was found in the result cache",
+			"table"),
+		misses: env.Exporter().NewCountersWithSingleLabel(
+			"ResultCacheMisses",
+			"Number of cacheable queries whose result was not found in the result cache",
+			"table"),
+		invalidations: env.Exporter().NewCountersWithSingleLabel(
+			"ResultCacheInvalidations",
+			"Number of results removed from the result cache because their table changed",
+			"table"),
+		skippedSetStale: env.Exporter().NewCounter(
+			"ResultCacheStaleSkipped",
+			"Number of results not cached because their table changed while the query ran"),
+	}
+	for _, table := range config.Tables {
+		rc.tables[table] = true
+	}
+	env.Exporter().NewGaugeFunc("ResultCacheSize", "Size in bytes of the results in the result cache", rc.Size)
+	env.Exporter().NewGaugeFunc("ResultCacheEntries", "Number of results in the result cache", rc.Len)
+	env.Exporter().NewCounterFunc("ResultCacheEvictions", "Number of results evicted from the result cache because it was full", rc.Evictions)
+	return rc
+}
+
+// Enabled returns true if the cache is enabled.
+func (rc *Cache) Enabled() bool {
+	return rc.enabled
+}
+
+// Cacheable returns true if the results of a query on the tables may be
+// cached: either the query asked for it, or all the tables are configured
+// to be cached. Queries that read no table are never cached.
+func (rc *Cache) Cacheable(tables []string, directive bool) bool {
+	if !rc.enabled || len(tables) == 0 {
+		return false
+	}
+	if directive {
+		return true
+	}
+	for _, table := range tables {
+		if !rc.tables[table] {
+			return false
+		}
+	}
+	return true
+}
+
+// Get returns a copy of the cached result for the key, or nil. table is
+// the table under which the hit or miss is counted. The caller owns the
+// copy, and can modify it.
+// If the result is nil, token must be passed to Set along with the
+// result of the query.
+func (rc *Cache) Get(key, table string) (result *sqltypes.Result, token int64) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if !rc.watching {
+		return nil, -1
+	}
+	if element := rc.entries[key]; element != nil {
+		rc.list.MoveToFront(element)
+		rc.hits.Add(table, 1)
+		return element.Value.(*entry).result.Copy(), 0
+	}
+	rc.misses.Add(table, 1)
+	return nil, rc.generation
+}
+
+// Set caches the result of the query for the key. token is the value
+// returned by Get before the query ran: if any of the tables was
+// invalidated since, the result may be stale and is not cached.
+// The cache stores a copy of the result, so the caller can still modify it.
+func (rc *Cache) Set(key string, tables []string, token int64, result *sqltypes.Result) {
+	size := int64(len(key)) + result.CachedSize(true)
+	if size > rc.maxSize {
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if !rc.watching || token < 0 {
+		return
+	}
+	if rc.allInvalidated > token {
+		rc.skippedSetStale.Add(1)
+		return
+	}
+	for _, table := range tables {
+		if rc.invalidated[table] > token {
+			rc.skippedSetStale.Add(1)
+			return
+		}
+	}
+	if element := rc.entries[key]; element != nil {
+		rc.removeLocked(element)
+	}
+	element := rc.list.PushFront(&entry{key: key, tables: tables, result: result.Copy(), size: size})
+	rc.entries[key] = element
+	for _, table := range tables {
+		keys := rc.byTable[table]
+		if keys == nil {
+			keys = make(map[string]*list.Element)
+			rc.byTable[table] = keys
+		}
+		keys[key] = element
+	}
+	rc.size += size
+	for rc.size > rc.maxSize {
+		rc.removeLocked(rc.list.Back())
+		rc.evictions++
+	}
+}
+
+// Invalidate removes the cached results of the queries on the table.
+func (rc *Cache) Invalidate(table string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.generation++
+	rc.invalidated[table] = rc.generation
+	for _, element := range rc.byTable[table] {
+		rc.removeLocked(element)
+		rc.invalidations.Add(table, 1)
+	}
+}
+
+// InvalidateAll removes all the cached results.
+func (rc *Cache) InvalidateAll() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.invalidateAllLocked()
+}
+
+func (rc *Cache) invalidateAllLocked() {
+	rc.generation++
+	rc.allInvalidated = rc.generation
+	rc.invalidated = make(map[string]int64)
+	rc.list.Init()
+	rc.entries = make(map[string]*list.Element)
+	rc.byTable = make(map[string]map[string]*list.Element)
+	rc.size = 0
+}
+
+// Resume lets the cache serve results. It must be called once the
+// replication stream that invalidates the cache is watched.
+func (rc *Cache) Resume() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if !rc.enabled || rc.watching {
+		return
+	}
+	log.Info("Result cache: resumed")
+	rc.watching = true
+}
+
+// Suspend empties the cache and stops it from serving results. It must
+// be called when the replication stream is not watched anymore, because
+// the cache could then miss changes.
+func (rc *Cache) Suspend() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if !rc.watching {
+		return
+	}
+	log.Info("Result cache: suspended")
+	rc.watching = false
+	rc.invalidateAllLocked()
+}
+
+// removeLocked removes the entry of the element from the cache.
+// The method has the suffix "Locked" to clarify that "rc.mu" must be locked.
+func (rc *Cache) removeLocked(element *list.Element) {
+	e := element.Value.(*entry)
+	rc.list.Remove(element)
+	delete(rc.entries, e.key)
+	for _, table := range e.tables {
+		if keys := rc.byTable[table]; keys != nil {
+			delete(keys, e.key)
+			if len(keys) == 0 {
+				delete(rc.byTable, table)
+			}
+		}
+	}
+	rc.size -= e.size
+}
+
+// Size returns the size in bytes of the cached results.
+func (rc *Cache) Size() int64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.size
+}
+
+// Len returns the number of cached results.
+func (rc *Cache) Len() int64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return int64(rc.list.Len())
+}
+
+// Evictions returns the number of results evicted because the cache was full.
+func (rc *Cache) Evictions() int64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.evictions
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resultcache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func newTestCache(name string, maxSize int64, tables ...string) *Cache {
+	config := tabletenv.NewDefaultConfig()
+	config.ResultCache = tabletenv.ResultCacheConfig{
+		Enable:       true,
+		Tables:       tables,
+		MaxSizeBytes: maxSize,
+	}
+	rc := New(tabletenv.NewEnv(config, name))
+	rc.Resume()
+	return rc
+}
+
+func testResult(rows ...string) *sqltypes.Result {
+	result := &sqltypes.Result{}
+	for _, row := range rows {
+		result.Rows = append(result.Rows, []sqltypes.Value{sqltypes.NewVarChar(row)})
+	}
+	return result
+}
+
+func TestCacheable(t *testing.T) {
+	rc := newTestCache("ResultCacheCacheableTest", 1024*1024, "t1", "t2")
+	assert.True(t, rc.Cacheable([]string{"t1"}, false))
+	assert.True(t, rc.Cacheable([]string{"t1", "t2"}, false))
+	assert.False(t, rc.Cacheable([]string{"t1", "t3"}, false))
+	assert.True(t, rc.Cacheable([]string{"t1", "t3"}, true))
+	assert.False(t, rc.Cacheable(nil, true))
+}
+
+func TestGetSetInvalidate(t *testing.T) {
+	rc := newTestCache("ResultCacheInvalidateTest", 1024*1024)
+
+	result, token := rc.Get("select * from t1", "t1")
+	assert.Nil(t, result)
+	rc.Set("select * from t1", []string{"t1"}, token, testResult("a"))
+	result, token = rc.Get("select * from t2 join t1", "Join")
+	assert.Nil(t, result)
+	rc.Set("select * from t2 join t1", []string{"t2", "t1"}, token, testResult("b"))
+	assert.EqualValues(t, 2, rc.Len())
+
+	result, _ = rc.Get("select * from t1", "t1")
+	assert.Equal(t, testResult("a"), result)
+	assert.EqualValues(t, 1, rc.hits.Counts()["t1"])
+	assert.EqualValues(t, 1, rc.misses.Counts()["t1"])
+
+	// A change to t1 invalidates both queries.
+	rc.Invalidate("t1")
+	assert.EqualValues(t, 0, rc.Len())
+	assert.EqualValues(t, 0, rc.Size())
+	assert.EqualValues(t, 2, rc.invalidations.Counts()["t1"])
+	assert.Empty(t, rc.byTable)
+}
+
+func TestSetStale(t *testing.T) {
+	rc := newTestCache("ResultCacheStaleTest", 1024*1024)
+
+	_, token := rc.Get("select * from t1", "t1")
+	// t1 changes while the query runs.
+	rc.Invalidate("t1")
+	rc.Set("select * from t1", []string{"t1"}, token, testResult("a"))
+	result, _ := rc.Get("select * from t1", "t1")
+	assert.Nil(t, result)
+	assert.EqualValues(t, 1, rc.skippedSetStale.Get())
+
+	// A change to another table does not matter.
+	_, token = rc.Get("select * from t1", "t1")
+	rc.Invalidate("t2")
+	rc.Set("select * from t1", []string{"t1"}, token, testResult("a"))
+	result, _ = rc.Get("select * from t1", "t1")
+	assert.NotNil(t, result)
+
+	_, token = rc.Get("select * from t2", "t2")
+	rc.InvalidateAll()
+	rc.Set("select * from t2", []string{"t2"}, token, testResult("b"))
+	assert.EqualValues(t, 0, rc.Len())
+}
+
+func TestEviction(t *testing.T) {
+	size := int64(len("q1")) + testResult("a").CachedSize(true)
+	rc := newTestCache("ResultCacheEvictionTest", 2*size)
+
+	for _, key := range []string{"q1", "q2"} {
+		_, token := rc.Get(key, "t1")
+		rc.Set(key, []string{"t1"}, token, testResult("a"))
+	}
+	// q1 becomes the most recently used.
+	result, _ := rc.Get("q1", "t1")
+	require.NotNil(t, result)
+
+	_, token := rc.Get("q3", "t1")
+	rc.Set("q3", []string{"t1"}, token, testResult("a"))
+	assert.EqualValues(t, 2, rc.Len())
+	assert.EqualValues(t, 1, rc.Evictions())
+	result, _ = rc.Get("q2", "t1")
+	assert.Nil(t, result)
+	assert.Len(t, rc.byTable["t1"], 2)
+
+	// Results that exceed the size of the cache are not cached.
+	_, token = rc.Get("q4", "t1")
+	rc.Set("q4", []string{"t1"}, token, testResult("a", "b", "c", "d"))
+	result, _ = rc.Get("q4", "t1")
+	assert.Nil(t, result)
+}
+
+func TestSuspend(t *testing.T) {
+	rc := newTestCache("ResultCacheSuspendTest", 1024*1024)
+
+	_, token := rc.Get("select * from t1", "t1")
+	rc.Set("select * from t1", []string{"t1"}, token, testResult("a"))
+	rc.Suspend()
+	assert.EqualValues(t, 0, rc.Len())
+
+	result, token := rc.Get("select * from t1", "t1")
+	assert.Nil(t, result)
+	rc.Set("select * from t1", []string{"t1"}, token, testResult("a"))
+	assert.EqualValues(t, 0, rc.Len())
+
+	rc.Resume()
+	_, token = rc.Get("select * from t1", "t1")
+	rc.Set("select * from t1", []string{"t1"}, token, testResult("a"))
+	assert.EqualValues(t, 1, rc.Len())
+}
+
+func TestGetReturnsCopies(t *testing.T) {
+	rc := newTestCache("ResultCacheCopiesTest", 1024*1024)
+
+	newResult := func() *sqltypes.Result {
+		result := testResult("a")
+		result.Fields = []*querypb.Field{{Name: "c", Type: sqltypes.VarChar, Database: "vt_ks"}}
+		return result
+	}
+	_, token := rc.Get("select * from t1", "t1")
+	result := newResult()
+	rc.Set("select * from t1", []string{"t1"}, token, result)
+	// The caller of Set can still modify its result.
+	result.Rows[0][0] = sqltypes.NewVarChar("changed")
+
+	// The hits can rename the database of their fields concurrently, as
+	// the tablet server does.
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			hit, _ := rc.Get("select * from t1", "t1")
+			if assert.NotNil(t, hit) {
+				hit.Fields[0].Database = fmt.Sprintf("ks%d", i)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	hit, _ := rc.Get("select * from t1", "t1")
+	assert.Equal(t, newResult(), hit)
+}
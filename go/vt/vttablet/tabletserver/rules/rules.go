@@ -18,15 +18,19 @@ package rules
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
+	"vitess.io/vitess/go/ratelimiter"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
@@ -166,19 +170,33 @@ func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableN
 	return &Rules{newrules}
 }
 
-// GetAction runs the input against the rules engine and returns the action to be performed.
+// GetAction runs the input against the rules engine and returns the action to be performed,
+// along with the rule that triggered it. Rules that fail the query take precedence over
+// rules that throttle it: the first rule that throttles the query only applies if no
+// rule fails it. Only that first throttling rule applies, the other throttling rules
+// that match the query are ignored: their limits don't add up, and their stats don't
+// count the query.
 func (qrs *Rules) GetAction(
 	ip,
 	user string,
 	bindVars map[string]*querypb.BindVariable,
 	marginComments sqlparser.MarginComments,
-) (action Action, desc string) {
+) (action Action, cause *Rule, desc string) {
 	for _, qr := range qrs.rules {
-		if act := qr.GetAction(ip, user, bindVars, marginComments); act != QRContinue {
-			return act, qr.Description
+		act := qr.GetAction(ip, user, bindVars, marginComments)
+		switch {
+		case act == QRContinue:
+			continue
+		case !act.throttles():
+			return act, qr, qr.Description
+		case cause == nil:
+			action, cause = act, qr
 		}
 	}
-	return QRContinue, ""
+	if cause == nil {
+		return QRContinue, nil, ""
+	}
+	return action, cause, cause.Description
 }
 
 //-----------------------------------------------
@@ -211,6 +229,35 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// Parameters of the actions that throttle queries
+	delay               time.Duration
+	maxConcurrency      int
+	maxQueriesPerSecond int
+
+	// state is shared by all the copies of the rule, which are
+	// the rules of the plans that it applies to.
+	state *ruleState
+}
+
+// ruleState holds the limiters and counters of a rule that throttles queries.
+type ruleState struct {
+	concurrency *sync2.Semaphore
+	rateLimiter *ratelimiter.RateLimiter
+
+	matched, rejected, running sync2.AtomicInt64
+}
+
+// ruleStats are the counters of a rule that throttles queries,
+// as reported in /debug/query_rules.
+type ruleStats struct {
+	// Matched is the number of queries that the rule applied to.
+	Matched int64
+	// Rejected is the number of queries that exceeded the concurrency or rate limit.
+	Rejected int64
+	// Running is the number of queries that are being delayed, or that
+	// run under the concurrency limit.
+	Running int64
 }
 
 type namedRegexp struct {
@@ -233,8 +280,7 @@ func (nr namedRegexp) Equal(other namedRegexp) bool {
 
 // NewQueryRule creates a new Rule.
 func NewQueryRule(description, name string, act Action) (qr *Rule) {
-	// We ignore act because there's only one action right now
-	return &Rule{Description: description, Name: name, act: act}
+	return &Rule{Description: description, Name: name, act: act, state: &ruleState{}}
 }
 
 // Equal returns true if other is equal to this Rule, otherwise false.
@@ -252,20 +298,28 @@ func (qr *Rule) Equal(other *Rule) bool {
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.act == other.act &&
+		qr.delay == other.delay &&
+		qr.maxConcurrency == other.maxConcurrency &&
+		qr.maxQueriesPerSecond == other.maxQueriesPerSecond)
 }
 
-// Copy performs a deep copy of a Rule.
+// Copy performs a deep copy of a Rule. The copy shares the limiters
+// and counters of the original rule.
 func (qr *Rule) Copy() (newqr *Rule) {
 	newqr = &Rule{
-		Description:     qr.Description,
-		Name:            qr.Name,
-		requestIP:       qr.requestIP,
-		user:            qr.user,
-		query:           qr.query,
-		leadingComment:  qr.leadingComment,
-		trailingComment: qr.trailingComment,
-		act:             qr.act,
+		Description:         qr.Description,
+		Name:                qr.Name,
+		requestIP:           qr.requestIP,
+		user:                qr.user,
+		query:               qr.query,
+		leadingComment:      qr.leadingComment,
+		trailingComment:     qr.trailingComment,
+		act:                 qr.act,
+		delay:               qr.delay,
+		maxConcurrency:      qr.maxConcurrency,
+		maxQueriesPerSecond: qr.maxQueriesPerSecond,
+		state:               qr.state,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -314,6 +368,21 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	switch qr.act {
+	case QRDelay:
+		safeEncode(b, `,"Delay":`, qr.delay.String())
+	case QRConcurrencyLimit:
+		safeEncode(b, `,"MaxConcurrency":`, qr.maxConcurrency)
+	case QRRateLimit:
+		safeEncode(b, `,"MaxQueriesPerSecond":`, qr.maxQueriesPerSecond)
+	}
+	if qr.act.throttles() && qr.state != nil {
+		safeEncode(b, `,"Stats":`, ruleStats{
+			Matched:  qr.state.matched.Get(),
+			Rejected: qr.state.rejected.Get(),
+			Running:  qr.state.running.Get(),
+		})
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
@@ -369,6 +438,26 @@ func (qr *Rule) SetTrailingCommentCond(pattern string) (err error) {
 	return
 }
 
+// SetDelay sets the time for which a rule with the QRDelay action
+// delays the queries that it matches.
+func (qr *Rule) SetDelay(delay time.Duration) {
+	qr.delay = delay
+}
+
+// SetMaxConcurrency sets the number of queries that a rule with the
+// QRConcurrencyLimit action lets run at the same time.
+func (qr *Rule) SetMaxConcurrency(maxConcurrency int) {
+	qr.maxConcurrency = maxConcurrency
+	qr.state.concurrency = sync2.NewSemaphore(maxConcurrency, 0)
+}
+
+// SetMaxQueriesPerSecond sets the number of queries per second that a
+// rule with the QRRateLimit action lets run.
+func (qr *Rule) SetMaxQueriesPerSecond(maxQueriesPerSecond int) {
+	qr.maxQueriesPerSecond = maxQueriesPerSecond
+	qr.state.rateLimiter = ratelimiter.NewRateLimiter(maxQueriesPerSecond, time.Second)
+}
+
 // makeExact forces a full string match for the regex instead of substring
 func makeExact(pattern string) string {
 	return fmt.Sprintf("^%s$", pattern)
@@ -482,6 +571,43 @@ func (qr *Rule) GetAction(
 	return qr.act
 }
 
+// Throttle applies the action of a rule that throttles queries to a
+// query that it matched: it delays the query, or checks it against the
+// concurrency or rate limit of the rule. If the query may run, it returns
+// a function that the caller must call once the query is done.
+func (qr *Rule) Throttle(ctx context.Context) (release func(), err error) {
+	state := qr.state
+	state.matched.Add(1)
+	switch qr.act {
+	case QRDelay:
+		state.running.Add(1)
+		defer state.running.Add(-1)
+		timer := time.NewTimer(qr.delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return nil, vterrors.Wrapf(ctx.Err(), "query delayed due to rule: %s", qr.Description)
+		}
+	case QRConcurrencyLimit:
+		if !state.concurrency.TryAcquire() {
+			state.rejected.Add(1)
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "concurrency limit of %d queries exceeded due to rule: %s", qr.maxConcurrency, qr.Description)
+		}
+		state.running.Add(1)
+		return func() {
+			state.running.Add(-1)
+			state.concurrency.Release()
+		}, nil
+	case QRRateLimit:
+		if !state.rateLimiter.Allow() {
+			state.rejected.Add(1)
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "rate limit of %d queries per second exceeded due to rule: %s", qr.maxQueriesPerSecond, qr.Description)
+		}
+	}
+	return func() {}, nil
+}
+
 func reMatch(re *regexp.Regexp, val string) bool {
 	return re == nil || re.MatchString(val)
 }
@@ -533,8 +659,22 @@ const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	// QRDelay delays the query before running it.
+	QRDelay
+	// QRConcurrencyLimit fails the query if too many queries
+	// matching the rule are already running.
+	QRConcurrencyLimit
+	// QRRateLimit fails the query if too many queries matching
+	// the rule ran in the last second.
+	QRRateLimit
 )
 
+// throttles returns true for the actions that throttle queries,
+// rather than fail them.
+func (act Action) throttles() bool {
+	return act == QRDelay || act == QRConcurrencyLimit || act == QRRateLimit
+}
+
 // MarshalJSON marshals to JSON.
 func (act Action) MarshalJSON() ([]byte, error) {
 	// If we add more actions, we'll need to use a map.
@@ -544,6 +684,12 @@ func (act Action) MarshalJSON() ([]byte, error) {
 		str = "FAIL"
 	case QRFailRetry:
 		str = "FAIL_RETRY"
+	case QRDelay:
+		str = "DELAY"
+	case QRConcurrencyLimit:
+		str = "CONCURRENCY_LIMIT"
+	case QRRateLimit:
+		str = "RATE_LIMIT"
 	default:
 		str = "INVALID"
 	}
@@ -832,9 +978,10 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 	for k, v := range ruleInfo {
 		var sv string
 		var lv []interface{}
+		var iv int64
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action", "LeadingComment", "TrailingComment":
+		case "Name", "Description", "RequestIP", "User", "Query", "Action", "LeadingComment", "TrailingComment", "Delay":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
@@ -844,6 +991,16 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want list for %s", k)
 			}
+		case "MaxConcurrency", "MaxQueriesPerSecond":
+			var nv json.Number
+			nv, ok = v.(json.Number)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int for %s", k)
+			}
+			iv, err = nv.Int64()
+			if err != nil || iv <= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want positive int for %s: %s", k, nv)
+			}
 		default:
 			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized tag %s", k)
 		}
@@ -914,11 +1071,41 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				qr.act = QRFail
 			case "FAIL_RETRY":
 				qr.act = QRFailRetry
+			case "DELAY":
+				qr.act = QRDelay
+			case "CONCURRENCY_LIMIT":
+				qr.act = QRConcurrencyLimit
+			case "RATE_LIMIT":
+				qr.act = QRRateLimit
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+		case "Delay":
+			delay, err := time.ParseDuration(sv)
+			if err != nil || delay <= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want positive duration for Delay: %s", sv)
+			}
+			qr.SetDelay(delay)
+		case "MaxConcurrency":
+			qr.SetMaxConcurrency(int(iv))
+		case "MaxQueriesPerSecond":
+			qr.SetMaxQueriesPerSecond(int(iv))
 		}
 	}
+	switch {
+	case qr.act != QRDelay && qr.delay != 0:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Delay is only valid for Action DELAY")
+	case qr.act != QRConcurrencyLimit && qr.maxConcurrency != 0:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxConcurrency is only valid for Action CONCURRENCY_LIMIT")
+	case qr.act != QRRateLimit && qr.maxQueriesPerSecond != 0:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxQueriesPerSecond is only valid for Action RATE_LIMIT")
+	case qr.act == QRDelay && qr.delay == 0:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Delay missing for Action DELAY")
+	case qr.act == QRConcurrencyLimit && qr.maxConcurrency == 0:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxConcurrency missing for Action CONCURRENCY_LIMIT")
+	case qr.act == QRRateLimit && qr.maxQueriesPerSecond == 0:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxQueriesPerSecond missing for Action RATE_LIMIT")
+	}
 	return qr, nil
 }
 
@@ -18,13 +18,16 @@ package rules
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"reflect"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -505,19 +508,19 @@ func TestAction(t *testing.T) {
 		Trailing: "other trailing comments",
 	}
 
-	action, desc := qrs.GetAction("123", "user1", bv, mc)
+	action, _, desc := qrs.GetAction("123", "user1", bv, mc)
 	assert.Equalf(t, action, QRFail, "expected fail, got %v", action)
 	assert.Equalf(t, desc, "rule 1", "want rule 1, got %s", desc)
 
-	action, desc = qrs.GetAction("1234", "user", bv, mc)
+	action, _, desc = qrs.GetAction("1234", "user", bv, mc)
 	assert.Equalf(t, action, QRFailRetry, "want fail_retry, got: %s", action)
 	assert.Equalf(t, desc, "rule 2", "want rule 2, got %s", desc)
 
-	action, _ = qrs.GetAction("1234", "user1", bv, mc)
+	action, _, _ = qrs.GetAction("1234", "user1", bv, mc)
 	assert.Equalf(t, action, QRContinue, "want continue, got %s", action)
 
 	bv["a"] = sqltypes.Uint64BindVariable(1)
-	action, desc = qrs.GetAction("1234", "user1", bv, mc)
+	action, _, desc = qrs.GetAction("1234", "user1", bv, mc)
 	assert.Equalf(t, action, QRFail, "want fail, got %s", action)
 	assert.Equalf(t, desc, "rule 3", "want rule 3, got %s", desc)
 
@@ -530,7 +533,7 @@ func TestAction(t *testing.T) {
 	newQrs := qrs.Copy()
 	newQrs.Add(qr4)
 
-	action, desc = newQrs.GetAction("1234", "user1", bv, mc)
+	action, _, desc = newQrs.GetAction("1234", "user1", bv, mc)
 	assert.Equalf(t, action, QRFail, "want fail, got %s", action)
 	assert.Equalf(t, desc, "rule 4", "want rule 4, got %s", desc)
 
@@ -539,11 +542,155 @@ func TestAction(t *testing.T) {
 
 	newQrs = qrs.Copy()
 	newQrs.Add(qr5)
-	action, desc = newQrs.GetAction("1234", "user1", bv, mc)
+	action, _, desc = newQrs.GetAction("1234", "user1", bv, mc)
 	assert.Equalf(t, action, QRFail, "want fail, got %s", action)
 	assert.Equalf(t, desc, "rule 5", "want rule 5, got %s", desc)
 }
 
+func TestActionThrottlePrecedence(t *testing.T) {
+	qrs := New()
+
+	qr1 := NewQueryRule("rule 1", "r1", QRDelay)
+	qr1.SetDelay(time.Millisecond)
+	qrs.Add(qr1)
+
+	qr2 := NewQueryRule("rule 2", "r2", QRRateLimit)
+	qr2.SetMaxQueriesPerSecond(1)
+	qrs.Add(qr2)
+
+	// Only the first throttling rule applies.
+	action, cause, desc := qrs.GetAction("123", "user1", nil, sqlparser.MarginComments{})
+	assert.Equal(t, QRDelay, action)
+	assert.Equal(t, qr1, cause)
+	assert.Equal(t, "rule 1", desc)
+
+	reversed := New()
+	reversed.Add(qr2)
+	reversed.Add(qr1)
+	action, cause, _ = reversed.GetAction("123", "user1", nil, sqlparser.MarginComments{})
+	assert.Equal(t, QRRateLimit, action)
+	assert.Equal(t, qr2, cause)
+
+	qr3 := NewQueryRule("rule 3", "r3", QRFail)
+	qrs.Add(qr3)
+
+	action, cause, desc = qrs.GetAction("123", "user1", nil, sqlparser.MarginComments{})
+	assert.Equal(t, QRFail, action)
+	assert.Equal(t, qr3, cause)
+	assert.Equal(t, "rule 3", desc)
+}
+
+func TestThrottleDelay(t *testing.T) {
+	qr := NewQueryRule("rule 1", "r1", QRDelay)
+	qr.SetDelay(10 * time.Millisecond)
+
+	start := time.Now()
+	release, err := qr.Throttle(context.Background())
+	require.NoError(t, err)
+	release()
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(10*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	qr.SetDelay(time.Hour)
+	_, err = qr.Throttle(ctx)
+	assert.EqualError(t, err, "query delayed due to rule: rule 1: context canceled")
+
+	assert.EqualValues(t, 2, qr.state.matched.Get())
+	assert.EqualValues(t, 0, qr.state.running.Get())
+}
+
+func TestThrottleConcurrencyLimit(t *testing.T) {
+	qr := NewQueryRule("rule 1", "r1", QRConcurrencyLimit)
+	qr.SetMaxConcurrency(1)
+	// Copies of the rule share its limit.
+	qrCopy := qr.Copy()
+
+	release, err := qr.Throttle(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, qr.state.running.Get())
+
+	_, err = qrCopy.Throttle(context.Background())
+	assert.EqualError(t, err, "concurrency limit of 1 queries exceeded due to rule: rule 1")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+
+	release()
+	release, err = qrCopy.Throttle(context.Background())
+	require.NoError(t, err)
+	release()
+
+	got := marshalled(qr)
+	want := `{"Description":"rule 1","Name":"r1","Action":"CONCURRENCY_LIMIT","MaxConcurrency":1,"Stats":{"Matched":3,"Rejected":1,"Running":0}}`
+	assert.Equal(t, want, got)
+}
+
+func TestThrottleRateLimit(t *testing.T) {
+	qr := NewQueryRule("rule 1", "r1", QRRateLimit)
+	qr.SetMaxQueriesPerSecond(2)
+
+	for i := 0; i < 2; i++ {
+		release, err := qr.Throttle(context.Background())
+		require.NoError(t, err)
+		release()
+	}
+	_, err := qr.Throttle(context.Background())
+	assert.EqualError(t, err, "rate limit of 2 queries per second exceeded due to rule: rule 1")
+	assert.EqualValues(t, 1, qr.state.rejected.Get())
+}
+
+func TestImportThrottlingRules(t *testing.T) {
+	var qrs = New()
+	jsondata := `[{
+		"Description": "desc1",
+		"Name": "name1",
+		"Query": "select.*from t1",
+		"Action": "DELAY",
+		"Delay": "1.5s"
+	},{
+		"Description": "desc2",
+		"Name": "name2",
+		"TableNames": ["t2"],
+		"Action": "CONCURRENCY_LIMIT",
+		"MaxConcurrency": 4
+	},{
+		"Description": "desc3",
+		"Name": "name3",
+		"User": "reporting",
+		"Action": "RATE_LIMIT",
+		"MaxQueriesPerSecond": 100
+	}]`
+	err := qrs.UnmarshalJSON([]byte(jsondata))
+	require.NoError(t, err)
+	assert.Equal(t, 1500*time.Millisecond, qrs.rules[0].delay)
+	assert.Equal(t, 4, qrs.rules[1].maxConcurrency)
+	assert.Equal(t, 100, qrs.rules[2].maxQueriesPerSecond)
+
+	got := marshalled(qrs)
+	want := compacted(`[{
+		"Description": "desc1",
+		"Name": "name1",
+		"Query": "select.*from t1",
+		"Action": "DELAY",
+		"Delay": "1.5s",
+		"Stats": {"Matched": 0, "Rejected": 0, "Running": 0}
+	},{
+		"Description": "desc2",
+		"Name": "name2",
+		"TableNames": ["t2"],
+		"Action": "CONCURRENCY_LIMIT",
+		"MaxConcurrency": 4,
+		"Stats": {"Matched": 0, "Rejected": 0, "Running": 0}
+	},{
+		"Description": "desc3",
+		"Name": "name3",
+		"User": "reporting",
+		"Action": "RATE_LIMIT",
+		"MaxQueriesPerSecond": 100,
+		"Stats": {"Matched": 0, "Rejected": 0, "Running": 0}
+	}]`)
+	assert.Equal(t, want, got)
+}
+
 func TestImport(t *testing.T) {
 	var qrs = New()
 	jsondata := `[{
@@ -692,6 +839,19 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "NOMATCH", "Value": "["}]}]`, "processing [: error parsing regexp: missing closing ]: `[$`"},
 	{`[{"Action": 1 }]`, "want string for Action"},
 	{`[{"Action": "foo" }]`, "invalid Action foo"},
+	{`[{"Action": "DELAY" }]`, "Delay missing for Action DELAY"},
+	{`[{"Action": "DELAY", "Delay": 1 }]`, "want string for Delay"},
+	{`[{"Action": "DELAY", "Delay": "-1s" }]`, "want positive duration for Delay: -1s"},
+	{`[{"Action": "CONCURRENCY_LIMIT" }]`, "MaxConcurrency missing for Action CONCURRENCY_LIMIT"},
+	{`[{"Action": "CONCURRENCY_LIMIT", "MaxConcurrency": "1" }]`, "want int for MaxConcurrency"},
+	{`[{"Action": "CONCURRENCY_LIMIT", "MaxConcurrency": 0 }]`, "want positive int for MaxConcurrency: 0"},
+	{`[{"Action": "RATE_LIMIT" }]`, "MaxQueriesPerSecond missing for Action RATE_LIMIT"},
+	{`[{"Action": "RATE_LIMIT", "MaxQueriesPerSecond": 1.5 }]`, "want positive int for MaxQueriesPerSecond: 1.5"},
+	{`[{"Action": "FAIL", "Delay": "1s" }]`, "Delay is only valid for Action DELAY"},
+	{`[{"Action": "RATE_LIMIT", "MaxQueriesPerSecond": 1, "Delay": "1s" }]`, "Delay is only valid for Action DELAY"},
+	{`[{"Action": "DELAY", "Delay": "1s", "MaxConcurrency": 2 }]`, "MaxConcurrency is only valid for Action CONCURRENCY_LIMIT"},
+	{`[{"MaxConcurrency": 2 }]`, "MaxConcurrency is only valid for Action CONCURRENCY_LIMIT"},
+	{`[{"Action": "CONCURRENCY_LIMIT", "MaxConcurrency": 2, "MaxQueriesPerSecond": 10 }]`, "MaxQueriesPerSecond is only valid for Action RATE_LIMIT"},
 }
 
 func TestInvalidJSON(t *testing.T) {
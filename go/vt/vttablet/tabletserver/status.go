@@ -25,6 +25,8 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/txthrottler"
+
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -251,6 +253,10 @@ func (tsv *TabletServer) AddStatusPart() {
 		return status
 	})
 
+	tsv.exporter.AddStatusPart("Transaction Throttler", txthrottler.StatusTemplate, func() interface{} {
+		return tsv.txThrottler.Status()
+	})
+
 	tsv.exporter.HandleFunc("/debug/status_details", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		details := tsv.sm.AppendDetails(nil)
@@ -17,9 +17,11 @@ limitations under the License.
 package tabletenv
 
 import (
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"time"
 
 	"google.golang.org/protobuf/encoding/prototext"
@@ -82,6 +84,9 @@ var (
 	unhealthyThreshold           time.Duration
 	transitionGracePeriod        time.Duration
 	enableReplicationReporter    bool
+	workloadClassesFile          string
+	txThrottlerClassesFile       string
+	resultCacheTables            []string
 )
 
 func init() {
@@ -134,6 +139,10 @@ func init() {
 	flagutil.DualFormatBoolVar(&currentConfig.EnableTxThrottler, "enable_tx_throttler", defaultConfig.EnableTxThrottler, "If true replication-lag-based throttling on transactions will be enabled.")
 	flagutil.DualFormatStringVar(&currentConfig.TxThrottlerConfig, "tx_throttler_config", defaultConfig.TxThrottlerConfig, "The configuration of the transaction throttler as a text formatted throttlerdata.Configuration protocol buffer message")
 	flagutil.DualFormatStringListVar(&currentConfig.TxThrottlerHealthCheckCells, "tx_throttler_healthcheck_cells", defaultConfig.TxThrottlerHealthCheckCells, "A comma-separated list of cells. Only tabletservers running in these cells will be monitored for replication lag by the transaction throttler.")
+	flag.StringVar(&currentConfig.TxThrottlerSource, "tx_throttler_source", defaultConfig.TxThrottlerSource, "Where the transaction throttler reads the health of the shard from: 'healthcheck' throttles on the replication lag of the replicas in -tx_throttler_healthcheck_cells, as configured by -tx_throttler_config; 'tablet_throttler' throttles when the metrics of the tablet throttler, such as the replica lag and the semi-sync ack latency, exceed their thresholds for the 'tx-throttler' app. The latter requires -enable_lag_throttler.")
+	flag.BoolVar(&currentConfig.TxThrottlerDryRun, "tx_throttler_dry_run", defaultConfig.TxThrottlerDryRun, "If true, the transaction throttler does not throttle transactions, but counts and logs those that it would have throttled.")
+	flag.IntVar(&currentConfig.TxThrottlerDefaultPriority, "tx_throttler_default_priority", defaultConfig.TxThrottlerDefaultPriority, "Priority, between 0 and 100, of the transactions that belong to no class of -tx_throttler_priority_classes_file. While the shard is unhealthy, a transaction of priority p is throttled with a probability of p/100.")
+	flag.StringVar(&txThrottlerClassesFile, "tx_throttler_priority_classes_file", "", "JSON file with the list of priority classes of the transaction throttler. A transaction belongs to the first class that lists its caller.")
 
 	flag.BoolVar(&enableHotRowProtection, "enable_hot_row_protection", false, "If true, incoming transactions for the same row (range) will be queued and cannot consume all txpool slots.")
 	flag.BoolVar(&enableHotRowProtectionDryRun, "enable_hot_row_protection_dry_run", false, "If true, hot row protection is not enforced but logs if transactions would have been queued.")
@@ -165,6 +174,10 @@ func init() {
 
 	flag.BoolVar(&enableReplicationReporter, "enable_replication_reporter", false, "Use polling to track replication lag.")
 	flag.BoolVar(&currentConfig.EnableOnlineDDL, "queryserver_enable_online_ddl", true, "Enable online DDL.")
+	flag.BoolVar(&currentConfig.ResultCache.Enable, "enable_result_cache", defaultConfig.ResultCache.Enable, "If true, replicas cache the results of the queries on the tables of -result_cache_tables, and of the queries with the CACHE_RESULT comment directive. The cached results are invalidated by the replication stream, which is watched as with -watch_replication_stream.")
+	flagutil.StringListVar(&resultCacheTables, "result_cache_tables", nil, "Comma-separated list of tables whose query results are cached when -enable_result_cache is set.")
+	flag.Int64Var(&currentConfig.ResultCache.MaxSizeBytes, "result_cache_size", defaultConfig.ResultCache.MaxSizeBytes, "Maximum size in bytes of the results held in the result cache.")
+	flag.StringVar(&workloadClassesFile, "workload_classes_file", "", "JSON file with the list of workload classes. Queries that belong to a workload class are queued in front of the query pools, up to the concurrency limit of their class.")
 }
 
 // Init must be called after flag.Parse, and before doing any other operations.
@@ -222,6 +235,28 @@ func Init() {
 		log.Exitf("Invalid querylog-format value %v: must be either text or json", *streamlog.QueryLogFormat)
 	}
 
+	currentConfig.ResultCache.Tables = resultCacheTables
+
+	if workloadClassesFile != "" {
+		data, err := ioutil.ReadFile(workloadClassesFile)
+		if err != nil {
+			log.Exitf("Cannot read workload classes file %v: %v", workloadClassesFile, err)
+		}
+		if err := json.Unmarshal(data, &currentConfig.WorkloadClasses); err != nil {
+			log.Exitf("Cannot parse workload classes file %v: %v", workloadClassesFile, err)
+		}
+	}
+
+	if txThrottlerClassesFile != "" {
+		data, err := ioutil.ReadFile(txThrottlerClassesFile)
+		if err != nil {
+			log.Exitf("Cannot read transaction throttler priority classes file %v: %v", txThrottlerClassesFile, err)
+		}
+		if err := json.Unmarshal(data, &currentConfig.TxThrottlerPriorityClasses); err != nil {
+			log.Exitf("Cannot parse transaction throttler priority classes file %v: %v", txThrottlerClassesFile, err)
+		}
+	}
+
 	if *queryLogHandler != "" {
 		StatsLogger.ServeLogs(*queryLogHandler, streamlog.GetFormatter(StatsLogger))
 	}
@@ -269,6 +304,9 @@ type TabletConfig struct {
 
 	ExternalConnections map[string]*dbconfigs.DBConfigs `json:"externalConnections,omitempty"`
 
+	WorkloadClasses []*WorkloadClassConfig `json:"workloadClasses,omitempty"`
+	ResultCache     ResultCacheConfig      `json:"resultCache,omitempty"`
+
 	StrictTableACL          bool    `json:"-"`
 	EnableTableACLDryRun    bool    `json:"-"`
 	TableACLExemptACL       string  `json:"-"`
@@ -276,9 +314,13 @@ type TabletConfig struct {
 	TwoPCCoordinatorAddress string  `json:"-"`
 	TwoPCAbandonAge         Seconds `json:"-"`
 
-	EnableTxThrottler           bool     `json:"-"`
-	TxThrottlerConfig           string   `json:"-"`
-	TxThrottlerHealthCheckCells []string `json:"-"`
+	EnableTxThrottler           bool                              `json:"-"`
+	TxThrottlerConfig           string                            `json:"-"`
+	TxThrottlerHealthCheckCells []string                          `json:"-"`
+	TxThrottlerSource           string                            `json:"-"`
+	TxThrottlerDryRun           bool                              `json:"-"`
+	TxThrottlerDefaultPriority  int                               `json:"-"`
+	TxThrottlerPriorityClasses  []*TxThrottlerPriorityClassConfig `json:"-"`
 
 	EnableLagThrottler bool `json:"-"`
 
@@ -314,6 +356,86 @@ type HotRowProtectionConfig struct {
 	MaxConcurrency     int    `json:"maxConcurrency,omitempty"`
 }
 
+// WorkloadClassConfig contains the config for a workload class.
+// A query belongs to the first class that names it in its WORKLOAD_CLASS
+// comment directive, or else that lists its caller in Users, or else that
+// lists its table in Tables.
+type WorkloadClassConfig struct {
+	Name string `json:"name,omitempty"`
+	// Users are matched against the principal of the effective caller id,
+	// and against the username of the immediate caller id.
+	Users  []string `json:"users,omitempty"`
+	Tables []string `json:"tables,omitempty"`
+	// MaxConcurrency is the number of queries of the class that can run
+	// at the same time.
+	MaxConcurrency int `json:"maxConcurrency,omitempty"`
+	// MaxQueueSize is the number of queries of the class that can wait
+	// for one of the others to finish.
+	MaxQueueSize int `json:"maxQueueSize,omitempty"`
+	// QueueTimeoutSeconds is how long a query waits in the queue.
+	// If zero, the query waits until its context is done.
+	QueueTimeoutSeconds Seconds `json:"queueTimeoutSeconds,omitempty"`
+}
+
+// ResultCacheConfig contains the config for the result cache of replicas.
+type ResultCacheConfig struct {
+	Enable bool `json:"enable,omitempty"`
+	// Tables are the tables whose query results are always cached.
+	Tables       []string `json:"tables,omitempty"`
+	MaxSizeBytes int64    `json:"maxSizeBytes,omitempty"`
+}
+
+// Sources of the health of the shard for the transaction throttler.
+const (
+	// TxThrottlerSourceHealthCheck is the replication lag of the replicas,
+	// as reported by their health checks.
+	TxThrottlerSourceHealthCheck = "healthcheck"
+	// TxThrottlerSourceTabletThrottler is the metrics of the tablet throttler.
+	TxThrottlerSourceTabletThrottler = "tablet_throttler"
+
+	// TxThrottlerDefaultPriorityClass is the name of the priority class of the
+	// transactions that belong to no configured class.
+	TxThrottlerDefaultPriorityClass = "default"
+)
+
+// TxThrottlerPriorityClassConfig contains the config for a priority class
+// of the transaction throttler. A transaction belongs to the first class
+// that lists its caller in Users.
+type TxThrottlerPriorityClassConfig struct {
+	Name string `json:"name,omitempty"`
+	// Users are matched against the principal of the effective caller id,
+	// and against the username of the immediate caller id.
+	Users []string `json:"users,omitempty"`
+	// Priority is between 0 and 100. While the shard is unhealthy, a
+	// transaction of the class is throttled with a probability of
+	// Priority/100: transactions of priority 0 are never throttled.
+	Priority int `json:"priority"`
+}
+
+// VerifyWorkloadClasses checks a list of workload classes for sanity.
+func VerifyWorkloadClasses(classes []*WorkloadClassConfig) error {
+	names := make(map[string]bool, len(classes))
+	for _, class := range classes {
+		if class.Name == "" {
+			return errors.New("workload class without a name")
+		}
+		if names[class.Name] {
+			return fmt.Errorf("duplicate workload class %v", class.Name)
+		}
+		names[class.Name] = true
+		if class.MaxConcurrency <= 0 {
+			return fmt.Errorf("maxConcurrency of workload class %v must be > 0 (specified value: %v)", class.Name, class.MaxConcurrency)
+		}
+		if class.MaxQueueSize < 0 {
+			return fmt.Errorf("maxQueueSize of workload class %v must be >= 0 (specified value: %v)", class.Name, class.MaxQueueSize)
+		}
+		if class.QueueTimeoutSeconds < 0 {
+			return fmt.Errorf("queueTimeoutSeconds of workload class %v must be >= 0 (specified value: %v)", class.Name, class.QueueTimeoutSeconds)
+		}
+	}
+	return nil
+}
+
 // HealthcheckConfig contains the config for healthcheck.
 type HealthcheckConfig struct {
 	IntervalSeconds           Seconds `json:"intervalSeconds,omitempty"`
@@ -383,6 +505,48 @@ func (c *TabletConfig) Verify() error {
 	if v := c.HotRowProtection.MaxConcurrency; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	if err := VerifyWorkloadClasses(c.WorkloadClasses); err != nil {
+		return err
+	}
+	if v := c.ResultCache.MaxSizeBytes; c.ResultCache.Enable && v <= 0 {
+		return fmt.Errorf("-result_cache_size must be > 0 (specified value: %v)", v)
+	}
+	if err := c.verifyTxThrottlerConfig(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// verifyTxThrottlerConfig checks the config of the transaction throttler for sanity.
+func (c *TabletConfig) verifyTxThrottlerConfig() error {
+	switch c.TxThrottlerSource {
+	case TxThrottlerSourceHealthCheck:
+	case TxThrottlerSourceTabletThrottler:
+		if c.EnableTxThrottler && !c.EnableLagThrottler {
+			return errors.New("-tx_throttler_source=tablet_throttler requires -enable_lag_throttler")
+		}
+	default:
+		return fmt.Errorf("-tx_throttler_source must be %v or %v (specified value: %v)", TxThrottlerSourceHealthCheck, TxThrottlerSourceTabletThrottler, c.TxThrottlerSource)
+	}
+	if v := c.TxThrottlerDefaultPriority; v < 0 || v > 100 {
+		return fmt.Errorf("-tx_throttler_default_priority must be between 0 and 100 (specified value: %v)", v)
+	}
+	names := make(map[string]bool, len(c.TxThrottlerPriorityClasses))
+	for _, class := range c.TxThrottlerPriorityClasses {
+		if class.Name == "" {
+			return errors.New("transaction throttler priority class without a name")
+		}
+		if class.Name == TxThrottlerDefaultPriorityClass {
+			return fmt.Errorf("transaction throttler priority class name %v is reserved", class.Name)
+		}
+		if names[class.Name] {
+			return fmt.Errorf("duplicate transaction throttler priority class %v", class.Name)
+		}
+		names[class.Name] = true
+		if class.Priority < 0 || class.Priority > 100 {
+			return fmt.Errorf("priority of transaction throttler priority class %v must be between 0 and 100 (specified value: %v)", class.Name, class.Priority)
+		}
+	}
 	return nil
 }
 
@@ -457,6 +621,9 @@ var defaultConfig = TabletConfig{
 		// of them ready in MySQL and profit from a pipelining effect.
 		MaxConcurrency: 5,
 	},
+	ResultCache: ResultCacheConfig{
+		MaxSizeBytes: 64 * 1024 * 1024,
+	},
 	Consolidator:                Enable,
 	ConsolidatorStreamTotalSize: 128 * 1024 * 1024,
 	ConsolidatorStreamQuerySize: 2 * 1024 * 1024,
@@ -479,6 +646,8 @@ var defaultConfig = TabletConfig{
 	EnableTxThrottler:           false,
 	TxThrottlerConfig:           defaultTxThrottlerConfig(),
 	TxThrottlerHealthCheckCells: []string{},
+	TxThrottlerSource:           TxThrottlerSourceHealthCheck,
+	TxThrottlerDefaultPriority:  100,
 
 	EnableLagThrottler: false, // Feature flag; to switch to 'true' at some stage in the future
 
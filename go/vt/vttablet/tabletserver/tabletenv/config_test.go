@@ -79,6 +79,7 @@ oltpReadPool:
   size: 16
   timeoutSeconds: 10
 replicationTracker: {}
+resultCache: {}
 txPool: {}
 `
 	assert.Equal(t, wantBytes, string(gotBytes))
@@ -141,6 +142,8 @@ queryCacheSize: 5000
 replicationTracker:
   heartbeatIntervalSeconds: 0.25
   mode: disable
+resultCache:
+  maxSizeBytes: 67108864
 schemaReloadIntervalSeconds: 1800
 signalSchemaChangeReloadIntervalSeconds: 5
 streamBufferSize: 32768
@@ -197,6 +200,9 @@ func TestFlags(t *testing.T) {
 			MaxGlobalQueueSize: 1000,
 			MaxConcurrency:     5,
 		},
+		ResultCache: ResultCacheConfig{
+			MaxSizeBytes: 64 * 1024 * 1024,
+		},
 		StreamBufferSize:                        32768,
 		QueryCacheSize:                          int(cache.DefaultConfig.MaxEntries),
 		QueryCacheMemory:                        cache.DefaultConfig.MaxMemoryUsage,
@@ -208,6 +214,8 @@ func TestFlags(t *testing.T) {
 		CacheResultFields:                       true,
 		TxThrottlerConfig:                       "target_replication_lag_sec: 2\nmax_replication_lag_sec: 10\ninitial_rate: 100\nmax_increase: 1\nemergency_decrease: 0.5\nmin_duration_between_increases_sec: 40\nmax_duration_between_increases_sec: 62\nmin_duration_between_decreases_sec: 20\nspread_backlog_across_sec: 20\nage_bad_rate_after_sec: 180\nbad_rate_increase: 0.1\nmax_rate_approach_threshold: 0.9\n",
 		TxThrottlerHealthCheckCells:             []string{},
+		TxThrottlerSource:                       "healthcheck",
+		TxThrottlerDefaultPriority:              100,
 		TransactionLimitConfig: TransactionLimitConfig{
 			TransactionLimitPerUser:     0.4,
 			TransactionLimitByUsername:  true,
@@ -333,3 +341,23 @@ func TestFlags(t *testing.T) {
 	want.GracePeriods.TransitionSeconds = 4
 	assert.Equal(t, want, currentConfig)
 }
+
+func TestVerifyTxThrottlerConfig(t *testing.T) {
+	config := NewDefaultConfig()
+	config.EnableTxThrottler = true
+	config.TxThrottlerSource = "lag"
+	assert.EqualError(t, config.Verify(), "-tx_throttler_source must be healthcheck or tablet_throttler (specified value: lag)")
+
+	config.TxThrottlerSource = TxThrottlerSourceTabletThrottler
+	assert.EqualError(t, config.Verify(), "-tx_throttler_source=tablet_throttler requires -enable_lag_throttler")
+
+	config.EnableLagThrottler = true
+	config.TxThrottlerPriorityClasses = []*TxThrottlerPriorityClassConfig{{Name: "default", Priority: 10}}
+	assert.EqualError(t, config.Verify(), "transaction throttler priority class name default is reserved")
+
+	config.TxThrottlerPriorityClasses = []*TxThrottlerPriorityClassConfig{{Name: "batch", Priority: 101}}
+	assert.EqualError(t, config.Verify(), "priority of transaction throttler priority class batch must be between 0 and 100 (specified value: 101)")
+
+	config.TxThrottlerPriorityClasses = []*TxThrottlerPriorityClassConfig{{Name: "batch", Priority: 100}}
+	assert.NoError(t, config.Verify())
+}
@@ -200,6 +200,11 @@ func TestLogStatsFormatQuerySources(t *testing.T) {
 	if !strings.Contains(logStats.FmtQuerySources(), "consolidator") {
 		t.Fatalf("'consolidator' should be in formatted query sources")
 	}
+
+	logStats.QuerySources |= QuerySourceResultCache
+	if got, want := logStats.FmtQuerySources(), "mysql,consolidator,resultcache"; got != want {
+		t.Fatalf("FmtQuerySources: %s, want %s", got, want)
+	}
 }
 
 func TestLogStatsContextHTML(t *testing.T) {
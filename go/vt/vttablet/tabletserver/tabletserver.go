@@ -176,9 +176,9 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.rt = repltracker.NewReplTracker(tsv, alias)
 	tsv.vstreamer = vstreamer.NewEngine(tsv, srvTopoServer, tsv.se, tsv.lagThrottler, alias.Cell)
 	tsv.tracker = schema.NewTracker(tsv, tsv.vstreamer, tsv.se)
-	tsv.watcher = NewBinlogWatcher(tsv, tsv.vstreamer, tsv.config)
 	tsv.qe = NewQueryEngine(tsv, tsv.se)
-	tsv.txThrottler = txthrottler.NewTxThrottler(tsv.config, topoServer)
+	tsv.watcher = NewBinlogWatcher(tsv, tsv.vstreamer, tsv.config, tsv.qe.resultCache)
+	tsv.txThrottler = txthrottler.NewTxThrottler(tsv, topoServer, tsv.lagThrottler)
 	tsv.te = NewTxEngine(tsv)
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer)
 
@@ -466,7 +466,7 @@ func (tsv *TabletServer) begin(ctx context.Context, target *querypb.Target, preQ
 		target, options, false, /* allowOnShutdown */
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
 			startTime := time.Now()
-			if tsv.txThrottler.Throttle() {
+			if tsv.txThrottler.Throttle(ctx) {
 				return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "Transaction throttled")
 			}
 			var beginSQL string
@@ -1751,6 +1751,7 @@ func (tsv *TabletServer) registerThrottlerCheckHandlers() {
 			}
 			flags := &throttle.CheckFlags{
 				LowPriority: (r.URL.Query().Get("p") == "low"),
+				AllMetrics:  (r.URL.Query().Get("all_metrics") == "true"),
 			}
 			checkResult := tsv.lagThrottler.CheckByType(ctx, appName, remoteAddr, flags, checkType)
 			if checkResult.StatusCode == http.StatusNotFound && flags.OKIfNotExists {
@@ -1895,6 +1896,16 @@ func (tsv *TabletServer) SetQueryPlanCacheCap(val int) {
 	tsv.qe.SetQueryPlanCacheCap(val)
 }
 
+// SetWorkloadClasses replaces the workload classes of the query engine.
+func (tsv *TabletServer) SetWorkloadClasses(classes []*tabletenv.WorkloadClassConfig) error {
+	return tsv.qe.workload.SetClasses(classes)
+}
+
+// WorkloadClasses returns the workload classes of the query engine.
+func (tsv *TabletServer) WorkloadClasses() []*tabletenv.WorkloadClassConfig {
+	return tsv.qe.workload.Classes()
+}
+
 // QueryPlanCacheCap returns the plan cache capacity
 func (tsv *TabletServer) QueryPlanCacheCap() int {
 	return tsv.qe.QueryPlanCacheCap()
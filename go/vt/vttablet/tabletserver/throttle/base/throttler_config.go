@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// Names of the metrics that the throttler collects on each tablet.
+const (
+	// LagMetricName is the replication lag, in seconds, measured with the heartbeat table
+	LagMetricName = "lag"
+	// ThreadsRunningMetricName is the number of threads running on the MySQL server
+	ThreadsRunningMetricName = "threads_running"
+	// HistoryListLengthMetricName is the length of the InnoDB history list
+	HistoryListLengthMetricName = "history_list_length"
+	// LoadAvgMetricName is the 1 minute load average of the host, per CPU
+	LoadAvgMetricName = "loadavg"
+	// DataDirUsedRatioMetricName is the used ratio, between 0 and 1, of the disk of the MySQL data directory
+	DataDirUsedRatioMetricName = "datadir_used_ratio"
+	// SemiSyncAckLatencyMetricName is the average time, in seconds, that the MySQL server waited for
+	// a semi-sync replica to acknowledge a transaction. It is 0 when semi-sync is not in use.
+	SemiSyncAckLatencyMetricName = "semi_sync_ack_latency"
+	// CustomMetricName is the result of the -throttle_metrics_query query, if set
+	CustomMetricName = "custom"
+)
+
+// KnownMetricNames are the names of all the metrics that the throttler may collect
+var KnownMetricNames = []string{
+	LagMetricName,
+	ThreadsRunningMetricName,
+	HistoryListLengthMetricName,
+	LoadAvgMetricName,
+	DataDirUsedRatioMetricName,
+	SemiSyncAckLatencyMetricName,
+	CustomMetricName,
+}
+
+// IsKnownMetricName returns true when the throttler may collect a metric by this name
+func IsKnownMetricName(metricName string) bool {
+	for _, name := range KnownMetricNames {
+		if name == metricName {
+			return true
+		}
+	}
+	return false
+}
+
+// MetricThreshold is a metric that an app is checked against, with its threshold
+type MetricThreshold struct {
+	MetricName string
+	Threshold  float64
+}
+
+// ValidateThrottlerConfig checks that a throttler config only refers to known metrics, with
+// positive thresholds, and that all the metrics of the apps have a threshold.
+func ValidateThrottlerConfig(config *topodatapb.ThrottlerConfig) error {
+	validateThresholds := func(thresholds map[string]float64) error {
+		for metricName, threshold := range thresholds {
+			if !IsKnownMetricName(metricName) {
+				return fmt.Errorf("unknown metric %s, expected one of: %s", metricName, strings.Join(KnownMetricNames, ", "))
+			}
+			if threshold <= 0 {
+				return fmt.Errorf("threshold of metric %s must be positive, got %v", metricName, threshold)
+			}
+		}
+		return nil
+	}
+	if err := validateThresholds(config.MetricThresholds); err != nil {
+		return err
+	}
+	for appName, appConfig := range config.AppConfigs {
+		if appName == "" {
+			return fmt.Errorf("app name must not be empty")
+		}
+		if err := validateThresholds(appConfig.MetricThresholds); err != nil {
+			return fmt.Errorf("app %s: %v", appName, err)
+		}
+		for _, metricName := range appConfig.Metrics {
+			if !IsKnownMetricName(metricName) {
+				return fmt.Errorf("app %s: unknown metric %s, expected one of: %s", appName, metricName, strings.Join(KnownMetricNames, ", "))
+			}
+			_, hasAppThreshold := appConfig.MetricThresholds[metricName]
+			_, hasThreshold := config.MetricThresholds[metricName]
+			if !hasAppThreshold && !hasThreshold {
+				return fmt.Errorf("app %s: metric %s has no threshold", appName, metricName)
+			}
+		}
+	}
+	return nil
+}
+
+// findAppConfig returns the configuration of an app. Apps may identify as several
+// colon-separated names, e.g. "online-ddl:gh-ost:<uuid>", in which case the
+// configuration of the full name, or else of the first name that has one, applies.
+func findAppConfig(config *topodatapb.ThrottlerConfig, appName string) *topodatapb.ThrottlerConfig_AppConfig {
+	if appConfig, ok := config.AppConfigs[appName]; ok {
+		return appConfig
+	}
+	for _, singleAppName := range strings.Split(appName, ":") {
+		if appConfig, ok := config.AppConfigs[singleAppName]; ok {
+			return appConfig
+		}
+	}
+	return nil
+}
+
+// AppMetricThresholds returns the metrics that an app is checked against, with their
+// thresholds, in the order in which they are checked. defaultThresholds apply when the
+// config, which may be nil, has no thresholds of its own.
+func AppMetricThresholds(config *topodatapb.ThrottlerConfig, appName string, defaultThresholds map[string]float64) []MetricThreshold {
+	thresholds := defaultThresholds
+	if len(config.GetMetricThresholds()) != 0 {
+		thresholds = config.MetricThresholds
+	}
+	var appConfig *topodatapb.ThrottlerConfig_AppConfig
+	if config != nil {
+		appConfig = findAppConfig(config, appName)
+	}
+	threshold := func(metricName string) (float64, bool) {
+		if threshold, ok := appConfig.GetMetricThresholds()[metricName]; ok {
+			return threshold, true
+		}
+		threshold, ok := thresholds[metricName]
+		return threshold, ok
+	}
+
+	metricNames := appConfig.GetMetrics()
+	if len(metricNames) == 0 {
+		for metricName := range thresholds {
+			metricNames = append(metricNames, metricName)
+		}
+		for metricName := range appConfig.GetMetricThresholds() {
+			if _, ok := thresholds[metricName]; !ok {
+				metricNames = append(metricNames, metricName)
+			}
+		}
+		sort.Strings(metricNames)
+	}
+
+	metricThresholds := make([]MetricThreshold, 0, len(metricNames))
+	for _, metricName := range metricNames {
+		if threshold, ok := threshold(metricName); ok {
+			metricThresholds = append(metricThresholds, MetricThreshold{MetricName: metricName, Threshold: threshold})
+		}
+	}
+	return metricThresholds
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestAppMetricThresholds(t *testing.T) {
+	defaultThresholds := map[string]float64{LagMetricName: 1}
+	config := &topodatapb.ThrottlerConfig{
+		MetricThresholds: map[string]float64{LagMetricName: 5, ThreadsRunningMetricName: 100},
+		AppConfigs: map[string]*topodatapb.ThrottlerConfig_AppConfig{
+			"tablegc": {
+				Metrics:          []string{LoadAvgMetricName, LagMetricName},
+				MetricThresholds: map[string]float64{LoadAvgMetricName: 2},
+			},
+			"online-ddl": {
+				MetricThresholds: map[string]float64{LagMetricName: 10, HistoryListLengthMetricName: 1000000},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		config   *topodatapb.ThrottlerConfig
+		appName  string
+		expected []MetricThreshold
+	}{{
+		name:     "no config",
+		appName:  "vreplication",
+		expected: []MetricThreshold{{LagMetricName, 1}},
+	}, {
+		name:     "no app config",
+		config:   config,
+		appName:  "vreplication",
+		expected: []MetricThreshold{{LagMetricName, 5}, {ThreadsRunningMetricName, 100}},
+	}, {
+		name:     "app metrics",
+		config:   config,
+		appName:  "tablegc",
+		expected: []MetricThreshold{{LoadAvgMetricName, 2}, {LagMetricName, 5}},
+	}, {
+		name:     "app thresholds",
+		config:   config,
+		appName:  "online-ddl:gh-ost:2a6cc7b2_3b1f_11ec_8d3d_0242ac130003",
+		expected: []MetricThreshold{{HistoryListLengthMetricName, 1000000}, {LagMetricName, 10}, {ThreadsRunningMetricName, 100}},
+	}, {
+		name: "app config without keyspace thresholds",
+		config: &topodatapb.ThrottlerConfig{
+			AppConfigs: config.AppConfigs,
+		},
+		appName:  "tablegc",
+		expected: []MetricThreshold{{LoadAvgMetricName, 2}, {LagMetricName, 1}},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			assert.Equal(t, tcase.expected, AppMetricThresholds(tcase.config, tcase.appName, defaultThresholds))
+		})
+	}
+}
+
+func TestValidateThrottlerConfig(t *testing.T) {
+	testcases := []struct {
+		name   string
+		config *topodatapb.ThrottlerConfig
+		err    string
+	}{{
+		name: "valid",
+		config: &topodatapb.ThrottlerConfig{
+			MetricThresholds: map[string]float64{LagMetricName: 5},
+			AppConfigs: map[string]*topodatapb.ThrottlerConfig_AppConfig{
+				"tablegc": {Metrics: []string{LagMetricName, LoadAvgMetricName}, MetricThresholds: map[string]float64{LoadAvgMetricName: 2}},
+			},
+		},
+	}, {
+		name: "unknown metric",
+		config: &topodatapb.ThrottlerConfig{
+			MetricThresholds: map[string]float64{"disk": 5},
+		},
+		err: "unknown metric disk, expected one of: lag, threads_running, history_list_length, loadavg, datadir_used_ratio, semi_sync_ack_latency, custom",
+	}, {
+		name: "negative threshold",
+		config: &topodatapb.ThrottlerConfig{
+			AppConfigs: map[string]*topodatapb.ThrottlerConfig_AppConfig{
+				"tablegc": {MetricThresholds: map[string]float64{LagMetricName: -1}},
+			},
+		},
+		err: "app tablegc: threshold of metric lag must be positive, got -1",
+	}, {
+		name: "app metric without threshold",
+		config: &topodatapb.ThrottlerConfig{
+			MetricThresholds: map[string]float64{LagMetricName: 5},
+			AppConfigs: map[string]*topodatapb.ThrottlerConfig_AppConfig{
+				"tablegc": {Metrics: []string{LagMetricName, ThreadsRunningMetricName}},
+			},
+		},
+		err: "app tablegc: metric threads_running has no threshold",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			err := ValidateThrottlerConfig(tcase.config)
+			if tcase.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tcase.err)
+		})
+	}
+}
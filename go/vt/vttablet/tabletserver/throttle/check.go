@@ -33,6 +33,9 @@ type CheckFlags struct {
 	OverrideThreshold float64
 	LowPriority       bool
 	OKIfNotExists     bool
+	// AllMetrics reports all the metrics of the store in the check result, including the ones that the
+	// app is not checked against, which have no threshold.
+	AllMetrics bool
 }
 
 // StandardCheckFlags have no special hints
@@ -51,33 +54,16 @@ func NewThrottlerCheck(throttler *Throttler) *ThrottlerCheck {
 }
 
 // checkAppMetricResult allows an app to check on a metric
-func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName string, storeType string, storeName string, metricResultFunc base.MetricResultFunc, flags *CheckFlags) (checkResult *CheckResult) {
-	// Handle deprioritized app logic
-	denyApp := false
-	metricName := fmt.Sprintf("%s/%s", storeType, storeName)
-	if flags.LowPriority {
-		if _, exists := check.throttler.nonLowPriorityAppRequestsThrottled.Get(metricName); exists {
-			// a non-deprioritized app, ie a "normal" app, has recently been throttled.
-			// This is now a deprioritized app. Deny access to this request.
-			denyApp = true
-		}
-	}
-	//
-	metricResult, threshold := check.throttler.AppRequestMetricResult(ctx, appName, metricResultFunc, denyApp)
+func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName string, storeType string, storeName string, metricName string, metricResultFunc base.MetricResultFunc, flags *CheckFlags) (checkResult *CheckResult) {
+	metricResult, threshold := metricResultFunc()
 	if flags.OverrideThreshold > 0 {
 		threshold = flags.OverrideThreshold
 	}
 	value, err := metricResult.Get()
-	if appName == "" {
-		return NewCheckResult(http.StatusExpectationFailed, value, threshold, fmt.Errorf("no app indicated"))
-	}
 
 	var statusCode int
 
-	if err == base.ErrAppDenied {
-		// app specifically not allowed to get metrics
-		statusCode = http.StatusExpectationFailed // 417
-	} else if err == base.ErrNoSuchMetric {
+	if err == base.ErrNoSuchMetric {
 		// not collected yet, or metric does not exist
 		statusCode = http.StatusNotFound // 404
 	} else if err != nil {
@@ -90,31 +76,96 @@ func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName s
 
 		if !flags.LowPriority && !flags.ReadCheck && appName != frenoAppName {
 			// low priority requests will henceforth be denied
-			go check.throttler.nonLowPriorityAppRequestsThrottled.SetDefault(metricName, true)
+			go check.throttler.nonLowPriorityAppRequestsThrottled.SetDefault(fmt.Sprintf("%s/%s", storeType, storeName), true)
 		}
 	} else {
 		// all good!
 		statusCode = http.StatusOK // 200
 	}
-	return NewCheckResult(statusCode, value, threshold, err)
+	checkResult = NewCheckResult(statusCode, value, threshold, err)
+	checkResult.MetricName = metricName
+	return checkResult
 }
 
-// Check is the core function that runs when a user wants to check a metric
-func (check *ThrottlerCheck) Check(ctx context.Context, appName string, storeType string, storeName string, remoteAddr string, flags *CheckFlags) (checkResult *CheckResult) {
-	var metricResultFunc base.MetricResultFunc
-	switch storeType {
-	case "mysql":
-		{
-			metricResultFunc = func() (metricResult base.MetricResult, threshold float64) {
-				return check.throttler.getMySQLClusterMetrics(ctx, storeName)
+// checkAppMetrics allows an app to check on the metrics of a store that it is checked against.
+// The check is rejected by the first of these metrics that is not OK. When it is not rejected,
+// the result is that of the metric which is the closest to its threshold.
+func (check *ThrottlerCheck) checkAppMetrics(ctx context.Context, appName string, storeType string, storeName string, flags *CheckFlags) (checkResult *CheckResult) {
+	if appName == "" {
+		return NewCheckResult(http.StatusExpectationFailed, 0, 0, fmt.Errorf("no app indicated"))
+	}
+	// Handle deprioritized app logic
+	if flags.LowPriority {
+		if _, exists := check.throttler.nonLowPriorityAppRequestsThrottled.Get(fmt.Sprintf("%s/%s", storeType, storeName)); exists {
+			// a non-deprioritized app, ie a "normal" app, has recently been throttled.
+			// This is now a deprioritized app. Deny access to this request.
+			return NewCheckResult(http.StatusExpectationFailed, 0, 0, base.ErrAppDenied) // 417
+		}
+	}
+	if check.throttler.IsAppThrottled(appName) {
+		// app specifically not allowed to get metrics
+		return NewCheckResult(http.StatusExpectationFailed, 0, 0, base.ErrAppDenied) // 417
+	}
+
+	metricCheckResults := make(map[string]*CheckResult)
+	var rejectingResult, closestResult *CheckResult
+	for _, metricThreshold := range check.throttler.appMetricThresholds(appName) {
+		metricThreshold := metricThreshold
+		metricResultFunc := func() (metricResult base.MetricResult, threshold float64) {
+			return check.throttler.getMySQLStoreMetric(ctx, storeName, metricThreshold.MetricName), metricThreshold.Threshold
+		}
+		metricCheckResult := check.checkAppMetricResult(ctx, appName, storeType, storeName, metricThreshold.MetricName, metricResultFunc, flags)
+		metricCheckResults[metricThreshold.MetricName] = metricCheckResult
+
+		if metricCheckResult.StatusCode != http.StatusOK {
+			if rejectingResult == nil {
+				rejectingResult = metricCheckResult
 			}
+		} else if closestResult == nil || metricCheckResult.Value/metricCheckResult.Threshold > closestResult.Value/closestResult.Threshold {
+			closestResult = metricCheckResult
 		}
 	}
-	if metricResultFunc == nil {
+	if flags.AllMetrics {
+		for _, metricName := range base.KnownMetricNames {
+			if _, ok := metricCheckResults[metricName]; ok {
+				continue
+			}
+			metricResult := check.throttler.getMySQLStoreMetric(ctx, storeName, metricName)
+			if metricResult == base.NoSuchMetric {
+				continue
+			}
+			value, err := metricResult.Get()
+			statusCode := http.StatusOK
+			if err != nil {
+				statusCode = http.StatusInternalServerError
+			}
+			metricCheckResults[metricName] = NewCheckResult(statusCode, value, 0, err)
+			metricCheckResults[metricName].MetricName = metricName
+		}
+	}
+
+	switch {
+	case rejectingResult != nil:
+		checkResult = NewCheckResult(rejectingResult.StatusCode, rejectingResult.Value, rejectingResult.Threshold, rejectingResult.Error)
+		checkResult.MetricName = rejectingResult.MetricName
+	case closestResult != nil:
+		checkResult = NewCheckResult(closestResult.StatusCode, closestResult.Value, closestResult.Threshold, closestResult.Error)
+		checkResult.MetricName = closestResult.MetricName
+	default:
+		// the app is not checked against any metric
+		checkResult = NewCheckResult(http.StatusOK, 0, 0, nil)
+	}
+	checkResult.Metrics = metricCheckResults
+	return checkResult
+}
+
+// Check is the core function that runs when a user wants to check a metric
+func (check *ThrottlerCheck) Check(ctx context.Context, appName string, storeType string, storeName string, remoteAddr string, flags *CheckFlags) (checkResult *CheckResult) {
+	if storeType != "mysql" {
 		return NoSuchMetricCheckResult
 	}
 
-	checkResult = check.checkAppMetricResult(ctx, appName, storeType, storeName, metricResultFunc, flags)
+	checkResult = check.checkAppMetrics(ctx, appName, storeType, storeName, flags)
 	atomic.StoreInt64(&check.throttler.lastCheckTimeNano, time.Now().UnixNano())
 
 	go func(statusCode int) {
@@ -138,42 +189,42 @@ func (check *ThrottlerCheck) Check(ctx context.Context, appName string, storeTyp
 	return checkResult
 }
 
-func (check *ThrottlerCheck) splitMetricTokens(metricName string) (storeType string, storeName string, err error) {
-	metricTokens := strings.Split(metricName, "/")
-	if len(metricTokens) != 2 {
-		return storeType, storeName, base.ErrNoSuchMetric
+func (check *ThrottlerCheck) splitMetricTokens(aggregatedMetricName string) (storeType string, storeName string, metricName string, err error) {
+	metricTokens := strings.Split(aggregatedMetricName, "/")
+	if len(metricTokens) != 3 {
+		return storeType, storeName, metricName, base.ErrNoSuchMetric
 	}
 	storeType = metricTokens[0]
 	storeName = metricTokens[1]
+	metricName = metricTokens[2]
 
-	return storeType, storeName, nil
+	return storeType, storeName, metricName, nil
 }
 
-// localCheck
-func (check *ThrottlerCheck) localCheck(ctx context.Context, metricName string) (checkResult *CheckResult) {
-	storeType, storeName, err := check.splitMetricTokens(metricName)
-	if err != nil {
-		return NoSuchMetricCheckResult
-	}
+// localCheck checks a store as the throttler itself, and takes note of the healthy metrics
+func (check *ThrottlerCheck) localCheck(ctx context.Context, storeType string, storeName string) (checkResult *CheckResult) {
 	checkResult = check.Check(ctx, frenoAppName, storeType, storeName, "local", StandardCheckFlags)
 
-	if checkResult.StatusCode == http.StatusOK {
-		check.throttler.markMetricHealthy(metricName)
-	}
-	if timeSinceHealthy, found := check.throttler.timeSinceMetricHealthy(metricName); found {
-		metrics.GetOrRegisterGauge(fmt.Sprintf("check.%s.%s.seconds_since_healthy", storeType, storeName), nil).Update(int64(timeSinceHealthy.Seconds()))
+	for metricName, metricCheckResult := range checkResult.Metrics {
+		aggregatedMetricName := aggregatedMetricName(storeType, storeName, metricName)
+		if metricCheckResult.StatusCode == http.StatusOK {
+			check.throttler.markMetricHealthy(aggregatedMetricName)
+		}
+		if timeSinceHealthy, found := check.throttler.timeSinceMetricHealthy(aggregatedMetricName); found {
+			metrics.GetOrRegisterGauge(fmt.Sprintf("check.%s.%s.%s.seconds_since_healthy", storeType, storeName, metricName), nil).Update(int64(timeSinceHealthy.Seconds()))
+		}
 	}
 
 	return checkResult
 }
 
-func (check *ThrottlerCheck) reportAggregated(metricName string, metricResult base.MetricResult) {
-	storeType, storeName, err := check.splitMetricTokens(metricName)
+func (check *ThrottlerCheck) reportAggregated(aggregatedMetricName string, metricResult base.MetricResult) {
+	storeType, storeName, metricName, err := check.splitMetricTokens(aggregatedMetricName)
 	if err != nil {
 		return
 	}
 	if value, err := metricResult.Get(); err == nil {
-		metrics.GetOrRegisterGaugeFloat64(fmt.Sprintf("aggregated.%s.%s", storeType, storeName), nil).Update(value)
+		metrics.GetOrRegisterGaugeFloat64(fmt.Sprintf("aggregated.%s.%s.%s", storeType, storeName, metricName), nil).Update(value)
 	}
 }
 
@@ -193,11 +244,18 @@ func (check *ThrottlerCheck) SelfChecks(ctx context.Context) {
 	selfCheckTicker := time.NewTicker(selfCheckInterval)
 	go func() {
 		for range selfCheckTicker.C {
-			for metricName, metricResult := range check.AggregatedMetrics(ctx) {
-				metricName := metricName
+			stores := make(map[string]bool)
+			for aggregatedMetricName, metricResult := range check.AggregatedMetrics(ctx) {
+				aggregatedMetricName := aggregatedMetricName
 				metricResult := metricResult
-				go check.localCheck(ctx, metricName)
-				go check.reportAggregated(metricName, metricResult)
+				if storeType, storeName, _, err := check.splitMetricTokens(aggregatedMetricName); err == nil {
+					stores[fmt.Sprintf("%s/%s", storeType, storeName)] = true
+				}
+				go check.reportAggregated(aggregatedMetricName, metricResult)
+			}
+			for store := range stores {
+				storeTokens := strings.Split(store, "/")
+				go check.localCheck(ctx, storeTokens[0], storeTokens[1])
 			}
 		}
 	}()
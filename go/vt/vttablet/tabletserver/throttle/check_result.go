@@ -13,12 +13,17 @@ import (
 )
 
 // CheckResult is the result for an app inquiring on a metric. It also exports as JSON via the API
+// When the check is rejected, the value and threshold are those of the metric that
+// rejected it, which MetricName names. Metrics has the results of all the metrics that
+// the app was checked against.
 type CheckResult struct {
-	StatusCode int     `json:"StatusCode"`
-	Value      float64 `json:"Value"`
-	Threshold  float64 `json:"Threshold"`
-	Error      error   `json:"-"`
-	Message    string  `json:"Message"`
+	StatusCode int                     `json:"StatusCode"`
+	Value      float64                 `json:"Value"`
+	Threshold  float64                 `json:"Threshold"`
+	Error      error                   `json:"-"`
+	Message    string                  `json:"Message"`
+	MetricName string                  `json:"MetricName"`
+	Metrics    map[string]*CheckResult `json:"Metrics,omitempty"`
 }
 
 // NewCheckResult returns a CheckResult
@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func newTestThrottlerCheck(throttlerConfig *topodatapb.ThrottlerConfig, aggregatedMetrics map[string]float64) *ThrottlerCheck {
+	throttler := &Throttler{
+		MetricsThreshold:                   sync2.NewAtomicFloat64(1),
+		aggregatedMetrics:                  cache.New(cache.NoExpiration, 0),
+		throttledApps:                      cache.New(cache.NoExpiration, 0),
+		nonLowPriorityAppRequestsThrottled: cache.New(cache.NoExpiration, 0),
+	}
+	throttler.throttlerConfig.Store(throttlerConfig)
+	for metricName, value := range aggregatedMetrics {
+		throttler.aggregatedMetrics.Set(aggregatedMetricName("mysql", shardStoreName, metricName), base.NewSimpleMetricResult(value), cache.DefaultExpiration)
+	}
+	return NewThrottlerCheck(throttler)
+}
+
+func TestCheckAppMetrics(t *testing.T) {
+	ctx := context.Background()
+	throttlerConfig := &topodatapb.ThrottlerConfig{
+		MetricThresholds: map[string]float64{base.LagMetricName: 5, base.ThreadsRunningMetricName: 100},
+		AppConfigs: map[string]*topodatapb.ThrottlerConfig_AppConfig{
+			"tablegc": {
+				Metrics:          []string{base.LagMetricName, base.LoadAvgMetricName},
+				MetricThresholds: map[string]float64{base.LoadAvgMetricName: 2},
+			},
+		},
+	}
+	check := newTestThrottlerCheck(throttlerConfig, map[string]float64{
+		base.LagMetricName:            2,
+		base.ThreadsRunningMetricName: 80,
+		base.LoadAvgMetricName:        3,
+	})
+
+	// threads_running is closer to its threshold than lag
+	checkResult := check.checkAppMetrics(ctx, "vreplication", "mysql", shardStoreName, StandardCheckFlags)
+	assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+	assert.Equal(t, base.ThreadsRunningMetricName, checkResult.MetricName)
+	assert.Equal(t, 80.0, checkResult.Value)
+	assert.Equal(t, 100.0, checkResult.Threshold)
+	assert.Len(t, checkResult.Metrics, 2)
+
+	// loadavg rejects the check
+	checkResult = check.checkAppMetrics(ctx, "tablegc", "mysql", shardStoreName, StandardCheckFlags)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.StatusCode)
+	assert.Equal(t, base.LoadAvgMetricName, checkResult.MetricName)
+	assert.Equal(t, 3.0, checkResult.Value)
+	assert.Equal(t, 2.0, checkResult.Threshold)
+	assert.Equal(t, base.ErrThresholdExceeded.Error(), checkResult.Message)
+	assert.Equal(t, http.StatusOK, checkResult.Metrics[base.LagMetricName].StatusCode)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.Metrics[base.LoadAvgMetricName].StatusCode)
+
+	// the metrics that the app is not checked against are reported without a threshold
+	checkResult = check.checkAppMetrics(ctx, "vreplication", "mysql", shardStoreName, &CheckFlags{AllMetrics: true})
+	assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+	assert.Len(t, checkResult.Metrics, 3)
+	assert.Equal(t, 3.0, checkResult.Metrics[base.LoadAvgMetricName].Value)
+	assert.Equal(t, 0.0, checkResult.Metrics[base.LoadAvgMetricName].Threshold)
+
+	// a metric that is not collected rejects the check
+	checkResult = check.checkAppMetrics(ctx, "vreplication", "mysql", selfStoreName, StandardCheckFlags)
+	assert.Equal(t, http.StatusNotFound, checkResult.StatusCode)
+	assert.Equal(t, base.LagMetricName, checkResult.MetricName)
+
+	check.throttler.ThrottleApp("vreplication", time.Now().Add(time.Hour), 1)
+	checkResult = check.checkAppMetrics(ctx, "vreplication", "mysql", shardStoreName, StandardCheckFlags)
+	assert.Equal(t, http.StatusExpectationFailed, checkResult.StatusCode)
+
+	// without a keyspace config, apps are checked against the lag
+	check = newTestThrottlerCheck(nil, map[string]float64{
+		base.LagMetricName:            2,
+		base.ThreadsRunningMetricName: 80,
+	})
+	checkResult = check.checkAppMetrics(ctx, "vreplication", "mysql", shardStoreName, StandardCheckFlags)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.StatusCode)
+	assert.Equal(t, base.LagMetricName, checkResult.MetricName)
+	assert.Len(t, checkResult.Metrics, 1)
+}
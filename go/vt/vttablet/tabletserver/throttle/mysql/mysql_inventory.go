@@ -24,12 +24,25 @@ func GetClusterInstanceKey(clusterName string, key *InstanceKey) ClusterInstance
 // InstanceMetricResultMap maps a cluster-instance to a result
 type InstanceMetricResultMap map[ClusterInstanceKey]base.MetricResult
 
+// MetricsInstanceMetricResultMap maps a metric name to the results of the cluster-instances
+type MetricsInstanceMetricResultMap map[string]InstanceMetricResultMap
+
+// Set stores the result of a metric of a cluster-instance
+func (m MetricsInstanceMetricResultMap) Set(metric *MySQLThrottleMetric) {
+	instanceResultsMap, ok := m[metric.MetricName]
+	if !ok {
+		instanceResultsMap = make(InstanceMetricResultMap)
+		m[metric.MetricName] = instanceResultsMap
+	}
+	instanceResultsMap[metric.GetClusterInstanceKey()] = metric
+}
+
 // Inventory has the operational data about probes, their metrics, and relevant configuration
 type Inventory struct {
 	ClustersProbes       map[string](*Probes)
 	IgnoreHostsCount     map[string]int
 	IgnoreHostsThreshold map[string]float64
-	InstanceKeyMetrics   InstanceMetricResultMap
+	InstanceKeyMetrics   MetricsInstanceMetricResultMap
 }
 
 // NewInventory creates a Inventory
@@ -38,7 +51,7 @@ func NewInventory() *Inventory {
 		ClustersProbes:       make(map[string](*Probes)),
 		IgnoreHostsCount:     make(map[string]int),
 		IgnoreHostsThreshold: make(map[string]float64),
-		InstanceKeyMetrics:   make(map[ClusterInstanceKey]base.MetricResult),
+		InstanceKeyMetrics:   make(MetricsInstanceMetricResultMap),
 	}
 	return inventory
 }
@@ -35,23 +35,25 @@ func getMySQLMetricCacheKey(probe *Probe) string {
 	return fmt.Sprintf("%s:%s", probe.Key, probe.MetricQuery)
 }
 
-func cacheMySQLThrottleMetric(probe *Probe, mySQLThrottleMetric *MySQLThrottleMetric) *MySQLThrottleMetric {
-	if mySQLThrottleMetric.Err != nil {
-		return mySQLThrottleMetric
+func cacheMySQLThrottleMetrics(probe *Probe, mySQLThrottleMetrics MySQLThrottleMetrics) MySQLThrottleMetrics {
+	for _, metric := range mySQLThrottleMetrics {
+		if metric.Err != nil {
+			return mySQLThrottleMetrics
+		}
 	}
 	if probe.CacheMillis > 0 {
-		mysqlMetricCache.Set(getMySQLMetricCacheKey(probe), mySQLThrottleMetric, time.Duration(probe.CacheMillis)*time.Millisecond)
+		mysqlMetricCache.Set(getMySQLMetricCacheKey(probe), mySQLThrottleMetrics, time.Duration(probe.CacheMillis)*time.Millisecond)
 	}
-	return mySQLThrottleMetric
+	return mySQLThrottleMetrics
 }
 
-func getCachedMySQLThrottleMetric(probe *Probe) *MySQLThrottleMetric {
+func getCachedMySQLThrottleMetrics(probe *Probe) MySQLThrottleMetrics {
 	if probe.CacheMillis == 0 {
 		return nil
 	}
-	if metric, found := mysqlMetricCache.Get(getMySQLMetricCacheKey(probe)); found {
-		mySQLThrottleMetric, _ := metric.(*MySQLThrottleMetric)
-		return mySQLThrottleMetric
+	if cached, found := mysqlMetricCache.Get(getMySQLMetricCacheKey(probe)); found {
+		mySQLThrottleMetrics, _ := cached.(MySQLThrottleMetrics)
+		return mySQLThrottleMetrics
 	}
 	return nil
 }
@@ -73,6 +75,7 @@ func GetMetricsQueryType(query string) MetricsQueryType {
 // MySQLThrottleMetric has the probed metric for a mysql instance
 type MySQLThrottleMetric struct { // nolint:revive
 	ClusterName string
+	MetricName  string
 	Key         InstanceKey
 	Value       float64
 	Err         error
@@ -83,6 +86,9 @@ func NewMySQLThrottleMetric() *MySQLThrottleMetric {
 	return &MySQLThrottleMetric{Value: 0}
 }
 
+// MySQLThrottleMetrics are the metrics probed for a mysql instance, by metric name
+type MySQLThrottleMetrics map[string]*MySQLThrottleMetric // nolint:revive
+
 // GetClusterInstanceKey returns the ClusterInstanceKey part of the metric
 func (metric *MySQLThrottleMetric) GetClusterInstanceKey() ClusterInstanceKey {
 	return GetClusterInstanceKey(metric.ClusterName, &metric.Key)
@@ -93,29 +99,32 @@ func (metric *MySQLThrottleMetric) Get() (float64, error) {
 	return metric.Value, metric.Err
 }
 
-// ReadThrottleMetric returns a metric for the given probe. Either by explicit query
-// or via SHOW SLAVE STATUS
-func ReadThrottleMetric(probe *Probe, clusterName string, overrideGetMetricFunc func() *MySQLThrottleMetric) (mySQLThrottleMetric *MySQLThrottleMetric) {
-	if mySQLThrottleMetric := getCachedMySQLThrottleMetric(probe); mySQLThrottleMetric != nil {
-		return mySQLThrottleMetric
+// ReadThrottleMetrics returns the metrics for the given probe, as read by the given function
+func ReadThrottleMetrics(probe *Probe, clusterName string, overrideGetMetricsFunc func() MySQLThrottleMetrics) (mySQLThrottleMetrics MySQLThrottleMetrics) {
+	if mySQLThrottleMetrics := getCachedMySQLThrottleMetrics(probe); mySQLThrottleMetrics != nil {
+		return mySQLThrottleMetrics
 		// On cached results we avoid taking latency metrics
 	}
 
 	started := time.Now()
-	mySQLThrottleMetric = NewMySQLThrottleMetric()
-	mySQLThrottleMetric.ClusterName = clusterName
-	mySQLThrottleMetric.Key = probe.Key
-
-	defer func(metric *MySQLThrottleMetric, started time.Time) {
-		go func() {
+	defer func() {
+		go func(probedMetrics MySQLThrottleMetrics) {
 			metrics.GetOrRegisterTimer("probes.latency", nil).Update(time.Since(started))
 			metrics.GetOrRegisterCounter("probes.total", nil).Inc(1)
-			if metric.Err != nil {
-				metrics.GetOrRegisterCounter("probes.error", nil).Inc(1)
+			for _, metric := range probedMetrics {
+				if metric.Err != nil {
+					metrics.GetOrRegisterCounter("probes.error", nil).Inc(1)
+					return
+				}
 			}
-		}()
-	}(mySQLThrottleMetric, started)
-
-	mySQLThrottleMetric = overrideGetMetricFunc()
-	return cacheMySQLThrottleMetric(probe, mySQLThrottleMetric)
+		}(mySQLThrottleMetrics)
+	}()
+
+	mySQLThrottleMetrics = overrideGetMetricsFunc()
+	for metricName, metric := range mySQLThrottleMetrics {
+		metric.ClusterName = clusterName
+		metric.MetricName = metricName
+		metric.Key = probe.Key
+	}
+	return cacheMySQLThrottleMetrics(probe, mySQLThrottleMetrics)
 }
@@ -15,12 +15,17 @@ import (
 	"math"
 	"math/rand"
 	"net/http"
+	"os"
+	"runtime"
 	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"syscall"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/textutil"
 	"vitess.io/vitess/go/timer"
@@ -42,6 +47,7 @@ const (
 	mysqlDormantCollectInterval = 5 * time.Second
 	mysqlRefreshInterval        = 10 * time.Second
 	mysqlAggregateInterval      = 125 * time.Millisecond
+	configRefreshInterval       = 10 * time.Second
 
 	aggregatedMetricsExpiration   = 5 * time.Second
 	aggregatedMetricsCleanup      = 10 * time.Second
@@ -62,12 +68,16 @@ const (
 var (
 	throttleThreshold         = flag.Duration("throttle_threshold", 1*time.Second, "Replication lag threshold for default lag throttling")
 	throttleTabletTypes       = flag.String("throttle_tablet_types", "replica", "Comma separated VTTablet types to be considered by the throttler. default: 'replica'. example: 'replica,rdonly'. 'replica' aways implicitly included")
-	throttleMetricQuery       = flag.String("throttle_metrics_query", "", "Override default heartbeat/lag metric. Use either `SELECT` (must return single row, single value) or `SHOW GLOBAL ... LIKE ...` queries. Set -throttle_metrics_threshold respectively.")
+	throttleMetricQuery       = flag.String("throttle_metrics_query", "", "Custom metric, which apps are checked against instead of the default heartbeat/lag metric when the keyspace has no throttler config. Use either `SELECT` (must return single row, single value) or `SHOW GLOBAL ... LIKE ...` queries. Set -throttle_metrics_threshold respectively.")
 	throttleMetricThreshold   = flag.Float64("throttle_metrics_threshold", math.MaxFloat64, "Override default throttle threshold, respective to -throttle_metrics_query")
 	throttlerCheckAsCheckSelf = flag.Bool("throttle_check_as_check_self", false, "Should throttler/check return a throttler/check-self result (changes throttler behavior for writes)")
 )
 var (
-	replicationLagQuery = `select unix_timestamp(now(6))-max(ts/1000000000) as replication_lag from _vt.heartbeat`
+	replicationLagQuery    = `select unix_timestamp(now(6))-max(ts/1000000000) as replication_lag from _vt.heartbeat`
+	threadsRunningQuery    = `show global status like 'threads_running'`
+	historyListLengthQuery = `select count as history_list_length from information_schema.innodb_metrics where name = 'trx_rseg_history_len'`
+	dataDirQuery           = `select @@datadir as datadir`
+	semiSyncAckWaitQuery   = `show global status like 'Rpl_semi_sync_master_tx_avg_wait_time'`
 )
 
 // ThrottleCheckType allows a client to indicate what type of check it wants to issue. See available types below.
@@ -101,21 +111,24 @@ type Throttler struct {
 
 	throttleTabletTypesMap map[topodatapb.TabletType]bool
 
-	mysqlThrottleMetricChan chan *mysql.MySQLThrottleMetric
+	mysqlThrottleMetricChan chan mysql.MySQLThrottleMetrics
 	mysqlInventoryChan      chan *mysql.Inventory
 	mysqlClusterProbesChan  chan *mysql.ClusterProbes
 
 	mysqlInventory *mysql.Inventory
 
-	metricsQuery     string
+	customMetricsQuery     string
+	customMetricsQueryType mysql.MetricsQueryType
+	// MetricsThreshold is the threshold of the lag metric, or of the custom metric if there is
+	// one, when the keyspace has no throttler config.
 	MetricsThreshold sync2.AtomicFloat64
-	metricsQueryType mysql.MetricsQueryType
+	// throttlerConfig holds the *topodatapb.ThrottlerConfig of the keyspace, which may be nil.
+	throttlerConfig atomic.Value
 
-	mysqlClusterThresholds *cache.Cache
-	aggregatedMetrics      *cache.Cache
-	throttledApps          *cache.Cache
-	recentApps             *cache.Cache
-	metricsHealth          *cache.Cache
+	aggregatedMetrics *cache.Cache
+	throttledApps     *cache.Cache
+	recentApps        *cache.Cache
+	metricsHealth     *cache.Cache
 
 	lastCheckTimeNano int64
 
@@ -138,6 +151,7 @@ type ThrottlerStatus struct {
 
 	AggregatedMetrics map[string]base.MetricResult
 	MetricsHealth     base.MetricHealthMap
+	ThrottlerConfig   *topodatapb.ThrottlerConfig
 }
 
 // NewThrottler creates a Throttler
@@ -156,17 +170,15 @@ func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topo
 	}
 
 	if env.Config().EnableLagThrottler {
-		throttler.mysqlThrottleMetricChan = make(chan *mysql.MySQLThrottleMetric)
+		throttler.mysqlThrottleMetricChan = make(chan mysql.MySQLThrottleMetrics)
 
 		throttler.mysqlInventoryChan = make(chan *mysql.Inventory, 1)
 		throttler.mysqlClusterProbesChan = make(chan *mysql.ClusterProbes)
 		throttler.mysqlInventory = mysql.NewInventory()
 
-		throttler.metricsQuery = replicationLagQuery
 		throttler.MetricsThreshold = sync2.NewAtomicFloat64(throttleThreshold.Seconds())
 
 		throttler.throttledApps = cache.New(cache.NoExpiration, 10*time.Second)
-		throttler.mysqlClusterThresholds = cache.New(cache.NoExpiration, 0)
 		throttler.aggregatedMetrics = cache.New(aggregatedMetricsExpiration, aggregatedMetricsCleanup)
 		throttler.recentApps = cache.New(recentAppsExpiration, time.Minute)
 		throttler.metricsHealth = cache.New(cache.NoExpiration, 0)
@@ -221,25 +233,66 @@ func (throttler *Throttler) initConfig() {
 		},
 	}
 	if *throttleMetricQuery != "" {
-		throttler.metricsQuery = *throttleMetricQuery
+		throttler.customMetricsQuery = *throttleMetricQuery
+		throttler.customMetricsQueryType = mysql.GetMetricsQueryType(throttler.customMetricsQuery)
 	}
 	if *throttleMetricThreshold != math.MaxFloat64 {
 		throttler.MetricsThreshold = sync2.NewAtomicFloat64(*throttleMetricThreshold)
 	}
-	throttler.metricsQueryType = mysql.GetMetricsQueryType(throttler.metricsQuery)
 
 	config.Instance.Stores.MySQL.Clusters[selfStoreName] = &config.MySQLClusterConfigurationSettings{
-		MetricQuery:       throttler.metricsQuery,
-		ThrottleThreshold: throttler.MetricsThreshold.Get(),
-		IgnoreHostsCount:  0,
+		MetricQuery:      throttler.customMetricsQuery,
+		IgnoreHostsCount: 0,
 	}
 	config.Instance.Stores.MySQL.Clusters[shardStoreName] = &config.MySQLClusterConfigurationSettings{
-		MetricQuery:       throttler.metricsQuery,
-		ThrottleThreshold: throttler.MetricsThreshold.Get(),
-		IgnoreHostsCount:  0,
+		MetricQuery:      throttler.customMetricsQuery,
+		IgnoreHostsCount: 0,
 	}
 }
 
+// getThrottlerConfig returns the throttler config of the keyspace, which is nil if there is none
+func (throttler *Throttler) getThrottlerConfig() *topodatapb.ThrottlerConfig {
+	throttlerConfig, _ := throttler.throttlerConfig.Load().(*topodatapb.ThrottlerConfig)
+	return throttlerConfig
+}
+
+// refreshThrottlerConfig reads the throttler config from the topo record of the keyspace
+func (throttler *Throttler) refreshThrottlerConfig(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, configRefreshInterval)
+	defer cancel()
+
+	keyspaceInfo, err := throttler.ts.GetKeyspace(ctx, throttler.keyspace)
+	if err != nil {
+		return err
+	}
+	throttlerConfig := keyspaceInfo.ThrottlerConfig
+	if throttlerConfig != nil {
+		if err := base.ValidateThrottlerConfig(throttlerConfig); err != nil {
+			return fmt.Errorf("invalid throttler config in keyspace %s: %v", throttler.keyspace, err)
+		}
+	}
+	if !proto.Equal(throttlerConfig, throttler.getThrottlerConfig()) {
+		log.Infof("Throttler: new throttler config for keyspace %s: %v", throttler.keyspace, throttlerConfig)
+	}
+	throttler.throttlerConfig.Store(throttlerConfig)
+	return nil
+}
+
+// defaultMetricThresholds returns the thresholds of the metrics when the keyspace has no
+// throttler config: apps are checked against the custom metric if there is one, or else
+// against the lag metric.
+func (throttler *Throttler) defaultMetricThresholds() map[string]float64 {
+	if throttler.customMetricsQuery != "" {
+		return map[string]float64{base.CustomMetricName: throttler.MetricsThreshold.Get()}
+	}
+	return map[string]float64{base.LagMetricName: throttler.MetricsThreshold.Get()}
+}
+
+// appMetricThresholds returns the metrics that an app is checked against, with their thresholds
+func (throttler *Throttler) appMetricThresholds(appName string) []base.MetricThreshold {
+	return base.AppMetricThresholds(throttler.getThrottlerConfig(), appName, throttler.defaultMetricThresholds())
+}
+
 // Open opens database pool and initializes the schema
 func (throttler *Throttler) Open() error {
 	throttler.initMutex.Lock()
@@ -278,47 +331,133 @@ func (throttler *Throttler) Close() {
 	atomic.StoreInt64(&throttler.isOpen, 0)
 }
 
-// readSelfMySQLThrottleMetric reads the mysql metric from thi very tablet's backend mysql.
-func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMetric {
-	metric := &mysql.MySQLThrottleMetric{
-		ClusterName: selfStoreName,
-		Key:         *mysql.SelfInstanceKey,
-		Value:       0,
-		Err:         nil,
-	}
+// readSelfMySQLThrottleMetrics reads the metrics of this very tablet: those of its backend mysql,
+// and those of its host.
+func (throttler *Throttler) readSelfMySQLThrottleMetrics() mysql.MySQLThrottleMetrics {
 	ctx := context.Background()
-	conn, err := throttler.pool.Get(ctx)
-	if err != nil {
-		metric.Err = err
-		return metric
+	metrics := make(mysql.MySQLThrottleMetrics)
+	conn, connErr := throttler.pool.Get(ctx)
+	if connErr == nil {
+		defer conn.Recycle()
+	}
+	readMetric := func(metricName string, read func() (float64, error)) {
+		metric := mysql.NewMySQLThrottleMetric()
+		metric.Value, metric.Err = read()
+		metrics[metricName] = metric
+	}
+	readMySQLMetric := func(metricName string, read func() (float64, error)) {
+		readMetric(metricName, func() (float64, error) {
+			if connErr != nil {
+				return 0, connErr
+			}
+			return read()
+		})
+	}
+	readQueryMetric := func(metricName string, query string, queryType mysql.MetricsQueryType) {
+		readMySQLMetric(metricName, func() (float64, error) {
+			return throttler.readMySQLQueryMetric(ctx, conn, query, queryType)
+		})
 	}
-	defer conn.Recycle()
 
-	tm, err := conn.Exec(ctx, throttler.metricsQuery, 1, true)
+	readQueryMetric(base.LagMetricName, replicationLagQuery, mysql.MetricsQueryTypeSelect)
+	readQueryMetric(base.ThreadsRunningMetricName, threadsRunningQuery, mysql.MetricsQueryTypeShowGlobal)
+	readQueryMetric(base.HistoryListLengthMetricName, historyListLengthQuery, mysql.MetricsQueryTypeSelect)
+	readMySQLMetric(base.DataDirUsedRatioMetricName, func() (float64, error) {
+		return throttler.readDataDirUsedRatio(ctx, conn)
+	})
+	readMySQLMetric(base.SemiSyncAckLatencyMetricName, func() (float64, error) {
+		return throttler.readSemiSyncAckLatency(ctx, conn)
+	})
+	if throttler.customMetricsQuery != "" {
+		readQueryMetric(base.CustomMetricName, throttler.customMetricsQuery, throttler.customMetricsQueryType)
+	}
+	readMetric(base.LoadAvgMetricName, readLoadAvg)
+
+	return metrics
+}
+
+// readMySQLQueryMetric reads a metric from the result of a query on this tablet's backend mysql.
+func (throttler *Throttler) readMySQLQueryMetric(ctx context.Context, conn *connpool.DBConn, query string, queryType mysql.MetricsQueryType) (value float64, err error) {
+	tm, err := conn.Exec(ctx, query, 1, true)
 	if err != nil {
-		metric.Err = err
-		return metric
+		return 0, err
 	}
 	row := tm.Named().Row()
 	if row == nil {
-		metric.Err = fmt.Errorf("no results for readSelfMySQLThrottleMetric")
-		return metric
+		return 0, fmt.Errorf("no results for query %s", query)
 	}
 
-	switch throttler.metricsQueryType {
+	switch queryType {
 	case mysql.MetricsQueryTypeSelect:
 		// We expect a single row, single column result.
 		// The "for" iteration below is just a way to get first result without knowning column name
 		for k := range row {
-			metric.Value, metric.Err = row.ToFloat64(k)
+			value, err = row.ToFloat64(k)
 		}
+		return value, err
 	case mysql.MetricsQueryTypeShowGlobal:
-		metric.Value, metric.Err = strconv.ParseFloat(row["Value"].ToString(), 64)
+		return strconv.ParseFloat(row["Value"].ToString(), 64)
 	default:
-		metric.Err = fmt.Errorf("Unsupported metrics query type for query %s", throttler.metricsQuery)
+		return 0, fmt.Errorf("Unsupported metrics query type for query %s", query)
+	}
+}
+
+// readDataDirUsedRatio reads the used ratio of the disk of the data directory of this tablet's
+// backend mysql, which is expected to run on the same host as the tablet.
+func (throttler *Throttler) readDataDirUsedRatio(ctx context.Context, conn *connpool.DBConn) (float64, error) {
+	tm, err := conn.Exec(ctx, dataDirQuery, 1, true)
+	if err != nil {
+		return 0, err
+	}
+	row := tm.Named().Row()
+	if row == nil {
+		return 0, fmt.Errorf("no results for query %s", dataDirQuery)
+	}
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(row["datadir"].ToString(), &stat); err != nil {
+		return 0, err
+	}
+	if stat.Blocks == 0 {
+		return 0, fmt.Errorf("no blocks in the filesystem of %s", row["datadir"].ToString())
+	}
+	return 1 - float64(stat.Bavail)/float64(stat.Blocks), nil
+}
+
+// readSemiSyncAckLatency reads the average time, in seconds, that this tablet's backend mysql waited
+// for a semi-sync replica to acknowledge a transaction. The latency is 0 when the semi-sync plugin
+// is not loaded, which is the case on servers that do not use semi-sync.
+func (throttler *Throttler) readSemiSyncAckLatency(ctx context.Context, conn *connpool.DBConn) (float64, error) {
+	tm, err := conn.Exec(ctx, semiSyncAckWaitQuery, 1, true)
+	if err != nil {
+		return 0, err
 	}
+	row := tm.Named().Row()
+	if row == nil {
+		return 0, nil
+	}
+	// The status variable is in microseconds.
+	microseconds, err := strconv.ParseFloat(row["Value"].ToString(), 64)
+	if err != nil {
+		return 0, err
+	}
+	return microseconds / 1000000, nil
+}
 
-	return metric
+// readLoadAvg reads the 1 minute load average of this host, per CPU.
+func readLoadAvg() (float64, error) {
+	content, err := os.ReadFile("/proc/loadavg")
+	if err != nil {
+		return 0, err
+	}
+	fields := strings.Fields(string(content))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("unexpected /proc/loadavg content: %s", content)
+	}
+	loadAvg, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, err
+	}
+	return loadAvg / float64(runtime.NumCPU()), nil
 }
 
 // ThrottledAppsSnapshot returns a snapshot (a copy) of current throttled apps
@@ -351,6 +490,7 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 	mysqlRefreshTicker := addTicker(mysqlRefreshInterval)
 	mysqlAggregateTicker := addTicker(mysqlAggregateInterval)
 	throttledAppsTicker := addTicker(throttledAppsSnapshotInterval)
+	configRefreshTicker := addTicker(configRefreshInterval)
 
 	for {
 		select {
@@ -403,10 +543,12 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 					}
 				}
 			}
-		case metric := <-throttler.mysqlThrottleMetricChan:
+		case metrics := <-throttler.mysqlThrottleMetricChan:
 			{
-				// incoming MySQL metric, frequent, as result of collectMySQLMetrics()
-				throttler.mysqlInventory.InstanceKeyMetrics[metric.GetClusterInstanceKey()] = metric
+				// incoming MySQL metrics, frequent, as result of collectMySQLMetrics()
+				for _, metric := range metrics {
+					throttler.mysqlInventory.InstanceKeyMetrics.Set(metric)
+				}
 			}
 		case <-mysqlRefreshTicker.C:
 			{
@@ -432,39 +574,61 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 					go throttler.expireThrottledApps()
 				}
 			}
+		case <-configRefreshTicker.C:
+			{
+				// sparse
+				if atomic.LoadInt64(&throttler.isOpen) > 0 {
+					go func() {
+						if err := throttler.refreshThrottlerConfig(ctx); err != nil {
+							log.Errorf("Throttler: could not refresh throttler config: %v", err)
+						}
+					}()
+				}
+			}
 		}
 	}
 }
 
-func (throttler *Throttler) generateTabletHTTPProbeFunction(ctx context.Context, clusterName string, probe *mysql.Probe) (probeFunc func() *mysql.MySQLThrottleMetric) {
-	return func() *mysql.MySQLThrottleMetric {
-		// Hit a tablet's `check-self` via HTTP, and convert its CheckResult JSON output into a MySQLThrottleMetric
-		mySQLThrottleMetric := mysql.NewMySQLThrottleMetric()
-		mySQLThrottleMetric.ClusterName = clusterName
-		mySQLThrottleMetric.Key = probe.Key
+func (throttler *Throttler) generateTabletHTTPProbeFunction(ctx context.Context, clusterName string, probe *mysql.Probe) (probeFunc func() mysql.MySQLThrottleMetrics) {
+	return func() mysql.MySQLThrottleMetrics {
+		// Hit a tablet's `check-self` via HTTP, and convert its CheckResult JSON output into MySQLThrottleMetrics
+		errorMetrics := func(err error) mysql.MySQLThrottleMetrics {
+			mySQLThrottleMetrics := make(mysql.MySQLThrottleMetrics)
+			for _, metricName := range base.KnownMetricNames {
+				mySQLThrottleMetrics[metricName] = &mysql.MySQLThrottleMetric{Err: err}
+			}
+			return mySQLThrottleMetrics
+		}
 
-		tabletCheckSelfURL := fmt.Sprintf("http://%s:%d/throttler/check-self?app=vitess", probe.TabletHost, probe.TabletPort)
+		tabletCheckSelfURL := fmt.Sprintf("http://%s:%d/throttler/check-self?app=vitess&all_metrics=true", probe.TabletHost, probe.TabletPort)
 		resp, err := throttler.httpClient.Get(tabletCheckSelfURL)
 		if err != nil {
-			mySQLThrottleMetric.Err = err
-			return mySQLThrottleMetric
+			return errorMetrics(err)
 		}
+		defer resp.Body.Close()
 		b, err := io.ReadAll(resp.Body)
 		if err != nil {
-			mySQLThrottleMetric.Err = err
-			return mySQLThrottleMetric
+			return errorMetrics(err)
 		}
 		checkResult := &CheckResult{}
 		if err := json.Unmarshal(b, checkResult); err != nil {
-			mySQLThrottleMetric.Err = err
-			return mySQLThrottleMetric
+			return errorMetrics(err)
+		}
+		if checkResult.Metrics == nil {
+			// A tablet that only knows about a single metric, which is the lag one
+			checkResult.Metrics = map[string]*CheckResult{base.LagMetricName: checkResult}
 		}
-		mySQLThrottleMetric.Value = checkResult.Value
 
-		if checkResult.StatusCode == http.StatusInternalServerError {
-			mySQLThrottleMetric.Err = fmt.Errorf("Status code: %d", checkResult.StatusCode)
+		mySQLThrottleMetrics := make(mysql.MySQLThrottleMetrics)
+		for metricName, metricCheckResult := range checkResult.Metrics {
+			mySQLThrottleMetric := mysql.NewMySQLThrottleMetric()
+			mySQLThrottleMetric.Value = metricCheckResult.Value
+			if metricCheckResult.StatusCode == http.StatusInternalServerError {
+				mySQLThrottleMetric.Err = fmt.Errorf("Status code: %d", metricCheckResult.StatusCode)
+			}
+			mySQLThrottleMetrics[metricName] = mySQLThrottleMetric
 		}
-		return mySQLThrottleMetric
+		return mySQLThrottleMetrics
 	}
 }
 
@@ -486,13 +650,13 @@ func (throttler *Throttler) collectMySQLMetrics(ctx context.Context) error {
 					}
 					defer atomic.StoreInt64(&probe.QueryInProgress, 0)
 
-					var throttleMetricFunc func() *mysql.MySQLThrottleMetric
+					var throttleMetricsFunc func() mysql.MySQLThrottleMetrics
 					if clusterName == selfStoreName {
-						throttleMetricFunc = throttler.readSelfMySQLThrottleMetric
+						throttleMetricsFunc = throttler.readSelfMySQLThrottleMetrics
 					} else {
-						throttleMetricFunc = throttler.generateTabletHTTPProbeFunction(ctx, clusterName, probe)
+						throttleMetricsFunc = throttler.generateTabletHTTPProbeFunction(ctx, clusterName, probe)
 					}
-					throttleMetrics := mysql.ReadThrottleMetric(probe, clusterName, throttleMetricFunc)
+					throttleMetrics := mysql.ReadThrottleMetrics(probe, clusterName, throttleMetricsFunc)
 					throttler.mysqlThrottleMetricChan <- throttleMetrics
 				}()
 			}
@@ -532,7 +696,6 @@ func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
 		// config may dynamically change, but internal structure (config.Settings().Stores.MySQL.Clusters in our case)
 		// is immutable and can only be _replaced_. Hence, it's safe to read in a goroutine:
 		go func() {
-			throttler.mysqlClusterThresholds.Set(clusterName, clusterSettings.ThrottleThreshold, cache.DefaultExpiration)
 			clusterProbes := &mysql.ClusterProbes{
 				ClusterName:      clusterName,
 				IgnoreHostsCount: clusterSettings.IgnoreHostsCount,
@@ -588,15 +751,35 @@ func (throttler *Throttler) updateMySQLClusterProbes(ctx context.Context, cluste
 // synchronous aggregation of collected data
 func (throttler *Throttler) aggregateMySQLMetrics(ctx context.Context) error {
 	for clusterName, probes := range throttler.mysqlInventory.ClustersProbes {
-		metricName := fmt.Sprintf("mysql/%s", clusterName)
 		ignoreHostsCount := throttler.mysqlInventory.IgnoreHostsCount[clusterName]
 		ignoreHostsThreshold := throttler.mysqlInventory.IgnoreHostsThreshold[clusterName]
-		aggregatedMetric := aggregateMySQLProbes(ctx, probes, clusterName, throttler.mysqlInventory.InstanceKeyMetrics, ignoreHostsCount, config.Settings().Stores.MySQL.IgnoreDialTCPErrors, ignoreHostsThreshold)
-		throttler.aggregatedMetrics.Set(metricName, aggregatedMetric, cache.DefaultExpiration)
+		for metricName, instanceResultsMap := range throttler.mysqlInventory.InstanceKeyMetrics {
+			aggregatedMetric := aggregateMySQLProbes(ctx, probes, clusterName, instanceResultsMap, ignoreHostsCount, config.Settings().Stores.MySQL.IgnoreDialTCPErrors, ignoreHostsThreshold)
+			if aggregatedMetric == base.NoMetricResultYet && !throttler.isCollectedMetric(clusterName, metricName) {
+				// e.g. the custom metric, which only some tablets collect
+				continue
+			}
+			throttler.aggregatedMetrics.Set(aggregatedMetricName("mysql", clusterName, metricName), aggregatedMetric, cache.DefaultExpiration)
+		}
 	}
 	return nil
 }
 
+// isCollectedMetric returns true when some probe of the cluster collects the metric
+func (throttler *Throttler) isCollectedMetric(clusterName string, metricName string) bool {
+	for clusterInstanceKey := range throttler.mysqlInventory.InstanceKeyMetrics[metricName] {
+		if clusterInstanceKey.ClusterName == clusterName {
+			return true
+		}
+	}
+	return false
+}
+
+// aggregatedMetricName returns the name of a metric aggregated over a store, e.g. "mysql/shard/lag"
+func aggregatedMetricName(storeType string, storeName string, metricName string) string {
+	return fmt.Sprintf("%s/%s/%s", storeType, storeName, metricName)
+}
+
 func (throttler *Throttler) getNamedMetric(metricName string) base.MetricResult {
 	if metricResultVal, found := throttler.aggregatedMetrics.Get(metricName); found {
 		return metricResultVal.(base.MetricResult)
@@ -604,14 +787,9 @@ func (throttler *Throttler) getNamedMetric(metricName string) base.MetricResult
 	return base.NoSuchMetric
 }
 
-func (throttler *Throttler) getMySQLClusterMetrics(ctx context.Context, clusterName string) (base.MetricResult, float64) {
-	if thresholdVal, found := throttler.mysqlClusterThresholds.Get(clusterName); found {
-		threshold, _ := thresholdVal.(float64)
-		metricName := fmt.Sprintf("mysql/%s", clusterName)
-		return throttler.getNamedMetric(metricName), threshold
-	}
-
-	return base.NoSuchMetric, 0
+// getMySQLStoreMetric returns a metric aggregated over a MySQL store
+func (throttler *Throttler) getMySQLStoreMetric(ctx context.Context, storeName string, metricName string) base.MetricResult {
+	return throttler.getNamedMetric(aggregatedMetricName("mysql", storeName, metricName))
 }
 
 func (throttler *Throttler) aggregatedMetricsSnapshot() map[string]base.MetricResult {
@@ -752,17 +930,6 @@ func (throttler *Throttler) metricsHealthSnapshot() base.MetricHealthMap {
 	return snapshot
 }
 
-// AppRequestMetricResult gets a metric result in the context of a specific app
-func (throttler *Throttler) AppRequestMetricResult(ctx context.Context, appName string, metricResultFunc base.MetricResultFunc, denyApp bool) (metricResult base.MetricResult, threshold float64) {
-	if denyApp {
-		return base.AppDeniedMetric, 0
-	}
-	if throttler.IsAppThrottled(appName) {
-		return base.AppDeniedMetric, 0
-	}
-	return metricResultFunc()
-}
-
 // checkStore checks the aggregated value of given MySQL store
 func (throttler *Throttler) checkStore(ctx context.Context, appName string, storeName string, remoteAddr string, flags *CheckFlags) (checkResult *CheckResult) {
 	if !throttler.env.Config().EnableLagThrottler {
@@ -808,5 +975,6 @@ func (throttler *Throttler) Status() *ThrottlerStatus {
 
 		AggregatedMetrics: throttler.aggregatedMetricsSnapshot(),
 		MetricsHealth:     throttler.metricsHealthSnapshot(),
+		ThrottlerConfig:   throttler.getThrottlerConfig(),
 	}
 }
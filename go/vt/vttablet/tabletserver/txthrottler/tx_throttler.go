@@ -18,6 +18,8 @@ package txthrottler
 
 import (
 	"fmt"
+	"math/rand"
+	"net/http"
 	"sync"
 	"time"
 
@@ -27,24 +29,44 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/throttler"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	throttlerdatapb "vitess.io/vitess/go/vt/proto/throttlerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
-// TxThrottler throttles transactions based on replication lag.
-// It's a thin wrapper around the throttler found in vitess/go/vt/throttler.
-// It uses a discovery.LegacyHealthCheck to send replication-lag updates to the wrapped throttler.
+// TxThrottler throttles transactions based on the health of the shard.
+// It reads the health of the shard from one of two sources:
+//
+//   - The replication lag of the replicas, as reported by their health checks. The
+//     TxThrottler is then a thin wrapper around the throttler found in
+//     vitess/go/vt/throttler. It uses a discovery.LegacyHealthCheck to send
+//     replication-lag updates to the wrapped throttler.
+//   - The metrics of the tablet throttler, see go/vt/vttablet/tabletserver/throttle,
+//     which aggregates the replica lag of the shard, and reads the semi-sync ack
+//     latency of the primary. The transactions of a priority class are then checked
+//     as the "tx-throttler:<class>" app, so that the throttler config of the keyspace
+//     can select the metrics of each class, and their thresholds.
+//
+// A transaction belongs to the first priority class that lists its caller, or else
+// to the default class. While the shard is unhealthy, a transaction of priority p
+// is throttled with a probability of p/100.
+//
+// In dry-run mode, the TxThrottler only counts and logs the transactions that it
+// would have throttled.
 //
 // Intended Usage:
 //   // Assuming topoServer is a topo.Server variable pointing to a Vitess topology server.
-//   t := NewTxThrottler(config, topoServer)
+//   t := NewTxThrottler(env, topoServer, tabletThrottler)
 //
 //   // A transaction throttler must be opened before its first use:
 //   if err := t.Open(keyspace, shard); err != nil {
@@ -52,7 +74,7 @@ import (
 //   }
 //
 //   // Checking whether to throttle can be done as follows before starting a transaction.
-//   if t.Throttle() {
+//   if t.Throttle(ctx) {
 //     return fmt.Errorf("Transaction throttled!")
 //   } else {
 //     // execute transaction.
@@ -62,19 +84,43 @@ import (
 //   t.Close()
 //
 // A TxThrottler object is generally not thread-safe: at any given time at most one goroutine should
-// be executing a method. The only exception is the 'Throttle' method where multiple goroutines are
-// allowed to execute it concurrently.
+// be executing a method. The exceptions are the 'Throttle' method where multiple goroutines are
+// allowed to execute it concurrently, and the 'Status' method.
 type TxThrottler struct {
 	// config stores the transaction throttler's configuration.
 	// It is populated in NewTxThrottler and is not modified
 	// since.
 	config *txThrottlerConfig
 
+	// classes are the priority classes, and byUser indexes them by the
+	// users they list. defaultClass is the class of the other transactions.
+	classes      []*tabletenv.TxThrottlerPriorityClassConfig
+	byUser       map[string]*tabletenv.TxThrottlerPriorityClassConfig
+	defaultClass *tabletenv.TxThrottlerPriorityClassConfig
+
+	// mu protects state for Status, which runs concurrently with Open and Close.
+	mu sync.Mutex
 	// state holds an open transaction throttler state. It is nil
 	// if the TransactionThrottler is closed.
-	state *txThrottlerState
+	state shardHealth
 
 	target *querypb.Target
+
+	// requests counts per priority class the transactions that were checked.
+	// throttled counts those that were throttled, or that would have been
+	// in dry-run mode.
+	requests, throttled *stats.CountersWithSingleLabel
+	logDryRun           *logutil.ThrottledLogger
+}
+
+// shardHealth tells whether the shard is healthy enough for more transactions.
+type shardHealth interface {
+	// throttle returns true, along with the reason, if a transaction
+	// of the priority class should not proceed.
+	throttle(className string) (throttled bool, reason string)
+	// health describes the health of the shard for the priority class.
+	health(className string) string
+	deallocateResources()
 }
 
 // NewTxThrottler tries to construct a TxThrottler from the
@@ -82,12 +128,12 @@ type TxThrottler struct {
 // any error occurs.
 // This function calls tryCreateTxThrottler that does the actual creation work
 // and returns an error if one occurred.
-func NewTxThrottler(config *tabletenv.TabletConfig, topoServer *topo.Server) *TxThrottler {
-	txThrottler, err := tryCreateTxThrottler(config, topoServer)
+func NewTxThrottler(env tabletenv.Env, topoServer *topo.Server, tabletThrottler TabletThrottler) *TxThrottler {
+	txThrottler, err := tryCreateTxThrottler(env, topoServer, tabletThrottler)
 	if err != nil {
 		log.Errorf("Error creating transaction throttler. Transaction throttling will"+
 			" be disabled. Error: %v", err)
-		txThrottler, err = newTxThrottler(&txThrottlerConfig{enabled: false})
+		txThrottler, err = newTxThrottler(env, &txThrottlerConfig{enabled: false})
 		if err != nil {
 			panic("BUG: Can't create a disabled transaction throttler")
 		}
@@ -102,9 +148,10 @@ func (t *TxThrottler) InitDBConfig(target *querypb.Target) {
 	t.target = proto.Clone(target).(*querypb.Target)
 }
 
-func tryCreateTxThrottler(config *tabletenv.TabletConfig, topoServer *topo.Server) (*TxThrottler, error) {
+func tryCreateTxThrottler(env tabletenv.Env, topoServer *topo.Server, tabletThrottler TabletThrottler) (*TxThrottler, error) {
+	config := env.Config()
 	if !config.EnableTxThrottler {
-		return newTxThrottler(&txThrottlerConfig{enabled: false})
+		return newTxThrottler(env, &txThrottlerConfig{enabled: false})
 	}
 
 	var throttlerConfig throttlerdatapb.Configuration
@@ -117,11 +164,16 @@ func tryCreateTxThrottler(config *tabletenv.TabletConfig, topoServer *topo.Serve
 	healthCheckCells := make([]string, len(config.TxThrottlerHealthCheckCells))
 	copy(healthCheckCells, config.TxThrottlerHealthCheckCells)
 
-	return newTxThrottler(&txThrottlerConfig{
+	return newTxThrottler(env, &txThrottlerConfig{
 		enabled:          true,
+		source:           config.TxThrottlerSource,
+		dryRun:           config.TxThrottlerDryRun,
 		topoServer:       topoServer,
 		throttlerConfig:  &throttlerConfig,
 		healthCheckCells: healthCheckCells,
+		tabletThrottler:  tabletThrottler,
+		defaultPriority:  config.TxThrottlerDefaultPriority,
+		classes:          config.TxThrottlerPriorityClasses,
 	})
 }
 
@@ -132,12 +184,27 @@ type txThrottlerConfig struct {
 	// of a disabled transaction throttler do nothing and Throttle() always
 	// returns false.
 	enabled bool
+	// source is where the health of the shard is read from, one of
+	// tabletenv.TxThrottlerSourceHealthCheck and tabletenv.TxThrottlerSourceTabletThrottler.
+	source string
+	// dryRun is true if transactions are not throttled, but only counted and logged.
+	dryRun bool
 
 	topoServer      *topo.Server
 	throttlerConfig *throttlerdatapb.Configuration
 	// healthCheckCells stores the cell names in which running vttablets will be monitored for
 	// replication lag.
 	healthCheckCells []string
+
+	tabletThrottler TabletThrottler
+	defaultPriority int
+	classes         []*tabletenv.TxThrottlerPriorityClassConfig
+}
+
+// TabletThrottler defines the public interface that is implemented by the tablet throttler,
+// go/vt/vttablet/tabletserver/throttle.Throttler. It is only used here to allow mocking it out.
+type TabletThrottler interface {
+	CheckByType(ctx context.Context, appName string, remoteAddr string, flags *throttle.CheckFlags, checkType throttle.ThrottleCheckType) *throttle.CheckResult
 }
 
 // ThrottlerInterface defines the public interface that is implemented by go/vt/throttler.Throttler
@@ -162,7 +229,8 @@ type TopologyWatcherInterface interface {
 	Stop()
 }
 
-// txThrottlerState holds the state of an open TxThrottler object.
+// txThrottlerState holds the state of an open TxThrottler object
+// that reads the replication lag of the replicas from their health checks.
 type txThrottlerState struct {
 	// throttleMu serializes calls to throttler.Throttler.Throttle(threadId).
 	// That method is required to be called in serial for each threadId.
@@ -204,20 +272,57 @@ func resetTxThrottlerFactories() {
 // go/vt/throttler.GlobalManager.
 const TxThrottlerName = "TransactionThrottler"
 
-func newTxThrottler(config *txThrottlerConfig) (*TxThrottler, error) {
+// TxThrottlerAppName is the name of the app as which the transactions are checked against
+// the tablet throttler. The transactions of a priority class are checked as the
+// "tx-throttler:<class>" app.
+const TxThrottlerAppName = "tx-throttler"
+
+func newTxThrottler(env tabletenv.Env, config *txThrottlerConfig) (*TxThrottler, error) {
 	if config.enabled {
 		// Verify config.
-		err := throttler.MaxReplicationLagModuleConfig{Configuration: config.throttlerConfig}.Verify()
-		if err != nil {
-			return nil, err
+		switch config.source {
+		case tabletenv.TxThrottlerSourceHealthCheck:
+			err := throttler.MaxReplicationLagModuleConfig{Configuration: config.throttlerConfig}.Verify()
+			if err != nil {
+				return nil, err
+			}
+			if len(config.healthCheckCells) == 0 {
+				return nil, fmt.Errorf("empty healthCheckCells given. %+v", config)
+			}
+		case tabletenv.TxThrottlerSourceTabletThrottler:
+			if config.tabletThrottler == nil {
+				return nil, fmt.Errorf("no tablet throttler given. %+v", config)
+			}
+		default:
+			return nil, fmt.Errorf("unknown transaction throttler source %v", config.source)
 		}
-		if len(config.healthCheckCells) == 0 {
-			return nil, fmt.Errorf("empty healthCheckCells given. %+v", config)
+	}
+	t := &TxThrottler{
+		config:  config,
+		classes: config.classes,
+		byUser:  make(map[string]*tabletenv.TxThrottlerPriorityClassConfig),
+		defaultClass: &tabletenv.TxThrottlerPriorityClassConfig{
+			Name:     tabletenv.TxThrottlerDefaultPriorityClass,
+			Priority: config.defaultPriority,
+		},
+		requests: env.Exporter().NewCountersWithSingleLabel(
+			"TransactionThrottlerRequests",
+			"Number of transactions of a priority class checked by the transaction throttler",
+			"class"),
+		throttled: env.Exporter().NewCountersWithSingleLabel(
+			"TransactionThrottlerThrottled",
+			"Number of transactions of a priority class throttled by the transaction throttler, or that would have been in dry-run mode",
+			"class"),
+		logDryRun: logutil.NewThrottledLogger("TxThrottler DryRun", 5*time.Second),
+	}
+	for _, class := range config.classes {
+		for _, user := range class.Users {
+			if _, ok := t.byUser[user]; !ok {
+				t.byUser[user] = class
+			}
 		}
 	}
-	return &TxThrottler{
-		config: config,
-	}, nil
+	return t, nil
 }
 
 // Open opens the transaction throttler. It must be called prior to 'Throttle'.
@@ -229,9 +334,20 @@ func (t *TxThrottler) Open() error {
 		return nil
 	}
 	log.Info("TxThrottler: opening")
+	var state shardHealth
 	var err error
-	t.state, err = newTxThrottlerState(t.config, t.target.Keyspace, t.target.Shard)
-	return err
+	if t.config.source == tabletenv.TxThrottlerSourceTabletThrottler {
+		state = newTabletThrottlerState(t.config, t.classNames())
+	} else {
+		state, err = newTxThrottlerState(t.config, t.target.Keyspace, t.target.Shard)
+		if err != nil {
+			return err
+		}
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.state = state
+	return nil
 }
 
 // Close closes the TxThrottler object and releases resources.
@@ -245,22 +361,65 @@ func (t *TxThrottler) Close() {
 		return
 	}
 	t.state.deallocateResources()
+	t.mu.Lock()
 	t.state = nil
+	t.mu.Unlock()
 	log.Info("TxThrottler: closed")
 }
 
 // Throttle should be called before a new transaction is started.
 // It returns true if the transaction should not proceed (the caller
-// should back off). Throttle requires that Open() was previously called
+// should back off). The priority class of the transaction is that of
+// its caller in ctx. Throttle requires that Open() was previously called
 // successfully.
-func (t *TxThrottler) Throttle() (result bool) {
+func (t *TxThrottler) Throttle(ctx context.Context) (result bool) {
 	if !t.config.enabled {
 		return false
 	}
 	if t.state == nil {
 		panic("BUG: Throttle() called on a closed TxThrottler")
 	}
-	return t.state.throttle()
+	class := t.classify(ctx)
+	t.requests.Add(class.Name, 1)
+	// Transactions that are not subject to throttling do not consult the
+	// state, so that they do not count against the rate of the wrapped throttler.
+	if rand.Intn(100) >= class.Priority {
+		return false
+	}
+	throttled, reason := t.state.throttle(class.Name)
+	if !throttled {
+		return false
+	}
+	t.throttled.Add(class.Name, 1)
+	if t.config.dryRun {
+		t.logDryRun.Infof("Would have throttled a transaction of priority class %v: %v", class.Name, reason)
+		return false
+	}
+	return true
+}
+
+// classify returns the priority class of the caller in ctx.
+func (t *TxThrottler) classify(ctx context.Context) *tabletenv.TxThrottlerPriorityClassConfig {
+	if principal := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx)); principal != "" {
+		if class, ok := t.byUser[principal]; ok {
+			return class
+		}
+	}
+	if username := callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx)); username != "" {
+		if class, ok := t.byUser[username]; ok {
+			return class
+		}
+	}
+	return t.defaultClass
+}
+
+// classNames returns the names of the priority classes, including the default one.
+func (t *TxThrottler) classNames() []string {
+	names := make([]string, 0, len(t.classes)+1)
+	for _, class := range t.classes {
+		names = append(names, class.Name)
+	}
+	return append(names, t.defaultClass.Name)
 }
 
 func newTxThrottlerState(config *txThrottlerConfig, keyspace, shard string,
@@ -300,14 +459,24 @@ func newTxThrottlerState(config *txThrottlerConfig, keyspace, shard string,
 	return result, nil
 }
 
-func (ts *txThrottlerState) throttle() bool {
+// throttle is part of the shardHealth interface. The replication lag
+// applies to all the priority classes alike.
+func (ts *txThrottlerState) throttle(className string) (bool, string) {
 	if ts.throttler == nil {
 		panic("BUG: throttle called after deallocateResources was called.")
 	}
 	// Serialize calls to ts.throttle.Throttle()
 	ts.throttleMu.Lock()
 	defer ts.throttleMu.Unlock()
-	return ts.throttler.Throttle(0 /* threadId */) > 0
+	if ts.throttler.Throttle(0 /* threadId */) > 0 {
+		return true, fmt.Sprintf("replication lag: rate limited to %v TPS", ts.throttler.MaxRate())
+	}
+	return false, ""
+}
+
+// health is part of the shardHealth interface.
+func (ts *txThrottlerState) health(className string) string {
+	return fmt.Sprintf("rate limited to %v TPS by replication lag", ts.throttler.MaxRate())
 }
 
 func (ts *txThrottlerState) deallocateResources() {
@@ -341,3 +510,205 @@ func (ts *txThrottlerState) StatsUpdate(tabletStats *discovery.LegacyTabletStats
 	}
 	ts.throttler.RecordReplicationLag(time.Now(), tabletStats)
 }
+
+// tabletThrottlerCheckInterval is how often the priority classes
+// are checked against the tablet throttler.
+const tabletThrottlerCheckInterval = 250 * time.Millisecond
+
+// tabletThrottlerState holds the state of an open TxThrottler object
+// that reads the metrics of the tablet throttler.
+//
+// Checking the tablet throttler on each transaction would be expensive,
+// so the state checks each priority class in the background, and
+// throttles according to the latest results.
+type tabletThrottlerState struct {
+	tabletThrottler TabletThrottler
+	classNames      []string
+	cancel          context.CancelFunc
+	wg              sync.WaitGroup
+
+	// mu protects results, which holds for each priority class
+	// the result of its latest check.
+	mu      sync.Mutex
+	results map[string]*throttle.CheckResult
+}
+
+func newTabletThrottlerState(config *txThrottlerConfig, classNames []string) *tabletThrottlerState {
+	ctx, cancel := context.WithCancel(context.Background())
+	ts := &tabletThrottlerState{
+		tabletThrottler: config.tabletThrottler,
+		classNames:      classNames,
+		cancel:          cancel,
+		results:         make(map[string]*throttle.CheckResult, len(classNames)),
+	}
+	// Check once before the first transactions arrive.
+	ts.checkClasses(ctx)
+	ts.wg.Add(1)
+	go ts.run(ctx)
+	return ts
+}
+
+func (ts *tabletThrottlerState) run(ctx context.Context) {
+	defer ts.wg.Done()
+	ticker := time.NewTicker(tabletThrottlerCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ts.checkClasses(ctx)
+		}
+	}
+}
+
+// checkClasses checks each priority class against both the replicas of the shard,
+// whose lag the tablet throttler aggregates, and this very primary, whose metrics
+// include the semi-sync ack latency.
+func (ts *tabletThrottlerState) checkClasses(ctx context.Context) {
+	for _, className := range ts.classNames {
+		appName := TxThrottlerAppName + ":" + className
+		result := ts.tabletThrottler.CheckByType(ctx, appName, "", throttle.StandardCheckFlags, throttle.ThrottleCheckPrimaryWrite)
+		if !isThrottled(result) {
+			if selfResult := ts.tabletThrottler.CheckByType(ctx, appName, "", throttle.StandardCheckFlags, throttle.ThrottleCheckSelf); isThrottled(selfResult) {
+				result = selfResult
+			}
+		}
+		ts.mu.Lock()
+		ts.results[className] = result
+		ts.mu.Unlock()
+	}
+}
+
+// isThrottled returns true if the check result rejects the app, because a metric
+// exceeds its threshold or because the app is explicitly throttled. Other errors,
+// such as metrics that were not collected yet, do not throttle transactions.
+func isThrottled(result *throttle.CheckResult) bool {
+	return result.StatusCode == http.StatusTooManyRequests || result.StatusCode == http.StatusExpectationFailed
+}
+
+// describeCheckResult describes a check result for humans.
+func describeCheckResult(result *throttle.CheckResult) string {
+	if result == nil {
+		return "not checked yet"
+	}
+	if result.MetricName == "" {
+		return fmt.Sprintf("status %d %v", result.StatusCode, result.Message)
+	}
+	description := fmt.Sprintf("status %d: %v = %v, threshold %v", result.StatusCode, result.MetricName, result.Value, result.Threshold)
+	if result.Message != "" {
+		description += ": " + result.Message
+	}
+	return description
+}
+
+// throttle is part of the shardHealth interface.
+func (ts *tabletThrottlerState) throttle(className string) (bool, string) {
+	ts.mu.Lock()
+	result := ts.results[className]
+	ts.mu.Unlock()
+	if result == nil || !isThrottled(result) {
+		return false, ""
+	}
+	return true, describeCheckResult(result)
+}
+
+// health is part of the shardHealth interface.
+func (ts *tabletThrottlerState) health(className string) string {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return describeCheckResult(ts.results[className])
+}
+
+func (ts *tabletThrottlerState) deallocateResources() {
+	ts.cancel()
+	ts.wg.Wait()
+}
+
+// ClassStatus is the status of a priority class, as reported in /debug/status.
+type ClassStatus struct {
+	Name      string
+	Priority  int
+	Users     []string
+	Requests  int64
+	Throttled int64
+	// Health describes the health of the shard for the class.
+	Health string
+}
+
+// Status is the status of the transaction throttler, as reported in /debug/status.
+type Status struct {
+	Enabled bool
+	Open    bool
+	Source  string
+	DryRun  bool
+	Classes []ClassStatus
+}
+
+// Status returns the status of the transaction throttler.
+func (t *TxThrottler) Status() *Status {
+	status := &Status{
+		Enabled: t.config.enabled,
+		Source:  t.config.source,
+		DryRun:  t.config.dryRun,
+	}
+	if !t.config.enabled {
+		return status
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	status.Open = t.state != nil
+	requests := t.requests.Counts()
+	throttled := t.throttled.Counts()
+	// The classes are copied, since the configured slice is shared.
+	classes := make([]*tabletenv.TxThrottlerPriorityClassConfig, 0, len(t.classes)+1)
+	classes = append(classes, t.classes...)
+	classes = append(classes, t.defaultClass)
+	for _, class := range classes {
+		classStatus := ClassStatus{
+			Name:      class.Name,
+			Priority:  class.Priority,
+			Users:     class.Users,
+			Requests:  requests[class.Name],
+			Throttled: throttled[class.Name],
+		}
+		if t.state != nil {
+			classStatus.Health = t.state.health(class.Name)
+		}
+		status.Classes = append(status.Classes, classStatus)
+	}
+	return status
+}
+
+// StatusTemplate is the template of the status of the transaction
+// throttler in /debug/status.
+const StatusTemplate = `
+{{if .Enabled}}
+<p>
+  Source: {{.Source}}{{if .DryRun}} (dry-run: transactions are not throttled){{end}}<br>
+  {{if not .Open}}Closed<br>{{end}}
+</p>
+<table>
+  <tr>
+    <th>Priority Class</th>
+    <th>Priority</th>
+    <th>Users</th>
+    <th>Transactions</th>
+    <th>Throttled</th>
+    <th>Health</th>
+  </tr>
+  {{range .Classes}}
+  <tr>
+    <td>{{.Name}}</td>
+    <td>{{.Priority}}</td>
+    <td>{{range $i, $user := .Users}}{{if $i}}, {{end}}{{$user}}{{end}}</td>
+    <td>{{.Requests}}</td>
+    <td>{{.Throttled}}</td>
+    <td>{{.Health}}</td>
+  </tr>
+  {{end}}
+</table>
+{{else}}
+Disabled
+{{end}}
+`
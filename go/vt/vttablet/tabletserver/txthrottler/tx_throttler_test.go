@@ -22,15 +22,24 @@ package txthrottler
 //go:generate mockgen -destination mock_topology_watcher_test.go -package txthrottler vitess.io/vitess/go/vt/vttablet/tabletserver/txthrottler TopologyWatcherInterface
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -39,7 +48,7 @@ import (
 func TestDisabledThrottler(t *testing.T) {
 	config := tabletenv.NewDefaultConfig()
 	config.EnableTxThrottler = false
-	throttler := NewTxThrottler(config, nil)
+	throttler := NewTxThrottler(tabletenv.NewEnv(config, "TxThrottlerDisabledTest"), nil, nil)
 	throttler.InitDBConfig(&querypb.Target{
 		Keyspace: "keyspace",
 		Shard:    "shard",
@@ -47,7 +56,7 @@ func TestDisabledThrottler(t *testing.T) {
 	if err := throttler.Open(); err != nil {
 		t.Fatalf("want: nil, got: %v", err)
 	}
-	if result := throttler.Throttle(); result != false {
+	if result := throttler.Throttle(context.Background()); result != false {
 		t.Errorf("want: false, got: %v", result)
 	}
 	throttler.Close()
@@ -108,17 +117,20 @@ func TestEnabledThrottler(t *testing.T) {
 	call2 := mockThrottler.EXPECT().RecordReplicationLag(gomock.Any(), tabletStats)
 	call3 := mockThrottler.EXPECT().Throttle(0)
 	call3.Return(1 * time.Second)
-	call4 := mockThrottler.EXPECT().Close()
+	call4 := mockThrottler.EXPECT().MaxRate()
+	call4.Return(int64(10))
+	call5 := mockThrottler.EXPECT().Close()
 	call1.After(call0)
 	call2.After(call1)
 	call3.After(call2)
 	call4.After(call3)
+	call5.After(call4)
 
 	config := tabletenv.NewDefaultConfig()
 	config.EnableTxThrottler = true
 	config.TxThrottlerHealthCheckCells = []string{"cell1", "cell2"}
 
-	throttler, err := tryCreateTxThrottler(config, ts)
+	throttler, err := tryCreateTxThrottler(tabletenv.NewEnv(config, "TxThrottlerEnabledTest"), ts, nil)
 	if err != nil {
 		t.Fatalf("want: nil, got: %v", err)
 	}
@@ -129,7 +141,7 @@ func TestEnabledThrottler(t *testing.T) {
 	if err := throttler.Open(); err != nil {
 		t.Fatalf("want: nil, got: %v", err)
 	}
-	if result := throttler.Throttle(); result != false {
+	if result := throttler.Throttle(context.Background()); result != false {
 		t.Errorf("want: false, got: %v", result)
 	}
 	hcListener.StatsUpdate(tabletStats)
@@ -141,8 +153,162 @@ func TestEnabledThrottler(t *testing.T) {
 	// This call should not be forwarded to the go/vt/throttler.Throttler object.
 	hcListener.StatsUpdate(rdonlyTabletStats)
 	// The second throttle call should reject.
-	if result := throttler.Throttle(); result != true {
+	if result := throttler.Throttle(context.Background()); result != true {
 		t.Errorf("want: true, got: %v", result)
 	}
 	throttler.Close()
 }
+
+// fakeTabletThrottler returns the check results that were set for an app
+// and a check type, and OK results otherwise.
+type fakeTabletThrottler struct {
+	mu      sync.Mutex
+	results map[string]*throttle.CheckResult
+}
+
+func newFakeTabletThrottler() *fakeTabletThrottler {
+	return &fakeTabletThrottler{results: make(map[string]*throttle.CheckResult)}
+}
+
+func (f *fakeTabletThrottler) CheckByType(ctx context.Context, appName string, remoteAddr string, flags *throttle.CheckFlags, checkType throttle.ThrottleCheckType) *throttle.CheckResult {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if result, ok := f.results[fmt.Sprintf("%s/%d", appName, checkType)]; ok {
+		return result
+	}
+	return throttle.NewCheckResult(http.StatusOK, 0.1, 1, nil)
+}
+
+func (f *fakeTabletThrottler) set(appName string, checkType throttle.ThrottleCheckType, result *throttle.CheckResult) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.results[fmt.Sprintf("%s/%d", appName, checkType)] = result
+}
+
+func newThresholdExceededResult(metricName string, value, threshold float64) *throttle.CheckResult {
+	result := throttle.NewCheckResult(http.StatusTooManyRequests, value, threshold, base.ErrThresholdExceeded)
+	result.MetricName = metricName
+	return result
+}
+
+func newTabletThrottlerSourceThrottler(t *testing.T, name string, tabletThrottler TabletThrottler, dryRun bool) *TxThrottler {
+	t.Helper()
+	config := tabletenv.NewDefaultConfig()
+	config.EnableTxThrottler = true
+	config.EnableLagThrottler = true
+	config.TxThrottlerSource = tabletenv.TxThrottlerSourceTabletThrottler
+	config.TxThrottlerDryRun = dryRun
+	config.TxThrottlerPriorityClasses = []*tabletenv.TxThrottlerPriorityClassConfig{{
+		Name:     "critical",
+		Users:    []string{"payments"},
+		Priority: 0,
+	}, {
+		Name:     "batch",
+		Users:    []string{"reporter"},
+		Priority: 100,
+	}}
+	require.NoError(t, config.Verify())
+
+	throttler, err := tryCreateTxThrottler(tabletenv.NewEnv(config, name), nil, tabletThrottler)
+	require.NoError(t, err)
+	throttler.InitDBConfig(&querypb.Target{
+		Keyspace: "keyspace",
+		Shard:    "shard",
+	})
+	require.NoError(t, throttler.Open())
+	return throttler
+}
+
+// recheck makes the throttler see the latest results of the tablet throttler.
+func recheck(throttler *TxThrottler) {
+	throttler.state.(*tabletThrottlerState).checkClasses(context.Background())
+}
+
+func TestTabletThrottlerSource(t *testing.T) {
+	tabletThrottler := newFakeTabletThrottler()
+	throttler := newTabletThrottlerSourceThrottler(t, "TxThrottlerTabletThrottlerTest", tabletThrottler, false)
+	defer throttler.Close()
+
+	ctx := context.Background()
+	paymentsCtx := callerid.NewContext(ctx, callerid.NewEffectiveCallerID("payments", "", ""), nil)
+	reporterCtx := callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("reporter"))
+
+	assert.False(t, throttler.Throttle(ctx))
+	assert.False(t, throttler.Throttle(reporterCtx))
+
+	// The replicas lag behind.
+	tabletThrottler.set("tx-throttler:default", throttle.ThrottleCheckPrimaryWrite, newThresholdExceededResult(base.LagMetricName, 5, 2))
+	recheck(throttler)
+	assert.True(t, throttler.Throttle(ctx))
+	// Other classes may have higher thresholds.
+	assert.False(t, throttler.Throttle(reporterCtx))
+
+	// The semi-sync replicas are slow to acknowledge transactions.
+	tabletThrottler.set("tx-throttler:batch", throttle.ThrottleCheckSelf, newThresholdExceededResult(base.SemiSyncAckLatencyMetricName, 0.2, 0.05))
+	tabletThrottler.set("tx-throttler:critical", throttle.ThrottleCheckSelf, newThresholdExceededResult(base.SemiSyncAckLatencyMetricName, 0.2, 0.05))
+	recheck(throttler)
+	assert.True(t, throttler.Throttle(reporterCtx))
+	// Transactions of priority 0 are never throttled.
+	assert.False(t, throttler.Throttle(paymentsCtx))
+
+	// Metrics that are not collected yet do not throttle.
+	tabletThrottler.set("tx-throttler:default", throttle.ThrottleCheckPrimaryWrite, throttle.NoSuchMetricCheckResult)
+	recheck(throttler)
+	assert.False(t, throttler.Throttle(ctx))
+
+	status := throttler.Status()
+	assert.True(t, status.Enabled)
+	assert.True(t, status.Open)
+	assert.Equal(t, "tablet_throttler", status.Source)
+	require.Len(t, status.Classes, 3)
+	assert.Equal(t, ClassStatus{
+		Name:      "critical",
+		Priority:  0,
+		Users:     []string{"payments"},
+		Requests:  1,
+		Throttled: 0,
+		Health:    "status 429: semi_sync_ack_latency = 0.2, threshold 0.05: Threshold exceeded",
+	}, status.Classes[0])
+	assert.Equal(t, ClassStatus{
+		Name:      "batch",
+		Priority:  100,
+		Users:     []string{"reporter"},
+		Requests:  3,
+		Throttled: 1,
+		Health:    "status 429: semi_sync_ack_latency = 0.2, threshold 0.05: Threshold exceeded",
+	}, status.Classes[1])
+	assert.Equal(t, "default", status.Classes[2].Name)
+	assert.EqualValues(t, 3, status.Classes[2].Requests)
+	assert.EqualValues(t, 1, status.Classes[2].Throttled)
+	assert.Equal(t, "status 404 No such metric", status.Classes[2].Health)
+}
+
+func TestStatusKeepsClasses(t *testing.T) {
+	tabletThrottler := newFakeTabletThrottler()
+	throttler := newTabletThrottlerSourceThrottler(t, "TxThrottlerStatusClassesTest", tabletThrottler, false)
+	defer throttler.Close()
+
+	// The configured classes may have spare capacity, which Status must not
+	// write the default class into.
+	classes := make([]*tabletenv.TxThrottlerPriorityClassConfig, len(throttler.classes), len(throttler.classes)+1)
+	copy(classes, throttler.classes)
+	throttler.classes = classes
+
+	require.Len(t, throttler.Status().Classes, 3)
+	assert.Nil(t, classes[:cap(classes)][len(classes)])
+}
+
+func TestTabletThrottlerSourceDryRun(t *testing.T) {
+	tabletThrottler := newFakeTabletThrottler()
+	throttler := newTabletThrottlerSourceThrottler(t, "TxThrottlerDryRunTest", tabletThrottler, true)
+	defer throttler.Close()
+
+	tabletThrottler.set("tx-throttler:default", throttle.ThrottleCheckPrimaryWrite, newThresholdExceededResult(base.LagMetricName, 5, 2))
+	recheck(throttler)
+	assert.False(t, throttler.Throttle(context.Background()))
+	assert.EqualValues(t, 1, throttler.throttled.Counts()["default"])
+	assert.True(t, throttler.Status().DryRun)
+
+	throttler.Close()
+	assert.False(t, throttler.Status().Open)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package vstreamer
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -74,6 +75,9 @@ const (
 	GreaterThanEqual
 	// NotEqual is used to filter a comparable column if != specific value
 	NotEqual
+	// Expression is used to filter the rows for which an expression
+	// evaluated by evalengine is not true
+	Expression
 )
 
 // Filter contains opcodes for filtering.
@@ -90,6 +94,10 @@ type Filter struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 	KeyRange      *topodatapb.KeyRange
+
+	// Expr is the condition of an Expression filter. Its columns
+	// are the columns of the table.
+	Expr evalengine.Expr
 }
 
 // ColExpr represents a column expression.
@@ -107,6 +115,10 @@ type ColExpr struct {
 	Field *querypb.Field
 
 	FixedValue sqltypes.Value
+
+	// Expr, if set, is evaluated to generate the value, which is then
+	// cast to the type of Field. If so, ColNum is ignored.
+	Expr evalengine.Expr
 }
 
 // Table contains the metadata for a table.
@@ -220,6 +232,14 @@ func (plan *Plan) filter(values, result []sqltypes.Value) (bool, error) {
 			if !key.KeyRangeContains(filter.KeyRange, ksid) {
 				return false, nil
 			}
+		case Expression:
+			match, err := evaluateCondition(filter.Expr, values)
+			if err != nil {
+				return false, err
+			}
+			if !match {
+				return false, nil
+			}
 		default:
 			match, err := compare(filter.Opcode, values[filter.ColNum], filter.Value)
 			if err != nil {
@@ -231,6 +251,16 @@ func (plan *Plan) filter(values, result []sqltypes.Value) (bool, error) {
 		}
 	}
 	for i, colExpr := range plan.ColExprs {
+		if colExpr.Expr != nil {
+			res, err := colExpr.Expr.Evaluate(&evalengine.ExpressionEnv{Row: values})
+			if err != nil {
+				return false, err
+			}
+			if result[i], err = evalengine.Cast(res.Value(), colExpr.Field.Type); err != nil {
+				return false, err
+			}
+			continue
+		}
 		if colExpr.ColNum == -1 {
 			result[i] = colExpr.FixedValue
 			continue
@@ -251,6 +281,16 @@ func (plan *Plan) filter(values, result []sqltypes.Value) (bool, error) {
 	return true, nil
 }
 
+// evaluateCondition returns true if the condition is true for the row.
+// Like in a MySQL where clause, NULL is not true.
+func evaluateCondition(expr evalengine.Expr, values []sqltypes.Value) (bool, error) {
+	res, err := expr.Evaluate(&evalengine.ExpressionEnv{Row: values})
+	if err != nil {
+		return false, err
+	}
+	return res.IsTrue(), nil
+}
+
 func getKeyspaceID(values []sqltypes.Value, vindex vindexes.Vindex, vindexColumns []int, fields []*querypb.Field) (key.DestinationKeyspaceID, error) {
 	vindexValues := make([]sqltypes.Value, 0, len(vindexColumns))
 	for _, col := range vindexColumns {
@@ -481,56 +521,205 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	for _, expr := range exprs {
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
-			opcode, err := getOpcode(expr)
+			filter, ok, err := plan.analyzeComparison(expr)
 			if err != nil {
 				return err
 			}
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
 			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
-			}
-			colnum, err := findColumn(plan.Table, qualifiedName.Name)
-			if err != nil {
-				return err
-			}
-			val, ok := expr.Right.(*sqlparser.Literal)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			pv, err := sqlparser.NewPlanValue(val)
-			if err != nil {
-				return err
-			}
-			resolved, err := pv.ResolveValue(nil)
-			if err != nil {
-				return err
+				if err := plan.analyzeCondition(expr); err != nil {
+					return err
+				}
+				continue
 			}
-			plan.Filters = append(plan.Filters, Filter{
-				Opcode: opcode,
-				ColNum: colnum,
-				Value:  resolved,
-			})
+			plan.Filters = append(plan.Filters, filter)
 		case *sqlparser.FuncExpr:
 			if !expr.Name.EqualString("in_keyrange") {
-				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+				if err := plan.analyzeCondition(expr); err != nil {
+					return err
+				}
+				continue
 			}
 			if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
 				return err
 			}
 		default:
+			if err := plan.analyzeCondition(expr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// analyzeComparison builds the filter of a comparison between a column
+// and a literal. It returns false if the comparison has another form.
+func (plan *Plan) analyzeComparison(expr *sqlparser.ComparisonExpr) (Filter, bool, error) {
+	opcode, err := getOpcode(expr)
+	if err != nil {
+		return Filter{}, false, nil
+	}
+	qualifiedName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return Filter{}, false, nil
+	}
+	val, ok := expr.Right.(*sqlparser.Literal)
+	if !ok {
+		return Filter{}, false, nil
+	}
+	//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
+	if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
+		return Filter{}, false, nil
+	}
+	if !qualifiedName.Qualifier.IsEmpty() {
+		return Filter{}, false, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+	}
+	colnum, err := findColumn(plan.Table, qualifiedName.Name)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	pv, err := sqlparser.NewPlanValue(val)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	resolved, err := pv.ResolveValue(nil)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	return Filter{
+		Opcode: opcode,
+		ColNum: colnum,
+		Value:  resolved,
+	}, true, nil
+}
+
+// analyzeCondition adds an Expression filter for the conditions that
+// evalengine can evaluate, like OR trees, IN lists, IS NULL or LIKE.
+func (plan *Plan) analyzeCondition(expr sqlparser.Expr) error {
+	cond, err := plan.convertExpr(expr)
+	if err != nil {
+		if err == errUnsupportedExpr {
 			return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
 		}
+		return err
 	}
+	plan.Filters = append(plan.Filters, Filter{
+		Opcode: Expression,
+		Expr:   cond,
+	})
 	return nil
 }
 
+// errUnsupportedExpr is returned by convertExpr for the expressions
+// that evalengine cannot evaluate.
+var errUnsupportedExpr = errors.New("unsupported expression")
+
+// convertExpr converts an expression on the columns of the table to
+// an evalengine expression.
+func (plan *Plan) convertExpr(expr sqlparser.Expr) (evalengine.Expr, error) {
+	lookup := &columnLookup{table: plan.Table}
+	converted, err := evalengine.Convert(expr, lookup)
+	if lookup.err != nil {
+		return nil, lookup.err
+	}
+	if err != nil {
+		log.Infof("Cannot convert %v: %v", sqlparser.String(expr), err)
+		return nil, errUnsupportedExpr
+	}
+	return converted, nil
+}
+
+// columnLookup resolves the columns of the expressions converted by
+// evalengine to the columns of the table.
+type columnLookup struct {
+	table *Table
+	err   error
+}
+
+var _ evalengine.ConverterLookup = (*columnLookup)(nil)
+
+// ColumnLookup implements the evalengine.ConverterLookup interface.
+func (cl *columnLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	if !col.Qualifier.IsEmpty() {
+		cl.err = fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(col))
+		return 0, cl.err
+	}
+	colnum, err := findColumn(cl.table, col.Name)
+	if err != nil {
+		cl.err = err
+		return 0, err
+	}
+	return colnum, nil
+}
+
+// CollationIDLookup implements the evalengine.ConverterLookup interface.
+// The columns use their own collation, or the binary collation if they
+// have none, and the literals use the default collation.
+func (cl *columnLookup) CollationIDLookup(expr sqlparser.Expr) collations.ID {
+	if col, ok := expr.(*sqlparser.ColName); ok {
+		if colnum, err := findColumn(cl.table, col.Name); err == nil {
+			if charset := cl.table.Fields[colnum].Charset; charset != 0 {
+				return collations.ID(charset)
+			}
+			return collations.CollationBinaryID
+		}
+	}
+	return collations.Local().DefaultCollationForCharset("utf8mb4").ID()
+}
+
+// exprType returns the type of the values of a projected expression.
+// evalengine can only type an expression by evaluating it, so the type
+// is derived from the kind of expression, and the values are cast to it.
+func exprType(expr evalengine.Expr, fields []*querypb.Field) querypb.Type {
+	switch expr := expr.(type) {
+	case *evalengine.Column:
+		return fields[expr.Offset].Type
+	case *evalengine.Literal:
+		if typ, err := expr.Type(nil); err == nil && typ != sqltypes.Null {
+			return typ
+		}
+	case *evalengine.ComparisonExpr, *evalengine.LogicalExpr, *evalengine.NotExpr, *evalengine.IsExpr:
+		return sqltypes.Int64
+	case *evalengine.BinaryExpr:
+		return arithmeticType(expr, fields)
+	}
+	return sqltypes.VarBinary
+}
+
+// arithmeticType returns the type of the result of an arithmetic expression.
+// Like evalengine, it divides as floats, keeps integer arithmetic integral,
+// signed unless an operand is unsigned, and falls back to floats for
+// operands that are not numbers.
+func arithmeticType(expr *evalengine.BinaryExpr, fields []*querypb.Field) querypb.Type {
+	if _, ok := expr.Op.(*evalengine.Division); ok {
+		return sqltypes.Float64
+	}
+	ltype := numericType(exprType(expr.Left, fields))
+	rtype := numericType(exprType(expr.Right, fields))
+	switch {
+	case ltype == sqltypes.Float64 || rtype == sqltypes.Float64:
+		return sqltypes.Float64
+	case ltype == sqltypes.Decimal || rtype == sqltypes.Decimal:
+		return sqltypes.Decimal
+	case ltype == sqltypes.Uint64 || rtype == sqltypes.Uint64:
+		return sqltypes.Uint64
+	}
+	return sqltypes.Int64
+}
+
+// numericType returns the type an operand of an arithmetic expression
+// is evaluated as.
+func numericType(typ querypb.Type) querypb.Type {
+	switch {
+	case sqltypes.IsSigned(typ):
+		return sqltypes.Int64
+	case sqltypes.IsUnsigned(typ):
+		return sqltypes.Uint64
+	case typ == sqltypes.Decimal:
+		return sqltypes.Decimal
+	}
+	return sqltypes.Float64
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -592,7 +781,11 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 		}, nil
 	case *sqlparser.FuncExpr:
 		if inner.Name.Lowered() != "keyspace_id" {
-			return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			cExpr, err := plan.analyzeEvalExpr(aliased)
+			if err == errUnsupportedExpr {
+				return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			}
+			return cExpr, err
 		}
 		if len(inner.Exprs) != 0 {
 			return ColExpr{}, fmt.Errorf("unexpected: %v", sqlparser.String(inner))
@@ -645,11 +838,35 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			Field:  field,
 		}, nil
 	default:
-		log.Infof("Unsupported expression: %v", inner)
-		return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
+		cExpr, err := plan.analyzeEvalExpr(aliased)
+		if err == errUnsupportedExpr {
+			log.Infof("Unsupported expression: %v", inner)
+			return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
+		}
+		return cExpr, err
 	}
 }
 
+// analyzeEvalExpr builds a column whose value is computed by evalengine.
+func (plan *Plan) analyzeEvalExpr(aliased *sqlparser.AliasedExpr) (ColExpr, error) {
+	expr, err := plan.convertExpr(aliased.Expr)
+	if err != nil {
+		return ColExpr{}, err
+	}
+	as := aliased.As
+	if as.IsEmpty() {
+		as = sqlparser.NewColIdent(sqlparser.String(aliased.Expr))
+	}
+	return ColExpr{
+		ColNum: -1,
+		Field: &querypb.Field{
+			Name: as.String(),
+			Type: exprType(expr, plan.Table.Fields),
+		},
+		Expr: expr,
+	}, nil
+}
+
 // analyzeInKeyRange allows the following constructs: "in_keyrange('-80')",
 // "in_keyrange(col, 'hash', '-80')", "in_keyrange(col, 'local_vindex', '-80')", or
 // "in_keyrange(col, 'ks.external_vindex', '-80')".
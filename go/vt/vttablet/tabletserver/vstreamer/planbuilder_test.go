@@ -536,8 +536,8 @@ func TestPlanBuilder(t *testing.T) {
 		outErr:  `unsupported function: max(val)`,
 	}, {
 		inTable: t1,
-		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+1, val from t1"},
-		outErr:  `unsupported: id + 1`,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id << 1, val from t1"},
+		outErr:  `unsupported: id << 1`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select t1.id, val from t1"},
@@ -697,3 +697,122 @@ func TestCompare(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanFilterExpressions(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "val",
+			Type: sqltypes.VarBinary,
+		}, {
+			Name:    "name",
+			Type:    sqltypes.VarChar,
+			Charset: 45, // utf8mb4_general_ci
+		}, {
+			Name: "uid",
+			Type: sqltypes.Uint64,
+		}, {
+			Name: "price",
+			Type: sqltypes.Decimal,
+		}},
+	}
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarBinary("a"), sqltypes.NewVarChar("Alice"), sqltypes.NewUint64(18446744073709551614), sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.5"))},
+		{sqltypes.NewInt64(2), sqltypes.NULL, sqltypes.NewVarChar("bob"), sqltypes.NewUint64(1), sqltypes.NULL},
+		{sqltypes.NewInt64(3), sqltypes.NewVarBinary("c"), sqltypes.NULL, sqltypes.NewUint64(2), sqltypes.NULL},
+	}
+	testcases := []struct {
+		inFilter  string
+		outFields []*querypb.Field
+		outRows   [][]sqltypes.Value
+		outErr    string
+	}{{
+		inFilter: "select id from t1 where id = 1 or val = 'c'",
+		outRows:  [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(3)}},
+	}, {
+		inFilter: "select id from t1 where id in (2, 3) and (val is null or id > 2)",
+		outRows:  [][]sqltypes.Value{{sqltypes.NewInt64(2)}, {sqltypes.NewInt64(3)}},
+	}, {
+		inFilter: "select id from t1 where name is not null and id <= 2",
+		outRows:  [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(2)}},
+	}, {
+		// The varchar columns are compared with their collation.
+		inFilter: "select id from t1 where name like 'B%'",
+		outRows:  [][]sqltypes.Value{{sqltypes.NewInt64(2)}},
+	}, {
+		inFilter: "select id from t1 where name in ('ALICE', 'carol') or val in ('C', 'c')",
+		outRows:  [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(3)}},
+	}, {
+		inFilter: "select id from t1 where id not in (1, 2)",
+		outRows:  [][]sqltypes.Value{{sqltypes.NewInt64(3)}},
+	}, {
+		inFilter: "select id from t1 where 1 = id",
+		outRows:  [][]sqltypes.Value{{sqltypes.NewInt64(1)}},
+	}, {
+		inFilter: "select id, id * 10 as id10, concat(name, '!') as greeting, val is null from t1 where id < 3",
+		outFields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+			{Name: "id10", Type: sqltypes.Int64},
+			{Name: "greeting", Type: sqltypes.VarBinary},
+			{Name: "val is null", Type: sqltypes.Int64},
+		},
+		outRows: [][]sqltypes.Value{
+			{sqltypes.NewInt64(1), sqltypes.NewInt64(10), sqltypes.NewVarBinary("Alice!"), sqltypes.NewInt64(0)},
+			{sqltypes.NewInt64(2), sqltypes.NewInt64(20), sqltypes.NewVarBinary("bob!"), sqltypes.NewInt64(1)},
+		},
+	}, {
+		// The arithmetic keeps the precision of the operands.
+		inFilter: "select id + 9007199254740993 as big, uid + 1 as uid, price + 1 as price, id / 2 as half, id + 0.5 as fraction from t1 where id = 1",
+		outFields: []*querypb.Field{
+			{Name: "big", Type: sqltypes.Int64},
+			{Name: "uid", Type: sqltypes.Uint64},
+			{Name: "price", Type: sqltypes.Decimal},
+			{Name: "half", Type: sqltypes.Float64},
+			{Name: "fraction", Type: sqltypes.Float64},
+		},
+		outRows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(9007199254740994),
+			sqltypes.NewUint64(18446744073709551615),
+			sqltypes.MakeTrusted(sqltypes.Decimal, []byte("2.5")),
+			sqltypes.NewFloat64(0.5),
+			sqltypes.NewFloat64(1.5),
+		}},
+	}, {
+		inFilter: "select id from t1 where none is null",
+		outErr:   "column `none` not found in table t1",
+	}, {
+		inFilter: "select id from t1 where t1.id = 1 or id = 2",
+		outErr:   "unsupported qualifier for column: t1.id",
+	}, {
+		inFilter: "select id from t1 where id = 1 or in_keyrange('-80')",
+		outErr:   "unsupported constraint: id = 1 or in_keyrange('-80')",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.inFilter, func(t *testing.T) {
+			plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.inFilter}},
+			})
+			if tcase.outErr != "" {
+				assert.EqualError(t, err, tcase.outErr)
+				return
+			}
+			require.NoError(t, err)
+			if tcase.outFields != nil {
+				utils.MustMatch(t, tcase.outFields, plan.fields())
+			}
+			var got [][]sqltypes.Value
+			for _, row := range rows {
+				result := make([]sqltypes.Value, len(plan.ColExprs))
+				ok, err := plan.filter(row, result)
+				require.NoError(t, err)
+				if ok {
+					got = append(got, result)
+				}
+			}
+			utils.MustMatch(t, tcase.outRows, got)
+		})
+	}
+}
@@ -18,7 +18,9 @@ package vstreamer
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -70,6 +72,9 @@ type rowStreamer struct {
 	sendQuery     string
 	vse           *Engine
 	pktsize       PacketSizer
+
+	// endpk is the last pk to stream, if the endpk directive is set.
+	endpk []sqltypes.Value
 }
 
 func newRowStreamer(ctx context.Context, cp dbconfigs.Connector, se *schema.Engine, query string, lastpk []sqltypes.Value, vschema *localVSchema, send func(*binlogdatapb.VStreamRowsResponse) error, vse *Engine) *rowStreamer {
@@ -150,6 +155,12 @@ func (rs *rowStreamer) buildPlan() error {
 	if err != nil {
 		return err
 	}
+	if s := directives.GetString("endpk", ""); s != "" {
+		rs.endpk, err = rs.buildEndPK(s)
+		if err != nil {
+			return err
+		}
+	}
 	rs.sendQuery, err = rs.buildSelect()
 	if err != nil {
 		return err
@@ -212,24 +223,18 @@ func (rs *rowStreamer) buildSelect() (string, error) {
 			return "", fmt.Errorf("primary key values don't match length: %v vs %v", rs.lastpk, rs.pkColumns)
 		}
 		buf.WriteString(" where ")
-		prefix := ""
-		// This loop handles the case for composite pks. For example,
-		// if lastpk was (1,2), the where clause would be:
-		// (col1 = 1 and col2 > 2) or (col1 > 1).
-		// A tuple inequality like (col1,col2) > (1,2) ends up
-		// being a full table scan for mysql.
-		for lastcol := len(rs.pkColumns) - 1; lastcol >= 0; lastcol-- {
-			buf.Myprintf("%s(", prefix)
-			prefix = " or "
-			for i, pk := range rs.pkColumns[:lastcol] {
-				buf.Myprintf("%v = ", sqlparser.NewColIdent(rs.plan.Table.Fields[pk].Name))
-				rs.lastpk[i].EncodeSQL(buf)
-				buf.Myprintf(" and ")
-			}
-			buf.Myprintf("%v > ", sqlparser.NewColIdent(rs.plan.Table.Fields[rs.pkColumns[lastcol]].Name))
-			rs.lastpk[lastcol].EncodeSQL(buf)
-			buf.Myprintf(")")
+		if len(rs.endpk) != 0 {
+			buf.WriteString("(")
 		}
+		rs.writePKCondition(buf, rs.lastpk, ">", ">")
+		if len(rs.endpk) != 0 {
+			buf.WriteString(") and (")
+			rs.writePKCondition(buf, rs.endpk, "<", "<=")
+			buf.WriteString(")")
+		}
+	} else if len(rs.endpk) != 0 {
+		buf.WriteString(" where ")
+		rs.writePKCondition(buf, rs.endpk, "<", "<=")
 	}
 	buf.Myprintf(" order by ", sqlparser.NewTableIdent(rs.plan.Table.Name))
 	prefix = ""
@@ -240,6 +245,52 @@ func (rs *rowStreamer) buildSelect() (string, error) {
 	return buf.String(), nil
 }
 
+// writePKCondition writes the condition that compares the pk columns with
+// values. fullOp compares the last pk column when all the others are equal
+// to their values, and op compares the previous ones. For example, if values
+// are (1,2), op is "<" and fullOp is "<=", the condition is:
+// (col1 = 1 and col2 <= 2) or (col1 < 1).
+// A tuple inequality like (col1,col2) > (1,2) ends up
+// being a full table scan for mysql.
+func (rs *rowStreamer) writePKCondition(buf *sqlparser.TrackedBuffer, values []sqltypes.Value, op, fullOp string) {
+	prefix := ""
+	for lastcol := len(rs.pkColumns) - 1; lastcol >= 0; lastcol-- {
+		buf.Myprintf("%s(", prefix)
+		prefix = " or "
+		for i, pk := range rs.pkColumns[:lastcol] {
+			buf.Myprintf("%v = ", sqlparser.NewColIdent(rs.plan.Table.Fields[pk].Name))
+			values[i].EncodeSQL(buf)
+			buf.Myprintf(" and ")
+		}
+		colOp := op
+		if lastcol == len(rs.pkColumns)-1 {
+			colOp = fullOp
+		}
+		buf.Myprintf("%v %s ", sqlparser.NewColIdent(rs.plan.Table.Fields[rs.pkColumns[lastcol]].Name), colOp)
+		values[lastcol].EncodeSQL(buf)
+		buf.Myprintf(")")
+	}
+}
+
+// buildEndPK decodes the value of the endpk directive: the quoted hex encoded
+// values of the pk columns, separated by commas. The rows after them are
+// not streamed.
+func (rs *rowStreamer) buildEndPK(directive string) ([]sqltypes.Value, error) {
+	encoded := strings.Split(directive, ",")
+	if len(encoded) != len(rs.pkColumns) {
+		return nil, fmt.Errorf("endpk values don't match the primary key: %v vs %v", directive, rs.pkColumns)
+	}
+	endpk := make([]sqltypes.Value, len(encoded))
+	for i, pk := range rs.pkColumns {
+		val, err := hex.DecodeString(encoded[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid endpk value %s: %v", encoded[i], err)
+		}
+		endpk[i] = sqltypes.MakeTrusted(rs.plan.Table.Fields[pk].Type, val)
+	}
+	return endpk, nil
+}
+
 func (rs *rowStreamer) streamQuery(conn *snapshotConn, send func(*binlogdatapb.VStreamRowsResponse) error) error {
 	log.Infof("Streaming query: %v\n", rs.sendQuery)
 	gtid, err := conn.streamWithSnapshot(rs.ctx, rs.plan.Table.Name, rs.sendQuery)
@@ -134,6 +134,22 @@ func TestStreamRowsScan(t *testing.T) {
 	wantQuery = "select id1, id2, val from t2 where (id1 = 1 and id2 > 2) or (id1 > 1) order by id1, id2"
 	checkStream(t, "select * from t2", []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}, wantQuery, wantStream)
 
+	// t1: endpk=1
+	wantStream = []string{
+		`fields:{name:"id" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id" column_length:11 charset:63} fields:{name:"val" type:VARBINARY table:"t1" org_table:"t1" database:"vttest" org_name:"val" column_length:128 charset:63} pkfields:{name:"id" type:INT32}`,
+		`rows:{lengths:1 lengths:3 values:"1aaa"} lastpk:{lengths:1 values:"1"}`,
+	}
+	wantQuery = "select id, val from t1 where (id <= 1) order by id"
+	checkStream(t, `select /*vt+ endpk="31" */ * from t1`, nil, wantQuery, wantStream)
+
+	// t2: lastpk=1,2 endpk=1,3
+	wantStream = []string{
+		`fields:{name:"id1" type:INT32 table:"t2" org_table:"t2" database:"vttest" org_name:"id1" column_length:11 charset:63} fields:{name:"id2" type:INT32 table:"t2" org_table:"t2" database:"vttest" org_name:"id2" column_length:11 charset:63} fields:{name:"val" type:VARBINARY table:"t2" org_table:"t2" database:"vttest" org_name:"val" column_length:128 charset:63} pkfields:{name:"id1" type:INT32} pkfields:{name:"id2" type:INT32}`,
+		`rows:{lengths:1 lengths:1 lengths:3 values:"13bbb"} lastpk:{lengths:1 lengths:1 values:"13"}`,
+	}
+	wantQuery = "select id1, id2, val from t2 where ((id1 = 1 and id2 > 2) or (id1 > 1)) and ((id1 = 1 and id2 <= 3) or (id1 < 1)) order by id1, id2"
+	checkStream(t, `select /*vt+ endpk="31,33" */ * from t2`, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}, wantQuery, wantStream)
+
 	// t3: all rows
 	wantStream = []string{
 		`fields:{name:"id" type:INT32 table:"t3" org_table:"t3" database:"vttest" org_name:"id" column_length:11 charset:63} fields:{name:"val" type:VARBINARY table:"t3" org_table:"t3" database:"vttest" org_name:"val" column_length:128 charset:63} pkfields:{name:"id" type:INT32} pkfields:{name:"val" type:VARBINARY}`,
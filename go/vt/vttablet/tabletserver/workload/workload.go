@@ -0,0 +1,311 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package workload provides the vttablet workload management.
+// See the Manager struct for details.
+package workload
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Manager queues the queries of a workload class in front of the query
+// pools, so that a heavy workload cannot take all the connections that
+// interactive traffic needs.
+//
+// A query belongs to the class named in its WORKLOAD_CLASS comment directive,
+// or else to the first class that lists its caller, or else to the first
+// class that lists its table. Queries that belong to no class are not queued,
+// and neither are the queries of transactions: they already hold a connection
+// of the transaction pool, and queueing them while their transaction holds
+// its locks could make the queries they block wait on them.
+//
+// Each class lets MaxConcurrency queries run at the same time. Additional
+// queries wait in arrival order, up to MaxQueueSize of them, for at most
+// QueueTimeoutSeconds. The classes can be replaced at runtime, in which case
+// the queries that already run keep counting against their old class.
+type Manager struct {
+	// mu protects the following fields.
+	mu      sync.RWMutex
+	configs []*tabletenv.WorkloadClassConfig
+	classes map[string]*class
+	byUser  map[string]*class
+	byTable map[string]*class
+
+	// running and queued are the number of queries per class that run
+	// or wait in the queue.
+	running, queued *stats.GaugesWithSingleLabel
+	// admitted counts per class the queries that were let through.
+	// queueExceeded counts per class the queries that were rejected because
+	// the queue was full, and queueTimeouts those that waited too long.
+	admitted, queueExceeded, queueTimeouts *stats.CountersWithSingleLabel
+	// waits records per class how long the admitted queries waited.
+	waits *servenv.TimingsWrapper
+}
+
+// class is a workload class along with its slots.
+type class struct {
+	config *tabletenv.WorkloadClassConfig
+	// slots has a buffer of MaxConcurrency. A query that runs holds a slot.
+	slots   chan struct{}
+	running sync2.AtomicInt64
+	queued  sync2.AtomicInt64
+}
+
+// NewManager returns a Manager with the workload classes of the config.
+func NewManager(env tabletenv.Env) *Manager {
+	m := &Manager{
+		running: env.Exporter().NewGaugesWithSingleLabel(
+			"WorkloadClassRunning",
+			"Number of queries of a workload class that are running",
+			"class"),
+		queued: env.Exporter().NewGaugesWithSingleLabel(
+			"WorkloadClassQueued",
+			"Number of queries of a workload class that wait in its queue",
+			"class"),
+		admitted: env.Exporter().NewCountersWithSingleLabel(
+			"WorkloadClassAdmitted",
+			"Number of queries of a workload class that were let through",
+			"class"),
+		queueExceeded: env.Exporter().NewCountersWithSingleLabel(
+			"WorkloadClassQueueExceeded",
+			"Number of queries of a workload class that were rejected because its queue was full",
+			"class"),
+		queueTimeouts: env.Exporter().NewCountersWithSingleLabel(
+			"WorkloadClassQueueTimeouts",
+			"Number of queries of a workload class that timed out in its queue",
+			"class"),
+		waits: env.Exporter().NewTimings(
+			"WorkloadClassWaits",
+			"Time that the queries of a workload class waited in its queue",
+			"class"),
+	}
+	if err := m.SetClasses(env.Config().WorkloadClasses); err != nil {
+		// The config was verified at startup.
+		log.Errorf("Invalid workload classes: %v", err)
+	}
+	return m
+}
+
+// SetClasses replaces the workload classes.
+func (m *Manager) SetClasses(configs []*tabletenv.WorkloadClassConfig) error {
+	if err := tabletenv.VerifyWorkloadClasses(configs); err != nil {
+		return vterrors.Wrap(err, "invalid workload classes")
+	}
+	classes := make(map[string]*class, len(configs))
+	byUser := make(map[string]*class)
+	byTable := make(map[string]*class)
+	for _, config := range configs {
+		c := &class{
+			config: config,
+			slots:  make(chan struct{}, config.MaxConcurrency),
+		}
+		classes[config.Name] = c
+		for _, user := range config.Users {
+			if _, ok := byUser[user]; !ok {
+				byUser[user] = c
+			}
+		}
+		for _, table := range config.Tables {
+			if _, ok := byTable[table]; !ok {
+				byTable[table] = c
+			}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.configs = configs
+	m.classes = classes
+	m.byUser = byUser
+	m.byTable = byTable
+	return nil
+}
+
+// Classes returns the workload classes.
+func (m *Manager) Classes() []*tabletenv.WorkloadClassConfig {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.configs
+}
+
+// Classify returns the name of the workload class of a query, or ""
+// if the query belongs to no class. directive is the value of the
+// WORKLOAD_CLASS comment directive of the query.
+func (m *Manager) Classify(ctx context.Context, directive, table string) string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if len(m.classes) == 0 {
+		return ""
+	}
+	if _, ok := m.classes[directive]; ok {
+		return directive
+	}
+	if principal := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx)); principal != "" {
+		if c, ok := m.byUser[principal]; ok {
+			return c.config.Name
+		}
+	}
+	if username := callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx)); username != "" {
+		if c, ok := m.byUser[username]; ok {
+			return c.config.Name
+		}
+	}
+	if c, ok := m.byTable[table]; ok {
+		return c.config.Name
+	}
+	return ""
+}
+
+// Wait blocks until a query of the named workload class may run.
+// If err is nil, the caller must call done once the query is done.
+// err is not nil if a) the context is done, b) the queue of the class
+// is full or c) the query timed out in the queue.
+func (m *Manager) Wait(ctx context.Context, name string) (done func(), err error) {
+	m.mu.RLock()
+	c := m.classes[name]
+	m.mu.RUnlock()
+	if c == nil {
+		return func() {}, nil
+	}
+
+	// A query only skips the queue if no other query waits in it, so that
+	// the queued queries are admitted first.
+	if c.queued.Get() == 0 {
+		select {
+		case c.slots <- struct{}{}:
+			return m.admit(c, 0), nil
+		default:
+		}
+	}
+
+	if c.queued.Add(1) > int64(c.config.MaxQueueSize) {
+		c.queued.Add(-1)
+		m.queueExceeded.Add(name, 1)
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED,
+			"workload class %v: too many queued queries (max %d)", name, c.config.MaxQueueSize)
+	}
+	m.queued.Add(name, 1)
+	defer func() {
+		c.queued.Add(-1)
+		m.queued.Add(name, -1)
+	}()
+
+	var timeout <-chan time.Time
+	if d := c.config.QueueTimeoutSeconds.Get(); d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	start := time.Now()
+	select {
+	case c.slots <- struct{}{}:
+		return m.admit(c, time.Since(start)), nil
+	case <-timeout:
+		m.queueTimeouts.Add(name, 1)
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED,
+			"workload class %v: query timed out in queue after %v", name, c.config.QueueTimeoutSeconds.Get())
+	case <-ctx.Done():
+		return nil, vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED,
+			"workload class %v: context done while the query was queued: %v", name, ctx.Err())
+	}
+}
+
+// admit records that a query of the class holds a slot, and returns
+// the function that gives the slot back.
+func (m *Manager) admit(c *class, waited time.Duration) func() {
+	name := c.config.Name
+	m.admitted.Add(name, 1)
+	m.waits.Add(name, waited)
+	c.running.Add(1)
+	m.running.Add(name, 1)
+	return func() {
+		c.running.Add(-1)
+		m.running.Add(name, -1)
+		<-c.slots
+	}
+}
+
+// ClassStatus is the status of a workload class, as reported
+// in /debug/workload_classes.
+type ClassStatus struct {
+	*tabletenv.WorkloadClassConfig
+	Running int64 `json:"running"`
+	Queued  int64 `json:"queued"`
+}
+
+// Status returns the status of the workload classes.
+func (m *Manager) Status() []ClassStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	status := make([]ClassStatus, 0, len(m.configs))
+	for _, config := range m.configs {
+		c := m.classes[config.Name]
+		status = append(status, ClassStatus{
+			WorkloadClassConfig: config,
+			Running:             c.running.Get(),
+			Queued:              c.queued.Get(),
+		})
+	}
+	return status
+}
+
+// ServeHTTP lists the workload classes on GET, and replaces them with
+// the JSON list of classes in the request body on POST.
+func (m *Manager) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	if request.Method == "POST" {
+		if err := acl.CheckAccessHTTP(request, acl.ADMIN); err != nil {
+			acl.SendError(response, err)
+			return
+		}
+		var configs []*tabletenv.WorkloadClassConfig
+		if err := json.NewDecoder(request.Body).Decode(&configs); err != nil {
+			http.Error(response, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := m.SetClasses(configs); err != nil {
+			http.Error(response, err.Error(), http.StatusBadRequest)
+			return
+		}
+		log.Infof("Workload classes set to %d classes", len(configs))
+	} else if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+
+	b, err := json.MarshalIndent(m.Status(), "", " ")
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	response.Write(b)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func newTestManager(t *testing.T, name string, classes ...*tabletenv.WorkloadClassConfig) *Manager {
+	t.Helper()
+	config := tabletenv.NewDefaultConfig()
+	config.WorkloadClasses = classes
+	return NewManager(tabletenv.NewEnv(config, name))
+}
+
+func TestClassify(t *testing.T) {
+	m := newTestManager(t, "WorkloadClassifyTest",
+		&tabletenv.WorkloadClassConfig{Name: "reporting", Users: []string{"reporter"}, Tables: []string{"events"}, MaxConcurrency: 1},
+		&tabletenv.WorkloadClassConfig{Name: "batch", Users: []string{"batcher"}, Tables: []string{"events", "jobs"}, MaxConcurrency: 1},
+	)
+
+	ctx := context.Background()
+	assert.Equal(t, "", m.Classify(ctx, "", "users"))
+	assert.Equal(t, "", m.Classify(ctx, "unknown", "users"))
+	assert.Equal(t, "batch", m.Classify(ctx, "batch", "users"))
+	// The first class that lists a table wins.
+	assert.Equal(t, "reporting", m.Classify(ctx, "", "events"))
+	assert.Equal(t, "batch", m.Classify(ctx, "", "jobs"))
+
+	ctx = callerid.NewContext(ctx, callerid.NewEffectiveCallerID("batcher", "", ""), nil)
+	assert.Equal(t, "batch", m.Classify(ctx, "", "events"))
+	// The directive takes precedence over the caller.
+	assert.Equal(t, "reporting", m.Classify(ctx, "reporting", "jobs"))
+
+	ctx = callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID("reporter"))
+	assert.Equal(t, "reporting", m.Classify(ctx, "", "jobs"))
+}
+
+func TestWaitConcurrencyLimit(t *testing.T) {
+	m := newTestManager(t, "WorkloadConcurrencyTest",
+		&tabletenv.WorkloadClassConfig{Name: "reporting", MaxConcurrency: 1, MaxQueueSize: 1},
+	)
+
+	done1, err := m.Wait(context.Background(), "reporting")
+	require.NoError(t, err)
+
+	// The second query waits in the queue until the first one is done.
+	admitted := make(chan func())
+	go func() {
+		done2, err := m.Wait(context.Background(), "reporting")
+		assert.NoError(t, err)
+		admitted <- done2
+	}()
+	for m.queued.Counts()["reporting"] != 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The third query finds the queue full.
+	_, err = m.Wait(context.Background(), "reporting")
+	assert.EqualError(t, err, "workload class reporting: too many queued queries (max 1)")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+
+	done1()
+	done2 := <-admitted
+	status := m.Status()
+	require.Len(t, status, 1)
+	assert.EqualValues(t, 1, status[0].Running)
+	assert.EqualValues(t, 0, status[0].Queued)
+	done2()
+
+	assert.EqualValues(t, 2, m.admitted.Counts()["reporting"])
+	assert.EqualValues(t, 1, m.queueExceeded.Counts()["reporting"])
+	assert.EqualValues(t, 0, m.running.Counts()["reporting"])
+
+	// Queries of no class are not queued.
+	done, err := m.Wait(context.Background(), "")
+	require.NoError(t, err)
+	done()
+}
+
+func TestWaitQueueTimeout(t *testing.T) {
+	m := newTestManager(t, "WorkloadQueueTimeoutTest",
+		&tabletenv.WorkloadClassConfig{Name: "reporting", MaxConcurrency: 1, MaxQueueSize: 10, QueueTimeoutSeconds: 0.01},
+	)
+
+	done, err := m.Wait(context.Background(), "reporting")
+	require.NoError(t, err)
+	defer done()
+
+	_, err = m.Wait(context.Background(), "reporting")
+	assert.EqualError(t, err, "workload class reporting: query timed out in queue after 10ms")
+	assert.EqualValues(t, 1, m.queueTimeouts.Counts()["reporting"])
+
+	m.classes["reporting"].config.QueueTimeoutSeconds = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = m.Wait(ctx, "reporting")
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+	assert.EqualValues(t, 0, m.queued.Counts()["reporting"])
+}
+
+func TestSetClasses(t *testing.T) {
+	m := newTestManager(t, "WorkloadSetClassesTest")
+
+	err := m.SetClasses([]*tabletenv.WorkloadClassConfig{{Name: "reporting"}})
+	assert.EqualError(t, err, "invalid workload classes: maxConcurrency of workload class reporting must be > 0 (specified value: 0)")
+	err = m.SetClasses([]*tabletenv.WorkloadClassConfig{{Name: "a", MaxConcurrency: 1}, {Name: "a", MaxConcurrency: 1}})
+	assert.EqualError(t, err, "invalid workload classes: duplicate workload class a")
+	assert.Empty(t, m.Classes())
+
+	req := httptest.NewRequest("POST", "/debug/workload_classes", strings.NewReader(`[{"name": "reporting", "tables": ["events"], "maxConcurrency": 2}]`))
+	rr := httptest.NewRecorder()
+	m.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Contains(t, rr.Body.String(), `"name": "reporting"`)
+	assert.Contains(t, rr.Body.String(), `"running": 0`)
+	assert.Equal(t, "reporting", m.Classify(context.Background(), "", "events"))
+
+	req = httptest.NewRequest("POST", "/debug/workload_classes", strings.NewReader(`[{"name": ""}]`))
+	rr = httptest.NewRecorder()
+	m.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Len(t, m.Classes(), 1)
+}
@@ -916,6 +916,41 @@ func (fra *fakeRPCTM) VReplicationWaitForPos(ctx context.Context, id int, pos st
 	return nil
 }
 
+var testVDiffRequest = &tabletmanagerdatapb.VDiffRequest{
+	Keyspace:  "ks",
+	Workflow:  "wf",
+	Action:    "show",
+	ActionArg: "last",
+	Options: &tabletmanagerdatapb.VDiffOptions{
+		Tables:  "t1,t2",
+		MaxRows: 100,
+	},
+}
+
+var testVDiffResponse = &tabletmanagerdatapb.VDiffResponse{
+	Id:        1,
+	Output:    testExecuteFetchResult,
+	VdiffUuid: "uuid",
+}
+
+func (fra *fakeRPCTM) VDiff(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "VDiff request", req, testVDiffRequest)
+	return testVDiffResponse, nil
+}
+
+func tmRPCTestVDiff(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	resp, err := client.VDiff(ctx, tablet, testVDiffRequest)
+	compareError(t, "VDiff", err, resp, testVDiffResponse)
+}
+
+func tmRPCTestVDiffPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.VDiff(ctx, tablet, testVDiffRequest)
+	expectHandleRPCPanic(t, "VDiff", true /*verbose*/, err)
+}
+
 func tmRPCTestVReplicationWaitForPos(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
 	err := client.VReplicationWaitForPos(ctx, tablet, wfpid, wfppos)
 	compareError(t, "VReplicationWaitForPos", err, true, true)
@@ -1213,24 +1248,30 @@ func tmRPCTestPromoteReplicaPanic(ctx context.Context, t *testing.T, client tmcl
 // Backup / restore related methods
 //
 
-var testBackupConcurrency = 24
-var testBackupAllowPrimary = false
+var testBackupRequest = &tabletmanagerdatapb.BackupRequest{
+	Concurrency:       24,
+	AllowPrimary:      false,
+	Incremental:       true,
+	CompressionEngine: "zstd",
+}
 var testBackupCalled = false
 var testRestoreFromBackupCalled = false
+var testRestoreFromBackupRequest = &tabletmanagerdatapb.RestoreFromBackupRequest{
+	RestoreToPos: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-5",
+}
 
-func (fra *fakeRPCTM) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowPrimary bool) error {
+func (fra *fakeRPCTM) Backup(ctx context.Context, logger logutil.Logger, request *tabletmanagerdatapb.BackupRequest) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
-	compare(fra.t, "Backup args", concurrency, testBackupConcurrency)
-	compare(fra.t, "Backup args", allowPrimary, testBackupAllowPrimary)
+	compare(fra.t, "Backup request", request, testBackupRequest)
 	logStuff(logger, 10)
 	testBackupCalled = true
 	return nil
 }
 
 func tmRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowPrimary)
+	stream, err := client.Backup(ctx, tablet, testBackupRequest)
 	if err != nil {
 		t.Fatalf("Backup failed: %v", err)
 	}
@@ -1239,7 +1280,7 @@ func tmRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.TabletMa
 }
 
 func tmRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowPrimary)
+	stream, err := client.Backup(ctx, tablet, testBackupRequest)
 	if err != nil {
 		t.Fatalf("Backup failed: %v", err)
 	}
@@ -1250,17 +1291,18 @@ func tmRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.Tab
 	expectHandleRPCPanic(t, "Backup", true /*verbose*/, err)
 }
 
-func (fra *fakeRPCTM) RestoreFromBackup(ctx context.Context, logger logutil.Logger, backupTime time.Time) error {
+func (fra *fakeRPCTM) RestoreFromBackup(ctx context.Context, logger logutil.Logger, request *tabletmanagerdatapb.RestoreFromBackupRequest) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compare(fra.t, "RestoreFromBackup request", request, testRestoreFromBackupRequest)
 	logStuff(logger, 10)
 	testRestoreFromBackupCalled = true
 	return nil
 }
 
-func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet, backupTime time.Time) {
-	stream, err := client.RestoreFromBackup(ctx, tablet, backupTime)
+func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreFromBackupRequest)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1268,8 +1310,8 @@ func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclie
 	compareError(t, "RestoreFromBackup", err, true, testRestoreFromBackupCalled)
 }
 
-func tmRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet, backupTime time.Time) {
-	stream, err := client.RestoreFromBackup(ctx, tablet, backupTime)
+func tmRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreFromBackupRequest)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1301,8 +1343,6 @@ func (fra *fakeRPCTM) HandleRPCPanic(ctx context.Context, name string, args, rep
 func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet, fakeTM tabletmanager.RPCTM) {
 	ctx := context.Background()
 
-	backupTime := time.Time{}
-
 	// Test RPC specific methods of the interface.
 	tmRPCTestDialExpiredContext(ctx, t, client, tablet)
 	tmRPCTestRPCTimeout(ctx, t, client, tablet, fakeTM.(*fakeRPCTM))
@@ -1339,6 +1379,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// VReplication methods
 	tmRPCTestVReplicationExec(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPos(ctx, t, client, tablet)
+	tmRPCTestVDiff(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplication(ctx, t, client, tablet)
@@ -1356,7 +1397,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 
 	// Backup / restore related methods
 	tmRPCTestBackup(ctx, t, client, tablet)
-	tmRPCTestRestoreFromBackup(ctx, t, client, tablet, backupTime)
+	tmRPCTestRestoreFromBackup(ctx, t, client, tablet)
 
 	//
 	// Tests panic handling everywhere now
@@ -1391,6 +1432,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// VReplication methods
 	tmRPCTestVReplicationExecPanic(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPosPanic(ctx, t, client, tablet)
+	tmRPCTestVDiffPanic(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplicationPanic(ctx, t, client, tablet)
@@ -1407,7 +1449,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestReplicaWasRestartedPanic(ctx, t, client, tablet)
 	// Backup / restore related methods
 	tmRPCTestBackupPanic(ctx, t, client, tablet)
-	tmRPCTestRestoreFromBackupPanic(ctx, t, client, tablet, backupTime)
+	tmRPCTestRestoreFromBackupPanic(ctx, t, client, tablet)
 
 	client.Close()
 }
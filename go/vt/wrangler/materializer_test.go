@@ -566,7 +566,7 @@ func TestCreateLookupVindexCreateDDL(t *testing.T) {
 			delete(env.tmc.schema, ms.SourceKeyspace+".t1")
 		}
 
-		outms, _, _, err := env.wr.prepareCreateLookup(context.Background(), ms.SourceKeyspace, tcase.specs, false)
+		outms, _, _, err := env.wr.workflowServer().PrepareCreateLookup(context.Background(), ms.SourceKeyspace, tcase.specs, false)
 		if tcase.err != "" {
 			if err == nil || !strings.Contains(err.Error(), tcase.err) {
 				t.Errorf("prepareCreateLookup(%s) err: %v, must contain %v", tcase.description, err, tcase.err)
@@ -808,7 +808,7 @@ func TestCreateLookupVindexSourceVSchema(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		_, got, _, err := env.wr.prepareCreateLookup(context.Background(), ms.SourceKeyspace, specs, false)
+		_, got, _, err := env.wr.workflowServer().PrepareCreateLookup(context.Background(), ms.SourceKeyspace, specs, false)
 		require.NoError(t, err)
 		if !proto.Equal(got, tcase.out) {
 			t.Errorf("%s: got:\n%v, want\n%v", tcase.description, got, tcase.out)
@@ -1041,7 +1041,7 @@ func TestCreateLookupVindexTargetVSchema(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		_, _, got, err := env.wr.prepareCreateLookup(context.Background(), ms.SourceKeyspace, specs, false)
+		_, _, got, err := env.wr.workflowServer().PrepareCreateLookup(context.Background(), ms.SourceKeyspace, specs, false)
 		if tcase.err != "" {
 			if err == nil || !strings.Contains(err.Error(), tcase.err) {
 				t.Errorf("prepareCreateLookup(%s) err: %v, must contain %v", tcase.description, err, tcase.err)
@@ -1156,7 +1156,7 @@ func TestCreateLookupVindexSameKeyspace(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	_, got, _, err := env.wr.prepareCreateLookup(context.Background(), ms.SourceKeyspace, specs, false)
+	_, got, _, err := env.wr.workflowServer().PrepareCreateLookup(context.Background(), ms.SourceKeyspace, specs, false)
 	require.NoError(t, err)
 	if !proto.Equal(got, want) {
 		t.Errorf("same keyspace: got:\n%v, want\n%v", got, want)
@@ -1267,7 +1267,7 @@ func TestCreateCustomizedVindex(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	_, got, _, err := env.wr.prepareCreateLookup(context.Background(), ms.SourceKeyspace, specs, false)
+	_, got, _, err := env.wr.workflowServer().PrepareCreateLookup(context.Background(), ms.SourceKeyspace, specs, false)
 	require.NoError(t, err)
 	if !proto.Equal(got, want) {
 		t.Errorf("customize create lookup error same: got:\n%v, want\n%v", got, want)
@@ -1341,11 +1341,11 @@ func TestStopAfterCopyFlag(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	ms1, _, _, err := env.wr.prepareCreateLookup(context.Background(), ms.SourceKeyspace, specs, false)
+	ms1, _, _, err := env.wr.workflowServer().PrepareCreateLookup(context.Background(), ms.SourceKeyspace, specs, false)
 	require.NoError(t, err)
 	require.Equal(t, ms1.StopAfterCopy, true)
 
-	ms2, _, _, err := env.wr.prepareCreateLookup(context.Background(), ms.SourceKeyspace, specs, true)
+	ms2, _, _, err := env.wr.workflowServer().PrepareCreateLookup(context.Background(), ms.SourceKeyspace, specs, true)
 	require.NoError(t, err)
 	require.Equal(t, ms2.StopAfterCopy, false)
 }
@@ -1790,6 +1790,56 @@ func TestMaterializerOneToOne(t *testing.T) {
 	env.tmc.verifyQueries(t)
 }
 
+func TestMaterializerOnDDL(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+			CreateDdl:        "t1ddl",
+		}},
+		OnDdl: "exec_additive",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(
+		200,
+		insertPrefix+
+			`\('workflow', 'keyspace:\\"sourceks\\" shard:\\"0\\" filter:{rules:{match:\\"t1\\" filter:\\"select.*t1\\"}} on_ddl:EXEC_ADDITIVE', `+
+			`'', [0-9]*, [0-9]*, '', '', [0-9]*, 0, 'Stopped', 'vt_targetks'\)`+eol,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestMaterializerInvalidOnDDL(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+			CreateDdl:        "t1ddl",
+		}},
+		OnDdl: "bad",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	err := env.wr.Materialize(context.Background(), ms)
+	require.EqualError(t, err, "invalid on_ddl value: bad")
+}
+
 func TestMaterializerManyToOne(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -2548,80 +2598,6 @@ func TestMaterializerNoVindexInExpression(t *testing.T) {
 	require.EqualError(t, err, "could not find vindex column c1")
 }
 
-func TestStripConstraints(t *testing.T) {
-	tcs := []struct {
-		desc string
-		ddl  string
-
-		hasErr bool
-		newDDL string
-	}{
-		{
-			desc: "constraints",
-			ddl: "CREATE TABLE `table1` (\n" +
-				"`id` int(11) NOT NULL AUTO_INCREMENT,\n" +
-				"`foreign_id` int(11) NOT NULL,\n" +
-				"`user_id` int(11) NOT NULL,\n" +
-				"PRIMARY KEY (`id`),\n" +
-				"KEY `fk_table1_ref_foreign_id` (`foreign_id`),\n" +
-				"KEY `fk_table1_ref_user_id` (`user_id`),\n" +
-				"CONSTRAINT `fk_table1_ref_foreign_id` FOREIGN KEY (`foreign_id`) REFERENCES `foreign` (`id`),\n" +
-				"CONSTRAINT `fk_table1_ref_user_id` FOREIGN KEY (`user_id`) REFERENCES `core_user` (`id`)\n" +
-				") ENGINE=InnoDB DEFAULT CHARSET=latin1;",
-
-			newDDL: "create table table1 (\n" +
-				"\tid int(11) not null auto_increment,\n" +
-				"\tforeign_id int(11) not null,\n" +
-				"\tuser_id int(11) not null,\n" +
-				"\tPRIMARY KEY (id),\n" +
-				"\tKEY fk_table1_ref_foreign_id (foreign_id),\n" +
-				"\tKEY fk_table1_ref_user_id (user_id)\n" +
-				") ENGINE InnoDB,\n" +
-				"  CHARSET latin1",
-
-			hasErr: false,
-		},
-		{
-			desc: "no constraints",
-			ddl: "CREATE TABLE `table1` (\n" +
-				"`id` int(11) NOT NULL AUTO_INCREMENT,\n" +
-				"`foreign_id` int(11) NOT NULL,\n" +
-				"`user_id` int(11) NOT NULL,\n" +
-				"PRIMARY KEY (`id`),\n" +
-				"KEY `fk_table1_ref_foreign_id` (`foreign_id`),\n" +
-				"KEY `fk_table1_ref_user_id` (`user_id`)\n" +
-				") ENGINE=InnoDB DEFAULT CHARSET=latin1;",
-
-			newDDL: "create table table1 (\n" +
-				"\tid int(11) not null auto_increment,\n" +
-				"\tforeign_id int(11) not null,\n" +
-				"\tuser_id int(11) not null,\n" +
-				"\tPRIMARY KEY (id),\n" +
-				"\tKEY fk_table1_ref_foreign_id (foreign_id),\n" +
-				"\tKEY fk_table1_ref_user_id (user_id)\n" +
-				") ENGINE InnoDB,\n" +
-				"  CHARSET latin1",
-		},
-		{
-			desc: "bad ddl has error",
-			ddl:  "bad ddl",
-
-			hasErr: true,
-		},
-	}
-
-	for _, tc := range tcs {
-		newDDL, err := stripTableConstraints(tc.ddl)
-		if tc.hasErr != (err != nil) {
-			t.Fatalf("hasErr does not match: err: %v, tc: %+v", err, tc)
-		}
-
-		if newDDL != tc.newDDL {
-			utils.MustMatch(t, tc.newDDL, newDDL, fmt.Sprintf("newDDL does not match. tc: %+v", tc))
-		}
-	}
-}
-
 func TestMaterializerManyToManySomeUnreachable(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -31,6 +31,7 @@ import (
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
@@ -554,6 +555,24 @@ func TestResharderServingState(t *testing.T) {
 	assert.EqualError(t, err, "buildResharder: ValidateForReshard: source and target keyranges don't match: - vs -80")
 }
 
+func TestResharderSeedFromBackupErrors(t *testing.T) {
+	env := newTestResharderEnv(t, []string{"-80", "80-"}, []string{"0"})
+	defer env.close()
+	ctx := context.Background()
+
+	err := env.wr.ReshardFromBackup(ctx, env.keyspace, env.workflow, env.sources, env.targets, "", "", true, false)
+	assert.EqualError(t, err, "seedReshardTargets: target shard 0 has a primary, only shards without a primary can be seeded from a backup")
+
+	_, err = env.topoServ.UpdateShardFields(ctx, env.keyspace, "0", func(si *topo.ShardInfo) error {
+		si.PrimaryAlias = nil
+		return nil
+	})
+	require.NoError(t, err)
+	err = env.wr.ReshardFromBackup(ctx, env.keyspace, env.workflow, env.sources, env.targets, "", "", true, false)
+	assert.EqualError(t, err, "seedReshardTargets: target shard 0 overlaps 2 source shards, only the target shards of a split can be seeded from a backup")
+	env.tmc.verifyQueries(t)
+}
+
 func TestResharderTargetAlreadyResharding(t *testing.T) {
 	env := newTestResharderEnv(t, []string{"0"}, []string{"-80", "80-"})
 	defer env.close()
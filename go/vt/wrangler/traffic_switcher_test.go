@@ -909,8 +909,8 @@ func TestTableMigrateOneToMany(t *testing.T) {
 		tme.dbTargetClients[0].addQuery("select 1 from _vt.vreplication where db_name='vt_ks2' and workflow='test' and message!='FROZEN'", &sqltypes.Result{}, nil)
 		tme.dbTargetClients[1].addQuery("select 1 from _vt.vreplication where db_name='vt_ks2' and workflow='test' and message!='FROZEN'", &sqltypes.Result{}, nil)
 		tme.dbSourceClients[0].addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", &sqltypes.Result{}, nil)
-		tme.tmeDB.AddQuery(fmt.Sprintf("rename table vt_ks1.t1 TO vt_ks1.%s", getRenameFileName("t1")), &sqltypes.Result{})
-		tme.tmeDB.AddQuery(fmt.Sprintf("rename table vt_ks1.t2 TO vt_ks1.%s", getRenameFileName("t2")), &sqltypes.Result{})
+		tme.tmeDB.AddQuery("rename table vt_ks1.t1 TO vt_ks1._t1_old", &sqltypes.Result{})
+		tme.tmeDB.AddQuery("rename table vt_ks1.t2 TO vt_ks1._t2_old", &sqltypes.Result{})
 		tme.dbTargetClients[0].addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'test'", &sqltypes.Result{}, nil) //
 		tme.dbTargetClients[1].addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'test'", &sqltypes.Result{}, nil)
 	}
@@ -1690,51 +1690,6 @@ func TestMigrateNoTableWildcards(t *testing.T) {
 	}
 }
 
-func TestReverseVReplicationUpdateQuery(t *testing.T) {
-	ts := &trafficSwitcher{
-		reverseWorkflow: "wf",
-	}
-	dbname := "db"
-	type tCase struct {
-		optCells       string
-		optTabletTypes string
-		targetCell     string
-		sourceCell     string
-		want           string
-	}
-	updateQuery := "update _vt.vreplication set cell = '%s', tablet_types = '%s' where workflow = 'wf' and db_name = 'db'"
-	tCases := []tCase{
-		{
-			targetCell: "cell1", sourceCell: "cell1", optCells: "cell1", optTabletTypes: "",
-			want: fmt.Sprintf(updateQuery, "cell1", ""),
-		},
-		{
-			targetCell: "cell1", sourceCell: "cell2", optCells: "cell1", optTabletTypes: "",
-			want: fmt.Sprintf(updateQuery, "cell2", ""),
-		},
-		{
-			targetCell: "cell1", sourceCell: "cell2", optCells: "cell2", optTabletTypes: "",
-			want: fmt.Sprintf(updateQuery, "cell2", ""),
-		},
-		{
-			targetCell: "cell1", sourceCell: "cell1", optCells: "cell1,cell2", optTabletTypes: "replica,primary",
-			want: fmt.Sprintf(updateQuery, "cell1,cell2", "replica,primary"),
-		},
-		{
-			targetCell: "cell1", sourceCell: "cell1", optCells: "", optTabletTypes: "replica,primary",
-			want: fmt.Sprintf(updateQuery, "", "replica,primary"),
-		},
-	}
-	for _, tc := range tCases {
-		t.Run("", func(t *testing.T) {
-			ts.optCells = tc.optCells
-			ts.optTabletTypes = tc.optTabletTypes
-			got := ts.getReverseVReplicationUpdateQuery(tc.targetCell, tc.sourceCell, dbname)
-			require.Equal(t, tc.want, got)
-		})
-	}
-}
-
 func TestShardMigrateNoAvailableTabletsForReverseReplication(t *testing.T) {
 	ctx := context.Background()
 	tme := newTestShardMigrater(ctx, t, []string{"-40", "40-"}, []string{"-80", "80-"})
@@ -83,7 +83,7 @@ type RowDiff struct {
 
 // vdiff contains the metadata for performing vdiff for one workflow.
 type vdiff struct {
-	ts             *trafficSwitcher
+	ts             workflow.ITrafficSwitcher
 	sourceCell     string
 	targetCell     string
 	tabletTypesStr string
@@ -180,7 +180,7 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sou
 		wr.Logger().Errorf("buildTrafficSwitcher: %v", err)
 		return nil, err
 	}
-	if err := ts.validate(ctx); err != nil {
+	if err := ts.Validate(ctx); err != nil {
 		ts.Logger().Errorf("validate: %v", err)
 		return nil, err
 	}
@@ -569,7 +569,7 @@ func newMergeSorter(participants map[string]*shardStreamer, comparePKs []compare
 }
 
 // selectTablets selects the tablets that will be used for the diff.
-func (df *vdiff) selectTablets(ctx context.Context, ts *trafficSwitcher) error {
+func (df *vdiff) selectTablets(ctx context.Context, ts workflow.ITrafficSwitcher) error {
 	var wg sync.WaitGroup
 	var err1, err2 error
 
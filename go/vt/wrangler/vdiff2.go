@@ -0,0 +1,388 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
+	"vitess.io/vitess/go/vt/vterrors"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// VDiffOutput holds the responses of the target primaries to a vdiff
+// action, by shard.
+type VDiffOutput struct {
+	mu        sync.Mutex
+	Request   *tabletmanagerdatapb.VDiffRequest
+	Responses map[string]*tabletmanagerdatapb.VDiffResponse
+}
+
+// VDiffSummary is the state of one vdiff across the target shards.
+type VDiffSummary struct {
+	Workflow    string
+	Keyspace    string
+	UUID        string
+	State       string
+	Shards      string
+	StartedAt   string            `json:",omitempty"`
+	CompletedAt string            `json:",omitempty"`
+	Errors      map[string]string `json:",omitempty"`
+	// TableSummaryMap is the state of each table, across the shards.
+	TableSummaryMap map[string]*VDiffTableSummary
+	// Reports are the reports of each table, by shard.
+	Reports map[string]map[string]*tabletvdiff.DiffReport `json:",omitempty"`
+}
+
+// VDiffTableSummary is the state of one table of a vdiff across the target
+// shards.
+type VDiffTableSummary struct {
+	TableName    string
+	State        string
+	RowsCompared int64
+	HasMismatch  bool
+}
+
+// VDiffListEntry is one of the vdiffs of a workflow, as listed by "show all".
+type VDiffListEntry struct {
+	UUID        string
+	State       string
+	Shards      string
+	CreatedAt   string
+	CompletedAt string `json:",omitempty"`
+}
+
+// vdiffStateOrder orders the states of the shards of a vdiff, or of a
+// table: the state across the shards is the first one any shard is in.
+var vdiffStateOrder = []string{tabletvdiff.ErrorState, tabletvdiff.StartedState, tabletvdiff.PendingState, tabletvdiff.StoppedState, tabletvdiff.CompletedState}
+
+// VDiff2 performs an action on the vdiffs of a workflow, which run on the
+// primaries of its target shards: create starts a new vdiff, show reports
+// the progress and differences of one, and stop, resume and delete manage
+// them. Except for create, actionArg selects the vdiffs: a uuid, "last" or
+// "all".
+func (wr *Wrangler) VDiff2(ctx context.Context, keyspace, workflowName, action, actionArg, format string, options *tabletmanagerdatapb.VDiffOptions) (*VDiffOutput, error) {
+	req := &tabletmanagerdatapb.VDiffRequest{
+		Keyspace:  keyspace,
+		Workflow:  workflowName,
+		Action:    action,
+		ActionArg: actionArg,
+		Options:   options,
+	}
+	switch action {
+	case tabletvdiff.CreateAction:
+		// All the shards share the same uuid, so the vdiff can be managed as
+		// a whole.
+		req.VdiffUuid = uuid.New().String()
+		if actionArg != "" {
+			if _, err := uuid.Parse(actionArg); err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid vdiff uuid %v: %v", actionArg, err)
+			}
+			req.VdiffUuid = actionArg
+		}
+		req.ActionArg = ""
+	case tabletvdiff.ShowAction, tabletvdiff.StopAction, tabletvdiff.ResumeAction, tabletvdiff.DeleteAction:
+		if actionArg == "" {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vdiff %v needs a vdiff uuid, %q or %q", action, tabletvdiff.LastActionArg, tabletvdiff.AllActionArg)
+		}
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid vdiff action %v: it has to be one of create, show, stop, resume or delete", action)
+	}
+
+	ts, err := wr.buildTrafficSwitcher(ctx, keyspace, workflowName)
+	if err != nil {
+		return nil, err
+	}
+	output := &VDiffOutput{
+		Request:   req,
+		Responses: make(map[string]*tabletmanagerdatapb.VDiffResponse),
+	}
+	err = ts.ForAllTargets(func(target *workflow.MigrationTarget) error {
+		resp, err := wr.tmc.VDiff(ctx, target.GetPrimary().Tablet, req)
+		if err != nil {
+			return vterrors.Wrapf(err, "vdiff %v on %v", action, target.GetPrimary().AliasString())
+		}
+		output.mu.Lock()
+		defer output.mu.Unlock()
+		output.Responses[target.GetShard().ShardName()] = resp
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case tabletvdiff.CreateAction:
+		if format == "json" {
+			wr.printJSON(map[string]string{"UUID": req.VdiffUuid})
+		} else {
+			wr.Logger().Printf("VDiff %v scheduled on target shards, use show to view progress\n", req.VdiffUuid)
+		}
+	case tabletvdiff.ShowAction:
+		if actionArg == tabletvdiff.AllActionArg {
+			wr.printVDiffList(keyspace, workflowName, format, summarizeVDiffList(output))
+			break
+		}
+		summary, err := summarizeVDiff(output)
+		if err != nil {
+			return nil, err
+		}
+		wr.printVDiffSummary(keyspace, workflowName, actionArg, format, summary)
+	default:
+		wr.Logger().Printf("VDiff %v of %v done on target shards\n", action, actionArg)
+	}
+	return output, nil
+}
+
+// summarizeVDiff summarizes the reports of a vdiff from the target shards.
+// It returns nil if no shard has the vdiff.
+func summarizeVDiff(output *VDiffOutput) (*VDiffSummary, error) {
+	var summary *VDiffSummary
+	var shards []string
+	shardStates := make(map[string]bool)
+	tableStates := make(map[string]map[string]bool)
+	for _, shard := range sortedShards(output.Responses) {
+		resp := output.Responses[shard]
+		if resp.Output == nil || len(resp.Output.Rows) == 0 {
+			continue
+		}
+		for _, row := range sqltypes.ToNamedResult(sqltypes.Proto3ToResult(resp.Output)).Rows {
+			if summary == nil {
+				summary = &VDiffSummary{
+					Workflow:        row.AsString("workflow", ""),
+					Keyspace:        row.AsString("keyspace", ""),
+					UUID:            row.AsString("vdiff_uuid", ""),
+					Errors:          make(map[string]string),
+					TableSummaryMap: make(map[string]*VDiffTableSummary),
+					Reports:         make(map[string]map[string]*tabletvdiff.DiffReport),
+				}
+			}
+			if len(shards) == 0 || shards[len(shards)-1] != shard {
+				shards = append(shards, shard)
+				shardStates[row.AsString("state", "")] = true
+				if startedAt := row.AsString("started_at", ""); startedAt != "" && (summary.StartedAt == "" || startedAt < summary.StartedAt) {
+					summary.StartedAt = startedAt
+				}
+				if completedAt := row.AsString("completed_at", ""); completedAt > summary.CompletedAt {
+					summary.CompletedAt = completedAt
+				}
+				if lastError := row.AsString("last_error", ""); lastError != "" {
+					summary.Errors[shard] = lastError
+				}
+			}
+
+			table := row.AsString("table_name", "")
+			if table == "" {
+				// The vdiff of this shard has not listed its tables yet.
+				continue
+			}
+			ts := summary.TableSummaryMap[table]
+			if ts == nil {
+				ts = &VDiffTableSummary{TableName: table}
+				summary.TableSummaryMap[table] = ts
+				tableStates[table] = make(map[string]bool)
+				summary.Reports[table] = make(map[string]*tabletvdiff.DiffReport)
+			}
+			tableStates[table][row.AsString("table_state", "")] = true
+			ts.RowsCompared += row.AsInt64("rows_compared", 0)
+			ts.HasMismatch = ts.HasMismatch || row.AsInt64("mismatch", 0) != 0
+			if report := row.AsString("report", ""); report != "" {
+				dr := &tabletvdiff.DiffReport{}
+				if err := json.Unmarshal([]byte(report), dr); err != nil {
+					return nil, vterrors.Wrapf(err, "invalid report of table %v on shard %v", table, shard)
+				}
+				summary.Reports[table][shard] = dr
+			}
+		}
+	}
+	if summary == nil {
+		return nil, nil
+	}
+	summary.Shards = strings.Join(shards, ",")
+	summary.State = aggregateVDiffState(shardStates)
+	if summary.State != tabletvdiff.CompletedState {
+		summary.CompletedAt = ""
+	}
+	for table, ts := range summary.TableSummaryMap {
+		ts.State = aggregateVDiffState(tableStates[table])
+	}
+	return summary, nil
+}
+
+// summarizeVDiffList lists the vdiffs of the workflow from the target
+// shards, most recent first.
+func summarizeVDiffList(output *VDiffOutput) []*VDiffListEntry {
+	entries := make(map[string]*VDiffListEntry)
+	states := make(map[string]map[string]bool)
+	for _, shard := range sortedShards(output.Responses) {
+		resp := output.Responses[shard]
+		if resp.Output == nil {
+			continue
+		}
+		for _, row := range sqltypes.ToNamedResult(sqltypes.Proto3ToResult(resp.Output)).Rows {
+			vdiffUUID := row.AsString("vdiff_uuid", "")
+			entry := entries[vdiffUUID]
+			if entry == nil {
+				entry = &VDiffListEntry{UUID: vdiffUUID, CreatedAt: row.AsString("created_at", "")}
+				entries[vdiffUUID] = entry
+				states[vdiffUUID] = make(map[string]bool)
+			} else {
+				entry.Shards += ","
+			}
+			entry.Shards += shard
+			states[vdiffUUID][row.AsString("state", "")] = true
+			if createdAt := row.AsString("created_at", ""); createdAt < entry.CreatedAt {
+				entry.CreatedAt = createdAt
+			}
+			if completedAt := row.AsString("completed_at", ""); completedAt > entry.CompletedAt {
+				entry.CompletedAt = completedAt
+			}
+		}
+	}
+	list := make([]*VDiffListEntry, 0, len(entries))
+	for vdiffUUID, entry := range entries {
+		entry.State = aggregateVDiffState(states[vdiffUUID])
+		if entry.State != tabletvdiff.CompletedState {
+			entry.CompletedAt = ""
+		}
+		list = append(list, entry)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].CreatedAt != list[j].CreatedAt {
+			return list[i].CreatedAt > list[j].CreatedAt
+		}
+		return list[i].UUID < list[j].UUID
+	})
+	return list
+}
+
+// aggregateVDiffState returns the state across shards, given the states of
+// the shards.
+func aggregateVDiffState(states map[string]bool) string {
+	for _, state := range vdiffStateOrder {
+		if states[state] {
+			return state
+		}
+	}
+	// Unknown states are reported as is.
+	for state := range states {
+		return state
+	}
+	return ""
+}
+
+func sortedShards(responses map[string]*tabletmanagerdatapb.VDiffResponse) []string {
+	shards := make([]string, 0, len(responses))
+	for shard := range responses {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	return shards
+}
+
+func (wr *Wrangler) printVDiffSummary(keyspace, workflowName, actionArg, format string, summary *VDiffSummary) {
+	if summary == nil {
+		wr.Logger().Printf("No vdiff %v found for %v.%v\n", actionArg, keyspace, workflowName)
+		return
+	}
+	if format == "json" {
+		wr.printJSON(summary)
+		return
+	}
+	wr.Logger().Printf("VDiff Summary for %v.%v (%v)\n", keyspace, workflowName, summary.UUID)
+	wr.Logger().Printf("State:        %v\n", summary.State)
+	wr.Logger().Printf("Shards:       %v\n", summary.Shards)
+	if summary.StartedAt != "" {
+		wr.Logger().Printf("StartedAt:    %v\n", summary.StartedAt)
+	}
+	if summary.CompletedAt != "" {
+		wr.Logger().Printf("CompletedAt:  %v\n", summary.CompletedAt)
+	}
+	for _, shard := range sortedKeys(summary.Errors) {
+		wr.Logger().Printf("Error on %v: %v\n", shard, summary.Errors[shard])
+	}
+	tables := make([]string, 0, len(summary.TableSummaryMap))
+	for table := range summary.TableSummaryMap {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		ts := summary.TableSummaryMap[table]
+		wr.Logger().Printf("Table %v: State %v, RowsCompared %v, HasMismatch %v\n", table, ts.State, ts.RowsCompared, ts.HasMismatch)
+		if !ts.HasMismatch {
+			continue
+		}
+		reports := summary.Reports[table]
+		for _, shard := range sortedReportShards(reports) {
+			dr := reports[shard]
+			wr.Logger().Printf("\tShard %v: MatchingRows %v, MismatchedRows %v, ExtraRowsSource %v, ExtraRowsTarget %v\n",
+				shard, dr.MatchingRows, dr.MismatchedRows, dr.ExtraRowsSource, dr.ExtraRowsTarget)
+		}
+	}
+	wr.Logger().Printf("\nUse -format=json for the samples of the rows that differ\n")
+}
+
+func (wr *Wrangler) printVDiffList(keyspace, workflowName, format string, list []*VDiffListEntry) {
+	if format == "json" {
+		wr.printJSON(list)
+		return
+	}
+	if len(list) == 0 {
+		wr.Logger().Printf("No vdiff found for %v.%v\n", keyspace, workflowName)
+		return
+	}
+	for _, entry := range list {
+		wr.Logger().Printf("%v: State %v, Shards %v, CreatedAt %v\n", entry.UUID, entry.State, entry.Shards, entry.CreatedAt)
+	}
+}
+
+func (wr *Wrangler) printJSON(v interface{}) {
+	out, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		wr.Logger().Printf("Error converting report to json: %v", err.Error())
+		return
+	}
+	wr.Logger().Printf("%s\n", out)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedReportShards(reports map[string]*tabletvdiff.DiffReport) []string {
+	shards := make([]string, 0, len(reports))
+	for shard := range reports {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	return shards
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestSummarizeVDiff(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"vdiff_uuid|workflow|keyspace|shard|state|started_at|completed_at|last_error|table_name|table_state|rows_compared|mismatch|report",
+		"varchar|varbinary|varbinary|varchar|varbinary|timestamp|timestamp|varbinary|varbinary|varbinary|int64|int8|json")
+	output := &VDiffOutput{
+		Responses: map[string]*tabletmanagerdatapb.VDiffResponse{
+			"-80": {Output: sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields,
+				`u1|wf|ks|-80|completed|2021-01-01 00:00:02|2021-01-01 00:00:05||t1|completed|10|0|{"TableName":"t1","ProcessedRows":10,"MatchingRows":10}`,
+				`u1|wf|ks|-80|completed|2021-01-01 00:00:02|2021-01-01 00:00:05||t2|completed|5|0|{"TableName":"t2","ProcessedRows":5,"MatchingRows":5}`,
+			))},
+			"80-": {Output: sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields,
+				`u1|wf|ks|80-|started|2021-01-01 00:00:01|null||t1|completed|7|1|{"TableName":"t1","ProcessedRows":7,"MatchingRows":6,"ExtraRowsTarget":1}`,
+				`u1|wf|ks|80-|started|2021-01-01 00:00:01|null||t2|started|2|0|{"TableName":"t2","ProcessedRows":2,"MatchingRows":2}`,
+			))},
+			"c0-": {Output: sqltypes.ResultToProto3(&sqltypes.Result{})},
+		},
+	}
+	summary, err := summarizeVDiff(output)
+	require.NoError(t, err)
+	assert.Equal(t, &VDiffSummary{
+		Workflow:  "wf",
+		Keyspace:  "ks",
+		UUID:      "u1",
+		State:     tabletvdiff.StartedState,
+		Shards:    "-80,80-",
+		StartedAt: "2021-01-01 00:00:01",
+		Errors:    map[string]string{},
+		TableSummaryMap: map[string]*VDiffTableSummary{
+			"t1": {TableName: "t1", State: tabletvdiff.CompletedState, RowsCompared: 17, HasMismatch: true},
+			"t2": {TableName: "t2", State: tabletvdiff.StartedState, RowsCompared: 7},
+		},
+		Reports: map[string]map[string]*tabletvdiff.DiffReport{
+			"t1": {
+				"-80": {TableName: "t1", ProcessedRows: 10, MatchingRows: 10},
+				"80-": {TableName: "t1", ProcessedRows: 7, MatchingRows: 6, ExtraRowsTarget: 1},
+			},
+			"t2": {
+				"-80": {TableName: "t2", ProcessedRows: 5, MatchingRows: 5},
+				"80-": {TableName: "t2", ProcessedRows: 2, MatchingRows: 2},
+			},
+		},
+	}, summary)
+
+	summary, err = summarizeVDiff(&VDiffOutput{Responses: map[string]*tabletmanagerdatapb.VDiffResponse{"0": {}}})
+	require.NoError(t, err)
+	assert.Nil(t, summary)
+}
+
+func TestSummarizeVDiffList(t *testing.T) {
+	fields := sqltypes.MakeTestFields("vdiff_uuid|state|created_at|completed_at", "varchar|varbinary|timestamp|timestamp")
+	output := &VDiffOutput{
+		Responses: map[string]*tabletmanagerdatapb.VDiffResponse{
+			"-80": {Output: sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields,
+				"u2|completed|2021-01-02 00:00:00|2021-01-02 00:01:00",
+				"u1|completed|2021-01-01 00:00:00|2021-01-01 00:01:00",
+			))},
+			"80-": {Output: sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields,
+				"u2|error|2021-01-02 00:00:01|null",
+				"u1|completed|2021-01-01 00:00:01|2021-01-01 00:02:00",
+			))},
+		},
+	}
+	assert.Equal(t, []*VDiffListEntry{{
+		UUID:      "u2",
+		State:     tabletvdiff.ErrorState,
+		Shards:    "-80,80-",
+		CreatedAt: "2021-01-02 00:00:00",
+	}, {
+		UUID:        "u1",
+		State:       tabletvdiff.CompletedState,
+		Shards:      "-80,80-",
+		CreatedAt:   "2021-01-01 00:00:00",
+		CompletedAt: "2021-01-01 00:02:00",
+	}}, summarizeVDiffList(output))
+}
@@ -43,7 +43,7 @@ type VReplicationWorkflow struct {
 	ctx          context.Context
 	wr           *Wrangler
 	params       *VReplicationWorkflowParams
-	ts           *trafficSwitcher
+	ts           workflow.ITrafficSwitcher
 	ws           *workflow.State
 }
 
@@ -71,6 +71,7 @@ type VReplicationWorkflowParams struct {
 	// Reshard specific
 	SourceShards, TargetShards []string
 	SkipSchemaCopy             bool
+	SeedFromBackup             bool
 	AutoStart, StopAfterCopy   bool
 
 	// Migrate specific
@@ -90,9 +91,9 @@ func (wr *Wrangler) NewVReplicationWorkflow(ctx context.Context, workflowType VR
 	}
 	log.Infof("Workflow state is %+v", ws)
 	if ts != nil { //Other than on create we need to get SourceKeyspace from the workflow
-		vrw.params.TargetKeyspace = ts.targetKeyspace
-		vrw.params.Workflow = ts.workflow
-		vrw.params.SourceKeyspace = ts.sourceKeyspace
+		vrw.params.TargetKeyspace = ts.TargetKeyspaceName()
+		vrw.params.Workflow = ts.WorkflowName()
+		vrw.params.SourceKeyspace = ts.SourceKeyspaceName()
 	}
 	vrw.ts = ts
 	vrw.ws = ws
@@ -400,6 +401,10 @@ func (vrw *VReplicationWorkflow) initMoveTables() error {
 
 func (vrw *VReplicationWorkflow) initReshard() error {
 	log.Infof("In VReplicationWorkflow.initReshard() for %+v", vrw)
+	if vrw.params.SeedFromBackup {
+		return vrw.wr.ReshardFromBackup(vrw.ctx, vrw.params.TargetKeyspace, vrw.params.Workflow, vrw.params.SourceShards,
+			vrw.params.TargetShards, vrw.params.Cells, vrw.params.TabletTypes, vrw.params.AutoStart, vrw.params.StopAfterCopy)
+	}
 	return vrw.wr.Reshard(vrw.ctx, vrw.params.TargetKeyspace, vrw.params.Workflow, vrw.params.SourceShards,
 		vrw.params.TargetShards, vrw.params.SkipSchemaCopy, vrw.params.Cells, vrw.params.TabletTypes, vrw.params.AutoStart, vrw.params.StopAfterCopy)
 }
@@ -460,7 +465,7 @@ type CopyProgress map[string]*TableCopyProgress
 func (vrw *VReplicationWorkflow) IsCopyInProgress() (bool, error) {
 	ctx := context.Background()
 	getTablesQuery := "select 1 from _vt.copy_state cs, _vt.vreplication vr where vr.id = cs.vrepl_id and vr.id = %d"
-	for _, target := range vrw.ts.targets {
+	for _, target := range vrw.ts.Targets() {
 		for id := range target.Sources {
 			query := fmt.Sprintf(getTablesQuery, id)
 			p3qr, err := vrw.wr.tmc.ExecuteFetchAsDba(ctx, target.GetPrimary().Tablet, true, []byte(query), 1, false, false)
@@ -483,7 +488,7 @@ func (vrw *VReplicationWorkflow) GetCopyProgress() (*CopyProgress, error) {
 	tables := make(map[string]bool)
 	const MaxRows = 1000
 	sourcePrimaries := make(map[*topodatapb.TabletAlias]bool)
-	for _, target := range vrw.ts.targets {
+	for _, target := range vrw.ts.Targets() {
 		for id, bls := range target.Sources {
 			query := fmt.Sprintf(getTablesQuery, id)
 			p3qr, err := vrw.wr.tmc.ExecuteFetchAsDba(ctx, target.GetPrimary().Tablet, true, []byte(query), MaxRows, false, false)
@@ -551,7 +556,7 @@ func (vrw *VReplicationWorkflow) GetCopyProgress() (*CopyProgress, error) {
 		return nil
 	}
 	sourceDbName := ""
-	for _, tsSource := range vrw.ts.sources {
+	for _, tsSource := range vrw.ts.Sources() {
 		sourceDbName = tsSource.GetPrimary().DbName()
 		break
 	}
@@ -559,7 +564,7 @@ func (vrw *VReplicationWorkflow) GetCopyProgress() (*CopyProgress, error) {
 		return nil, fmt.Errorf("no sources found for workflow %s.%s", vrw.ws.TargetKeyspace, vrw.ws.Workflow)
 	}
 	targetDbName := ""
-	for _, tsTarget := range vrw.ts.targets {
+	for _, tsTarget := range vrw.ts.Targets() {
 		targetDbName = tsTarget.GetPrimary().DbName()
 		break
 	}
@@ -569,7 +574,7 @@ func (vrw *VReplicationWorkflow) GetCopyProgress() (*CopyProgress, error) {
 	sort.Strings(tableList) // sort list for repeatability for mocking in tests
 	tablesStr := strings.Join(tableList, ",")
 	query := fmt.Sprintf(getRowCountQuery, encodeString(targetDbName), tablesStr)
-	for _, target := range vrw.ts.targets {
+	for _, target := range vrw.ts.Targets() {
 		tablet := target.GetPrimary().Tablet
 		if err := getTableMetrics(tablet, query, &targetRowCounts, &targetTableSizes); err != nil {
 			return nil, err
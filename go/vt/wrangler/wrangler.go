@@ -22,6 +22,7 @@ import (
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
@@ -42,21 +43,19 @@ var (
 // Multiple go routines can use the same Wrangler at the same time,
 // provided they want to share the same logger / topo server / lock timeout.
 type Wrangler struct {
-	logger   logutil.Logger
-	ts       *topo.Server
-	tmc      tmclient.TabletManagerClient
-	vtctld   vtctlservicepb.VtctldServer
-	sourceTs *topo.Server
+	logger logutil.Logger
+	ts     *topo.Server
+	tmc    tmclient.TabletManagerClient
+	vtctld vtctlservicepb.VtctldServer
 }
 
 // New creates a new Wrangler object.
 func New(logger logutil.Logger, ts *topo.Server, tmc tmclient.TabletManagerClient) *Wrangler {
 	return &Wrangler{
-		logger:   logger,
-		ts:       ts,
-		tmc:      tmc,
-		vtctld:   grpcvtctldserver.NewVtctldServer(ts),
-		sourceTs: ts,
+		logger: logger,
+		ts:     ts,
+		tmc:    tmc,
+		vtctld: grpcvtctldserver.NewVtctldServer(ts),
 	}
 }
 
@@ -77,6 +76,12 @@ func (wr *Wrangler) VtctldServer() vtctlservicepb.VtctldServer {
 	return wr.vtctld
 }
 
+// workflowServer returns the workflow.Server that runs the workflow actions
+// with the topo server and tablet manager client of this wrangler.
+func (wr *Wrangler) workflowServer() *workflow.Server {
+	return workflow.NewServer(wr.ts, wr.tmc)
+}
+
 // SetLogger can be used to change the current logger. Not synchronized,
 // no calls to this wrangler should be in progress.
 func (wr *Wrangler) SetLogger(logger logutil.Logger) {